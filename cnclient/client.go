@@ -27,9 +27,11 @@ import (
 	"io/ioutil"
 	"log"
 	"os"
+	"path"
 	"strings"
 	"time"
 
+	"github.com/agl/ed25519"
 	"github.com/maditya/protobuf/jsonpb"
 	"golang.org/x/crypto/sha3"
 	"golang.org/x/net/context"
@@ -37,6 +39,7 @@ import (
 	"google.golang.org/grpc/credentials"
 
 	"github.com/yahoo/coname"
+	"github.com/yahoo/coname/keyfile"
 	"github.com/yahoo/coname/proto"
 )
 
@@ -61,12 +64,20 @@ func parsePrivateKey(der []byte) (crypto.PrivateKey, error) {
 }
 
 // This getKey interprets key IDs as paths, and loads private keys from the
-// specified file
+// specified file, decrypting it if it is encrypted (see package keyfile)
 func getKey(keyid string) (crypto.PrivateKey, error) {
-	fileContents, err := ioutil.ReadFile(keyid)
+	fileContents, err := keyfile.ReadFile(keyid)
 	if err != nil {
 		return nil, err
 	}
+	if path.Ext(keyid) == ".ed25519secret" {
+		if got, want := len(fileContents), ed25519.PrivateKeySize; got != want {
+			return nil, fmt.Errorf("ed25519 private key %s has wrong size %d (want %d)", keyid, got, want)
+		}
+		var keyArray [ed25519.PrivateKeySize]uint8
+		copy(keyArray[:], fileContents)
+		return &keyArray, nil
+	}
 	keyPEM := fileContents
 	var keyDER *pem.Block
 	for {
@@ -85,6 +96,7 @@ func main() {
 	configPathPtr := flag.String("config", "clientconfig.json", "path to config file")
 	name := flag.String("name", "dmz@yahoo-inc.com", "name to be looked up")
 	lookupOnly := flag.Bool("lookup", false, "only lookup the name")
+	keyPath := flag.String("key", "", "path to the key (.ed25519secret, or PEM ECDSA P-256 or RSA) that authorizes updates of the entry; if empty, anyone can update it")
 	flag.Parse()
 
 	timeOut := 10 * time.Second
@@ -139,10 +151,11 @@ func main() {
 	}
 	fmt.Printf("looking up %s:\n", *name)
 	keys, err := coname.VerifyLookup(cfg, *name, lookup, time.Now())
-	if err != nil {
+	if err == coname.ErrDeleted {
+		fmt.Printf("deleted\n")
+	} else if err != nil {
 		log.Fatal(err)
-	}
-	if keys == nil {
+	} else if keys == nil {
 		fmt.Printf("not present\n")
 	} else {
 		fmt.Printf("keys: %s\n", keys)
@@ -189,7 +202,31 @@ func main() {
 			ProfileCommitment: commitment[:],
 		},
 	}
+	var sk crypto.PrivateKey
+	var skID uint64
+	if *keyPath != "" {
+		if sk, err = getKey(*keyPath); err != nil {
+			log.Fatal(err)
+		}
+		pk, err := coname.PublicKeyFor(sk)
+		if err != nil {
+			log.Fatalf("%s: %s", *keyPath, err)
+		}
+		skID = proto.KeyID(pk)
+		entry.UpdatePolicy.PublicKeys[skID] = pk
+		quorum := entry.UpdatePolicy.GetQuorum()
+		quorum.Threshold = 1
+		quorum.Candidates = []uint64{skID}
+	}
 	entry.UpdateEncoding()
+	signatures := make(map[uint64][]byte)
+	if sk != nil {
+		// the same key authorizes replacing the previous entry if it had
+		// the same update policy
+		if signatures[skID], err = coname.Sign(sk, entry.Encoding); err != nil {
+			log.Fatal(err)
+		}
+	}
 	var entryHash [32]byte
 	sha3.ShakeSum256(entryHash[:], entry.Encoding)
 
@@ -197,7 +234,7 @@ func main() {
 	proof, err := publicC.Update(context.Background(), &proto.UpdateRequest{
 		Update: &proto.SignedEntryUpdate{
 			NewEntry:   entry,
-			Signatures: make(map[uint64][]byte),
+			Signatures: signatures,
 		},
 		Profile: profile,
 		LookupParameters: &proto.LookupRequest{
@@ -26,7 +26,7 @@ type SequenceBroadcast struct {
 	subscribers list.List // value type: sequenceSubscription
 }
 type sequenceSubscription struct {
-	ch           chan<- interface{}
+	ch           chan interface{}
 	start, limit uint64 // [start, limit)
 }
 
@@ -93,3 +93,20 @@ func (sb *SequenceBroadcast) Receive(start, limit uint64) <-chan interface{} {
 	sb.subscribers.PushBack(sequenceSubscription{ch: ch, start: start, limit: limit})
 	return ch
 }
+
+// Cancel ends the subscription whose channel ch was returned by Receive,
+// closing ch if Send has not already done so. Cancel(nil) is a no-op.
+func (sb *SequenceBroadcast) Cancel(ch <-chan interface{}) {
+	if ch == nil {
+		return
+	}
+	sb.Lock()
+	defer sb.Unlock()
+	for e := sb.subscribers.Front(); e != nil; e = e.Next() {
+		if s := (e.Value).(sequenceSubscription); s.ch == ch {
+			close(s.ch)
+			sb.subscribers.Remove(e)
+			return
+		}
+	}
+}
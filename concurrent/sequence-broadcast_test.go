@@ -55,6 +55,26 @@ func TestSequenceBroadcastSameStartLimit(t *testing.T) {
 	}
 }
 
+func TestSequenceBroadcastCancel(t *testing.T) {
+	sb := NewSequenceBroadcast(7)
+	ch1 := sb.Receive(7, 1<<40)
+	ch2 := sb.Receive(7, 1<<40)
+	sb.Cancel(ch1)
+	if _, ok := <-ch1; ok {
+		t.Errorf("sequenceBroadcast.Cancel did not close ch")
+	}
+	if sb.subscribers.Len() != 1 {
+		t.Errorf("sequenceBroadcast.Cancel left %d subscribers, expected 1", sb.subscribers.Len())
+	}
+	sb.Send(nil)
+	if _, ok := <-ch2; !ok {
+		t.Errorf("sequenceBroadcast.Cancel closed another subscription")
+	}
+	// cancelling a subscription again or after it ended must not panic
+	sb.Cancel(ch1)
+	sb.Cancel(nil)
+}
+
 func TestSequenceBroadcastFutureReceiveBlocks(t *testing.T) {
 	sb := NewSequenceBroadcast(7)
 	ch := sb.Receive(13, 1<<40)
@@ -916,6 +916,12 @@ func FeInvert(out, z *FieldElement) {
 	FeMul(out, &t1, &t0) // 254..5,3,1,0
 }
 
+// FePow22523 sets out to z^((q-5)/8), the exponentiation that square roots in
+// GF(2^255 - 19) are computed with.
+func FePow22523(out, z *FieldElement) {
+	fePow22523(out, z)
+}
+
 func fePow22523(out, z *FieldElement) {
 	var t0, t1, t2 FieldElement
 	var i int
@@ -9,9 +9,12 @@ import (
 
 	"github.com/andres-erbsen/clock"
 	"github.com/yahoo/coname"
+	"github.com/yahoo/coname/httpfront"
 	"github.com/yahoo/coname/proto"
 	"golang.org/x/crypto/openpgp/armor"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
 )
 
 // HKPFront implements a unverified GnuPG-compatible HKP frontend for the
@@ -105,6 +108,10 @@ func (h *HKPFront) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 	user := q["search"][0]
 	ctx := context.Background()
+	if addr, err := net.ResolveTCPAddr("tcp", r.RemoteAddr); err == nil {
+		// the keyserver rate-limits lookups by the client address
+		ctx = peer.NewContext(ctx, &peer.Peer{Addr: addr})
+	}
 
 	var requiredSignatures *proto.QuorumExpr
 	if !h.InsecureSkipVerify {
@@ -122,7 +129,7 @@ func (h *HKPFront) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 	pf, err := h.Lookup(ctx, &proto.LookupRequest{UserId: user, QuorumRequirement: requiredSignatures})
 	if err != nil {
-		http.Error(w, err.Error(), 503)
+		http.Error(w, grpc.ErrorDesc(err), httpfront.HTTPStatus(err))
 		return
 	}
 
@@ -130,6 +137,10 @@ func (h *HKPFront) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		coname.VerifyLookup(h.Config, user, pf, h.Clk.Now())
 	}
 
+	if pf.Entry != nil && pf.Entry.Deleted {
+		http.Error(w, `No results found: No keys found: the email has been deleted`, 404)
+		return
+	}
 	if pf.Profile == nil || pf.Profile.Keys == nil {
 		http.Error(w, `No results found: No keys found: unknown email`, 404)
 		return
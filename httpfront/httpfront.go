@@ -2,27 +2,38 @@ package httpfront
 
 import (
 	//"errors"
+	"crypto/rand"
+	"crypto/subtle"
 	"crypto/tls"
+	"encoding/base64"
+	"encoding/json"
 	"io"
 	"net"
 	"net/http"
 	"net/url"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/maditya/protobuf/jsonpb"
 	"github.com/yahoo/coname/proto"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
 )
 
 // HTTPFront implements a dumb http proxy for the keyserver grpc interface
 type HTTPFront struct {
 	Lookup        func(context.Context, *proto.LookupRequest) (*proto.LookupProof, error)
 	Update        func(context.Context, *proto.UpdateRequest) (*proto.LookupProof, error)
-	SAMLRequest   func() (string, error)
-	OIDCRequest   func(string, string) (string, error)
+	SAMLRequest   func(string) (string, error)
+	OIDCRequest   func(string, string, string, string) (string, error) // domain, redirect URI, state, nonce
+	OIDCCallback  func(string, string, string, string) (string, error) // domain, code, redirect URI, nonce
 	InRotation    func() bool
-	IsAuthExpired func(error) bool
+	PendingUpdate func(context.Context, *proto.UpdateRequest) error // optional
 
 	// this is needed due to https://github.com/golang/go/issues/14374
 	TLSConfig *tls.Config
@@ -101,7 +112,7 @@ func (h *HTTPFront) doLookup(b io.Reader, ctx context.Context) (*proto.LookupPro
 	lr := &proto.LookupRequest{}
 	err := jsonpb.Unmarshal(b, lr)
 	if err != nil {
-		return nil, err
+		return nil, grpc.Errorf(codes.InvalidArgument, "%s", err)
 	}
 
 	pf, err := h.Lookup(ctx, lr)
@@ -116,7 +127,7 @@ func (h *HTTPFront) doUpdate(b io.Reader, ctx context.Context) (*proto.LookupPro
 	ur := &proto.UpdateRequest{}
 	err := jsonpb.Unmarshal(b, ur)
 	if err != nil {
-		return nil, err
+		return nil, grpc.Errorf(codes.InvalidArgument, "%s", err)
 	}
 
 	pf, err := h.Update(ctx, ur)
@@ -127,6 +138,136 @@ func (h *HTTPFront) doUpdate(b io.Reader, ctx context.Context) (*proto.LookupPro
 
 }
 
+func (h *HTTPFront) doPendingUpdate(b io.Reader, ctx context.Context) error {
+	ur := &proto.UpdateRequest{}
+	err := jsonpb.Unmarshal(b, ur)
+	if err != nil {
+		return grpc.Errorf(codes.InvalidArgument, "%s", err)
+	}
+	return h.PendingUpdate(ctx, ur)
+}
+
+// requestContext makes the client address and certificate of r available
+// the same way grpc does, for client authentication and rate limiting.
+func requestContext(r *http.Request) context.Context {
+	pr := &peer.Peer{}
+	if addr, err := net.ResolveTCPAddr("tcp", r.RemoteAddr); err == nil {
+		pr.Addr = addr
+	}
+	if r.TLS != nil {
+		pr.AuthInfo = credentials.TLSInfo{State: *r.TLS}
+	}
+	return peer.NewContext(context.Background(), pr)
+}
+
+// HTTPStatus returns the HTTP status code for the gRPC status code of err,
+// as the gRPC HTTP gateway maps them.
+func HTTPStatus(err error) int {
+	switch grpc.Code(err) {
+	case codes.OK:
+		return http.StatusOK
+	case codes.Canceled:
+		return http.StatusRequestTimeout
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	}
+	return http.StatusInternalServerError
+}
+
+// writeError responds with the HTTP status for err and a JSON body that
+// carries the name of its gRPC status code, so that clients can tell errors
+// apart the same way over HTTP as over gRPC.
+func writeError(w http.ResponseWriter, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(HTTPStatus(err))
+	json.NewEncoder(w).Encode(struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	}{grpc.Code(err).String(), grpc.ErrorDesc(err)})
+}
+
+const (
+	// oidcCookie binds an OpenID Connect authentication request to the
+	// browser that started it. Its value is state.nonce.time.domain, where
+	// time is when the request was started in seconds since the epoch, so
+	// that it expires even if the browser keeps the cookie.
+	oidcCookie       = "coname_oidc"
+	oidcCookieMaxAge = 10 * 60
+)
+
+func randomToken() string {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		panic(err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b[:])
+}
+
+// oidcCallback handles the redirect from the OpenID Connect provider. It
+// responds with the email proof to be included in an update request.
+func (h *HTTPFront) oidcCallback(w http.ResponseWriter, r *http.Request) {
+	u, err := url.ParseQuery(r.URL.RawQuery)
+	if err != nil {
+		http.Error(w, `error parsing query string`, http.StatusBadRequest)
+		return
+	}
+	if e := u.Get("error"); e != "" {
+		http.Error(w, "authentication failed: "+e+": "+u.Get("error_description"), http.StatusUnauthorized)
+		return
+	}
+	c, err := r.Cookie(oidcCookie)
+	if err != nil {
+		http.Error(w, `no authentication request in progress`, http.StatusBadRequest)
+		return
+	}
+	// the request can only be completed once
+	http.SetCookie(w, &http.Cookie{Name: oidcCookie, Path: "/oidcsso", MaxAge: -1, Secure: true, HttpOnly: true})
+	parts := strings.SplitN(c.Value, ".", 4)
+	if len(parts) != 4 || parts[0] == "" || subtle.ConstantTimeCompare([]byte(parts[0]), []byte(u.Get("state"))) != 1 {
+		http.Error(w, `state does not match the authentication request`, http.StatusBadRequest)
+		return
+	}
+	started, err := strconv.ParseInt(parts[2], 10, 64)
+	if err != nil || time.Since(time.Unix(started, 0)) > oidcCookieMaxAge*time.Second {
+		http.Error(w, `authentication request expired`, http.StatusBadRequest)
+		return
+	}
+	nonce, d := parts[1], parts[3]
+	code := u.Get("code")
+	if code == "" {
+		http.Error(w, `code not found`, http.StatusBadRequest)
+		return
+	}
+	token, err := h.OIDCCallback(d, code, "https://"+r.Host+"/oidcsso", nonce)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	marshaler := jsonpb.Marshaler{OrigName: true}
+	if err := marshaler.Marshal(w, &proto.EmailProof{ProofType: &proto.EmailProof_OIDCToken{OIDCToken: token}}); err != nil {
+		http.Error(w, `Internal server error`, http.StatusInternalServerError)
+		return
+	}
+}
+
 func (h *HTTPFront) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	path := r.URL.Path
 	method := r.Method
@@ -144,9 +285,16 @@ func (h *HTTPFront) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if method == "GET" && path == "/saml" {
-		url, err := h.SAMLRequest()
+		u, err := url.ParseQuery(r.URL.RawQuery)
 		if err != nil {
-			http.Error(w, err.Error(), http.StatusNotFound)
+			http.Error(w, `error parsing query string`, http.StatusBadRequest)
+			return
+		}
+		// without a domain, the keyserver picks its identity provider if
+		// it has only one
+		url, err := h.SAMLRequest(u.Get("domain"))
+		if err != nil {
+			http.Error(w, grpc.ErrorDesc(err), HTTPStatus(err))
 			return
 		}
 		http.Redirect(w, r, url, http.StatusFound)
@@ -164,35 +312,55 @@ func (h *HTTPFront) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
-		url, err := h.OIDCRequest(d, "https://"+r.Host+"/oidcsso")
+		state, nonce := randomToken(), randomToken()
+		url, err := h.OIDCRequest(d, "https://"+r.Host+"/oidcsso", state, nonce)
 		if err != nil {
-			http.Error(w, err.Error(), http.StatusNotFound)
+			http.Error(w, grpc.ErrorDesc(err), HTTPStatus(err))
 			return
 		}
+		// the state and nonce are checked when the provider redirects the
+		// same browser back to /oidcsso
+		http.SetCookie(w, &http.Cookie{Name: oidcCookie, Value: state + "." + nonce + "." + strconv.FormatInt(time.Now().Unix(), 10) + "." + d,
+			Path: "/oidcsso", MaxAge: oidcCookieMaxAge, Secure: true, HttpOnly: true})
 		http.Redirect(w, r, url, http.StatusFound)
+		return
+	}
+	if method == "GET" && path == "/oidcsso" {
+		h.oidcCallback(w, r)
+		return
+	}
+
+	if method == "POST" && path == "/pendingupdate" {
+		if h.PendingUpdate == nil {
+			http.Error(w, `registration by email is not supported`, http.StatusNotFound)
+			return
+		}
+		if err := h.doPendingUpdate(r.Body, requestContext(r)); err != nil {
+			writeError(w, err)
+			return
+		}
+		// the registration completes when the email proof arrives
+		w.WriteHeader(http.StatusAccepted)
+		return
 	}
 
 	if method != "POST" || (path != "/lookup" && path != "/update") {
-		http.Error(w, `this server only supports queries of the POST /lookup or POST /update`, http.StatusNotFound)
+		http.Error(w, `this server only supports queries of the POST /lookup, POST /update or POST /pendingupdate`, http.StatusNotFound)
 		return
 	}
 	pf := &proto.LookupProof{}
 	var err error
-	ctx := context.Background()
+	ctx := requestContext(r)
 	if path == "/lookup" {
 		pf, err = h.doLookup(r.Body, ctx)
 		if err != nil {
-			http.Error(w, err.Error(), http.StatusBadRequest)
+			writeError(w, err)
 			return
 		}
 	} else if path == "/update" {
 		pf, err = h.doUpdate(r.Body, ctx)
 		if err != nil {
-			status := http.StatusBadRequest
-			if h.IsAuthExpired(err) {
-				status = http.StatusUnauthorized
-			}
-			http.Error(w, err.Error(), status)
+			writeError(w, err)
 			return
 		}
 	}
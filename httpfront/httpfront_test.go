@@ -0,0 +1,203 @@
+package httpfront
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+func TestHTTPStatus(t *testing.T) {
+	for _, c := range []struct {
+		code   codes.Code
+		status int
+	}{
+		{codes.OK, http.StatusOK},
+		{codes.Canceled, http.StatusRequestTimeout},
+		{codes.Unknown, http.StatusInternalServerError},
+		{codes.InvalidArgument, http.StatusBadRequest},
+		{codes.DeadlineExceeded, http.StatusGatewayTimeout},
+		{codes.NotFound, http.StatusNotFound},
+		{codes.AlreadyExists, http.StatusConflict},
+		{codes.PermissionDenied, http.StatusForbidden},
+		{codes.ResourceExhausted, http.StatusTooManyRequests},
+		{codes.FailedPrecondition, http.StatusBadRequest},
+		{codes.Aborted, http.StatusConflict},
+		{codes.OutOfRange, http.StatusBadRequest},
+		{codes.Unimplemented, http.StatusNotImplemented},
+		{codes.Internal, http.StatusInternalServerError},
+		{codes.Unavailable, http.StatusServiceUnavailable},
+		{codes.DataLoss, http.StatusInternalServerError},
+		{codes.Unauthenticated, http.StatusUnauthorized},
+	} {
+		var err error
+		if c.code != codes.OK {
+			err = grpc.Errorf(c.code, "error with code %s", c.code)
+		}
+		if got := HTTPStatus(err); got != c.status {
+			t.Errorf("HTTPStatus(%s) = %d, want %d", c.code, got, c.status)
+		}
+		if c.code == codes.OK {
+			continue
+		}
+		w := httptest.NewRecorder()
+		writeError(w, err)
+		if w.Code != c.status {
+			t.Errorf("writeError(%s) responded with status %d, want %d", c.code, w.Code, c.status)
+		}
+		var body struct{ Code, Message string }
+		if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+			t.Errorf("writeError(%s): %s", c.code, err)
+			continue
+		}
+		if body.Code != c.code.String() || body.Message != fmt.Sprintf("error with code %s", c.code) {
+			t.Errorf("writeError(%s) responded with %q", c.code, w.Body.String())
+		}
+	}
+	// errors without a gRPC code are internal errors
+	if got := HTTPStatus(fmt.Errorf("no code")); got != http.StatusInternalServerError {
+		t.Errorf("HTTPStatus(no code) = %d, want %d", got, http.StatusInternalServerError)
+	}
+}
+
+// oidcTestFront returns an HTTPFront whose identity provider issues a token
+// for each authentication request, bound to its nonce, under the code
+// "code-" + state.
+func oidcTestFront() *HTTPFront {
+	tokenNonces := make(map[string]string) // code -> nonce
+	return &HTTPFront{
+		OIDCRequest: func(domain, uri, state, nonce string) (string, error) {
+			tokenNonces["code-"+state] = nonce
+			return "https://idp.example.com/auth?state=" + url.QueryEscape(state), nil
+		},
+		OIDCCallback: func(domain, code, uri, nonce string) (string, error) {
+			tokenNonce, ok := tokenNonces[code]
+			if !ok {
+				return "", grpc.Errorf(codes.InvalidArgument, "unknown code")
+			}
+			if tokenNonce != nonce {
+				return "", grpc.Errorf(codes.Unauthenticated, "nonce invalid")
+			}
+			return "token-for-" + domain, nil
+		},
+	}
+}
+
+// startOIDC starts an authentication request at h and returns its state and
+// the cookie that binds it to the browser.
+func startOIDC(t *testing.T, h *HTTPFront) (string, *http.Cookie) {
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest("GET", "https://ks.example.com/oidc?domain=example.com", nil))
+	if w.Code != http.StatusFound {
+		t.Fatalf("/oidc responded with %d: %s", w.Code, w.Body.String())
+	}
+	loc, err := url.Parse(w.Header().Get("Location"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range w.Result().Cookies() {
+		if c.Name == oidcCookie {
+			return loc.Query().Get("state"), c
+		}
+	}
+	t.Fatal("/oidc did not set the authentication request cookie")
+	return "", nil
+}
+
+func oidcCallbackStatus(h *HTTPFront, state, code string, c *http.Cookie) (int, string) {
+	r := httptest.NewRequest("GET", "https://ks.example.com/oidcsso?state="+url.QueryEscape(state)+"&code="+url.QueryEscape(code), nil)
+	if c != nil {
+		r.AddCookie(c)
+	}
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	return w.Code, w.Body.String()
+}
+
+func TestOIDCCallback(t *testing.T) {
+	h := oidcTestFront()
+	state, c := startOIDC(t, h)
+	if status, body := oidcCallbackStatus(h, state, "code-"+state, c); status != http.StatusOK || !strings.Contains(body, "token-for-example.com") {
+		t.Errorf("callback responded with %d: %s", status, body)
+	}
+}
+
+func TestOIDCCallbackStateMismatch(t *testing.T) {
+	h := oidcTestFront()
+	state, c := startOIDC(t, h)
+	otherState, _ := startOIDC(t, h)
+	for _, s := range []string{"", otherState, state + "x"} {
+		if status, body := oidcCallbackStatus(h, s, "code-"+state, c); status != http.StatusBadRequest {
+			t.Errorf("callback with state %q responded with %d: %s", s, status, body)
+		}
+	}
+}
+
+func TestOIDCCallbackNonceMismatch(t *testing.T) {
+	h := oidcTestFront()
+	state, c := startOIDC(t, h)
+	otherState, _ := startOIDC(t, h)
+	// a code issued for another authentication request carries the nonce of
+	// that request
+	if status, body := oidcCallbackStatus(h, state, "code-"+otherState, c); status != http.StatusUnauthorized {
+		t.Errorf("callback with the code of another request responded with %d: %s", status, body)
+	}
+}
+
+func TestOIDCCallbackMissingCookie(t *testing.T) {
+	h := oidcTestFront()
+	state, _ := startOIDC(t, h)
+	if status, body := oidcCallbackStatus(h, state, "code-"+state, nil); status != http.StatusBadRequest {
+		t.Errorf("callback without a cookie responded with %d: %s", status, body)
+	}
+}
+
+func TestOIDCCallbackExpiredCookie(t *testing.T) {
+	h := oidcTestFront()
+	state, c := startOIDC(t, h)
+	parts := strings.SplitN(c.Value, ".", 4)
+	for _, started := range []string{
+		strconv.FormatInt(time.Now().Add(-(oidcCookieMaxAge+1)*time.Second).Unix(), 10),
+		"",
+		"notanumber",
+	} {
+		expired := *c
+		expired.Value = strings.Join([]string{parts[0], parts[1], started, parts[3]}, ".")
+		if status, body := oidcCallbackStatus(h, state, "code-"+state, &expired); status != http.StatusBadRequest {
+			t.Errorf("callback with a cookie from %q responded with %d: %s", started, status, body)
+		}
+	}
+}
+
+func TestSAMLWithoutDomain(t *testing.T) {
+	var gotDomain *string
+	h := &HTTPFront{SAMLRequest: func(domain string) (string, error) {
+		gotDomain = &domain
+		if domain == "" {
+			return "https://idp.example.com/sso?SAMLRequest=x", nil
+		}
+		return "", grpc.Errorf(codes.NotFound, "domain %q NOT configured for SAML auth", domain)
+	}}
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest("GET", "https://ks.example.com/saml", nil))
+	if gotDomain == nil || *gotDomain != "" {
+		t.Fatalf("/saml without a domain did not ask the keyserver for its only identity provider")
+	}
+	if w.Code != http.StatusFound || w.Header().Get("Location") != "https://idp.example.com/sso?SAMLRequest=x" {
+		t.Errorf("/saml without a domain responded with %d, location %q", w.Code, w.Header().Get("Location"))
+	}
+
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest("GET", "https://ks.example.com/saml?domain=example.com", nil))
+	if *gotDomain != "example.com" || w.Code != http.StatusNotFound {
+		t.Errorf("/saml for an unconfigured domain responded with %d", w.Code)
+	}
+}
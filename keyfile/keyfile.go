@@ -0,0 +1,284 @@
+// Copyright 2014-2015 The Dename Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy of
+// the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations under
+// the License.
+
+// Package keyfile implements encryption at rest for the private key files of
+// keyserver replicas, verifiers and clients.
+//
+// An encrypted key file is a PEM block of type "CONAME ENCRYPTED KEY" whose
+// contents are the original file (raw key bytes or PEM) sealed with AES-256-GCM
+// under a random 96-bit nonce that is prepended to the ciphertext. The
+// AES key is either derived from a passphrase using scrypt, with the salt and
+// cost parameters in the Scrypt-* headers, or wrapped by a key management
+// service under the key-encryption key named in the KEK-ID header, with the
+// wrapped key in the Wrapped-Key header. The headers are authenticated as
+// additional data.
+package keyfile
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	pemType = "CONAME ENCRYPTED KEY"
+
+	keySize = 32
+	// scrypt parameters for new files, and the largest ones that are
+	// accepted from existing files so that a corrupt file can not exhaust
+	// memory or time
+	scryptN, scryptR, scryptP          = 1 << 15, 8, 1
+	maxScryptN, maxScryptR, maxScryptP = 1 << 20, 16, 16
+	saltSize                           = 16
+)
+
+// The environment variables read by FromEnvironment.
+const (
+	PassphraseEnv     = "CONAME_KEY_PASSPHRASE"
+	PassphraseFileEnv = "CONAME_KEY_PASSPHRASE_FILE"
+	KMSSocketEnv      = "CONAME_KEY_KMS_SOCKET"
+	KEKIDEnv          = "CONAME_KEY_KEK_ID"
+)
+
+// KMS wraps and unwraps data keys under key-encryption keys that it keeps to
+// itself, the way a key management service or HSM does.
+type KMS interface {
+	Wrap(kekID string, key []byte) ([]byte, error)
+	Unwrap(kekID string, wrapped []byte) ([]byte, error)
+}
+
+// Options determine how key files are encrypted and decrypted.
+type Options struct {
+	// Passphrase is used for files encrypted with a passphrase.
+	Passphrase []byte
+	// KMS is used for files encrypted with a wrapped key.
+	KMS KMS
+	// KEKID makes Encrypt wrap the data key with KMS under this
+	// key-encryption key instead of deriving it from Passphrase.
+	KEKID string
+}
+
+// FromEnvironment returns Options with the passphrase in $CONAME_KEY_PASSPHRASE
+// or in the file named by $CONAME_KEY_PASSPHRASE_FILE, the KMS listening on the
+// Unix socket named by $CONAME_KEY_KMS_SOCKET and the key-encryption key ID in
+// $CONAME_KEY_KEK_ID. All of them are optional.
+func FromEnvironment() (*Options, error) {
+	o := new(Options)
+	if p := os.Getenv(PassphraseEnv); p != "" {
+		o.Passphrase = []byte(p)
+	} else if path := os.Getenv(PassphraseFileEnv); path != "" {
+		p, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("keyfile: reading passphrase: %s", err)
+		}
+		o.Passphrase = bytes.TrimRight(p, "\r\n")
+	}
+	if path := os.Getenv(KMSSocketEnv); path != "" {
+		o.KMS = DialKMS(path)
+	}
+	o.KEKID = os.Getenv(KEKIDEnv)
+	return o, nil
+}
+
+// IsEncrypted returns whether contents is an encrypted key file.
+func IsEncrypted(contents []byte) bool {
+	return bytes.HasPrefix(bytes.TrimSpace(contents), []byte("-----BEGIN "+pemType+"-----"))
+}
+
+// Encrypt returns an encrypted key file with contents plaintext.
+func (o *Options) Encrypt(plaintext []byte) ([]byte, error) {
+	key := make([]byte, keySize)
+	headers := make(map[string]string)
+	if o.KEKID != "" {
+		if o.KMS == nil {
+			return nil, errors.New("keyfile: a key-encryption key ID is set, but no KMS")
+		}
+		if strings.ContainsAny(o.KEKID, "\r\n") || strings.TrimSpace(o.KEKID) != o.KEKID {
+			return nil, fmt.Errorf("keyfile: key-encryption key ID %q does not fit in a PEM header", o.KEKID)
+		}
+		if _, err := rand.Read(key); err != nil {
+			return nil, err
+		}
+		wrapped, err := o.KMS.Wrap(o.KEKID, key)
+		if err != nil {
+			return nil, fmt.Errorf("keyfile: wrapping key: %s", err)
+		}
+		headers["KEK-ID"] = o.KEKID
+		headers["Wrapped-Key"] = hex.EncodeToString(wrapped)
+	} else {
+		if len(o.Passphrase) == 0 {
+			return nil, fmt.Errorf("keyfile: no passphrase or key-encryption key to encrypt with (set $%s, $%s or $%s)", PassphraseEnv, PassphraseFileEnv, KEKIDEnv)
+		}
+		salt := make([]byte, saltSize)
+		if _, err := rand.Read(salt); err != nil {
+			return nil, err
+		}
+		headers["Scrypt-Salt"] = hex.EncodeToString(salt)
+		headers["Scrypt-N"] = strconv.Itoa(scryptN)
+		headers["Scrypt-R"] = strconv.Itoa(scryptR)
+		headers["Scrypt-P"] = strconv.Itoa(scryptP)
+		var err error
+		if key, err = scrypt.Key(o.Passphrase, salt, scryptN, scryptR, scryptP, keySize); err != nil {
+			return nil, err
+		}
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{
+		Type:    pemType,
+		Headers: headers,
+		Bytes:   aead.Seal(nonce, nonce, plaintext, additionalData(headers)),
+	}), nil
+}
+
+// Decrypt returns the plaintext of an encrypted key file. Files that are not
+// encrypted are returned unchanged.
+func (o *Options) Decrypt(contents []byte) ([]byte, error) {
+	if !IsEncrypted(contents) {
+		return contents, nil
+	}
+	block, _ := pem.Decode(contents)
+	if block == nil || block.Type != pemType {
+		return nil, errors.New("keyfile: bad PEM encoding")
+	}
+	headers, sealed := block.Headers, block.Bytes
+	var key []byte
+	if kekID, ok := headers["KEK-ID"]; ok {
+		if o.KMS == nil {
+			return nil, fmt.Errorf("keyfile: the key is wrapped by a KMS, but none is configured (set $%s)", KMSSocketEnv)
+		}
+		wrapped, err := hex.DecodeString(headers["Wrapped-Key"])
+		if err != nil {
+			return nil, fmt.Errorf("keyfile: bad Wrapped-Key: %s", err)
+		}
+		if key, err = o.KMS.Unwrap(kekID, wrapped); err != nil {
+			return nil, fmt.Errorf("keyfile: unwrapping key: %s", err)
+		}
+	} else {
+		if len(o.Passphrase) == 0 {
+			return nil, fmt.Errorf("keyfile: the key is encrypted with a passphrase, but none is configured (set $%s or $%s)", PassphraseEnv, PassphraseFileEnv)
+		}
+		salt, err := hex.DecodeString(headers["Scrypt-Salt"])
+		if err != nil {
+			return nil, fmt.Errorf("keyfile: bad Scrypt-Salt: %s", err)
+		}
+		var params [3]int
+		for i, name := range []string{"Scrypt-N", "Scrypt-R", "Scrypt-P"} {
+			if params[i], err = strconv.Atoi(headers[name]); err != nil || params[i] <= 0 {
+				return nil, fmt.Errorf("keyfile: bad %s %q", name, headers[name])
+			}
+		}
+		if params[0] > maxScryptN || params[1] > maxScryptR || params[2] > maxScryptP {
+			return nil, fmt.Errorf("keyfile: scrypt parameters N=%d r=%d p=%d are too expensive", params[0], params[1], params[2])
+		}
+		if key, err = scrypt.Key(o.Passphrase, salt, params[0], params[1], params[2], keySize); err != nil {
+			return nil, fmt.Errorf("keyfile: %s", err)
+		}
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(sealed) < aead.NonceSize() {
+		return nil, errors.New("keyfile: ciphertext too short")
+	}
+	plaintext, err := aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], additionalData(headers))
+	if err != nil {
+		return nil, errors.New("keyfile: decryption failed (wrong passphrase or key, or the file was modified)")
+	}
+	return plaintext, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	if len(key) != keySize {
+		return nil, fmt.Errorf("keyfile: key of %d bytes (want %d)", len(key), keySize)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// additionalData binds the headers to the ciphertext.
+func additionalData(headers map[string]string) []byte {
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	ad := []byte(pemType + "\n")
+	for _, name := range names {
+		ad = append(ad, name+": "+headers[name]+"\n"...)
+	}
+	return ad
+}
+
+var (
+	envOnce    sync.Once
+	envOptions *Options
+	envErr     error
+)
+
+// ReadFile reads the key file at path and decrypts it if it is encrypted,
+// using the Options from FromEnvironment.
+func ReadFile(path string) ([]byte, error) {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil || !IsEncrypted(contents) {
+		return contents, err
+	}
+	envOnce.Do(func() { envOptions, envErr = FromEnvironment() })
+	if envErr != nil {
+		return nil, envErr
+	}
+	plaintext, err := envOptions.Decrypt(contents)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", path, err)
+	}
+	return plaintext, nil
+}
+
+// WriteFile encrypts plaintext using the Options from FromEnvironment and
+// writes it to path, readable only by the owner. It is intended for tools that
+// generate keys.
+func WriteFile(path string, plaintext []byte) error {
+	o, err := FromEnvironment()
+	if err != nil {
+		return err
+	}
+	contents, err := o.Encrypt(plaintext)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, contents, 0600)
+}
@@ -0,0 +1,163 @@
+// Copyright 2014-2015 The Dename Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy of
+// the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations under
+// the License.
+
+package keyfile
+
+import (
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"time"
+)
+
+const kmsTimeout = 10 * time.Second
+
+// kmsRequest and kmsResponse are exchanged as JSON over a stream connection,
+// one request per connection. Op is "wrap" or "unwrap".
+type kmsRequest struct {
+	Op    string
+	KEKID string
+	Key   []byte
+}
+
+type kmsResponse struct {
+	Key   []byte
+	Error string
+}
+
+type kmsClient struct {
+	path string
+}
+
+// DialKMS returns a KMS that forwards requests to the key management service
+// listening on the Unix socket at path, for example one run by ServeKMS. The
+// socket is dialed for each request.
+func DialKMS(path string) KMS {
+	return &kmsClient{path: path}
+}
+
+func (c *kmsClient) Wrap(kekID string, key []byte) ([]byte, error) {
+	return c.do(&kmsRequest{Op: "wrap", KEKID: kekID, Key: key})
+}
+
+func (c *kmsClient) Unwrap(kekID string, wrapped []byte) ([]byte, error) {
+	return c.do(&kmsRequest{Op: "unwrap", KEKID: kekID, Key: wrapped})
+}
+
+func (c *kmsClient) do(req *kmsRequest) ([]byte, error) {
+	conn, err := net.DialTimeout("unix", c.path, kmsTimeout)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(kmsTimeout))
+	if err := json.NewEncoder(conn).Encode(req); err != nil {
+		return nil, err
+	}
+	var resp kmsResponse
+	if err := json.NewDecoder(conn).Decode(&resp); err != nil {
+		return nil, err
+	}
+	if resp.Error != "" {
+		return nil, fmt.Errorf("KMS %s: %s", c.path, resp.Error)
+	}
+	return resp.Key, nil
+}
+
+// ServeKMS answers requests from DialKMS on ln using kms until ln is closed.
+func ServeKMS(ln net.Listener, kms KMS) error {
+	for {
+		conn, err := ln.Accept()
+		if err != nil {
+			if ne, ok := err.(net.Error); ok && ne.Temporary() {
+				continue
+			}
+			return err
+		}
+		go serveKMSConn(conn, kms)
+	}
+}
+
+func serveKMSConn(conn net.Conn, kms KMS) {
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(kmsTimeout))
+	var req kmsRequest
+	if err := json.NewDecoder(conn).Decode(&req); err != nil {
+		log.Printf("KMS: reading request: %s", err)
+		return
+	}
+	var resp kmsResponse
+	var err error
+	switch req.Op {
+	case "wrap":
+		resp.Key, err = kms.Wrap(req.KEKID, req.Key)
+	case "unwrap":
+		resp.Key, err = kms.Unwrap(req.KEKID, req.Key)
+	default:
+		err = fmt.Errorf("unknown op %q", req.Op)
+	}
+	if err != nil {
+		resp.Error = err.Error()
+	}
+	if err := json.NewEncoder(conn).Encode(&resp); err != nil {
+		log.Printf("KMS: writing response: %s", err)
+	}
+}
+
+// LocalKMS is a stand-in for a key management service that keeps its
+// key-encryption keys in memory. Served by ServeKMS in a separate process, it
+// keeps the keys of key files out of the processes that use them.
+type LocalKMS struct {
+	// KEKs are 32-byte AES keys, keyed by key-encryption key ID.
+	KEKs map[string][]byte
+}
+
+func (k *LocalKMS) Wrap(kekID string, key []byte) ([]byte, error) {
+	aead, err := k.aead(kekID)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, key, []byte(kekID)), nil
+}
+
+func (k *LocalKMS) Unwrap(kekID string, wrapped []byte) ([]byte, error) {
+	aead, err := k.aead(kekID)
+	if err != nil {
+		return nil, err
+	}
+	if len(wrapped) < aead.NonceSize() {
+		return nil, errors.New("wrapped key too short")
+	}
+	key, err := aead.Open(nil, wrapped[:aead.NonceSize()], wrapped[aead.NonceSize():], []byte(kekID))
+	if err != nil {
+		return nil, errors.New("wrapped key is not valid under this key-encryption key")
+	}
+	return key, nil
+}
+
+func (k *LocalKMS) aead(kekID string) (cipher.AEAD, error) {
+	kek, ok := k.KEKs[kekID]
+	if !ok {
+		return nil, fmt.Errorf("unknown key-encryption key %q", kekID)
+	}
+	return newAEAD(kek)
+}
@@ -0,0 +1,75 @@
+// Copyright 2014-2015 The Dename Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy of
+// the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations under
+// the License.
+
+// localkms serves a keyfile.LocalKMS on a Unix socket, as a stand-in for a key
+// management service. Point $CONAME_KEY_KMS_SOCKET at the socket to use it.
+package main
+
+import (
+	"bufio"
+	"encoding/hex"
+	"flag"
+	"log"
+	"net"
+	"os"
+	"os/signal"
+	"strings"
+
+	"github.com/yahoo/coname/keyfile"
+)
+
+func main() {
+	socketPath := flag.String("socket", "kms.sock", "path of the Unix socket to listen on")
+	keksPath := flag.String("keks", "keks", "file with one key-encryption key per line: an ID, a space and 32 hex-encoded bytes")
+	flag.Parse()
+
+	f, err := os.Open(*keksPath)
+	if err != nil {
+		log.Fatalf("Failed to open key-encryption keys: %s", err)
+	}
+	kms := &keyfile.LocalKMS{KEKs: make(map[string][]byte)}
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) != 2 {
+			log.Fatalf("Malformed line in %s: want an ID and a key", *keksPath)
+		}
+		kek, err := hex.DecodeString(fields[1])
+		if err != nil || len(kek) != 32 {
+			log.Fatalf("Key-encryption key %s is not 32 hex-encoded bytes", fields[0])
+		}
+		kms.KEKs[fields[0]] = kek
+	}
+	if err := scanner.Err(); err != nil {
+		log.Fatalf("Failed to read key-encryption keys: %s", err)
+	}
+	f.Close()
+
+	ln, err := net.Listen("unix", *socketPath)
+	if err != nil {
+		log.Fatalf("Failed to listen on %s: %s", *socketPath, err)
+	}
+	if err := os.Chmod(*socketPath, 0600); err != nil {
+		log.Fatalf("Failed to restrict access to %s: %s", *socketPath, err)
+	}
+	go keyfile.ServeKMS(ln, kms)
+
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, os.Interrupt)
+	<-ch
+	ln.Close()
+}
@@ -0,0 +1,126 @@
+// Copyright 2014-2015 The Dename Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy of
+// the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations under
+// the License.
+
+package keyserver
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/agl/ed25519"
+	"github.com/yahoo/coname"
+	"github.com/yahoo/coname/keyserver/kv"
+	"github.com/yahoo/coname/proto"
+	"golang.org/x/net/context"
+)
+
+// adminUpdates returns the user IDs of the AdminUpdate steps in the verifier
+// log of ks.
+func adminUpdates(t *testing.T, ks *Keyserver) (userIDs []string) {
+	iter := ks.db.NewIterator(kv.BytesPrefix([]byte{tableVerifierLogPrefix}))
+	defer iter.Release()
+	for iter.Next() {
+		step := new(proto.VerifierStep)
+		if err := step.Unmarshal(iter.Value()); err != nil {
+			t.Fatal(err)
+		}
+		if adminUpdate := step.GetAdminUpdate(); adminUpdate != nil {
+			userIDs = append(userIDs, adminUpdate.UserId)
+		}
+	}
+	if err := iter.Error(); err != nil {
+		t.Fatal(err)
+	}
+	return userIDs
+}
+
+func TestKeyserverDomainAdminUpdate(t *testing.T) {
+	dieOnCtrlC()
+	adminPK, adminSK, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pk := &proto.PublicKey{PubkeyType: &proto.PublicKey_Ed25519{Ed25519: adminPK[:]}}
+	adminID := proto.KeyID(pk)
+	adminPolicies := []*proto.DomainAdminPolicy{{
+		Domains: []string{realmDomain},
+		Policy: &proto.AuthorizationPolicy{
+			PublicKeys: map[uint64]*proto.PublicKey{adminID: pk},
+			PolicyType: &proto.AuthorizationPolicy_Quorum{Quorum: &proto.QuorumExpr{
+				Threshold:      1,
+				Candidates:     []uint64{adminID},
+				Subexpressions: []*proto.QuorumExpr{},
+			}},
+		},
+	}}
+	if coname.GetDomainAdminPolicy(adminPolicies, "mallory@example.com") != nil {
+		t.Fatalf("domain admin policy applies to another domain")
+	}
+	kss, _, _, _, clks, _, _, clientConfig, teardown := setupRealmWithConfig(t, 3, 1, func(cfg *proto.ReplicaConfig) {
+		cfg.DomainAdminPolicies = adminPolicies
+	})
+	defer teardown()
+	stop := stoppableSyncedClocks(clks)
+	defer close(stop)
+
+	quorum := clientConfig.Realms[0].VerificationPolicy.GetQuorum()
+	waitForFirstEpoch(kss[0], quorum)
+	ctx := context.Background()
+
+	req, _, _ := recoverableUpdate(t, kss[0], alice, 0, 0, quorum)
+	if _, err := kss[0].Update(ctx, req); err != nil {
+		t.Fatal(err)
+	}
+
+	// the admin cannot register users or set keys that do not accept the
+	// entry
+	bob := "bob@" + realmDomain
+	req, _, _ = recoverableUpdate(t, kss[0], bob, 0, 0, quorum)
+	req.Update.Signatures = map[uint64][]byte{adminID: ed25519.Sign(adminSK, req.Update.NewEntry.Encoding)[:]}
+	req.EmailProof = nil
+	if _, err := kss[0].verifyUpdateDeterministic(nil, nil, req, clks[0].Now()); err == nil {
+		t.Fatalf("registration authorized by the domain admin")
+	}
+	req, _, _ = recoverableUpdate(t, kss[0], alice, 1, 0, quorum)
+	req.Update.Signatures = map[uint64][]byte{adminID: ed25519.Sign(adminSK, req.Update.NewEntry.Encoding)[:]}
+	if _, err := kss[0].Update(ctx, req); err == nil {
+		t.Fatalf("admin update went through without a signature by the new key")
+	}
+
+	// the admin resets the keys of alice
+	req, _, _ = recoverableUpdate(t, kss[0], alice, 1, 0, quorum)
+	req.Update.Signatures[adminID] = ed25519.Sign(adminSK, req.Update.NewEntry.Encoding)[:]
+	proof, err := kss[0].Update(ctx, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := coname.VerifyLookup(clientConfig, alice, proof, clks[0].Now()); err != nil {
+		t.Fatal(err)
+	}
+	if got := adminUpdates(t, kss[0]); len(got) != 1 || got[0] != alice {
+		t.Fatalf("admin updates in the verifier log: %q, expected one for %q", got, alice)
+	}
+
+	// and deletes the entry
+	proof, err = kss[0].Update(ctx, tombstone(kss[0], alice, 2, quorum, adminSK, adminID))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := coname.VerifyLookup(clientConfig, alice, proof, clks[0].Now()); err != coname.ErrDeleted {
+		t.Fatalf("lookup of a deleted entry returned %v, expected %v", err, coname.ErrDeleted)
+	}
+	if got := adminUpdates(t, kss[0]); len(got) != 2 {
+		t.Fatalf("admin updates in the verifier log: %q, expected two", got)
+	}
+}
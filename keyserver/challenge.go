@@ -0,0 +1,145 @@
+// Copyright 2014-2015 The Dename Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy of
+// the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations under
+// the License.
+
+package keyserver
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base32"
+	"log"
+	"net/smtp"
+	"strings"
+	"time"
+
+	"github.com/yahoo/coname/keyserver/replication"
+	"github.com/yahoo/coname/proto"
+	"golang.org/x/crypto/sha3"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// challengeCodeBytes is the number of random bytes in an emailed code. The
+// code is sent base32-encoded, 16 characters.
+const challengeCodeBytes = 10
+
+func hashChallengeCode(code string) []byte {
+	ret := make([]byte, 32)
+	sha3.ShakeSum256(ret, []byte(strings.ToUpper(strings.TrimSpace(code))))
+	return ret
+}
+
+// RequestEmailChallenge implements proto.E2EKSPublicServer.RequestEmailChallenge
+func (ks *Keyserver) RequestEmailChallenge(ctx context.Context, req *proto.EmailChallengeRequest) (*proto.EmailChallengeResponse, error) {
+	ctx, _ = context.WithTimeout(ctx, ks.clientTimeout)
+	if err := ks.checkRateLimit(ctx, req.UserId); err != nil {
+		return nil, err
+	}
+	if _, err := ks.registrationPolicy("challenge_code", req.UserId); err != nil {
+		return nil, err
+	}
+	if len(req.EntryHash) != 32 {
+		return nil, grpc.Errorf(codes.InvalidArgument, "entry hash has wrong length %d (expected 32)", len(req.EntryHash))
+	}
+
+	var codeBytes [challengeCodeBytes]byte
+	if _, err := rand.Read(codeBytes[:]); err != nil {
+		log.Printf("rand.Read: %s", err)
+		return nil, errInternal
+	}
+	code := base32.StdEncoding.EncodeToString(codeBytes[:])
+	expiration := ks.clk.Now().Add(ks.challengeProofValidity)
+
+	// The code is only stored once it has been sent, so a failed send does not
+	// replace anything. It is accepted by any replica once this call returns.
+	msg := "From: " + ks.challengeProofFromAddr + "\r\n" +
+		"To: " + req.UserId + "\r\n" +
+		"Subject: " + ks.challengeProofSubject + "\r\n" +
+		"\r\n" +
+		"Your verification code is " + code + "\r\n" +
+		"It expires at " + expiration.UTC().Format(time.RFC1123) + ".\r\n"
+	if err := smtp.SendMail(ks.challengeProofSMTPRelay, nil, ks.challengeProofFromAddr, []string{req.UserId}, []byte(msg)); err != nil {
+		log.Printf("sending email challenge to %q: %s", req.UserId, err)
+		return nil, grpc.Errorf(codes.Unavailable, "failed to send email")
+	}
+
+	uid := genUID()
+	ch := ks.wr.Wait(uid)
+	ks.log.Propose(ctx, replication.LogEntry{Data: proto.MustMarshal(&proto.KeyserverStep{
+		UID: uid,
+		Type: &proto.KeyserverStep_EmailChallenge{EmailChallenge: &proto.EmailChallenge{
+			Index:      ks.vrfSuite.Compute([]byte(req.UserId), ks.currentVRFSecret()),
+			EntryHash:  req.EntryHash,
+			CodeHash:   hashChallengeCode(code),
+			Expiration: proto.Time(expiration),
+		}},
+	})})
+	select {
+	case <-ctx.Done():
+		ks.wr.Notify(uid, nil)
+		return nil, contextError(ctx.Err())
+	case v := <-ch:
+		if v != nil {
+			return nil, v.(error)
+		}
+	}
+	return &proto.EmailChallengeResponse{Expiration: proto.Time(expiration)}, nil
+}
+
+// getEmailChallenge returns the outstanding challenge for registering the entry
+// with hash entryHash at idx, or nil if there is none. Challenges are keyed by
+// the entry so that requesting a challenge for some other entry does not
+// replace the code sent to the user.
+func (ks *Keyserver) getEmailChallenge(idx, entryHash []byte) (*proto.EmailChallenge, error) {
+	switch challengeBytes, err := ks.db.Get(tableEmailChallenges(idx, entryHash)); err {
+	case ks.db.ErrNotFound():
+		return nil, nil
+	case nil:
+		ret := new(proto.EmailChallenge)
+		if err := ret.Unmarshal(challengeBytes); err != nil {
+			return nil, err
+		}
+		return ret, nil
+	default:
+		return nil, err
+	}
+}
+
+// verifyEmailChallengeDeterministic checks that req carries the code of the
+// outstanding challenge for its index and that the challenge was for the entry
+// being registered. Expiration is checked separately because it depends on the
+// local clock.
+func (ks *Keyserver) verifyEmailChallengeDeterministic(req *proto.UpdateRequest) (*proto.EmailChallenge, error) {
+	var entryHash [32]byte
+	sha3.ShakeSum256(entryHash[:], req.Update.NewEntry.Encoding)
+	return ks.verifyEmailChallenge(req.Update.NewEntry.Index, req.LookupParameters.UserId, entryHash[:], req.EmailProof.GetChallengeCode())
+}
+
+// verifyEmailChallenge checks that code is the code of the outstanding
+// challenge for registering the entry with the given hash at index.
+func (ks *Keyserver) verifyEmailChallenge(index []byte, userID string, entryHash []byte, code string) (*proto.EmailChallenge, error) {
+	challenge, err := ks.getEmailChallenge(index, entryHash)
+	if err != nil {
+		log.Print(err)
+		return nil, errInternal
+	}
+	if challenge == nil {
+		return nil, grpc.Errorf(codes.FailedPrecondition, "no outstanding email challenge for user %q and the requested entry", userID)
+	}
+	if subtle.ConstantTimeCompare(hashChallengeCode(code), challenge.CodeHash) != 1 {
+		return nil, grpc.Errorf(codes.PermissionDenied, "incorrect email challenge code")
+	}
+	return challenge, nil
+}
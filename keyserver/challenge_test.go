@@ -0,0 +1,264 @@
+// Copyright 2014-2015 The Dename Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy of
+// the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations under
+// the License.
+
+package keyserver
+
+import (
+	"crypto/rand"
+	"net"
+	"net/textproto"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/agl/ed25519"
+	"github.com/yahoo/coname"
+	"github.com/yahoo/coname/proto"
+	"golang.org/x/crypto/sha3"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+)
+
+// newRegistration returns a signed registration of a new key for name, without
+// an email proof, and the hash of the new entry.
+func newRegistration(t *testing.T, ks *Keyserver, name string, quorum *proto.QuorumExpr) (*proto.UpdateRequest, []byte) {
+	edpk, sk, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pk := &proto.PublicKey{PubkeyType: &proto.PublicKey_Ed25519{Ed25519: edpk[:]}}
+	keyid := proto.KeyID(pk)
+	profile := proto.EncodedProfile{Profile: proto.Profile{Nonce: []byte("noncenoncenonceNONCE")}}
+	profile.UpdateEncoding()
+	var commitment [64]byte
+	sha3.ShakeSum256(commitment[:], profile.Encoding)
+	entry := proto.EncodedEntry{Entry: proto.Entry{
+		Index: ks.vrfSuite.Compute([]byte(name), ks.currentVRFSecret()),
+		UpdatePolicy: &proto.AuthorizationPolicy{
+			PublicKeys: map[uint64]*proto.PublicKey{keyid: pk},
+			PolicyType: &proto.AuthorizationPolicy_Quorum{Quorum: &proto.QuorumExpr{
+				Threshold:      1,
+				Candidates:     []uint64{keyid},
+				Subexpressions: []*proto.QuorumExpr{},
+			}},
+		},
+		ProfileCommitment: commitment[:],
+	}}
+	entry.UpdateEncoding()
+	entryHash := make([]byte, 32)
+	sha3.ShakeSum256(entryHash, entry.Encoding)
+	return &proto.UpdateRequest{
+		Update: &proto.SignedEntryUpdate{
+			NewEntry:   entry,
+			Signatures: map[uint64][]byte{keyid: ed25519.Sign(sk, entry.Encoding)[:]},
+		},
+		Profile: profile,
+		LookupParameters: &proto.LookupRequest{
+			UserId:            name,
+			QuorumRequirement: quorum,
+		},
+	}, entryHash
+}
+
+type fakeSMTPMail struct {
+	From, To string
+	Data     string
+}
+
+// fakeSMTPServer accepts all mail sent to it over plain SMTP and delivers it
+// on the returned channel.
+func fakeSMTPServer(t *testing.T) (addr string, mails <-chan fakeSMTPMail, teardown func()) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ch := make(chan fakeSMTPMail, 10)
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go func(conn net.Conn) {
+				defer conn.Close()
+				tc := textproto.NewConn(conn)
+				var mail fakeSMTPMail
+				tc.PrintfLine("220 localhost fake SMTP")
+				for {
+					line, err := tc.ReadLine()
+					if err != nil {
+						return
+					}
+					switch verb := strings.ToUpper(strings.SplitN(line, " ", 2)[0]); verb {
+					case "EHLO", "HELO":
+						tc.PrintfLine("250 localhost")
+					case "MAIL":
+						mail.From = line[len("MAIL FROM:"):]
+						tc.PrintfLine("250 OK")
+					case "RCPT":
+						mail.To = line[len("RCPT TO:"):]
+						tc.PrintfLine("250 OK")
+					case "DATA":
+						tc.PrintfLine("354 go ahead")
+						data, err := tc.ReadDotBytes()
+						if err != nil {
+							return
+						}
+						mail.Data = string(data)
+						ch <- mail
+						tc.PrintfLine("250 OK")
+					case "QUIT":
+						tc.PrintfLine("221 bye")
+						return
+					default:
+						tc.PrintfLine("502 %s not implemented", verb)
+					}
+				}
+			}(conn)
+		}
+	}()
+	return ln.Addr().String(), ch, func() { ln.Close() }
+}
+
+func TestKeyserverEmailChallengeRegistration(t *testing.T) {
+	dieOnCtrlC()
+	smtpAddr, mails, smtpTeardown := fakeSMTPServer(t)
+	defer smtpTeardown()
+	kss, _, _, _, clks, _, ck, clientConfig, teardown := setupRealmWithConfig(t, 3, 0, func(cfg *proto.ReplicaConfig) {
+		cfg.RegistrationPolicy = append(cfg.RegistrationPolicy, &proto.RegistrationPolicy{
+			PolicyType: &proto.RegistrationPolicy_EmailProofByChallenge{EmailProofByChallenge: &proto.EmailProofByChallenge{
+				AllowedDomains: []string{realmDomain},
+				SMTPRelay:      smtpAddr,
+				FromAddr:       "keyserver@" + realmDomain,
+				Subject:        "Your verification code",
+				// the mock clocks run much faster than real time
+				Validity: proto.DurationStamp(100 * 365 * 24 * time.Hour),
+			}},
+		})
+	})
+	defer teardown()
+	stop := stoppableSyncedClocks(clks)
+	defer close(stop)
+
+	waitForFirstEpoch(kss[0], clientConfig.Realms[0].VerificationPolicy.GetQuorum())
+
+	clientTLS, err := clientConfig.Realms[0].ClientTLS.Config(ck)
+	if err != nil {
+		t.Fatal(err)
+	}
+	conn, err := grpc.Dial(kss[0].publicListen.Addr().String(), grpc.WithTransportCredentials(credentials.NewTLS(clientTLS)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	c := proto.NewE2EKSPublicClient(conn)
+
+	req, entryHash := newRegistration(t, kss[0], alice, clientConfig.Realms[0].VerificationPolicy.GetQuorum())
+
+	if _, err := c.RequestEmailChallenge(context.Background(), &proto.EmailChallengeRequest{
+		UserId:    "alice@looking.glass",
+		EntryHash: entryHash,
+	}); err == nil {
+		t.Fatalf("challenge sent to a domain that is not in the whitelist")
+	}
+	if _, err := c.RequestEmailChallenge(context.Background(), &proto.EmailChallengeRequest{
+		UserId:    alice,
+		EntryHash: entryHash,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	var mail fakeSMTPMail
+	select {
+	case mail = <-mails:
+	case <-time.After(10 * time.Second):
+		t.Fatalf("no challenge email was sent")
+	}
+	if got, want := mail.To, "<"+alice+">"; got != want {
+		t.Errorf("challenge sent to %s, expected %s", got, want)
+	}
+	m := regexp.MustCompile(`verification code is ([A-Z2-7]+)`).FindStringSubmatch(mail.Data)
+	if m == nil {
+		t.Fatalf("no code in challenge email: %q", mail.Data)
+	}
+	code := m[1]
+
+	// a challenge for someone else's entry does not replace alice's
+	_, otherEntryHash := newRegistration(t, kss[0], alice, clientConfig.Realms[0].VerificationPolicy.GetQuorum())
+	if _, err := c.RequestEmailChallenge(context.Background(), &proto.EmailChallengeRequest{
+		UserId:    alice,
+		EntryHash: otherEntryHash,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	<-mails
+
+	update := func(code string) (*proto.LookupProof, error) {
+		req.EmailProof = &proto.EmailProof{ProofType: &proto.EmailProof_ChallengeCode{ChallengeCode: code}}
+		return c.Update(context.Background(), req)
+	}
+	if _, err := update(strings.Repeat("A", len(code))); err == nil {
+		t.Fatalf("registration went through with an incorrect challenge code")
+	}
+	now := clks[0].Now()
+	proof, err := update(code)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := coname.VerifyLookup(clientConfig, alice, proof, now); err != nil {
+		t.Fatal(err)
+	}
+	// the code is used up
+	if _, err := kss[0].db.Get(tableEmailChallenges(req.Update.NewEntry.Index, entryHash)); err != kss[0].db.ErrNotFound() {
+		t.Errorf("email challenge was not deleted after registration (err=%v)", err)
+	}
+}
+
+func TestKeyserverEmailChallengeNotStoredIfNotSent(t *testing.T) {
+	dieOnCtrlC()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	smtpAddr := ln.Addr().String()
+	ln.Close() // nothing is listening there anymore
+	kss, _, _, _, clks, _, _, clientConfig, teardown := setupRealmWithConfig(t, 1, 0, func(cfg *proto.ReplicaConfig) {
+		cfg.RegistrationPolicy = append(cfg.RegistrationPolicy, &proto.RegistrationPolicy{
+			PolicyType: &proto.RegistrationPolicy_EmailProofByChallenge{EmailProofByChallenge: &proto.EmailProofByChallenge{
+				AllowedDomains: []string{realmDomain},
+				SMTPRelay:      smtpAddr,
+				FromAddr:       "keyserver@" + realmDomain,
+				Subject:        "Your verification code",
+			}},
+		})
+	})
+	defer teardown()
+	stop := stoppableSyncedClocks(clks)
+	defer close(stop)
+
+	waitForFirstEpoch(kss[0], clientConfig.Realms[0].VerificationPolicy.GetQuorum())
+
+	req, entryHash := newRegistration(t, kss[0], alice, clientConfig.Realms[0].VerificationPolicy.GetQuorum())
+	if _, err := kss[0].RequestEmailChallenge(context.Background(), &proto.EmailChallengeRequest{
+		UserId:    alice,
+		EntryHash: entryHash,
+	}); grpc.Code(err) != codes.Unavailable {
+		t.Fatalf("challenge request without a working SMTP relay: got %v, expected Unavailable", err)
+	}
+	if _, err := kss[0].db.Get(tableEmailChallenges(req.Update.NewEntry.Index, entryHash)); err != kss[0].db.ErrNotFound() {
+		t.Errorf("email challenge was stored although it was not sent (err=%v)", err)
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright 2014-2015 The Dename Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy of
+// the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations under
+// the License.
+
+package keyserver
+
+import (
+	"crypto/x509"
+	"fmt"
+
+	"github.com/yahoo/coname/proto"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+// verifyClientCertProof checks that the holder of a certificate issued by
+// clientCertProofRoots to userID requested the registration of the entry with
+// the given hash. If the proof is not signed, the certificates presented by
+// the client in the TLS handshake of the connection that ctx belongs to are
+// used; TLS has already shown that the client holds their key.
+func (ks *Keyserver) verifyClientCertProof(ctx context.Context, userID string, entryHash []byte, emailProof *proto.EmailProof) error {
+	proof := emailProof.GetClientCert()
+	var certs []*x509.Certificate
+	if len(proof.Signature) == 0 {
+		if pr, ok := peer.FromContext(ctx); ok {
+			if tlsInfo, ok := pr.AuthInfo.(credentials.TLSInfo); ok {
+				certs = tlsInfo.State.PeerCertificates
+			}
+		}
+		if len(certs) == 0 {
+			return fmt.Errorf("no signature in client certificate proof and no client certificate presented over TLS")
+		}
+	} else {
+		for _, der := range proof.Certificates {
+			cert, err := x509.ParseCertificate(der)
+			if err != nil {
+				return fmt.Errorf("failed to parse certificate in client certificate proof: %s", err)
+			}
+			certs = append(certs, cert)
+		}
+		if len(certs) == 0 {
+			return fmt.Errorf("no certificates in client certificate proof")
+		}
+		var alg x509.SignatureAlgorithm
+		switch certs[0].PublicKeyAlgorithm {
+		case x509.RSA:
+			alg = x509.SHA256WithRSA
+		case x509.ECDSA:
+			alg = x509.ECDSAWithSHA256
+		default:
+			return fmt.Errorf("unsupported client certificate key type: %v", certs[0].PublicKeyAlgorithm)
+		}
+		if err := certs[0].CheckSignature(alg, append([]byte(proto.ClientCertProofContext), entryHash...), proof.Signature); err != nil {
+			return fmt.Errorf("invalid signature in client certificate proof: %s", err)
+		}
+	}
+
+	intermediates := x509.NewCertPool()
+	for _, cert := range certs[1:] {
+		intermediates.AddCert(cert)
+	}
+	if _, err := certs[0].Verify(x509.VerifyOptions{
+		Roots:         ks.clientCertProofRoots,
+		Intermediates: intermediates,
+		CurrentTime:   ks.clk.Now(),
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageEmailProtection, x509.ExtKeyUsageClientAuth},
+	}); err != nil {
+		if invalid, ok := err.(x509.CertificateInvalidError); ok && invalid.Reason == x509.Expired {
+			return ProofExpired(err)
+		}
+		return fmt.Errorf("failed to verify client certificate: %s", err)
+	}
+	for _, email := range certs[0].EmailAddresses {
+		if email == userID {
+			return nil
+		}
+	}
+	return fmt.Errorf("requested user ID %q is not in the client certificate (has %q)", userID, certs[0].EmailAddresses)
+}
@@ -0,0 +1,181 @@
+// Copyright 2014-2015 The Dename Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy of
+// the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations under
+// the License.
+
+package keyserver
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/yahoo/coname"
+	"github.com/yahoo/coname/proto"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// newEmailCert returns a certificate for email signed by parent (self-signed
+// if parent is nil). It is valid from the start of the mock clocks until long
+// after the real current time.
+func newEmailCert(t *testing.T, email string, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey) {
+	return newCertWithUsage(t, email, parent, parentKey, x509.ExtKeyUsageClientAuth, x509.ExtKeyUsageEmailProtection)
+}
+
+// newCertWithUsage is like newEmailCert, but the certificate is only valid
+// for extKeyUsage.
+func newCertWithUsage(t *testing.T, email string, parent *x509.Certificate, parentKey *ecdsa.PrivateKey, extKeyUsage ...x509.ExtKeyUsage) (*x509.Certificate, *ecdsa.PrivateKey) {
+	sk, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	serial, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: email},
+		NotBefore:    time.Unix(0, 0),
+		NotAfter:     time.Now().Add(100 * 365 * 24 * time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  extKeyUsage,
+	}
+	if parent == nil {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+		template.KeyUsage |= x509.KeyUsageCertSign
+		parent, parentKey = template, sk
+	} else {
+		template.EmailAddresses = []string{email}
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &sk.PublicKey, parentKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert, sk
+}
+
+func signClientCertProof(t *testing.T, cert *x509.Certificate, sk *ecdsa.PrivateKey, req *proto.UpdateRequest) *proto.EmailProof {
+	h := sha256.Sum256(proto.ClientCertProofMessage(req.Update.NewEntry.Encoding))
+	sig, err := sk.Sign(rand.Reader, h[:], crypto.SHA256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &proto.EmailProof{ProofType: &proto.EmailProof_ClientCert{ClientCert: &proto.ClientCertProof{
+		Certificates: [][]byte{cert.Raw},
+		Signature:    sig,
+	}}}
+}
+
+func TestKeyserverClientCertRegistration(t *testing.T) {
+	dieOnCtrlC()
+	emailCA, emailCAKey := newEmailCert(t, "email CA", nil, nil)
+	otherCA, otherCAKey := newEmailCert(t, "other CA", nil, nil)
+	kss, _, _, _, clks, _, ck, clientConfig, teardown := setupRealmWithConfig(t, 3, 0, func(cfg *proto.ReplicaConfig) {
+		cfg.RegistrationPolicy = append(cfg.RegistrationPolicy, &proto.RegistrationPolicy{
+			PolicyType: &proto.RegistrationPolicy_EmailProofByClientCert{EmailProofByClientCert: &proto.EmailProofByClientCert{
+				AllowedDomains: []string{realmDomain},
+				CaCert:         emailCA.Raw,
+			}},
+		})
+		cfg.PublicTLS.ClientCAs = append(cfg.PublicTLS.ClientCAs, emailCA.Raw)
+	})
+	defer teardown()
+	stop := stoppableSyncedClocks(clks)
+	defer close(stop)
+
+	waitForFirstEpoch(kss[0], clientConfig.Realms[0].VerificationPolicy.GetQuorum())
+
+	clientTLS, err := clientConfig.Realms[0].ClientTLS.Config(ck)
+	if err != nil {
+		t.Fatal(err)
+	}
+	conn, err := grpc.Dial(kss[0].publicListen.Addr().String(), grpc.WithTransportCredentials(credentials.NewTLS(clientTLS)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	c := proto.NewE2EKSPublicClient(conn)
+	quorum := clientConfig.Realms[0].VerificationPolicy.GetQuorum()
+
+	// signed proofs
+	aliceCert, aliceKey := newEmailCert(t, alice, emailCA, emailCAKey)
+	req, _ := newRegistration(t, kss[0], alice, quorum)
+	wrongUserCert, wrongUserKey := newEmailCert(t, "bob@"+realmDomain, emailCA, emailCAKey)
+	req.EmailProof = signClientCertProof(t, wrongUserCert, wrongUserKey, req)
+	if _, err := c.Update(context.Background(), req); err == nil {
+		t.Fatalf("registration went through with a certificate for another user")
+	}
+	untrustedCert, untrustedKey := newEmailCert(t, alice, otherCA, otherCAKey)
+	req.EmailProof = signClientCertProof(t, untrustedCert, untrustedKey, req)
+	if _, err := c.Update(context.Background(), req); err == nil {
+		t.Fatalf("registration went through with a certificate from an untrusted CA")
+	}
+	serverCert, serverKey := newCertWithUsage(t, alice, emailCA, emailCAKey, x509.ExtKeyUsageServerAuth)
+	req.EmailProof = signClientCertProof(t, serverCert, serverKey, req)
+	if _, err := c.Update(context.Background(), req); err == nil {
+		t.Fatalf("registration went through with a certificate not for email or client authentication")
+	}
+	otherReq, _ := newRegistration(t, kss[0], alice, quorum)
+	req.EmailProof = signClientCertProof(t, aliceCert, aliceKey, otherReq)
+	if _, err := c.Update(context.Background(), req); err == nil {
+		t.Fatalf("registration went through with a proof for another entry")
+	}
+	req.EmailProof = signClientCertProof(t, aliceCert, aliceKey, req)
+	now := clks[0].Now()
+	proof, err := c.Update(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := coname.VerifyLookup(clientConfig, alice, proof, now); err != nil {
+		t.Fatal(err)
+	}
+
+	// proofs by the certificate presented over TLS
+	const carol = "carol@" + realmDomain
+	req, _ = newRegistration(t, kss[0], carol, quorum)
+	req.EmailProof = &proto.EmailProof{ProofType: &proto.EmailProof_ClientCert{ClientCert: &proto.ClientCertProof{}}}
+	if _, err := c.Update(context.Background(), req); err == nil {
+		t.Fatalf("registration went through with a TLS client certificate for another user")
+	}
+	carolCert, carolKey := newEmailCert(t, carol, emailCA, emailCAKey)
+	carolTLS := clientTLS.Clone()
+	carolTLS.Certificates = []tls.Certificate{{Certificate: [][]byte{carolCert.Raw}, PrivateKey: carolKey}}
+	carolConn, err := grpc.Dial(kss[0].publicListen.Addr().String(), grpc.WithTransportCredentials(credentials.NewTLS(carolTLS)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer carolConn.Close()
+	now = clks[0].Now()
+	proof, err = proto.NewE2EKSPublicClient(carolConn).Update(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := coname.VerifyLookup(clientConfig, carol, proof, now); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,135 @@
+// Copyright 2014-2015 The Dename Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy of
+// the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations under
+// the License.
+
+package keyserver
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/agl/ed25519"
+	"github.com/yahoo/coname"
+	"github.com/yahoo/coname/proto"
+	"golang.org/x/net/context"
+)
+
+// tombstone returns a deletion of the entry of name, signed by sk.
+func tombstone(ks *Keyserver, name string, version uint64, quorum *proto.QuorumExpr, sk *[ed25519.PrivateKeySize]byte, keyid uint64) *proto.UpdateRequest {
+	entry := proto.EncodedEntry{Entry: proto.Entry{
+		Index:   ks.vrfSuite.Compute([]byte(name), ks.currentVRFSecret()),
+		Version: version,
+		Deleted: true,
+	}}
+	entry.UpdateEncoding()
+	return &proto.UpdateRequest{
+		Update: &proto.SignedEntryUpdate{
+			NewEntry:   entry,
+			Signatures: map[uint64][]byte{keyid: ed25519.Sign(sk, entry.Encoding)[:]},
+		},
+		LookupParameters: &proto.LookupRequest{
+			UserId:            name,
+			QuorumRequirement: quorum,
+		},
+	}
+}
+
+func TestKeyserverDeletion(t *testing.T) {
+	dieOnCtrlC()
+	adminPK, adminSK, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pk := &proto.PublicKey{PubkeyType: &proto.PublicKey_Ed25519{Ed25519: adminPK[:]}}
+	adminID := proto.KeyID(pk)
+	deletionPolicy := &proto.AuthorizationPolicy{
+		PublicKeys: map[uint64]*proto.PublicKey{adminID: pk},
+		PolicyType: &proto.AuthorizationPolicy_Quorum{Quorum: &proto.QuorumExpr{
+			Threshold:      1,
+			Candidates:     []uint64{adminID},
+			Subexpressions: []*proto.QuorumExpr{},
+		}},
+	}
+	kss, _, _, _, clks, _, _, clientConfig, teardown := setupRealmWithConfig(t, 3, 1, func(cfg *proto.ReplicaConfig) {
+		cfg.DeletionPolicy = deletionPolicy
+	})
+	defer teardown()
+	stop := stoppableSyncedClocks(clks)
+	defer close(stop)
+
+	quorum := clientConfig.Realms[0].VerificationPolicy.GetQuorum()
+	waitForFirstEpoch(kss[0], quorum)
+	ctx := context.Background()
+
+	bob := "bob@" + realmDomain
+	registration, aliceSK, aliceID := recoverableUpdate(t, kss[0], alice, 0, 0, quorum)
+	if _, err := kss[0].Update(ctx, registration); err != nil {
+		t.Fatal(err)
+	}
+	req, _, _ := recoverableUpdate(t, kss[0], bob, 0, 0, quorum)
+	if _, err := kss[0].Update(ctx, req); err != nil {
+		t.Fatal(err)
+	}
+
+	_, otherSK, otherID := recoverableUpdate(t, kss[0], alice, 0, 0, quorum)
+	if _, err := kss[0].Update(ctx, tombstone(kss[0], alice, 1, quorum, otherSK, otherID)); err == nil {
+		t.Fatalf("deletion went through without authorization")
+	}
+	if _, err := kss[0].Update(ctx, tombstone(kss[0], alice, 0, quorum, aliceSK, aliceID)); err == nil {
+		t.Fatalf("deletion went through without increasing the version")
+	}
+
+	// the owner deletes the entry
+	proof, err := kss[0].Update(ctx, tombstone(kss[0], alice, 1, quorum, aliceSK, aliceID))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := coname.VerifyLookup(clientConfig, alice, proof, clks[0].Now()); err != coname.ErrDeleted {
+		t.Fatalf("lookup of a deleted entry returned %v, expected %v", err, coname.ErrDeleted)
+	}
+	if _, err := kss[0].Update(ctx, tombstone(kss[0], alice, 2, quorum, aliceSK, aliceID)); err == nil {
+		t.Fatalf("deleted entry deleted again")
+	}
+
+	// an administrator deletes the entry
+	proof, err = kss[0].Update(ctx, tombstone(kss[0], bob, 1, quorum, adminSK, adminID))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := coname.VerifyLookup(clientConfig, bob, proof, clks[0].Now()); err != coname.ErrDeleted {
+		t.Fatalf("lookup of a deleted entry returned %v, expected %v", err, coname.ErrDeleted)
+	}
+
+	// a deleted user ID is not treated like one that was never registered
+	carol := "carol@" + realmDomain
+	proof, err = kss[0].Lookup(ctx, &proto.LookupRequest{UserId: carol, QuorumRequirement: quorum})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if keys, err := coname.VerifyLookup(clientConfig, carol, proof, clks[0].Now()); keys != nil || err != nil {
+		t.Fatalf("lookup of an unregistered user returned %v, %v", keys, err)
+	}
+
+	// registering again must not replay the registration before the deletion
+	if _, err := kss[0].Update(ctx, registration); err == nil {
+		t.Fatalf("registration replayed after deletion")
+	}
+	req, _, _ = recoverableUpdate(t, kss[0], alice, 2, 0, quorum)
+	proof, err = kss[0].Update(ctx, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := coname.VerifyLookup(clientConfig, alice, proof, clks[0].Now()); err != nil {
+		t.Fatal(err)
+	}
+}
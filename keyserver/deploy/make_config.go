@@ -37,6 +37,7 @@ import (
 
 	"github.com/agl/ed25519"
 	"github.com/maditya/protobuf/jsonpb"
+	"github.com/yahoo/coname/keyfile"
 	"github.com/yahoo/coname/proto"
 	"github.com/yahoo/coname/vrf"
 )
@@ -50,6 +51,9 @@ const (
 	realm           = "yahoo"
 	dkimProofPrefix = "_YAHOO_E2E_KEYSERVER_PROOF_"
 	dkimProofDomain = "yahoo-inc.com"
+	// vrfSuite is the VRF of the realm, VRF_ECVRF_EDWARDS25519_SHA512_ELL2
+	// for clients that verify index proofs with an RFC 9381 library
+	vrfSuite = proto.VRF_CONAME
 )
 
 func newSerial(rnd io.Reader) (*big.Int, error) {
@@ -125,7 +129,7 @@ func main() {
 
 	hosts := os.Args[3:]
 
-	vrfPublic, vrfSecret, err := vrf.GenerateKey(rand.Reader)
+	vrfPublic, vrfSecret, err := vrf.Suite(vrfSuite).GenerateKey(rand.Reader)
 	if err != nil {
 		log.Panic(err)
 	}
@@ -157,6 +161,7 @@ func main() {
 		Realm:    realm,
 		ServerID: binary.LittleEndian.Uint64(serverID[:]),
 		VRFKeyID: "vrf.vrfsecret",
+		VRFSuite: vrfSuite,
 
 		MinEpochInterval:      proto.DurationStamp(1 * time.Second),
 		MaxEpochInterval:      proto.DurationStamp(1 * time.Minute),
@@ -221,30 +226,25 @@ func main() {
 		if _, err := os.Stat(host + "/"); os.IsNotExist(err) {
 			os.Mkdir(host, 0700)
 		}
-		tlsKeyF, err := os.OpenFile(path.Join(host, "tls.key.pem"), os.O_WRONLY|os.O_CREATE, 0600)
-		defer tlsKeyF.Close()
-		if err != nil {
-			log.Panic(err)
-		}
 		pkDer, err := x509.MarshalECPrivateKey(cert.PrivateKey.(*ecdsa.PrivateKey))
 		if err != nil {
 			log.Panic(err)
 		}
-		err = pem.Encode(tlsKeyF, &pem.Block{
+		err = keyfile.WriteFile(path.Join(host, "tls.key.pem"), pem.EncodeToMemory(&pem.Block{
 			Type:    "EC PRIVATE KEY",
 			Headers: make(map[string]string),
 			Bytes:   pkDer,
-		})
+		}))
 		if err != nil {
 			log.Panic(err)
 		}
 
-		err = ioutil.WriteFile(path.Join(host, "vrf.vrfsecret"), vrfSecret[:], 0600)
+		err = keyfile.WriteFile(path.Join(host, "vrf.vrfsecret"), vrfSecret[:])
 		if err != nil {
 			log.Panic(err)
 		}
 
-		err = ioutil.WriteFile(path.Join(host, "signing.ed25519secret"), sk[:], 0600)
+		err = keyfile.WriteFile(path.Join(host, "signing.ed25519secret"), sk[:])
 		if err != nil {
 			log.Panic(err)
 		}
@@ -297,6 +297,7 @@ func main() {
 				Domains:            []string{"yahoo-inc.com"},
 				Addr:               cfgs[0].PublicAddr,
 				VRFPublic:          vrfPublic[:],
+				VRFSuite:           vrfSuite,
 				VerificationPolicy: verificationPolicy,
 				EpochTimeToLive:    proto.DurationStamp(3 * time.Minute),
 				TreeNonce:          nil,
@@ -318,20 +319,15 @@ func main() {
 		log.Panic(err)
 	}
 
-	clientKeyF, err := os.OpenFile("client.key.pem", os.O_WRONLY|os.O_CREATE, 0644)
-	defer clientKeyF.Close()
-	if err != nil {
-		log.Panic(err)
-	}
 	skDer, err := x509.MarshalECPrivateKey(clientCert.PrivateKey.(*ecdsa.PrivateKey))
 	if err != nil {
 		log.Panic(err)
 	}
-	err = pem.Encode(clientKeyF, &pem.Block{
+	err = keyfile.WriteFile("client.key.pem", pem.EncodeToMemory(&pem.Block{
 		Type:    "EC PRIVATE KEY",
 		Headers: make(map[string]string),
 		Bytes:   skDer,
-	})
+	}))
 	if err != nil {
 		log.Panic(err)
 	}
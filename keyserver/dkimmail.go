@@ -0,0 +1,211 @@
+// Copyright 2014-2015 The Dename Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy of
+// the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations under
+// the License.
+
+package keyserver
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"log"
+	"math"
+	"time"
+
+	"github.com/yahoo/coname/keyserver/dkim"
+	"github.com/yahoo/coname/keyserver/kv"
+	"github.com/yahoo/coname/keyserver/replication"
+	"github.com/yahoo/coname/proto"
+	"github.com/yahoo/coname/vrf"
+	"golang.org/x/crypto/sha3"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	// defaultPendingUpdateValidity is used if
+	// EmailProofByDKIM.PendingUpdateValidity is not set.
+	defaultPendingUpdateValidity = 24 * time.Hour
+	// maxPendingUpdatesPerIndex is the number of registrations for the same
+	// user ID that may wait for an emailed proof at the same time. Anyone can
+	// submit them, so they are limited to keep the replicated state small.
+	maxPendingUpdatesPerIndex = 4
+)
+
+// SubmitPendingUpdate stores a registration that will be completed when the
+// user emails a DKIM-signed proof for it to the keyserver's SMTP interface. The
+// email proof of req is ignored.
+func (ks *Keyserver) SubmitPendingUpdate(ctx context.Context, req *proto.UpdateRequest) error {
+	ctx, _ = context.WithTimeout(ctx, ks.clientTimeout)
+	if ks.smtpFront == nil {
+		return grpc.Errorf(codes.Unimplemented, "this keyserver does not accept email proofs over SMTP")
+	}
+	if req.Update == nil || req.LookupParameters == nil {
+		return grpc.Errorf(codes.InvalidArgument, "incomplete update request")
+	}
+	if err := ks.checkRateLimit(ctx, req.LookupParameters.UserId); err != nil {
+		return err
+	}
+	if _, err := ks.registrationPolicy("dkim_proof", req.LookupParameters.UserId); err != nil {
+		return err
+	}
+	if len(req.Update.NewEntry.Index) != vrf.Size {
+		return grpc.Errorf(codes.InvalidArgument, "index '%x' has wrong length (expected %d)", req.Update.NewEntry.Index, vrf.Size)
+	}
+	prevUpdate, err := ks.getUpdate(req.Update.NewEntry.Index, math.MaxUint64)
+	if err != nil {
+		log.Print(err)
+		return errInternal
+	}
+	if registered(prevUpdate) {
+		return grpc.Errorf(codes.AlreadyExists, "user %q is already registered", req.LookupParameters.UserId)
+	}
+	if _, err := ks.verifyUpdateDeterministic(prevUpdate, nil, req, time.Time{}); err != nil {
+		return err
+	}
+	pending := *req
+	pending.EmailProof = nil
+
+	uid := genUID()
+	ch := ks.wr.Wait(uid)
+	ks.log.Propose(ctx, replication.LogEntry{Data: proto.MustMarshal(&proto.KeyserverStep{
+		UID:  uid,
+		Type: &proto.KeyserverStep_PendingUpdate{PendingUpdate: &pending},
+	})})
+	select {
+	case <-ctx.Done():
+		ks.wr.Notify(uid, nil)
+		return contextError(ctx.Err())
+	case v := <-ch:
+		if v != nil {
+			return v.(error)
+		}
+		return nil
+	}
+}
+
+// getPendingUpdate returns the registration of the entry with hash entryHash
+// at idx that is waiting for an emailed proof, or nil if there is none or it
+// has expired. Pending registrations are keyed by the entry so that
+// registrations for the same user ID do not replace each other: only the user
+// can tell which one is theirs, by emailing a proof for it.
+func (ks *Keyserver) getPendingUpdate(idx, entryHash []byte) (*proto.UpdateRequest, error) {
+	switch pendingBytes, err := ks.db.Get(tablePendingUpdates(idx, entryHash)); err {
+	case ks.db.ErrNotFound():
+		return nil, nil
+	case nil:
+		pending := new(proto.PendingUpdate)
+		if err := pending.Unmarshal(pendingBytes); err != nil {
+			return nil, err
+		}
+		if !ks.clk.Now().Before(pending.Expiration.Time()) {
+			return nil, nil // waiting to be deleted by the next epoch
+		}
+		return pending.Update, nil
+	default:
+		return nil, err
+	}
+}
+
+// pendingUpdateKeys returns the db keys of all registrations for idx that are
+// waiting for an emailed proof, and those of their expirations.
+func (ks *Keyserver) pendingUpdateKeys(idx []byte) ([][]byte, error) {
+	iter := ks.db.NewIterator(kv.BytesPrefix(tablePendingUpdates(idx, nil)[:1+vrf.Size]))
+	defer iter.Release()
+	var keys [][]byte
+	for iter.Next() {
+		pending := new(proto.PendingUpdate)
+		if err := pending.Unmarshal(iter.Value()); err != nil {
+			return nil, err
+		}
+		key := append([]byte(nil), iter.Key()...)
+		keys = append(keys, key, tablePendingUpdateExpirations(pending.Expiration.Time(), idx, key[1+vrf.Size:]))
+	}
+	return keys, iter.Error()
+}
+
+// storePendingUpdate stores req until it expires or the user ID is registered,
+// unless there are too many other pending registrations for the same user ID.
+// called from step: no io
+func (ks *Keyserver) storePendingUpdate(req *proto.UpdateRequest, rs *proto.ReplicaState, wb kv.Batch) error {
+	index := req.Update.NewEntry.Index
+	var entryHash [32]byte
+	sha3.ShakeSum256(entryHash[:], req.Update.NewEntry.Encoding)
+	keys, err := ks.pendingUpdateKeys(index)
+	if err != nil {
+		log.Printf("pendingUpdateKeys: %s", err)
+		return errInternal
+	}
+	key := tablePendingUpdates(index, entryHash[:])
+	for i := 0; i < len(keys); i += 2 {
+		if bytes.Equal(keys[i], key) {
+			// resubmitted: the registration gets a new expiration
+			wb.Delete(keys[i+1])
+			keys = append(keys[:i], keys[i+2:]...)
+			break
+		}
+	}
+	if len(keys)/2 >= maxPendingUpdatesPerIndex {
+		return grpc.Errorf(codes.ResourceExhausted, "too many registrations of %q are waiting for an email proof", req.LookupParameters.UserId)
+	}
+	expiration := lastEpochTime(rs).Add(ks.dkimPendingUpdateValidity)
+	wb.Put(key, proto.MustMarshal(&proto.PendingUpdate{Update: req, Expiration: proto.Time(expiration)}))
+	wb.Put(tablePendingUpdateExpirations(expiration, index, entryHash[:]), nil)
+	return nil
+}
+
+// expirePendingUpdates deletes the registrations waiting for an emailed proof
+// that have expired at now, the time of a new epoch or refresh.
+// called from step: no io
+func (ks *Keyserver) expirePendingUpdates(now time.Time, wb kv.Batch) {
+	iter := ks.db.NewIterator(&kv.Range{
+		Start: []byte{tablePendingUpdateExpirationsPrefix},
+		Limit: tablePendingUpdateExpirations(now.Add(time.Nanosecond), nil, nil)[:1+8],
+	})
+	defer iter.Release()
+	for iter.Next() {
+		key := append([]byte(nil), iter.Key()...)
+		wb.Delete(key)
+		wb.Delete(tablePendingUpdates(key[1+8:1+8+vrf.Size], key[1+8+vrf.Size:]))
+	}
+	if err := iter.Error(); err != nil {
+		log.Panicf("scanning tablePendingUpdateExpirations: %s", err)
+	}
+}
+
+// deliverDKIMMail is called by the SMTP interface for each email sent to
+// dkimProofToAddr. It completes the pending registration of the sender using
+// the email as the proof.
+func (ks *Keyserver) deliverDKIMMail(mail []byte) error {
+	email, payload, err := dkim.CheckEmailProof(mail, ks.dkimProofToAddr, ks.dkimProofSubjectPrefix, ks.lookupTXT, ks.clk.Now)
+	if err != nil {
+		return fmt.Errorf("failed to verify DKIM proof: %s", err)
+	}
+	entryHash, err := base64.StdEncoding.DecodeString(payload)
+	if err != nil || len(entryHash) != 32 {
+		return fmt.Errorf("bad entry hash in email proof: %q", payload)
+	}
+	pending, err := ks.getPendingUpdate(ks.vrfSuite.Compute([]byte(email), ks.currentVRFSecret()), entryHash)
+	if err != nil {
+		log.Print(err)
+		return errInternal
+	}
+	if pending == nil {
+		return fmt.Errorf("no pending registration for %q with entry hash %s", email, payload)
+	}
+	// Update checks that the proof is for the pending entry
+	pending.EmailProof = &proto.EmailProof{ProofType: &proto.EmailProof_DKIMProof{DKIMProof: mail}}
+	_, err = ks.Update(context.Background(), pending)
+	return err
+}
@@ -0,0 +1,188 @@
+// Copyright 2014-2015 The Dename Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy of
+// the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations under
+// the License.
+
+package keyserver
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/base64"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"net/smtp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/maditya/protobuf/jsonpb"
+	"github.com/yahoo/coname/proto"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+func TestKeyserverPendingUpdateByEmail(t *testing.T) {
+	dieOnCtrlC()
+	const toAddr = "proofs@" + realmDomain
+	const subjectPrefix = "_KEYSERVER_PROOF_"
+	kss, _, _, _, clks, _, _, clientConfig, teardown := setupRealmWithConfig(t, 3, 0, func(cfg *proto.ReplicaConfig) {
+		cfg.RegistrationPolicy = append(cfg.RegistrationPolicy, &proto.RegistrationPolicy{
+			PolicyType: &proto.RegistrationPolicy_EmailProofByDKIM{EmailProofByDKIM: &proto.EmailProofByDKIM{
+				AllowedDomains: []string{realmDomain},
+				ToAddr:         toAddr,
+				SubjectPrefix:  subjectPrefix,
+			}},
+		})
+		cfg.SMTPAddr = "localhost:0"
+	})
+	defer teardown()
+	stop := stoppableSyncedClocks(clks)
+	defer close(stop)
+
+	waitForFirstEpoch(kss[0], clientConfig.Realms[0].VerificationPolicy.GetQuorum())
+
+	req, entryHash := newRegistration(t, kss[0], alice, clientConfig.Realms[0].VerificationPolicy.GetQuorum())
+	var b bytes.Buffer
+	if err := (&jsonpb.Marshaler{OrigName: true}).Marshal(&b, req); err != nil {
+		t.Fatal(err)
+	}
+	c := &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}}}
+	resp, err := c.Post("https://"+kss[0].httpFrontListen.Addr().String()+"/pendingupdate", "application/json", &b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, _ := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusAccepted {
+		t.Fatalf("%s (%s)", resp.Status, body)
+	}
+	pending, err := kss[0].getPendingUpdate(req.Update.NewEntry.Index, entryHash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pending == nil || !bytes.Equal(pending.Update.NewEntry.Encoding, req.Update.NewEntry.Encoding) {
+		t.Fatalf("pending update was not stored")
+	}
+
+	// a proof without a DKIM signature must be rejected by the SMTP interface
+	msg := "From: " + alice + "\r\nTo: " + toAddr + "\r\nSubject: " + subjectPrefix + base64.StdEncoding.EncodeToString(entryHash) + "\r\n\r\n"
+	err = smtp.SendMail(kss[0].smtpListen.Addr().String(), nil, alice, []string{toAddr}, []byte(msg))
+	if err == nil || !strings.Contains(err.Error(), "DKIM") {
+		t.Fatalf("unsigned email proof was not rejected with a DKIM error: %v", err)
+	}
+	update, err := kss[0].getUpdate(req.Update.NewEntry.Index, math.MaxUint64)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if update != nil {
+		t.Fatalf("registration completed without a valid email proof")
+	}
+}
+
+func TestKeyserverPendingUpdatesDoNotReplaceEachOther(t *testing.T) {
+	dieOnCtrlC()
+	kss, _, _, _, clks, _, _, clientConfig, teardown := setupRealmWithConfig(t, 1, 0, func(cfg *proto.ReplicaConfig) {
+		cfg.RegistrationPolicy = append(cfg.RegistrationPolicy, &proto.RegistrationPolicy{
+			PolicyType: &proto.RegistrationPolicy_EmailProofByDKIM{EmailProofByDKIM: &proto.EmailProofByDKIM{
+				AllowedDomains: []string{realmDomain},
+				ToAddr:         "proofs@" + realmDomain,
+				SubjectPrefix:  "_KEYSERVER_PROOF_",
+			}},
+		})
+		cfg.SMTPAddr = "localhost:0"
+	})
+	defer teardown()
+	stop := stoppableSyncedClocks(clks)
+	defer close(stop)
+
+	waitForFirstEpoch(kss[0], clientConfig.Realms[0].VerificationPolicy.GetQuorum())
+
+	// someone else submitting an entry for alice must not replace hers
+	ours, ourHash := newRegistration(t, kss[0], alice, clientConfig.Realms[0].VerificationPolicy.GetQuorum())
+	theirs, theirHash := newRegistration(t, kss[0], alice, clientConfig.Realms[0].VerificationPolicy.GetQuorum())
+	for _, req := range []*proto.UpdateRequest{ours, theirs} {
+		if err := kss[0].SubmitPendingUpdate(context.Background(), req); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, want := range []struct {
+		req       *proto.UpdateRequest
+		entryHash []byte
+	}{{ours, ourHash}, {theirs, theirHash}} {
+		pending, err := kss[0].getPendingUpdate(want.req.Update.NewEntry.Index, want.entryHash)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if pending == nil || !bytes.Equal(pending.Update.NewEntry.Encoding, want.req.Update.NewEntry.Encoding) {
+			t.Errorf("pending update with entry hash %x was not stored", want.entryHash)
+		}
+	}
+	keys, err := kss[0].pendingUpdateKeys(ours.Update.NewEntry.Index)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != 2*2 { // each with its expiration
+		t.Errorf("%d pending updates for alice, expected 2", len(keys)/2)
+	}
+
+	// but there may only be a few of them
+	for i := 2; i < maxPendingUpdatesPerIndex; i++ {
+		req, _ := newRegistration(t, kss[0], alice, clientConfig.Realms[0].VerificationPolicy.GetQuorum())
+		if err := kss[0].SubmitPendingUpdate(context.Background(), req); err != nil {
+			t.Fatal(err)
+		}
+	}
+	req, _ := newRegistration(t, kss[0], alice, clientConfig.Realms[0].VerificationPolicy.GetQuorum())
+	if err := kss[0].SubmitPendingUpdate(context.Background(), req); grpc.Code(err) != codes.ResourceExhausted {
+		t.Errorf("pending update beyond the limit: got %v, expected ResourceExhausted", err)
+	}
+}
+
+func TestKeyserverPendingUpdateExpires(t *testing.T) {
+	dieOnCtrlC()
+	kss, _, _, _, clks, _, _, clientConfig, teardown := setupRealmWithConfig(t, 1, 0, func(cfg *proto.ReplicaConfig) {
+		cfg.RegistrationPolicy = append(cfg.RegistrationPolicy, &proto.RegistrationPolicy{
+			PolicyType: &proto.RegistrationPolicy_EmailProofByDKIM{EmailProofByDKIM: &proto.EmailProofByDKIM{
+				AllowedDomains:        []string{realmDomain},
+				ToAddr:                "proofs@" + realmDomain,
+				SubjectPrefix:         "_KEYSERVER_PROOF_",
+				PendingUpdateValidity: proto.DurationStamp(10 * tick),
+			}},
+		})
+		cfg.SMTPAddr = "localhost:0"
+	})
+	defer teardown()
+	stop := stoppableSyncedClocks(clks)
+	defer close(stop)
+
+	waitForFirstEpoch(kss[0], clientConfig.Realms[0].VerificationPolicy.GetQuorum())
+
+	req, _ := newRegistration(t, kss[0], alice, clientConfig.Realms[0].VerificationPolicy.GetQuorum())
+	if err := kss[0].SubmitPendingUpdate(context.Background(), req); err != nil {
+		t.Fatal(err)
+	}
+	for deadline := time.Now().Add(10 * time.Second); ; time.Sleep(poll) {
+		keys, err := kss[0].pendingUpdateKeys(req.Update.NewEntry.Index)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(keys) == 0 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("pending update was not deleted after it expired")
+		}
+	}
+}
@@ -18,6 +18,7 @@ import (
 	"encoding/binary"
 	"fmt"
 	"log"
+	"math"
 
 	"github.com/yahoo/coname"
 	"github.com/yahoo/coname/keyserver/kv"
@@ -25,6 +26,8 @@ import (
 	"github.com/yahoo/coname/proto"
 	"github.com/yahoo/coname/vrf"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 )
 
 const (
@@ -44,13 +47,13 @@ func (ks *Keyserver) findRatificationsForEpoch(epoch uint64, desiredVerifiers ma
 			continue
 		default:
 			log.Printf("ERROR: ks.db.Get(tableRatifications(%d, %d): %s", epoch, verifier, err)
-			return nil, nil, fmt.Errorf("internal error")
+			return nil, nil, errInternal
 		}
 		seh := new(proto.SignedEpochHead)
 		err = seh.Unmarshal(sehBytes)
 		if err != nil {
 			log.Printf("ERROR: tableRatifications(%d, %d) = %x is invalid: %s", epoch, verifier, sehBytes, err)
-			return nil, nil, fmt.Errorf("internal error")
+			return nil, nil, errInternal
 		}
 		ratifications = append(ratifications, seh)
 		haveVerifiers[verifier] = struct{}{}
@@ -59,56 +62,91 @@ func (ks *Keyserver) findRatificationsForEpoch(epoch uint64, desiredVerifiers ma
 }
 
 func (ks *Keyserver) findLatestEpochSignedByQuorum(quorum *proto.QuorumExpr) (uint64, []*proto.SignedEpochHead, error) {
+	epoch, ratifications, quorumMet, err := ks.findBestRatifiedEpoch(quorum)
+	if err != nil {
+		return 0, nil, err
+	}
+	if !quorumMet {
+		return 0, nil, grpc.Errorf(codes.Unavailable, "could not find sufficient verification in the last %d epochs (and not bothering to look further into the past)", ks.laggingVerifierScan)
+	}
+	return epoch, ratifications, nil
+}
+
+// findBestRatifiedEpoch returns the latest epoch whose ratifications satisfy
+// quorum. If there is none among the last laggingVerifierScan epochs, it
+// returns the latest epoch ratified by the most verifiers in quorum instead,
+// with quorumMet set to false.
+func (ks *Keyserver) findBestRatifiedEpoch(quorum *proto.QuorumExpr) (
+	epoch uint64, ratifications []*proto.SignedEpochHead, quorumMet bool, err error,
+) {
 	verifiers := coname.ListQuorum(quorum, nil)
 	// find latest epoch, iterate backwards until quorum requirement is met
 	// 0 is bad for iterating uint64 in the negative direction and there is no epoch 0
 	oldestEpoch, newestEpoch := uint64(1), ks.lastSignedEpoch()
 	if newestEpoch == 0 {
-		log.Printf("ERROR: no epochs created yet, so lookup failed")
-		return 0, nil, fmt.Errorf("internal error")
+		return 0, nil, false, grpc.Errorf(codes.Unavailable, "no epochs created yet")
 	}
 	if newestEpoch-oldestEpoch > ks.laggingVerifierScan { // careful with overflows!
 		oldestEpoch = newestEpoch - ks.laggingVerifierScan
 	}
 	// TODO: (for lookup throughput and latency) optimize this for the case
 	// where verifiers sign everything consecutively
-	for epoch := newestEpoch; epoch >= oldestEpoch; epoch-- {
-		ratifications, haveVerifiers, err := ks.findRatificationsForEpoch(epoch, verifiers)
+	for e := newestEpoch; e >= oldestEpoch; e-- {
+		rs, haveVerifiers, err := ks.findRatificationsForEpoch(e, verifiers)
 		if err != nil {
-			return 0, nil, err
+			return 0, nil, false, err
 		}
 		if coname.CheckQuorum(quorum, haveVerifiers) {
-			return epoch, ratifications, nil
+			return e, rs, true, nil
+		}
+		if len(rs) > len(ratifications) {
+			epoch, ratifications = e, rs
 		}
 	}
-	// TODO: (why? ~andreser) return whatever ratification we could find
-	return 0, nil, fmt.Errorf("could not find sufficient verification in the last %d epochs (and not bothering to look further into the past)", ks.laggingVerifierScan)
+	if len(ratifications) == 0 {
+		return 0, nil, false, grpc.Errorf(codes.Unavailable, "no verifier in the quorum requirement ratified any of the last %d epochs", ks.laggingVerifierScan)
+	}
+	return epoch, ratifications, false, nil
 }
 
 func (ks *Keyserver) assembleLookupProof(req *proto.LookupRequest, lookupEpoch uint64, ratifications []*proto.SignedEpochHead) (
 	*proto.LookupProof, error,
 ) {
 	ret := &proto.LookupProof{UserId: req.UserId}
-	ret.Index, ret.IndexProof = vrf.Prove([]byte(req.UserId), ks.vrfSecret)
+	vrfSecret, err := ks.vrfSecretForEpoch(lookupEpoch)
+	if err != nil {
+		log.Printf("ERROR: VRF key of epoch %d: %s", lookupEpoch, err)
+		return nil, errInternal
+	}
+	ret.Index, ret.IndexProof = ks.vrfSuite.Prove([]byte(req.UserId), vrfSecret)
 	ret.Ratifications = ratifications
 	tree, err := ks.merkletreeForEpoch(lookupEpoch)
 	if err != nil {
 		log.Printf("ERROR: couldn't get merkle tree for epoch %d: %s", lookupEpoch, err)
-		return nil, fmt.Errorf("internal error")
+		return nil, errInternal
 	}
 	_, ret.TreeProof, err = tree.Lookup(ret.Index)
 	if err != nil {
 		log.Printf("ERROR: merkle tree lookup %x at or before epoch %d: %s", ret.Index, lookupEpoch, err)
-		return nil, fmt.Errorf("internal error")
+		return nil, errInternal
 	}
 	urq, err := ks.getUpdate(ret.Index, lookupEpoch)
 	if err != nil {
 		log.Printf("ERROR: getProfile of %x at or before epoch %d: %s", ret.Index, lookupEpoch, err)
-		return nil, fmt.Errorf("internal error")
+		return nil, errInternal
 	}
 	if urq != nil {
-		ret.Profile = &urq.Profile
 		ret.Entry = &urq.Update.NewEntry
+		if !urq.Update.NewEntry.Deleted {
+			ret.Profile = &urq.Profile
+		}
+	}
+	// the latest pending recovery is reported so that the owner of the entry
+	// can veto it before it shows up in a ratified epoch
+	ret.PendingRecovery, err = ks.getPendingRecovery(ret.Index, math.MaxUint64)
+	if err != nil {
+		log.Printf("ERROR: getPendingRecovery of %x: %s", ret.Index, err)
+		return nil, errInternal
 	}
 	return ret, nil
 }
@@ -116,9 +154,19 @@ func (ks *Keyserver) assembleLookupProof(req *proto.LookupRequest, lookupEpoch u
 // Lookup implements proto.E2EKSLookupServer
 func (ks *Keyserver) Lookup(ctx context.Context, req *proto.LookupRequest) (*proto.LookupProof, error) {
 	ctx, _ = context.WithTimeout(ctx, ks.clientTimeout)
+	if err := ks.checkRateLimit(ctx, req.UserId); err != nil {
+		return nil, err
+	}
 	var lookupEpoch uint64
 	var ratifications []*proto.SignedEpochHead
-	if req.Epoch == 0 {
+	quorumMet := true
+	if req.Epoch == 0 && req.AllowPartialRatification {
+		var err error
+		lookupEpoch, ratifications, quorumMet, err = ks.findBestRatifiedEpoch(req.QuorumRequirement)
+		if err != nil {
+			return nil, err
+		}
+	} else if req.Epoch == 0 {
 		// use the latest epoch possible
 		var err error
 		lookupEpoch, ratifications, err = ks.findLatestEpochSignedByQuorum(req.QuorumRequirement)
@@ -133,19 +181,31 @@ func (ks *Keyserver) Lookup(ctx context.Context, req *proto.LookupRequest) (*pro
 		if err != nil {
 			return nil, err
 		}
-		if !coname.CheckQuorum(req.QuorumRequirement, haveVerifiers) {
-			// TODO: return whatever ratification we could find
-			return nil, fmt.Errorf("could not find sufficient verification")
+		quorumMet = coname.CheckQuorum(req.QuorumRequirement, haveVerifiers)
+		if !quorumMet && !req.AllowPartialRatification {
+			return nil, grpc.Errorf(codes.Unavailable, "could not find sufficient verification")
 		}
 	}
-	return ks.assembleLookupProof(req, lookupEpoch, ratifications)
+	ret, err := ks.assembleLookupProof(req, lookupEpoch, ratifications)
+	if err != nil {
+		return nil, err
+	}
+	ret.QuorumNotMet = !quorumMet
+	return ret, nil
 }
 
 // Waits until a sufficient quorum is assembled
 func (ks *Keyserver) blockingLookup(ctx context.Context, req *proto.LookupRequest, epoch uint64) (*proto.LookupProof, error) {
 	newSignatures := make(chan interface{}, newSignatureBufferSize)
 	ks.signatureBroadcast.Subscribe(epoch, newSignatures)
-	defer ks.signatureBroadcast.Unsubscribe(epoch, newSignatures)
+	defer func() {
+		// signatures of refreshes of the epoch may still be published after we
+		// stopped reading; if the buffer filled up, Publish and thus also
+		// Unsubscribe would block, so drain until Unsubscribe closes it
+		go ks.signatureBroadcast.Unsubscribe(epoch, newSignatures)
+		for range newSignatures {
+		}
+	}()
 	verifiersLeft := coname.ListQuorum(req.QuorumRequirement, nil)
 	ratifications, haveVerifiers, err := ks.findRatificationsForEpoch(epoch, verifiersLeft)
 	if err != nil {
@@ -157,7 +217,7 @@ func (ks *Keyserver) blockingLookup(ctx context.Context, req *proto.LookupReques
 	for !coname.CheckQuorum(req.QuorumRequirement, haveVerifiers) {
 		select {
 		case <-ctx.Done():
-			return nil, fmt.Errorf("timed out while waiting for ratification")
+			return nil, grpc.Errorf(codes.DeadlineExceeded, "timed out while waiting for ratification")
 		case v := <-newSignatures:
 			newSig := v.(*proto.SignedEpochHead)
 			for id := range newSig.Signatures {
@@ -204,7 +264,8 @@ func (ks *Keyserver) lastSignedEpoch() uint64 {
 }
 
 // getUpdate returns the last update to profile of idx during or before epoch.
-// If there is no such update, (nil, nil) is returned.
+// If there is no such update, or the entry has been moved to another index by
+// a VRF key rotation since, (nil, nil) is returned.
 func (ks *Keyserver) getUpdate(idx []byte, epoch uint64) (*proto.UpdateRequest, error) {
 	// idx: []&const
 	if len(idx) != vrf.Size {
@@ -225,6 +286,9 @@ func (ks *Keyserver) getUpdate(idx []byte, epoch uint64) (*proto.UpdateRequest,
 		}
 		return nil, nil
 	}
+	if len(iter.Value()) == 0 {
+		return nil, nil
+	}
 	ret := new(proto.UpdateRequest)
 	if err := ret.Unmarshal(iter.Value()); err != nil {
 		return nil, iter.Error()
@@ -22,7 +22,6 @@ import (
 	"crypto/x509"
 	"encoding/pem"
 	"fmt"
-	"io/ioutil"
 	"log"
 	"net"
 	"os"
@@ -37,10 +36,12 @@ import (
 	"github.com/andres-erbsen/clock"
 	"github.com/syndtr/goleveldb/leveldb"
 
+	"github.com/yahoo/coname/keyfile"
 	"github.com/yahoo/coname/keyserver/kv/leveldbkv"
 	"github.com/yahoo/coname/keyserver/replication/raftlog"
 	raftproto "github.com/yahoo/coname/keyserver/replication/raftlog/proto"
 	"github.com/yahoo/coname/proto"
+	"github.com/yahoo/coname/signer"
 	"github.com/yahoo/coname/vrf"
 )
 
@@ -65,9 +66,13 @@ func parsePrivateKey(der []byte) (crypto.PrivateKey, error) {
 }
 
 // This getKey interprets key IDs as paths, and loads private keys from the
-// specified file
+// specified file, decrypting it if it is encrypted (see package keyfile). Key IDs that name an external signer (see package signer)
+// are connected to instead; only signing keys can be external.
 func getKey(keyid string) (crypto.PrivateKey, error) {
-	fileContents, err := ioutil.ReadFile(keyid)
+	if signer.IsExternal(keyid) {
+		return signer.Open(keyid)
+	}
+	fileContents, err := keyfile.ReadFile(keyid)
 	if err != nil {
 		return nil, err
 	}
@@ -291,6 +291,13 @@ func (snapshot *NewSnapshot) Flush(wb kv.Batch) (flushed *Snapshot) {
 	return
 }
 
+// FlushedRootHash returns the summary hash of the snapshot returned by the
+// last call to Flush. Unlike GetRootHash on that snapshot, it does not read
+// the tree back from the db, so it can be called before wb has been written.
+func (snapshot *NewSnapshot) FlushedRootHash() []byte {
+	return snapshot.tree.hash([]bool{}, snapshot.root)
+}
+
 //////// Node manipulation functions ////////
 
 func (snapshot *Snapshot) loadRoot() (*node, error) {
@@ -112,6 +112,43 @@ func TestOneEntry(t *testing.T) {
 	})
 }
 
+func TestFlushedRootHash(t *testing.T) {
+	withDB(func(db kv.DB) {
+		m, err := AccessMerkleTree(db, nil, treeNonce)
+		if err != nil {
+			panic(err)
+		}
+		snapshot := m.GetSnapshot(0)
+		for i := 0; i < 3; i++ {
+			ne, err := snapshot.BeginModification()
+			if err != nil {
+				panic(err)
+			}
+			for j := 0; j < 10; j++ {
+				index, value := make([]byte, coname.IndexBytes), make([]byte, coname.HashBytes)
+				rand.Read(index)
+				rand.Read(value)
+				if err := ne.Set(index, value); err != nil {
+					panic(err)
+				}
+			}
+			wb := new(leveldb.Batch)
+			snapshot = ne.Flush(wb)
+			flushedRootHash := ne.FlushedRootHash()
+			if err := db.Write(wb); err != nil {
+				panic(err)
+			}
+			rootHash, err := snapshot.GetRootHash()
+			if err != nil {
+				panic(err)
+			}
+			if !bytes.Equal(flushedRootHash, rootHash) {
+				t.Fatalf("FlushedRootHash() = %x, but GetRootHash() = %x after writing the batch", flushedRootHash, rootHash)
+			}
+		}
+	})
+}
+
 func TestTwoEntriesOneEpoch(t *testing.T) {
 	withDB(func(db kv.DB) {
 		m, err := AccessMerkleTree(db, []byte("abcd"), treeNonce)
@@ -1,27 +1,55 @@
 package keyserver
 
 import (
-	"fmt"
-	"net/url"
 	"strings"
-)
 
-func (ks *Keyserver) OIDCRequest(domain string, uri string) (string, error) {
+	"github.com/yahoo/coname/keyserver/oidc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
 
-	for _, oc := range ks.oidcProofConfig {
-		if _, ok := oc.allowedDomains[domain]; !ok {
-			continue
+// oidcConfig returns the OpenID Connect provider configured for domain, or nil.
+func (ks *Keyserver) oidcConfig(domain string) *OIDCConfig {
+	for i := range ks.oidcProofConfig {
+		if _, ok := ks.oidcProofConfig[i].allowedDomains[domain]; ok {
+			return &ks.oidcProofConfig[i]
 		}
-		v := url.Values{}
-		v.Set("client_id", oc.oidcClient.ClientID)
-		v.Set("response_type", "id_token")
-		v.Set("redirect_uri", uri)
-		v.Set("scope", oc.scope)
-		// TODO: if this is absolutely required, generate a random one and validate it
-		v.Set("nonce", "foobar")
-		// replace '+' with '%20' due to https://github.com/golang/go/issues/4013
-		return oc.oidcClient.Issuer + "/oauth2/request_auth?" + strings.Replace(v.Encode(), "+", "%20", -1), nil
+	}
+	return nil
+}
 
+// OIDCRequest returns the URL that starts the authorization code flow at the
+// provider configured for domain. The provider redirects back to uri.
+func (ks *Keyserver) OIDCRequest(domain, uri, state, nonce string) (string, error) {
+	oc := ks.oidcConfig(domain)
+	if oc == nil {
+		return "", grpc.Errorf(codes.NotFound, "domain %q NOT configured for OIDC auth", domain)
+	}
+	return oc.oidcClient.AuthCodeURL(uri, oc.scope, state, nonce), nil
+}
+
+// OIDCCallback completes the authorization code flow started by OIDCRequest:
+// it exchanges code for an ID token at the provider configured for domain
+// and returns the token if it is valid, carries nonce and vouches for an
+// email address in domain. The token can then be used as an email proof.
+func (ks *Keyserver) OIDCCallback(domain, code, uri, nonce string) (string, error) {
+	oc := ks.oidcConfig(domain)
+	if oc == nil {
+		return "", grpc.Errorf(codes.NotFound, "domain %q NOT configured for OIDC auth", domain)
+	}
+	token, err := oc.oidcClient.Exchange(code, uri)
+	if err != nil {
+		return "", withCode(codes.InvalidArgument, err)
+	}
+	email, err := oc.oidcClient.VerifyIDTokenNonce(token, nonce)
+	if err != nil {
+		if _, ok := err.(*oidc.ErrExpired); ok {
+			return "", ProofExpired(err)
+		}
+		return "", withCode(codes.PermissionDenied, err)
+	}
+	if got := email[strings.LastIndex(email, "@")+1:]; got != domain {
+		return "", grpc.Errorf(codes.PermissionDenied, "email address %q is not in domain %q", email, domain)
 	}
-	return "", fmt.Errorf("domain %q NOT configured for OIDC auth", domain)
+	return token, nil
 }
@@ -8,23 +8,52 @@ import (
 	"encoding/binary"
 	"encoding/json"
 	"fmt"
+	"log"
 	"math/big"
 	"net/http"
+	"net/url"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 )
 
+// DefaultRefreshInterval is used when Client.RefreshInterval is zero.
+const DefaultRefreshInterval = time.Hour
+
+// DefaultMinRefreshInterval is used when Client.MinRefreshInterval is zero.
+const DefaultMinRefreshInterval = time.Minute
+
 // Client represents an openid connect client
 type Client struct {
-	pubKeys      map[string]interface{}
 	Issuer       string
 	ClientID     string
+	ClientSecret string
 	DiscoveryURL string
 	Validity     time.Duration
+	// AuthorizationEndpoint and TokenEndpoint are filled in from the
+	// discovery document by FetchPubKeys unless they are already set.
+	AuthorizationEndpoint string
+	TokenEndpoint         string
+	// RefreshInterval specifies how often KeepFresh fetches the provider keys
+	// when the JWKS response does not say how long it may be cached.
+	RefreshInterval time.Duration
+	// MinRefreshInterval rate-limits fetching the provider keys, both when a
+	// token signed with an unknown key arrives and when the cache headers of
+	// the JWKS response ask for more frequent refreshes.
+	MinRefreshInterval time.Duration
+
+	mu        sync.Mutex
+	pubKeys   map[string]interface{}
+	jwksURI   string
+	lastFetch time.Time // last attempt to fetch the keys
+	expires   time.Time // zero if the JWKS response had no cache headers
 }
 
 // AddECDSAKey adds an ECDSA public key to the Client object
 func (c *Client) AddECDSAKey(curve elliptic.Curve, x, y *big.Int, kid string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	if c.pubKeys == nil {
 		c.pubKeys = make(map[string]interface{})
 	}
@@ -38,6 +67,8 @@ func (c *Client) AddECDSAKey(curve elliptic.Curve, x, y *big.Int, kid string) {
 
 // AddRSAKey adds a RSA public key to the Client object
 func (c *Client) AddRSAKey(n *big.Int, e int, kid string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	if c.pubKeys == nil {
 		c.pubKeys = make(map[string]interface{})
 	}
@@ -50,29 +81,14 @@ func (c *Client) AddRSAKey(n *big.Int, e int, kid string) {
 
 // FetchPubKeys gets JWKS URI from the discovery document
 // Provider public keys are then fetched from JWKS URI
-// This could potentially be a goroutine running periodically
-// and syncing cached public keys
 func (c *Client) FetchPubKeys() error {
-
 	type discoveryResp struct {
-		Issuer  string `json:"issuer"`
-		JWKSURI string `json:"jwks_uri"`
+		Issuer                string `json:"issuer"`
+		JWKSURI               string `json:"jwks_uri"`
+		AuthorizationEndpoint string `json:"authorization_endpoint"`
+		TokenEndpoint         string `json:"token_endpoint"`
 		// other fields are ignored
 	}
-
-	type keysResp struct {
-		Keys []struct {
-			Kty string `json:"kty"`
-			Alg string `json:"alg"`
-			Use string `json:"use"`
-			Kid string `json:"kid"`
-			N   string `json:"n"`
-			E   string `json:"e"`
-			Crv string `json:"crv"`
-			X   string `json:"x"`
-			Y   string `json:"y"`
-		} `json:"keys"`
-	}
 	dr, err := http.Get(c.DiscoveryURL)
 	if err != nil {
 		return err
@@ -83,61 +99,274 @@ func (c *Client) FetchPubKeys() error {
 	if dj.JWKSURI == "" {
 		return fmt.Errorf("JWKS uri not found at %s", c.DiscoveryURL)
 	}
-
 	c.Issuer = dj.Issuer
-	kr, err := http.Get(dj.JWKSURI)
+	if c.AuthorizationEndpoint == "" {
+		c.AuthorizationEndpoint = dj.AuthorizationEndpoint
+	}
+	if c.TokenEndpoint == "" {
+		c.TokenEndpoint = dj.TokenEndpoint
+	}
+	c.mu.Lock()
+	c.jwksURI = dj.JWKSURI
+	c.mu.Unlock()
+	return c.fetchKeys()
+}
+
+// AuthCodeURL returns the URL that starts the authorization code flow at the
+// provider. The provider redirects the user agent back to redirectURI with
+// the authorization code and state as query parameters, and includes nonce in
+// the ID token the code is exchanged for.
+func (c *Client) AuthCodeURL(redirectURI, scope, state, nonce string) string {
+	endpoint := c.AuthorizationEndpoint
+	if endpoint == "" {
+		endpoint = c.Issuer + "/oauth2/request_auth"
+	}
+	v := url.Values{}
+	v.Set("client_id", c.ClientID)
+	v.Set("response_type", "code")
+	v.Set("redirect_uri", redirectURI)
+	v.Set("scope", scope)
+	v.Set("state", state)
+	v.Set("nonce", nonce)
+	// replace '+' with '%20' due to https://github.com/golang/go/issues/4013
+	return endpoint + "?" + strings.Replace(v.Encode(), "+", "%20", -1)
+}
+
+// Exchange redeems an authorization code at the token endpoint of the
+// provider and returns the ID token it was exchanged for. The token is not
+// verified.
+func (c *Client) Exchange(code, redirectURI string) (string, error) {
+	type tokenResp struct {
+		IDToken          string `json:"id_token"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+		// other fields are ignored
+	}
+	if c.TokenEndpoint == "" {
+		return "", fmt.Errorf("token endpoint of %s not known", c.Issuer)
+	}
+	v := url.Values{}
+	v.Set("grant_type", "authorization_code")
+	v.Set("code", code)
+	v.Set("redirect_uri", redirectURI)
+	req, err := http.NewRequest("POST", c.TokenEndpoint, strings.NewReader(v.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth(url.QueryEscape(c.ClientID), url.QueryEscape(c.ClientSecret))
+	tr, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer tr.Body.Close()
+	tj := tokenResp{}
+	json.NewDecoder(tr.Body).Decode(&tj)
+	if tj.Error != "" {
+		return "", fmt.Errorf("exchanging authorization code: %s: %s", tj.Error, tj.ErrorDescription)
+	}
+	if tr.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("exchanging authorization code: %s", tr.Status)
+	}
+	if tj.IDToken == "" {
+		return "", fmt.Errorf("no ID token in token response")
+	}
+	return tj.IDToken, nil
+}
+
+// fetchKeys replaces the provider public keys with the ones currently
+// published at the JWKS URI. Keys that are no longer published are dropped.
+func (c *Client) fetchKeys() error {
+	type keysResp struct {
+		Keys []jwk `json:"keys"`
+	}
+	c.mu.Lock()
+	jwksURI := c.jwksURI
+	c.lastFetch = time.Now()
+	c.mu.Unlock()
+
+	kr, err := http.Get(jwksURI)
 	if err != nil {
 		return err
 	}
 	defer kr.Body.Close()
+	if kr.StatusCode != http.StatusOK {
+		return fmt.Errorf("fetching keys from JWKS URI: %s", kr.Status)
+	}
 	kj := keysResp{}
 	json.NewDecoder(kr.Body).Decode(&kj)
 	if len(kj.Keys) == 0 {
 		return fmt.Errorf("No keys available at JWKS URI")
 	}
+	keys := make(map[string]interface{})
 	for _, obj := range kj.Keys {
-		if obj.Kty == "RSA" {
-			n := addOptionalPadding(obj.N)
-			dn, err := base64.URLEncoding.DecodeString(n)
-			if err != nil {
-				return fmt.Errorf("failed to decode \"n\" value %s", obj.N)
-			}
-			nInt := (&big.Int{}).SetBytes(dn)
-			e := addOptionalPadding(obj.E)
-			de, err := base64.URLEncoding.DecodeString(e)
-			if err != nil {
-				return fmt.Errorf("failed to decode \"e\" value %s", obj.E)
-			}
-			if len(de) > 8 {
-				return fmt.Errorf("Invalid length of decoded \"e\", expected <= 8 , got %d", len(de))
-			}
-			deBytes := make([]byte, 8-len(de), 8)
-			deBytes = append(deBytes, de...)
-			eInt := int(binary.BigEndian.Uint64(deBytes))
-			c.AddRSAKey(nInt, eInt, obj.Kid)
-
-		} else if obj.Kty == "EC" {
-			x := addOptionalPadding(obj.X)
-			dx, err := base64.URLEncoding.DecodeString(x)
-			if err != nil {
-				return fmt.Errorf("failed to decode \"X\" value %s", obj.X)
-			}
+		key, err := obj.publicKey()
+		if err != nil {
+			return err
+		}
+		if key != nil {
+			keys[obj.Kid] = key
+		}
+	}
+	expires := cacheExpiry(kr.Header, time.Now())
 
-			y := addOptionalPadding(obj.Y)
-			dy, err := base64.URLEncoding.DecodeString(y)
-			if err != nil {
-				return fmt.Errorf("failed to decode \"Y\" value %s", obj.Y)
-			}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.pubKeys = keys
+	c.expires = expires
+	return nil
+}
+
+type jwk struct {
+	Kty string `json:"kty"`
+	Alg string `json:"alg"`
+	Use string `json:"use"`
+	Kid string `json:"kid"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
 
-			xInt := (&big.Int{}).SetBytes(dx)
-			yInt := (&big.Int{}).SetBytes(dy)
-			if obj.Crv == "P-256" {
-				c.AddECDSAKey(elliptic.P256(), xInt, yInt, obj.Kid)
+// publicKey returns nil for key types that are not supported
+func (obj *jwk) publicKey() (interface{}, error) {
+	if obj.Kty == "RSA" {
+		n := addOptionalPadding(obj.N)
+		dn, err := base64.URLEncoding.DecodeString(n)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode \"n\" value %s", obj.N)
+		}
+		nInt := (&big.Int{}).SetBytes(dn)
+		e := addOptionalPadding(obj.E)
+		de, err := base64.URLEncoding.DecodeString(e)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode \"e\" value %s", obj.E)
+		}
+		if len(de) > 8 {
+			return nil, fmt.Errorf("Invalid length of decoded \"e\", expected <= 8 , got %d", len(de))
+		}
+		deBytes := make([]byte, 8-len(de), 8)
+		deBytes = append(deBytes, de...)
+		eInt := int(binary.BigEndian.Uint64(deBytes))
+		return &rsa.PublicKey{N: nInt, E: eInt}, nil
+	} else if obj.Kty == "EC" {
+		x := addOptionalPadding(obj.X)
+		dx, err := base64.URLEncoding.DecodeString(x)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode \"X\" value %s", obj.X)
+		}
+		y := addOptionalPadding(obj.Y)
+		dy, err := base64.URLEncoding.DecodeString(y)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode \"Y\" value %s", obj.Y)
+		}
+		xInt := (&big.Int{}).SetBytes(dx)
+		yInt := (&big.Int{}).SetBytes(dy)
+		if obj.Crv == "P-256" {
+			return &ecdsa.PublicKey{Curve: elliptic.P256(), X: xInt, Y: yInt}, nil
+		}
+		//TODO: add other curves
+	}
+	return nil, nil
+}
+
+// keys returns the current provider public keys. The map must not be
+// modified.
+func (c *Client) keys() map[string]interface{} {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.pubKeys
+}
+
+func (c *Client) minRefreshInterval() time.Duration {
+	if c.MinRefreshInterval == 0 {
+		return DefaultMinRefreshInterval
+	}
+	return c.MinRefreshInterval
+}
+
+// mayFetchKeys reports whether the keys may be fetched again now that a
+// token signed with an unknown key has arrived. At most one caller per
+// MinRefreshInterval gets true.
+func (c *Client) mayFetchKeys() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.jwksURI == "" || time.Since(c.lastFetch) < c.minRefreshInterval() {
+		return false
+	}
+	c.lastFetch = time.Now()
+	return true
+}
+
+// nextFetch returns when KeepFresh should fetch the keys again.
+func (c *Client) nextFetch() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	next := c.expires
+	if next.IsZero() {
+		interval := c.RefreshInterval
+		if interval == 0 {
+			interval = DefaultRefreshInterval
+		}
+		next = c.lastFetch.Add(interval)
+	}
+	if earliest := c.lastFetch.Add(c.minRefreshInterval()); next.Before(earliest) {
+		next = earliest
+	}
+	return next
+}
+
+// KeepFresh fetches the provider public keys whenever the previous JWKS
+// response expires, or every RefreshInterval if it had no cache headers,
+// until stop is closed. If fetching fails, the old keys are kept and the
+// fetch is retried after MinRefreshInterval.
+func (c *Client) KeepFresh(stop <-chan struct{}) {
+	for {
+		t := time.NewTimer(c.nextFetch().Sub(time.Now()))
+		select {
+		case <-stop:
+			t.Stop()
+			return
+		case <-t.C:
+		}
+		if err := c.fetchKeys(); err != nil {
+			log.Printf("refreshing OIDC keys of %s: %s", c.Issuer, err)
+		}
+	}
+}
+
+// cacheExpiry returns until when a response with header h may be cached
+// according to its Cache-Control and Expires headers, or the zero time if
+// the response does not say.
+func cacheExpiry(h http.Header, now time.Time) time.Time {
+	if cc := h.Get("Cache-Control"); cc != "" {
+		for _, d := range strings.Split(cc, ",") {
+			d = strings.ToLower(strings.TrimSpace(d))
+			if d == "no-cache" || d == "no-store" {
+				return now
+			}
+			if strings.HasPrefix(d, "max-age=") {
+				maxAge, err := strconv.ParseInt(d[len("max-age="):], 10, 64)
+				if err != nil || maxAge < 0 {
+					continue
+				}
+				if age, err := strconv.ParseInt(h.Get("Age"), 10, 64); err == nil && age > 0 {
+					maxAge -= age
+				}
+				return now.Add(time.Duration(maxAge) * time.Second)
 			}
-			//TODO: add other curves
 		}
 	}
-	return nil
+	if e := h.Get("Expires"); e != "" {
+		t, err := http.ParseTime(e)
+		if err != nil {
+			// invalid dates mean the response has already expired
+			return now
+		}
+		return t
+	}
+	return time.Time{}
 }
 
 // addOptionalPadding is a workaround for https://github.com/golang/go/issues/4237
@@ -4,8 +4,14 @@ import (
 	"crypto/ecdsa"
 	"crypto/elliptic"
 	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -171,3 +177,227 @@ func TestRealIDToken(t *testing.T) {
 	}
 
 }
+
+// fakeProvider serves an OpenID Connect discovery document and the JWKS it
+// points to, with the keys and cache headers set by the test.
+type fakeProvider struct {
+	*httptest.Server
+
+	mu           sync.Mutex
+	keys         map[string]*ecdsa.PrivateKey
+	cacheControl string
+	fetches      int
+	// idTokens maps authorization codes to the ID tokens they are exchanged for
+	idTokens map[string]string
+}
+
+func newFakeProvider() *fakeProvider {
+	p := &fakeProvider{keys: make(map[string]*ecdsa.PrivateKey), idTokens: make(map[string]string)}
+	p.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		switch r.URL.Path {
+		case "/.well-known/openid-configuration":
+			json.NewEncoder(w).Encode(map[string]string{
+				"issuer":                 p.URL,
+				"jwks_uri":               p.URL + "/jwks",
+				"authorization_endpoint": p.URL + "/auth",
+				"token_endpoint":         p.URL + "/token",
+			})
+		case "/jwks":
+			p.fetches++
+			var keys []map[string]string
+			for kid, k := range p.keys {
+				keys = append(keys, map[string]string{
+					"kty": "EC",
+					"crv": "P-256",
+					"kid": kid,
+					"x":   base64.RawURLEncoding.EncodeToString(k.X.Bytes()),
+					"y":   base64.RawURLEncoding.EncodeToString(k.Y.Bytes()),
+				})
+			}
+			if p.cacheControl != "" {
+				w.Header().Set("Cache-Control", p.cacheControl)
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{"keys": keys})
+		case "/token":
+			id, secret, _ := r.BasicAuth()
+			idToken, ok := p.idTokens[r.PostFormValue("code")]
+			if r.Method != "POST" || r.PostFormValue("grant_type") != "authorization_code" || id != "foo" || secret != "bar" || !ok {
+				w.WriteHeader(http.StatusBadRequest)
+				json.NewEncoder(w).Encode(map[string]string{"error": "invalid_grant"})
+				return
+			}
+			delete(p.idTokens, r.PostFormValue("code"))
+			json.NewEncoder(w).Encode(map[string]string{"access_token": "x", "token_type": "Bearer", "id_token": idToken})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	return p
+}
+
+// rotate replaces the published keys with a new one called kid
+func (p *fakeProvider) rotate(t *testing.T, kid string) *ecdsa.PrivateKey {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.keys = map[string]*ecdsa.PrivateKey{kid: key}
+	return key
+}
+
+func (p *fakeProvider) fetchCount() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.fetches
+}
+
+func (p *fakeProvider) token(t *testing.T, key *ecdsa.PrivateKey, kid, email, aud string) string {
+	return p.tokenWithNonce(t, key, kid, email, aud, "")
+}
+
+func (p *fakeProvider) tokenWithNonce(t *testing.T, key *ecdsa.PrivateKey, kid, email, aud, nonce string) string {
+	token := jwt.New(jwt.SigningMethodES256)
+	claims := jwt.MapClaims{
+		"aud":            aud,
+		"email":          email,
+		"email_verified": true,
+		"exp":            time.Now().Add(time.Minute).Unix(),
+		"iat":            time.Now().Unix(),
+		"iss":            p.URL,
+	}
+	if nonce != "" {
+		claims["nonce"] = nonce
+	}
+	token.Claims = claims
+	token.Header["kid"] = kid
+	tokStr, err := token.SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tokStr
+}
+
+func TestKeyRotation(t *testing.T) {
+	p := newFakeProvider()
+	defer p.Close()
+	email, aud := "alice@example.com", "foo"
+	p.cacheControl = "public, max-age=0"
+	key1 := p.rotate(t, "1")
+	c := &Client{DiscoveryURL: p.URL + "/.well-known/openid-configuration", ClientID: aud, MinRefreshInterval: 100 * time.Millisecond}
+	if err := c.FetchPubKeys(); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := c.VerifyIDToken(p.token(t, key1, "1", email, aud)); err != nil || got != email {
+		t.Fatalf("VerifyIDToken() = %q, %v", got, err)
+	}
+
+	// a token with an unknown key ID makes the client fetch the keys again
+	time.Sleep(c.MinRefreshInterval)
+	key2 := p.rotate(t, "2")
+	if got, err := c.VerifyIDToken(p.token(t, key2, "2", email, aud)); err != nil || got != email {
+		t.Fatalf("VerifyIDToken() after rotation = %q, %v", got, err)
+	}
+	if _, err := c.VerifyIDToken(p.token(t, key1, "1", email, aud)); err == nil {
+		t.Error("validated a token signed with a key that is no longer published")
+	}
+
+	// ... but not more than once per MinRefreshInterval
+	fetches := p.fetchCount()
+	key3 := p.rotate(t, "3")
+	if _, err := c.VerifyIDToken(p.token(t, key3, "3", email, aud)); err == nil {
+		t.Error("validated a token signed with a key that was not fetched yet")
+	}
+	if got := p.fetchCount(); got != fetches {
+		t.Errorf("keys were fetched %d times within MinRefreshInterval, want 0", got-fetches)
+	}
+
+	// the keys are refreshed in the background when they expire
+	stop := make(chan struct{})
+	defer close(stop)
+	go c.KeepFresh(stop)
+	for deadline := time.Now().Add(5 * time.Second); ; time.Sleep(10 * time.Millisecond) {
+		if _, ok := c.keys()["3"]; ok {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("keys were not refreshed in the background")
+		}
+	}
+}
+
+func TestAuthorizationCodeFlow(t *testing.T) {
+	p := newFakeProvider()
+	defer p.Close()
+	email, aud := "alice@example.com", "foo"
+	key := p.rotate(t, "1")
+	c := &Client{DiscoveryURL: p.URL + "/.well-known/openid-configuration", ClientID: aud, ClientSecret: "bar"}
+	if err := c.FetchPubKeys(); err != nil {
+		t.Fatal(err)
+	}
+
+	u, err := url.Parse(c.AuthCodeURL("https://ks.example.com/oidcsso", "openid email", "s", "n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := u.Scheme+"://"+u.Host+u.Path, p.URL+"/auth"; got != want {
+		t.Errorf("authorization endpoint: got %q, want %q", got, want)
+	}
+	for k, want := range map[string]string{"client_id": aud, "response_type": "code", "redirect_uri": "https://ks.example.com/oidcsso", "scope": "openid email", "state": "s", "nonce": "n"} {
+		if got := u.Query().Get(k); got != want {
+			t.Errorf("%s: got %q, want %q", k, got, want)
+		}
+	}
+
+	p.mu.Lock()
+	p.idTokens["code1"] = p.tokenWithNonce(t, key, "1", email, aud, "n")
+	p.idTokens["code2"] = p.tokenWithNonce(t, key, "1", email, aud, "n")
+	p.mu.Unlock()
+	if _, err := c.Exchange("bogus", "https://ks.example.com/oidcsso"); err == nil {
+		t.Error("exchanged an unknown authorization code")
+	}
+	token, err := c.Exchange("code1", "https://ks.example.com/oidcsso")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, err := c.VerifyIDTokenNonce(token, "n"); err != nil || got != email {
+		t.Errorf("VerifyIDTokenNonce() = %q, %v", got, err)
+	}
+	if _, err := c.VerifyIDTokenNonce(token, "m"); err == nil || err.Error() != "nonce invalid" {
+		t.Errorf("VerifyIDTokenNonce() with the wrong nonce: got %v", err)
+	}
+	if _, err := c.VerifyIDTokenNonce(p.token(t, key, "1", email, aud), "n"); err == nil {
+		t.Error("validated a token without a nonce")
+	}
+	if _, err := c.Exchange("code1", "https://ks.example.com/oidcsso"); err == nil {
+		t.Error("exchanged an authorization code twice")
+	}
+
+	c.ClientSecret = "baz"
+	if _, err := c.Exchange("code2", "https://ks.example.com/oidcsso"); err == nil {
+		t.Error("exchanged an authorization code with the wrong client secret")
+	}
+}
+
+func TestCacheExpiry(t *testing.T) {
+	now := time.Unix(1e9, 0)
+	for _, tc := range []struct {
+		header http.Header
+		want   time.Time
+	}{
+		{http.Header{}, time.Time{}},
+		{http.Header{"Cache-Control": {"public, max-age=3600"}}, now.Add(time.Hour)},
+		{http.Header{"Cache-Control": {"max-age=3600"}, "Age": {"600"}}, now.Add(50 * time.Minute)},
+		{http.Header{"Cache-Control": {"no-cache"}}, now},
+		{http.Header{"Expires": {now.Add(time.Minute).UTC().Format(http.TimeFormat)}}, now.Add(time.Minute)},
+		{http.Header{"Cache-Control": {"max-age=60"}, "Expires": {"0"}}, now.Add(time.Minute)},
+		{http.Header{"Expires": {"0"}}, now},
+	} {
+		if got := cacheExpiry(tc.header, now); !got.Equal(tc.want) {
+			t.Errorf("cacheExpiry(%v) = %v, want %v", tc.header, got, tc.want)
+		}
+	}
+}
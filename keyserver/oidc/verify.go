@@ -1,6 +1,7 @@
 package oidc
 
 import (
+	"crypto/subtle"
 	"fmt"
 	"time"
 
@@ -22,18 +23,39 @@ func (e ErrExpired) Error() string {
 // iss - must match issuer specified in the config
 // aud - must match the clientID specified in the config
 // email_verified - must be true
-// nonce - must be validated by the client
+// nonce - must be validated by the client, see VerifyIDTokenNonce
 func (c *Client) VerifyIDToken(token string) (email string, err error) {
+	return c.verifyIDToken(token, "")
+}
+
+// VerifyIDTokenNonce is like VerifyIDToken, but also requires the nonce in
+// the token to match the one sent in the authentication request.
+func (c *Client) VerifyIDTokenNonce(token, nonce string) (email string, err error) {
+	if nonce == "" {
+		return "", fmt.Errorf("empty nonce")
+	}
+	return c.verifyIDToken(token, nonce)
+}
+
+func (c *Client) verifyIDToken(token, nonce string) (email string, err error) {
 
 	tok, err := jwt.Parse(token, func(t *jwt.Token) (interface{}, error) {
 		kid, ok := t.Header["kid"].(string)
 		if !ok {
 			return nil, fmt.Errorf("\"kid\" not a string")
 		}
-		if c.pubKeys == nil {
+		pubKeys := c.keys()
+		if _, ok := pubKeys[kid]; !ok && c.mayFetchKeys() {
+			// the provider may have rotated its keys
+			if err := c.fetchKeys(); err != nil {
+				return nil, err
+			}
+			pubKeys = c.keys()
+		}
+		if pubKeys == nil {
 			return nil, fmt.Errorf("No public key found to verify token")
 		}
-		return c.pubKeys[kid], nil
+		return pubKeys[kid], nil
 	})
 	if err != nil {
 		if ve, ok := err.(*jwt.ValidationError); ok {
@@ -79,6 +101,11 @@ func (c *Client) VerifyIDToken(token string) (email string, err error) {
 		if !emailVrf {
 			return "", fmt.Errorf("email not verified")
 		}
+		if nonce != "" {
+			if got, _ := claims["nonce"].(string); subtle.ConstantTimeCompare([]byte(got), []byte(nonce)) != 1 {
+				return "", fmt.Errorf("nonce invalid")
+			}
+		}
 		return claims["email"].(string), err
 	}
 	return "", fmt.Errorf("Invalid token")
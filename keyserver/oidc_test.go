@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/yahoo/coname/keyserver/oidc"
+	"google.golang.org/grpc"
 )
 
 func TestOIDCRequest(t *testing.T) {
@@ -11,7 +12,7 @@ func TestOIDCRequest(t *testing.T) {
 	i := "https://bar.com"
 	d := "https://example.com"
 	s := "read"
-	expURL := "https://bar.com/oauth2/request_auth?client_id=foo&nonce=foobar&redirect_uri=ks.com&response_type=id_token&scope=read"
+	expURL := "https://bar.com/oauth2/request_auth?client_id=foo&nonce=n&redirect_uri=ks.com&response_type=code&scope=read&state=s"
 	o := &oidc.Client{ClientID: c, Issuer: i, DiscoveryURL: d}
 	oc := OIDCConfig{oidcClient: o, scope: s}
 	oc.allowedDomains = make(map[string]struct{})
@@ -19,7 +20,7 @@ func TestOIDCRequest(t *testing.T) {
 		oc.allowedDomains[d] = struct{}{}
 	}
 	ks := Keyserver{oidcProofConfig: []OIDCConfig{oc}}
-	url, err := ks.OIDCRequest("foomail.com", "ks.com")
+	url, err := ks.OIDCRequest("foomail.com", "ks.com", "s", "n")
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -27,19 +28,19 @@ func TestOIDCRequest(t *testing.T) {
 		t.Fatalf("url: got %q but wanted %q", got, want)
 	}
 
-	url, err = ks.OIDCRequest("foomailinvalid.com", "ks.com")
+	url, err = ks.OIDCRequest("foomailinvalid.com", "ks.com", "s", "n")
 	if err == nil {
 		t.Fatalf("OIDCRequest expected to fail, but got a url %q", url)
 	}
-	if got, want := err.Error(), "domain \"foomailinvalid.com\" NOT configured for OIDC auth"; got != want {
+	if got, want := grpc.ErrorDesc(err), "domain \"foomailinvalid.com\" NOT configured for OIDC auth"; got != want {
 		t.Fatalf("OIDCRequest expected to fail with err %q , got %q", want, got)
 	}
 
-	url, err = ks.OIDCRequest("", "ks.com")
+	url, err = ks.OIDCRequest("", "ks.com", "s", "n")
 	if err == nil {
 		t.Fatalf("OIDCRequest expected to fail, but got a url %q", url)
 	}
-	if got, want := err.Error(), "domain \"\" NOT configured for OIDC auth"; got != want {
+	if got, want := grpc.ErrorDesc(err), "domain \"\" NOT configured for OIDC auth"; got != want {
 		t.Fatalf("OIDCRequest expected to fail with err %q , got %q", want, got)
 	}
 
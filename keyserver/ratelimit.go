@@ -0,0 +1,114 @@
+// Copyright 2014-2015 The Dename Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy of
+// the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations under
+// the License.
+
+package keyserver
+
+import (
+	"encoding/binary"
+	"fmt"
+	"log"
+	"net"
+	"strings"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+)
+
+// clientIP returns the IP address of the client that made the request of
+// ctx, or "" if it is not known. HTTPFront and HKPFront pass the client
+// address the same way grpc does.
+func clientIP(ctx context.Context) string {
+	pr, ok := peer.FromContext(ctx)
+	if !ok || pr.Addr == nil {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(pr.Addr.String())
+	if err != nil {
+		return pr.Addr.String()
+	}
+	return host
+}
+
+// checkRateLimit takes a token from the buckets of the client of ctx and of
+// userID. A request is rejected with codes.ResourceExhausted if either of
+// them is empty.
+func (ks *Keyserver) checkRateLimit(ctx context.Context, userID string) error {
+	if err := ks.checkClientRateLimit(ctx); err != nil {
+		return err
+	}
+	if ks.userIDLimiter != nil && !ks.userIDLimiter.Allow(userID) {
+		return grpc.Errorf(codes.ResourceExhausted, "too many requests for %q", userID)
+	}
+	return nil
+}
+
+// checkClientRateLimit takes a token from the bucket of the client of ctx
+// only, for requests that are not about a single user ID.
+func (ks *Keyserver) checkClientRateLimit(ctx context.Context) error {
+	if ks.clientIPLimiter != nil {
+		if ip := clientIP(ctx); ip != "" && !ks.clientIPLimiter.Allow(ip) {
+			return grpc.Errorf(codes.ResourceExhausted, "too many requests from %s", ip)
+		}
+	}
+	return nil
+}
+
+func userDomain(userID string) string {
+	return userID[strings.LastIndex(userID, "@")+1:]
+}
+
+// registrationCount returns the number of user IDs of domain that have been
+// registered in epoch.
+func (ks *Keyserver) registrationCount(domain string, epoch uint64) (uint64, error) {
+	v, err := ks.db.Get(tableRegistrationCounts(domain))
+	switch err {
+	case nil:
+	case ks.db.ErrNotFound():
+		return 0, nil
+	default:
+		return 0, err
+	}
+	if len(v) != 8+8 {
+		return 0, fmt.Errorf("registration count of %q has bad length %d", domain, len(v))
+	}
+	if binary.BigEndian.Uint64(v[:8]) != epoch {
+		return 0, nil
+	}
+	return binary.BigEndian.Uint64(v[8:]), nil
+}
+
+// checkRegistrationLimitDeterministic returns the number of registrations of
+// the domain of userID in epoch including one more, or an error if that is
+// over the limit.
+func (ks *Keyserver) checkRegistrationLimitDeterministic(userID string, epoch uint64) (uint64, error) {
+	domain := userDomain(userID)
+	count, err := ks.registrationCount(domain, epoch)
+	if err != nil {
+		log.Printf("registrationCount: %s", err)
+		return 0, errInternal
+	}
+	if count >= ks.registrationsPerDomainPerEpoch {
+		return 0, grpc.Errorf(codes.ResourceExhausted, "too many registrations for %q in this epoch, try again later", domain)
+	}
+	return count + 1, nil
+}
+
+func registrationCountValue(epoch, count uint64) []byte {
+	ret := make([]byte, 8+8)
+	binary.BigEndian.PutUint64(ret[:8], epoch)
+	binary.BigEndian.PutUint64(ret[8:], count)
+	return ret
+}
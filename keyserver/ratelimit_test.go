@@ -0,0 +1,116 @@
+// Copyright 2014-2015 The Dename Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy of
+// the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations under
+// the License.
+
+package keyserver
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/yahoo/coname/proto"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+)
+
+func fromClient(ip string) context.Context {
+	return peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(ip), Port: 1234}})
+}
+
+func TestKeyserverRateLimit(t *testing.T) {
+	dieOnCtrlC()
+	kss, _, _, _, clks, _, _, clientConfig, teardown := setupRealmWithConfig(t, 3, 1, func(cfg *proto.ReplicaConfig) {
+		cfg.ClientIPRateLimit = &proto.RateLimit{Interval: proto.DurationStamp(time.Hour), Burst: 2}
+		cfg.UserIDRateLimit = &proto.RateLimit{Interval: proto.DurationStamp(time.Hour), Burst: 3}
+		cfg.RegistrationsPerDomainPerEpoch = 1
+	})
+	defer teardown()
+	stop := stoppableSyncedClocks(clks)
+	defer close(stop)
+
+	quorum := clientConfig.Realms[0].VerificationPolicy.GetQuorum()
+	waitForFirstEpoch(kss[0], quorum)
+	lookup := &proto.LookupRequest{UserId: alice, QuorumRequirement: quorum}
+
+	req, _, _ := recoverableUpdate(t, kss[0], alice, 0, 0, quorum)
+	proof, err := kss[0].Update(fromClient("192.0.2.1"), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := kss[0].Lookup(fromClient("192.0.2.1"), lookup); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := kss[0].Lookup(fromClient("192.0.2.1"), lookup); grpc.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("lookup over the client IP rate limit returned %v", err)
+	}
+	if _, err := kss[0].Lookup(fromClient("192.0.2.2"), lookup); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := kss[0].Lookup(fromClient("192.0.2.3"), lookup); grpc.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("lookup over the user ID rate limit returned %v", err)
+	}
+
+	// the registration of alice used up the registrations of the domain in
+	// its epoch
+	bob := "bob@" + realmDomain
+	epoch := proof.Ratifications[0].Head.Head.Epoch
+	if _, err := kss[0].checkRegistrationLimitDeterministic(bob, epoch); grpc.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("registration over the limit in epoch %d returned %v", epoch, err)
+	}
+	if count, err := kss[0].checkRegistrationLimitDeterministic(bob, epoch+1); err != nil || count != 1 {
+		t.Fatalf("first registration in epoch %d returned %d, %v", epoch+1, count, err)
+	}
+	req, _, _ = recoverableUpdate(t, kss[0], bob, 0, 0, quorum)
+	if _, err := kss[0].Update(fromClient("192.0.2.4"), req); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestKeyserverRateLimitMailingRequests(t *testing.T) {
+	dieOnCtrlC()
+	kss, _, _, _, clks, _, _, clientConfig, teardown := setupRealmWithConfig(t, 1, 1, func(cfg *proto.ReplicaConfig) {
+		cfg.ClientIPRateLimit = &proto.RateLimit{Interval: proto.DurationStamp(time.Hour), Burst: 1}
+		cfg.UserIDRateLimit = &proto.RateLimit{Interval: proto.DurationStamp(time.Hour), Burst: 1}
+	})
+	defer teardown()
+	stop := stoppableSyncedClocks(clks)
+	defer close(stop)
+
+	quorum := clientConfig.Realms[0].VerificationPolicy.GetQuorum()
+	challenge := func(ip, userID string) error {
+		_, err := kss[0].RequestEmailChallenge(fromClient(ip), &proto.EmailChallengeRequest{UserId: userID, EntryHash: make([]byte, 32)})
+		return err
+	}
+	// the first request passes the rate limits and fails for other reasons
+	if err := challenge("192.0.2.1", alice); grpc.Code(err) == codes.ResourceExhausted {
+		t.Fatalf("first email challenge request returned %v", err)
+	}
+	if err := challenge("192.0.2.2", alice); grpc.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("email challenge request over the user ID rate limit returned %v", err)
+	}
+
+	bob := "bob@" + realmDomain
+	req, _, _ := recoverableUpdate(t, kss[0], bob, 0, 0, quorum)
+	if _, err := kss[0].StartRecovery(fromClient("192.0.2.1"), req); grpc.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("recovery request over the client IP rate limit returned %v", err)
+	}
+	if _, err := kss[0].StartRecovery(fromClient("192.0.2.3"), req); grpc.Code(err) == codes.ResourceExhausted {
+		t.Fatalf("first recovery request returned %v", err)
+	}
+	if _, err := kss[0].StartRecovery(fromClient("192.0.2.4"), req); grpc.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("recovery request over the user ID rate limit returned %v", err)
+	}
+}
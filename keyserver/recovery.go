@@ -0,0 +1,218 @@
+// Copyright 2014-2015 The Dename Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy of
+// the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations under
+// the License.
+
+package keyserver
+
+import (
+	"encoding/binary"
+	"log"
+	"math"
+	"net/smtp"
+	"time"
+
+	"github.com/yahoo/coname"
+	"github.com/yahoo/coname/keyserver/kv"
+	"github.com/yahoo/coname/keyserver/replication"
+	"github.com/yahoo/coname/proto"
+	"github.com/yahoo/coname/vrf"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+type recoveryOutput struct {
+	Recovery *proto.PendingRecovery
+	Error    error
+}
+
+// lastEpochIssueTime returns the issue time of the last epoch head, or the
+// zero time if there is none yet. Pending recoveries are timed by it, because
+// verifiers see the same epoch heads.
+func lastEpochIssueTime(rs *proto.ReplicaState) time.Time {
+	if rs.LastEpochDelimiter.EpochNumber == 0 {
+		return time.Time{}
+	}
+	return rs.LastEpochDelimiter.Timestamp.Time()
+}
+
+// getPendingRecovery returns the recovery of idx that was pending at the end
+// of epoch. If there is none, (nil, nil) is returned.
+func (ks *Keyserver) getPendingRecovery(idx []byte, epoch uint64) (*proto.PendingRecovery, error) {
+	// idx: []&const
+	if len(idx) != vrf.Size {
+		log.Panicf("getPendingRecovery: index %x has bad length", idx)
+	}
+	prefixIdxEpoch := make([]byte, 1+vrf.Size+8)
+	prefixIdxEpoch[0] = tablePendingRecoveriesPrefix
+	copy(prefixIdxEpoch[1:], idx)
+	binary.BigEndian.PutUint64(prefixIdxEpoch[1+len(idx):], epoch)
+	iter := ks.db.NewIterator(&kv.Range{
+		Start: prefixIdxEpoch[:1+len(idx)],
+		Limit: kv.IncrementKey(prefixIdxEpoch),
+	})
+	defer iter.Release()
+	if !iter.Last() {
+		return nil, iter.Error()
+	}
+	if len(iter.Value()) == 0 { // vetoed or completed
+		return nil, nil
+	}
+	ret := new(proto.PendingRecovery)
+	if err := ret.Unmarshal(iter.Value()); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// getEntryAndPendingRecovery returns the current entry at idx and its pending
+// recovery, each nil if there is none.
+func (ks *Keyserver) getEntryAndPendingRecovery(idx []byte) (*proto.Entry, *proto.PendingRecovery, error) {
+	prevUpdate, err := ks.getUpdate(idx, math.MaxUint64)
+	if err != nil {
+		return nil, nil, err
+	}
+	recovery, err := ks.getPendingRecovery(idx, math.MaxUint64)
+	if err != nil {
+		return nil, nil, err
+	}
+	if prevUpdate == nil {
+		return nil, recovery, nil
+	}
+	return movedEntry(&prevUpdate.Update.NewEntry.Entry, idx), recovery, nil
+}
+
+// startRecoveryDeterministic returns the recovery that req starts of the entry
+// last updated by prevUpdate, the last epoch head having been issued at
+// lastEpochTime.
+func (ks *Keyserver) startRecoveryDeterministic(prevUpdate *proto.UpdateRequest, req *proto.UpdateRequest, lastEpochTime time.Time) (*proto.PendingRecovery, error) {
+	if err := ks.verifyIndex(req); err != nil {
+		return nil, err
+	}
+	if !registered(prevUpdate) {
+		return nil, grpc.Errorf(codes.NotFound, "user %q is not registered", req.LookupParameters.UserId)
+	}
+	prevEntry := movedEntry(&prevUpdate.Update.NewEntry.Entry, req.Update.NewEntry.Index)
+	notBefore, err := coname.RecoveryNotBefore(prevEntry, lastEpochTime)
+	if err != nil {
+		return nil, withCode(codes.FailedPrecondition, err)
+	}
+	recovery := &proto.PendingRecovery{Update: req.Update, NotBefore: proto.Time(notBefore)}
+	if err := coname.VerifyRecoveryStart(prevEntry, recovery, lastEpochTime); err != nil {
+		return nil, withCode(codes.FailedPrecondition, err)
+	}
+	return recovery, nil
+}
+
+func (ks *Keyserver) verifyRecoveryStartEdge(ctx context.Context, req *proto.UpdateRequest) error {
+	if !ks.recoveryEnabled {
+		return grpc.Errorf(codes.Unimplemented, "this keyserver does not allow recovering entries")
+	}
+	if len(req.Update.NewEntry.Index) != vrf.Size {
+		return grpc.Errorf(codes.InvalidArgument, "index '%x' has wrong length (expected %d)", req.Update.NewEntry.Index, vrf.Size)
+	}
+	prevUpdate, err := ks.getUpdate(req.Update.NewEntry.Index, math.MaxUint64)
+	if err != nil {
+		log.Print(err)
+		return errInternal
+	}
+	if prevUpdate != nil {
+		if policy := prevUpdate.Update.NewEntry.RecoveryPolicy; policy != nil && policy.DelaySeconds < uint64(ks.recoveryMinDelay/time.Second) {
+			return grpc.Errorf(codes.FailedPrecondition, "recovery delay of %d seconds is shorter than the minimum of %s", policy.DelaySeconds, ks.recoveryMinDelay)
+		}
+	}
+	if _, err := ks.startRecoveryDeterministic(prevUpdate, req, ks.clk.Now()); err != nil {
+		return err
+	}
+	return ks.verifyEmailProof(ctx, req)
+}
+
+// StartRecovery implements proto.E2EKSPublicServer.StartRecovery
+func (ks *Keyserver) StartRecovery(ctx context.Context, req *proto.UpdateRequest) (*proto.PendingRecovery, error) {
+	ctx, _ = context.WithTimeout(ctx, ks.clientTimeout)
+	if err := ks.checkRateLimit(ctx, req.LookupParameters.UserId); err != nil {
+		return nil, err
+	}
+	if err := ks.verifyRecoveryStartEdge(ctx, req); err != nil {
+		return nil, err
+	}
+
+	uid := genUID()
+	ch := ks.wr.Wait(uid)
+	ks.log.Propose(ctx, replication.LogEntry{Data: proto.MustMarshal(&proto.KeyserverStep{
+		UID:  uid,
+		Type: &proto.KeyserverStep_RecoveryStart{RecoveryStart: req},
+	})})
+	var recovery *proto.PendingRecovery
+	select {
+	case <-ctx.Done():
+		ks.wr.Notify(uid, nil)
+		return nil, contextError(ctx.Err())
+	case v := <-ch:
+		out := v.(recoveryOutput)
+		if out.Error != nil {
+			return nil, out.Error
+		}
+		recovery = out.Recovery
+	}
+
+	// The recovery has been started regardless of whether the notification
+	// can be delivered, so a failure is not reported to the client.
+	if ks.recoverySMTPRelay != "" {
+		msg := "From: " + ks.recoveryFromAddr + "\r\n" +
+			"To: " + req.LookupParameters.UserId + "\r\n" +
+			"Subject: " + ks.recoverySubject + "\r\n" +
+			"\r\n" +
+			"Somebody has requested to replace the keys of " + req.LookupParameters.UserId + " in " + ks.realm + ".\r\n" +
+			"Unless you cancel the request using your current keys, the new keys can be\r\n" +
+			"published after " + recovery.NotBefore.Time().UTC().Format(time.RFC1123) + ".\r\n"
+		if err := smtp.SendMail(ks.recoverySMTPRelay, nil, ks.recoveryFromAddr, []string{req.LookupParameters.UserId}, []byte(msg)); err != nil {
+			log.Printf("sending recovery notification to %q: %s", req.LookupParameters.UserId, err)
+		}
+	}
+	return recovery, nil
+}
+
+// VetoRecovery implements proto.E2EKSPublicServer.VetoRecovery
+func (ks *Keyserver) VetoRecovery(ctx context.Context, veto *proto.RecoveryVeto) (*proto.PendingRecovery, error) {
+	ctx, _ = context.WithTimeout(ctx, ks.clientTimeout)
+	if len(veto.Index) != vrf.Size {
+		return nil, grpc.Errorf(codes.InvalidArgument, "index '%x' has wrong length (expected %d)", veto.Index, vrf.Size)
+	}
+	prevEntry, recovery, err := ks.getEntryAndPendingRecovery(veto.Index)
+	if err != nil {
+		log.Print(err)
+		return nil, errInternal
+	}
+	if err := coname.VerifyRecoveryVeto(prevEntry, recovery, veto); err != nil {
+		return nil, withCode(codes.FailedPrecondition, err)
+	}
+
+	uid := genUID()
+	ch := ks.wr.Wait(uid)
+	ks.log.Propose(ctx, replication.LogEntry{Data: proto.MustMarshal(&proto.KeyserverStep{
+		UID:  uid,
+		Type: &proto.KeyserverStep_RecoveryVeto{RecoveryVeto: veto},
+	})})
+	select {
+	case <-ctx.Done():
+		ks.wr.Notify(uid, nil)
+		return nil, contextError(ctx.Err())
+	case v := <-ch:
+		out := v.(recoveryOutput)
+		if out.Error != nil {
+			return nil, out.Error
+		}
+		return out.Recovery, nil
+	}
+}
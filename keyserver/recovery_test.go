@@ -0,0 +1,176 @@
+// Copyright 2014-2015 The Dename Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy of
+// the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations under
+// the License.
+
+package keyserver
+
+import (
+	"bytes"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/agl/ed25519"
+	"github.com/yahoo/coname"
+	"github.com/yahoo/coname/proto"
+	"golang.org/x/crypto/sha3"
+	"golang.org/x/net/context"
+)
+
+// recoverableUpdate returns an update of the entry of name to the given
+// version that allows recovery after delaySeconds, signed by a new key.
+func recoverableUpdate(t *testing.T, ks *Keyserver, name string, version, delaySeconds uint64, quorum *proto.QuorumExpr) (
+	req *proto.UpdateRequest, sk *[ed25519.PrivateKeySize]byte, keyid uint64,
+) {
+	edpk, sk, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pk := &proto.PublicKey{PubkeyType: &proto.PublicKey_Ed25519{Ed25519: edpk[:]}}
+	keyid = proto.KeyID(pk)
+	profile := proto.EncodedProfile{Profile: proto.Profile{Nonce: []byte("noncenoncenonceNONCE")}}
+	profile.UpdateEncoding()
+	var commitment [64]byte
+	sha3.ShakeSum256(commitment[:], profile.Encoding)
+	entry := proto.EncodedEntry{Entry: proto.Entry{
+		Index:   ks.vrfSuite.Compute([]byte(name), ks.currentVRFSecret()),
+		Version: version,
+		UpdatePolicy: &proto.AuthorizationPolicy{
+			PublicKeys: map[uint64]*proto.PublicKey{keyid: pk},
+			PolicyType: &proto.AuthorizationPolicy_Quorum{Quorum: &proto.QuorumExpr{
+				Threshold:      1,
+				Candidates:     []uint64{keyid},
+				Subexpressions: []*proto.QuorumExpr{},
+			}},
+		},
+		ProfileCommitment: commitment[:],
+		RecoveryPolicy:    &proto.RecoveryPolicy{DelaySeconds: delaySeconds},
+	}}
+	entry.UpdateEncoding()
+	return &proto.UpdateRequest{
+		Update: &proto.SignedEntryUpdate{
+			NewEntry:   entry,
+			Signatures: map[uint64][]byte{keyid: ed25519.Sign(sk, entry.Encoding)[:]},
+		},
+		Profile: profile,
+		LookupParameters: &proto.LookupRequest{
+			UserId:            name,
+			QuorumRequirement: quorum,
+		},
+		EmailProof: &proto.EmailProof{ProofType: &proto.EmailProof_External{External: &proto.ExternalProof{Type: "test-password", Proof: []byte("password")}}},
+	}, sk, keyid
+}
+
+func recoveryVeto(recovery *proto.PendingRecovery, sk *[ed25519.PrivateKeySize]byte, keyid uint64) *proto.RecoveryVeto {
+	entryHash := make([]byte, 32)
+	sha3.ShakeSum256(entryHash, recovery.Update.NewEntry.Encoding)
+	return &proto.RecoveryVeto{
+		Index:      recovery.Update.NewEntry.Index,
+		EntryHash:  entryHash,
+		Signatures: map[uint64][]byte{keyid: ed25519.Sign(sk, proto.RecoveryVetoMessage(entryHash))[:]},
+	}
+}
+
+func TestKeyserverAccountRecovery(t *testing.T) {
+	dieOnCtrlC()
+	const delaySeconds = 10
+	kss, _, _, _, clks, _, _, clientConfig, teardown := setupRealmWithConfig(t, 3, 1, func(cfg *proto.ReplicaConfig) {
+		cfg.RegistrationPolicy = append(cfg.RegistrationPolicy, &proto.RegistrationPolicy{
+			PolicyType: &proto.RegistrationPolicy_EmailProofByExternalVerifier{EmailProofByExternalVerifier: &proto.EmailProofByExternalVerifier{
+				Type:           "test-password",
+				AllowedDomains: []string{realmDomain},
+			}},
+		})
+		cfg.AccountRecovery = &proto.AccountRecoveryConfig{MinDelay: proto.DurationStamp(delaySeconds * time.Second)}
+	})
+	defer teardown()
+	stop := stoppableSyncedClocks(clks)
+	defer close(stop)
+
+	quorum := clientConfig.Realms[0].VerificationPolicy.GetQuorum()
+	waitForFirstEpoch(kss[0], quorum)
+	ctx := context.Background()
+	lookup := func() *proto.LookupProof {
+		proof, err := kss[0].Lookup(ctx, &proto.LookupRequest{UserId: alice, QuorumRequirement: quorum})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return proof
+	}
+
+	// recovery policies with a delay below the configured minimum are not
+	// honored
+	carol := "carol@" + realmDomain
+	req, _, _ := recoverableUpdate(t, kss[0], carol, 0, delaySeconds-1, quorum)
+	if _, err := kss[0].Update(ctx, req); err != nil {
+		t.Fatal(err)
+	}
+	req, _, _ = recoverableUpdate(t, kss[0], carol, 1, delaySeconds, quorum)
+	if _, err := kss[0].StartRecovery(ctx, req); err == nil {
+		t.Fatalf("recovery started although the delay is below the minimum")
+	}
+
+	registration, oldKey, oldKeyID := recoverableUpdate(t, kss[0], alice, 0, delaySeconds, quorum)
+	if _, err := kss[0].Update(ctx, registration); err != nil {
+		t.Fatal(err)
+	}
+
+	// the owner of the entry vetoes a recovery with the current key
+	req, newKey, newKeyID := recoverableUpdate(t, kss[0], alice, 1, delaySeconds, quorum)
+	recovery, err := kss[0].StartRecovery(ctx, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pending := lookup().PendingRecovery; pending == nil || !pending.Equal(recovery) {
+		t.Fatalf("lookup returned pending recovery %v, expected %v", pending, recovery)
+	}
+	if _, err := kss[0].verifyUpdateDeterministic(registration, recovery, req, recovery.NotBefore.Time().Add(-time.Second)); err == nil {
+		t.Fatalf("recovery completed before its delay")
+	}
+	if _, err := kss[0].VetoRecovery(ctx, recoveryVeto(recovery, newKey, newKeyID)); err == nil {
+		t.Fatalf("recovery vetoed by the new key")
+	}
+	if _, err := kss[0].VetoRecovery(ctx, recoveryVeto(recovery, oldKey, oldKeyID)); err != nil {
+		t.Fatal(err)
+	}
+	if pending := lookup().PendingRecovery; pending != nil {
+		t.Fatalf("lookup returned pending recovery %v after veto", pending)
+	}
+	if _, err := kss[0].Update(ctx, req); err == nil {
+		t.Fatalf("vetoed recovery completed")
+	}
+
+	// without a veto, the recovery completes once an epoch has been issued
+	// after the delay
+	req, _, _ = recoverableUpdate(t, kss[0], alice, 1, delaySeconds, quorum)
+	if _, err := kss[0].StartRecovery(ctx, req); err != nil {
+		t.Fatal(err)
+	}
+	var proof *proto.LookupProof
+	for deadline := time.Now().Add(10 * time.Second); proof == nil; time.Sleep(poll) {
+		if time.Now().After(deadline) {
+			t.Fatalf("recovery did not complete: %s", err)
+		}
+		proof, err = kss[0].Update(ctx, req)
+	}
+	now := clks[0].Now()
+	if _, err := coname.VerifyLookup(clientConfig, alice, proof, now); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(proof.Entry.Encoding, req.Update.NewEntry.Encoding) {
+		t.Fatalf("lookup returned entry %x, expected the recovered entry %x", proof.Entry.Encoding, req.Update.NewEntry.Encoding)
+	}
+	if proof.PendingRecovery != nil {
+		t.Fatalf("lookup returned pending recovery %v after completion", proof.PendingRecovery)
+	}
+}
@@ -0,0 +1,161 @@
+// Copyright 2014-2015 The Dename Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy of
+// the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations under
+// the License.
+
+package keyserver
+
+import (
+	"bytes"
+	"log"
+	"time"
+
+	"github.com/yahoo/coname"
+	"github.com/yahoo/coname/keyserver/kv"
+	"github.com/yahoo/coname/proto"
+)
+
+// replicaSignedRefresh is called by step for a ReplicaSigned step whose head is
+// not the epoch head in tableEpochHeads. The only other heads replicas sign
+// are refreshes of the last epoch; signatures of refreshes that have been
+// superseded are ignored. Once the current refresh has been signed by the
+// keyserver, it is appended to the verifier log for verifiers to sign. No i/o
+// allowed.
+func (ks *Keyserver) replicaSignedRefresh(newSEH *proto.SignedEpochHead, tehBytes []byte, rs *proto.ReplicaState, wb kv.Batch) (deferredIO func()) {
+	epochNr := newSEH.Head.Head.Epoch
+	var teh proto.EncodedTimestampedEpochHead
+	if err := teh.Unmarshal(tehBytes); err != nil {
+		log.Panicf("invalid epoch head %d (%x): %s", epochNr, tehBytes, err)
+	}
+	if !bytes.Equal(teh.Head.Encoding, newSEH.Head.Head.Encoding) {
+		log.Panicf("replica signed different head: wanted %x, got %x", newSEH.Head.Encoding, tehBytes)
+	}
+	if epochNr != rs.LastEpochDelimiter.EpochNumber || newSEH.Head.Timestamp.Time().Before(rs.LastEpochRefresh.Time()) {
+		return // the signature of a refresh that has been superseded
+	}
+	if refreshed := refreshedEpochHead(&teh, rs.LastEpochRefresh); !bytes.Equal(refreshed.Encoding, newSEH.Head.Encoding) {
+		log.Panicf("replica signed different refresh: wanted %x, got %x", newSEH.Head.Encoding, refreshed.Encoding)
+	}
+
+	// each signature of the refresh replaces the signature of the epoch head
+	// (or an earlier refresh) by the same replica
+	newSehBytes := proto.MustMarshal(newSEH)
+	for id := range newSEH.Signatures {
+		wb.Put(tableRatifications(epochNr, id), newSehBytes)
+	}
+	deferredIO = func() {
+		// As in step, first write to DB, *then* notify subscribers.
+		ks.signatureBroadcast.Publish(epochNr, newSEH)
+	}
+
+	if rs.ThisReplicaNeedsToSignLastEpoch && newSEH.Signatures[ks.replicaID] != nil {
+		rs.ThisReplicaNeedsToSignLastEpoch = false
+		ks.updateEpochProposer()
+		// see the corresponding comment in step
+		ks.updateSignatureProposer()
+	}
+	// get all existing signatures of this refresh
+	allSignatures := make(map[uint64][]byte)
+	existingRatifications, err := ks.allRatificationsForEpoch(epochNr)
+	if err != nil {
+		log.Panicf("allRatificationsForEpoch(%d): %s", epochNr, err)
+	}
+	for _, seh := range existingRatifications {
+		if !bytes.Equal(seh.Head.Encoding, newSEH.Head.Encoding) {
+			continue
+		}
+		for id, sig := range seh.Signatures {
+			allSignatures[id] = sig
+		}
+	}
+	if coname.VerifyPolicy(ks.serverAuthorized, newSEH.Head.Encoding, allSignatures) {
+		return // already signed by the keyserver
+	}
+	for id, sig := range newSEH.Signatures {
+		allSignatures[id] = sig
+	}
+	if !coname.VerifyPolicy(ks.serverAuthorized, newSEH.Head.Encoding, allSignatures) {
+		return
+	}
+	refreshSEH := &proto.SignedEpochHead{
+		Head:       newSEH.Head,
+		Signatures: allSignatures,
+	}
+	rs.LastEpochRefreshNeedsRatification = false
+	ks.updateEpochProposer()
+	if rs.PendingUpdates {
+		// verifiers do not sign a refresh that comes after changes to the
+		// directory in the verifier log, the next epoch will have them
+		return deferredIO
+	}
+	oldDeferredIO := deferredIO
+	deferredSendRefresh := ks.verifierLogAppend(&proto.VerifierStep{Type: &proto.VerifierStep_EpochRefresh{EpochRefresh: refreshSEH}}, rs, wb)
+	return func() {
+		oldDeferredIO()
+		deferredSendRefresh()
+	}
+}
+
+// isSupersededRatification returns true if the ratification at dbkey is of the
+// same epoch head as seh but of a later refresh, i.e., if seh was pushed late.
+// A ratification of the last refresh is never superseded, and it supersedes
+// all other ratifications of the last epoch: the timestamps chosen by the
+// signers are only compared otherwise, because the clocks of verifiers and
+// the keyserver may disagree. No i/o other than reading the db allowed.
+func (ks *Keyserver) isSupersededRatification(dbkey []byte, seh *proto.SignedEpochHead, rs *proto.ReplicaState) bool {
+	if isLastRefresh(seh, rs) {
+		return false
+	}
+	oldBytes, err := ks.db.Get(dbkey)
+	switch err {
+	case nil:
+	case ks.db.ErrNotFound():
+		return false
+	default:
+		log.Panicf("get %x: %s", dbkey, err)
+	}
+	old := new(proto.SignedEpochHead)
+	if err := old.Unmarshal(oldBytes); err != nil {
+		log.Panicf("%x: invalid ratification %x: %s", dbkey, oldBytes, err)
+	}
+	return bytes.Equal(old.Head.Head.Encoding, seh.Head.Head.Encoding) &&
+		(isLastRefresh(old, rs) || old.Head.Timestamp.Time().After(seh.Head.Timestamp.Time()))
+}
+
+// isLastRefresh returns true if seh is a ratification of the last refresh of
+// the last epoch.
+func isLastRefresh(seh *proto.SignedEpochHead, rs *proto.ReplicaState) bool {
+	return seh.Head.Head.Epoch == rs.LastEpochDelimiter.EpochNumber &&
+		lastEpochTime(rs).After(rs.LastEpochDelimiter.Timestamp.Time()) &&
+		seh.Head.Timestamp.Time().Equal(rs.LastEpochRefresh.Time())
+}
+
+// lastEpochTime returns the timestamp of the last epoch delimiter, or of the
+// last refresh of that epoch if there has been one.
+func lastEpochTime(rs *proto.ReplicaState) time.Time {
+	if t := rs.LastEpochRefresh.Time(); t.After(rs.LastEpochDelimiter.Timestamp.Time()) {
+		return t
+	}
+	return rs.LastEpochDelimiter.Timestamp.Time()
+}
+
+// refreshedEpochHead returns a copy of teh with the timestamp t, marked as a
+// refresh.
+func refreshedEpochHead(teh *proto.EncodedTimestampedEpochHead, t proto.Timestamp) *proto.EncodedTimestampedEpochHead {
+	ret := &proto.EncodedTimestampedEpochHead{TimestampedEpochHead: proto.TimestampedEpochHead{
+		Head:      teh.Head,
+		Timestamp: t,
+		Refresh:   true,
+	}, Encoding: nil}
+	ret.UpdateEncoding()
+	return ret
+}
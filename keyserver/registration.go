@@ -0,0 +1,220 @@
+// Copyright 2014-2015 The Dename Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy of
+// the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations under
+// the License.
+
+package keyserver
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/yahoo/coname/keyserver/dkim"
+	"github.com/yahoo/coname/keyserver/oidc"
+	"github.com/yahoo/coname/keyserver/saml"
+	"github.com/yahoo/coname/proto"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// RegistrationVerifier checks the email proof that comes with the
+// registration of a new user ID. entryHash is the SHAKE256 hash (32 bytes) of
+// the encoding of the entry being registered; a proof that is not bound to
+// the request in some other way must commit to it. Verify returns nil to
+// accept the registration, an error returned by ProofExpired if the proof
+// would have been accepted earlier, and any other error to reject it.
+// The domain of userID has already been checked against the allowed domains
+// of the registration policy.
+type RegistrationVerifier interface {
+	Verify(ctx context.Context, userID string, entryHash []byte, proof *proto.EmailProof) error
+}
+
+// RegistrationVerifierFunc adapts an ordinary function to a
+// RegistrationVerifier.
+type RegistrationVerifierFunc func(ctx context.Context, userID string, entryHash []byte, proof *proto.EmailProof) error
+
+// Verify calls f.
+func (f RegistrationVerifierFunc) Verify(ctx context.Context, userID string, entryHash []byte, proof *proto.EmailProof) error {
+	return f(ctx, userID, entryHash, proof)
+}
+
+// ProofExpired wraps err to tell the client that the email proof has expired
+// and a fresh one is needed. The client sees codes.Unauthenticated, while
+// other rejections of a proof are reported as codes.PermissionDenied.
+func ProofExpired(err error) error {
+	return grpc.Errorf(codes.Unauthenticated, "%s", err)
+}
+
+// builtinProofTypes are the names of the EmailProof fields the keyserver
+// verifies itself.
+var builtinProofTypes = map[string]struct{}{
+	"dkim_proof":     {},
+	"oidc_token":     {},
+	"saml_response":  {},
+	"challenge_code": {},
+	"client_cert":    {},
+}
+
+var (
+	externalVerifiersMu sync.Mutex
+	externalVerifiers   = make(map[string]RegistrationVerifier)
+)
+
+// RegisterVerifier makes v check the ExternalProofs of type proofType in
+// keyservers opened afterwards with an EmailProofByExternalVerifier policy
+// for that type. It panics if called twice for the same type.
+func RegisterVerifier(proofType string, v RegistrationVerifier) {
+	externalVerifiersMu.Lock()
+	defer externalVerifiersMu.Unlock()
+	if v == nil {
+		panic("keyserver: RegisterVerifier verifier is nil")
+	}
+	if _, ok := builtinProofTypes[proofType]; ok {
+		panic("keyserver: RegisterVerifier called for built-in proof type " + proofType)
+	}
+	if _, dup := externalVerifiers[proofType]; dup {
+		panic("keyserver: RegisterVerifier called twice for proof type " + proofType)
+	}
+	externalVerifiers[proofType] = v
+}
+
+func externalVerifier(proofType string) (RegistrationVerifier, bool) {
+	externalVerifiersMu.Lock()
+	defer externalVerifiersMu.Unlock()
+	v, ok := externalVerifiers[proofType]
+	return v, ok
+}
+
+// emailProofType returns the key of the registration policy proof is checked
+// by: the name of the EmailProof field that is set, or the type of an
+// ExternalProof.
+func emailProofType(proof *proto.EmailProof) string {
+	switch t := proof.ProofType.(type) {
+	case *proto.EmailProof_DKIMProof:
+		return "dkim_proof"
+	case *proto.EmailProof_OIDCToken:
+		return "oidc_token"
+	case *proto.EmailProof_SAMLResponse:
+		return "saml_response"
+	case *proto.EmailProof_ChallengeCode:
+		return "challenge_code"
+	case *proto.EmailProof_ClientCert:
+		return "client_cert"
+	case *proto.EmailProof_External:
+		return t.External.Type
+	}
+	return ""
+}
+
+// registrationPolicy is an enabled way of proving the ownership of an email
+// address.
+type registrationPolicy struct {
+	allowedDomains map[string]struct{}
+	verifier       RegistrationVerifier
+}
+
+// allowRegistrations makes the keyserver accept registrations in domains
+// with proofs of proofType as checked by v. Calling it again for the same
+// proofType adds domains.
+func (ks *Keyserver) allowRegistrations(proofType string, domains []string, v RegistrationVerifier) {
+	p, ok := ks.registrationPolicies[proofType]
+	if !ok {
+		p = &registrationPolicy{allowedDomains: make(map[string]struct{}), verifier: v}
+		ks.registrationPolicies[proofType] = p
+	}
+	for _, d := range domains {
+		p.allowedDomains[d] = struct{}{}
+	}
+}
+
+// registrationPolicy returns the policy for proofs of proofType in the domain
+// of userID, or an error if there is none.
+func (ks *Keyserver) registrationPolicy(proofType, userID string) (*registrationPolicy, error) {
+	lastAtIndex := strings.LastIndex(userID, "@")
+	if lastAtIndex == -1 {
+		return nil, grpc.Errorf(codes.InvalidArgument, "requested user id is not a valid email address: %q", userID)
+	}
+	p, ok := ks.registrationPolicies[proofType]
+	if !ok {
+		return nil, grpc.Errorf(codes.InvalidArgument, "Invalid email proof type: %q", proofType)
+	}
+	if _, ok := p.allowedDomains[userID[lastAtIndex+1:]]; !ok {
+		return nil, grpc.Errorf(codes.PermissionDenied, "domain not in registration whitelist: %q", userID[lastAtIndex+1:])
+	}
+	return p, nil
+}
+
+func (ks *Keyserver) verifyDKIMProof(ctx context.Context, userID string, entryHash []byte, proof *proto.EmailProof) error {
+	email, payload, err := dkim.CheckEmailProof(proof.GetDKIMProof(), ks.dkimProofToAddr, ks.dkimProofSubjectPrefix, ks.lookupTXT, ks.clk.Now)
+	if err != nil {
+		return fmt.Errorf("failed to verify DKIM proof: %s", err)
+	}
+	if got, want := email, userID; got != want {
+		return fmt.Errorf("requested user ID does not match the email proof: %q != %q", got, want)
+	}
+	proofHash, err := base64.StdEncoding.DecodeString(payload)
+	if err != nil {
+		return fmt.Errorf("bad base64 in email proof: %q", payload)
+	}
+	if !bytes.Equal(proofHash, entryHash) {
+		return fmt.Errorf("email proof does not match requested entry: %s vs %s", base64.StdEncoding.EncodeToString(entryHash), payload)
+	}
+	return nil
+}
+
+func (ks *Keyserver) verifyOIDCProof(ctx context.Context, userID string, entryHash []byte, proof *proto.EmailProof) error {
+	oc := ks.oidcConfig(userID[strings.LastIndex(userID, "@")+1:])
+	if oc == nil {
+		return fmt.Errorf("domain not in registration whitelist: %q", userID[strings.LastIndex(userID, "@")+1:])
+	}
+	email, err := oc.oidcClient.VerifyIDToken(proof.GetOIDCToken())
+	if err != nil {
+		if _, ok := err.(*oidc.ErrExpired); ok {
+			return ProofExpired(err)
+		}
+		return err
+	}
+	if got, want := email, userID; got != want {
+		return fmt.Errorf("requested user ID does not match the email proof: %q != %q", got, want)
+	}
+	return nil
+}
+
+func (ks *Keyserver) verifySAMLProof(ctx context.Context, userID string, entryHash []byte, proof *proto.EmailProof) error {
+	email, err := ks.verifySAMLResponse(userID[strings.LastIndex(userID, "@")+1:], proof.GetSAMLResponse())
+	if err != nil {
+		if _, ok := err.(*saml.ErrExpired); ok {
+			return ProofExpired(err)
+		}
+		return err
+	}
+	if got, want := email, userID; got != want {
+		return fmt.Errorf("requested user ID does not match the email proof: %q != %q", got, want)
+	}
+	return nil
+}
+
+func (ks *Keyserver) verifyChallengeProof(ctx context.Context, userID string, entryHash []byte, proof *proto.EmailProof) error {
+	index := ks.vrfSuite.Compute([]byte(userID), ks.currentVRFSecret())
+	challenge, err := ks.verifyEmailChallenge(index, userID, entryHash, proof.GetChallengeCode())
+	if err != nil {
+		return err
+	}
+	if ks.clk.Now().After(challenge.Expiration.Time()) {
+		return ProofExpired(fmt.Errorf("email challenge expired at %s", challenge.Expiration.Time()))
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+// Copyright 2014-2015 The Dename Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy of
+// the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations under
+// the License.
+
+package keyserver
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/yahoo/coname"
+	"github.com/yahoo/coname/proto"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+func init() {
+	// accepts "password" as the proof for everyone, "expired" is rejected as
+	// expired
+	RegisterVerifier("test-password", RegistrationVerifierFunc(func(ctx context.Context, userID string, entryHash []byte, proof *proto.EmailProof) error {
+		if len(entryHash) != 32 {
+			return fmt.Errorf("entry hash has wrong length %d", len(entryHash))
+		}
+		switch string(proof.GetExternal().Proof) {
+		case "password":
+			return nil
+		case "expired":
+			return ProofExpired(fmt.Errorf("password expired"))
+		}
+		return fmt.Errorf("wrong password for %q", userID)
+	}))
+}
+
+func TestKeyserverExternalRegistration(t *testing.T) {
+	dieOnCtrlC()
+	kss, _, _, _, clks, _, _, clientConfig, teardown := setupRealmWithConfig(t, 3, 0, func(cfg *proto.ReplicaConfig) {
+		cfg.RegistrationPolicy = append(cfg.RegistrationPolicy, &proto.RegistrationPolicy{
+			PolicyType: &proto.RegistrationPolicy_EmailProofByExternalVerifier{EmailProofByExternalVerifier: &proto.EmailProofByExternalVerifier{
+				Type:           "test-password",
+				AllowedDomains: []string{realmDomain},
+			}},
+		})
+	})
+	defer teardown()
+	stop := stoppableSyncedClocks(clks)
+	defer close(stop)
+
+	waitForFirstEpoch(kss[0], clientConfig.Realms[0].VerificationPolicy.GetQuorum())
+	quorum := clientConfig.Realms[0].VerificationPolicy.GetQuorum()
+
+	external := func(typ, proof string) *proto.EmailProof {
+		return &proto.EmailProof{ProofType: &proto.EmailProof_External{External: &proto.ExternalProof{Type: typ, Proof: []byte(proof)}}}
+	}
+	req, _ := newRegistration(t, kss[0], alice, quorum)
+	for _, tc := range []struct {
+		proof *proto.EmailProof
+		code  codes.Code
+	}{
+		{external("test-password", "wrong"), codes.PermissionDenied},
+		{external("test-password", "expired"), codes.Unauthenticated},
+		{external("other", "password"), codes.InvalidArgument},
+		{&proto.EmailProof{ProofType: &proto.EmailProof_OIDCToken{OIDCToken: "password"}}, codes.InvalidArgument},
+	} {
+		req.EmailProof = tc.proof
+		err := kss[0].verifyUpdateEdge(context.Background(), req)
+		if err == nil {
+			t.Fatalf("registration went through with email proof %v", tc.proof)
+		}
+		if got := grpc.Code(err); got != tc.code {
+			t.Errorf("registration with email proof %v: got error %q (code %s), want code %s", tc.proof, err, got, tc.code)
+		}
+	}
+
+	bob := "bob@example.com"
+	req, _ = newRegistration(t, kss[0], bob, quorum)
+	req.EmailProof = external("test-password", "password")
+	if err := kss[0].verifyUpdateEdge(context.Background(), req); grpc.Code(err) != codes.PermissionDenied {
+		t.Fatalf("registration for a domain that is not allowed returned %v", err)
+	}
+
+	req, _ = newRegistration(t, kss[0], alice, quorum)
+	req.EmailProof = external("test-password", "password")
+	now := clks[0].Now()
+	proof, err := kss[0].Update(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := coname.VerifyLookup(clientConfig, alice, proof, now); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -2,7 +2,11 @@ package keyserver
 
 import (
 	"fmt"
+	"log"
+
 	"github.com/yahoo/coname/keyserver/saml"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 )
 
 type SAMLReq struct {
@@ -10,15 +14,107 @@ type SAMLReq struct {
 	IDPSSOURL string
 }
 
+// refresh fetches the identity provider metadata again. If the certificate
+// has changed, the previous one is kept until the next refresh so that
+// responses signed just before the rollover are still accepted.
+func (sc *SAMLConfig) refresh() error {
+	url, cert, err := sc.fetchIDPInfo(sc.metadataURL)
+	if err != nil {
+		return err
+	}
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+	sc.idpSSOURL = url
+	if len(sc.idpCerts) != 0 && !sc.idpCerts[0].Equal(cert) {
+		sc.idpCerts = append(sc.idpCerts[:0:0], cert, sc.idpCerts[0])
+	} else {
+		sc.idpCerts = append(sc.idpCerts[:0:0], cert)
+	}
+	return nil
+}
+
+// samlConfig returns the identity provider configured for domain, or nil.
+func (ks *Keyserver) samlConfig(domain string) *SAMLConfig {
+	for _, sc := range ks.samlProofConfig {
+		if _, ok := sc.allowedDomains[domain]; ok {
+			return sc
+		}
+	}
+	return nil
+}
+
 // SAMLRequest constructs the redirect URL with SAMLRequest
-// as a query string parameter
-func (ks *Keyserver) SAMLRequest() (string, error) {
-	if len(ks.samlProofAllowedDomains) == 0 {
-		return "", fmt.Errorf("No domains configured for SAML auth")
+// as a query string parameter for the identity provider of domain. If domain
+// is "", the only configured identity provider is used.
+func (ks *Keyserver) SAMLRequest(domain string) (string, error) {
+	sc := ks.samlConfig(domain)
+	if domain == "" {
+		if len(ks.samlProofConfig) != 1 {
+			return "", grpc.Errorf(codes.InvalidArgument, "domain not specified and %d SAML identity providers are configured", len(ks.samlProofConfig))
+		}
+		sc = ks.samlProofConfig[0]
 	}
-	payload, err := saml.GenerateSAMLRequest(ks.samlProofIDPCert, ks.samlProofSPKey, ks.samlProofConsumerServiceURL, ks.samlProofIDPSSOURL)
+	if sc == nil {
+		return "", grpc.Errorf(codes.NotFound, "domain %q NOT configured for SAML auth", domain)
+	}
+	sc.mu.Lock()
+	cert, ssoURL := sc.idpCerts[0], sc.idpSSOURL
+	sc.mu.Unlock()
+	payload, err := saml.GenerateSAMLRequest(cert, ks.samlProofSPKey, ks.samlProofConsumerServiceURL, ssoURL)
 	if err != nil {
-		return "", err
+		log.Printf("GenerateSAMLRequest: %s", err)
+		return "", errInternal
+	}
+	return ssoURL + "?SAMLRequest=" + payload, nil
+}
+
+// verifySAMLResponse checks the SAML response against every certificate the
+// identity provider of domain currently has and returns the email address
+// asserted in it.
+func (ks *Keyserver) verifySAMLResponse(domain, payload string) (string, error) {
+	sc := ks.samlConfig(domain)
+	if sc == nil {
+		return "", fmt.Errorf("domain not in registration whitelist: %q", domain)
+	}
+	sc.mu.Lock()
+	certs := sc.idpCerts
+	sc.mu.Unlock()
+	var firstErr error
+	for _, cert := range certs {
+		email, err := saml.VerifySAMLResponse(payload, cert, ks.samlProofConsumerServiceURL, "EmailAddress", ks.samlProofValidity)
+		if err == nil {
+			return email, nil
+		}
+		if _, ok := err.(*saml.ErrExpired); ok {
+			return "", err
+		}
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+	return "", firstErr
+}
+
+// refreshSAMLMetadata periodically fetches the metadata of all configured
+// identity providers until the keyserver is stopped. A provider whose
+// metadata cannot be fetched keeps its previous certificates.
+func (ks *Keyserver) refreshSAMLMetadata() {
+	defer close(ks.samlMetadataStopped)
+	if len(ks.samlProofConfig) == 0 || ks.samlProofMetadataRefreshInterval == 0 {
+		return
+	}
+	ticker := ks.clk.Ticker(ks.samlProofMetadataRefreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ks.stop:
+			return
+		case <-ticker.C:
+			for _, sc := range ks.samlProofConfig {
+				if err := sc.refresh(); err != nil {
+					log.Printf("refreshing SAML metadata from %q: %s", sc.metadataURL, err)
+				}
+			}
+		}
 	}
-	return ks.samlProofIDPSSOURL + "?SAMLRequest=" + payload, nil
 }
@@ -1,17 +1,30 @@
 package keyserver
 
 import (
+	"crypto/rand"
+	"crypto/rsa"
 	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
 	"encoding/pem"
+	"fmt"
 	"io/ioutil"
+	"math/big"
 	"os/exec"
 	"strings"
+	"sync"
 	"testing"
+	"time"
+
+	"github.com/andres-erbsen/clock"
+	gosaml "github.com/maditya/go-saml"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 )
 
 func TestSAMLRequest(t *testing.T) {
 	ks := Keyserver{}
-	_, err := ks.SAMLRequest()
+	_, err := ks.SAMLRequest("foomail.com")
 	if err == nil {
 		t.Fatal("expected SAMLRequest() to fail, but succeeded")
 	}
@@ -20,11 +33,6 @@ func TestSAMLRequest(t *testing.T) {
 	for _, d := range []string{"foomail.com", "barmail.com"} {
 		domains[d] = struct{}{}
 	}
-	ks = Keyserver{samlProofAllowedDomains: domains}
-	_, err = ks.SAMLRequest()
-	if err == nil {
-		t.Fatal("expected SAMLRequest() to fail, but succeeded")
-	}
 	k, err := ioutil.ReadFile("saml/test.key")
 	if err != nil {
 		t.Errorf(err.Error())
@@ -54,17 +62,218 @@ func TestSAMLRequest(t *testing.T) {
 		t.Errorf(err.Error())
 		return
 	}
+	idps := []*SAMLConfig{
+		{allowedDomains: domains, idpCerts: []*x509.Certificate{cert}, idpSSOURL: "https://idp.bob"},
+		{allowedDomains: map[string]struct{}{"bazmail.com": {}}, idpCerts: []*x509.Certificate{cert}, idpSSOURL: "https://idp.carol"},
+	}
+	ks = Keyserver{samlProofConfig: idps, samlProofSPKey: privateKey, samlProofConsumerServiceURL: "https://ks.alice.wonderland"}
+	if _, err := ks.SAMLRequest("quxmail.com"); grpc.Code(err) != codes.NotFound {
+		t.Fatalf("expected SAMLRequest() to fail for an unconfigured domain, got %v", err)
+	}
+	if _, err := ks.SAMLRequest(""); grpc.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected SAMLRequest() without a domain to fail with two identity providers, got %v", err)
+	}
+
 	_, err = exec.LookPath("xmlsec1")
 	if err != nil {
 		t.Skip("skipping subsequent test since xmlsec1 is missing")
 	}
-	ks = Keyserver{samlProofAllowedDomains: domains, samlProofIDPCert: cert, samlProofSPKey: privateKey, samlProofConsumerServiceURL: "https://ks.alice.wonderland", samlProofIDPSSOURL: "https://idp.bob"}
-	req, err := ks.SAMLRequest()
+	for domain, p := range map[string]string{
+		"barmail.com": "https://idp.bob?SAMLRequest=",
+		"bazmail.com": "https://idp.carol?SAMLRequest=",
+	} {
+		req, err := ks.SAMLRequest(domain)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.HasPrefix(req, p) {
+			t.Errorf("got request url %q for %s, expected it to begin with %q", req, domain, p)
+		}
+	}
+	ks.samlProofConfig = idps[1:]
+	req, err := ks.SAMLRequest("")
 	if err != nil {
 		t.Fatal(err)
 	}
-	p := "https://idp.bob?SAMLRequest="
-	if !strings.HasPrefix(req, p) {
-		t.Errorf("got request url %q, expected it to begin with %q", req, p)
+	if p := "https://idp.carol?SAMLRequest="; !strings.HasPrefix(req, p) {
+		t.Errorf("got request url %q without a domain, expected it to begin with %q", req, p)
+	}
+}
+
+// fakeIDPMetadata stands in for saml.FetchIDPInfo, serving the metadata that
+// is set last.
+type fakeIDPMetadata struct {
+	mu     sync.Mutex
+	ssoURL string
+	cert   *x509.Certificate
+	err    error
+	nFetch int
+}
+
+func (m *fakeIDPMetadata) set(ssoURL string, cert *x509.Certificate, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.ssoURL, m.cert, m.err = ssoURL, cert, err
+}
+
+func (m *fakeIDPMetadata) fetch(url string) (string, *x509.Certificate, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.nFetch++
+	return m.ssoURL, m.cert, m.err
+}
+
+func (m *fakeIDPMetadata) fetches() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.nFetch
+}
+
+func TestSAMLMetadataRefreshKeepsPreviousCertificate(t *testing.T) {
+	certA, certB := &x509.Certificate{Raw: []byte("A")}, &x509.Certificate{Raw: []byte("B")}
+	m := new(fakeIDPMetadata)
+	sc := &SAMLConfig{metadataURL: "https://idp.example.com/metadata", fetchIDPInfo: m.fetch}
+	for i, step := range []struct {
+		ssoURL string
+		cert   *x509.Certificate
+		err    error
+
+		wantURL   string
+		wantCerts []*x509.Certificate
+	}{
+		{"https://idp.example.com/sso", certA, nil, "https://idp.example.com/sso", []*x509.Certificate{certA}},
+		{"https://idp.example.com/sso", certA, nil, "https://idp.example.com/sso", []*x509.Certificate{certA}},
+		// a new certificate overlaps with the previous one...
+		{"https://idp.example.com/sso2", certB, nil, "https://idp.example.com/sso2", []*x509.Certificate{certB, certA}},
+		// ...until the refresh after that
+		{"https://idp.example.com/sso2", certB, nil, "https://idp.example.com/sso2", []*x509.Certificate{certB}},
+		{"https://idp.example.com/sso2", certA, nil, "https://idp.example.com/sso2", []*x509.Certificate{certA, certB}},
+		// a failed refresh changes nothing
+		{"", nil, fmt.Errorf("metadata unavailable"), "https://idp.example.com/sso2", []*x509.Certificate{certA, certB}},
+	} {
+		m.set(step.ssoURL, step.cert, step.err)
+		if err := sc.refresh(); err != step.err {
+			t.Errorf("%d: refresh() = %v, want %v", i, err, step.err)
+		}
+		sc.mu.Lock()
+		gotURL, gotCerts := sc.idpSSOURL, sc.idpCerts
+		sc.mu.Unlock()
+		if gotURL != step.wantURL {
+			t.Errorf("%d: SSO URL %q, want %q", i, gotURL, step.wantURL)
+		}
+		if len(gotCerts) != len(step.wantCerts) {
+			t.Errorf("%d: %d certificates, want %d", i, len(gotCerts), len(step.wantCerts))
+			continue
+		}
+		for j := range gotCerts {
+			if gotCerts[j] != step.wantCerts[j] {
+				t.Errorf("%d: certificate %d is %q, want %q", i, j, gotCerts[j].Raw, step.wantCerts[j].Raw)
+			}
+		}
+	}
+}
+
+func TestSAMLMetadataRefreshPeriodically(t *testing.T) {
+	certA, certB := &x509.Certificate{Raw: []byte("A")}, &x509.Certificate{Raw: []byte("B")}
+	m := new(fakeIDPMetadata)
+	m.set("https://idp.example.com/sso", certA, nil)
+	sc := &SAMLConfig{metadataURL: "https://idp.example.com/metadata", fetchIDPInfo: m.fetch}
+	if err := sc.refresh(); err != nil {
+		t.Fatal(err)
+	}
+	clk := clock.NewMock()
+	ks := &Keyserver{
+		clk:                              clk,
+		stop:                             make(chan struct{}),
+		samlMetadataStopped:              make(chan struct{}),
+		samlProofConfig:                  []*SAMLConfig{sc},
+		samlProofMetadataRefreshInterval: time.Hour,
+	}
+	go ks.refreshSAMLMetadata()
+
+	m.set("https://idp.example.com/sso", certB, nil)
+	for deadline := time.Now().Add(10 * time.Second); m.fetches() < 2; {
+		if time.Now().After(deadline) {
+			t.Fatal("metadata was not refreshed")
+		}
+		clk.Add(time.Hour)
+	}
+	close(ks.stop)
+	<-ks.samlMetadataStopped
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+	if len(sc.idpCerts) == 0 || sc.idpCerts[0] != certB {
+		t.Errorf("refreshed certificates are %v, want certificate B first", sc.idpCerts)
+	}
+}
+
+func TestSAMLResponseDuringCertificateOverlap(t *testing.T) {
+	_, err := exec.LookPath("xmlsec1")
+	if err != nil {
+		t.Skip("skipping test since xmlsec1 is missing")
+	}
+	k, err := ioutil.ReadFile("saml/test.key")
+	if err != nil {
+		t.Fatal(err)
+	}
+	kd, _ := pem.Decode(k)
+	privateKey, err := x509.ParsePKCS1PrivateKey(kd.Bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certPem, err := ioutil.ReadFile("saml/test.crt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	certBlock, _ := pem.Decode(certPem)
+	if certBlock == nil {
+		t.Fatal("failed to PEM decode cert")
+	}
+	oldCert, err := x509.ParseCertificate(certBlock.Bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	newKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "idp"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	newCertDER, err := x509.CreateCertificate(rand.Reader, template, template, &newKey.PublicKey, newKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	newCert, err := x509.ParseCertificate(newCertDER)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// a response signed with the key of the certificate that was just
+	// replaced
+	issuer := "https://idp.example.com"
+	authnResponse := gosaml.NewSignedResponse()
+	authnResponse.Issuer.Url = issuer
+	authnResponse.Destination = "https://ks.alice.wonderland"
+	authnResponse.Assertion.Issuer.Url = issuer
+	authnResponse.AddAttribute("EmailAddress", "alice@foomail.com")
+	authnResponse.Assertion.Subject.SubjectConfirmation.SubjectConfirmationData.Recipient = "https://ks.alice.wonderland"
+	authnResponse.Signature.KeyInfo.X509Data.X509Certificate.Cert = base64.StdEncoding.EncodeToString(certBlock.Bytes)
+	payload, err := authnResponse.EncodedSignedString(privateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sc := &SAMLConfig{allowedDomains: map[string]struct{}{"foomail.com": {}}, idpCerts: []*x509.Certificate{newCert, oldCert}}
+	ks := Keyserver{samlProofConfig: []*SAMLConfig{sc}, samlProofConsumerServiceURL: "https://ks.alice.wonderland", samlProofValidity: time.Minute}
+	if email, err := ks.verifySAMLResponse("foomail.com", payload); err != nil || email != "alice@foomail.com" {
+		t.Errorf("verifySAMLResponse() during the overlap = %q, %v", email, err)
+	}
+	sc.idpCerts = sc.idpCerts[:1]
+	if _, err := ks.verifySAMLResponse("foomail.com", payload); err == nil {
+		t.Error("verifySAMLResponse() accepted a response signed under a certificate that is no longer in the metadata")
 	}
 }
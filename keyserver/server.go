@@ -34,7 +34,6 @@ import (
 	"golang.org/x/crypto/sha3"
 	"golang.org/x/net/context"
 
-	"github.com/agl/ed25519"
 	"github.com/andres-erbsen/clock"
 	"github.com/yahoo/coname"
 	"github.com/yahoo/coname/concurrent"
@@ -46,9 +45,13 @@ import (
 	"github.com/yahoo/coname/keyserver/replication"
 	"github.com/yahoo/coname/keyserver/saml"
 	"github.com/yahoo/coname/proto"
+	"github.com/yahoo/coname/ratelimit"
+	"github.com/yahoo/coname/signer"
+	"github.com/yahoo/coname/smtpfront"
 	"github.com/yahoo/coname/vrf"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
 )
 
@@ -58,31 +61,71 @@ type Keyserver struct {
 	serverID, replicaID uint64
 	serverAuthorized    *proto.AuthorizationPolicy
 
-	sehKey    *[ed25519.PrivateKeySize]byte
+	sehSigner signer.Signer
+
+	// vrfSuite is the VRF that the directory is indexed with.
+	vrfSuite vrf.Suite
+	// vrfSecrets holds the VRF keys named by VRFKeyID and NextVRFKeyID, keyed
+	// by public key. vrfPublic is that of VRFKeyID, which indexes the directory
+	// until the first VRF key rotation; nextVRFPublic is that of NextVRFKeyID,
+	// nil if there is none.
+	vrfSecrets    map[string]*[vrf.SecretKeySize]byte
+	vrfPublic     []byte
+	nextVRFPublic []byte
+	// vrfSecret indexes the latest tree. step changes it when the VRF key is
+	// rotated, see vrfrotation.go.
+	vrfMu     sync.RWMutex
 	vrfSecret *[vrf.SecretKeySize]byte
 
-	dkimProofToAddr         string
-	dkimProofSubjectPrefix  string
-	dkimProofAllowedDomains map[string]struct{}
-	oidcProofConfig         []OIDCConfig
+	// registrationPolicies are keyed on emailProofType
+	registrationPolicies map[string]*registrationPolicy
 
-	samlProofAllowedDomains     map[string]struct{}
-	samlProofIDPSSOURL          string
-	samlProofConsumerServiceURL string
-	samlProofIDPCert            *x509.Certificate
-	samlProofSPKey              crypto.PrivateKey
-	samlProofValidity           time.Duration
+	dkimProofToAddr           string
+	dkimProofSubjectPrefix    string
+	dkimPendingUpdateValidity time.Duration
+	dkimMaxEmailSize          int
+	oidcProofConfig        []OIDCConfig
+
+	samlProofConfig                  []*SAMLConfig
+	samlProofConsumerServiceURL      string
+	samlProofSPKey                   crypto.PrivateKey
+	samlProofValidity                time.Duration
+	samlProofMetadataRefreshInterval time.Duration
+	samlMetadataStopped              chan struct{}
+
+	challengeProofSMTPRelay string
+	challengeProofFromAddr  string
+	challengeProofSubject   string
+	challengeProofValidity  time.Duration
+
+	clientCertProofRoots *x509.CertPool
 
 	insecureSkipEmailProof bool
 
+	deletionPolicy      *proto.AuthorizationPolicy
+	domainAdminPolicies []*proto.DomainAdminPolicy
+
+	// verifierPublicKeys are advertised by ListVerifiers, keyed by KeyID
+	verifierPublicKeys map[uint64]*proto.PublicKey
+
+	recoveryEnabled   bool
+	recoveryMinDelay  time.Duration
+	recoverySMTPRelay string
+	recoveryFromAddr  string
+	recoverySubject   string
+
+	clientIPLimiter, userIDLimiter *ratelimit.Limiter
+	registrationsPerDomainPerEpoch uint64
+
 	db  kv.DB
 	log replication.LogReplicator
 	rs  proto.ReplicaState
 
-	publicServer, verifierServer                             *grpc.Server
-	hkpFront                                                 *hkpfront.HKPFront
-	httpFront                                                *httpfront.HTTPFront
-	publicListen, verifierListen, hkpListen, httpFrontListen net.Listener
+	publicServer, verifierServer                                         *grpc.Server
+	hkpFront                                                             *hkpfront.HKPFront
+	httpFront                                                            *httpfront.HTTPFront
+	smtpFront                                                            *smtpfront.SMTPFront
+	publicListen, verifierListen, hkpListen, httpFrontListen, smtpListen net.Listener
 
 	clk       clock.Clock
 	lookupTXT func(string) ([]string, error)
@@ -91,9 +134,14 @@ type Keyserver struct {
 	laggingVerifierScan uint64
 
 	minEpochInterval, maxEpochInterval, retryProposalInterval time.Duration
+	refreshIdleEpochs                                         bool
 
 	// epochProposer makes sure we try to advance epochs.
 	epochProposer *Proposer
+	// refreshProposer makes sure we try to refresh the last epoch when
+	// refreshIdleEpochs is set and there have been no updates. It has the same
+	// sensitivity list as epochProposer.
+	refreshProposer *Proposer
 	// whether we should be advancing epochs is determined based on the
 	// following variables (sensitivity list wantEpochProposer) {
 	leaderHint bool
@@ -106,6 +154,8 @@ type Keyserver struct {
 
 	// signatureProposer makes sure we try to sign epochs.
 	signatureProposer *Proposer
+	// vrfRotationProposer makes sure we try to rotate to nextVRFPublic.
+	vrfRotationProposer *Proposer
 	// whether our signature is needed is determined by this sensitivity list {
 	// rs.ThisReplicaNeedsToSignLastEpoch
 	//}
@@ -131,17 +181,44 @@ type OIDCConfig struct {
 	scope          string
 }
 
-type errExpired struct {
-	err error
+// SAMLConfig manages a SAML2.0 identity provider
+type SAMLConfig struct {
+	allowedDomains map[string]struct{}
+	metadataURL    string
+	// fetchIDPInfo returns the SSO URL and the certificate from the metadata
+	// at a URL, it is saml.FetchIDPInfo outside of tests.
+	fetchIDPInfo func(string) (string, *x509.Certificate, error)
+
+	mu        sync.Mutex
+	idpSSOURL string
+	// idpCerts starts with the certificate from the latest metadata,
+	// followed by the one it replaced if the last refresh changed it.
+	idpCerts []*x509.Certificate
 }
 
-func (e *errExpired) Error() string {
-	return e.err.Error()
+// errInternal is returned to clients when the keyserver fails to handle a
+// request by no fault of theirs. The cause is logged instead.
+var errInternal = grpc.Errorf(codes.Internal, "internal error")
+
+// withCode returns err with the gRPC status code c, unless it already has a
+// code other than codes.Unknown.
+func withCode(c codes.Code, err error) error {
+	if err == nil || grpc.Code(err) != codes.Unknown {
+		return err
+	}
+	return grpc.Errorf(c, "%s", err)
 }
 
-func isExpired(err error) bool {
-	_, ok := err.(*errExpired)
-	return ok
+// contextError returns the error of a context that is done with the matching
+// gRPC status code.
+func contextError(err error) error {
+	switch err {
+	case context.DeadlineExceeded:
+		return grpc.Errorf(codes.DeadlineExceeded, "%s", err)
+	case context.Canceled:
+		return grpc.Errorf(codes.Canceled, "%s", err)
+	}
+	return err
 }
 
 // Open initializes a new keyserver based on cfg, reads the persistent state and
@@ -151,10 +228,20 @@ func Open(cfg *proto.ReplicaConfig, db kv.DB, log replication.LogReplicator, ini
 	if err != nil {
 		return nil, err
 	}
+	sehSigner, err := signer.New(signingKey)
+	if err != nil {
+		return nil, fmt.Errorf("signing key %s: %s", cfg.SigningKeyID, err)
+	}
 	vrfKey, err := getKey(cfg.VRFKeyID)
 	if err != nil {
 		return nil, err
 	}
+	var nextVRFKey crypto.PrivateKey
+	if cfg.NextVRFKeyID != "" {
+		if nextVRFKey, err = getKey(cfg.NextVRFKeyID); err != nil {
+			return nil, err
+		}
+	}
 	publicTLS, err := cfg.PublicTLS.Config(getKey)
 	if err != nil {
 		return nil, err
@@ -178,16 +265,21 @@ func Open(cfg *proto.ReplicaConfig, db kv.DB, log replication.LogReplicator, ini
 		serverID:                cfg.ServerID,
 		replicaID:               cfg.ReplicaID,
 		serverAuthorized:        initialAuthorizationPolicy,
-		sehKey:                  signingKey.(*[ed25519.PrivateKeySize]byte),
-		vrfSecret:               vrfKey.(*[vrf.SecretKeySize]byte),
+		sehSigner:               sehSigner,
+		vrfSuite:                vrf.Suite(cfg.VRFSuite),
+		vrfSecrets:              make(map[string]*[vrf.SecretKeySize]byte),
 		laggingVerifierScan:     cfg.LaggingVerifierScan,
 		clientTimeout:           cfg.ClientTimeout.Duration(),
 		minEpochInterval:        cfg.MinEpochInterval.Duration(),
 		maxEpochInterval:        cfg.MaxEpochInterval.Duration(),
 		retryProposalInterval:   cfg.ProposalRetryInterval.Duration(),
-		dkimProofAllowedDomains: make(map[string]struct{}),
+		refreshIdleEpochs:       cfg.RefreshIdleEpochs,
+		registrationPolicies:    make(map[string]*registrationPolicy),
 		oidcProofConfig:         make([]OIDCConfig, 0),
-		samlProofAllowedDomains: make(map[string]struct{}),
+		samlProofConfig:         make([]*SAMLConfig, 0),
+		samlMetadataStopped:     make(chan struct{}),
+
+		clientCertProofRoots: x509.NewCertPool(),
 
 		db:                 db,
 		log:                log,
@@ -208,15 +300,18 @@ func Open(cfg *proto.ReplicaConfig, db kv.DB, log replication.LogReplicator, ini
 	for _, p := range cfg.RegistrationPolicy {
 		switch t := p.PolicyType.(type) {
 		case *proto.RegistrationPolicy_EmailProofByDKIM:
-			for _, d := range t.EmailProofByDKIM.AllowedDomains {
-				ks.dkimProofAllowedDomains[d] = struct{}{}
-			}
+			ks.allowRegistrations("dkim_proof", t.EmailProofByDKIM.AllowedDomains, RegistrationVerifierFunc(ks.verifyDKIMProof))
 			ks.dkimProofToAddr = t.EmailProofByDKIM.ToAddr
 			ks.dkimProofSubjectPrefix = t.EmailProofByDKIM.SubjectPrefix
+			ks.dkimPendingUpdateValidity = t.EmailProofByDKIM.PendingUpdateValidity.Duration()
+			if ks.dkimPendingUpdateValidity == 0 {
+				ks.dkimPendingUpdateValidity = defaultPendingUpdateValidity
+			}
+			ks.dkimMaxEmailSize = int(t.EmailProofByDKIM.MaxEmailSize)
 
 		case *proto.RegistrationPolicy_EmailProofByOIDC:
 			for _, c := range t.EmailProofByOIDC.OIDCConfig {
-				o := &oidc.Client{ClientID: c.ClientID, Issuer: c.Issuer, Validity: c.Validity.Duration(), DiscoveryURL: c.DiscoveryURL}
+				o := &oidc.Client{ClientID: c.ClientID, Issuer: c.Issuer, Validity: c.Validity.Duration(), ClientSecret: c.ClientSecret, DiscoveryURL: c.DiscoveryURL, RefreshInterval: c.KeyRefreshInterval.Duration()}
 				err := o.FetchPubKeys()
 				if err != nil {
 					return nil, err
@@ -227,30 +322,89 @@ func Open(cfg *proto.ReplicaConfig, db kv.DB, log replication.LogReplicator, ini
 					oc.allowedDomains[d] = struct{}{}
 				}
 				ks.oidcProofConfig = append(ks.oidcProofConfig, oc)
+				ks.allowRegistrations("oidc_token", c.AllowedDomains, RegistrationVerifierFunc(ks.verifyOIDCProof))
 			}
 		case *proto.RegistrationPolicy_EmailProofBySAML:
-			for _, d := range t.EmailProofBySAML.AllowedDomains {
-				ks.samlProofAllowedDomains[d] = struct{}{}
+			configs := t.EmailProofBySAML.SAMLConfig
+			if t.EmailProofBySAML.IDPMetadataURL != "" {
+				configs = append([]*proto.SAMLConfig{{AllowedDomains: t.EmailProofBySAML.AllowedDomains, IDPMetadataURL: t.EmailProofBySAML.IDPMetadataURL}}, configs...)
 			}
-			url, cert, err := saml.FetchIDPInfo(t.EmailProofBySAML.IDPMetadataURL)
-			if err != nil {
-				return nil, err
+			for _, c := range configs {
+				sc := &SAMLConfig{metadataURL: c.IDPMetadataURL, fetchIDPInfo: saml.FetchIDPInfo}
+				if err := sc.refresh(); err != nil {
+					return nil, err
+				}
+				sc.allowedDomains = make(map[string]struct{})
+				for _, d := range c.AllowedDomains {
+					sc.allowedDomains[d] = struct{}{}
+				}
+				ks.samlProofConfig = append(ks.samlProofConfig, sc)
+				ks.allowRegistrations("saml_response", c.AllowedDomains, RegistrationVerifierFunc(ks.verifySAMLProof))
 			}
 			ks.samlProofConsumerServiceURL = t.EmailProofBySAML.ConsumerServiceURL
-			ks.samlProofIDPSSOURL = url
-			ks.samlProofIDPCert = cert
 			ks.samlProofValidity = t.EmailProofBySAML.Validity.Duration()
+			ks.samlProofMetadataRefreshInterval = t.EmailProofBySAML.MetadataRefreshInterval.Duration()
 			key, err := getKey(t.EmailProofBySAML.ServiceProviderTLS.Certificates[0].KeyID)
 			if err != nil {
 				return nil, err
 			}
 			ks.samlProofSPKey = key
 
+		case *proto.RegistrationPolicy_EmailProofByChallenge:
+			ks.allowRegistrations("challenge_code", t.EmailProofByChallenge.AllowedDomains, RegistrationVerifierFunc(ks.verifyChallengeProof))
+			ks.challengeProofSMTPRelay = t.EmailProofByChallenge.SMTPRelay
+			ks.challengeProofFromAddr = t.EmailProofByChallenge.FromAddr
+			ks.challengeProofSubject = t.EmailProofByChallenge.Subject
+			ks.challengeProofValidity = t.EmailProofByChallenge.Validity.Duration()
+
+		case *proto.RegistrationPolicy_EmailProofByClientCert:
+			ks.allowRegistrations("client_cert", t.EmailProofByClientCert.AllowedDomains, RegistrationVerifierFunc(ks.verifyClientCertProof))
+			caCert, err := x509.ParseCertificate(t.EmailProofByClientCert.CaCert)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse client certificate proof CA: %s", err)
+			}
+			ks.clientCertProofRoots.AddCert(caCert)
+
+		case *proto.RegistrationPolicy_EmailProofByExternalVerifier:
+			v, ok := externalVerifier(t.EmailProofByExternalVerifier.Type)
+			if !ok {
+				return nil, fmt.Errorf("no verifier registered for email proof type %q", t.EmailProofByExternalVerifier.Type)
+			}
+			ks.allowRegistrations(t.EmailProofByExternalVerifier.Type, t.EmailProofByExternalVerifier.AllowedDomains, v)
+
 		// TODO remove this before production
 		case *proto.RegistrationPolicy_InsecureSkipEmailProof:
 			ks.insecureSkipEmailProof = true
 		}
 	}
+	if ks.vrfPublic, err = ks.addVRFSecret(vrfKey.(*[vrf.SecretKeySize]byte)); err != nil {
+		return nil, fmt.Errorf("VRF key %s: %s", cfg.VRFKeyID, err)
+	}
+	if nextVRFKey != nil {
+		if ks.nextVRFPublic, err = ks.addVRFSecret(nextVRFKey.(*[vrf.SecretKeySize]byte)); err != nil {
+			return nil, fmt.Errorf("VRF key %s: %s", cfg.NextVRFKeyID, err)
+		}
+	}
+	ks.deletionPolicy = cfg.DeletionPolicy
+	ks.domainAdminPolicies = cfg.DomainAdminPolicies
+	ks.verifierPublicKeys = make(map[uint64]*proto.PublicKey, len(cfg.VerifierPublicKeys))
+	for _, pk := range cfg.VerifierPublicKeys {
+		ks.verifierPublicKeys[proto.KeyID(pk)] = pk
+	}
+	if r := cfg.AccountRecovery; r != nil {
+		ks.recoveryEnabled = true
+		ks.recoveryMinDelay = r.MinDelay.Duration()
+		ks.recoverySMTPRelay = r.SMTPRelay
+		ks.recoveryFromAddr = r.FromAddr
+		ks.recoverySubject = r.Subject
+	}
+	if l := cfg.ClientIPRateLimit; l != nil {
+		ks.clientIPLimiter = ratelimit.New(l.Interval.Duration(), l.Burst, clk)
+	}
+	if l := cfg.UserIDRateLimit; l != nil {
+		ks.userIDLimiter = ratelimit.New(l.Interval.Duration(), l.Burst, clk)
+	}
+	ks.registrationsPerDomainPerEpoch = cfg.RegistrationsPerDomainPerEpoch
 
 	switch replicaStateBytes, err := db.Get(tableReplicaState); err {
 	case ks.db.ErrNotFound():
@@ -262,9 +416,15 @@ func Open(cfg *proto.ReplicaConfig, db kv.DB, log replication.LogReplicator, ini
 	default:
 		return nil, err
 	}
+	vrfSecret := ks.vrfSecrets[string(ks.currentVRFPublic(&ks.rs))]
+	if vrfSecret == nil {
+		return nil, fmt.Errorf("the directory is indexed by VRF key %x, which is neither VRFKeyID nor NextVRFKeyID", ks.rs.VRFPublic)
+	}
+	ks.setVRFSecret(vrfSecret)
 	ks.leaderHint = true
-	ks.resetEpochTimers(ks.rs.LastEpochDelimiter.Timestamp.Time())
+	ks.resetEpochTimers(lastEpochTime(&ks.rs))
 	ks.updateEpochProposer()
+	ks.updateVRFRotationProposer()
 
 	ks.sb = concurrent.NewSequenceBroadcast(ks.rs.NextIndexVerifier)
 
@@ -313,13 +473,29 @@ func Open(cfg *proto.ReplicaConfig, db kv.DB, log replication.LogReplicator, ini
 			return nil, err
 		}
 		ks.httpFront = &httpfront.HTTPFront{Lookup: ks.Lookup, Update: ks.Update, InRotation: ks.InRotation,
-			IsAuthExpired: isExpired, TLSConfig: httpFrontTLS, SAMLRequest: ks.SAMLRequest, OIDCRequest: ks.OIDCRequest}
+			TLSConfig: httpFrontTLS, SAMLRequest: ks.SAMLRequest, OIDCRequest: ks.OIDCRequest,
+			OIDCCallback: ks.OIDCCallback, PendingUpdate: ks.SubmitPendingUpdate}
 		defer func() {
 			if !ok {
 				ks.httpFrontListen.Close()
 			}
 		}()
 	}
+	if cfg.SMTPAddr != "" {
+		if ks.dkimProofToAddr == "" {
+			return nil, fmt.Errorf("SMTPAddr is set but there is no EmailProofByDKIM policy with a ToAddr")
+		}
+		ks.smtpListen, err = net.Listen("tcp", cfg.SMTPAddr)
+		if err != nil {
+			return nil, err
+		}
+		ks.smtpFront = &smtpfront.SMTPFront{ToAddr: ks.dkimProofToAddr, Deliver: ks.deliverDKIMMail, MaxSize: ks.dkimMaxEmailSize}
+		defer func() {
+			if !ok {
+				ks.smtpListen.Close()
+			}
+		}()
+	}
 	ks.merkletree, err = merkletree.AccessMerkleTree(ks.db, []byte{tableMerkleTreePrefix}, nil)
 	if err != nil {
 		return nil, err
@@ -344,7 +520,14 @@ func (ks *Keyserver) Start() {
 	if ks.httpFront != nil {
 		ks.httpFront.Start(ks.httpFrontListen)
 	}
+	if ks.smtpFront != nil {
+		ks.smtpFront.Start(ks.smtpListen)
+	}
 	go ks.run()
+	go ks.refreshSAMLMetadata()
+	for _, oc := range ks.oidcProofConfig {
+		go oc.oidcClient.KeepFresh(ks.stop)
+	}
 	go ks.takeOutOfRotation()
 	go ks.takeInRotation()
 }
@@ -365,12 +548,18 @@ func (ks *Keyserver) Stop() {
 		if ks.httpFront != nil {
 			ks.httpFront.Stop()
 		}
+		if ks.smtpFront != nil {
+			ks.smtpFront.Stop()
+		}
 		close(ks.stop)
 		<-ks.stopped
+		<-ks.samlMetadataStopped
 		ks.minEpochIntervalTimer.Stop()
 		ks.maxEpochIntervalTimer.Stop()
 		ks.epochProposer.Stop()
+		ks.refreshProposer.Stop()
 		ks.signatureProposer.Stop()
+		ks.vrfRotationProposer.Stop()
 		ks.log.Stop()
 		ks.signatureBroadcast.Stop()
 	})
@@ -433,7 +622,7 @@ func (ks *Keyserver) run() {
 
 // step is called by run and changes the in-memory state. No i/o allowed.
 func (ks *Keyserver) step(step *proto.KeyserverStep, rs *proto.ReplicaState, wb kv.Batch) (deferredIO func()) {
-	// ks: &const
+	// ks: &const, except for vrfSecret
 	// step, rs, wb: &mut
 	switch step.Type.(type) {
 	case *proto.KeyserverStep_Update:
@@ -441,19 +630,44 @@ func (ks *Keyserver) step(step *proto.KeyserverStep, rs *proto.ReplicaState, wb
 		prevUpdate, err := ks.getUpdate(index, math.MaxUint64)
 		if err != nil {
 			log.Printf("getUpdate: %s", err)
-			ks.wr.Notify(step.UID, updateOutput{Error: fmt.Errorf("internal error")})
+			ks.wr.Notify(step.UID, updateOutput{Error: errInternal})
 			return
 		}
-		if err := ks.verifyUpdateDeterministic(prevUpdate, step.GetUpdate()); err != nil {
+		recovery, err := ks.getPendingRecovery(index, math.MaxUint64)
+		if err != nil {
+			log.Printf("getPendingRecovery: %s", err)
+			ks.wr.Notify(step.UID, updateOutput{Error: errInternal})
+			return
+		}
+		admin, err := ks.verifyUpdateDeterministic(prevUpdate, recovery, step.GetUpdate(), lastEpochIssueTime(rs))
+		if err != nil {
 			ks.wr.Notify(step.UID, updateOutput{Error: err})
 			return
 		}
+		epochNr := rs.LastEpochDelimiter.EpochNumber + 1
+		var registrations uint64
+		countRegistration := ks.registrationsPerDomainPerEpoch != 0 && !registered(prevUpdate) && !step.GetUpdate().Update.NewEntry.Deleted
+		if countRegistration {
+			registrations, err = ks.checkRegistrationLimitDeterministic(step.GetUpdate().LookupParameters.UserId, epochNr)
+			if err != nil {
+				ks.wr.Notify(step.UID, updateOutput{Error: err})
+				return
+			}
+		}
+		// an email challenge code can only be used for one registration
+		var challenge *proto.EmailChallenge
+		if !registered(prevUpdate) && step.GetUpdate().EmailProof.GetChallengeCode() != "" {
+			if challenge, err = ks.verifyEmailChallengeDeterministic(step.GetUpdate()); err != nil {
+				ks.wr.Notify(step.UID, updateOutput{Error: err})
+				return
+			}
+		}
 		latestTree := ks.merkletree.GetSnapshot(rs.LatestTreeSnapshot)
 
 		// sanity check: compare previous version in Merkle tree vs in updates table
 		prevEntryHashTree, _, err := latestTree.Lookup(index)
 		if err != nil {
-			ks.wr.Notify(step.UID, updateOutput{Error: fmt.Errorf("internal error")})
+			ks.wr.Notify(step.UID, updateOutput{Error: errInternal})
 			return
 		}
 		var prevEntryHash []byte
@@ -465,44 +679,74 @@ func (ks *Keyserver) step(step *proto.KeyserverStep, rs *proto.ReplicaState, wb
 			log.Fatalf("ERROR: merkle tree and DB inconsistent for index %x: %x vs %x", index, prevEntryHashTree, prevEntryHash)
 		}
 
+		// registrations for index waiting for an email proof are obsolete
+		var pendingUpdates [][]byte
+		if !registered(prevUpdate) {
+			if pendingUpdates, err = ks.pendingUpdateKeys(index); err != nil {
+				log.Printf("pendingUpdateKeys: %s", err)
+				ks.wr.Notify(step.UID, updateOutput{Error: errInternal})
+				return
+			}
+		}
+
 		var entryHash [32]byte
 		sha3.ShakeSum256(entryHash[:], step.GetUpdate().Update.NewEntry.Encoding)
 		newTree, err := latestTree.BeginModification()
 		if err != nil {
-			ks.wr.Notify(step.UID, updateOutput{Error: fmt.Errorf("internal error")})
+			ks.wr.Notify(step.UID, updateOutput{Error: errInternal})
 			return
 		}
 		if err := newTree.Set(index, entryHash[:]); err != nil {
 			log.Printf("setting index '%x' gave error: %s", index, err)
-			ks.wr.Notify(step.UID, updateOutput{Error: fmt.Errorf("internal error")})
+			ks.wr.Notify(step.UID, updateOutput{Error: errInternal})
 			return
 		}
 		rs.LatestTreeSnapshot = newTree.Flush(wb).Nr
-		epochNr := rs.LastEpochDelimiter.EpochNumber + 1
 		wb.Put(tableUpdateRequests(index, epochNr), proto.MustMarshal(step.GetUpdate()))
+		if countRegistration {
+			domain := userDomain(step.GetUpdate().LookupParameters.UserId)
+			wb.Put(tableRegistrationCounts(domain), registrationCountValue(epochNr, registrations))
+		}
+		if challenge != nil {
+			wb.Delete(tableEmailChallenges(index, challenge.EntryHash))
+		}
+		for _, k := range pendingUpdates {
+			wb.Delete(k)
+		}
+		if recovery != nil {
+			// completed or cancelled by the update
+			wb.Put(tablePendingRecoveries(index, epochNr), nil)
+		}
 		ks.wr.Notify(step.UID, updateOutput{Epoch: epochNr})
 
 		rs.PendingUpdates = true
 		ks.updateEpochProposer()
 
-		if rs.LastEpochNeedsRatification {
-			// We need to wait for the last epoch to appear in the verifier log before
-			// inserting this update.
-			wb.Put(tableUpdatesPendingRatification(rs.NextIndexLog), proto.MustMarshal(step.GetUpdate().Update))
-		} else {
-			// We can deliver the update to verifiers right away.
-			return ks.verifierLogAppend(&proto.VerifierStep{Type: &proto.VerifierStep_Update{Update: step.GetUpdate().Update}}, rs, wb)
+		vstep := &proto.VerifierStep{Type: &proto.VerifierStep_Update{Update: step.GetUpdate().Update}}
+		if admin {
+			// verifiers check the domain of the user ID against the admin policy
+			userID := step.GetUpdate().LookupParameters.UserId
+			_, indexProof := ks.vrfSuite.Prove([]byte(userID), ks.currentVRFSecret())
+			vstep.Type = &proto.VerifierStep_AdminUpdate{AdminUpdate: &proto.AdminUpdate{
+				Update:     step.GetUpdate().Update,
+				UserId:     userID,
+				IndexProof: indexProof,
+			}}
 		}
+		return ks.verifierLogAppendAfterRatification(vstep, rs, wb)
 
 	case *proto.KeyserverStep_EpochDelimiter:
 		if step.GetEpochDelimiter().EpochNumber <= rs.LastEpochDelimiter.EpochNumber {
 			return // a duplicate of this step has already been handled
 		}
 		rs.LastEpochDelimiter = *step.GetEpochDelimiter()
+		rs.LastEpochRefresh = proto.Timestamp{}
+		rs.LastEpochRefreshNeedsRatification = false
 		log.Printf("epoch %d", step.GetEpochDelimiter().EpochNumber)
 
 		rs.PendingUpdates = false
 		ks.resetEpochTimers(rs.LastEpochDelimiter.Timestamp.Time())
+		ks.expirePendingUpdates(rs.LastEpochDelimiter.Timestamp.Time(), wb)
 		// rs.ThisReplicaNeedsToSignLastEpoch might already be true, if a majority
 		// signed that did not include us. This will make us skip signing the last
 		// epoch, but that's fine.
@@ -515,6 +759,10 @@ func (ks *Keyserver) step(step *proto.KeyserverStep, rs *proto.ReplicaState, wb
 		rs.LastEpochNeedsRatification = true
 		ks.updateEpochProposer()
 		deferredIO = ks.updateSignatureProposer
+		if step.GetEpochDelimiter().EpochNumber == rs.VRFRotationEpoch {
+			rotationTime := step.GetEpochDelimiter().Timestamp
+			rs.VRFRotationTime = &rotationTime
+		}
 
 		snapshotNumberBytes := make([]byte, 8)
 		binary.BigEndian.PutUint64(snapshotNumberBytes, rs.LatestTreeSnapshot)
@@ -532,6 +780,8 @@ func (ks *Keyserver) step(step *proto.KeyserverStep, rs *proto.ReplicaState, wb
 				Realm:               ks.realm,
 				Epoch:               step.GetEpochDelimiter().EpochNumber,
 				IssueTime:           step.GetEpochDelimiter().Timestamp,
+				VRFPublic:           ks.currentVRFPublic(rs),
+				VRFRotationTime:     rs.VRFRotationTime,
 			}, Encoding: nil},
 			Timestamp: step.GetEpochDelimiter().Timestamp,
 		}, Encoding: nil}
@@ -544,6 +794,110 @@ func (ks *Keyserver) step(step *proto.KeyserverStep, rs *proto.ReplicaState, wb
 
 		wb.Put(tableEpochHeads(step.GetEpochDelimiter().EpochNumber), proto.MustMarshal(teh))
 
+	case *proto.KeyserverStep_EpochRefresh:
+		refresh := step.GetEpochRefresh()
+		if refresh.EpochNumber != rs.LastEpochDelimiter.EpochNumber || rs.LastEpochNeedsRatification ||
+			rs.LastEpochRefreshNeedsRatification || rs.PendingUpdates || !refresh.Timestamp.Time().After(lastEpochTime(rs)) {
+			return // a duplicate or stale refresh
+		}
+		rs.LastEpochRefresh = refresh.Timestamp
+		rs.LastEpochRefreshNeedsRatification = true
+		log.Printf("epoch %d refreshed", refresh.EpochNumber)
+
+		ks.resetEpochTimers(refresh.Timestamp.Time())
+		ks.expirePendingUpdates(refresh.Timestamp.Time(), wb)
+		// Our signature of the last epoch (or of an earlier refresh of it) is
+		// superseded by a signature of this refresh, even if we have not
+		// gotten to sign the former yet.
+		rs.ThisReplicaNeedsToSignLastEpoch = true
+		ks.updateEpochProposer()
+		deferredIO = func() {
+			ks.signatureProposer.Stop()
+			ks.signatureProposer = nil
+			ks.updateSignatureProposer()
+		}
+
+	case *proto.KeyserverStep_EmailChallenge:
+		challenge := step.GetEmailChallenge()
+		if len(challenge.Index) != vrf.Size {
+			ks.wr.Notify(step.UID, errInternal)
+			return
+		}
+		if len(challenge.EntryHash) != 32 {
+			ks.wr.Notify(step.UID, errInternal)
+			return
+		}
+		// replaces any earlier challenge for the same entry, but not those for
+		// other entries
+		wb.Put(tableEmailChallenges(challenge.Index, challenge.EntryHash), proto.MustMarshal(challenge))
+		ks.wr.Notify(step.UID, nil)
+
+	case *proto.KeyserverStep_PendingUpdate:
+		pending := step.GetPendingUpdate()
+		if pending.Update == nil || len(pending.Update.NewEntry.Index) != vrf.Size {
+			ks.wr.Notify(step.UID, errInternal)
+			return
+		}
+		if err := ks.storePendingUpdate(pending, rs, wb); err != nil {
+			ks.wr.Notify(step.UID, err)
+			return
+		}
+		ks.wr.Notify(step.UID, nil)
+
+	case *proto.KeyserverStep_RecoveryStart:
+		req := step.GetRecoveryStart()
+		index := req.Update.NewEntry.Index
+		prevUpdate, err := ks.getUpdate(index, math.MaxUint64)
+		if err != nil {
+			log.Printf("getUpdate: %s", err)
+			ks.wr.Notify(step.UID, recoveryOutput{Error: errInternal})
+			return
+		}
+		recovery, err := ks.startRecoveryDeterministic(prevUpdate, req, lastEpochIssueTime(rs))
+		if err != nil {
+			ks.wr.Notify(step.UID, recoveryOutput{Error: err})
+			return
+		}
+		// an email challenge code can only be used once
+		if req.EmailProof.GetChallengeCode() != "" {
+			challenge, err := ks.verifyEmailChallengeDeterministic(req)
+			if err != nil {
+				ks.wr.Notify(step.UID, recoveryOutput{Error: err})
+				return
+			}
+			wb.Delete(tableEmailChallenges(index, challenge.EntryHash))
+		}
+		// replaces any earlier recovery of the same entry
+		wb.Put(tablePendingRecoveries(index, rs.LastEpochDelimiter.EpochNumber+1), proto.MustMarshal(recovery))
+		ks.wr.Notify(step.UID, recoveryOutput{Recovery: recovery})
+		// verifiers do not sign a refresh of the last epoch after this
+		rs.PendingUpdates = true
+		ks.updateEpochProposer()
+		return ks.verifierLogAppendAfterRatification(&proto.VerifierStep{Type: &proto.VerifierStep_RecoveryStart{RecoveryStart: recovery}}, rs, wb)
+
+	case *proto.KeyserverStep_RecoveryVeto:
+		veto := step.GetRecoveryVeto()
+		if len(veto.Index) != vrf.Size {
+			ks.wr.Notify(step.UID, recoveryOutput{Error: errInternal})
+			return
+		}
+		prevEntry, recovery, err := ks.getEntryAndPendingRecovery(veto.Index)
+		if err != nil {
+			log.Print(err)
+			ks.wr.Notify(step.UID, recoveryOutput{Error: errInternal})
+			return
+		}
+		if err := coname.VerifyRecoveryVeto(prevEntry, recovery, veto); err != nil {
+			ks.wr.Notify(step.UID, recoveryOutput{Error: withCode(codes.FailedPrecondition, err)})
+			return
+		}
+		wb.Put(tablePendingRecoveries(veto.Index, rs.LastEpochDelimiter.EpochNumber+1), nil)
+		ks.wr.Notify(step.UID, recoveryOutput{Recovery: recovery})
+		// verifiers do not sign a refresh of the last epoch after this
+		rs.PendingUpdates = true
+		ks.updateEpochProposer()
+		return ks.verifierLogAppendAfterRatification(&proto.VerifierStep{Type: &proto.VerifierStep_RecoveryVeto{RecoveryVeto: veto}}, rs, wb)
+
 	case *proto.KeyserverStep_ReplicaSigned:
 		newSEH := step.GetReplicaSigned()
 		epochNr := newSEH.Head.Head.Epoch
@@ -553,8 +907,11 @@ func (ks *Keyserver) step(step *proto.KeyserverStep, rs *proto.ReplicaState, wb
 			log.Panicf("get tableEpochHeads(%d): %s", epochNr, err)
 		}
 		// compare epoch head to signed epoch head
-		if got, want := tehBytes, newSEH.Head.Encoding; !bytes.Equal(got, want) {
-			log.Panicf("replica signed different head: wanted %x, got %x", want, got)
+		if !bytes.Equal(tehBytes, newSEH.Head.Encoding) {
+			return ks.replicaSignedRefresh(newSEH, tehBytes, rs, wb)
+		}
+		if epochNr == rs.LastEpochDelimiter.EpochNumber && lastEpochTime(rs).After(rs.LastEpochDelimiter.Timestamp.Time()) {
+			return // a duplicate signature, superseded by the signatures of the last refresh
 		}
 
 		// insert all the new signatures into the ratifications table (there should
@@ -626,7 +983,9 @@ func (ks *Keyserver) step(step *proto.KeyserverStep, rs *proto.ReplicaState, wb
 		}
 		oldDeferredIO := deferredIO
 		deferredSendEpoch := ks.verifierLogAppend(&proto.VerifierStep{Type: &proto.VerifierStep_Epoch{Epoch: allSignaturesSEH}}, rs, wb)
-		deferredSendUpdates := []func(){}
+		deferredSendSteps := []func(){}
+		// Updates held back by an older version of the keyserver come first,
+		// they were handled before any of the steps in the other table.
 		iter := ks.db.NewIterator(kv.BytesPrefix([]byte{tableUpdatesPendingRatificationPrefix}))
 		defer iter.Release()
 		for iter.Next() {
@@ -635,15 +994,25 @@ func (ks *Keyserver) step(step *proto.KeyserverStep, rs *proto.ReplicaState, wb
 			if err != nil {
 				log.Panicf("invalid pending update %x: %s", iter.Value(), err)
 			}
-			deferredSendUpdates = append(deferredSendUpdates, ks.verifierLogAppend(&proto.VerifierStep{Type: &proto.VerifierStep_Update{Update: update}}, rs, wb))
+			deferredSendSteps = append(deferredSendSteps, ks.verifierLogAppend(&proto.VerifierStep{Type: &proto.VerifierStep_Update{Update: update}}, rs, wb))
 			wb.Delete(iter.Key())
 		}
+		stepIter := ks.db.NewIterator(kv.BytesPrefix([]byte{tableStepsPendingRatificationPrefix}))
+		defer stepIter.Release()
+		for stepIter.Next() {
+			vstep := &proto.VerifierStep{}
+			if err := vstep.Unmarshal(stepIter.Value()); err != nil {
+				log.Panicf("invalid pending verifier step %x: %s", stepIter.Value(), err)
+			}
+			deferredSendSteps = append(deferredSendSteps, ks.verifierLogAppend(vstep, rs, wb))
+			wb.Delete(stepIter.Key())
+		}
 		deferredIO = func() {
 			oldDeferredIO()
 			// First, send the ratified epoch to verifiers
 			deferredSendEpoch()
-			// Then send updates that were waiting for that epoch to go out
-			for _, f := range deferredSendUpdates {
+			// Then send steps that were waiting for that epoch to go out
+			for _, f := range deferredSendSteps {
 				f()
 			}
 		}
@@ -655,6 +1024,11 @@ func (ks *Keyserver) step(step *proto.KeyserverStep, rs *proto.ReplicaState, wb
 			// into the log, or else verifiers could just trample over everyone else's
 			// signatures, including our own.
 			dbkey := tableRatifications(rNew.Head.Head.Epoch, id)
+			if ks.isSupersededRatification(dbkey, rNew, rs) {
+				// a ratification that was pushed late must not replace the
+				// signature of a later refresh
+				continue
+			}
 			wb.Put(dbkey, proto.MustMarshal(rNew))
 		}
 		ks.wr.Notify(step.UID, nil)
@@ -662,6 +1036,9 @@ func (ks *Keyserver) step(step *proto.KeyserverStep, rs *proto.ReplicaState, wb
 			// As above, first write to DB, *then* notify subscribers.
 			ks.signatureBroadcast.Publish(rNew.Head.Head.Epoch, rNew)
 		}
+
+	case *proto.KeyserverStep_VrfRotation:
+		return ks.rotateVRF(step.GetVrfRotation().VRFPublic, rs, wb)
 	default:
 		log.Panicf("unknown step pb in replicated log: %#v", step)
 	}
@@ -693,6 +1070,9 @@ type Proposer struct {
 	clk      clock.Clock
 	delay    time.Duration
 	proposal replication.LogEntry
+	// prepare computes proposal before it is first proposed if it is not nil,
+	// see StartPreparingProposer
+	prepare func() (replication.LogEntry, error)
 
 	stop     chan struct{}
 	stopped  chan struct{}
@@ -712,6 +1092,23 @@ func StartProposer(log replication.LogReplicator, clk clock.Clock, initialDelay
 	return p
 }
 
+// StartPreparingProposer is like StartProposer, except that the proposal is
+// computed by prepare in the background. If prepare fails, the error is logged
+// and it is retried with the same backoff as the proposal. This keeps i/o that
+// may fail, such as signing with a remote key, out of step.
+func StartPreparingProposer(log replication.LogReplicator, clk clock.Clock, initialDelay time.Duration, prepare func() (replication.LogEntry, error)) *Proposer {
+	p := &Proposer{
+		log:     log,
+		clk:     clk,
+		delay:   initialDelay,
+		prepare: prepare,
+		stop:    make(chan struct{}),
+		stopped: make(chan struct{}),
+	}
+	go p.run()
+	return p
+}
+
 func (p *Proposer) Stop() {
 	if p == nil {
 		return
@@ -728,6 +1125,16 @@ func (p *Proposer) run() {
 	for {
 		select {
 		case <-timer.C:
+			if p.prepare != nil {
+				proposal, err := p.prepare()
+				if err != nil {
+					log.Printf("ERROR: preparing proposal (retrying in %s): %s", p.delay, err)
+					timer.Reset(p.delay)
+					p.delay = p.delay * 2
+					continue
+				}
+				p.proposal, p.prepare = proposal, nil
+			}
 			ctx, cancel := context.WithCancel(context.Background())
 			done := make(chan struct{})
 			go func() {
@@ -751,11 +1158,25 @@ func (p *Proposer) run() {
 // log.
 func (ks *Keyserver) wantEpochProposer() bool {
 	return !ks.rs.LastEpochNeedsRatification && ks.leaderHint &&
-		(ks.maxEpochIntervalPassed || ks.minEpochIntervalPassed && ks.rs.PendingUpdates)
+		(ks.maxEpochIntervalPassed && !ks.idle() || ks.minEpochIntervalPassed && ks.rs.PendingUpdates)
+}
+
+// wantRefreshProposer returns true if this node should append an epoch refresh
+// to the log.
+func (ks *Keyserver) wantRefreshProposer() bool {
+	return !ks.rs.LastEpochNeedsRatification && !ks.rs.LastEpochRefreshNeedsRatification &&
+		ks.leaderHint && ks.maxEpochIntervalPassed && ks.idle()
+}
+
+// idle returns true if the last epoch should be refreshed instead of being
+// followed by a new one once maxEpochInterval has passed.
+func (ks *Keyserver) idle() bool {
+	return ks.refreshIdleEpochs && !ks.rs.PendingUpdates && ks.rs.LastEpochDelimiter.EpochNumber != 0
 }
 
 // updateEpochProposer either starts or stops the epoch delimiter proposer as necessary.
 func (ks *Keyserver) updateEpochProposer() {
+	ks.updateRefreshProposer()
 	want := ks.wantEpochProposer()
 	have := ks.epochProposer != nil
 	if have == want {
@@ -780,6 +1201,30 @@ func (ks *Keyserver) updateEpochProposer() {
 	}
 }
 
+// updateRefreshProposer either starts or stops the epoch refresh proposer as
+// necessary. It is called by updateEpochProposer.
+func (ks *Keyserver) updateRefreshProposer() {
+	want := ks.wantRefreshProposer()
+	have := ks.refreshProposer != nil
+	if have == want {
+		return
+	}
+
+	switch want {
+	case true:
+		ks.refreshProposer = StartProposer(ks.log, ks.clk, ks.retryProposalInterval,
+			replication.LogEntry{
+				Data: proto.MustMarshal(&proto.KeyserverStep{Type: &proto.KeyserverStep_EpochRefresh{EpochRefresh: &proto.EpochDelimiter{
+					EpochNumber: ks.rs.LastEpochDelimiter.EpochNumber,
+					Timestamp:   proto.Time(ks.clk.Now()),
+				}}}),
+			})
+	case false:
+		ks.refreshProposer.Stop()
+		ks.refreshProposer = nil
+	}
+}
+
 func (ks *Keyserver) updateSignatureProposer() {
 	// invariant: do not access the db if ThisReplicaNeedsToSignLastEpoch = false
 	want := ks.rs.ThisReplicaNeedsToSignLastEpoch
@@ -798,12 +1243,22 @@ func (ks *Keyserver) updateSignatureProposer() {
 		if err := teh.Unmarshal(tehBytes); err != nil {
 			log.Panicf("tableEpochHeads(%d) invalid: %s", ks.rs.LastEpochDelimiter.EpochNumber, err)
 		}
-		seh := &proto.SignedEpochHead{
-			Head:       teh,
-			Signatures: map[uint64][]byte{ks.replicaID: ed25519.Sign(ks.sehKey, tehBytes)[:]},
+		if lastEpochTime(&ks.rs).After(teh.Timestamp.Time()) {
+			teh = *refreshedEpochHead(&teh, ks.rs.LastEpochRefresh)
+			tehBytes = teh.Encoding
 		}
-		ks.signatureProposer = StartProposer(ks.log, ks.clk, ks.retryProposalInterval,
-			replication.LogEntry{Data: proto.MustMarshal(&proto.KeyserverStep{Type: &proto.KeyserverStep_ReplicaSigned{ReplicaSigned: seh}})})
+		epochNr := ks.rs.LastEpochDelimiter.EpochNumber
+		ks.signatureProposer = StartPreparingProposer(ks.log, ks.clk, ks.retryProposalInterval, func() (replication.LogEntry, error) {
+			sig, err := ks.sehSigner.Sign(tehBytes)
+			if err != nil {
+				return replication.LogEntry{}, fmt.Errorf("failed to sign epoch head %d: %s", epochNr, err)
+			}
+			seh := &proto.SignedEpochHead{
+				Head:       teh,
+				Signatures: map[uint64][]byte{ks.replicaID: sig},
+			}
+			return replication.LogEntry{Data: proto.MustMarshal(&proto.KeyserverStep{Type: &proto.KeyserverStep_ReplicaSigned{ReplicaSigned: seh}})}, nil
+		})
 	case false:
 		ks.signatureProposer.Stop()
 		ks.signatureProposer = nil
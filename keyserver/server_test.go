@@ -18,7 +18,9 @@ import (
 	"bytes"
 	"crypto"
 	"crypto/ecdsa"
+	"crypto/elliptic"
 	"crypto/rand"
+	"crypto/rsa"
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/binary"
@@ -32,6 +34,7 @@ import (
 	_ "net/http/pprof"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"runtime"
 	"sync"
 	"testing"
@@ -42,6 +45,7 @@ import (
 	"golang.org/x/net/context"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
 
 	"github.com/agl/ed25519"
@@ -58,6 +62,7 @@ import (
 	"github.com/yahoo/coname/keyserver/replication/raftlog/nettestutil"
 	raftproto "github.com/yahoo/coname/keyserver/replication/raftlog/proto"
 	"github.com/yahoo/coname/proto"
+	"github.com/yahoo/coname/signer"
 	"github.com/yahoo/coname/verifier"
 	"github.com/yahoo/coname/vrf"
 )
@@ -372,7 +377,7 @@ loop:
 
 func doUpdate(
 	t *testing.T, ks *Keyserver, clientConfig *proto.Config, clientTLS *tls.Config, caPool *x509.CertPool, now time.Time,
-	name string, sk *[ed25519.PrivateKeySize]byte, pk *proto.PublicKey, version uint64, profileContents proto.Profile,
+	name string, sk crypto.PrivateKey, pk *proto.PublicKey, version uint64, profileContents proto.Profile,
 ) (*proto.EncodedEntry, *proto.EncodedProfile) {
 	conn, err := grpc.Dial(ks.publicListen.Addr().String(), grpc.WithTransportCredentials(credentials.NewTLS(clientTLS)))
 	if err != nil {
@@ -422,10 +427,14 @@ func doUpdate(
 		},
 	}
 	entry.UpdateEncoding()
+	sig, err := coname.Sign(sk, entry.Encoding)
+	if err != nil {
+		t.Fatal(err)
+	}
 	proof, err := publicC.Update(context.Background(), &proto.UpdateRequest{
 		Update: &proto.SignedEntryUpdate{
 			NewEntry:   entry,
-			Signatures: map[uint64][]byte{keyid: ed25519.Sign(sk, entry.Encoding)[:]},
+			Signatures: map[uint64][]byte{keyid: sig},
 		},
 		Profile: profile,
 		LookupParameters: &proto.LookupRequest{
@@ -688,6 +697,86 @@ func TestKeyserverUpdate(t *testing.T) {
 	})
 }
 
+func TestKeyserverECVRF(t *testing.T) {
+	dieOnCtrlC()
+	kss, caPool, _, _, clks, _, ck, clientConfig, teardown := setupRealmWithConfig(t, 3, 1, func(cfg *proto.ReplicaConfig) {
+		cfg.VRFSuite = proto.VRF_ECVRF_EDWARDS25519_SHA512_ELL2
+	})
+	defer teardown()
+	stop := stoppableSyncedClocks(clks)
+	defer close(stop)
+
+	quorum := clientConfig.Realms[0].VerificationPolicy.GetQuorum()
+	waitForFirstEpoch(kss[0], quorum)
+	clientTLS, err := clientConfig.Realms[0].ClientTLS.Config(ck)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sk, pk, _, _ := doRegister(t, kss[0], clientConfig, clientTLS, caPool, clks[0].Now(), alice, 0, proto.Profile{
+		Nonce: []byte("noncenoncenonceNONCE"),
+		Keys:  map[string][]byte{"abc": []byte{1, 2, 3}},
+	})
+	doUpdate(t, kss[1], clientConfig, clientTLS, caPool, clks[0].Now(), alice, sk, pk, 1, proto.Profile{
+		Nonce: []byte("XYZNONCE"),
+		Keys:  map[string][]byte{"abc": []byte{4, 5, 6}},
+	})
+
+	// the index proof is the proof of RFC 9381, and the index a prefix of its
+	// output
+	proof, err := kss[2].Lookup(context.Background(), &proto.LookupRequest{UserId: alice, QuorumRequirement: quorum})
+	if err != nil {
+		t.Fatal(err)
+	}
+	beta, ok := vrf.ECVRFVerify(clientConfig.Realms[0].VRFPublic, []byte(alice), proof.IndexProof)
+	if !ok {
+		t.Fatal("index proof is not a valid ECVRF proof")
+	}
+	if !bytes.Equal(proof.Index, beta[:vrf.Size]) {
+		t.Errorf("index %x is not a prefix of the VRF output %x", proof.Index, beta)
+	}
+	clientConfig.Realms[0].VRFSuite = proto.VRF_CONAME
+	if _, err := coname.VerifyLookup(clientConfig, alice, proof, clks[0].Now()); err == nil {
+		t.Error("ECVRF index proof verified as a proof of the default VRF")
+	}
+}
+
+func TestKeyserverUpdateSignatureAlgorithms(t *testing.T) {
+	dieOnCtrlC()
+	kss, caPool, clks, _, ck, clientConfig, teardown := setupRealm(t, 3, 1)
+	defer teardown()
+	stop := stoppableSyncedClocks(clks)
+	defer close(stop)
+
+	waitForFirstEpoch(kss[0], clientConfig.Realms[0].VerificationPolicy.GetQuorum())
+
+	clientTLS, err := clientConfig.Realms[0].ClientTLS.Config(ck)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecsk, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rsask, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// each entry is registered and then updated, so its key authorizes both
+	// the registration and the replacement of the registered entry
+	for name, sk := range map[string]crypto.PrivateKey{alice: ecsk, "bob@" + realmDomain: rsask} {
+		pk, err := coname.PublicKeyFor(sk)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for version := uint64(0); version < 2; version++ {
+			doUpdate(t, kss[0], clientConfig, clientTLS, caPool, clks[0].Now(), name, sk, pk, version, proto.Profile{
+				Nonce: []byte("noncenoncenonceNONCE"),
+				Keys:  map[string][]byte{"abc": []byte{byte(version)}},
+			})
+		}
+	}
+}
+
 func TestKeyserverLookupSpecificEpoch(t *testing.T) {
 	dieOnCtrlC()
 	kss, caPool, clks, verifiers, ck, clientConfig, teardown := setupRealm(t, 3, 3)
@@ -798,18 +887,39 @@ func setupVerifier(t *testing.T, keyserverVerif *proto.AuthorizationPolicy, keys
 	}
 	teardown = chain(func() { ldb.Close() }, teardown)
 
-	pk, sk, err := ed25519.GenerateKey(rand.Reader)
+	// the replicas sign with ed25519, so the verifiers use ECDSA to cover
+	// ratifications with mixed signature algorithms
+	sk, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		teardown()
+		t.Fatal(err)
+	}
+	sv, err = coname.PublicKeyFor(sk)
+	if err != nil {
+		teardown()
+		t.Fatal(err)
+	}
+
+	// the verifiers sign through a remote signer to cover external keys
+	local, err := signer.New(sk)
+	if err != nil {
+		teardown()
+		t.Fatal(err)
+	}
+	signerPath := filepath.Join(dir, "signer.sock")
+	ln, err := net.Listen("unix", signerPath)
 	if err != nil {
 		teardown()
 		t.Fatal(err)
 	}
-	sv = &proto.PublicKey{PubkeyType: &proto.PublicKey_Ed25519{Ed25519: pk[:]}}
+	go signer.Serve(ln, local)
+	teardown = chain(func() { ln.Close() }, teardown)
 
 	cert := tlstestutil.Cert(t, caCert, caKey, fmt.Sprintf("verifier %x", proto.KeyID(sv)), nil)
 	getKey = func(keyid string) (crypto.PrivateKey, error) {
 		switch keyid {
 		case "signing":
-			return sk, nil
+			return signer.DialUnix(signerPath)
 		case "tls":
 			return cert.PrivateKey, nil
 		default:
@@ -834,8 +944,48 @@ func setupVerifier(t *testing.T, keyserverVerif *proto.AuthorizationPolicy, keys
 func setupRealm(t *testing.T, nReplicas, nVerifiers int) (
 	kss []*Keyserver, caPool *x509.CertPool, clks []*clock.Mock, verifiers []uint64,
 	clientKeyGetter func(string) (crypto.PrivateKey, error), clientConfig *proto.Config, teardown func(),
+) {
+	kss, caPool, _, _, clks, verifiers, clientKeyGetter, clientConfig, teardown = setupRealmWithCA(t, nReplicas, nVerifiers)
+	return
+}
+
+// setupRealmWithCA is like setupRealm, but also returns the CA so that more
+// verifiers can be added later.
+func setupRealmWithCA(t *testing.T, nReplicas, nVerifiers int) (
+	kss []*Keyserver, caPool *x509.CertPool, caCert *x509.Certificate, caKey *ecdsa.PrivateKey, clks []*clock.Mock, verifiers []uint64,
+	clientKeyGetter func(string) (crypto.PrivateKey, error), clientConfig *proto.Config, teardown func(),
+) {
+	return setupRealmWithConfig(t, nReplicas, nVerifiers, func(*proto.ReplicaConfig) {})
+}
+
+// setupRealmWithConfig is like setupRealmWithCA, but calls configure on the
+// configuration of each replica before it is opened.
+func setupRealmWithConfig(t *testing.T, nReplicas, nVerifiers int, configure func(*proto.ReplicaConfig)) (
+	kss []*Keyserver, caPool *x509.CertPool, caCert *x509.Certificate, caKey *ecdsa.PrivateKey, clks []*clock.Mock, verifiers []uint64,
+	clientKeyGetter func(string) (crypto.PrivateKey, error), clientConfig *proto.Config, teardown func(),
 ) {
 	cfgs, gks, ck, clientConfig, caCert, caPool, caKey, teardown := setupKeyservers(t, nReplicas)
+	for _, cfg := range cfgs {
+		configure(cfg)
+	}
+	if suite := cfgs[0].VRFSuite; suite != proto.VRF_CONAME {
+		// setupKeyservers generated a VRF key for the default suite
+		vrfPublic, vrfSecret, err := vrf.Suite(suite).GenerateKey(rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i := range gks {
+			getKey := gks[i]
+			gks[i] = func(keyid string) (crypto.PrivateKey, error) {
+				if keyid == cfgs[0].VRFKeyID {
+					return vrfSecret, nil
+				}
+				return getKey(keyid)
+			}
+		}
+		clientConfig.Realms[0].VRFPublic = vrfPublic
+		clientConfig.Realms[0].VRFSuite = suite
+	}
 	logs, dbs, clks, _, teardown2 := setupRaftLogCluster(t, nReplicas, 0)
 	teardown = chain(teardown2, teardown)
 
@@ -885,6 +1035,10 @@ func setupRealm(t *testing.T, nReplicas, nVerifiers int) (
 			var getKey func(string) (crypto.PrivateKey, error)
 			vcfg, getKey, vdb, vpks[i], verifierTeardown = setupVerifier(t, clientConfig.Realms[0].VerificationPolicy,
 				kss[i%nReplicas].verifierListen.Addr().String(), caCert, caPool, caKey)
+			vcfg.DeletionPolicy = cfgs[0].DeletionPolicy
+			vcfg.DomainAdminPolicies = cfgs[0].DomainAdminPolicies
+			vcfg.VRFPublic = clientConfig.Realms[0].VRFPublic
+			vcfg.VRFSuite = clientConfig.Realms[0].VRFSuite
 
 			vr, err := verifier.Start(vcfg, vdb, getKey)
 			if err != nil {
@@ -899,7 +1053,8 @@ func setupRealm(t *testing.T, nReplicas, nVerifiers int) (
 		if err != nil {
 			t.Fatal(err)
 		}
-		ks.insecureSkipEmailProof = true
+		// tests that configure a registration policy want it enforced
+		ks.insecureSkipEmailProof = len(cfgs[i].RegistrationPolicy) == 0
 		ks.Start()
 		teardown = chain(ks.Stop, teardown)
 		kss = append(kss, ks)
@@ -938,7 +1093,7 @@ loop:
 		pol.PublicKeys[proto.KeyID(vpks[i])] = vpks[i]
 	}
 	clientConfig.Realms[0].VerificationPolicy = pol
-	return kss, caPool, clks, verifiers, ck, clientConfig, teardown
+	return kss, caPool, caCert, caKey, clks, verifiers, ck, clientConfig, teardown
 }
 
 func copyAuthorizationPolicy(pol *proto.AuthorizationPolicy) *proto.AuthorizationPolicy {
@@ -997,6 +1152,354 @@ func TestKeyserverLookupRequireThreeVerifiers(t *testing.T) {
 	}
 }
 
+func TestKeyserverLookupPartialRatification(t *testing.T) {
+	dieOnCtrlC()
+	kss, _, clks, _, _, clientConfig, teardown := setupRealm(t, 3, 1)
+	defer teardown()
+	stop := stoppableSyncedClocks(clks)
+	defer close(stop)
+
+	quorum := clientConfig.Realms[0].VerificationPolicy.GetQuorum()
+	waitForFirstEpoch(kss[0], quorum)
+
+	// no verifier with this ID exists, so the quorum can never be met
+	absentVerifier := uint64(0xdeadbeef)
+	unmet := &proto.QuorumExpr{Threshold: 2, Subexpressions: []*proto.QuorumExpr{
+		quorum, &proto.QuorumExpr{Threshold: 1, Candidates: []uint64{absentVerifier}},
+	}}
+	if _, err := kss[0].Lookup(context.Background(), &proto.LookupRequest{UserId: alice, QuorumRequirement: unmet}); grpc.Code(err) != codes.Unavailable {
+		t.Fatalf("lookup with an unmet quorum returned %v", err)
+	}
+
+	proof, err := kss[0].Lookup(context.Background(), &proto.LookupRequest{
+		UserId:                   alice,
+		QuorumRequirement:        unmet,
+		AllowPartialRatification: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proof.QuorumNotMet {
+		t.Error("quorum of an absent verifier was not flagged as not met")
+	}
+	// a client whose policy does not include the absent verifier can still
+	// verify the answer
+	if _, err := coname.VerifyLookup(clientConfig, alice, proof, clks[0].Now()); err != nil {
+		t.Fatal(err)
+	}
+
+	epoch := proof.Ratifications[0].Head.Head.Epoch
+	proof, err = kss[0].Lookup(context.Background(), &proto.LookupRequest{
+		Epoch:                    epoch,
+		UserId:                   alice,
+		QuorumRequirement:        unmet,
+		AllowPartialRatification: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proof.QuorumNotMet {
+		t.Errorf("quorum of an absent verifier was not flagged as not met in epoch %d", epoch)
+	}
+	if _, err := coname.VerifyLookup(clientConfig, alice, proof, clks[0].Now()); err != nil {
+		t.Fatal(err)
+	}
+
+	// the flag is only set if the quorum is actually not met
+	proof, err = kss[0].Lookup(context.Background(), &proto.LookupRequest{
+		UserId:                   alice,
+		QuorumRequirement:        quorum,
+		AllowPartialRatification: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if proof.QuorumNotMet {
+		t.Error("quorum that is met was flagged as not met")
+	}
+}
+
+func TestVerifierBootstrapFromCheckpoint(t *testing.T) {
+	dieOnCtrlC()
+	kss, caPool, caCert, caKey, clks, verifiers, ck, clientConfig, teardown := setupRealmWithCA(t, 3, 2)
+	defer teardown()
+	stop := stoppableSyncedClocks(clks)
+	defer close(stop)
+
+	waitForFirstEpoch(kss[0], clientConfig.Realms[0].VerificationPolicy.GetQuorum())
+
+	clientTLS, err := clientConfig.Realms[0].ClientTLS.Config(ck)
+	if err != nil {
+		t.Fatal(err)
+	}
+	doRegister(t, kss[0], clientConfig, clientTLS, caPool, clks[0].Now(), alice, 0, proto.Profile{
+		Nonce: []byte("noncenoncenonceNONCE"),
+		Keys:  map[string][]byte{"abc": []byte{1, 2, 3}, "xyz": []byte("TEST 456")},
+	})
+	checkpointQuorum := &proto.QuorumExpr{Candidates: verifiers, Threshold: uint32(len(verifiers))}
+	checkpointEpoch, err := getLatestEpoch(kss[0], checkpointQuorum)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pol := clientConfig.Realms[0].VerificationPolicy
+	keyserverAuth := &proto.AuthorizationPolicy{
+		PublicKeys: pol.PublicKeys,
+		PolicyType: &proto.AuthorizationPolicy_Quorum{Quorum: pol.GetQuorum().Subexpressions[0]},
+	}
+	vcfg, getKey, vdb, _, vteardown := setupVerifier(t, keyserverAuth, kss[0].verifierListen.Addr().String(), caCert, caPool, caKey)
+	defer vteardown()
+	vcfg.CheckpointRatifiers = &proto.AuthorizationPolicy{
+		PublicKeys: pol.PublicKeys,
+		PolicyType: &proto.AuthorizationPolicy_Quorum{Quorum: checkpointQuorum},
+	}
+	vr, err := verifier.Start(vcfg, vdb, getKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer vr.Stop()
+
+	// wait for the new verifier to ratify an epoch after the checkpoint
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if _, err := kss[0].blockingLookup(ctx, &proto.LookupRequest{
+		UserId:            alice,
+		QuorumRequirement: &proto.QuorumExpr{Candidates: []uint64{vcfg.ID}, Threshold: 1},
+	}, checkpointEpoch+1); err != nil {
+		t.Fatal(err)
+	}
+	for epoch := uint64(1); epoch <= checkpointEpoch; epoch++ {
+		if _, err := kss[0].db.Get(tableRatifications(epoch, vcfg.ID)); err != kss[0].db.ErrNotFound() {
+			t.Errorf("verifier bootstrapped at epoch %d ratified epoch %d (err=%v)", checkpointEpoch, epoch, err)
+		}
+	}
+}
+
+func TestVerifierGossipDetectsEquivocation(t *testing.T) {
+	dieOnCtrlC()
+	kss, caPool, caCert, caKey, clks, _, _, clientConfig, teardown := setupRealmWithCA(t, 1, 0)
+	defer teardown()
+	stop := stoppableSyncedClocks(clks)
+	defer close(stop)
+
+	// two verifiers that gossip with each other
+	var addrs [2]string
+	for i := range addrs {
+		l, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		addrs[i] = l.Addr().String()
+		l.Close()
+	}
+	var vcfgs [2]*proto.VerifierConfig
+	var getKeys [2]func(string) (crypto.PrivateKey, error)
+	var vdbs [2]kv.DB
+	for i := range vcfgs {
+		var getKey func(string) (crypto.PrivateKey, error)
+		var vteardown func()
+		vcfgs[i], getKey, vdbs[i], _, vteardown = setupVerifier(t, clientConfig.Realms[0].VerificationPolicy,
+			kss[0].verifierListen.Addr().String(), caCert, caPool, caKey)
+		defer vteardown()
+		gossipCert := tlstestutil.Cert(t, caCert, caKey, "127.0.0.1", nil)
+		getKeys[i] = func(keyid string) (crypto.PrivateKey, error) {
+			if keyid == "gossip" {
+				return gossipCert.PrivateKey, nil
+			}
+			return getKey(keyid)
+		}
+		vcfgs[i].GossipAddr = addrs[i]
+		vcfgs[i].GossipTLS = &proto.TLSConfig{
+			Certificates: []*proto.CertificateAndKeyID{{Certificate: gossipCert.Certificate, KeyID: "gossip"}},
+			ClientCAs:    [][]byte{caCert.Raw},
+			ClientAuth:   proto.REQUIRE_AND_VERIFY_CLIENT_CERT,
+		}
+		vcfgs[i].GossipInterval = proto.DurationStamp(10 * time.Millisecond)
+	}
+	var vrs [2]*verifier.Verifier
+	for i := range vcfgs {
+		vcfgs[i].GossipPeers = []*proto.GossipPeer{{ID: vcfgs[1-i].ID, Addr: addrs[1-i]}}
+		vr, err := verifier.Start(vcfgs[i], vdbs[i], getKeys[i])
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer vr.Stop()
+		vrs[i] = vr
+	}
+	if _, err := kss[0].blockingLookup(context.Background(), &proto.LookupRequest{
+		UserId:            alice,
+		QuorumRequirement: &proto.QuorumExpr{Candidates: []uint64{vcfgs[0].ID, vcfgs[1].ID}, Threshold: 2},
+	}, 2); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(50 * time.Millisecond) // a few rounds of gossip
+	for i := range vrs {
+		if eqs, err := vrs[i].Equivocations(); err != nil || len(eqs) != 0 {
+			t.Fatalf("honest keyserver accused of equivocation: %v, %v", eqs, err)
+		}
+	}
+
+	// Pretending to be the second verifier, show the first one a different
+	// head of epoch 1, signed by the keyserver.
+	var teh proto.EncodedTimestampedEpochHead
+	tehBytes, err := kss[0].db.Get(tableEpochHeads(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := teh.Unmarshal(tehBytes); err != nil {
+		t.Fatal(err)
+	}
+	teh.Head.RootHash = make([]byte, len(teh.Head.RootHash))
+	teh.Head.UpdateEncoding()
+	teh.UpdateEncoding()
+	sig, err := kss[0].sehSigner.Sign(teh.Encoding)
+	if err != nil {
+		t.Fatal(err)
+	}
+	forged := &proto.SignedEpochHead{
+		Head:       teh,
+		Signatures: map[uint64][]byte{kss[0].replicaID: sig},
+	}
+	peerTLS, err := vcfgs[1].TLS.Config(getKeys[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	conn, err := grpc.Dial(addrs[0], grpc.WithTransportCredentials(credentials.NewTLS(peerTLS)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	if _, err := proto.NewE2EKSVerifierGossipClient(conn).Gossip(context.Background(), &proto.EpochHeadGossip{
+		Heads: []*proto.SignedEpochHead{forged},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	eqs, err := vrs[0].Equivocations()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(eqs) != 1 {
+		t.Fatalf("expected 1 equivocation, got %d", len(eqs))
+	}
+	if got, want := eqs[0].Peer, vcfgs[1].ID; got != want {
+		t.Errorf("equivocation reported by %x, expected %x", got, want)
+	}
+	if got, want := eqs[0].Theirs.Head.Head.Encoding, forged.Head.Head.Encoding; !bytes.Equal(got, want) {
+		t.Errorf("equivocation evidence has head %x, expected %x", got, want)
+	}
+}
+
+// proposalRecorder is a LogReplicator that only records proposals.
+type proposalRecorder struct {
+	replication.LogReplicator
+	proposals chan replication.LogEntry
+}
+
+func (r *proposalRecorder) Propose(ctx context.Context, data replication.LogEntry) {
+	r.proposals <- data
+}
+
+func TestPreparingProposerRetriesFailedPreparation(t *testing.T) {
+	r := &proposalRecorder{proposals: make(chan replication.LogEntry, 1)}
+	failures := 2
+	p := StartPreparingProposer(r, clock.New(), time.Millisecond, func() (replication.LogEntry, error) {
+		if failures > 0 {
+			failures--
+			return replication.LogEntry{}, fmt.Errorf("signer unavailable")
+		}
+		return replication.LogEntry{Data: []byte("signed")}, nil
+	})
+	defer p.Stop()
+	select {
+	case e := <-r.proposals:
+		if failures != 0 {
+			t.Errorf("proposed with %d failures to go", failures)
+		}
+		if string(e.Data) != "signed" {
+			t.Errorf("proposed %q, expected %q", e.Data, "signed")
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatalf("nothing proposed after preparation failed")
+	}
+}
+
+func TestKeyserverRefreshesIdleEpoch(t *testing.T) {
+	dieOnCtrlC()
+	kss, _, _, _, clks, verifiers, ck, clientConfig, teardown := setupRealmWithConfig(t, 3, 1, func(cfg *proto.ReplicaConfig) {
+		cfg.RefreshIdleEpochs = true
+	})
+	defer teardown()
+	stop := stoppableSyncedClocks(clks)
+	defer close(stop)
+
+	waitForFirstEpoch(kss[0], clientConfig.Realms[0].VerificationPolicy.GetQuorum())
+
+	// wait for the verifier to sign a refresh of epoch 1
+	var refreshed *proto.SignedEpochHead
+	for deadline := time.Now().Add(10 * time.Second); refreshed == nil; time.Sleep(poll) {
+		if time.Now().After(deadline) {
+			t.Fatalf("verifier did not sign a refresh of epoch 1")
+		}
+		sehBytes, err := kss[0].db.Get(tableRatifications(1, verifiers[0]))
+		if err != nil {
+			t.Fatal(err)
+		}
+		seh := new(proto.SignedEpochHead)
+		if err := seh.Unmarshal(sehBytes); err != nil {
+			t.Fatal(err)
+		}
+		// the verifier signs epochs with its own (real) clock, but refreshes
+		// with the timestamp chosen by the keyserver (in mock time)
+		if ts := seh.Head.Timestamp.Time(); seh.Head.Refresh && ts.After(seh.Head.Head.IssueTime.Time()) && !ts.After(clks[0].Now()) {
+			refreshed = seh
+		}
+	}
+	for _, ks := range kss {
+		if _, err := ks.db.Get(tableEpochHeads(2)); err != ks.db.ErrNotFound() {
+			t.Errorf("idle keyserver created epoch 2 (err=%v)", err)
+		}
+	}
+
+	clientTLS, err := clientConfig.Realms[0].ClientTLS.Config(ck)
+	if err != nil {
+		t.Fatal(err)
+	}
+	conn, err := grpc.Dial(kss[0].publicListen.Addr().String(), grpc.WithTransportCredentials(credentials.NewTLS(clientTLS)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	proof, err := proto.NewE2EKSPublicClient(conn).Lookup(context.Background(), &proto.LookupRequest{
+		UserId:            alice,
+		QuorumRequirement: clientConfig.Realms[0].VerificationPolicy.GetQuorum(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if proof.Ratifications[0].Head.Head.Epoch != 1 {
+		t.Fatalf("lookup returned epoch %d, expected 1", proof.Ratifications[0].Head.Head.Epoch)
+	}
+
+	// epoch 1 itself has expired at now, but its refreshes have not
+	issueTime, refreshTime := refreshed.Head.Head.IssueTime.Time(), refreshed.Head.Timestamp.Time()
+	ttl := refreshTime.Sub(issueTime) / 2
+	now := refreshTime.Add(ttl)
+	clientConfig.Realms[0].EpochTimeToLive = proto.DurationStamp(ttl)
+	if _, err := coname.VerifyLookup(clientConfig, alice, proof, now); err != nil {
+		t.Fatal(err)
+	}
+	// the timestamps verifiers put on epochs they ratify do not count
+	latest := refreshTime
+	for _, seh := range proof.Ratifications {
+		if ts := seh.Head.Timestamp.Time(); seh.Head.Refresh && ts.After(latest) {
+			latest = ts
+		}
+	}
+	if _, err := coname.VerifyLookup(clientConfig, alice, proof, latest.Add(ttl+time.Second)); err == nil {
+		t.Fatalf("lookup verified after all signatures expired")
+	}
+}
+
 func TestKeyserverRejectsUnsignedUpdate(t *testing.T) {
 	dieOnCtrlC()
 	kss, caPool, clks, _, ck, clientConfig, teardown := setupRealm(t, 3, 3)
@@ -16,6 +16,7 @@ package keyserver
 
 import (
 	"encoding/binary"
+	"time"
 
 	"github.com/yahoo/coname/vrf"
 )
@@ -27,10 +28,17 @@ var (
 	tableVerifierLogPrefix                byte = 'v' // index uint64 -> proto.VerifierStep
 	tableRatificationsPrefix              byte = 'r' // epoch uint64, ratifier uint64 -> []byte (signature)
 	tableEpochHeadsPrefix                 byte = 'e' // epoch uint64 -> proto.TimestampedEpochHead
-	tableUpdateRequestsPrefix             byte = 'u' // vrfidx [vrf.Size]byte -> epoch uint64 -> proto.UpdateRequest
+	tableUpdateRequestsPrefix             byte = 'u' // vrfidx [vrf.Size]byte -> epoch uint64 -> proto.UpdateRequest, empty if the entry has been moved by a VRF key rotation since
 	tableMerkleTreeSnapshotPrefix         byte = 's' // epochNumber uint64 -> snapshotNumber uint64
 	tableMerkleTreePrefix                 byte = 't'
-	tableUpdatesPendingRatificationPrefix byte = 'p' // logIndex uint64 -> proto.SignedEntryUpdate
+	tableUpdatesPendingRatificationPrefix byte = 'p' // logIndex uint64 -> proto.SignedEntryUpdate, only written by older versions
+	tableStepsPendingRatificationPrefix   byte = 'q' // logIndex uint64, i uint64 -> proto.VerifierStep, the i-th step appended by that log entry (older versions omit i)
+	tableVerifierLogEpochsPrefix          byte = 'c' // epoch uint64 -> index uint64 of the epoch's step in the verifier log
+	tableEmailChallengesPrefix            byte = 'm' // vrfidx [vrf.Size]byte, entryHash [32]byte -> proto.EmailChallenge
+	tablePendingUpdatesPrefix             byte = 'w' // vrfidx [vrf.Size]byte, entryHash [32]byte -> proto.PendingUpdate waiting for an emailed DKIM proof of entryHash
+	tablePendingUpdateExpirationsPrefix   byte = 'x' // expiration uint64 (unix nanoseconds), vrfidx [vrf.Size]byte, entryHash [32]byte -> nothing, for deleting expired pending updates
+	tablePendingRecoveriesPrefix          byte = 'd' // vrfidx [vrf.Size]byte -> epoch uint64 -> proto.PendingRecovery, empty if none is pending since
+	tableRegistrationCountsPrefix         byte = 'n' // domain string -> epoch uint64, count uint64 of the registrations in that epoch
 
 	tableReplicaState = []byte{'e'} // proto.ReplicaState
 )
@@ -72,9 +80,54 @@ func tableMerkleTreeSnapshot(epoch uint64) []byte {
 	return ret
 }
 
-func tableUpdatesPendingRatification(logIndex uint64) []byte {
-	ret := make([]byte, 1+8)
-	ret[0] = tableUpdatesPendingRatificationPrefix
+func tableStepsPendingRatification(logIndex, i uint64) []byte {
+	ret := make([]byte, 1+8+8)
+	ret[0] = tableStepsPendingRatificationPrefix
 	binary.BigEndian.PutUint64(ret[1:1+8], logIndex)
+	binary.BigEndian.PutUint64(ret[1+8:1+8+8], i)
+	return ret
+}
+
+func tableVerifierLogEpochs(epoch uint64) []byte {
+	ret := make([]byte, 1+8)
+	ret[0] = tableVerifierLogEpochsPrefix
+	binary.BigEndian.PutUint64(ret[1:1+8], epoch)
+	return ret
+}
+
+func tableEmailChallenges(vrfidx, entryHash []byte) []byte {
+	ret := make([]byte, 1+vrf.Size+32)
+	ret[0] = tableEmailChallengesPrefix
+	copy(ret[1:1+vrf.Size], vrfidx)
+	copy(ret[1+vrf.Size:], entryHash)
+	return ret
+}
+
+func tablePendingUpdates(vrfidx, entryHash []byte) []byte {
+	ret := make([]byte, 1+vrf.Size+32)
+	ret[0] = tablePendingUpdatesPrefix
+	copy(ret[1:1+vrf.Size], vrfidx)
+	copy(ret[1+vrf.Size:1+vrf.Size+32], entryHash)
 	return ret
 }
+
+func tablePendingUpdateExpirations(expiration time.Time, vrfidx, entryHash []byte) []byte {
+	ret := make([]byte, 1+8+vrf.Size+32)
+	ret[0] = tablePendingUpdateExpirationsPrefix
+	binary.BigEndian.PutUint64(ret[1:1+8], uint64(expiration.UnixNano()))
+	copy(ret[1+8:1+8+vrf.Size], vrfidx)
+	copy(ret[1+8+vrf.Size:1+8+vrf.Size+32], entryHash)
+	return ret
+}
+
+func tablePendingRecoveries(vrfidx []byte, epoch uint64) []byte {
+	ret := make([]byte, 1+vrf.Size+8)
+	ret[0] = tablePendingRecoveriesPrefix
+	copy(ret[1:1+vrf.Size], vrfidx)
+	binary.BigEndian.PutUint64(ret[1+vrf.Size:1+vrf.Size+8], epoch)
+	return ret
+}
+
+func tableRegistrationCounts(domain string) []byte {
+	return append([]byte{tableRegistrationCountsPrefix}, domain...)
+}
@@ -16,124 +16,103 @@ package keyserver
 
 import (
 	"bytes"
-	"encoding/base64"
-	"fmt"
 	"log"
 	"math"
-	"strings"
+	"time"
 
 	"github.com/yahoo/coname"
-	"github.com/yahoo/coname/keyserver/dkim"
-	"github.com/yahoo/coname/keyserver/oidc"
 	"github.com/yahoo/coname/keyserver/replication"
-	"github.com/yahoo/coname/keyserver/saml"
 	"github.com/yahoo/coname/proto"
 	"github.com/yahoo/coname/vrf"
 	"golang.org/x/crypto/sha3"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 )
 
-func (ks *Keyserver) verifyUpdateDeterministic(prevUpdate *proto.UpdateRequest, req *proto.UpdateRequest) error {
-	if got, want := vrf.Compute([]byte(req.LookupParameters.UserId), ks.vrfSecret), req.Update.NewEntry.Index; !bytes.Equal(got, want) {
-		return fmt.Errorf("incorrect index for user %s: got %x, expected %x", req.LookupParameters.UserId, got, want)
+func (ks *Keyserver) verifyIndex(req *proto.UpdateRequest) error {
+	if got, want := ks.vrfSuite.Compute([]byte(req.LookupParameters.UserId), ks.currentVRFSecret()), req.Update.NewEntry.Index; !bytes.Equal(got, want) {
+		return grpc.Errorf(codes.InvalidArgument, "incorrect index for user %s: got %x, expected %x", req.LookupParameters.UserId, got, want)
+	}
+	return nil
+}
+
+// registered returns whether the user ID is registered after prevUpdate, the
+// last update of its entry (nil if none).
+func registered(prevUpdate *proto.UpdateRequest) bool {
+	return prevUpdate != nil && !prevUpdate.Update.NewEntry.Deleted
+}
+
+// verifyUpdateDeterministic checks req against the previous update of the
+// same entry (nil if none) and the pending recovery of it (nil if none), the
+// last epoch head having been issued at lastEpochTime. If req is only
+// authorized by the domain admin policy for the user ID, admin is true.
+func (ks *Keyserver) verifyUpdateDeterministic(prevUpdate *proto.UpdateRequest, recovery *proto.PendingRecovery, req *proto.UpdateRequest, lastEpochTime time.Time) (admin bool, err error) {
+	if err := ks.verifyIndex(req); err != nil {
+		return false, err
 	}
 	var prevEntry *proto.Entry
 	if prevUpdate != nil {
 		prevEntry = &prevUpdate.Update.NewEntry.Entry
 	}
-	if err := coname.VerifyUpdate(prevEntry, req.Update); err != nil {
+	if req.Update.NewEntry.Deleted {
+		err = coname.VerifyDeletion(prevEntry, req.Update, ks.deletionPolicy)
+	} else {
+		err = coname.VerifyUpdateOrRecovery(prevEntry, recovery, req.Update, lastEpochTime)
+	}
+	if err == nil {
+		return false, nil
+	}
+	adminPolicy := coname.GetDomainAdminPolicy(ks.domainAdminPolicies, req.LookupParameters.UserId)
+	if adminPolicy == nil || coname.VerifyAdminUpdate(prevEntry, req.Update, adminPolicy) != nil {
+		return false, withCode(codes.FailedPrecondition, err)
+	}
+	return true, nil
+}
+
+// verifyEmailProof checks that req.EmailProof shows the ownership of the
+// requested user ID by one of the registration policies.
+func (ks *Keyserver) verifyEmailProof(ctx context.Context, req *proto.UpdateRequest) error {
+	if ks.insecureSkipEmailProof {
+		return nil
+	}
+	if req.EmailProof == nil {
+		return grpc.Errorf(codes.Unauthenticated, "No email proof provided")
+	}
+	p, err := ks.registrationPolicy(emailProofType(req.EmailProof), req.LookupParameters.UserId)
+	if err != nil {
 		return err
 	}
-	return nil
+	var entryHash [32]byte
+	sha3.ShakeSum256(entryHash[:], req.Update.NewEntry.Encoding)
+	// errors without a code are rejections of the proof, expired proofs
+	// have been marked by ProofExpired
+	return withCode(codes.PermissionDenied, p.verifier.Verify(ctx, req.LookupParameters.UserId, entryHash[:], req.EmailProof))
 }
 
-func (ks *Keyserver) verifyUpdateEdge(req *proto.UpdateRequest) error {
+func (ks *Keyserver) verifyUpdateEdge(ctx context.Context, req *proto.UpdateRequest) error {
 	if len(req.Update.NewEntry.Index) != vrf.Size {
-		return fmt.Errorf("index '%x' has wrong length (expected %d)", req.Update.NewEntry.Index, vrf.Size)
+		return grpc.Errorf(codes.InvalidArgument, "index '%x' has wrong length (expected %d)", req.Update.NewEntry.Index, vrf.Size)
 	}
 	prevUpdate, err := ks.getUpdate(req.Update.NewEntry.Index, math.MaxUint64)
 	if err != nil {
 		log.Print(err)
-		return fmt.Errorf("internal error")
+		return errInternal
 	}
-	if prevUpdate == nil { // registration: check email proof
-		if !ks.insecureSkipEmailProof {
-			if req.EmailProof == nil {
-				return fmt.Errorf("No email proof provided")
-			}
-
-			lastAtIndex := strings.LastIndex(req.LookupParameters.UserId, "@")
-			if lastAtIndex == -1 {
-				return fmt.Errorf("requested user id is not a valid email address: %q", req.LookupParameters.UserId)
-			}
-			// determine registration type
-			switch t := req.EmailProof.ProofType.(type) {
-			case *proto.EmailProof_DKIMProof:
-				if _, ok := ks.dkimProofAllowedDomains[req.LookupParameters.UserId[lastAtIndex+1:]]; !ok {
-					return fmt.Errorf("domain not in registration whitelist: %q", req.LookupParameters.UserId[lastAtIndex+1:])
-
-				}
-				email, payload, err := dkim.CheckEmailProof(t.DKIMProof, ks.dkimProofToAddr, ks.dkimProofToAddr, ks.lookupTXT, ks.clk.Now)
-				if err != nil {
-					return fmt.Errorf("failed to verify DKIM proof: %s", err)
-				}
-				if got, want := email, req.LookupParameters.UserId; got != want {
-					return fmt.Errorf("requested user ID does not match the email proof: %q != %q", got, want)
-				}
-				entryHash, err := base64.StdEncoding.DecodeString(payload)
-				if err != nil {
-					return fmt.Errorf("bad base64 in email proof: %q", payload)
-				}
-				var entryHashProposed [32]byte
-				sha3.ShakeSum256(entryHashProposed[:], req.Update.NewEntry.Encoding)
-				if !bytes.Equal(entryHashProposed[:], entryHash[:]) {
-					return fmt.Errorf("email proof does not match requested entry: %s vs %s (%x)", base64.StdEncoding.EncodeToString(entryHashProposed[:]), payload, req.Update.NewEntry.Encoding)
-				}
-
-			case *proto.EmailProof_OIDCToken:
-				found := false
-				for _, oc := range ks.oidcProofConfig {
-					if _, ok := oc.allowedDomains[req.LookupParameters.UserId[lastAtIndex+1:]]; !ok {
-						continue
-					}
-					found = true
-					email, err := oc.oidcClient.VerifyIDToken(t.OIDCToken)
-					if err != nil {
-						if _, ok := err.(*oidc.ErrExpired); ok {
-							return &errExpired{err: err}
-						}
-						return err
-					}
-					if got, want := email, req.LookupParameters.UserId; got != want {
-						return fmt.Errorf("requested user ID does not match the email proof: %q != %q", got, want)
-					}
-				}
-				if !found {
-					return fmt.Errorf("domain not in registration whitelist: %q", req.LookupParameters.UserId[lastAtIndex+1:])
-				}
-
-			case *proto.EmailProof_SAMLResponse:
-				if _, ok := ks.samlProofAllowedDomains[req.LookupParameters.UserId[lastAtIndex+1:]]; !ok {
-					return fmt.Errorf("domain not in registration whitelist: %q", req.LookupParameters.UserId[lastAtIndex+1:])
-
-				}
-				email, err := saml.VerifySAMLResponse(t.SAMLResponse, ks.samlProofIDPCert, ks.samlProofConsumerServiceURL, "EmailAddress", ks.samlProofValidity)
-				if err != nil {
-					if _, ok := err.(*saml.ErrExpired); ok {
-						return &errExpired{err: err}
-					}
-					return err
-				}
-				if got, want := email, req.LookupParameters.UserId; got != want {
-					return fmt.Errorf("requested user ID does not match the email proof: %q != %q", got, want)
-				}
-
-			default:
-				return fmt.Errorf("Invalid email proof type: %T", t)
-			}
+	if !registered(prevUpdate) && !req.Update.NewEntry.Deleted { // registration: check email proof
+		if err := ks.verifyEmailProof(ctx, req); err != nil {
+			return err
 		}
 	}
-	return ks.verifyUpdateDeterministic(prevUpdate, req)
+	recovery, err := ks.getPendingRecovery(req.Update.NewEntry.Index, math.MaxUint64)
+	if err != nil {
+		log.Print(err)
+		return errInternal
+	}
+	// the step checks a completed recovery against the issue time of the
+	// last epoch head, which is never later than now
+	_, err = ks.verifyUpdateDeterministic(prevUpdate, recovery, req, ks.clk.Now())
+	return err
 }
 
 type updateOutput struct {
@@ -144,7 +123,10 @@ type updateOutput struct {
 // Update implements proto.E2EKS.UpdateServer
 func (ks *Keyserver) Update(ctx context.Context, req *proto.UpdateRequest) (*proto.LookupProof, error) {
 	ctx, _ = context.WithTimeout(ctx, ks.clientTimeout)
-	if err := ks.verifyUpdateEdge(req); err != nil {
+	if err := ks.checkRateLimit(ctx, req.LookupParameters.UserId); err != nil {
+		return nil, err
+	}
+	if err := ks.verifyUpdateEdge(ctx, req); err != nil {
 		return nil, err
 	}
 
@@ -157,7 +139,7 @@ func (ks *Keyserver) Update(ctx context.Context, req *proto.UpdateRequest) (*pro
 	select {
 	case <-ctx.Done():
 		ks.wr.Notify(uid, nil)
-		return nil, ctx.Err()
+		return nil, contextError(ctx.Err())
 	case v := <-ch:
 		out := v.(updateOutput)
 		if out.Error != nil {
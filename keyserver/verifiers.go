@@ -0,0 +1,84 @@
+// Copyright 2014-2015 The Dename Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy of
+// the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations under
+// the License.
+
+package keyserver
+
+import (
+	"encoding/binary"
+	"log"
+	"sort"
+
+	"github.com/yahoo/coname/keyserver/kv"
+	"github.com/yahoo/coname/proto"
+	"golang.org/x/net/context"
+)
+
+type verifierInfosByID []*proto.VerifierInfo
+
+func (s verifierInfosByID) Len() int           { return len(s) }
+func (s verifierInfosByID) Less(i, j int) bool { return s[i].ID < s[j].ID }
+func (s verifierInfosByID) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// ListVerifiers implements proto.E2EKSPublicServer. It lists the configured
+// verifiers and all others whose ratifications are in the epochs scanned for
+// lookups, but not the replicas of the keyserver itself.
+func (ks *Keyserver) ListVerifiers(ctx context.Context, req *proto.ListVerifiersRequest) (*proto.ListVerifiersResponse, error) {
+	if err := ks.checkClientRateLimit(ctx); err != nil {
+		return nil, err
+	}
+	latest, err := ks.latestRatifiedEpochs()
+	if err != nil {
+		log.Printf("ERROR: latestRatifiedEpochs: %s", err)
+		return nil, errInternal
+	}
+	infos := make(map[uint64]*proto.VerifierInfo)
+	for id, pk := range ks.verifierPublicKeys {
+		infos[id] = &proto.VerifierInfo{ID: id, PublicKey: pk}
+	}
+	for id, epoch := range latest {
+		if _, ok := ks.serverAuthorized.PublicKeys[id]; ok {
+			continue
+		}
+		if infos[id] == nil {
+			infos[id] = &proto.VerifierInfo{ID: id}
+		}
+		infos[id].LatestRatifiedEpoch = epoch
+	}
+	ret := &proto.ListVerifiersResponse{Verifiers: make([]*proto.VerifierInfo, 0, len(infos))}
+	for _, info := range infos {
+		ret.Verifiers = append(ret.Verifiers, info)
+	}
+	sort.Sort(verifierInfosByID(ret.Verifiers))
+	return ret, nil
+}
+
+// latestRatifiedEpochs returns the latest epoch ratified by each signer among
+// the last laggingVerifierScan epochs.
+func (ks *Keyserver) latestRatifiedEpochs() (map[uint64]uint64, error) {
+	ret := make(map[uint64]uint64)
+	oldestEpoch, newestEpoch := uint64(1), ks.lastSignedEpoch()
+	if newestEpoch == 0 {
+		return ret, nil
+	}
+	if newestEpoch-oldestEpoch > ks.laggingVerifierScan { // careful with overflows!
+		oldestEpoch = newestEpoch - ks.laggingVerifierScan
+	}
+	iter := ks.db.NewIterator(&kv.Range{Start: tableRatifications(oldestEpoch, 0), Limit: tableRatifications(newestEpoch+1, 0)})
+	defer iter.Release()
+	for iter.Next() {
+		// the keys are sorted by epoch, so later epochs overwrite earlier ones
+		ret[binary.BigEndian.Uint64(iter.Key()[1+8:1+8+8])] = binary.BigEndian.Uint64(iter.Key()[1 : 1+8])
+	}
+	return ret, iter.Error()
+}
@@ -0,0 +1,96 @@
+// Copyright 2014-2015 The Dename Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy of
+// the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations under
+// the License.
+
+package keyserver
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/agl/ed25519"
+	"github.com/yahoo/coname"
+	"github.com/yahoo/coname/proto"
+	"golang.org/x/net/context"
+)
+
+func TestKeyserverListVerifiers(t *testing.T) {
+	dieOnCtrlC()
+	kss, _, clks, verifiers, _, clientConfig, teardown := setupRealm(t, 3, 2)
+	defer teardown()
+	stop := stoppableSyncedClocks(clks)
+	defer close(stop)
+
+	policy := clientConfig.Realms[0].VerificationPolicy
+	waitForFirstEpoch(kss[0], policy.GetQuorum())
+
+	// only the first verifier is configured, the second one is known from its
+	// ratifications only
+	configured := policy.PublicKeys[verifiers[0]]
+	kss[0].verifierPublicKeys = map[uint64]*proto.PublicKey{verifiers[0]: configured}
+	// a configured verifier that has not ratified anything is listed as well
+	absentPK, _, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	absent := &proto.PublicKey{PubkeyType: &proto.PublicKey_Ed25519{Ed25519: absentPK[:]}}
+	kss[0].verifierPublicKeys[proto.KeyID(absent)] = absent
+
+	rep, err := kss[0].ListVerifiers(context.Background(), &proto.ListVerifiersRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rep.Verifiers) != 3 {
+		t.Fatalf("expected 3 verifiers (and no replicas), got %v", rep.Verifiers)
+	}
+	listed := make(map[uint64]*proto.VerifierInfo)
+	for i, v := range rep.Verifiers {
+		if i > 0 && rep.Verifiers[i-1].ID >= v.ID {
+			t.Errorf("verifiers not sorted by ID: %v", rep.Verifiers)
+		}
+		listed[v.ID] = v
+	}
+	for _, id := range verifiers {
+		if listed[id] == nil || listed[id].LatestRatifiedEpoch == 0 {
+			t.Errorf("verifier %x not listed with a ratified epoch: %v", id, listed[id])
+		}
+	}
+	if !listed[verifiers[0]].PublicKey.Equal(configured) {
+		t.Errorf("configured key of verifier %x not listed", verifiers[0])
+	}
+	if listed[verifiers[1]].PublicKey != nil {
+		t.Errorf("unconfigured verifier %x listed with key %v", verifiers[1], listed[verifiers[1]].PublicKey)
+	}
+	if v := listed[proto.KeyID(absent)]; v == nil || v.LatestRatifiedEpoch != 0 {
+		t.Errorf("absent verifier listed as %v", v)
+	}
+
+	trusted := []*proto.PublicKey{configured, policy.PublicKeys[verifiers[1]], absent}
+	if _, err := coname.QuorumForTrustedVerifiers(trusted, 3, rep.Verifiers); err == nil {
+		t.Error("quorum including a verifier that has not ratified anything was accepted")
+	}
+	quorum, err := coname.QuorumForTrustedVerifiers(trusted, 2, rep.Verifiers)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the client still needs the ratifications required by its realm config
+	proof, err := kss[0].Lookup(context.Background(), &proto.LookupRequest{UserId: alice, QuorumRequirement: &proto.QuorumExpr{
+		Threshold: 2, Subexpressions: []*proto.QuorumExpr{quorum, policy.GetQuorum()},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := coname.VerifyLookup(clientConfig, alice, proof, clks[0].Now()); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -15,6 +15,7 @@
 package keyserver
 
 import (
+	"bytes"
 	"encoding/binary"
 	"fmt"
 	"log"
@@ -22,12 +23,15 @@ import (
 	"strconv"
 	"strings"
 
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/peer"
 
 	"github.com/yahoo/coname/keyserver/kv"
 	"github.com/yahoo/coname/keyserver/replication"
 	"github.com/yahoo/coname/proto"
+	"github.com/yahoo/coname/vrf"
 	"golang.org/x/net/context"
 )
 
@@ -47,19 +51,19 @@ func (ks *Keyserver) VerifierStream(rq *proto.VerifierStreamRequest, stream prot
 			select {
 			case <-stream.Context().Done():
 				iter.Release()
-				return stream.Context().Err()
+				return contextError(stream.Context().Err())
 			default:
 			}
 			dbIdx := binary.BigEndian.Uint64(iter.Key()[1:])
 			if dbIdx != start {
 				log.Printf("ERROR: non-consecutive entries in verifier log (wanted %d, got %d)", start, dbIdx)
 				iter.Release()
-				return fmt.Errorf("internal error")
+				return errInternal
 			}
 			if err := step.Unmarshal(iter.Value()); err != nil {
 				log.Printf("ERROR: invalid protobuf entry in verifier log (index %d)", start)
 				iter.Release()
-				return fmt.Errorf("internal error")
+				return errInternal
 			}
 			if err := stream.Send(&step); err != nil {
 				iter.Release()
@@ -70,7 +74,7 @@ func (ks *Keyserver) VerifierStream(rq *proto.VerifierStreamRequest, stream prot
 		iter.Release()
 		if err := iter.Error(); err != nil {
 			log.Printf("ERROR: range [tableVerifierLog(%d), tableVerifierLog(%d)) ended at %d (not included) with error %s", rq.Start, limit, start, err)
-			return fmt.Errorf("internal error")
+			return errInternal
 		}
 
 		// the requested entries are not in the db yet, so let's try to collect
@@ -80,7 +84,7 @@ func (ks *Keyserver) VerifierStream(rq *proto.VerifierStreamRequest, stream prot
 		for ch := ks.sb.Receive(start, limit); ch != nil && start < limit; start++ {
 			select {
 			case <-stream.Context().Done():
-				return stream.Context().Err()
+				return contextError(stream.Context().Err())
 			case sbStep, ok := <-ch: // declares new variable, a &const
 				if !ok {
 					// sb closed the connection. This must be because this
@@ -98,6 +102,181 @@ func (ks *Keyserver) VerifierStream(rq *proto.VerifierStreamRequest, stream prot
 	return nil
 }
 
+const (
+	// defaultVerifierBatchSize is the number of steps per VerifierStepBatch
+	// when the verifier does not request a batch size.
+	defaultVerifierBatchSize = 1024
+	// maxVerifierBatchSize bounds the requested batch size.
+	maxVerifierBatchSize = 1 << 14
+	// maxVerifierBatchBytes bounds the total size of the marshaled steps in
+	// a batch (before compression) so that batches fit in a gRPC message.
+	maxVerifierBatchBytes = 1 << 20
+)
+
+// VerifierBatchStream implements the interfaceE2EKSVerification interface from proto/verifier.proto
+func (ks *Keyserver) VerifierBatchStream(stream proto.E2EKSVerification_VerifierBatchStreamServer) error {
+	ctx := stream.Context()
+	first, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	rq := first.Open
+	if rq == nil {
+		return grpc.Errorf(codes.InvalidArgument, "VerifierBatchStream: first message must open the stream")
+	}
+	switch rq.Compression {
+	case proto.UNCOMPRESSED, proto.DEFLATE:
+	default:
+		return grpc.Errorf(codes.InvalidArgument, "VerifierBatchStream: unknown compression %d", rq.Compression)
+	}
+	batchSize := rq.BatchSize
+	if batchSize == 0 {
+		batchSize = defaultVerifierBatchSize
+	} else if batchSize > maxVerifierBatchSize {
+		batchSize = maxVerifierBatchSize
+	}
+	window := rq.Window
+	if window == 0 {
+		window = 1
+	}
+
+	// acks are read on a separate goroutine so that the verifier can
+	// acknowledge batches while this one is waiting for new steps. It exits
+	// when the stream is torn down, which happens when this function returns.
+	acks := make(chan uint64)
+	go func() {
+		defer close(acks)
+		for {
+			m, err := stream.Recv()
+			if err != nil {
+				return
+			}
+			select {
+			case acks <- m.Ack:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	var unacked []uint64 // NextIndex of each batch sent but not acknowledged
+	for start, limit := rq.Start, saturatingAdd(rq.Start, rq.PageSize); start < limit; {
+		for uint64(len(unacked)) >= window {
+			select {
+			case <-ctx.Done():
+				return contextError(ctx.Err())
+			case ack, ok := <-acks:
+				if !ok {
+					return nil // the verifier closed its side of the stream
+				}
+				for len(unacked) > 0 && unacked[0] <= ack {
+					unacked = unacked[1:]
+				}
+			}
+		}
+
+		batchLimit := saturatingAdd(start, batchSize)
+		if batchLimit > limit {
+			batchLimit = limit
+		}
+		steps, err := ks.verifierLogRange(start, batchLimit)
+		if err != nil {
+			return err
+		}
+		if len(steps) == 0 {
+			// Caught up with the db: wait for at least one new step on the
+			// sb and take whatever else it has ready, as in VerifierStream.
+			if steps, err = ks.verifierSBRange(ctx, start, batchLimit); err != nil {
+				return err
+			}
+			if len(steps) == 0 {
+				continue
+			}
+		}
+
+		encoded, err := proto.EncodeVerifierSteps(steps, rq.Compression)
+		if err != nil {
+			log.Printf("ERROR: failed to encode verifier steps [%d, %d): %s", start, start+uint64(len(steps)), err)
+			return errInternal
+		}
+		next := start + uint64(len(steps))
+		if err := stream.Send(&proto.VerifierStepBatch{
+			Start:       start,
+			NextIndex:   next,
+			Compression: rq.Compression,
+			Steps:       encoded,
+		}); err != nil {
+			return err
+		}
+		unacked = append(unacked, next)
+		start = next
+	}
+	return nil
+}
+
+// verifierSBRange waits for the verifier log entry start on ks.sb and returns
+// it, marshaled, followed by whatever entries with indices in [start, limit)
+// the sb has ready. It returns no entries if the entry start is in the db
+// instead. The sb subscription is cancelled on return.
+func (ks *Keyserver) verifierSBRange(ctx context.Context, start, limit uint64) ([][]byte, error) {
+	// ch=nil or a closed channel mean the next step is in the db.
+	ch := ks.sb.Receive(start, limit)
+	defer ks.sb.Cancel(ch)
+	if ch == nil {
+		return nil, nil
+	}
+	var ret [][]byte
+	size := 0
+	for idx := start; idx < limit && size < maxVerifierBatchBytes; idx++ {
+		var sbStep interface{}
+		var ok bool
+		if len(ret) == 0 {
+			select {
+			case <-ctx.Done():
+				return nil, contextError(ctx.Err())
+			case sbStep, ok = <-ch:
+			}
+		} else {
+			select {
+			case sbStep, ok = <-ch:
+			default:
+				return ret, nil
+			}
+		}
+		if !ok {
+			return ret, nil
+		}
+		stepBytes := proto.MustMarshal(sbStep.(*proto.VerifierStep))
+		ret = append(ret, stepBytes)
+		size += len(stepBytes)
+	}
+	return ret, nil
+}
+
+// verifierLogRange returns the marshaled verifier log entries with indices in
+// [start, limit) that are in the db, stopping early once their total size
+// reaches maxVerifierBatchBytes.
+func (ks *Keyserver) verifierLogRange(start, limit uint64) ([][]byte, error) {
+	var ret [][]byte
+	size := 0
+	iter := ks.db.NewIterator(&kv.Range{Start: tableVerifierLog(start), Limit: tableVerifierLog(limit)})
+	defer iter.Release()
+	for idx := start; idx < limit && size < maxVerifierBatchBytes && iter.Next(); idx++ {
+		dbIdx := binary.BigEndian.Uint64(iter.Key()[1:])
+		if dbIdx != idx {
+			log.Printf("ERROR: non-consecutive entries in verifier log (wanted %d, got %d)", idx, dbIdx)
+			return nil, errInternal
+		}
+		ret = append(ret, append([]byte(nil), iter.Value()...))
+		size += len(iter.Value())
+	}
+	if err := iter.Error(); err != nil {
+		log.Printf("ERROR: range [tableVerifierLog(%d), tableVerifierLog(%d)) ended at %d (not included) with error %s", start, limit, start+uint64(len(ret)), err)
+		return nil, errInternal
+	}
+	return ret, nil
+}
+
 const verifierCommonNamePrefix = "verifier" + " "
 
 func authenticateVerifier(ctx context.Context) (uint64, error) {
@@ -127,11 +306,11 @@ func (ks *Keyserver) PushRatification(ctx context.Context, r *proto.SignedEpochH
 	verifierCertID, err := authenticateVerifier(ctx)
 	if err != nil {
 		log.Printf("PushRatification: %s", err)
-		return nil, fmt.Errorf("PushRatification: %s", err)
+		return nil, grpc.Errorf(codes.Unauthenticated, "PushRatification: %s", err)
 	}
 	for signerID := range r.Signatures {
 		if signerID != verifierCertID {
-			return nil, fmt.Errorf("PushRatification: not authorized: authenticated as %x but tried to write %x's signature", verifierCertID, signerID)
+			return nil, grpc.Errorf(codes.PermissionDenied, "PushRatification: not authorized: authenticated as %x but tried to write %x's signature", verifierCertID, signerID)
 		}
 	}
 	uid := genUID()
@@ -143,12 +322,128 @@ func (ks *Keyserver) PushRatification(ctx context.Context, r *proto.SignedEpochH
 	select {
 	case <-ctx.Done():
 		ks.wr.Notify(uid, nil)
-		return nil, ctx.Err()
+		return nil, contextError(ctx.Err())
 	case <-ch:
-		return nil, nil
+		return &proto.Nothing{}, nil
 	}
 }
 
+// checkpointChunkSize is the number of entries per CheckpointChunk.
+const checkpointChunkSize = 1024
+
+// GetCheckpoint implements the interfaceE2EKSVerification interface from proto/verifier.proto
+func (ks *Keyserver) GetCheckpoint(rq *proto.CheckpointRequest, stream proto.E2EKSVerification_GetCheckpointServer) error {
+	if rq.QuorumRequirement == nil {
+		return grpc.Errorf(codes.InvalidArgument, "GetCheckpoint: no quorum requirement specified")
+	}
+	epoch, _, err := ks.findLatestEpochSignedByQuorum(rq.QuorumRequirement)
+	if err != nil {
+		return err
+	}
+	indexBytes, err := ks.db.Get(tableVerifierLogEpochs(epoch))
+	switch err {
+	case nil:
+	case ks.db.ErrNotFound():
+		// the epoch was ratified before checkpoints were supported
+		return grpc.Errorf(codes.FailedPrecondition, "GetCheckpoint: no checkpoint available for epoch %d", epoch)
+	default:
+		log.Printf("ERROR: ks.db.Get(tableVerifierLogEpochs(%d)): %s", epoch, err)
+		return errInternal
+	}
+	if len(indexBytes) != 8 {
+		log.Printf("ERROR: tableVerifierLogEpochs(%d) = %x is invalid", epoch, indexBytes)
+		return errInternal
+	}
+	ratifications, err := ks.allRatificationsForEpoch(epoch)
+	if err != nil {
+		log.Printf("ERROR: allRatificationsForEpoch(%d): %s", epoch, err)
+		return errInternal
+	}
+	chunk := &proto.CheckpointChunk{NextIndex: binary.BigEndian.Uint64(indexBytes) + 1}
+	for _, seh := range ratifications {
+		chunk.Ratifications = append(chunk.Ratifications, seh)
+	}
+
+	// tableUpdateRequests is sorted by index and then by epoch, so the last
+	// update of an index at or before the checkpoint epoch is the current one.
+	// An empty value means that the entry has been moved to another index.
+	var current *proto.CheckpointEntry
+	iter := ks.db.NewIterator(kv.BytesPrefix([]byte{tableUpdateRequestsPrefix}))
+	defer iter.Release()
+	for iter.Next() {
+		index := iter.Key()[1 : 1+vrf.Size]
+		if binary.BigEndian.Uint64(iter.Key()[1+vrf.Size:]) > epoch {
+			continue
+		}
+		if current != nil && !bytes.Equal(current.Index, index) {
+			chunk.Entries = append(chunk.Entries, current)
+			if len(chunk.Entries) == checkpointChunkSize {
+				if err := stream.Send(chunk); err != nil {
+					return err
+				}
+				chunk = new(proto.CheckpointChunk)
+			}
+		}
+		if len(iter.Value()) == 0 {
+			current = nil
+			continue
+		}
+		var update proto.UpdateRequest
+		if err := update.Unmarshal(iter.Value()); err != nil {
+			log.Printf("ERROR: invalid update request %x: %s", iter.Key(), err)
+			return errInternal
+		}
+		current = &proto.CheckpointEntry{Index: append([]byte(nil), index...), Entry: update.Update.NewEntry}
+	}
+	if err := iter.Error(); err != nil {
+		log.Printf("ERROR: scanning tableUpdateRequests: %s", err)
+		return errInternal
+	}
+	if current != nil {
+		chunk.Entries = append(chunk.Entries, current)
+	}
+
+	// tablePendingRecoveries is sorted the same way; an empty value means that
+	// no recovery is pending anymore.
+	var recovery []byte
+	var recoveryIndex []byte
+	flushRecovery := func() error {
+		if len(recovery) == 0 {
+			return nil
+		}
+		pending := new(proto.PendingRecovery)
+		if err := pending.Unmarshal(recovery); err != nil {
+			log.Printf("ERROR: invalid pending recovery of %x: %s", recoveryIndex, err)
+			return errInternal
+		}
+		chunk.PendingRecoveries = append(chunk.PendingRecoveries, pending)
+		return nil
+	}
+	recoveryIter := ks.db.NewIterator(kv.BytesPrefix([]byte{tablePendingRecoveriesPrefix}))
+	defer recoveryIter.Release()
+	for recoveryIter.Next() {
+		index := recoveryIter.Key()[1 : 1+vrf.Size]
+		if binary.BigEndian.Uint64(recoveryIter.Key()[1+vrf.Size:]) > epoch {
+			continue
+		}
+		if recoveryIndex != nil && !bytes.Equal(recoveryIndex, index) {
+			if err := flushRecovery(); err != nil {
+				return err
+			}
+		}
+		recoveryIndex = append(recoveryIndex[:0], index...)
+		recovery = append(recovery[:0], recoveryIter.Value()...)
+	}
+	if err := recoveryIter.Error(); err != nil {
+		log.Printf("ERROR: scanning tablePendingRecoveries: %s", err)
+		return errInternal
+	}
+	if err := flushRecovery(); err != nil {
+		return err
+	}
+	return stream.Send(chunk)
+}
+
 // verifierLogAppend censors an entry and prepares the commands to:
 // 1) store it to local persistent storage
 // 2) mark the log entry as used
@@ -159,12 +454,51 @@ func (ks *Keyserver) verifierLogAppend(m *proto.VerifierStep, rs *proto.ReplicaS
 	// ks : &const // read-only
 	// rs, wb : &mut
 	wb.Put(tableVerifierLog(rs.NextIndexVerifier), proto.MustMarshal(m))
+	if epoch, ok := m.Type.(*proto.VerifierStep_Epoch); ok {
+		// remembered for GetCheckpoint
+		indexBytes := make([]byte, 8)
+		binary.BigEndian.PutUint64(indexBytes, rs.NextIndexVerifier)
+		wb.Put(tableVerifierLogEpochs(epoch.Epoch.Head.Head.Epoch), indexBytes)
+	}
 	rs.NextIndexVerifier++
 	return func() {
 		ks.sb.Send(m)
 	}
 }
 
+// verifierLogAppendAfterRatification is like verifierLogAppend, except that
+// if the last epoch has not been ratified yet, m is held back until the epoch
+// has appeared in the verifier log: verifiers check m against the state as of
+// the last epoch.
+// called from step: no io
+func (ks *Keyserver) verifierLogAppendAfterRatification(m *proto.VerifierStep, rs *proto.ReplicaState, wb kv.Batch) func() {
+	return ks.verifierLogAppendAllAfterRatification([]*proto.VerifierStep{m}, rs, wb)
+}
+
+// verifierLogAppendAllAfterRatification is like
+// verifierLogAppendAfterRatification for several steps, which stay together
+// and in order in the verifier log: if they have to wait for the ratification,
+// they are keyed by the index of the log entry that appended them and then by
+// their position, and all waiting steps are appended in key order.
+// called from step: no io
+func (ks *Keyserver) verifierLogAppendAllAfterRatification(ms []*proto.VerifierStep, rs *proto.ReplicaState, wb kv.Batch) func() {
+	if rs.LastEpochNeedsRatification {
+		for i, m := range ms {
+			wb.Put(tableStepsPendingRatification(rs.NextIndexLog, uint64(i)), proto.MustMarshal(m))
+		}
+		return nil
+	}
+	deferredSends := make([]func(), len(ms))
+	for i, m := range ms {
+		deferredSends[i] = ks.verifierLogAppend(m, rs, wb)
+	}
+	return func() {
+		for _, deferredSend := range deferredSends {
+			deferredSend()
+		}
+	}
+}
+
 func saturatingAdd(a, b uint64) uint64 {
 	ret := a + b
 	if ret < a || ret < b {
@@ -0,0 +1,276 @@
+// Copyright 2014-2015 The Dename Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy of
+// the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations under
+// the License.
+
+package keyserver
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+
+	"github.com/yahoo/coname/keyserver/kv"
+	"github.com/yahoo/coname/keyserver/replication"
+	"github.com/yahoo/coname/proto"
+	"github.com/yahoo/coname/vrf"
+	"golang.org/x/crypto/sha3"
+)
+
+// A VRF key rotation moves every entry of the directory to the index of its
+// user ID under the new key, in a single step of the replicated log. The
+// stored update requests contain the user IDs, so the replicas can compute the
+// new indices deterministically. Verifiers are sent each user ID with proofs
+// of its old and new index, in steps of at most vrfRotationStepSize entries,
+// and check that every entry moves to the index of its own user ID and that
+// no entry is added, dropped or changed. The entries themselves keep the index
+// they were signed with.
+//
+// The old indices stay in tableUpdateRequests for lookups in earlier epochs,
+// which are answered with the VRF key announced in their epoch heads. Pending
+// recoveries are cancelled, and email challenges and pending updates are left
+// behind at the old indices: all of them are bound to the old index and can
+// not be completed anymore.
+
+// vrfRotationStepSize is the maximum number of entries moved by one step of a
+// VRF rotation in the verifier log. A move takes a few hundred bytes, which
+// keeps each step well below maxVerifierBatchBytes. Tests lower it to split
+// small directories.
+var vrfRotationStepSize = 1024
+
+// addVRFSecret adds sk to ks.vrfSecrets and returns its public key. sk must be
+// a key for ks.vrfSuite.
+func (ks *Keyserver) addVRFSecret(sk *[vrf.SecretKeySize]byte) ([]byte, error) {
+	// the public key is derived from the seed in the first half of sk
+	pk, _, err := ks.vrfSuite.GenerateKey(bytes.NewReader(sk[:vrf.SecretKeySize-vrf.PublicKeySize]))
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(pk, sk[vrf.SecretKeySize-vrf.PublicKeySize:]) {
+		return nil, fmt.Errorf("not a key for %s", proto.VRFSuite(ks.vrfSuite))
+	}
+	ks.vrfSecrets[string(pk)] = sk
+	return pk, nil
+}
+
+// currentVRFPublic returns the public key of the VRF that indexes the latest
+// tree.
+func (ks *Keyserver) currentVRFPublic(rs *proto.ReplicaState) []byte {
+	if len(rs.VRFPublic) == 0 {
+		return ks.vrfPublic
+	}
+	return rs.VRFPublic
+}
+
+// currentVRFSecret returns the VRF key that indexes the latest tree.
+func (ks *Keyserver) currentVRFSecret() *[vrf.SecretKeySize]byte {
+	ks.vrfMu.RLock()
+	defer ks.vrfMu.RUnlock()
+	return ks.vrfSecret
+}
+
+func (ks *Keyserver) setVRFSecret(sk *[vrf.SecretKeySize]byte) {
+	ks.vrfMu.Lock()
+	defer ks.vrfMu.Unlock()
+	ks.vrfSecret = sk
+}
+
+// vrfSecretForEpoch returns the VRF key that indexes the tree of epoch, as
+// announced in its epoch head.
+func (ks *Keyserver) vrfSecretForEpoch(epoch uint64) (*[vrf.SecretKeySize]byte, error) {
+	if epoch == 0 {
+		return ks.currentVRFSecret(), nil
+	}
+	tehBytes, err := ks.db.Get(tableEpochHeads(epoch))
+	if err != nil {
+		return nil, err
+	}
+	var teh proto.EncodedTimestampedEpochHead
+	if err := teh.Unmarshal(tehBytes); err != nil {
+		return nil, err
+	}
+	pk := teh.Head.VRFPublic
+	if len(pk) == 0 {
+		// epoch heads from before VRF key rotation was implemented
+		pk = ks.vrfPublic
+	}
+	sk, ok := ks.vrfSecrets[string(pk)]
+	if !ok {
+		return nil, fmt.Errorf("VRF key %x is not configured anymore", pk)
+	}
+	return sk, nil
+}
+
+// rotateVRF re-indexes the directory under the VRF key with public key pk.
+// Called from step.
+func (ks *Keyserver) rotateVRF(pk []byte, rs *proto.ReplicaState, wb kv.Batch) (deferredIO func()) {
+	// ks: &const, except for vrfSecret
+	// rs, wb: &mut
+	if bytes.Equal(pk, ks.currentVRFPublic(rs)) {
+		return // a duplicate of this step has already been handled
+	}
+	sk, ok := ks.vrfSecrets[string(pk)]
+	if !ok {
+		log.Panicf("VRF key rotation to %x, but this replica does not have that key (NextVRFKeyID)", pk)
+	}
+	oldSK, ok := ks.vrfSecrets[string(ks.currentVRFPublic(rs))]
+	if !ok {
+		log.Panicf("VRF key rotation from %x, but this replica does not have that key", ks.currentVRFPublic(rs))
+	}
+	epochNr := rs.LastEpochDelimiter.EpochNumber + 1
+	newTree, err := ks.merkletree.GetSnapshot(0).BeginModification()
+	if err != nil {
+		log.Panicf("BeginModification(): %s", err)
+	}
+	var moves []*proto.VRFMove
+
+	// tableUpdateRequests is sorted by index and then by epoch, so the last
+	// value of each index is the current update. The moved updates are
+	// written after all old indices have been cleared.
+	var index, value []byte
+	var moved [][]byte
+	move := func() {
+		if len(value) == 0 {
+			return // moved by an earlier rotation
+		}
+		update := new(proto.UpdateRequest)
+		if err := update.Unmarshal(value); err != nil {
+			log.Panicf("invalid update request at %x: %s", index, err)
+		}
+		userID := update.LookupParameters.UserId
+		oldIndex, oldIndexProof := ks.vrfSuite.Prove([]byte(userID), oldSK)
+		if !bytes.Equal(oldIndex, index) {
+			log.Panicf("update request of %q at index %x, expected %x", userID, index, oldIndex)
+		}
+		newIndex, newIndexProof := ks.vrfSuite.Prove([]byte(userID), sk)
+		var entryHash [32]byte
+		sha3.ShakeSum256(entryHash[:], update.Update.NewEntry.Encoding)
+		if err := newTree.Set(newIndex, entryHash[:]); err != nil {
+			log.Panicf("setting index '%x' gave error: %s", newIndex, err)
+		}
+		wb.Put(tableUpdateRequests(index, epochNr), nil)
+		moved = append(moved, append([]byte(nil), value...))
+		moves = append(moves, &proto.VRFMove{
+			UserId:        userID,
+			OldIndex:      oldIndex,
+			OldIndexProof: oldIndexProof,
+			NewIndex:      newIndex,
+			NewIndexProof: newIndexProof,
+		})
+	}
+	iter := ks.db.NewIterator(kv.BytesPrefix([]byte{tableUpdateRequestsPrefix}))
+	for iter.Next() {
+		if idx := iter.Key()[1 : 1+vrf.Size]; !bytes.Equal(idx, index) {
+			move()
+			index = append(index[:0], idx...)
+		}
+		value = append(value[:0], iter.Value()...)
+	}
+	move()
+	iter.Release()
+	if err := iter.Error(); err != nil {
+		log.Panicf("scanning tableUpdateRequests: %s", err)
+	}
+	for i, move := range moves {
+		wb.Put(tableUpdateRequests(move.NewIndex, epochNr), moved[i])
+	}
+
+	// cancel all pending recoveries: they would have to be completed by an
+	// update with the old index
+	index, value = nil, nil
+	cancel := func() {
+		if len(value) != 0 {
+			wb.Put(tablePendingRecoveries(index, epochNr), nil)
+		}
+	}
+	recoveryIter := ks.db.NewIterator(kv.BytesPrefix([]byte{tablePendingRecoveriesPrefix}))
+	for recoveryIter.Next() {
+		if idx := recoveryIter.Key()[1 : 1+vrf.Size]; !bytes.Equal(idx, index) {
+			cancel()
+			index = append(index[:0], idx...)
+		}
+		value = append(value[:0], recoveryIter.Value()...)
+	}
+	cancel()
+	recoveryIter.Release()
+	if err := recoveryIter.Error(); err != nil {
+		log.Panicf("scanning tablePendingRecoveries: %s", err)
+	}
+
+	rs.LatestTreeSnapshot = newTree.Flush(wb).Nr
+	rs.VRFPublic = pk
+	rs.VRFRotationEpoch = epochNr
+	log.Printf("rotated VRF key to %x, %d entries re-indexed", pk, len(moves))
+	ks.setVRFSecret(sk)
+
+	// the next epoch announces the new key even if nothing else changed
+	rs.PendingUpdates = true
+	ks.updateEpochProposer()
+
+	var vsteps []*proto.VerifierStep
+	for start := 0; start == 0 || start < len(moves); start += vrfRotationStepSize {
+		end := start + vrfRotationStepSize
+		if end > len(moves) {
+			end = len(moves)
+		}
+		rotation := &proto.VRFRotation{VRFPublic: pk, Moves: moves[start:end], More: end < len(moves)}
+		vsteps = append(vsteps, &proto.VerifierStep{Type: &proto.VerifierStep_VRFRotation{VRFRotation: rotation}})
+	}
+	// Verifiers stop at any other step in the middle of a rotation, so all
+	// of its steps are appended here at once, and nothing else is appended
+	// by this step.
+	deferredSend := ks.verifierLogAppendAllAfterRatification(vsteps, rs, wb)
+	return func() {
+		ks.updateVRFRotationProposer()
+		if deferredSend != nil {
+			deferredSend()
+		}
+	}
+}
+
+// movedEntry returns entry as it is found at idx, its index after the VRF key
+// rotations that moved it there. The entry keeps the index it was signed with,
+// so the index checks of an update or recovery of a moved entry need the copy.
+func movedEntry(entry *proto.Entry, idx []byte) *proto.Entry {
+	if entry == nil || bytes.Equal(entry.Index, idx) {
+		return entry
+	}
+	moved := *entry
+	moved.Index = idx
+	return &moved
+}
+
+// updateVRFRotationProposer either starts or stops the VRF rotation proposer
+// as necessary.
+func (ks *Keyserver) updateVRFRotationProposer() {
+	want := ks.nextVRFPublic != nil && !bytes.Equal(ks.currentVRFPublic(&ks.rs), ks.nextVRFPublic)
+	have := ks.vrfRotationProposer != nil
+	if have == want {
+		return
+	}
+
+	switch want {
+	case true:
+		ks.vrfRotationProposer = StartProposer(ks.log, ks.clk, ks.retryProposalInterval,
+			replication.LogEntry{
+				Data: proto.MustMarshal(&proto.KeyserverStep{Type: &proto.KeyserverStep_VrfRotation{VrfRotation: &proto.VRFRotation{
+					VRFPublic: ks.nextVRFPublic,
+				}}}),
+				ConfChange: &replication.ConfChange{
+					Operation: replication.ConfChangeNOP,
+				},
+			})
+	case false:
+		ks.vrfRotationProposer.Stop()
+		ks.vrfRotationProposer = nil
+	}
+}
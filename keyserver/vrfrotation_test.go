@@ -0,0 +1,136 @@
+// Copyright 2014-2015 The Dename Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy of
+// the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations under
+// the License.
+
+package keyserver
+
+import (
+	"bytes"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/yahoo/coname"
+	"github.com/yahoo/coname/keyserver/replication"
+	"github.com/yahoo/coname/proto"
+	"github.com/yahoo/coname/vrf"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+func TestKeyserverRotatesVRFKey(t *testing.T) {
+	dieOnCtrlC()
+	// alice and bob are moved in separate verifier steps
+	defer func(stepSize int) { vrfRotationStepSize = stepSize }(vrfRotationStepSize)
+	vrfRotationStepSize = 1
+	kss, caPool, clks, _, ck, clientConfig, teardown := setupRealm(t, 3, 3)
+	defer teardown()
+	stop := stoppableSyncedClocks(clks)
+	defer close(stop)
+
+	quorum := clientConfig.Realms[0].VerificationPolicy.GetQuorum()
+	waitForFirstEpoch(kss[0], quorum)
+	clientTLS, err := clientConfig.Realms[0].ClientTLS.Config(ck)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bob := "bob@" + realmDomain
+	profile := proto.Profile{
+		Nonce: []byte("noncenoncenonceNONCE"),
+		Keys:  map[string][]byte{"abc": []byte{1, 2, 3}},
+	}
+	sk, pk, _, _ := doRegister(t, kss[0], clientConfig, clientTLS, caPool, clks[0].Now(), alice, 0, profile)
+	doRegister(t, kss[0], clientConfig, clientTLS, caPool, clks[0].Now(), bob, 0, profile)
+	epochBefore, err := getLatestEpoch(kss[0], quorum)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	newPublic, newSecret, err := vrf.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, ks := range kss {
+		if _, err := ks.addVRFSecret(newSecret); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// raft drops a proposal while another one is being committed, so the
+	// rotation is retried like the keyserver's own proposals
+	rotationProposer := StartProposer(kss[0].log, clks[0], kss[0].retryProposalInterval, replication.LogEntry{
+		Data: proto.MustMarshal(&proto.KeyserverStep{Type: &proto.KeyserverStep_VrfRotation{VrfRotation: &proto.VRFRotation{
+			VRFPublic: newPublic,
+		}}}),
+		ConfChange: &replication.ConfChange{Operation: replication.ConfChangeNOP},
+	})
+	defer rotationProposer.Stop()
+
+	conn, err := grpc.Dial(kss[1].publicListen.Addr().String(), grpc.WithTransportCredentials(credentials.NewTLS(clientTLS)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	publicC := proto.NewE2EKSPublicClient(conn)
+	var proof *proto.LookupProof
+	for {
+		proof, err = publicC.Lookup(context.Background(), &proto.LookupRequest{UserId: alice, QuorumRequirement: quorum})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if bytes.Equal(proof.Ratifications[0].Head.Head.VRFPublic, newPublic) {
+			break
+		}
+		time.Sleep(poll)
+	}
+	if got, want := proof.Index, kss[0].vrfSuite.Compute([]byte(alice), newSecret); !bytes.Equal(got, want) {
+		t.Errorf("index after rotation is %x, want %x", got, want)
+	}
+	if proof.Ratifications[0].Head.Head.VRFRotationTime == nil {
+		t.Fatal("epoch head announces a new VRF key without a rotation time")
+	}
+
+	now := clks[0].Now()
+	if _, err := coname.VerifyLookup(clientConfig, alice, proof, now); err == nil {
+		t.Error("lookup with a new VRF key verified without a transition period")
+	}
+	clientConfig.Realms[0].VRFTransition = proto.DurationStamp(time.Hour)
+	keys, err := coname.VerifyLookup(clientConfig, alice, proof, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(keys["abc"], profile.Keys["abc"]) {
+		t.Errorf("keys after rotation are %v, want %v", keys, profile.Keys)
+	}
+	if _, err := coname.VerifyLookup(clientConfig, alice, proof, now.Add(2*time.Hour)); err == nil {
+		t.Error("lookup with a new VRF key verified after the transition period")
+	}
+
+	// lookups in earlier epochs are answered under the old key
+	oldProof, err := publicC.Lookup(context.Background(), &proto.LookupRequest{UserId: alice, Epoch: epochBefore, QuorumRequirement: quorum})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := coname.VerifyLookup(clientConfig, alice, oldProof, now); err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Equal(oldProof.Index, proof.Index) {
+		t.Error("lookup in an epoch before the rotation returned the new index")
+	}
+
+	// the moved entries can be updated, and the verifiers accept that
+	doUpdate(t, kss[2], clientConfig, clientTLS, caPool, clks[0].Now(), alice, sk, pk, 1, proto.Profile{
+		Nonce: []byte("XYZNONCE"),
+		Keys:  map[string][]byte{"abc": []byte{4, 5, 6}},
+	})
+}
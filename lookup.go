@@ -2,6 +2,7 @@ package coname
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -46,6 +47,11 @@ func GetRealmByUser(cfg *proto.Config, user string) (*proto.RealmConfig, error)
 	return GetRealmByDomain(cfg, domain)
 }
 
+// ErrDeleted is returned by VerifyLookup if the proof is valid and shows that
+// the entry of the user has been deleted. If the user has never been
+// registered, VerifyLookup returns no keys and no error instead.
+var ErrDeleted = errors.New("VerifyLookup: the entry has been deleted")
+
 func VerifyLookup(cfg *proto.Config, user string, pf *proto.LookupProof, now time.Time) (keys map[string][]byte, err error) {
 	if pf.UserId != "" && pf.UserId != user {
 		return nil, fmt.Errorf("VerifyLookup: proof specifies different user ID: %q != %q", pf.UserId, user)
@@ -54,13 +60,17 @@ func VerifyLookup(cfg *proto.Config, user string, pf *proto.LookupProof, now tim
 	if err != nil {
 		return nil, err
 	}
-	if !vrf.Verify(realm.VRFPublic, []byte(user), pf.Index, pf.IndexProof) {
-		return nil, fmt.Errorf("VerifyLookup: VRF verification failed")
-	}
 	root, err := VerifyConsensus(realm, pf.Ratifications, now)
 	if err != nil {
 		return
 	}
+	vrfPublic, err := lookupVRFPublic(realm, &pf.Ratifications[0].Head.Head.EpochHead, now)
+	if err != nil {
+		return nil, err
+	}
+	if !vrf.Suite(realm.VRFSuite).Verify(vrfPublic, []byte(user), pf.Index, pf.IndexProof) {
+		return nil, fmt.Errorf("VerifyLookup: VRF verification failed")
+	}
 
 	verifiedEntryHash, err := reconstructTreeAndLookup(realm.TreeNonce, root, pf.Index, pf.TreeProof)
 	if err != nil {
@@ -80,6 +90,9 @@ func VerifyLookup(cfg *proto.Config, user string, pf *proto.LookupProof, now tim
 		if !bytes.Equal(entryHash[:], verifiedEntryHash) {
 			return nil, fmt.Errorf("VerifyLookup: entry hash %x did not match verified lookup result %x", entryHash, verifiedEntryHash)
 		}
+		if pf.Entry.Deleted {
+			return nil, ErrDeleted
+		}
 
 		if !CheckCommitment(pf.Entry.ProfileCommitment, pf.Profile) {
 			return nil, fmt.Errorf("VerifyLookup: profile does not match the hash in the entry")
@@ -89,6 +102,24 @@ func VerifyLookup(cfg *proto.Config, user string, pf *proto.LookupProof, now tim
 	}
 }
 
+// lookupVRFPublic returns the VRF public key that the index proofs of a lookup
+// in the epoch of the ratified epoch head are checked against. That is the key
+// of the realm config, or the key announced in head for realm.VRFTransition
+// after the keyserver rotated to it.
+func lookupVRFPublic(realm *proto.RealmConfig, head *proto.EpochHead, now time.Time) ([]byte, error) {
+	if len(head.VRFPublic) == 0 || bytes.Equal(head.VRFPublic, realm.VRFPublic) {
+		return realm.VRFPublic, nil
+	}
+	if head.VRFRotationTime == nil {
+		return nil, fmt.Errorf("VerifyLookup: epoch head announces VRF key %x instead of %x without a rotation", head.VRFPublic, realm.VRFPublic)
+	}
+	rotated := head.VRFRotationTime.Time()
+	if end := rotated.Add(realm.VRFTransition.Duration()); !now.Before(end) {
+		return nil, fmt.Errorf("VerifyLookup: the VRF key was rotated to %x at %s and the transition ended at %s, the realm config needs to be updated", head.VRFPublic, rotated, end)
+	}
+	return head.VRFPublic, nil
+}
+
 func VerifyConsensus(rcg *proto.RealmConfig, ratifications []*proto.SignedEpochHead, now time.Time) (root []byte, err error) {
 	if len(ratifications) == 0 {
 		return nil, fmt.Errorf("VerifyConsensus: no signed epoch heads provided")
@@ -103,11 +134,10 @@ func VerifyConsensus(rcg *proto.RealmConfig, ratifications []*proto.SignedEpochH
 	if got := ratifications[0].Head.Head.Realm; got != rcg.RealmName {
 		return nil, fmt.Errorf("VerifyConsensus: SEH does not match realm: %q != %q", got, rcg.RealmName)
 	}
-	// check that the seh is not expired
-	if t := ratifications[0].Head.Head.IssueTime.Time().Add(rcg.EpochTimeToLive.Duration()); now.After(t) {
-		return nil, fmt.Errorf("VerifyConsensus: epoch expired at %v < %v", t, now)
-	}
-	// check that there are sufficiently many fresh signatures.
+	// check that there are sufficiently many fresh signatures. A signature is
+	// issued at the IssueTime of the epoch, unless it is of a refresh of the
+	// epoch by the keyserver. Signatures issued more than EpochTimeToLive in
+	// the past are expired.
 	pks := rcg.VerificationPolicy.PublicKeys
 	policyQuorum, ok := rcg.VerificationPolicy.PolicyType.(*proto.AuthorizationPolicy_Quorum)
 	if !ok {
@@ -116,26 +146,51 @@ func VerifyConsensus(rcg *proto.RealmConfig, ratifications []*proto.SignedEpochH
 	want := policyQuorum.Quorum
 	can := ListQuorum(want, nil)
 	have := make(map[uint64]struct{})
+	expired := make(map[uint64]struct{})
 next_verifier:
 	for id := range can {
 		if CheckQuorum(want, have) {
 			break // already sufficiently verified, short-circuit
 		}
+		pk, ok := pks[id]
+		if !ok {
+			continue // the quorum mentions a verifier without a key
+		}
 		for _, seh := range ratifications {
 			if sig, ok := seh.Signatures[id]; ok &&
-				VerifySignature(pks[id], seh.Head.Encoding, sig) {
+				VerifySignature(pk, seh.Head.Encoding, sig) {
+				if now.After(signatureIssueTime(seh).Add(rcg.EpochTimeToLive.Duration())) {
+					expired[id] = struct{}{}
+					continue
+				}
 				have[id] = struct{}{}
+				delete(expired, id)
 				continue next_verifier
 			}
 		}
 	}
 	if !CheckQuorum(want, have) {
+		if len(expired) != 0 {
+			return nil, fmt.Errorf("VerifyConsensus: epoch expired (fresh signatures %v, expired %v, want %v)", have, expired, want)
+		}
 		return nil, fmt.Errorf("VerifyConsensus: insufficient signatures (have %v, want %v)", have, want)
 	}
 
 	return ratifications[0].Head.Head.RootHash, nil
 }
 
+// signatureIssueTime returns the time at which the signatures of seh are
+// considered issued: the timestamp of a keyserver refresh of the epoch, or the
+// IssueTime of the epoch otherwise. A verifier signs its own timestamp on an
+// epoch head, but only vouches for the epoch being current at IssueTime.
+func signatureIssueTime(seh *proto.SignedEpochHead) time.Time {
+	issued := seh.Head.Head.IssueTime.Time()
+	if t := seh.Head.Timestamp.Time(); seh.Head.Refresh && t.After(issued) {
+		return t
+	}
+	return issued
+}
+
 func reconstructTreeAndLookup(treeNonce []byte, rootHash []byte, index []byte, proof *proto.TreeProof) ([]byte, error) {
 	// First, reconstruct the partial tree
 	reconstructed, err := ReconstructTree(proof, ToBits(IndexBits, index))
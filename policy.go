@@ -15,7 +15,17 @@
 package coname
 
 import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/asn1"
 	"fmt"
+	"math/big"
+	"strings"
 
 	"github.com/agl/ed25519"
 	"github.com/yahoo/coname/proto"
@@ -26,7 +36,10 @@ import (
 // current, update : &const // none of the inputs are modified
 func VerifyUpdate(current *proto.Entry, update *proto.SignedEntryUpdate) error {
 	next := &update.NewEntry
-	if current != nil {
+	if next.Deleted {
+		return VerifyDeletion(current, update, nil)
+	}
+	if current != nil && !current.Deleted {
 		if current.UpdatePolicy == nil {
 			return fmt.Errorf("VerifyUpdate: current.UpdatePolicy is nil")
 		}
@@ -36,6 +49,12 @@ func VerifyUpdate(current *proto.Entry, update *proto.SignedEntryUpdate) error {
 		if next.Version <= current.Version {
 			return fmt.Errorf("VerifyUpdate: entry version must increase (got %d <= %d)", next.Version, current.Version)
 		}
+	} else if current != nil {
+		// the version must increase so that the registration before the
+		// deletion cannot be replayed
+		if next.Version <= current.Version {
+			return fmt.Errorf("VerifyUpdate: registering a deleted entry again must increase the version (got %d <= %d)", next.Version, current.Version)
+		}
 	} else if next.Version != 0 {
 		return fmt.Errorf("VerifyUpdate: registering a new entry must use version number 0 (got %d)", next.Version)
 	}
@@ -48,6 +67,84 @@ func VerifyUpdate(current *proto.Entry, update *proto.SignedEntryUpdate) error {
 	return nil
 }
 
+// VerifyDeletion returns nil iff replacing entry current with the tombstone
+// in update is justified by the evidence in update, either by the update
+// policy of current or by deletionPolicy (nil if the realm has none).
+// Globally deterministic.
+// current, update, deletionPolicy : &const // none of the inputs are modified
+func VerifyDeletion(current *proto.Entry, update *proto.SignedEntryUpdate, deletionPolicy *proto.AuthorizationPolicy) error {
+	next := &update.NewEntry
+	if current == nil || current.Deleted {
+		return fmt.Errorf("VerifyDeletion: there is no entry to delete")
+	}
+	if !next.Deleted {
+		return fmt.Errorf("VerifyDeletion: the new entry is not a tombstone")
+	}
+	if next.UpdatePolicy != nil || next.ProfileCommitment != nil || next.RecoveryPolicy != nil {
+		return fmt.Errorf("VerifyDeletion: a tombstone must not have an update policy, profile commitment or recovery policy")
+	}
+	if next.Version <= current.Version {
+		return fmt.Errorf("VerifyDeletion: entry version must increase (got %d <= %d)", next.Version, current.Version)
+	}
+	if current.UpdatePolicy != nil && VerifyPolicy(current.UpdatePolicy, update.NewEntry.Encoding, update.Signatures) {
+		return nil
+	}
+	if deletionPolicy != nil && VerifyPolicy(deletionPolicy, update.NewEntry.Encoding, update.Signatures) {
+		return nil
+	}
+	return fmt.Errorf("VerifyDeletion: deleting an entry requires authorization from the old key or the deletion policy, but signature verification failed")
+}
+
+// VerifyAdminUpdate returns nil iff replacing entry current with the new entry
+// in update is justified by the signatures of adminPolicy, the domain admin
+// policy for the user ID of the entry, instead of those of current's update
+// policy. Registrations cannot be authorized this way. Globally deterministic.
+// current, update, adminPolicy : &const // none of the inputs are modified
+func VerifyAdminUpdate(current *proto.Entry, update *proto.SignedEntryUpdate, adminPolicy *proto.AuthorizationPolicy) error {
+	next := &update.NewEntry
+	if adminPolicy == nil {
+		return fmt.Errorf("VerifyAdminUpdate: the domain has no admin policy")
+	}
+	if next.Deleted {
+		return VerifyDeletion(current, update, adminPolicy)
+	}
+	if current == nil || current.Deleted {
+		return fmt.Errorf("VerifyAdminUpdate: there is no entry to update")
+	}
+	if !VerifyPolicy(adminPolicy, update.NewEntry.Encoding, update.Signatures) {
+		return fmt.Errorf("VerifyAdminUpdate: update needs to be accepted by the domain admin policy, but signature verification failed")
+	}
+	if next.Version <= current.Version {
+		return fmt.Errorf("VerifyAdminUpdate: entry version must increase (got %d <= %d)", next.Version, current.Version)
+	}
+	if next.UpdatePolicy == nil {
+		return fmt.Errorf("VerifyAdminUpdate: next.UpdatePolicy is nil")
+	}
+	if !VerifyPolicy(next.UpdatePolicy, update.NewEntry.Encoding, update.Signatures) {
+		return fmt.Errorf("VerifyAdminUpdate: update needs to be accepted by the new key, but signature verification failed")
+	}
+	return nil
+}
+
+// GetDomainAdminPolicy returns the policy of the first of policies that covers
+// the domain of user, or nil if there is none.
+// policies : &const
+func GetDomainAdminPolicy(policies []*proto.DomainAdminPolicy, user string) *proto.AuthorizationPolicy {
+	indexOfAt := strings.LastIndex(user, "@")
+	if indexOfAt == -1 {
+		return nil
+	}
+	domain := user[indexOfAt+1:]
+	for _, p := range policies {
+		for _, d := range p.Domains {
+			if d == domain {
+				return p.Policy
+			}
+		}
+	}
+	return nil
+}
+
 // VerifyPolicy returns whether, by policy, action is justified by evidence.
 // Evidence is in the form of digital signatures denoting agreement, and the
 // policy contains public keys and a quorum rule.
@@ -55,15 +152,18 @@ func VerifyUpdate(current *proto.Entry, update *proto.SignedEntryUpdate) error {
 // NOTE: This does not work for verifier signatures on epoch heads because the
 // signed contents will differ in their timestamps.
 func VerifyPolicy(policy *proto.AuthorizationPolicy, action []byte, evidence map[uint64][]byte) bool {
-	have := make(map[uint64]struct{}, len(evidence))
-	for id, pk := range policy.PublicKeys {
-		if sig, ok := evidence[id]; ok && VerifySignature(pk, action, sig) {
-			have[id] = struct{}{}
-		}
-	}
 	switch policy.PolicyType.(type) {
 	case *proto.AuthorizationPolicy_Quorum:
-		return CheckQuorum(policy.PolicyType.(*proto.AuthorizationPolicy_Quorum).Quorum, have)
+		quorum := policy.PolicyType.(*proto.AuthorizationPolicy_Quorum).Quorum
+		// only signatures that can make a difference are verified
+		have := make(map[uint64]struct{}, len(evidence))
+		for id := range ListQuorum(quorum, nil) {
+			pk, ok := policy.PublicKeys[id]
+			if sig, signed := evidence[id]; ok && signed && VerifySignature(pk, action, sig) {
+				have[id] = struct{}{}
+			}
+		}
+		return CheckQuorum(quorum, have)
 	default: // unknown policy
 		return false
 	}
@@ -80,11 +180,102 @@ func VerifySignature(pk *proto.PublicKey, message []byte, sig []byte) bool {
 		copy(edpk[:], pk.PubkeyType.(*proto.PublicKey_Ed25519).Ed25519[:])
 		copy(edsig[:], sig)
 		return ed25519.Verify(&edpk, message, &edsig)
+	case *proto.PublicKey_ECDSAP256SHA256:
+		x, y := elliptic.Unmarshal(elliptic.P256(), pk.PubkeyType.(*proto.PublicKey_ECDSAP256SHA256).ECDSAP256SHA256)
+		if x == nil || !elliptic.P256().IsOnCurve(x, y) {
+			return false
+		}
+		var ecsig struct{ R, S *big.Int }
+		if rest, err := asn1.Unmarshal(sig, &ecsig); err != nil || len(rest) != 0 {
+			return false
+		}
+		h := sha256.Sum256(message)
+		return ecdsa.Verify(&ecdsa.PublicKey{Curve: elliptic.P256(), X: x, Y: y}, h[:], ecsig.R, ecsig.S)
+	case *proto.PublicKey_RSAPSSSHA256:
+		rsapk, err := parseRSAPublicKey(pk.PubkeyType.(*proto.PublicKey_RSAPSSSHA256).RSAPSSSHA256)
+		if err != nil {
+			return false
+		}
+		h := sha256.Sum256(message)
+		return rsa.VerifyPSS(rsapk, crypto.SHA256, h[:], sig, rsaPSSOptions) == nil
 	default:
 		return false
 	}
 }
 
+// minRSABits is the minimum modulus size of RSAPSSSHA256 keys.
+const minRSABits = 2048
+
+var rsaPSSOptions = &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash, Hash: crypto.SHA256}
+
+func parseRSAPublicKey(der []byte) (*rsa.PublicKey, error) {
+	pk, err := x509.ParsePKIXPublicKey(der)
+	if err != nil {
+		return nil, err
+	}
+	rsapk, ok := pk.(*rsa.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("not an RSA key: %T", pk)
+	}
+	if rsapk.N.BitLen() < minRSABits {
+		return nil, fmt.Errorf("RSA key of %d bits is too short", rsapk.N.BitLen())
+	}
+	return rsapk, nil
+}
+
+// PublicKeyFor returns the public key of sk, which is one of the signing
+// keys supported by Sign.
+func PublicKeyFor(sk crypto.PrivateKey) (*proto.PublicKey, error) {
+	switch sk := sk.(type) {
+	case *[ed25519.PrivateKeySize]byte:
+		// the public key is the second half of an ed25519 secret key
+		return &proto.PublicKey{PubkeyType: &proto.PublicKey_Ed25519{Ed25519: append([]byte{}, sk[32:]...)}}, nil
+	case crypto.Signer:
+		switch pk := sk.Public().(type) {
+		case *ecdsa.PublicKey:
+			if pk.Curve != elliptic.P256() {
+				return nil, fmt.Errorf("unsupported ECDSA curve %s", pk.Curve.Params().Name)
+			}
+			return &proto.PublicKey{PubkeyType: &proto.PublicKey_ECDSAP256SHA256{ECDSAP256SHA256: elliptic.Marshal(pk.Curve, pk.X, pk.Y)}}, nil
+		case *rsa.PublicKey:
+			if pk.N.BitLen() < minRSABits {
+				return nil, fmt.Errorf("RSA key of %d bits is too short", pk.N.BitLen())
+			}
+			der, err := x509.MarshalPKIXPublicKey(pk)
+			if err != nil {
+				return nil, err
+			}
+			return &proto.PublicKey{PubkeyType: &proto.PublicKey_RSAPSSSHA256{RSAPSSSHA256: der}}, nil
+		default:
+			return nil, fmt.Errorf("unsupported signer public key type %T", pk)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported signing key type %T", sk)
+	}
+}
+
+// Sign returns a signature of message by sk that VerifySignature accepts
+// under PublicKeyFor(sk). sk is an ed25519 secret key, or a crypto.Signer
+// (such as an *ecdsa.PrivateKey or a hardware token) for an ECDSA P-256 or
+// RSA key.
+// sk, message : &const // none of the inputs are modified
+func Sign(sk crypto.PrivateKey, message []byte) ([]byte, error) {
+	pk, err := PublicKeyFor(sk)
+	if err != nil {
+		return nil, err
+	}
+	switch pk.PubkeyType.(type) {
+	case *proto.PublicKey_Ed25519:
+		return ed25519.Sign(sk.(*[ed25519.PrivateKeySize]byte), message)[:], nil
+	case *proto.PublicKey_ECDSAP256SHA256:
+		h := sha256.Sum256(message)
+		return sk.(crypto.Signer).Sign(rand.Reader, h[:], crypto.SHA256)
+	default: // *proto.PublicKey_RSAPSSSHA256
+		h := sha256.Sum256(message)
+		return sk.(crypto.Signer).Sign(rand.Reader, h[:], rsaPSSOptions)
+	}
+}
+
 // CheckQuorum evaluates whether the quorum requirement want can be satisfied
 // by ratifications of the verifiers in have.
 // want, have : &const // none of the inputs are modified
@@ -92,23 +283,69 @@ func CheckQuorum(want *proto.QuorumExpr, have map[uint64]struct{}) bool {
 	if want == nil {
 		return true // no requirements
 	}
-	var n uint32
-	for _, verifier := range want.Candidates {
-		if _, yes := have[verifier]; yes {
-			n++
+	switch want.Op {
+	case proto.QUORUM_THRESHOLD:
+		var n uint64
+		for _, verifier := range want.Candidates {
+			if _, yes := have[verifier]; yes {
+				n++
+			}
 		}
-	}
-	for _, e := range want.Subexpressions {
-		if CheckQuorum(e, have) {
-			n++
+		for _, c := range want.WeightedCandidates {
+			if _, yes := have[c.ID]; yes {
+				n += uint64(c.Weight)
+			}
+		}
+		for _, e := range want.Subexpressions {
+			if CheckQuorum(e, have) {
+				n++
+			}
+		}
+		return n >= uint64(want.Threshold)
+	case proto.QUORUM_AND:
+		for _, verifier := range want.Candidates {
+			if _, yes := have[verifier]; !yes {
+				return false
+			}
+		}
+		for _, c := range want.WeightedCandidates {
+			if _, yes := have[c.ID]; !yes {
+				return false
+			}
+		}
+		for _, e := range want.Subexpressions {
+			if !CheckQuorum(e, have) {
+				return false
+			}
+		}
+		return true
+	case proto.QUORUM_OR:
+		for _, verifier := range want.Candidates {
+			if _, yes := have[verifier]; yes {
+				return true
+			}
 		}
+		for _, c := range want.WeightedCandidates {
+			if _, yes := have[c.ID]; yes {
+				return true
+			}
+		}
+		for _, e := range want.Subexpressions {
+			if CheckQuorum(e, have) {
+				return true
+			}
+		}
+		return false
+	default: // unknown op
+		return false
 	}
-	return n >= want.Threshold
 }
 
-// ListQuorum inserts all verifiers mentioned in e to out. If out is nil, a new
-// map is allocated. ListQuorum is NOT intended to be used for implementing
-// quorum verification, use CheckQuorum instead.
+// ListQuorum inserts all verifiers mentioned in e to out, except for those
+// whose ratification can not make a difference: candidates of weight 0 in
+// threshold expressions and all candidates of expressions with an unknown
+// op. If out is nil, a new map is allocated. ListQuorum is NOT intended to be
+// used for implementing quorum verification, use CheckQuorum instead.
 // e : &const
 // out : *mut map mut // both the map and its contents can be modified arbitrarily
 func ListQuorum(e *proto.QuorumExpr, out map[uint64]struct{}) map[uint64]struct{} {
@@ -122,9 +359,19 @@ func ListQuorum(e *proto.QuorumExpr, out map[uint64]struct{}) map[uint64]struct{
 		}
 		out = make(map[uint64]struct{}, l)
 	}
+	switch e.Op {
+	case proto.QUORUM_THRESHOLD, proto.QUORUM_AND, proto.QUORUM_OR:
+	default:
+		return out
+	}
 	for _, verifier := range e.Candidates {
 		out[verifier] = struct{}{}
 	}
+	for _, c := range e.WeightedCandidates {
+		if c.Weight != 0 || e.Op != proto.QUORUM_THRESHOLD {
+			out[c.ID] = struct{}{}
+		}
+	}
 	for _, e := range e.Subexpressions {
 		ListQuorum(e, out)
 	}
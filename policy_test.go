@@ -0,0 +1,286 @@
+// Copyright 2014-2015 The Dename Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy of
+// the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations under
+// the License.
+
+package coname
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	mrand "math/rand"
+	"reflect"
+	"testing"
+	"testing/quick"
+
+	"github.com/agl/ed25519"
+	"github.com/yahoo/coname/proto"
+)
+
+const testVerifiers = 6
+
+// randomQuorum returns a QuorumExpr of the kind that was supported before
+// ops and weights: thresholds over candidates and subexpressions.
+func randomQuorum(r *mrand.Rand, depth int) *proto.QuorumExpr {
+	e := &proto.QuorumExpr{Candidates: []uint64{}, Subexpressions: []*proto.QuorumExpr{}}
+	for i := r.Intn(4); i > 0; i-- {
+		e.Candidates = append(e.Candidates, uint64(r.Intn(testVerifiers)))
+	}
+	if depth > 0 {
+		for i := r.Intn(3); i > 0; i-- {
+			e.Subexpressions = append(e.Subexpressions, randomQuorum(r, depth-1))
+		}
+	}
+	e.Threshold = uint32(r.Intn(len(e.Candidates) + len(e.Subexpressions) + 2))
+	return e
+}
+
+func randomVerifierSet(r *mrand.Rand) map[uint64]struct{} {
+	have := make(map[uint64]struct{})
+	for id := uint64(0); id < testVerifiers; id++ {
+		if r.Intn(2) == 0 {
+			have[id] = struct{}{}
+		}
+	}
+	return have
+}
+
+// oldCheckQuorum and oldListQuorum are the implementations from before
+// QuorumExpr had ops and weights.
+func oldCheckQuorum(want *proto.QuorumExpr, have map[uint64]struct{}) bool {
+	if want == nil {
+		return true
+	}
+	var n uint32
+	for _, verifier := range want.Candidates {
+		if _, yes := have[verifier]; yes {
+			n++
+		}
+	}
+	for _, e := range want.Subexpressions {
+		if oldCheckQuorum(e, have) {
+			n++
+		}
+	}
+	return n >= want.Threshold
+}
+
+func oldListQuorum(e *proto.QuorumExpr, out map[uint64]struct{}) map[uint64]struct{} {
+	if out == nil {
+		out = make(map[uint64]struct{})
+	}
+	for _, verifier := range e.Candidates {
+		out[verifier] = struct{}{}
+	}
+	for _, e := range e.Subexpressions {
+		oldListQuorum(e, out)
+	}
+	return out
+}
+
+// withUnitWeights moves all candidates of e to weighted_candidates with
+// weight 1.
+func withUnitWeights(e *proto.QuorumExpr) *proto.QuorumExpr {
+	ret := &proto.QuorumExpr{Threshold: e.Threshold, Op: e.Op}
+	for _, id := range e.Candidates {
+		ret.WeightedCandidates = append(ret.WeightedCandidates, &proto.WeightedCandidate{ID: id, Weight: 1})
+	}
+	for _, s := range e.Subexpressions {
+		ret.Subexpressions = append(ret.Subexpressions, withUnitWeights(s))
+	}
+	return ret
+}
+
+func TestCheckQuorumReducesToThreshold(t *testing.T) {
+	if err := quick.Check(func(seed int64) bool {
+		r := mrand.New(mrand.NewSource(seed))
+		e, have := randomQuorum(r, 3), randomVerifierSet(r)
+		return CheckQuorum(e, have) == oldCheckQuorum(e, have) &&
+			CheckQuorum(withUnitWeights(e), have) == oldCheckQuorum(e, have)
+	}, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestListQuorumReducesToThreshold(t *testing.T) {
+	if err := quick.Check(func(seed int64) bool {
+		r := mrand.New(mrand.NewSource(seed))
+		e := randomQuorum(r, 3)
+		return reflect.DeepEqual(ListQuorum(e, nil), oldListQuorum(e, nil)) &&
+			reflect.DeepEqual(ListQuorum(withUnitWeights(e), nil), oldListQuorum(e, nil))
+	}, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCheckQuorumAndOr(t *testing.T) {
+	if err := quick.Check(func(seed int64) bool {
+		r := mrand.New(mrand.NewSource(seed))
+		e, have := randomQuorum(r, 0), randomVerifierSet(r)
+		and := &proto.QuorumExpr{Op: proto.QUORUM_AND, Candidates: e.Candidates}
+		or := &proto.QuorumExpr{Op: proto.QUORUM_OR, Candidates: e.Candidates}
+		all := &proto.QuorumExpr{Threshold: uint32(len(e.Candidates)), Candidates: e.Candidates}
+		any := &proto.QuorumExpr{Threshold: 1, Candidates: e.Candidates}
+		return CheckQuorum(and, have) == oldCheckQuorum(all, have) &&
+			CheckQuorum(or, have) == oldCheckQuorum(any, have)
+	}, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCheckQuorumWeighted(t *testing.T) {
+	const keyserver, a, b, c = 10, 1, 2, 3
+	// the keyserver plus any two of the verifiers, where a counts double
+	want := &proto.QuorumExpr{Op: proto.QUORUM_AND, Candidates: []uint64{keyserver}, Subexpressions: []*proto.QuorumExpr{{
+		Threshold:          2,
+		Candidates:         []uint64{b, c},
+		WeightedCandidates: []*proto.WeightedCandidate{{ID: a, Weight: 2}},
+	}}}
+	for _, tc := range []struct {
+		have []uint64
+		ok   bool
+	}{
+		{[]uint64{keyserver, a}, true},
+		{[]uint64{keyserver, b, c}, true},
+		{[]uint64{keyserver, b}, false},
+		{[]uint64{a, b, c}, false},
+	} {
+		have := make(map[uint64]struct{})
+		for _, id := range tc.have {
+			have[id] = struct{}{}
+		}
+		if got := CheckQuorum(want, have); got != tc.ok {
+			t.Errorf("CheckQuorum(%v) = %v, want %v", tc.have, got, tc.ok)
+		}
+	}
+
+	zero := &proto.QuorumExpr{Threshold: 1, WeightedCandidates: []*proto.WeightedCandidate{{ID: a, Weight: 0}}}
+	if CheckQuorum(zero, map[uint64]struct{}{a: {}}) {
+		t.Error("candidate of weight 0 satisfied a threshold of 1")
+	}
+	if len(ListQuorum(zero, nil)) != 0 {
+		t.Error("candidate of weight 0 listed")
+	}
+	unknown := &proto.QuorumExpr{Op: proto.QuorumOp(42), Candidates: []uint64{a}}
+	if CheckQuorum(unknown, map[uint64]struct{}{a: {}}) {
+		t.Error("expression with an unknown op was satisfied")
+	}
+}
+
+func TestVerifyPolicyWeighted(t *testing.T) {
+	message := []byte("message")
+	policy := &proto.AuthorizationPolicy{PublicKeys: make(map[uint64]*proto.PublicKey)}
+	var ids []uint64
+	sigs := make(map[uint64][]byte)
+	for i := 0; i < 3; i++ {
+		pk, sk, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pked := &proto.PublicKey{PubkeyType: &proto.PublicKey_Ed25519{Ed25519: pk[:]}}
+		id := proto.KeyID(pked)
+		policy.PublicKeys[id] = pked
+		ids = append(ids, id)
+		sigs[id] = ed25519.Sign(sk, message)[:]
+	}
+	policy.PolicyType = &proto.AuthorizationPolicy_Quorum{Quorum: &proto.QuorumExpr{
+		Threshold:          3,
+		Candidates:         ids[1:],
+		WeightedCandidates: []*proto.WeightedCandidate{{ID: ids[0], Weight: 2}},
+	}}
+	if !VerifyPolicy(policy, message, map[uint64][]byte{ids[0]: sigs[ids[0]], ids[1]: sigs[ids[1]]}) {
+		t.Error("signatures of weight 3 rejected")
+	}
+	if VerifyPolicy(policy, message, map[uint64][]byte{ids[1]: sigs[ids[1]], ids[2]: sigs[ids[2]]}) {
+		t.Error("signatures of weight 2 accepted")
+	}
+	if VerifyPolicy(policy, message, map[uint64][]byte{ids[0]: sigs[ids[1]], ids[1]: sigs[ids[1]]}) {
+		t.Error("signature by the wrong key accepted")
+	}
+}
+
+func TestSignatureAlgorithms(t *testing.T) {
+	_, edsk, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecsk, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rsask, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	message := []byte("message")
+	var pks []*proto.PublicKey
+	for _, sk := range []crypto.PrivateKey{edsk, ecsk, rsask} {
+		pk, err := PublicKeyFor(sk)
+		if err != nil {
+			t.Fatalf("PublicKeyFor(%T): %s", sk, err)
+		}
+		pks = append(pks, pk)
+		sig, err := Sign(sk, message)
+		if err != nil {
+			t.Fatalf("Sign(%T): %s", sk, err)
+		}
+		if !VerifySignature(pk, message, sig) {
+			t.Errorf("signature by %T rejected", sk)
+		}
+		if VerifySignature(pk, []byte("other message"), sig) {
+			t.Errorf("signature by %T of another message accepted", sk)
+		}
+		// the key must round-trip through its encoding for key IDs to work
+		pk2 := new(proto.PublicKey)
+		if err := pk2.Unmarshal(proto.MustMarshal(pk)); err != nil || !VerifySignature(pk2, message, sig) {
+			t.Errorf("signature by %T rejected after unmarshaling the key: %v", sk, err)
+		}
+	}
+	for i := range pks {
+		for j := range pks {
+			if i != j && pks[i].Equal(pks[j]) {
+				t.Errorf("keys %d and %d are equal", i, j)
+			}
+		}
+	}
+
+	p384sk, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	shortsk, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, sk := range []crypto.PrivateKey{p384sk, shortsk, "not a key"} {
+		if _, err := Sign(sk, message); err == nil {
+			t.Errorf("signing with unsupported key %T succeeded", sk)
+		}
+	}
+	shortDER, err := x509.MarshalPKIXPublicKey(&shortsk.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := sha256.Sum256(message)
+	shortSig, err := rsa.SignPSS(rand.Reader, shortsk, crypto.SHA256, h[:], rsaPSSOptions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if VerifySignature(&proto.PublicKey{PubkeyType: &proto.PublicKey_RSAPSSSHA256{RSAPSSSHA256: shortDER}}, message, shortSig) {
+		t.Error("signature by a 1024-bit RSA key accepted")
+	}
+}
@@ -24,15 +24,29 @@
 		LookupProof
 		TreeProof
 		Entry
+		RecoveryPolicy
+		PendingRecovery
+		RecoveryVeto
 		SignedEntryUpdate
 		Profile
 		SignedEpochHead
 		TimestampedEpochHead
 		EpochHead
+		VRFRotation
+		VRFMove
 		AuthorizationPolicy
+		DomainAdminPolicy
 		PublicKey
 		QuorumExpr
+		WeightedCandidate
 		EmailProof
+		ExternalProof
+		ClientCertProof
+		EmailChallengeRequest
+		EmailChallengeResponse
+		ListVerifiersRequest
+		ListVerifiersResponse
+		VerifierInfo
 		Config
 		RealmConfig
 		Duration
@@ -43,18 +57,34 @@
 		EmailProofByClientCert
 		EmailProofByOIDC
 		EmailProofBySAML
+		EmailProofByChallenge
+		AccountRecoveryConfig
+		RateLimit
+		SAMLConfig
+		EmailProofByExternalVerifier
 		OIDCConfig
 		Replica
 		ReplicaState
 		KeyserverStep
 		EpochDelimiter
+		EmailChallenge
+		PendingUpdate
 		Timestamp
 		TLSConfig
 		CertificateAndKeyID
 		VerifierStreamRequest
+		VerifierBatchStreamRequest
+		VerifierStepBatch
+		CheckpointRequest
+		CheckpointChunk
+		CheckpointEntry
+		EpochHeadGossip
+		EpochHeadEquivocation
 		VerifierStep
+		AdminUpdate
 		Nothing
 		VerifierConfig
+		GossipPeer
 		VerifierState
 */
 package proto
@@ -91,6 +121,52 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto1.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
+type QuorumOp int32
+
+const (
+	QUORUM_THRESHOLD QuorumOp = 0
+	QUORUM_AND       QuorumOp = 1
+	QUORUM_OR        QuorumOp = 2
+)
+
+var QuorumOp_name = map[int32]string{
+	0: "QUORUM_THRESHOLD",
+	1: "QUORUM_AND",
+	2: "QUORUM_OR",
+}
+var QuorumOp_value = map[string]int32{
+	"QUORUM_THRESHOLD": 0,
+	"QUORUM_AND":       1,
+	"QUORUM_OR":        2,
+}
+
+func (QuorumOp) EnumDescriptor() ([]byte, []int) { return fileDescriptorClient, []int{0} }
+
+// VRFSuite specifies the verifiable random function that the index of a user
+// ID is computed with. The values are those of vrf.Suite.
+type VRFSuite int32
+
+const (
+	// VRF_CONAME is the VRF of the vrf package, which uses SHA3 and its own
+	// hash to the curve.
+	VRF_CONAME VRFSuite = 0
+	// VRF_ECVRF_EDWARDS25519_SHA512_ELL2 is ECVRF-EDWARDS25519-SHA512-ELL2 as
+	// specified in RFC 9381. The index is the first 32 bytes of the VRF output
+	// (beta) for the user ID, and index_proof is the proof (pi) of RFC 9381.
+	VRF_ECVRF_EDWARDS25519_SHA512_ELL2 VRFSuite = 1
+)
+
+var VRFSuite_name = map[int32]string{
+	0: "VRF_CONAME",
+	1: "VRF_ECVRF_EDWARDS25519_SHA512_ELL2",
+}
+var VRFSuite_value = map[string]int32{
+	"VRF_CONAME":                         0,
+	"VRF_ECVRF_EDWARDS25519_SHA512_ELL2": 1,
+}
+
+func (VRFSuite) EnumDescriptor() ([]byte, []int) { return fileDescriptorClient, []int{1} }
+
 type LookupRequest struct {
 	// Epoch as of which to perform the lookup ("latest" if not specified)
 	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
@@ -101,6 +177,11 @@ type LookupRequest struct {
 	// directory state if the ratifications of the latest one do not satisfy
 	// the quorum requirement.
 	QuorumRequirement *QuorumExpr `protobuf:"bytes,4,opt,name=quorum_requirement,json=quorumRequirement" json:"quorum_requirement,omitempty"`
+	// AllowPartialRatification asks the server to answer even if no epoch
+	// satisfies quorum_requirement: it then uses the epoch ratified by the
+	// most verifiers in quorum_requirement (the latest one of those) and sets
+	// LookupProof.quorum_not_met.
+	AllowPartialRatification bool `protobuf:"varint,5,opt,name=allow_partial_ratification,json=allowPartialRatification,proto3" json:"allow_partial_ratification,omitempty"`
 }
 
 func (m *LookupRequest) Reset()                    { *m = LookupRequest{} }
@@ -170,7 +251,8 @@ type LookupProof struct {
 	// index_proof proves that index is a result of applying a globally fixed
 	// bijection VRF to user_id: idx = VRF(user_ID). If this proof checks out,
 	// we can safely continue by looking up the keyserver entry corresponding
-	// to index to get the public key of user_id.
+	// to index to get the public key of user_id. The VRF is that of
+	// RealmConfig.VRFSuite.
 	IndexProof []byte `protobuf:"bytes,3,opt,name=index_proof,json=indexProof,proto3" json:"index_proof,omitempty"`
 	// ratifications contains signed directory state summaries for the epoch under
 	// which the lookup was performed.
@@ -190,6 +272,15 @@ type LookupProof struct {
 	// Entry specifies profile by hash(profile) = entry.profile_hash
 	Entry   *EncodedEntry   `protobuf:"bytes,6,opt,name=entry,customtype=EncodedEntry" json:"entry,omitempty"`
 	Profile *EncodedProfile `protobuf:"bytes,7,opt,name=profile,customtype=EncodedProfile" json:"profile,omitempty"`
+	// PendingRecovery is the recovery of this entry that is waiting for its
+	// delay to pass, if any. It is not covered by the tree proof and only
+	// serves to warn the owner of the entry.
+	PendingRecovery *PendingRecovery `protobuf:"bytes,8,opt,name=pending_recovery,json=pendingRecovery" json:"pending_recovery,omitempty"`
+	// QuorumNotMet is set if the lookup allowed partial ratification and the
+	// ratifications do not satisfy its quorum requirement. It is not
+	// authenticated: clients MUST check the ratifications against their own
+	// policy regardless.
+	QuorumNotMet bool `protobuf:"varint,9,opt,name=quorum_not_met,json=quorumNotMet,proto3" json:"quorum_not_met,omitempty"`
 }
 
 func (m *LookupProof) Reset()                    { *m = LookupProof{} }
@@ -210,6 +301,13 @@ func (m *LookupProof) GetTreeProof() *TreeProof {
 	return nil
 }
 
+func (m *LookupProof) GetPendingRecovery() *PendingRecovery {
+	if m != nil {
+		return m.PendingRecovery
+	}
+	return nil
+}
+
 // A Proof provides an authentication path through the Merkle Tree that
 // proves that an item is or is not present in the tree.
 type TreeProof struct {
@@ -269,6 +367,15 @@ type Entry struct {
 	// contents. The commitment is computed as commitment =
 	// sha3shake256(profile); the contents contain a nonce.
 	ProfileCommitment []byte `protobuf:"bytes,4,opt,name=profile_commitment,json=profileCommitment,proto3" json:"profile_commitment,omitempty"`
+	// RecoveryPolicy, if set, allows replacing this entry without a signature
+	// by update_policy. Otherwise the entry can only be changed with one.
+	RecoveryPolicy *RecoveryPolicy `protobuf:"bytes,5,opt,name=recovery_policy,json=recoveryPolicy" json:"recovery_policy,omitempty"`
+	// Deleted marks a tombstone: the user ID is not registered anymore. A
+	// tombstone has no update_policy, profile_commitment or recovery_policy,
+	// and it is authorized by the update policy of the entry it replaces or by
+	// the deletion policy of the realm. The user ID can be registered again
+	// with a version greater than that of the tombstone.
+	Deleted bool `protobuf:"varint,6,opt,name=deleted,proto3" json:"deleted,omitempty"`
 }
 
 func (m *Entry) Reset()                    { *m = Entry{} }
@@ -282,6 +389,77 @@ func (m *Entry) GetUpdatePolicy() *AuthorizationPolicy {
 	return nil
 }
 
+func (m *Entry) GetRecoveryPolicy() *RecoveryPolicy {
+	if m != nil {
+		return m.RecoveryPolicy
+	}
+	return nil
+}
+
+// RecoveryPolicy specifies how an entry can be recovered by its owner after
+// losing the keys of its update policy. The new entry is submitted together
+// with a fresh email proof and signed by its own update policy, but it only
+// takes effect after delay_seconds have passed (as measured by the issue times
+// of epoch heads). Until then, the holder of the old keys is notified and can
+// cancel the recovery with a RecoveryVeto.
+type RecoveryPolicy struct {
+	DelaySeconds uint64 `protobuf:"varint,1,opt,name=delay_seconds,json=delaySeconds,proto3" json:"delay_seconds,omitempty"`
+}
+
+func (m *RecoveryPolicy) Reset()                    { *m = RecoveryPolicy{} }
+func (*RecoveryPolicy) ProtoMessage()               {}
+func (*RecoveryPolicy) Descriptor() ([]byte, []int) { return fileDescriptorClient, []int{5} }
+
+// PendingRecovery is a started recovery of an entry.
+type PendingRecovery struct {
+	// Update carries the new entry and the signatures of its update policy.
+	// The signatures of the old update policy are missing.
+	Update *SignedEntryUpdate `protobuf:"bytes,1,opt,name=update" json:"update,omitempty"`
+	// NotBefore is the earliest issue time of the epoch head after which the
+	// update may be applied: the issue time of the last epoch head when the
+	// recovery was started plus the delay of the recovery policy.
+	NotBefore Timestamp `protobuf:"bytes,2,opt,name=not_before,json=notBefore" json:"not_before"`
+}
+
+func (m *PendingRecovery) Reset()                    { *m = PendingRecovery{} }
+func (*PendingRecovery) ProtoMessage()               {}
+func (*PendingRecovery) Descriptor() ([]byte, []int) { return fileDescriptorClient, []int{6} }
+
+func (m *PendingRecovery) GetUpdate() *SignedEntryUpdate {
+	if m != nil {
+		return m.Update
+	}
+	return nil
+}
+
+func (m *PendingRecovery) GetNotBefore() Timestamp {
+	if m != nil {
+		return m.NotBefore
+	}
+	return Timestamp{}
+}
+
+// RecoveryVeto cancels the pending recovery of the entry at index that would
+// replace it with the entry whose encoding hashes to entry_hash. The
+// signatures are by the current update policy of the entry over
+// "coname recovery veto\x00" followed by entry_hash.
+type RecoveryVeto struct {
+	Index      []byte            `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	EntryHash  []byte            `protobuf:"bytes,2,opt,name=entry_hash,json=entryHash,proto3" json:"entry_hash,omitempty"`
+	Signatures map[uint64][]byte `protobuf:"bytes,3,rep,name=signatures" json:"signatures,omitempty" protobuf_key:"fixed64,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *RecoveryVeto) Reset()                    { *m = RecoveryVeto{} }
+func (*RecoveryVeto) ProtoMessage()               {}
+func (*RecoveryVeto) Descriptor() ([]byte, []int) { return fileDescriptorClient, []int{7} }
+
+func (m *RecoveryVeto) GetSignatures() map[uint64][]byte {
+	if m != nil {
+		return m.Signatures
+	}
+	return nil
+}
+
 // SignedEntryUpdate is the minimal self-contained structure to justify
 // changing the value of an entry. In the state machine model of a namespace,
 // SignedEntryUpdate is the main input type.
@@ -298,7 +476,7 @@ type SignedEntryUpdate struct {
 
 func (m *SignedEntryUpdate) Reset()                    { *m = SignedEntryUpdate{} }
 func (*SignedEntryUpdate) ProtoMessage()               {}
-func (*SignedEntryUpdate) Descriptor() ([]byte, []int) { return fileDescriptorClient, []int{5} }
+func (*SignedEntryUpdate) Descriptor() ([]byte, []int) { return fileDescriptorClient, []int{8} }
 
 func (m *SignedEntryUpdate) GetSignatures() map[uint64][]byte {
 	if m != nil {
@@ -324,7 +502,7 @@ type Profile struct {
 
 func (m *Profile) Reset()                    { *m = Profile{} }
 func (*Profile) ProtoMessage()               {}
-func (*Profile) Descriptor() ([]byte, []int) { return fileDescriptorClient, []int{6} }
+func (*Profile) Descriptor() ([]byte, []int) { return fileDescriptorClient, []int{9} }
 
 func (m *Profile) GetKeys() map[string][]byte {
 	if m != nil {
@@ -349,7 +527,7 @@ type SignedEpochHead struct {
 
 func (m *SignedEpochHead) Reset()                    { *m = SignedEpochHead{} }
 func (*SignedEpochHead) ProtoMessage()               {}
-func (*SignedEpochHead) Descriptor() ([]byte, []int) { return fileDescriptorClient, []int{7} }
+func (*SignedEpochHead) Descriptor() ([]byte, []int) { return fileDescriptorClient, []int{10} }
 
 func (m *SignedEpochHead) GetSignatures() map[uint64][]byte {
 	if m != nil {
@@ -372,11 +550,16 @@ type TimestampedEpochHead struct {
 	// accept the previous state (assuming that all quorums the client considers
 	// sufficient contain a correct and honest server).
 	Timestamp Timestamp `protobuf:"bytes,2,opt,name=timestamp" json:"timestamp"`
+	// Refresh is set if the keyserver re-issued head at timestamp instead of
+	// starting a new epoch, see KeyserverConfig.RefreshIdleEpochs. Clients
+	// count the signatures of a refresh as issued at timestamp; all other
+	// signatures count as issued at head.issue_time.
+	Refresh bool `protobuf:"varint,3,opt,name=refresh,proto3" json:"refresh,omitempty"`
 }
 
 func (m *TimestampedEpochHead) Reset()                    { *m = TimestampedEpochHead{} }
 func (*TimestampedEpochHead) ProtoMessage()               {}
-func (*TimestampedEpochHead) Descriptor() ([]byte, []int) { return fileDescriptorClient, []int{8} }
+func (*TimestampedEpochHead) Descriptor() ([]byte, []int) { return fileDescriptorClient, []int{11} }
 
 func (m *TimestampedEpochHead) GetTimestamp() Timestamp {
 	if m != nil {
@@ -410,11 +593,20 @@ type EpochHead struct {
 	// client software and benefits from reliable automated updates, clients
 	// MAY ignore this field.
 	NextEpochPolicy AuthorizationPolicy `protobuf:"bytes,6,opt,name=next_epoch_policy,json=nextEpochPolicy" json:"next_epoch_policy"`
+	// VRFPublic is the public key of the verifiable random function that maps
+	// user IDs to the indices of the tree summarized by this epoch. It differs
+	// from RealmConfig.VRFPublic after the keyserver has rotated its VRF key.
+	VRFPublic []byte `protobuf:"bytes,7,opt,name=vrf_public,json=vrfPublic,proto3" json:"vrf_public,omitempty"`
+	// VRFRotationTime is the issue time of the first epoch whose tree was
+	// indexed by vrf_public, or nil if the VRF key has never been rotated.
+	// Clients accept index proofs under vrf_public instead of
+	// RealmConfig.VRFPublic for RealmConfig.VRFTransition after this time.
+	VRFRotationTime *Timestamp `protobuf:"bytes,8,opt,name=vrf_rotation_time,json=vrfRotationTime" json:"vrf_rotation_time,omitempty"`
 }
 
 func (m *EpochHead) Reset()                    { *m = EpochHead{} }
 func (*EpochHead) ProtoMessage()               {}
-func (*EpochHead) Descriptor() ([]byte, []int) { return fileDescriptorClient, []int{9} }
+func (*EpochHead) Descriptor() ([]byte, []int) { return fileDescriptorClient, []int{12} }
 
 func (m *EpochHead) GetIssueTime() Timestamp {
 	if m != nil {
@@ -430,6 +622,53 @@ func (m *EpochHead) GetNextEpochPolicy() AuthorizationPolicy {
 	return AuthorizationPolicy{}
 }
 
+func (m *EpochHead) GetVRFRotationTime() *Timestamp {
+	if m != nil {
+		return m.VRFRotationTime
+	}
+	return nil
+}
+
+// VRFRotation re-indexes the directory under a new VRF key: the entry of each
+// user ID is moved, unchanged, from its index under the old key to its index
+// under vrf_public. Pending recoveries are cancelled because their entries
+// were signed with the old index. In the keyserver log, only vrf_public is
+// set; the replicas compute the moves deterministically. In the verifier log,
+// a rotation is split into several consecutive steps that together list each
+// entry of the directory exactly once, in increasing order of the old index.
+// All but the last of them have more set.
+type VRFRotation struct {
+	VRFPublic []byte     `protobuf:"bytes,1,opt,name=vrf_public,json=vrfPublic,proto3" json:"vrf_public,omitempty"`
+	Moves     []*VRFMove `protobuf:"bytes,4,rep,name=moves" json:"moves,omitempty"`
+	More      bool       `protobuf:"varint,5,opt,name=more,proto3" json:"more,omitempty"`
+}
+
+func (m *VRFRotation) Reset()                    { *m = VRFRotation{} }
+func (*VRFRotation) ProtoMessage()               {}
+func (*VRFRotation) Descriptor() ([]byte, []int) { return fileDescriptorClient, []int{13} }
+
+func (m *VRFRotation) GetMoves() []*VRFMove {
+	if m != nil {
+		return m.Moves
+	}
+	return nil
+}
+
+// VRFMove moves the entry of user_id from old_index to new_index. The index
+// proofs show that these are the indices of user_id under the old and the new
+// VRF key, so that verifiers can check that each entry stays with its user ID.
+type VRFMove struct {
+	UserId        string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OldIndex      []byte `protobuf:"bytes,2,opt,name=old_index,json=oldIndex,proto3" json:"old_index,omitempty"`
+	OldIndexProof []byte `protobuf:"bytes,3,opt,name=old_index_proof,json=oldIndexProof,proto3" json:"old_index_proof,omitempty"`
+	NewIndex      []byte `protobuf:"bytes,4,opt,name=new_index,json=newIndex,proto3" json:"new_index,omitempty"`
+	NewIndexProof []byte `protobuf:"bytes,5,opt,name=new_index_proof,json=newIndexProof,proto3" json:"new_index_proof,omitempty"`
+}
+
+func (m *VRFMove) Reset()                    { *m = VRFMove{} }
+func (*VRFMove) ProtoMessage()               {}
+func (*VRFMove) Descriptor() ([]byte, []int) { return fileDescriptorClient, []int{14} }
+
 // AuthorizationPolicy is used to check whether some signatures make up
 // sufficient authorization to back an action.
 // This is used to implement the following:
@@ -453,7 +692,7 @@ type AuthorizationPolicy struct {
 
 func (m *AuthorizationPolicy) Reset()                    { *m = AuthorizationPolicy{} }
 func (*AuthorizationPolicy) ProtoMessage()               {}
-func (*AuthorizationPolicy) Descriptor() ([]byte, []int) { return fileDescriptorClient, []int{10} }
+func (*AuthorizationPolicy) Descriptor() ([]byte, []int) { return fileDescriptorClient, []int{15} }
 
 type isAuthorizationPolicy_PolicyType interface {
 	isAuthorizationPolicy_PolicyType()
@@ -545,6 +784,26 @@ func _AuthorizationPolicy_OneofSizer(msg proto1.Message) (n int) {
 	return n
 }
 
+// DomainAdminPolicy authorizes updates to any entry whose user ID is in one of
+// the domains, in addition to the update policy of the entry itself. It is
+// meant for managed realms in which the administrators of a domain need to
+// revoke or reset the keys of its users.
+type DomainAdminPolicy struct {
+	Domains []string             `protobuf:"bytes,1,rep,name=domains" json:"domains,omitempty"`
+	Policy  *AuthorizationPolicy `protobuf:"bytes,2,opt,name=policy" json:"policy,omitempty"`
+}
+
+func (m *DomainAdminPolicy) Reset()                    { *m = DomainAdminPolicy{} }
+func (*DomainAdminPolicy) ProtoMessage()               {}
+func (*DomainAdminPolicy) Descriptor() ([]byte, []int) { return fileDescriptorClient, []int{16} }
+
+func (m *DomainAdminPolicy) GetPolicy() *AuthorizationPolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return nil
+}
+
 // PublicKey wraps a public key of a cryptographically secure signature
 // scheme and verification metadata. Each verifier can have its own signature
 // format and needs to implement serialization and deserialization of its own
@@ -554,12 +813,14 @@ func _AuthorizationPolicy_OneofSizer(msg proto1.Message) (n int) {
 type PublicKey struct {
 	// Types that are valid to be assigned to PubkeyType:
 	//	*PublicKey_Ed25519
+	//	*PublicKey_ECDSAP256SHA256
+	//	*PublicKey_RSAPSSSHA256
 	PubkeyType isPublicKey_PubkeyType `protobuf_oneof:"pubkey_type"`
 }
 
 func (m *PublicKey) Reset()                    { *m = PublicKey{} }
 func (*PublicKey) ProtoMessage()               {}
-func (*PublicKey) Descriptor() ([]byte, []int) { return fileDescriptorClient, []int{11} }
+func (*PublicKey) Descriptor() ([]byte, []int) { return fileDescriptorClient, []int{17} }
 
 type isPublicKey_PubkeyType interface {
 	isPublicKey_PubkeyType()
@@ -572,8 +833,16 @@ type isPublicKey_PubkeyType interface {
 type PublicKey_Ed25519 struct {
 	Ed25519 []byte `protobuf:"bytes,1,opt,name=ed25519,proto3,oneof"`
 }
+type PublicKey_ECDSAP256SHA256 struct {
+	ECDSAP256SHA256 []byte `protobuf:"bytes,3,opt,name=ecdsa_p256_sha256,json=ecdsaP256Sha256,proto3,oneof"`
+}
+type PublicKey_RSAPSSSHA256 struct {
+	RSAPSSSHA256 []byte `protobuf:"bytes,4,opt,name=rsa_pss_sha256,json=rsaPssSha256,proto3,oneof"`
+}
 
-func (*PublicKey_Ed25519) isPublicKey_PubkeyType() {}
+func (*PublicKey_Ed25519) isPublicKey_PubkeyType()         {}
+func (*PublicKey_ECDSAP256SHA256) isPublicKey_PubkeyType() {}
+func (*PublicKey_RSAPSSSHA256) isPublicKey_PubkeyType()    {}
 
 func (m *PublicKey) GetPubkeyType() isPublicKey_PubkeyType {
 	if m != nil {
@@ -589,10 +858,26 @@ func (m *PublicKey) GetEd25519() []byte {
 	return nil
 }
 
+func (m *PublicKey) GetECDSAP256SHA256() []byte {
+	if x, ok := m.GetPubkeyType().(*PublicKey_ECDSAP256SHA256); ok {
+		return x.ECDSAP256SHA256
+	}
+	return nil
+}
+
+func (m *PublicKey) GetRSAPSSSHA256() []byte {
+	if x, ok := m.GetPubkeyType().(*PublicKey_RSAPSSSHA256); ok {
+		return x.RSAPSSSHA256
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*PublicKey) XXX_OneofFuncs() (func(msg proto1.Message, b *proto1.Buffer) error, func(msg proto1.Message, tag, wire int, b *proto1.Buffer) (bool, error), func(msg proto1.Message) (n int), []interface{}) {
 	return _PublicKey_OneofMarshaler, _PublicKey_OneofUnmarshaler, _PublicKey_OneofSizer, []interface{}{
 		(*PublicKey_Ed25519)(nil),
+		(*PublicKey_ECDSAP256SHA256)(nil),
+		(*PublicKey_RSAPSSSHA256)(nil),
 	}
 }
 
@@ -603,6 +888,12 @@ func _PublicKey_OneofMarshaler(msg proto1.Message, b *proto1.Buffer) error {
 	case *PublicKey_Ed25519:
 		_ = b.EncodeVarint(1<<3 | proto1.WireBytes)
 		_ = b.EncodeRawBytes(x.Ed25519)
+	case *PublicKey_ECDSAP256SHA256:
+		_ = b.EncodeVarint(3<<3 | proto1.WireBytes)
+		_ = b.EncodeRawBytes(x.ECDSAP256SHA256)
+	case *PublicKey_RSAPSSSHA256:
+		_ = b.EncodeVarint(4<<3 | proto1.WireBytes)
+		_ = b.EncodeRawBytes(x.RSAPSSSHA256)
 	case nil:
 	default:
 		return fmt.Errorf("PublicKey.PubkeyType has unexpected type %T", x)
@@ -620,6 +911,20 @@ func _PublicKey_OneofUnmarshaler(msg proto1.Message, tag, wire int, b *proto1.Bu
 		x, err := b.DecodeRawBytes(true)
 		m.PubkeyType = &PublicKey_Ed25519{x}
 		return true, err
+	case 3: // pubkey_type.ecdsa_p256_sha256
+		if wire != proto1.WireBytes {
+			return true, proto1.ErrInternalBadWireType
+		}
+		x, err := b.DecodeRawBytes(true)
+		m.PubkeyType = &PublicKey_ECDSAP256SHA256{x}
+		return true, err
+	case 4: // pubkey_type.rsa_pss_sha256
+		if wire != proto1.WireBytes {
+			return true, proto1.ErrInternalBadWireType
+		}
+		x, err := b.DecodeRawBytes(true)
+		m.PubkeyType = &PublicKey_RSAPSSSHA256{x}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -633,6 +938,14 @@ func _PublicKey_OneofSizer(msg proto1.Message) (n int) {
 		n += proto1.SizeVarint(1<<3 | proto1.WireBytes)
 		n += proto1.SizeVarint(uint64(len(x.Ed25519)))
 		n += len(x.Ed25519)
+	case *PublicKey_ECDSAP256SHA256:
+		n += proto1.SizeVarint(3<<3 | proto1.WireBytes)
+		n += proto1.SizeVarint(uint64(len(x.ECDSAP256SHA256)))
+		n += len(x.ECDSAP256SHA256)
+	case *PublicKey_RSAPSSSHA256:
+		n += proto1.SizeVarint(4<<3 | proto1.WireBytes)
+		n += proto1.SizeVarint(uint64(len(x.RSAPSSSHA256)))
+		n += len(x.RSAPSSSHA256)
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -640,11 +953,18 @@ func _PublicKey_OneofSizer(msg proto1.Message) (n int) {
 	return n
 }
 
-// QuorumExpr represents a function with type set<uint64> -> bool. An
-// expression evaluates to true given args iff the sum of the following two
-// numbers is at least threshold:
-// - number of entries in verifiers that are in args
+// QuorumExpr represents a function with type set<uint64> -> bool. With the
+// default op QUORUM_THRESHOLD, an expression evaluates to true given args iff
+// the sum of the following numbers is at least threshold:
+// - number of entries in candidates that are in args
+// - sum of the weights of the entries in weighted_candidates that are in args
 // - number of subexpressions that evaluate to true
+// With QUORUM_AND it evaluates to true iff all candidates (weighted or not)
+// are in args and all subexpressions evaluate to true, with QUORUM_OR iff any
+// of them does; threshold and weights are ignored. An expression with an
+// unknown op evaluates to false. Implementations that predate op and
+// weighted_candidates ignore them, so expressions that use them MUST NOT be
+// given to such implementations.
 // note: expr.eval(a) \wedge expr.eval(b) -> expr.eval(a \cup b)
 type QuorumExpr struct {
 	Threshold  uint32   `protobuf:"varint,1,opt,name=threshold,proto3" json:"threshold,omitempty"`
@@ -654,11 +974,15 @@ type QuorumExpr struct {
 	// If an implementation chooses to ban recursive thresholding, it can do so
 	// ignoring this field. However, doing so is NOT SUPPORTED.
 	Subexpressions []*QuorumExpr `protobuf:"bytes,3,rep,name=subexpressions" json:"subexpressions,omitempty"`
+	// WeightedCandidates allows expressing conditions of the form "any two
+	// of these, where the first one counts double".
+	WeightedCandidates []*WeightedCandidate `protobuf:"bytes,4,rep,name=weighted_candidates,json=weightedCandidates" json:"weighted_candidates,omitempty"`
+	Op                 QuorumOp             `protobuf:"varint,5,opt,name=op,proto3,enum=proto.QuorumOp" json:"op,omitempty"`
 }
 
 func (m *QuorumExpr) Reset()                    { *m = QuorumExpr{} }
 func (*QuorumExpr) ProtoMessage()               {}
-func (*QuorumExpr) Descriptor() ([]byte, []int) { return fileDescriptorClient, []int{12} }
+func (*QuorumExpr) Descriptor() ([]byte, []int) { return fileDescriptorClient, []int{18} }
 
 func (m *QuorumExpr) GetSubexpressions() []*QuorumExpr {
 	if m != nil {
@@ -667,18 +991,37 @@ func (m *QuorumExpr) GetSubexpressions() []*QuorumExpr {
 	return nil
 }
 
+func (m *QuorumExpr) GetWeightedCandidates() []*WeightedCandidate {
+	if m != nil {
+		return m.WeightedCandidates
+	}
+	return nil
+}
+
+type WeightedCandidate struct {
+	ID     uint64 `protobuf:"fixed64,1,opt,name=id,proto3" json:"id,omitempty"`
+	Weight uint32 `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (m *WeightedCandidate) Reset()                    { *m = WeightedCandidate{} }
+func (*WeightedCandidate) ProtoMessage()               {}
+func (*WeightedCandidate) Descriptor() ([]byte, []int) { return fileDescriptorClient, []int{19} }
+
 // EmailProof provides a proof of ownership of the email address
 type EmailProof struct {
 	// Types that are valid to be assigned to ProofType:
 	//	*EmailProof_DKIMProof
 	//	*EmailProof_OIDCToken
 	//	*EmailProof_SAMLResponse
+	//	*EmailProof_ChallengeCode
+	//	*EmailProof_ClientCert
+	//	*EmailProof_External
 	ProofType isEmailProof_ProofType `protobuf_oneof:"proof_type"`
 }
 
 func (m *EmailProof) Reset()                    { *m = EmailProof{} }
 func (*EmailProof) ProtoMessage()               {}
-func (*EmailProof) Descriptor() ([]byte, []int) { return fileDescriptorClient, []int{13} }
+func (*EmailProof) Descriptor() ([]byte, []int) { return fileDescriptorClient, []int{20} }
 
 type isEmailProof_ProofType interface {
 	isEmailProof_ProofType()
@@ -697,10 +1040,22 @@ type EmailProof_OIDCToken struct {
 type EmailProof_SAMLResponse struct {
 	SAMLResponse string `protobuf:"bytes,3,opt,name=saml_response,json=samlResponse,proto3,oneof"`
 }
+type EmailProof_ChallengeCode struct {
+	ChallengeCode string `protobuf:"bytes,4,opt,name=challenge_code,json=challengeCode,proto3,oneof"`
+}
+type EmailProof_ClientCert struct {
+	ClientCert *ClientCertProof `protobuf:"bytes,5,opt,name=client_cert,json=clientCert,oneof"`
+}
+type EmailProof_External struct {
+	External *ExternalProof `protobuf:"bytes,6,opt,name=external,oneof"`
+}
 
-func (*EmailProof_DKIMProof) isEmailProof_ProofType()    {}
-func (*EmailProof_OIDCToken) isEmailProof_ProofType()    {}
-func (*EmailProof_SAMLResponse) isEmailProof_ProofType() {}
+func (*EmailProof_DKIMProof) isEmailProof_ProofType()     {}
+func (*EmailProof_OIDCToken) isEmailProof_ProofType()     {}
+func (*EmailProof_SAMLResponse) isEmailProof_ProofType()  {}
+func (*EmailProof_ChallengeCode) isEmailProof_ProofType() {}
+func (*EmailProof_ClientCert) isEmailProof_ProofType()    {}
+func (*EmailProof_External) isEmailProof_ProofType()      {}
 
 func (m *EmailProof) GetProofType() isEmailProof_ProofType {
 	if m != nil {
@@ -730,12 +1085,36 @@ func (m *EmailProof) GetSAMLResponse() string {
 	return ""
 }
 
+func (m *EmailProof) GetChallengeCode() string {
+	if x, ok := m.GetProofType().(*EmailProof_ChallengeCode); ok {
+		return x.ChallengeCode
+	}
+	return ""
+}
+
+func (m *EmailProof) GetClientCert() *ClientCertProof {
+	if x, ok := m.GetProofType().(*EmailProof_ClientCert); ok {
+		return x.ClientCert
+	}
+	return nil
+}
+
+func (m *EmailProof) GetExternal() *ExternalProof {
+	if x, ok := m.GetProofType().(*EmailProof_External); ok {
+		return x.External
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*EmailProof) XXX_OneofFuncs() (func(msg proto1.Message, b *proto1.Buffer) error, func(msg proto1.Message, tag, wire int, b *proto1.Buffer) (bool, error), func(msg proto1.Message) (n int), []interface{}) {
 	return _EmailProof_OneofMarshaler, _EmailProof_OneofUnmarshaler, _EmailProof_OneofSizer, []interface{}{
 		(*EmailProof_DKIMProof)(nil),
 		(*EmailProof_OIDCToken)(nil),
 		(*EmailProof_SAMLResponse)(nil),
+		(*EmailProof_ChallengeCode)(nil),
+		(*EmailProof_ClientCert)(nil),
+		(*EmailProof_External)(nil),
 	}
 }
 
@@ -752,6 +1131,19 @@ func _EmailProof_OneofMarshaler(msg proto1.Message, b *proto1.Buffer) error {
 	case *EmailProof_SAMLResponse:
 		_ = b.EncodeVarint(3<<3 | proto1.WireBytes)
 		_ = b.EncodeStringBytes(x.SAMLResponse)
+	case *EmailProof_ChallengeCode:
+		_ = b.EncodeVarint(4<<3 | proto1.WireBytes)
+		_ = b.EncodeStringBytes(x.ChallengeCode)
+	case *EmailProof_ClientCert:
+		_ = b.EncodeVarint(5<<3 | proto1.WireBytes)
+		if err := b.EncodeMessage(x.ClientCert); err != nil {
+			return err
+		}
+	case *EmailProof_External:
+		_ = b.EncodeVarint(6<<3 | proto1.WireBytes)
+		if err := b.EncodeMessage(x.External); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("EmailProof.ProofType has unexpected type %T", x)
@@ -783,6 +1175,29 @@ func _EmailProof_OneofUnmarshaler(msg proto1.Message, tag, wire int, b *proto1.B
 		x, err := b.DecodeStringBytes()
 		m.ProofType = &EmailProof_SAMLResponse{x}
 		return true, err
+	case 4: // proof_type.challenge_code
+		if wire != proto1.WireBytes {
+			return true, proto1.ErrInternalBadWireType
+		}
+		x, err := b.DecodeStringBytes()
+		m.ProofType = &EmailProof_ChallengeCode{x}
+		return true, err
+	case 5: // proof_type.client_cert
+		if wire != proto1.WireBytes {
+			return true, proto1.ErrInternalBadWireType
+		}
+		msg := new(ClientCertProof)
+		err := b.DecodeMessage(msg)
+		m.ProofType = &EmailProof_ClientCert{msg}
+		return true, err
+	case 6: // proof_type.external
+		if wire != proto1.WireBytes {
+			return true, proto1.ErrInternalBadWireType
+		}
+		msg := new(ExternalProof)
+		err := b.DecodeMessage(msg)
+		m.ProofType = &EmailProof_External{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -804,6 +1219,20 @@ func _EmailProof_OneofSizer(msg proto1.Message) (n int) {
 		n += proto1.SizeVarint(3<<3 | proto1.WireBytes)
 		n += proto1.SizeVarint(uint64(len(x.SAMLResponse)))
 		n += len(x.SAMLResponse)
+	case *EmailProof_ChallengeCode:
+		n += proto1.SizeVarint(4<<3 | proto1.WireBytes)
+		n += proto1.SizeVarint(uint64(len(x.ChallengeCode)))
+		n += len(x.ChallengeCode)
+	case *EmailProof_ClientCert:
+		s := proto1.Size(x.ClientCert)
+		n += proto1.SizeVarint(5<<3 | proto1.WireBytes)
+		n += proto1.SizeVarint(uint64(s))
+		n += s
+	case *EmailProof_External:
+		s := proto1.Size(x.External)
+		n += proto1.SizeVarint(6<<3 | proto1.WireBytes)
+		n += proto1.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -811,21 +1240,157 @@ func _EmailProof_OneofSizer(msg proto1.Message) (n int) {
 	return n
 }
 
+// ExternalProof carries an email proof for a verifier that was registered with
+// the keyserver under type, see EmailProofByExternalVerifier. The format of
+// proof is up to that verifier.
+type ExternalProof struct {
+	Type  string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Proof []byte `protobuf:"bytes,2,opt,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (m *ExternalProof) Reset()                    { *m = ExternalProof{} }
+func (*ExternalProof) ProtoMessage()               {}
+func (*ExternalProof) Descriptor() ([]byte, []int) { return fileDescriptorClient, []int{21} }
+
+// ClientCertProof shows that the requester holds a certificate for the email
+// address being registered. If signature is empty, the certificate the client
+// presented in the TLS handshake is used and certificates are ignored.
+// Otherwise, certificates[0] is the certificate, followed by any
+// intermediates, and signature is its signature of ClientCertProofContext
+// followed by the SHAKE256 hash (32 bytes) of the encoding of the
+// SignedEntryUpdate.new_entry being registered. The signature is PKCS #1 v1.5
+// with SHA-256 for RSA keys and ASN.1 ECDSA with SHA-256 for ECDSA keys.
+type ClientCertProof struct {
+	Certificates [][]byte `protobuf:"bytes,1,rep,name=certificates" json:"certificates,omitempty"`
+	Signature    []byte   `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *ClientCertProof) Reset()                    { *m = ClientCertProof{} }
+func (*ClientCertProof) ProtoMessage()               {}
+func (*ClientCertProof) Descriptor() ([]byte, []int) { return fileDescriptorClient, []int{22} }
+
+// EmailChallengeRequest asks the keyserver to email a one-time code to
+// user_id. The code can only be used to register an entry whose hash is
+// entry_hash.
+type EmailChallengeRequest struct {
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// entry_hash is the SHAKE256 hash (32 bytes) of the encoding of the
+	// SignedEntryUpdate.new_entry that is going to be registered.
+	EntryHash []byte `protobuf:"bytes,2,opt,name=entry_hash,json=entryHash,proto3" json:"entry_hash,omitempty"`
+}
+
+func (m *EmailChallengeRequest) Reset()                    { *m = EmailChallengeRequest{} }
+func (*EmailChallengeRequest) ProtoMessage()               {}
+func (*EmailChallengeRequest) Descriptor() ([]byte, []int) { return fileDescriptorClient, []int{23} }
+
+type EmailChallengeResponse struct {
+	// expiration is the time after which the emailed code will not be
+	// accepted anymore.
+	Expiration Timestamp `protobuf:"bytes,1,opt,name=expiration" json:"expiration"`
+}
+
+func (m *EmailChallengeResponse) Reset()                    { *m = EmailChallengeResponse{} }
+func (*EmailChallengeResponse) ProtoMessage()               {}
+func (*EmailChallengeResponse) Descriptor() ([]byte, []int) { return fileDescriptorClient, []int{24} }
+
+func (m *EmailChallengeResponse) GetExpiration() Timestamp {
+	if m != nil {
+		return m.Expiration
+	}
+	return Timestamp{}
+}
+
+type ListVerifiersRequest struct {
+}
+
+func (m *ListVerifiersRequest) Reset()                    { *m = ListVerifiersRequest{} }
+func (*ListVerifiersRequest) ProtoMessage()               {}
+func (*ListVerifiersRequest) Descriptor() ([]byte, []int) { return fileDescriptorClient, []int{25} }
+
+type ListVerifiersResponse struct {
+	// Verifiers is sorted by id.
+	Verifiers []*VerifierInfo `protobuf:"bytes,1,rep,name=verifiers" json:"verifiers,omitempty"`
+}
+
+func (m *ListVerifiersResponse) Reset()                    { *m = ListVerifiersResponse{} }
+func (*ListVerifiersResponse) ProtoMessage()               {}
+func (*ListVerifiersResponse) Descriptor() ([]byte, []int) { return fileDescriptorClient, []int{26} }
+
+func (m *ListVerifiersResponse) GetVerifiers() []*VerifierInfo {
+	if m != nil {
+		return m.Verifiers
+	}
+	return nil
+}
+
+// VerifierInfo describes a verifier as seen by the keyserver. It is not
+// authenticated: clients MUST NOT trust a verifier just because it is listed.
+type VerifierInfo struct {
+	ID uint64 `protobuf:"fixed64,1,opt,name=id,proto3" json:"id,omitempty"`
+	// PublicKey is the signing key of the verifier as configured at the
+	// keyserver, or unset if the keyserver only knows its signatures.
+	PublicKey *PublicKey `protobuf:"bytes,2,opt,name=public_key,json=publicKey" json:"public_key,omitempty"`
+	// LatestRatifiedEpoch is the latest epoch the verifier has ratified among
+	// those the keyserver scans for lookups, or 0 if there is none.
+	LatestRatifiedEpoch uint64 `protobuf:"varint,3,opt,name=latest_ratified_epoch,json=latestRatifiedEpoch,proto3" json:"latest_ratified_epoch,omitempty"`
+}
+
+func (m *VerifierInfo) Reset()                    { *m = VerifierInfo{} }
+func (*VerifierInfo) ProtoMessage()               {}
+func (*VerifierInfo) Descriptor() ([]byte, []int) { return fileDescriptorClient, []int{27} }
+
+func (m *VerifierInfo) GetPublicKey() *PublicKey {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
 func init() {
 	proto1.RegisterType((*LookupRequest)(nil), "proto.LookupRequest")
 	proto1.RegisterType((*UpdateRequest)(nil), "proto.UpdateRequest")
 	proto1.RegisterType((*LookupProof)(nil), "proto.LookupProof")
 	proto1.RegisterType((*TreeProof)(nil), "proto.TreeProof")
 	proto1.RegisterType((*Entry)(nil), "proto.Entry")
+	proto1.RegisterType((*RecoveryPolicy)(nil), "proto.RecoveryPolicy")
+	proto1.RegisterType((*PendingRecovery)(nil), "proto.PendingRecovery")
+	proto1.RegisterType((*RecoveryVeto)(nil), "proto.RecoveryVeto")
 	proto1.RegisterType((*SignedEntryUpdate)(nil), "proto.SignedEntryUpdate")
 	proto1.RegisterType((*Profile)(nil), "proto.Profile")
 	proto1.RegisterType((*SignedEpochHead)(nil), "proto.SignedEpochHead")
 	proto1.RegisterType((*TimestampedEpochHead)(nil), "proto.TimestampedEpochHead")
 	proto1.RegisterType((*EpochHead)(nil), "proto.EpochHead")
+	proto1.RegisterType((*VRFRotation)(nil), "proto.VRFRotation")
+	proto1.RegisterType((*VRFMove)(nil), "proto.VRFMove")
 	proto1.RegisterType((*AuthorizationPolicy)(nil), "proto.AuthorizationPolicy")
+	proto1.RegisterType((*DomainAdminPolicy)(nil), "proto.DomainAdminPolicy")
 	proto1.RegisterType((*PublicKey)(nil), "proto.PublicKey")
 	proto1.RegisterType((*QuorumExpr)(nil), "proto.QuorumExpr")
+	proto1.RegisterType((*WeightedCandidate)(nil), "proto.WeightedCandidate")
 	proto1.RegisterType((*EmailProof)(nil), "proto.EmailProof")
+	proto1.RegisterType((*ExternalProof)(nil), "proto.ExternalProof")
+	proto1.RegisterType((*ClientCertProof)(nil), "proto.ClientCertProof")
+	proto1.RegisterType((*EmailChallengeRequest)(nil), "proto.EmailChallengeRequest")
+	proto1.RegisterType((*EmailChallengeResponse)(nil), "proto.EmailChallengeResponse")
+	proto1.RegisterType((*ListVerifiersRequest)(nil), "proto.ListVerifiersRequest")
+	proto1.RegisterType((*ListVerifiersResponse)(nil), "proto.ListVerifiersResponse")
+	proto1.RegisterType((*VerifierInfo)(nil), "proto.VerifierInfo")
+	proto1.RegisterEnum("proto.QuorumOp", QuorumOp_name, QuorumOp_value)
+	proto1.RegisterEnum("proto.VRFSuite", VRFSuite_name, VRFSuite_value)
+}
+func (x QuorumOp) String() string {
+	s, ok := QuorumOp_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (x VRFSuite) String() string {
+	s, ok := VRFSuite_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
 }
 func (this *LookupRequest) VerboseEqual(that interface{}) error {
 	if that == nil {
@@ -861,6 +1426,9 @@ func (this *LookupRequest) VerboseEqual(that interface{}) error {
 	if !this.QuorumRequirement.Equal(that1.QuorumRequirement) {
 		return fmt.Errorf("QuorumRequirement this(%v) Not Equal that(%v)", this.QuorumRequirement, that1.QuorumRequirement)
 	}
+	if this.AllowPartialRatification != that1.AllowPartialRatification {
+		return fmt.Errorf("AllowPartialRatification this(%v) Not Equal that(%v)", this.AllowPartialRatification, that1.AllowPartialRatification)
+	}
 	return nil
 }
 func (this *LookupRequest) Equal(that interface{}) bool {
@@ -897,6 +1465,9 @@ func (this *LookupRequest) Equal(that interface{}) bool {
 	if !this.QuorumRequirement.Equal(that1.QuorumRequirement) {
 		return false
 	}
+	if this.AllowPartialRatification != that1.AllowPartialRatification {
+		return false
+	}
 	return true
 }
 func (this *UpdateRequest) VerboseEqual(that interface{}) error {
@@ -1036,6 +1607,12 @@ func (this *LookupProof) VerboseEqual(that interface{}) error {
 	} else if !this.Profile.Equal(*that1.Profile) {
 		return fmt.Errorf("Profile this(%v) Not Equal that(%v)", this.Profile, that1.Profile)
 	}
+	if !this.PendingRecovery.Equal(that1.PendingRecovery) {
+		return fmt.Errorf("PendingRecovery this(%v) Not Equal that(%v)", this.PendingRecovery, that1.PendingRecovery)
+	}
+	if this.QuorumNotMet != that1.QuorumNotMet {
+		return fmt.Errorf("QuorumNotMet this(%v) Not Equal that(%v)", this.QuorumNotMet, that1.QuorumNotMet)
+	}
 	return nil
 }
 func (this *LookupProof) Equal(that interface{}) bool {
@@ -1097,6 +1674,12 @@ func (this *LookupProof) Equal(that interface{}) bool {
 	} else if !this.Profile.Equal(*that1.Profile) {
 		return false
 	}
+	if !this.PendingRecovery.Equal(that1.PendingRecovery) {
+		return false
+	}
+	if this.QuorumNotMet != that1.QuorumNotMet {
+		return false
+	}
 	return true
 }
 func (this *TreeProof) VerboseEqual(that interface{}) error {
@@ -1218,6 +1801,12 @@ func (this *Entry) VerboseEqual(that interface{}) error {
 	if !bytes.Equal(this.ProfileCommitment, that1.ProfileCommitment) {
 		return fmt.Errorf("ProfileCommitment this(%v) Not Equal that(%v)", this.ProfileCommitment, that1.ProfileCommitment)
 	}
+	if !this.RecoveryPolicy.Equal(that1.RecoveryPolicy) {
+		return fmt.Errorf("RecoveryPolicy this(%v) Not Equal that(%v)", this.RecoveryPolicy, that1.RecoveryPolicy)
+	}
+	if this.Deleted != that1.Deleted {
+		return fmt.Errorf("Deleted this(%v) Not Equal that(%v)", this.Deleted, that1.Deleted)
+	}
 	return nil
 }
 func (this *Entry) Equal(that interface{}) bool {
@@ -1257,9 +1846,15 @@ func (this *Entry) Equal(that interface{}) bool {
 	if !bytes.Equal(this.ProfileCommitment, that1.ProfileCommitment) {
 		return false
 	}
+	if !this.RecoveryPolicy.Equal(that1.RecoveryPolicy) {
+		return false
+	}
+	if this.Deleted != that1.Deleted {
+		return false
+	}
 	return true
 }
-func (this *SignedEntryUpdate) VerboseEqual(that interface{}) error {
+func (this *RecoveryPolicy) VerboseEqual(that interface{}) error {
 	if that == nil {
 		if this == nil {
 			return nil
@@ -1267,37 +1862,29 @@ func (this *SignedEntryUpdate) VerboseEqual(that interface{}) error {
 		return fmt.Errorf("that == nil && this != nil")
 	}
 
-	that1, ok := that.(*SignedEntryUpdate)
+	that1, ok := that.(*RecoveryPolicy)
 	if !ok {
-		that2, ok := that.(SignedEntryUpdate)
+		that2, ok := that.(RecoveryPolicy)
 		if ok {
 			that1 = &that2
 		} else {
-			return fmt.Errorf("that is not of type *SignedEntryUpdate")
+			return fmt.Errorf("that is not of type *RecoveryPolicy")
 		}
 	}
 	if that1 == nil {
 		if this == nil {
 			return nil
 		}
-		return fmt.Errorf("that is type *SignedEntryUpdate but is nil && this != nil")
+		return fmt.Errorf("that is type *RecoveryPolicy but is nil && this != nil")
 	} else if this == nil {
-		return fmt.Errorf("that is type *SignedEntryUpdate but is not nil && this == nil")
-	}
-	if !this.NewEntry.Equal(that1.NewEntry) {
-		return fmt.Errorf("NewEntry this(%v) Not Equal that(%v)", this.NewEntry, that1.NewEntry)
+		return fmt.Errorf("that is type *RecoveryPolicy but is not nil && this == nil")
 	}
-	if len(this.Signatures) != len(that1.Signatures) {
-		return fmt.Errorf("Signatures this(%v) Not Equal that(%v)", len(this.Signatures), len(that1.Signatures))
-	}
-	for i := range this.Signatures {
-		if !bytes.Equal(this.Signatures[i], that1.Signatures[i]) {
-			return fmt.Errorf("Signatures this[%v](%v) Not Equal that[%v](%v)", i, this.Signatures[i], i, that1.Signatures[i])
-		}
+	if this.DelaySeconds != that1.DelaySeconds {
+		return fmt.Errorf("DelaySeconds this(%v) Not Equal that(%v)", this.DelaySeconds, that1.DelaySeconds)
 	}
 	return nil
 }
-func (this *SignedEntryUpdate) Equal(that interface{}) bool {
+func (this *RecoveryPolicy) Equal(that interface{}) bool {
 	if that == nil {
 		if this == nil {
 			return true
@@ -1305,9 +1892,9 @@ func (this *SignedEntryUpdate) Equal(that interface{}) bool {
 		return false
 	}
 
-	that1, ok := that.(*SignedEntryUpdate)
+	that1, ok := that.(*RecoveryPolicy)
 	if !ok {
-		that2, ok := that.(SignedEntryUpdate)
+		that2, ok := that.(RecoveryPolicy)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1322,20 +1909,12 @@ func (this *SignedEntryUpdate) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.NewEntry.Equal(that1.NewEntry) {
-		return false
-	}
-	if len(this.Signatures) != len(that1.Signatures) {
+	if this.DelaySeconds != that1.DelaySeconds {
 		return false
 	}
-	for i := range this.Signatures {
-		if !bytes.Equal(this.Signatures[i], that1.Signatures[i]) {
-			return false
-		}
-	}
 	return true
 }
-func (this *Profile) VerboseEqual(that interface{}) error {
+func (this *PendingRecovery) VerboseEqual(that interface{}) error {
 	if that == nil {
 		if this == nil {
 			return nil
@@ -1343,37 +1922,32 @@ func (this *Profile) VerboseEqual(that interface{}) error {
 		return fmt.Errorf("that == nil && this != nil")
 	}
 
-	that1, ok := that.(*Profile)
+	that1, ok := that.(*PendingRecovery)
 	if !ok {
-		that2, ok := that.(Profile)
+		that2, ok := that.(PendingRecovery)
 		if ok {
 			that1 = &that2
 		} else {
-			return fmt.Errorf("that is not of type *Profile")
+			return fmt.Errorf("that is not of type *PendingRecovery")
 		}
 	}
 	if that1 == nil {
 		if this == nil {
 			return nil
 		}
-		return fmt.Errorf("that is type *Profile but is nil && this != nil")
+		return fmt.Errorf("that is type *PendingRecovery but is nil && this != nil")
 	} else if this == nil {
-		return fmt.Errorf("that is type *Profile but is not nil && this == nil")
-	}
-	if !bytes.Equal(this.Nonce, that1.Nonce) {
-		return fmt.Errorf("Nonce this(%v) Not Equal that(%v)", this.Nonce, that1.Nonce)
+		return fmt.Errorf("that is type *PendingRecovery but is not nil && this == nil")
 	}
-	if len(this.Keys) != len(that1.Keys) {
-		return fmt.Errorf("Keys this(%v) Not Equal that(%v)", len(this.Keys), len(that1.Keys))
+	if !this.Update.Equal(that1.Update) {
+		return fmt.Errorf("Update this(%v) Not Equal that(%v)", this.Update, that1.Update)
 	}
-	for i := range this.Keys {
-		if !bytes.Equal(this.Keys[i], that1.Keys[i]) {
-			return fmt.Errorf("Keys this[%v](%v) Not Equal that[%v](%v)", i, this.Keys[i], i, that1.Keys[i])
-		}
+	if !this.NotBefore.Equal(&that1.NotBefore) {
+		return fmt.Errorf("NotBefore this(%v) Not Equal that(%v)", this.NotBefore, that1.NotBefore)
 	}
 	return nil
 }
-func (this *Profile) Equal(that interface{}) bool {
+func (this *PendingRecovery) Equal(that interface{}) bool {
 	if that == nil {
 		if this == nil {
 			return true
@@ -1381,9 +1955,9 @@ func (this *Profile) Equal(that interface{}) bool {
 		return false
 	}
 
-	that1, ok := that.(*Profile)
+	that1, ok := that.(*PendingRecovery)
 	if !ok {
-		that2, ok := that.(Profile)
+		that2, ok := that.(PendingRecovery)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1398,20 +1972,15 @@ func (this *Profile) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !bytes.Equal(this.Nonce, that1.Nonce) {
+	if !this.Update.Equal(that1.Update) {
 		return false
 	}
-	if len(this.Keys) != len(that1.Keys) {
+	if !this.NotBefore.Equal(&that1.NotBefore) {
 		return false
 	}
-	for i := range this.Keys {
-		if !bytes.Equal(this.Keys[i], that1.Keys[i]) {
-			return false
-		}
-	}
 	return true
 }
-func (this *SignedEpochHead) VerboseEqual(that interface{}) error {
+func (this *RecoveryVeto) VerboseEqual(that interface{}) error {
 	if that == nil {
 		if this == nil {
 			return nil
@@ -1419,25 +1988,28 @@ func (this *SignedEpochHead) VerboseEqual(that interface{}) error {
 		return fmt.Errorf("that == nil && this != nil")
 	}
 
-	that1, ok := that.(*SignedEpochHead)
+	that1, ok := that.(*RecoveryVeto)
 	if !ok {
-		that2, ok := that.(SignedEpochHead)
+		that2, ok := that.(RecoveryVeto)
 		if ok {
 			that1 = &that2
 		} else {
-			return fmt.Errorf("that is not of type *SignedEpochHead")
+			return fmt.Errorf("that is not of type *RecoveryVeto")
 		}
 	}
 	if that1 == nil {
 		if this == nil {
 			return nil
 		}
-		return fmt.Errorf("that is type *SignedEpochHead but is nil && this != nil")
+		return fmt.Errorf("that is type *RecoveryVeto but is nil && this != nil")
 	} else if this == nil {
-		return fmt.Errorf("that is type *SignedEpochHead but is not nil && this == nil")
+		return fmt.Errorf("that is type *RecoveryVeto but is not nil && this == nil")
 	}
-	if !this.Head.Equal(that1.Head) {
-		return fmt.Errorf("Head this(%v) Not Equal that(%v)", this.Head, that1.Head)
+	if !bytes.Equal(this.Index, that1.Index) {
+		return fmt.Errorf("Index this(%v) Not Equal that(%v)", this.Index, that1.Index)
+	}
+	if !bytes.Equal(this.EntryHash, that1.EntryHash) {
+		return fmt.Errorf("EntryHash this(%v) Not Equal that(%v)", this.EntryHash, that1.EntryHash)
 	}
 	if len(this.Signatures) != len(that1.Signatures) {
 		return fmt.Errorf("Signatures this(%v) Not Equal that(%v)", len(this.Signatures), len(that1.Signatures))
@@ -1449,7 +2021,7 @@ func (this *SignedEpochHead) VerboseEqual(that interface{}) error {
 	}
 	return nil
 }
-func (this *SignedEpochHead) Equal(that interface{}) bool {
+func (this *RecoveryVeto) Equal(that interface{}) bool {
 	if that == nil {
 		if this == nil {
 			return true
@@ -1457,9 +2029,9 @@ func (this *SignedEpochHead) Equal(that interface{}) bool {
 		return false
 	}
 
-	that1, ok := that.(*SignedEpochHead)
+	that1, ok := that.(*RecoveryVeto)
 	if !ok {
-		that2, ok := that.(SignedEpochHead)
+		that2, ok := that.(RecoveryVeto)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1474,7 +2046,10 @@ func (this *SignedEpochHead) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.Head.Equal(that1.Head) {
+	if !bytes.Equal(this.Index, that1.Index) {
+		return false
+	}
+	if !bytes.Equal(this.EntryHash, that1.EntryHash) {
 		return false
 	}
 	if len(this.Signatures) != len(that1.Signatures) {
@@ -1487,7 +2062,7 @@ func (this *SignedEpochHead) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *TimestampedEpochHead) VerboseEqual(that interface{}) error {
+func (this *SignedEntryUpdate) VerboseEqual(that interface{}) error {
 	if that == nil {
 		if this == nil {
 			return nil
@@ -1495,32 +2070,37 @@ func (this *TimestampedEpochHead) VerboseEqual(that interface{}) error {
 		return fmt.Errorf("that == nil && this != nil")
 	}
 
-	that1, ok := that.(*TimestampedEpochHead)
+	that1, ok := that.(*SignedEntryUpdate)
 	if !ok {
-		that2, ok := that.(TimestampedEpochHead)
+		that2, ok := that.(SignedEntryUpdate)
 		if ok {
 			that1 = &that2
 		} else {
-			return fmt.Errorf("that is not of type *TimestampedEpochHead")
+			return fmt.Errorf("that is not of type *SignedEntryUpdate")
 		}
 	}
 	if that1 == nil {
 		if this == nil {
 			return nil
 		}
-		return fmt.Errorf("that is type *TimestampedEpochHead but is nil && this != nil")
+		return fmt.Errorf("that is type *SignedEntryUpdate but is nil && this != nil")
 	} else if this == nil {
-		return fmt.Errorf("that is type *TimestampedEpochHead but is not nil && this == nil")
+		return fmt.Errorf("that is type *SignedEntryUpdate but is not nil && this == nil")
 	}
-	if !this.Head.Equal(that1.Head) {
-		return fmt.Errorf("Head this(%v) Not Equal that(%v)", this.Head, that1.Head)
+	if !this.NewEntry.Equal(that1.NewEntry) {
+		return fmt.Errorf("NewEntry this(%v) Not Equal that(%v)", this.NewEntry, that1.NewEntry)
 	}
-	if !this.Timestamp.Equal(&that1.Timestamp) {
-		return fmt.Errorf("Timestamp this(%v) Not Equal that(%v)", this.Timestamp, that1.Timestamp)
+	if len(this.Signatures) != len(that1.Signatures) {
+		return fmt.Errorf("Signatures this(%v) Not Equal that(%v)", len(this.Signatures), len(that1.Signatures))
+	}
+	for i := range this.Signatures {
+		if !bytes.Equal(this.Signatures[i], that1.Signatures[i]) {
+			return fmt.Errorf("Signatures this[%v](%v) Not Equal that[%v](%v)", i, this.Signatures[i], i, that1.Signatures[i])
+		}
 	}
 	return nil
 }
-func (this *TimestampedEpochHead) Equal(that interface{}) bool {
+func (this *SignedEntryUpdate) Equal(that interface{}) bool {
 	if that == nil {
 		if this == nil {
 			return true
@@ -1528,9 +2108,9 @@ func (this *TimestampedEpochHead) Equal(that interface{}) bool {
 		return false
 	}
 
-	that1, ok := that.(*TimestampedEpochHead)
+	that1, ok := that.(*SignedEntryUpdate)
 	if !ok {
-		that2, ok := that.(TimestampedEpochHead)
+		that2, ok := that.(SignedEntryUpdate)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1545,15 +2125,20 @@ func (this *TimestampedEpochHead) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.Head.Equal(that1.Head) {
+	if !this.NewEntry.Equal(that1.NewEntry) {
 		return false
 	}
-	if !this.Timestamp.Equal(&that1.Timestamp) {
+	if len(this.Signatures) != len(that1.Signatures) {
 		return false
 	}
+	for i := range this.Signatures {
+		if !bytes.Equal(this.Signatures[i], that1.Signatures[i]) {
+			return false
+		}
+	}
 	return true
 }
-func (this *EpochHead) VerboseEqual(that interface{}) error {
+func (this *Profile) VerboseEqual(that interface{}) error {
 	if that == nil {
 		if this == nil {
 			return nil
@@ -1561,44 +2146,37 @@ func (this *EpochHead) VerboseEqual(that interface{}) error {
 		return fmt.Errorf("that == nil && this != nil")
 	}
 
-	that1, ok := that.(*EpochHead)
+	that1, ok := that.(*Profile)
 	if !ok {
-		that2, ok := that.(EpochHead)
+		that2, ok := that.(Profile)
 		if ok {
 			that1 = &that2
 		} else {
-			return fmt.Errorf("that is not of type *EpochHead")
+			return fmt.Errorf("that is not of type *Profile")
 		}
 	}
 	if that1 == nil {
 		if this == nil {
 			return nil
 		}
-		return fmt.Errorf("that is type *EpochHead but is nil && this != nil")
+		return fmt.Errorf("that is type *Profile but is nil && this != nil")
 	} else if this == nil {
-		return fmt.Errorf("that is type *EpochHead but is not nil && this == nil")
-	}
-	if this.Realm != that1.Realm {
-		return fmt.Errorf("Realm this(%v) Not Equal that(%v)", this.Realm, that1.Realm)
-	}
-	if this.Epoch != that1.Epoch {
-		return fmt.Errorf("Epoch this(%v) Not Equal that(%v)", this.Epoch, that1.Epoch)
-	}
-	if !bytes.Equal(this.RootHash, that1.RootHash) {
-		return fmt.Errorf("RootHash this(%v) Not Equal that(%v)", this.RootHash, that1.RootHash)
+		return fmt.Errorf("that is type *Profile but is not nil && this == nil")
 	}
-	if !this.IssueTime.Equal(&that1.IssueTime) {
-		return fmt.Errorf("IssueTime this(%v) Not Equal that(%v)", this.IssueTime, that1.IssueTime)
+	if !bytes.Equal(this.Nonce, that1.Nonce) {
+		return fmt.Errorf("Nonce this(%v) Not Equal that(%v)", this.Nonce, that1.Nonce)
 	}
-	if !bytes.Equal(this.PreviousSummaryHash, that1.PreviousSummaryHash) {
-		return fmt.Errorf("PreviousSummaryHash this(%v) Not Equal that(%v)", this.PreviousSummaryHash, that1.PreviousSummaryHash)
+	if len(this.Keys) != len(that1.Keys) {
+		return fmt.Errorf("Keys this(%v) Not Equal that(%v)", len(this.Keys), len(that1.Keys))
 	}
-	if !this.NextEpochPolicy.Equal(&that1.NextEpochPolicy) {
-		return fmt.Errorf("NextEpochPolicy this(%v) Not Equal that(%v)", this.NextEpochPolicy, that1.NextEpochPolicy)
+	for i := range this.Keys {
+		if !bytes.Equal(this.Keys[i], that1.Keys[i]) {
+			return fmt.Errorf("Keys this[%v](%v) Not Equal that[%v](%v)", i, this.Keys[i], i, that1.Keys[i])
+		}
 	}
 	return nil
 }
-func (this *EpochHead) Equal(that interface{}) bool {
+func (this *Profile) Equal(that interface{}) bool {
 	if that == nil {
 		if this == nil {
 			return true
@@ -1606,9 +2184,9 @@ func (this *EpochHead) Equal(that interface{}) bool {
 		return false
 	}
 
-	that1, ok := that.(*EpochHead)
+	that1, ok := that.(*Profile)
 	if !ok {
-		that2, ok := that.(EpochHead)
+		that2, ok := that.(Profile)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1623,27 +2201,20 @@ func (this *EpochHead) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Realm != that1.Realm {
-		return false
-	}
-	if this.Epoch != that1.Epoch {
-		return false
-	}
-	if !bytes.Equal(this.RootHash, that1.RootHash) {
-		return false
-	}
-	if !this.IssueTime.Equal(&that1.IssueTime) {
+	if !bytes.Equal(this.Nonce, that1.Nonce) {
 		return false
 	}
-	if !bytes.Equal(this.PreviousSummaryHash, that1.PreviousSummaryHash) {
+	if len(this.Keys) != len(that1.Keys) {
 		return false
 	}
-	if !this.NextEpochPolicy.Equal(&that1.NextEpochPolicy) {
-		return false
+	for i := range this.Keys {
+		if !bytes.Equal(this.Keys[i], that1.Keys[i]) {
+			return false
+		}
 	}
 	return true
 }
-func (this *AuthorizationPolicy) VerboseEqual(that interface{}) error {
+func (this *SignedEpochHead) VerboseEqual(that interface{}) error {
 	if that == nil {
 		if this == nil {
 			return nil
@@ -1651,117 +2222,111 @@ func (this *AuthorizationPolicy) VerboseEqual(that interface{}) error {
 		return fmt.Errorf("that == nil && this != nil")
 	}
 
-	that1, ok := that.(*AuthorizationPolicy)
+	that1, ok := that.(*SignedEpochHead)
 	if !ok {
-		that2, ok := that.(AuthorizationPolicy)
+		that2, ok := that.(SignedEpochHead)
 		if ok {
 			that1 = &that2
 		} else {
-			return fmt.Errorf("that is not of type *AuthorizationPolicy")
+			return fmt.Errorf("that is not of type *SignedEpochHead")
 		}
 	}
 	if that1 == nil {
 		if this == nil {
 			return nil
 		}
-		return fmt.Errorf("that is type *AuthorizationPolicy but is nil && this != nil")
+		return fmt.Errorf("that is type *SignedEpochHead but is nil && this != nil")
 	} else if this == nil {
-		return fmt.Errorf("that is type *AuthorizationPolicy but is not nil && this == nil")
+		return fmt.Errorf("that is type *SignedEpochHead but is not nil && this == nil")
 	}
-	if len(this.PublicKeys) != len(that1.PublicKeys) {
-		return fmt.Errorf("PublicKeys this(%v) Not Equal that(%v)", len(this.PublicKeys), len(that1.PublicKeys))
+	if !this.Head.Equal(that1.Head) {
+		return fmt.Errorf("Head this(%v) Not Equal that(%v)", this.Head, that1.Head)
 	}
-	for i := range this.PublicKeys {
-		if !this.PublicKeys[i].Equal(that1.PublicKeys[i]) {
-			return fmt.Errorf("PublicKeys this[%v](%v) Not Equal that[%v](%v)", i, this.PublicKeys[i], i, that1.PublicKeys[i])
-		}
+	if len(this.Signatures) != len(that1.Signatures) {
+		return fmt.Errorf("Signatures this(%v) Not Equal that(%v)", len(this.Signatures), len(that1.Signatures))
 	}
-	if that1.PolicyType == nil {
-		if this.PolicyType != nil {
-			return fmt.Errorf("this.PolicyType != nil && that1.PolicyType == nil")
+	for i := range this.Signatures {
+		if !bytes.Equal(this.Signatures[i], that1.Signatures[i]) {
+			return fmt.Errorf("Signatures this[%v](%v) Not Equal that[%v](%v)", i, this.Signatures[i], i, that1.Signatures[i])
 		}
-	} else if this.PolicyType == nil {
-		return fmt.Errorf("this.PolicyType == nil && that1.PolicyType != nil")
-	} else if err := this.PolicyType.VerboseEqual(that1.PolicyType); err != nil {
-		return err
 	}
 	return nil
 }
-func (this *AuthorizationPolicy_Quorum) VerboseEqual(that interface{}) error {
+func (this *SignedEpochHead) Equal(that interface{}) bool {
 	if that == nil {
 		if this == nil {
-			return nil
+			return true
 		}
-		return fmt.Errorf("that == nil && this != nil")
+		return false
 	}
 
-	that1, ok := that.(*AuthorizationPolicy_Quorum)
+	that1, ok := that.(*SignedEpochHead)
 	if !ok {
-		that2, ok := that.(AuthorizationPolicy_Quorum)
+		that2, ok := that.(SignedEpochHead)
 		if ok {
 			that1 = &that2
 		} else {
-			return fmt.Errorf("that is not of type *AuthorizationPolicy_Quorum")
+			return false
 		}
 	}
 	if that1 == nil {
 		if this == nil {
-			return nil
+			return true
 		}
-		return fmt.Errorf("that is type *AuthorizationPolicy_Quorum but is nil && this != nil")
+		return false
 	} else if this == nil {
-		return fmt.Errorf("that is type *AuthorizationPolicy_Quorum but is not nil && this == nil")
+		return false
 	}
-	if !this.Quorum.Equal(that1.Quorum) {
-		return fmt.Errorf("Quorum this(%v) Not Equal that(%v)", this.Quorum, that1.Quorum)
+	if !this.Head.Equal(that1.Head) {
+		return false
 	}
-	return nil
+	if len(this.Signatures) != len(that1.Signatures) {
+		return false
+	}
+	for i := range this.Signatures {
+		if !bytes.Equal(this.Signatures[i], that1.Signatures[i]) {
+			return false
+		}
+	}
+	return true
 }
-func (this *AuthorizationPolicy) Equal(that interface{}) bool {
+func (this *TimestampedEpochHead) VerboseEqual(that interface{}) error {
 	if that == nil {
 		if this == nil {
-			return true
+			return nil
 		}
-		return false
+		return fmt.Errorf("that == nil && this != nil")
 	}
 
-	that1, ok := that.(*AuthorizationPolicy)
+	that1, ok := that.(*TimestampedEpochHead)
 	if !ok {
-		that2, ok := that.(AuthorizationPolicy)
+		that2, ok := that.(TimestampedEpochHead)
 		if ok {
 			that1 = &that2
 		} else {
-			return false
+			return fmt.Errorf("that is not of type *TimestampedEpochHead")
 		}
 	}
 	if that1 == nil {
 		if this == nil {
-			return true
+			return nil
 		}
-		return false
+		return fmt.Errorf("that is type *TimestampedEpochHead but is nil && this != nil")
 	} else if this == nil {
-		return false
+		return fmt.Errorf("that is type *TimestampedEpochHead but is not nil && this == nil")
 	}
-	if len(this.PublicKeys) != len(that1.PublicKeys) {
-		return false
+	if !this.Head.Equal(that1.Head) {
+		return fmt.Errorf("Head this(%v) Not Equal that(%v)", this.Head, that1.Head)
 	}
-	for i := range this.PublicKeys {
-		if !this.PublicKeys[i].Equal(that1.PublicKeys[i]) {
-			return false
-		}
+	if !this.Timestamp.Equal(&that1.Timestamp) {
+		return fmt.Errorf("Timestamp this(%v) Not Equal that(%v)", this.Timestamp, that1.Timestamp)
 	}
-	if that1.PolicyType == nil {
-		if this.PolicyType != nil {
-			return false
-		}
-	} else if this.PolicyType == nil {
-		return false
-	} else if !this.PolicyType.Equal(that1.PolicyType) {
-		return false
+	if this.Refresh != that1.Refresh {
+		return fmt.Errorf("Refresh this(%v) Not Equal that(%v)", this.Refresh, that1.Refresh)
 	}
-	return true
+	return nil
 }
-func (this *AuthorizationPolicy_Quorum) Equal(that interface{}) bool {
+func (this *TimestampedEpochHead) Equal(that interface{}) bool {
 	if that == nil {
 		if this == nil {
 			return true
@@ -1769,9 +2334,9 @@ func (this *AuthorizationPolicy_Quorum) Equal(that interface{}) bool {
 		return false
 	}
 
-	that1, ok := that.(*AuthorizationPolicy_Quorum)
+	that1, ok := that.(*TimestampedEpochHead)
 	if !ok {
-		that2, ok := that.(AuthorizationPolicy_Quorum)
+		that2, ok := that.(TimestampedEpochHead)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1786,12 +2351,18 @@ func (this *AuthorizationPolicy_Quorum) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.Quorum.Equal(that1.Quorum) {
+	if !this.Head.Equal(that1.Head) {
+		return false
+	}
+	if !this.Timestamp.Equal(&that1.Timestamp) {
+		return false
+	}
+	if this.Refresh != that1.Refresh {
 		return false
 	}
 	return true
 }
-func (this *PublicKey) VerboseEqual(that interface{}) error {
+func (this *EpochHead) VerboseEqual(that interface{}) error {
 	if that == nil {
 		if this == nil {
 			return nil
@@ -1799,65 +2370,50 @@ func (this *PublicKey) VerboseEqual(that interface{}) error {
 		return fmt.Errorf("that == nil && this != nil")
 	}
 
-	that1, ok := that.(*PublicKey)
+	that1, ok := that.(*EpochHead)
 	if !ok {
-		that2, ok := that.(PublicKey)
+		that2, ok := that.(EpochHead)
 		if ok {
 			that1 = &that2
 		} else {
-			return fmt.Errorf("that is not of type *PublicKey")
+			return fmt.Errorf("that is not of type *EpochHead")
 		}
 	}
 	if that1 == nil {
 		if this == nil {
 			return nil
 		}
-		return fmt.Errorf("that is type *PublicKey but is nil && this != nil")
+		return fmt.Errorf("that is type *EpochHead but is nil && this != nil")
 	} else if this == nil {
-		return fmt.Errorf("that is type *PublicKey but is not nil && this == nil")
+		return fmt.Errorf("that is type *EpochHead but is not nil && this == nil")
 	}
-	if that1.PubkeyType == nil {
-		if this.PubkeyType != nil {
-			return fmt.Errorf("this.PubkeyType != nil && that1.PubkeyType == nil")
-		}
-	} else if this.PubkeyType == nil {
-		return fmt.Errorf("this.PubkeyType == nil && that1.PubkeyType != nil")
-	} else if err := this.PubkeyType.VerboseEqual(that1.PubkeyType); err != nil {
-		return err
+	if this.Realm != that1.Realm {
+		return fmt.Errorf("Realm this(%v) Not Equal that(%v)", this.Realm, that1.Realm)
 	}
-	return nil
-}
-func (this *PublicKey_Ed25519) VerboseEqual(that interface{}) error {
-	if that == nil {
-		if this == nil {
-			return nil
-		}
-		return fmt.Errorf("that == nil && this != nil")
+	if this.Epoch != that1.Epoch {
+		return fmt.Errorf("Epoch this(%v) Not Equal that(%v)", this.Epoch, that1.Epoch)
 	}
-
-	that1, ok := that.(*PublicKey_Ed25519)
-	if !ok {
-		that2, ok := that.(PublicKey_Ed25519)
-		if ok {
-			that1 = &that2
-		} else {
-			return fmt.Errorf("that is not of type *PublicKey_Ed25519")
-		}
+	if !bytes.Equal(this.RootHash, that1.RootHash) {
+		return fmt.Errorf("RootHash this(%v) Not Equal that(%v)", this.RootHash, that1.RootHash)
 	}
-	if that1 == nil {
-		if this == nil {
-			return nil
-		}
-		return fmt.Errorf("that is type *PublicKey_Ed25519 but is nil && this != nil")
-	} else if this == nil {
-		return fmt.Errorf("that is type *PublicKey_Ed25519 but is not nil && this == nil")
+	if !this.IssueTime.Equal(&that1.IssueTime) {
+		return fmt.Errorf("IssueTime this(%v) Not Equal that(%v)", this.IssueTime, that1.IssueTime)
 	}
-	if !bytes.Equal(this.Ed25519, that1.Ed25519) {
-		return fmt.Errorf("Ed25519 this(%v) Not Equal that(%v)", this.Ed25519, that1.Ed25519)
+	if !bytes.Equal(this.PreviousSummaryHash, that1.PreviousSummaryHash) {
+		return fmt.Errorf("PreviousSummaryHash this(%v) Not Equal that(%v)", this.PreviousSummaryHash, that1.PreviousSummaryHash)
+	}
+	if !this.NextEpochPolicy.Equal(&that1.NextEpochPolicy) {
+		return fmt.Errorf("NextEpochPolicy this(%v) Not Equal that(%v)", this.NextEpochPolicy, that1.NextEpochPolicy)
+	}
+	if !bytes.Equal(this.VRFPublic, that1.VRFPublic) {
+		return fmt.Errorf("VRFPublic this(%v) Not Equal that(%v)", this.VRFPublic, that1.VRFPublic)
+	}
+	if !this.VRFRotationTime.Equal(that1.VRFRotationTime) {
+		return fmt.Errorf("VRFRotationTime this(%v) Not Equal that(%v)", this.VRFRotationTime, that1.VRFRotationTime)
 	}
 	return nil
 }
-func (this *PublicKey) Equal(that interface{}) bool {
+func (this *EpochHead) Equal(that interface{}) bool {
 	if that == nil {
 		if this == nil {
 			return true
@@ -1865,9 +2421,9 @@ func (this *PublicKey) Equal(that interface{}) bool {
 		return false
 	}
 
-	that1, ok := that.(*PublicKey)
+	that1, ok := that.(*EpochHead)
 	if !ok {
-		that2, ok := that.(PublicKey)
+		that2, ok := that.(EpochHead)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1882,48 +2438,33 @@ func (this *PublicKey) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if that1.PubkeyType == nil {
-		if this.PubkeyType != nil {
-			return false
-		}
-	} else if this.PubkeyType == nil {
+	if this.Realm != that1.Realm {
 		return false
-	} else if !this.PubkeyType.Equal(that1.PubkeyType) {
+	}
+	if this.Epoch != that1.Epoch {
 		return false
 	}
-	return true
-}
-func (this *PublicKey_Ed25519) Equal(that interface{}) bool {
-	if that == nil {
-		if this == nil {
-			return true
-		}
+	if !bytes.Equal(this.RootHash, that1.RootHash) {
 		return false
 	}
-
-	that1, ok := that.(*PublicKey_Ed25519)
-	if !ok {
-		that2, ok := that.(PublicKey_Ed25519)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+	if !this.IssueTime.Equal(&that1.IssueTime) {
+		return false
 	}
-	if that1 == nil {
-		if this == nil {
-			return true
-		}
+	if !bytes.Equal(this.PreviousSummaryHash, that1.PreviousSummaryHash) {
 		return false
-	} else if this == nil {
+	}
+	if !this.NextEpochPolicy.Equal(&that1.NextEpochPolicy) {
 		return false
 	}
-	if !bytes.Equal(this.Ed25519, that1.Ed25519) {
+	if !bytes.Equal(this.VRFPublic, that1.VRFPublic) {
+		return false
+	}
+	if !this.VRFRotationTime.Equal(that1.VRFRotationTime) {
 		return false
 	}
 	return true
 }
-func (this *QuorumExpr) VerboseEqual(that interface{}) error {
+func (this *VRFRotation) VerboseEqual(that interface{}) error {
 	if that == nil {
 		if this == nil {
 			return nil
@@ -1931,45 +2472,40 @@ func (this *QuorumExpr) VerboseEqual(that interface{}) error {
 		return fmt.Errorf("that == nil && this != nil")
 	}
 
-	that1, ok := that.(*QuorumExpr)
+	that1, ok := that.(*VRFRotation)
 	if !ok {
-		that2, ok := that.(QuorumExpr)
+		that2, ok := that.(VRFRotation)
 		if ok {
 			that1 = &that2
 		} else {
-			return fmt.Errorf("that is not of type *QuorumExpr")
+			return fmt.Errorf("that is not of type *VRFRotation")
 		}
 	}
 	if that1 == nil {
 		if this == nil {
 			return nil
 		}
-		return fmt.Errorf("that is type *QuorumExpr but is nil && this != nil")
+		return fmt.Errorf("that is type *VRFRotation but is nil && this != nil")
 	} else if this == nil {
-		return fmt.Errorf("that is type *QuorumExpr but is not nil && this == nil")
+		return fmt.Errorf("that is type *VRFRotation but is not nil && this == nil")
 	}
-	if this.Threshold != that1.Threshold {
-		return fmt.Errorf("Threshold this(%v) Not Equal that(%v)", this.Threshold, that1.Threshold)
+	if !bytes.Equal(this.VRFPublic, that1.VRFPublic) {
+		return fmt.Errorf("VRFPublic this(%v) Not Equal that(%v)", this.VRFPublic, that1.VRFPublic)
 	}
-	if len(this.Candidates) != len(that1.Candidates) {
-		return fmt.Errorf("Candidates this(%v) Not Equal that(%v)", len(this.Candidates), len(that1.Candidates))
+	if len(this.Moves) != len(that1.Moves) {
+		return fmt.Errorf("Moves this(%v) Not Equal that(%v)", len(this.Moves), len(that1.Moves))
 	}
-	for i := range this.Candidates {
-		if this.Candidates[i] != that1.Candidates[i] {
-			return fmt.Errorf("Candidates this[%v](%v) Not Equal that[%v](%v)", i, this.Candidates[i], i, that1.Candidates[i])
+	for i := range this.Moves {
+		if !this.Moves[i].Equal(that1.Moves[i]) {
+			return fmt.Errorf("Moves this[%v](%v) Not Equal that[%v](%v)", i, this.Moves[i], i, that1.Moves[i])
 		}
 	}
-	if len(this.Subexpressions) != len(that1.Subexpressions) {
-		return fmt.Errorf("Subexpressions this(%v) Not Equal that(%v)", len(this.Subexpressions), len(that1.Subexpressions))
-	}
-	for i := range this.Subexpressions {
-		if !this.Subexpressions[i].Equal(that1.Subexpressions[i]) {
-			return fmt.Errorf("Subexpressions this[%v](%v) Not Equal that[%v](%v)", i, this.Subexpressions[i], i, that1.Subexpressions[i])
-		}
+	if this.More != that1.More {
+		return fmt.Errorf("More this(%v) Not Equal that(%v)", this.More, that1.More)
 	}
 	return nil
 }
-func (this *QuorumExpr) Equal(that interface{}) bool {
+func (this *VRFRotation) Equal(that interface{}) bool {
 	if that == nil {
 		if this == nil {
 			return true
@@ -1977,9 +2513,9 @@ func (this *QuorumExpr) Equal(that interface{}) bool {
 		return false
 	}
 
-	that1, ok := that.(*QuorumExpr)
+	that1, ok := that.(*VRFRotation)
 	if !ok {
-		that2, ok := that.(QuorumExpr)
+		that2, ok := that.(VRFRotation)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1994,28 +2530,23 @@ func (this *QuorumExpr) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Threshold != that1.Threshold {
+	if !bytes.Equal(this.VRFPublic, that1.VRFPublic) {
 		return false
 	}
-	if len(this.Candidates) != len(that1.Candidates) {
+	if len(this.Moves) != len(that1.Moves) {
 		return false
 	}
-	for i := range this.Candidates {
-		if this.Candidates[i] != that1.Candidates[i] {
+	for i := range this.Moves {
+		if !this.Moves[i].Equal(that1.Moves[i]) {
 			return false
 		}
 	}
-	if len(this.Subexpressions) != len(that1.Subexpressions) {
+	if this.More != that1.More {
 		return false
 	}
-	for i := range this.Subexpressions {
-		if !this.Subexpressions[i].Equal(that1.Subexpressions[i]) {
-			return false
-		}
-	}
 	return true
 }
-func (this *EmailProof) VerboseEqual(that interface{}) error {
+func (this *VRFMove) VerboseEqual(that interface{}) error {
 	if that == nil {
 		if this == nil {
 			return nil
@@ -2023,65 +2554,83 @@ func (this *EmailProof) VerboseEqual(that interface{}) error {
 		return fmt.Errorf("that == nil && this != nil")
 	}
 
-	that1, ok := that.(*EmailProof)
+	that1, ok := that.(*VRFMove)
 	if !ok {
-		that2, ok := that.(EmailProof)
+		that2, ok := that.(VRFMove)
 		if ok {
 			that1 = &that2
 		} else {
-			return fmt.Errorf("that is not of type *EmailProof")
+			return fmt.Errorf("that is not of type *VRFMove")
 		}
 	}
 	if that1 == nil {
 		if this == nil {
 			return nil
 		}
-		return fmt.Errorf("that is type *EmailProof but is nil && this != nil")
+		return fmt.Errorf("that is type *VRFMove but is nil && this != nil")
 	} else if this == nil {
-		return fmt.Errorf("that is type *EmailProof but is not nil && this == nil")
+		return fmt.Errorf("that is type *VRFMove but is not nil && this == nil")
 	}
-	if that1.ProofType == nil {
-		if this.ProofType != nil {
-			return fmt.Errorf("this.ProofType != nil && that1.ProofType == nil")
-		}
-	} else if this.ProofType == nil {
-		return fmt.Errorf("this.ProofType == nil && that1.ProofType != nil")
-	} else if err := this.ProofType.VerboseEqual(that1.ProofType); err != nil {
-		return err
+	if this.UserId != that1.UserId {
+		return fmt.Errorf("UserId this(%v) Not Equal that(%v)", this.UserId, that1.UserId)
+	}
+	if !bytes.Equal(this.OldIndex, that1.OldIndex) {
+		return fmt.Errorf("OldIndex this(%v) Not Equal that(%v)", this.OldIndex, that1.OldIndex)
+	}
+	if !bytes.Equal(this.OldIndexProof, that1.OldIndexProof) {
+		return fmt.Errorf("OldIndexProof this(%v) Not Equal that(%v)", this.OldIndexProof, that1.OldIndexProof)
+	}
+	if !bytes.Equal(this.NewIndex, that1.NewIndex) {
+		return fmt.Errorf("NewIndex this(%v) Not Equal that(%v)", this.NewIndex, that1.NewIndex)
+	}
+	if !bytes.Equal(this.NewIndexProof, that1.NewIndexProof) {
+		return fmt.Errorf("NewIndexProof this(%v) Not Equal that(%v)", this.NewIndexProof, that1.NewIndexProof)
 	}
 	return nil
 }
-func (this *EmailProof_DKIMProof) VerboseEqual(that interface{}) error {
+func (this *VRFMove) Equal(that interface{}) bool {
 	if that == nil {
 		if this == nil {
-			return nil
+			return true
 		}
-		return fmt.Errorf("that == nil && this != nil")
+		return false
 	}
 
-	that1, ok := that.(*EmailProof_DKIMProof)
+	that1, ok := that.(*VRFMove)
 	if !ok {
-		that2, ok := that.(EmailProof_DKIMProof)
+		that2, ok := that.(VRFMove)
 		if ok {
 			that1 = &that2
 		} else {
-			return fmt.Errorf("that is not of type *EmailProof_DKIMProof")
+			return false
 		}
 	}
 	if that1 == nil {
 		if this == nil {
-			return nil
+			return true
 		}
-		return fmt.Errorf("that is type *EmailProof_DKIMProof but is nil && this != nil")
+		return false
 	} else if this == nil {
-		return fmt.Errorf("that is type *EmailProof_DKIMProof but is not nil && this == nil")
+		return false
 	}
-	if !bytes.Equal(this.DKIMProof, that1.DKIMProof) {
-		return fmt.Errorf("DKIMProof this(%v) Not Equal that(%v)", this.DKIMProof, that1.DKIMProof)
+	if this.UserId != that1.UserId {
+		return false
 	}
-	return nil
+	if !bytes.Equal(this.OldIndex, that1.OldIndex) {
+		return false
+	}
+	if !bytes.Equal(this.OldIndexProof, that1.OldIndexProof) {
+		return false
+	}
+	if !bytes.Equal(this.NewIndex, that1.NewIndex) {
+		return false
+	}
+	if !bytes.Equal(this.NewIndexProof, that1.NewIndexProof) {
+		return false
+	}
+	return true
 }
-func (this *EmailProof_OIDCToken) VerboseEqual(that interface{}) error {
+func (this *AuthorizationPolicy) VerboseEqual(that interface{}) error {
 	if that == nil {
 		if this == nil {
 			return nil
@@ -2089,29 +2638,43 @@ func (this *EmailProof_OIDCToken) VerboseEqual(that interface{}) error {
 		return fmt.Errorf("that == nil && this != nil")
 	}
 
-	that1, ok := that.(*EmailProof_OIDCToken)
+	that1, ok := that.(*AuthorizationPolicy)
 	if !ok {
-		that2, ok := that.(EmailProof_OIDCToken)
+		that2, ok := that.(AuthorizationPolicy)
 		if ok {
 			that1 = &that2
 		} else {
-			return fmt.Errorf("that is not of type *EmailProof_OIDCToken")
+			return fmt.Errorf("that is not of type *AuthorizationPolicy")
 		}
 	}
 	if that1 == nil {
 		if this == nil {
 			return nil
 		}
-		return fmt.Errorf("that is type *EmailProof_OIDCToken but is nil && this != nil")
+		return fmt.Errorf("that is type *AuthorizationPolicy but is nil && this != nil")
 	} else if this == nil {
-		return fmt.Errorf("that is type *EmailProof_OIDCToken but is not nil && this == nil")
+		return fmt.Errorf("that is type *AuthorizationPolicy but is not nil && this == nil")
 	}
-	if this.OIDCToken != that1.OIDCToken {
-		return fmt.Errorf("OIDCToken this(%v) Not Equal that(%v)", this.OIDCToken, that1.OIDCToken)
+	if len(this.PublicKeys) != len(that1.PublicKeys) {
+		return fmt.Errorf("PublicKeys this(%v) Not Equal that(%v)", len(this.PublicKeys), len(that1.PublicKeys))
+	}
+	for i := range this.PublicKeys {
+		if !this.PublicKeys[i].Equal(that1.PublicKeys[i]) {
+			return fmt.Errorf("PublicKeys this[%v](%v) Not Equal that[%v](%v)", i, this.PublicKeys[i], i, that1.PublicKeys[i])
+		}
+	}
+	if that1.PolicyType == nil {
+		if this.PolicyType != nil {
+			return fmt.Errorf("this.PolicyType != nil && that1.PolicyType == nil")
+		}
+	} else if this.PolicyType == nil {
+		return fmt.Errorf("this.PolicyType == nil && that1.PolicyType != nil")
+	} else if err := this.PolicyType.VerboseEqual(that1.PolicyType); err != nil {
+		return err
 	}
 	return nil
 }
-func (this *EmailProof_SAMLResponse) VerboseEqual(that interface{}) error {
+func (this *AuthorizationPolicy_Quorum) VerboseEqual(that interface{}) error {
 	if that == nil {
 		if this == nil {
 			return nil
@@ -2119,29 +2682,29 @@ func (this *EmailProof_SAMLResponse) VerboseEqual(that interface{}) error {
 		return fmt.Errorf("that == nil && this != nil")
 	}
 
-	that1, ok := that.(*EmailProof_SAMLResponse)
+	that1, ok := that.(*AuthorizationPolicy_Quorum)
 	if !ok {
-		that2, ok := that.(EmailProof_SAMLResponse)
+		that2, ok := that.(AuthorizationPolicy_Quorum)
 		if ok {
 			that1 = &that2
 		} else {
-			return fmt.Errorf("that is not of type *EmailProof_SAMLResponse")
+			return fmt.Errorf("that is not of type *AuthorizationPolicy_Quorum")
 		}
 	}
 	if that1 == nil {
 		if this == nil {
 			return nil
 		}
-		return fmt.Errorf("that is type *EmailProof_SAMLResponse but is nil && this != nil")
+		return fmt.Errorf("that is type *AuthorizationPolicy_Quorum but is nil && this != nil")
 	} else if this == nil {
-		return fmt.Errorf("that is type *EmailProof_SAMLResponse but is not nil && this == nil")
+		return fmt.Errorf("that is type *AuthorizationPolicy_Quorum but is not nil && this == nil")
 	}
-	if this.SAMLResponse != that1.SAMLResponse {
-		return fmt.Errorf("SAMLResponse this(%v) Not Equal that(%v)", this.SAMLResponse, that1.SAMLResponse)
+	if !this.Quorum.Equal(that1.Quorum) {
+		return fmt.Errorf("Quorum this(%v) Not Equal that(%v)", this.Quorum, that1.Quorum)
 	}
 	return nil
 }
-func (this *EmailProof) Equal(that interface{}) bool {
+func (this *AuthorizationPolicy) Equal(that interface{}) bool {
 	if that == nil {
 		if this == nil {
 			return true
@@ -2149,9 +2712,9 @@ func (this *EmailProof) Equal(that interface{}) bool {
 		return false
 	}
 
-	that1, ok := that.(*EmailProof)
+	that1, ok := that.(*AuthorizationPolicy)
 	if !ok {
-		that2, ok := that.(EmailProof)
+		that2, ok := that.(AuthorizationPolicy)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2166,18 +2729,26 @@ func (this *EmailProof) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if that1.ProofType == nil {
-		if this.ProofType != nil {
+	if len(this.PublicKeys) != len(that1.PublicKeys) {
+		return false
+	}
+	for i := range this.PublicKeys {
+		if !this.PublicKeys[i].Equal(that1.PublicKeys[i]) {
 			return false
 		}
-	} else if this.ProofType == nil {
+	}
+	if that1.PolicyType == nil {
+		if this.PolicyType != nil {
+			return false
+		}
+	} else if this.PolicyType == nil {
 		return false
-	} else if !this.ProofType.Equal(that1.ProofType) {
+	} else if !this.PolicyType.Equal(that1.PolicyType) {
 		return false
 	}
 	return true
 }
-func (this *EmailProof_DKIMProof) Equal(that interface{}) bool {
+func (this *AuthorizationPolicy_Quorum) Equal(that interface{}) bool {
 	if that == nil {
 		if this == nil {
 			return true
@@ -2185,9 +2756,9 @@ func (this *EmailProof_DKIMProof) Equal(that interface{}) bool {
 		return false
 	}
 
-	that1, ok := that.(*EmailProof_DKIMProof)
+	that1, ok := that.(*AuthorizationPolicy_Quorum)
 	if !ok {
-		that2, ok := that.(EmailProof_DKIMProof)
+		that2, ok := that.(AuthorizationPolicy_Quorum)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2202,42 +2773,50 @@ func (this *EmailProof_DKIMProof) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !bytes.Equal(this.DKIMProof, that1.DKIMProof) {
+	if !this.Quorum.Equal(that1.Quorum) {
 		return false
 	}
 	return true
 }
-func (this *EmailProof_OIDCToken) Equal(that interface{}) bool {
+func (this *DomainAdminPolicy) VerboseEqual(that interface{}) error {
 	if that == nil {
 		if this == nil {
-			return true
+			return nil
 		}
-		return false
+		return fmt.Errorf("that == nil && this != nil")
 	}
 
-	that1, ok := that.(*EmailProof_OIDCToken)
+	that1, ok := that.(*DomainAdminPolicy)
 	if !ok {
-		that2, ok := that.(EmailProof_OIDCToken)
+		that2, ok := that.(DomainAdminPolicy)
 		if ok {
 			that1 = &that2
 		} else {
-			return false
+			return fmt.Errorf("that is not of type *DomainAdminPolicy")
 		}
 	}
 	if that1 == nil {
 		if this == nil {
-			return true
+			return nil
 		}
-		return false
+		return fmt.Errorf("that is type *DomainAdminPolicy but is nil && this != nil")
 	} else if this == nil {
-		return false
+		return fmt.Errorf("that is type *DomainAdminPolicy but is not nil && this == nil")
 	}
-	if this.OIDCToken != that1.OIDCToken {
-		return false
+	if len(this.Domains) != len(that1.Domains) {
+		return fmt.Errorf("Domains this(%v) Not Equal that(%v)", len(this.Domains), len(that1.Domains))
 	}
-	return true
+	for i := range this.Domains {
+		if this.Domains[i] != that1.Domains[i] {
+			return fmt.Errorf("Domains this[%v](%v) Not Equal that[%v](%v)", i, this.Domains[i], i, that1.Domains[i])
+		}
+	}
+	if !this.Policy.Equal(that1.Policy) {
+		return fmt.Errorf("Policy this(%v) Not Equal that(%v)", this.Policy, that1.Policy)
+	}
+	return nil
 }
-func (this *EmailProof_SAMLResponse) Equal(that interface{}) bool {
+func (this *DomainAdminPolicy) Equal(that interface{}) bool {
 	if that == nil {
 		if this == nil {
 			return true
@@ -2245,9 +2824,9 @@ func (this *EmailProof_SAMLResponse) Equal(that interface{}) bool {
 		return false
 	}
 
-	that1, ok := that.(*EmailProof_SAMLResponse)
+	that1, ok := that.(*DomainAdminPolicy)
 	if !ok {
-		that2, ok := that.(EmailProof_SAMLResponse)
+		that2, ok := that.(DomainAdminPolicy)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2262,2162 +2841,7506 @@ func (this *EmailProof_SAMLResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.SAMLResponse != that1.SAMLResponse {
+	if len(this.Domains) != len(that1.Domains) {
 		return false
 	}
-	return true
-}
-func (this *LookupRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	for i := range this.Domains {
+		if this.Domains[i] != that1.Domains[i] {
+			return false
+		}
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&proto.LookupRequest{")
-	s = append(s, "Epoch: "+fmt.Sprintf("%#v", this.Epoch)+",\n")
-	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
-	if this.QuorumRequirement != nil {
-		s = append(s, "QuorumRequirement: "+fmt.Sprintf("%#v", this.QuorumRequirement)+",\n")
+	if !this.Policy.Equal(that1.Policy) {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *UpdateRequest) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *PublicKey) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
 	}
-	s := make([]string, 0, 8)
-	s = append(s, "&proto.UpdateRequest{")
-	if this.Update != nil {
-		s = append(s, "Update: "+fmt.Sprintf("%#v", this.Update)+",\n")
+
+	that1, ok := that.(*PublicKey)
+	if !ok {
+		that2, ok := that.(PublicKey)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *PublicKey")
+		}
 	}
-	s = append(s, "Profile: "+strings.Replace(this.Profile.GoString(), `&`, ``, 1)+",\n")
-	if this.LookupParameters != nil {
-		s = append(s, "LookupParameters: "+fmt.Sprintf("%#v", this.LookupParameters)+",\n")
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *PublicKey but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *PublicKey but is not nil && this == nil")
 	}
-	if this.EmailProof != nil {
-		s = append(s, "EmailProof: "+fmt.Sprintf("%#v", this.EmailProof)+",\n")
+	if that1.PubkeyType == nil {
+		if this.PubkeyType != nil {
+			return fmt.Errorf("this.PubkeyType != nil && that1.PubkeyType == nil")
+		}
+	} else if this.PubkeyType == nil {
+		return fmt.Errorf("this.PubkeyType == nil && that1.PubkeyType != nil")
+	} else if err := this.PubkeyType.VerboseEqual(that1.PubkeyType); err != nil {
+		return err
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return nil
 }
-func (this *LookupProof) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 11)
-	s = append(s, "&proto.LookupProof{")
-	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
-	s = append(s, "Index: "+fmt.Sprintf("%#v", this.Index)+",\n")
-	s = append(s, "IndexProof: "+fmt.Sprintf("%#v", this.IndexProof)+",\n")
-	if this.Ratifications != nil {
-		s = append(s, "Ratifications: "+fmt.Sprintf("%#v", this.Ratifications)+",\n")
-	}
-	if this.TreeProof != nil {
-		s = append(s, "TreeProof: "+fmt.Sprintf("%#v", this.TreeProof)+",\n")
-	}
-	if this.Entry != nil {
-		s = append(s, "Entry: "+fmt.Sprintf("%#v", this.Entry)+",\n")
-	}
-	if this.Profile != nil {
-		s = append(s, "Profile: "+fmt.Sprintf("%#v", this.Profile)+",\n")
+func (this *PublicKey_Ed25519) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *TreeProof) GoString() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*PublicKey_Ed25519)
+	if !ok {
+		that2, ok := that.(PublicKey_Ed25519)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *PublicKey_Ed25519")
+		}
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&proto.TreeProof{")
-	s = append(s, "Neighbors: "+fmt.Sprintf("%#v", this.Neighbors)+",\n")
-	s = append(s, "ExistingIndex: "+fmt.Sprintf("%#v", this.ExistingIndex)+",\n")
-	s = append(s, "ExistingEntryHash: "+fmt.Sprintf("%#v", this.ExistingEntryHash)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *Entry) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *PublicKey_Ed25519 but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *PublicKey_Ed25519 but is not nil && this == nil")
 	}
-	s := make([]string, 0, 8)
-	s = append(s, "&proto.Entry{")
-	s = append(s, "Index: "+fmt.Sprintf("%#v", this.Index)+",\n")
-	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
-	if this.UpdatePolicy != nil {
-		s = append(s, "UpdatePolicy: "+fmt.Sprintf("%#v", this.UpdatePolicy)+",\n")
+	if !bytes.Equal(this.Ed25519, that1.Ed25519) {
+		return fmt.Errorf("Ed25519 this(%v) Not Equal that(%v)", this.Ed25519, that1.Ed25519)
 	}
-	s = append(s, "ProfileCommitment: "+fmt.Sprintf("%#v", this.ProfileCommitment)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return nil
 }
-func (this *SignedEntryUpdate) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *PublicKey_ECDSAP256SHA256) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&proto.SignedEntryUpdate{")
-	s = append(s, "NewEntry: "+strings.Replace(this.NewEntry.GoString(), `&`, ``, 1)+",\n")
-	keysForSignatures := make([]uint64, 0, len(this.Signatures))
-	for k, _ := range this.Signatures {
-		keysForSignatures = append(keysForSignatures, k)
+
+	that1, ok := that.(*PublicKey_ECDSAP256SHA256)
+	if !ok {
+		that2, ok := that.(PublicKey_ECDSAP256SHA256)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *PublicKey_ECDSAP256SHA256")
+		}
 	}
-	github_com_maditya_protobuf_sortkeys.Uint64s(keysForSignatures)
-	mapStringForSignatures := "map[uint64][]byte{"
-	for _, k := range keysForSignatures {
-		mapStringForSignatures += fmt.Sprintf("%#v: %#v,", k, this.Signatures[k])
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *PublicKey_ECDSAP256SHA256 but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *PublicKey_ECDSAP256SHA256 but is not nil && this == nil")
 	}
-	mapStringForSignatures += "}"
-	if this.Signatures != nil {
-		s = append(s, "Signatures: "+mapStringForSignatures+",\n")
+	if !bytes.Equal(this.ECDSAP256SHA256, that1.ECDSAP256SHA256) {
+		return fmt.Errorf("ECDSAP256SHA256 this(%v) Not Equal that(%v)", this.ECDSAP256SHA256, that1.ECDSAP256SHA256)
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return nil
 }
-func (this *Profile) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *PublicKey_RSAPSSSHA256) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&proto.Profile{")
-	s = append(s, "Nonce: "+fmt.Sprintf("%#v", this.Nonce)+",\n")
-	keysForKeys := make([]string, 0, len(this.Keys))
-	for k, _ := range this.Keys {
-		keysForKeys = append(keysForKeys, k)
+
+	that1, ok := that.(*PublicKey_RSAPSSSHA256)
+	if !ok {
+		that2, ok := that.(PublicKey_RSAPSSSHA256)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *PublicKey_RSAPSSSHA256")
+		}
 	}
-	github_com_maditya_protobuf_sortkeys.Strings(keysForKeys)
-	mapStringForKeys := "map[string][]byte{"
-	for _, k := range keysForKeys {
-		mapStringForKeys += fmt.Sprintf("%#v: %#v,", k, this.Keys[k])
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *PublicKey_RSAPSSSHA256 but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *PublicKey_RSAPSSSHA256 but is not nil && this == nil")
 	}
-	mapStringForKeys += "}"
-	if this.Keys != nil {
-		s = append(s, "Keys: "+mapStringForKeys+",\n")
+	if !bytes.Equal(this.RSAPSSSHA256, that1.RSAPSSSHA256) {
+		return fmt.Errorf("RSAPSSSHA256 this(%v) Not Equal that(%v)", this.RSAPSSSHA256, that1.RSAPSSSHA256)
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return nil
 }
-func (this *SignedEpochHead) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *PublicKey) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&proto.SignedEpochHead{")
-	s = append(s, "Head: "+strings.Replace(this.Head.GoString(), `&`, ``, 1)+",\n")
-	keysForSignatures := make([]uint64, 0, len(this.Signatures))
-	for k, _ := range this.Signatures {
-		keysForSignatures = append(keysForSignatures, k)
+
+	that1, ok := that.(*PublicKey)
+	if !ok {
+		that2, ok := that.(PublicKey)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	github_com_maditya_protobuf_sortkeys.Uint64s(keysForSignatures)
-	mapStringForSignatures := "map[uint64][]byte{"
-	for _, k := range keysForSignatures {
-		mapStringForSignatures += fmt.Sprintf("%#v: %#v,", k, this.Signatures[k])
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
 	}
-	mapStringForSignatures += "}"
-	if this.Signatures != nil {
-		s = append(s, "Signatures: "+mapStringForSignatures+",\n")
+	if that1.PubkeyType == nil {
+		if this.PubkeyType != nil {
+			return false
+		}
+	} else if this.PubkeyType == nil {
+		return false
+	} else if !this.PubkeyType.Equal(that1.PubkeyType) {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *TimestampedEpochHead) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *PublicKey_Ed25519) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&proto.TimestampedEpochHead{")
-	s = append(s, "Head: "+strings.Replace(this.Head.GoString(), `&`, ``, 1)+",\n")
-	s = append(s, "Timestamp: "+strings.Replace(this.Timestamp.GoString(), `&`, ``, 1)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *EpochHead) GoString() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*PublicKey_Ed25519)
+	if !ok {
+		that2, ok := that.(PublicKey_Ed25519)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := make([]string, 0, 10)
-	s = append(s, "&proto.EpochHead{")
-	s = append(s, "Realm: "+fmt.Sprintf("%#v", this.Realm)+",\n")
-	s = append(s, "Epoch: "+fmt.Sprintf("%#v", this.Epoch)+",\n")
-	s = append(s, "RootHash: "+fmt.Sprintf("%#v", this.RootHash)+",\n")
-	s = append(s, "IssueTime: "+strings.Replace(this.IssueTime.GoString(), `&`, ``, 1)+",\n")
-	s = append(s, "PreviousSummaryHash: "+fmt.Sprintf("%#v", this.PreviousSummaryHash)+",\n")
-	s = append(s, "NextEpochPolicy: "+strings.Replace(this.NextEpochPolicy.GoString(), `&`, ``, 1)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *AuthorizationPolicy) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&proto.AuthorizationPolicy{")
-	keysForPublicKeys := make([]uint64, 0, len(this.PublicKeys))
-	for k, _ := range this.PublicKeys {
-		keysForPublicKeys = append(keysForPublicKeys, k)
+	if !bytes.Equal(this.Ed25519, that1.Ed25519) {
+		return false
 	}
-	github_com_maditya_protobuf_sortkeys.Uint64s(keysForPublicKeys)
-	mapStringForPublicKeys := "map[uint64]*PublicKey{"
-	for _, k := range keysForPublicKeys {
-		mapStringForPublicKeys += fmt.Sprintf("%#v: %#v,", k, this.PublicKeys[k])
+	return true
+}
+func (this *PublicKey_ECDSAP256SHA256) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
 	}
-	mapStringForPublicKeys += "}"
-	if this.PublicKeys != nil {
-		s = append(s, "PublicKeys: "+mapStringForPublicKeys+",\n")
+
+	that1, ok := that.(*PublicKey_ECDSAP256SHA256)
+	if !ok {
+		that2, ok := that.(PublicKey_ECDSAP256SHA256)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if this.PolicyType != nil {
-		s = append(s, "PolicyType: "+fmt.Sprintf("%#v", this.PolicyType)+",\n")
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *AuthorizationPolicy_Quorum) GoString() string {
-	if this == nil {
-		return "nil"
+	if !bytes.Equal(this.ECDSAP256SHA256, that1.ECDSAP256SHA256) {
+		return false
 	}
-	s := strings.Join([]string{`&proto.AuthorizationPolicy_Quorum{` +
-		`Quorum:` + fmt.Sprintf("%#v", this.Quorum) + `}`}, ", ")
-	return s
+	return true
 }
-func (this *PublicKey) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *PublicKey_RSAPSSSHA256) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&proto.PublicKey{")
-	if this.PubkeyType != nil {
-		s = append(s, "PubkeyType: "+fmt.Sprintf("%#v", this.PubkeyType)+",\n")
+
+	that1, ok := that.(*PublicKey_RSAPSSSHA256)
+	if !ok {
+		that2, ok := that.(PublicKey_RSAPSSSHA256)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *PublicKey_Ed25519) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
 	}
-	s := strings.Join([]string{`&proto.PublicKey_Ed25519{` +
-		`Ed25519:` + fmt.Sprintf("%#v", this.Ed25519) + `}`}, ", ")
-	return s
+	if !bytes.Equal(this.RSAPSSSHA256, that1.RSAPSSSHA256) {
+		return false
+	}
+	return true
 }
-func (this *QuorumExpr) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *QuorumExpr) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&proto.QuorumExpr{")
-	s = append(s, "Threshold: "+fmt.Sprintf("%#v", this.Threshold)+",\n")
-	s = append(s, "Candidates: "+fmt.Sprintf("%#v", this.Candidates)+",\n")
-	if this.Subexpressions != nil {
-		s = append(s, "Subexpressions: "+fmt.Sprintf("%#v", this.Subexpressions)+",\n")
+
+	that1, ok := that.(*QuorumExpr)
+	if !ok {
+		that2, ok := that.(QuorumExpr)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *QuorumExpr")
+		}
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *EmailProof) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *QuorumExpr but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *QuorumExpr but is not nil && this == nil")
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&proto.EmailProof{")
-	if this.ProofType != nil {
-		s = append(s, "ProofType: "+fmt.Sprintf("%#v", this.ProofType)+",\n")
+	if this.Threshold != that1.Threshold {
+		return fmt.Errorf("Threshold this(%v) Not Equal that(%v)", this.Threshold, that1.Threshold)
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *EmailProof_DKIMProof) GoString() string {
-	if this == nil {
-		return "nil"
+	if len(this.Candidates) != len(that1.Candidates) {
+		return fmt.Errorf("Candidates this(%v) Not Equal that(%v)", len(this.Candidates), len(that1.Candidates))
 	}
-	s := strings.Join([]string{`&proto.EmailProof_DKIMProof{` +
-		`DKIMProof:` + fmt.Sprintf("%#v", this.DKIMProof) + `}`}, ", ")
-	return s
-}
-func (this *EmailProof_OIDCToken) GoString() string {
-	if this == nil {
-		return "nil"
+	for i := range this.Candidates {
+		if this.Candidates[i] != that1.Candidates[i] {
+			return fmt.Errorf("Candidates this[%v](%v) Not Equal that[%v](%v)", i, this.Candidates[i], i, that1.Candidates[i])
+		}
 	}
-	s := strings.Join([]string{`&proto.EmailProof_OIDCToken{` +
-		`OIDCToken:` + fmt.Sprintf("%#v", this.OIDCToken) + `}`}, ", ")
-	return s
-}
-func (this *EmailProof_SAMLResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if len(this.Subexpressions) != len(that1.Subexpressions) {
+		return fmt.Errorf("Subexpressions this(%v) Not Equal that(%v)", len(this.Subexpressions), len(that1.Subexpressions))
 	}
-	s := strings.Join([]string{`&proto.EmailProof_SAMLResponse{` +
-		`SAMLResponse:` + fmt.Sprintf("%#v", this.SAMLResponse) + `}`}, ", ")
-	return s
-}
-func valueToGoStringClient(v interface{}, typ string) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+	for i := range this.Subexpressions {
+		if !this.Subexpressions[i].Equal(that1.Subexpressions[i]) {
+			return fmt.Errorf("Subexpressions this[%v](%v) Not Equal that[%v](%v)", i, this.Subexpressions[i], i, that1.Subexpressions[i])
+		}
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
-}
-func extensionToGoStringClient(m github_com_maditya_protobuf_proto.Message) string {
-	e := github_com_maditya_protobuf_proto.GetUnsafeExtensionsMap(m)
-	if e == nil {
-		return "nil"
+	if len(this.WeightedCandidates) != len(that1.WeightedCandidates) {
+		return fmt.Errorf("WeightedCandidates this(%v) Not Equal that(%v)", len(this.WeightedCandidates), len(that1.WeightedCandidates))
 	}
-	s := "proto.NewUnsafeXXX_InternalExtensions(map[int32]proto.Extension{"
-	keys := make([]int, 0, len(e))
-	for k := range e {
-		keys = append(keys, int(k))
+	for i := range this.WeightedCandidates {
+		if !this.WeightedCandidates[i].Equal(that1.WeightedCandidates[i]) {
+			return fmt.Errorf("WeightedCandidates this[%v](%v) Not Equal that[%v](%v)", i, this.WeightedCandidates[i], i, that1.WeightedCandidates[i])
+		}
 	}
-	sort.Ints(keys)
-	ss := []string{}
-	for _, k := range keys {
-		ss = append(ss, strconv.Itoa(k)+": "+e[int32(k)].GoString())
+	if this.Op != that1.Op {
+		return fmt.Errorf("Op this(%v) Not Equal that(%v)", this.Op, that1.Op)
 	}
-	s += strings.Join(ss, ",") + "})"
-	return s
+	return nil
 }
+func (this *QuorumExpr) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// Client API for E2EKSPublic service
-
-type E2EKSPublicClient interface {
-	Lookup(ctx context.Context, in *LookupRequest, opts ...grpc.CallOption) (*LookupProof, error)
-	Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*LookupProof, error)
-}
-
-type e2EKSPublicClient struct {
-	cc *grpc.ClientConn
-}
-
-func NewE2EKSPublicClient(cc *grpc.ClientConn) E2EKSPublicClient {
-	return &e2EKSPublicClient{cc}
-}
-
-func (c *e2EKSPublicClient) Lookup(ctx context.Context, in *LookupRequest, opts ...grpc.CallOption) (*LookupProof, error) {
-	out := new(LookupProof)
-	err := grpc.Invoke(ctx, "/proto.E2EKSPublic/Lookup", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*QuorumExpr)
+	if !ok {
+		that2, ok := that.(QuorumExpr)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return out, nil
-}
-
-func (c *e2EKSPublicClient) Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*LookupProof, error) {
-	out := new(LookupProof)
-	err := grpc.Invoke(ctx, "/proto.E2EKSPublic/Update", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
 	}
-	return out, nil
-}
-
-// Server API for E2EKSPublic service
-
-type E2EKSPublicServer interface {
-	Lookup(context.Context, *LookupRequest) (*LookupProof, error)
-	Update(context.Context, *UpdateRequest) (*LookupProof, error)
-}
-
-func RegisterE2EKSPublicServer(s *grpc.Server, srv E2EKSPublicServer) {
-	s.RegisterService(&_E2EKSPublic_serviceDesc, srv)
-}
-
-func _E2EKSPublic_Lookup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LookupRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+	if this.Threshold != that1.Threshold {
+		return false
 	}
-	if interceptor == nil {
-		return srv.(E2EKSPublicServer).Lookup(ctx, in)
+	if len(this.Candidates) != len(that1.Candidates) {
+		return false
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/proto.E2EKSPublic/Lookup",
+	for i := range this.Candidates {
+		if this.Candidates[i] != that1.Candidates[i] {
+			return false
+		}
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(E2EKSPublicServer).Lookup(ctx, req.(*LookupRequest))
+	if len(this.Subexpressions) != len(that1.Subexpressions) {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _E2EKSPublic_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+	for i := range this.Subexpressions {
+		if !this.Subexpressions[i].Equal(that1.Subexpressions[i]) {
+			return false
+		}
 	}
-	if interceptor == nil {
-		return srv.(E2EKSPublicServer).Update(ctx, in)
+	if len(this.WeightedCandidates) != len(that1.WeightedCandidates) {
+		return false
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/proto.E2EKSPublic/Update",
+	for i := range this.WeightedCandidates {
+		if !this.WeightedCandidates[i].Equal(that1.WeightedCandidates[i]) {
+			return false
+		}
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(E2EKSPublicServer).Update(ctx, req.(*UpdateRequest))
+	if this.Op != that1.Op {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
-}
-
-var _E2EKSPublic_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "proto.E2EKSPublic",
-	HandlerType: (*E2EKSPublicServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Lookup",
-			Handler:    _E2EKSPublic_Lookup_Handler,
-		},
-		{
-			MethodName: "Update",
-			Handler:    _E2EKSPublic_Update_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: fileDescriptorClient,
+	return true
 }
-
-func (m *LookupRequest) Marshal() (data []byte, err error) {
-	size := m.Size()
-	data = make([]byte, size)
-	n, err := m.MarshalTo(data)
-	if err != nil {
-		return nil, err
+func (this *WeightedCandidate) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
 	}
-	return data[:n], nil
-}
 
-func (m *LookupRequest) MarshalTo(data []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Epoch != 0 {
-		data[i] = 0x8
-		i++
-		i = encodeVarintClient(data, i, uint64(m.Epoch))
-	}
-	if len(m.UserId) > 0 {
-		data[i] = 0x12
-		i++
-		i = encodeVarintClient(data, i, uint64(len(m.UserId)))
-		i += copy(data[i:], m.UserId)
+	that1, ok := that.(*WeightedCandidate)
+	if !ok {
+		that2, ok := that.(WeightedCandidate)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *WeightedCandidate")
+		}
 	}
-	if m.QuorumRequirement != nil {
-		data[i] = 0x22
-		i++
-		i = encodeVarintClient(data, i, uint64(m.QuorumRequirement.Size()))
-		n1, err := m.QuorumRequirement.MarshalTo(data[i:])
-		if err != nil {
-			return 0, err
+	if that1 == nil {
+		if this == nil {
+			return nil
 		}
-		i += n1
+		return fmt.Errorf("that is type *WeightedCandidate but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *WeightedCandidate but is not nil && this == nil")
 	}
-	return i, nil
-}
-
-func (m *UpdateRequest) Marshal() (data []byte, err error) {
-	size := m.Size()
-	data = make([]byte, size)
-	n, err := m.MarshalTo(data)
-	if err != nil {
-		return nil, err
+	if this.ID != that1.ID {
+		return fmt.Errorf("ID this(%v) Not Equal that(%v)", this.ID, that1.ID)
 	}
-	return data[:n], nil
+	if this.Weight != that1.Weight {
+		return fmt.Errorf("Weight this(%v) Not Equal that(%v)", this.Weight, that1.Weight)
+	}
+	return nil
 }
-
-func (m *UpdateRequest) MarshalTo(data []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Update != nil {
-		data[i] = 0xa
-		i++
-		i = encodeVarintClient(data, i, uint64(m.Update.Size()))
-		n2, err := m.Update.MarshalTo(data[i:])
-		if err != nil {
-			return 0, err
+func (this *WeightedCandidate) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
 		}
-		i += n2
-	}
-	data[i] = 0x12
-	i++
-	i = encodeVarintClient(data, i, uint64(m.Profile.Size()))
-	n3, err := m.Profile.MarshalTo(data[i:])
-	if err != nil {
-		return 0, err
+		return false
 	}
-	i += n3
-	if m.LookupParameters != nil {
-		data[i] = 0x1a
-		i++
-		i = encodeVarintClient(data, i, uint64(m.LookupParameters.Size()))
-		n4, err := m.LookupParameters.MarshalTo(data[i:])
-		if err != nil {
-			return 0, err
+
+	that1, ok := that.(*WeightedCandidate)
+	if !ok {
+		that2, ok := that.(WeightedCandidate)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i += n4
 	}
-	if m.EmailProof != nil {
-		data[i] = 0xc2
-		i++
-		data[i] = 0x3e
-		i++
-		i = encodeVarintClient(data, i, uint64(m.EmailProof.Size()))
-		n5, err := m.EmailProof.MarshalTo(data[i:])
-		if err != nil {
-			return 0, err
+	if that1 == nil {
+		if this == nil {
+			return true
 		}
-		i += n5
+		return false
+	} else if this == nil {
+		return false
 	}
-	return i, nil
-}
-
-func (m *LookupProof) Marshal() (data []byte, err error) {
-	size := m.Size()
-	data = make([]byte, size)
-	n, err := m.MarshalTo(data)
-	if err != nil {
-		return nil, err
+	if this.ID != that1.ID {
+		return false
 	}
-	return data[:n], nil
+	if this.Weight != that1.Weight {
+		return false
+	}
+	return true
 }
+func (this *EmailProof) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
 
-func (m *LookupProof) MarshalTo(data []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.UserId) > 0 {
-		data[i] = 0xa
-		i++
-		i = encodeVarintClient(data, i, uint64(len(m.UserId)))
-		i += copy(data[i:], m.UserId)
-	}
-	if len(m.Index) > 0 {
-		data[i] = 0x12
-		i++
-		i = encodeVarintClient(data, i, uint64(len(m.Index)))
-		i += copy(data[i:], m.Index)
+	that1, ok := that.(*EmailProof)
+	if !ok {
+		that2, ok := that.(EmailProof)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *EmailProof")
+		}
 	}
-	if len(m.IndexProof) > 0 {
-		data[i] = 0x1a
-		i++
-		i = encodeVarintClient(data, i, uint64(len(m.IndexProof)))
-		i += copy(data[i:], m.IndexProof)
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *EmailProof but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *EmailProof but is not nil && this == nil")
 	}
-	if len(m.Ratifications) > 0 {
-		for _, msg := range m.Ratifications {
-			data[i] = 0x22
-			i++
-			i = encodeVarintClient(data, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(data[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+	if that1.ProofType == nil {
+		if this.ProofType != nil {
+			return fmt.Errorf("this.ProofType != nil && that1.ProofType == nil")
 		}
+	} else if this.ProofType == nil {
+		return fmt.Errorf("this.ProofType == nil && that1.ProofType != nil")
+	} else if err := this.ProofType.VerboseEqual(that1.ProofType); err != nil {
+		return err
 	}
-	if m.TreeProof != nil {
-		data[i] = 0x2a
-		i++
-		i = encodeVarintClient(data, i, uint64(m.TreeProof.Size()))
-		n6, err := m.TreeProof.MarshalTo(data[i:])
-		if err != nil {
-			return 0, err
+	return nil
+}
+func (this *EmailProof_DKIMProof) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
 		}
-		i += n6
+		return fmt.Errorf("that == nil && this != nil")
 	}
-	if m.Entry != nil {
-		data[i] = 0x32
-		i++
-		i = encodeVarintClient(data, i, uint64(m.Entry.Size()))
-		n7, err := m.Entry.MarshalTo(data[i:])
-		if err != nil {
-			return 0, err
+
+	that1, ok := that.(*EmailProof_DKIMProof)
+	if !ok {
+		that2, ok := that.(EmailProof_DKIMProof)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *EmailProof_DKIMProof")
 		}
-		i += n7
 	}
-	if m.Profile != nil {
-		data[i] = 0x3a
-		i++
-		i = encodeVarintClient(data, i, uint64(m.Profile.Size()))
-		n8, err := m.Profile.MarshalTo(data[i:])
-		if err != nil {
-			return 0, err
+	if that1 == nil {
+		if this == nil {
+			return nil
 		}
-		i += n8
+		return fmt.Errorf("that is type *EmailProof_DKIMProof but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *EmailProof_DKIMProof but is not nil && this == nil")
 	}
-	return i, nil
-}
-
-func (m *TreeProof) Marshal() (data []byte, err error) {
-	size := m.Size()
-	data = make([]byte, size)
-	n, err := m.MarshalTo(data)
-	if err != nil {
-		return nil, err
+	if !bytes.Equal(this.DKIMProof, that1.DKIMProof) {
+		return fmt.Errorf("DKIMProof this(%v) Not Equal that(%v)", this.DKIMProof, that1.DKIMProof)
 	}
-	return data[:n], nil
+	return nil
 }
+func (this *EmailProof_OIDCToken) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
 
-func (m *TreeProof) MarshalTo(data []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Neighbors) > 0 {
-		for _, b := range m.Neighbors {
-			data[i] = 0xa
-			i++
-			i = encodeVarintClient(data, i, uint64(len(b)))
-			i += copy(data[i:], b)
+	that1, ok := that.(*EmailProof_OIDCToken)
+	if !ok {
+		that2, ok := that.(EmailProof_OIDCToken)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *EmailProof_OIDCToken")
 		}
 	}
-	if len(m.ExistingIndex) > 0 {
-		data[i] = 0x12
-		i++
-		i = encodeVarintClient(data, i, uint64(len(m.ExistingIndex)))
-		i += copy(data[i:], m.ExistingIndex)
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *EmailProof_OIDCToken but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *EmailProof_OIDCToken but is not nil && this == nil")
 	}
-	if len(m.ExistingEntryHash) > 0 {
-		data[i] = 0x1a
-		i++
-		i = encodeVarintClient(data, i, uint64(len(m.ExistingEntryHash)))
-		i += copy(data[i:], m.ExistingEntryHash)
+	if this.OIDCToken != that1.OIDCToken {
+		return fmt.Errorf("OIDCToken this(%v) Not Equal that(%v)", this.OIDCToken, that1.OIDCToken)
 	}
-	return i, nil
+	return nil
 }
-
-func (m *Entry) Marshal() (data []byte, err error) {
-	size := m.Size()
-	data = make([]byte, size)
-	n, err := m.MarshalTo(data)
-	if err != nil {
-		return nil, err
+func (this *EmailProof_SAMLResponse) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
 	}
-	return data[:n], nil
-}
 
-func (m *Entry) MarshalTo(data []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Index) > 0 {
-		data[i] = 0xa
-		i++
-		i = encodeVarintClient(data, i, uint64(len(m.Index)))
-		i += copy(data[i:], m.Index)
-	}
-	if m.Version != 0 {
-		data[i] = 0x10
-		i++
-		i = encodeVarintClient(data, i, uint64(m.Version))
+	that1, ok := that.(*EmailProof_SAMLResponse)
+	if !ok {
+		that2, ok := that.(EmailProof_SAMLResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *EmailProof_SAMLResponse")
+		}
 	}
-	if m.UpdatePolicy != nil {
-		data[i] = 0x1a
-		i++
-		i = encodeVarintClient(data, i, uint64(m.UpdatePolicy.Size()))
-		n9, err := m.UpdatePolicy.MarshalTo(data[i:])
-		if err != nil {
-			return 0, err
+	if that1 == nil {
+		if this == nil {
+			return nil
 		}
-		i += n9
+		return fmt.Errorf("that is type *EmailProof_SAMLResponse but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *EmailProof_SAMLResponse but is not nil && this == nil")
 	}
-	if len(m.ProfileCommitment) > 0 {
-		data[i] = 0x22
-		i++
-		i = encodeVarintClient(data, i, uint64(len(m.ProfileCommitment)))
-		i += copy(data[i:], m.ProfileCommitment)
+	if this.SAMLResponse != that1.SAMLResponse {
+		return fmt.Errorf("SAMLResponse this(%v) Not Equal that(%v)", this.SAMLResponse, that1.SAMLResponse)
 	}
-	return i, nil
+	return nil
 }
+func (this *EmailProof_ChallengeCode) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
 
-func (m *SignedEntryUpdate) Marshal() (data []byte, err error) {
-	size := m.Size()
-	data = make([]byte, size)
-	n, err := m.MarshalTo(data)
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*EmailProof_ChallengeCode)
+	if !ok {
+		that2, ok := that.(EmailProof_ChallengeCode)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *EmailProof_ChallengeCode")
+		}
 	}
-	return data[:n], nil
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *EmailProof_ChallengeCode but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *EmailProof_ChallengeCode but is not nil && this == nil")
+	}
+	if this.ChallengeCode != that1.ChallengeCode {
+		return fmt.Errorf("ChallengeCode this(%v) Not Equal that(%v)", this.ChallengeCode, that1.ChallengeCode)
+	}
+	return nil
 }
+func (this *EmailProof_ClientCert) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
 
-func (m *SignedEntryUpdate) MarshalTo(data []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	data[i] = 0xa
-	i++
-	i = encodeVarintClient(data, i, uint64(m.NewEntry.Size()))
-	n10, err := m.NewEntry.MarshalTo(data[i:])
-	if err != nil {
-		return 0, err
+	that1, ok := that.(*EmailProof_ClientCert)
+	if !ok {
+		that2, ok := that.(EmailProof_ClientCert)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *EmailProof_ClientCert")
+		}
 	}
-	i += n10
-	if len(m.Signatures) > 0 {
-		for k, _ := range m.Signatures {
-			data[i] = 0x12
-			i++
-			v := m.Signatures[k]
-			byteSize := 0
-			if len(v) > 0 {
-				byteSize = 1 + len(v) + sovClient(uint64(len(v)))
-			}
-			mapSize := 1 + 8 + byteSize
-			i = encodeVarintClient(data, i, uint64(mapSize))
-			data[i] = 0x9
-			i++
-			i = encodeFixed64Client(data, i, uint64(k))
-			if len(v) > 0 {
-				data[i] = 0x12
-				i++
-				i = encodeVarintClient(data, i, uint64(len(v)))
-				i += copy(data[i:], v)
-			}
+	if that1 == nil {
+		if this == nil {
+			return nil
 		}
+		return fmt.Errorf("that is type *EmailProof_ClientCert but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *EmailProof_ClientCert but is not nil && this == nil")
 	}
-	return i, nil
-}
-
-func (m *Profile) Marshal() (data []byte, err error) {
-	size := m.Size()
-	data = make([]byte, size)
-	n, err := m.MarshalTo(data)
-	if err != nil {
-		return nil, err
+	if !this.ClientCert.Equal(that1.ClientCert) {
+		return fmt.Errorf("ClientCert this(%v) Not Equal that(%v)", this.ClientCert, that1.ClientCert)
 	}
-	return data[:n], nil
+	return nil
 }
+func (this *EmailProof_External) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
 
-func (m *Profile) MarshalTo(data []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Nonce) > 0 {
-		data[i] = 0xa
-		i++
-		i = encodeVarintClient(data, i, uint64(len(m.Nonce)))
-		i += copy(data[i:], m.Nonce)
+	that1, ok := that.(*EmailProof_External)
+	if !ok {
+		that2, ok := that.(EmailProof_External)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *EmailProof_External")
+		}
 	}
-	if len(m.Keys) > 0 {
-		for k, _ := range m.Keys {
-			data[i] = 0x12
-			i++
-			v := m.Keys[k]
-			byteSize := 0
-			if len(v) > 0 {
-				byteSize = 1 + len(v) + sovClient(uint64(len(v)))
-			}
-			mapSize := 1 + len(k) + sovClient(uint64(len(k))) + byteSize
-			i = encodeVarintClient(data, i, uint64(mapSize))
-			data[i] = 0xa
-			i++
-			i = encodeVarintClient(data, i, uint64(len(k)))
-			i += copy(data[i:], k)
-			if len(v) > 0 {
-				data[i] = 0x12
-				i++
-				i = encodeVarintClient(data, i, uint64(len(v)))
-				i += copy(data[i:], v)
-			}
+	if that1 == nil {
+		if this == nil {
+			return nil
 		}
+		return fmt.Errorf("that is type *EmailProof_External but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *EmailProof_External but is not nil && this == nil")
 	}
-	return i, nil
-}
-
-func (m *SignedEpochHead) Marshal() (data []byte, err error) {
-	size := m.Size()
-	data = make([]byte, size)
-	n, err := m.MarshalTo(data)
-	if err != nil {
-		return nil, err
+	if !this.External.Equal(that1.External) {
+		return fmt.Errorf("External this(%v) Not Equal that(%v)", this.External, that1.External)
 	}
-	return data[:n], nil
+	return nil
 }
+func (this *EmailProof) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
 
-func (m *SignedEpochHead) MarshalTo(data []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	data[i] = 0xa
-	i++
-	i = encodeVarintClient(data, i, uint64(m.Head.Size()))
-	n11, err := m.Head.MarshalTo(data[i:])
-	if err != nil {
-		return 0, err
+	that1, ok := that.(*EmailProof)
+	if !ok {
+		that2, ok := that.(EmailProof)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	i += n11
-	if len(m.Signatures) > 0 {
-		for k, _ := range m.Signatures {
-			data[i] = 0x12
-			i++
-			v := m.Signatures[k]
-			byteSize := 0
-			if len(v) > 0 {
-				byteSize = 1 + len(v) + sovClient(uint64(len(v)))
-			}
-			mapSize := 1 + 8 + byteSize
-			i = encodeVarintClient(data, i, uint64(mapSize))
-			data[i] = 0x9
-			i++
-			i = encodeFixed64Client(data, i, uint64(k))
-			if len(v) > 0 {
-				data[i] = 0x12
-				i++
-				i = encodeVarintClient(data, i, uint64(len(v)))
-				i += copy(data[i:], v)
-			}
+	if that1 == nil {
+		if this == nil {
+			return true
 		}
+		return false
+	} else if this == nil {
+		return false
 	}
-	return i, nil
-}
-
-func (m *TimestampedEpochHead) Marshal() (data []byte, err error) {
-	size := m.Size()
-	data = make([]byte, size)
-	n, err := m.MarshalTo(data)
-	if err != nil {
-		return nil, err
+	if that1.ProofType == nil {
+		if this.ProofType != nil {
+			return false
+		}
+	} else if this.ProofType == nil {
+		return false
+	} else if !this.ProofType.Equal(that1.ProofType) {
+		return false
 	}
-	return data[:n], nil
+	return true
 }
+func (this *EmailProof_DKIMProof) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
 
-func (m *TimestampedEpochHead) MarshalTo(data []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	data[i] = 0xa
-	i++
-	i = encodeVarintClient(data, i, uint64(m.Head.Size()))
-	n12, err := m.Head.MarshalTo(data[i:])
-	if err != nil {
-		return 0, err
+	that1, ok := that.(*EmailProof_DKIMProof)
+	if !ok {
+		that2, ok := that.(EmailProof_DKIMProof)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	i += n12
-	data[i] = 0x12
-	i++
-	i = encodeVarintClient(data, i, uint64(m.Timestamp.Size()))
-	n13, err := m.Timestamp.MarshalTo(data[i:])
-	if err != nil {
-		return 0, err
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
 	}
-	i += n13
-	return i, nil
-}
-
-func (m *EpochHead) Marshal() (data []byte, err error) {
-	size := m.Size()
-	data = make([]byte, size)
-	n, err := m.MarshalTo(data)
-	if err != nil {
-		return nil, err
+	if !bytes.Equal(this.DKIMProof, that1.DKIMProof) {
+		return false
 	}
-	return data[:n], nil
+	return true
 }
+func (this *EmailProof_OIDCToken) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
 
-func (m *EpochHead) MarshalTo(data []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Realm) > 0 {
-		data[i] = 0xa
-		i++
-		i = encodeVarintClient(data, i, uint64(len(m.Realm)))
-		i += copy(data[i:], m.Realm)
+	that1, ok := that.(*EmailProof_OIDCToken)
+	if !ok {
+		that2, ok := that.(EmailProof_OIDCToken)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.Epoch != 0 {
-		data[i] = 0x10
-		i++
-		i = encodeVarintClient(data, i, uint64(m.Epoch))
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
 	}
-	if len(m.RootHash) > 0 {
-		data[i] = 0x1a
-		i++
-		i = encodeVarintClient(data, i, uint64(len(m.RootHash)))
-		i += copy(data[i:], m.RootHash)
+	if this.OIDCToken != that1.OIDCToken {
+		return false
 	}
-	data[i] = 0x22
-	i++
-	i = encodeVarintClient(data, i, uint64(m.IssueTime.Size()))
-	n14, err := m.IssueTime.MarshalTo(data[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n14
-	if len(m.PreviousSummaryHash) > 0 {
-		data[i] = 0x2a
-		i++
-		i = encodeVarintClient(data, i, uint64(len(m.PreviousSummaryHash)))
-		i += copy(data[i:], m.PreviousSummaryHash)
-	}
-	data[i] = 0x32
-	i++
-	i = encodeVarintClient(data, i, uint64(m.NextEpochPolicy.Size()))
-	n15, err := m.NextEpochPolicy.MarshalTo(data[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n15
-	return i, nil
+	return true
 }
-
-func (m *AuthorizationPolicy) Marshal() (data []byte, err error) {
-	size := m.Size()
-	data = make([]byte, size)
-	n, err := m.MarshalTo(data)
-	if err != nil {
-		return nil, err
+func (this *EmailProof_SAMLResponse) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
 	}
-	return data[:n], nil
-}
 
-func (m *AuthorizationPolicy) MarshalTo(data []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.PublicKeys) > 0 {
-		for k, _ := range m.PublicKeys {
-			data[i] = 0xa
-			i++
-			v := m.PublicKeys[k]
-			msgSize := 0
-			if v != nil {
-				msgSize = v.Size()
-				msgSize += 1 + sovClient(uint64(msgSize))
-			}
-			mapSize := 1 + 8 + msgSize
-			i = encodeVarintClient(data, i, uint64(mapSize))
-			data[i] = 0x9
-			i++
-			i = encodeFixed64Client(data, i, uint64(k))
-			if v != nil {
-				data[i] = 0x12
-				i++
-				i = encodeVarintClient(data, i, uint64(v.Size()))
-				n16, err := v.MarshalTo(data[i:])
-				if err != nil {
-					return 0, err
-				}
-				i += n16
-			}
+	that1, ok := that.(*EmailProof_SAMLResponse)
+	if !ok {
+		that2, ok := that.(EmailProof_SAMLResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if m.PolicyType != nil {
-		nn17, err := m.PolicyType.MarshalTo(data[i:])
-		if err != nil {
-			return 0, err
+	if that1 == nil {
+		if this == nil {
+			return true
 		}
-		i += nn17
+		return false
+	} else if this == nil {
+		return false
 	}
-	return i, nil
+	if this.SAMLResponse != that1.SAMLResponse {
+		return false
+	}
+	return true
 }
+func (this *EmailProof_ChallengeCode) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
 
-func (m *AuthorizationPolicy_Quorum) MarshalTo(data []byte) (int, error) {
-	i := 0
-	if m.Quorum != nil {
-		data[i] = 0x12
-		i++
-		i = encodeVarintClient(data, i, uint64(m.Quorum.Size()))
-		n18, err := m.Quorum.MarshalTo(data[i:])
-		if err != nil {
-			return 0, err
+	that1, ok := that.(*EmailProof_ChallengeCode)
+	if !ok {
+		that2, ok := that.(EmailProof_ChallengeCode)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i += n18
 	}
-	return i, nil
-}
-func (m *PublicKey) Marshal() (data []byte, err error) {
-	size := m.Size()
-	data = make([]byte, size)
-	n, err := m.MarshalTo(data)
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
 	}
-	return data[:n], nil
+	if this.ChallengeCode != that1.ChallengeCode {
+		return false
+	}
+	return true
 }
-
-func (m *PublicKey) MarshalTo(data []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.PubkeyType != nil {
-		nn19, err := m.PubkeyType.MarshalTo(data[i:])
-		if err != nil {
-			return 0, err
+func (this *EmailProof_ClientCert) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
 		}
-		i += nn19
+		return false
 	}
-	return i, nil
-}
 
-func (m *PublicKey_Ed25519) MarshalTo(data []byte) (int, error) {
-	i := 0
-	if m.Ed25519 != nil {
-		data[i] = 0xa
-		i++
-		i = encodeVarintClient(data, i, uint64(len(m.Ed25519)))
-		i += copy(data[i:], m.Ed25519)
+	that1, ok := that.(*EmailProof_ClientCert)
+	if !ok {
+		that2, ok := that.(EmailProof_ClientCert)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return i, nil
-}
-func (m *QuorumExpr) Marshal() (data []byte, err error) {
-	size := m.Size()
-	data = make([]byte, size)
-	n, err := m.MarshalTo(data)
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
 	}
-	return data[:n], nil
+	if !this.ClientCert.Equal(that1.ClientCert) {
+		return false
+	}
+	return true
 }
-
-func (m *QuorumExpr) MarshalTo(data []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Threshold != 0 {
-		data[i] = 0x8
-		i++
-		i = encodeVarintClient(data, i, uint64(m.Threshold))
+func (this *EmailProof_External) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
 	}
-	if len(m.Candidates) > 0 {
-		data[i] = 0x12
-		i++
-		i = encodeVarintClient(data, i, uint64(len(m.Candidates)*8))
-		for _, num := range m.Candidates {
-			data[i] = uint8(num)
-			i++
-			data[i] = uint8(num >> 8)
-			i++
-			data[i] = uint8(num >> 16)
-			i++
-			data[i] = uint8(num >> 24)
-			i++
-			data[i] = uint8(num >> 32)
-			i++
-			data[i] = uint8(num >> 40)
-			i++
-			data[i] = uint8(num >> 48)
-			i++
-			data[i] = uint8(num >> 56)
-			i++
+
+	that1, ok := that.(*EmailProof_External)
+	if !ok {
+		that2, ok := that.(EmailProof_External)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if len(m.Subexpressions) > 0 {
-		for _, msg := range m.Subexpressions {
-			data[i] = 0x1a
-			i++
-			i = encodeVarintClient(data, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(data[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+	if that1 == nil {
+		if this == nil {
+			return true
 		}
+		return false
+	} else if this == nil {
+		return false
 	}
-	return i, nil
-}
-
-func (m *EmailProof) Marshal() (data []byte, err error) {
-	size := m.Size()
-	data = make([]byte, size)
-	n, err := m.MarshalTo(data)
-	if err != nil {
-		return nil, err
+	if !this.External.Equal(that1.External) {
+		return false
 	}
-	return data[:n], nil
+	return true
 }
-
-func (m *EmailProof) MarshalTo(data []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.ProofType != nil {
-		nn20, err := m.ProofType.MarshalTo(data[i:])
-		if err != nil {
-			return 0, err
+func (this *ExternalProof) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
 		}
-		i += nn20
+		return fmt.Errorf("that == nil && this != nil")
 	}
-	return i, nil
-}
 
-func (m *EmailProof_DKIMProof) MarshalTo(data []byte) (int, error) {
-	i := 0
-	if m.DKIMProof != nil {
-		data[i] = 0xa
-		i++
-		i = encodeVarintClient(data, i, uint64(len(m.DKIMProof)))
-		i += copy(data[i:], m.DKIMProof)
+	that1, ok := that.(*ExternalProof)
+	if !ok {
+		that2, ok := that.(ExternalProof)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *ExternalProof")
+		}
 	}
-	return i, nil
-}
-func (m *EmailProof_OIDCToken) MarshalTo(data []byte) (int, error) {
-	i := 0
-	data[i] = 0x12
-	i++
-	i = encodeVarintClient(data, i, uint64(len(m.OIDCToken)))
-	i += copy(data[i:], m.OIDCToken)
-	return i, nil
-}
-func (m *EmailProof_SAMLResponse) MarshalTo(data []byte) (int, error) {
-	i := 0
-	data[i] = 0x1a
-	i++
-	i = encodeVarintClient(data, i, uint64(len(m.SAMLResponse)))
-	i += copy(data[i:], m.SAMLResponse)
-	return i, nil
-}
-func encodeFixed64Client(data []byte, offset int, v uint64) int {
-	data[offset] = uint8(v)
-	data[offset+1] = uint8(v >> 8)
-	data[offset+2] = uint8(v >> 16)
-	data[offset+3] = uint8(v >> 24)
-	data[offset+4] = uint8(v >> 32)
-	data[offset+5] = uint8(v >> 40)
-	data[offset+6] = uint8(v >> 48)
-	data[offset+7] = uint8(v >> 56)
-	return offset + 8
-}
-func encodeFixed32Client(data []byte, offset int, v uint32) int {
-	data[offset] = uint8(v)
-	data[offset+1] = uint8(v >> 8)
-	data[offset+2] = uint8(v >> 16)
-	data[offset+3] = uint8(v >> 24)
-	return offset + 4
-}
-func encodeVarintClient(data []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		data[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *ExternalProof but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *ExternalProof but is not nil && this == nil")
 	}
-	data[offset] = uint8(v)
-	return offset + 1
-}
-func NewPopulatedLookupRequest(r randyClient, easy bool) *LookupRequest {
-	this := &LookupRequest{}
-	this.Epoch = uint64(uint64(r.Uint32()))
-	this.UserId = randStringClient(r)
-	if r.Intn(10) == 0 {
-		this.QuorumRequirement = NewPopulatedQuorumExpr(r, easy)
+	if this.Type != that1.Type {
+		return fmt.Errorf("Type this(%v) Not Equal that(%v)", this.Type, that1.Type)
 	}
-	if !easy && r.Intn(10) != 0 {
+	if !bytes.Equal(this.Proof, that1.Proof) {
+		return fmt.Errorf("Proof this(%v) Not Equal that(%v)", this.Proof, that1.Proof)
 	}
-	return this
+	return nil
 }
+func (this *ExternalProof) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
 
-func NewPopulatedUpdateRequest(r randyClient, easy bool) *UpdateRequest {
-	this := &UpdateRequest{}
-	if r.Intn(10) == 0 {
-		this.Update = NewPopulatedSignedEntryUpdate(r, easy)
+	that1, ok := that.(*ExternalProof)
+	if !ok {
+		that2, ok := that.(ExternalProof)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	v1 := NewPopulatedEncodedProfile(r, easy)
-	this.Profile = *v1
-	if r.Intn(10) == 0 {
-		this.LookupParameters = NewPopulatedLookupRequest(r, easy)
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
 	}
-	if r.Intn(10) != 0 {
-		this.EmailProof = NewPopulatedEmailProof(r, easy)
+	if this.Type != that1.Type {
+		return false
 	}
-	if !easy && r.Intn(10) != 0 {
+	if !bytes.Equal(this.Proof, that1.Proof) {
+		return false
 	}
-	return this
+	return true
 }
-
-func NewPopulatedLookupProof(r randyClient, easy bool) *LookupProof {
-	this := &LookupProof{}
-	this.UserId = randStringClient(r)
-	v2 := r.Intn(100)
-	this.Index = make([]byte, v2)
-	for i := 0; i < v2; i++ {
-		this.Index[i] = byte(r.Intn(256))
-	}
-	v3 := r.Intn(100)
-	this.IndexProof = make([]byte, v3)
-	for i := 0; i < v3; i++ {
-		this.IndexProof[i] = byte(r.Intn(256))
+func (this *ClientCertProof) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
 	}
-	if r.Intn(10) == 0 {
-		v4 := r.Intn(5)
-		this.Ratifications = make([]*SignedEpochHead, v4)
-		for i := 0; i < v4; i++ {
-			this.Ratifications[i] = NewPopulatedSignedEpochHead(r, easy)
+
+	that1, ok := that.(*ClientCertProof)
+	if !ok {
+		that2, ok := that.(ClientCertProof)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *ClientCertProof")
 		}
 	}
-	if r.Intn(10) != 0 {
-		this.TreeProof = NewPopulatedTreeProof(r, easy)
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *ClientCertProof but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *ClientCertProof but is not nil && this == nil")
 	}
-	if r.Intn(10) == 0 {
-		this.Entry = NewPopulatedEncodedEntry(r, easy)
+	if len(this.Certificates) != len(that1.Certificates) {
+		return fmt.Errorf("Certificates this(%v) Not Equal that(%v)", len(this.Certificates), len(that1.Certificates))
 	}
-	if r.Intn(10) != 0 {
-		this.Profile = NewPopulatedEncodedProfile(r, easy)
+	for i := range this.Certificates {
+		if !bytes.Equal(this.Certificates[i], that1.Certificates[i]) {
+			return fmt.Errorf("Certificates this[%v](%v) Not Equal that[%v](%v)", i, this.Certificates[i], i, that1.Certificates[i])
+		}
 	}
-	if !easy && r.Intn(10) != 0 {
+	if !bytes.Equal(this.Signature, that1.Signature) {
+		return fmt.Errorf("Signature this(%v) Not Equal that(%v)", this.Signature, that1.Signature)
 	}
-	return this
+	return nil
 }
+func (this *ClientCertProof) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
 
-func NewPopulatedTreeProof(r randyClient, easy bool) *TreeProof {
-	this := &TreeProof{}
-	v5 := r.Intn(10)
-	this.Neighbors = make([][]byte, v5)
-	for i := 0; i < v5; i++ {
-		v6 := r.Intn(100)
-		this.Neighbors[i] = make([]byte, v6)
-		for j := 0; j < v6; j++ {
-			this.Neighbors[i][j] = byte(r.Intn(256))
+	that1, ok := that.(*ClientCertProof)
+	if !ok {
+		that2, ok := that.(ClientCertProof)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	v7 := r.Intn(100)
-	this.ExistingIndex = make([]byte, v7)
-	for i := 0; i < v7; i++ {
-		this.ExistingIndex[i] = byte(r.Intn(256))
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
 	}
-	v8 := r.Intn(100)
-	this.ExistingEntryHash = make([]byte, v8)
-	for i := 0; i < v8; i++ {
-		this.ExistingEntryHash[i] = byte(r.Intn(256))
+	if len(this.Certificates) != len(that1.Certificates) {
+		return false
 	}
-	if !easy && r.Intn(10) != 0 {
+	for i := range this.Certificates {
+		if !bytes.Equal(this.Certificates[i], that1.Certificates[i]) {
+			return false
+		}
 	}
-	return this
+	if !bytes.Equal(this.Signature, that1.Signature) {
+		return false
+	}
+	return true
 }
+func (this *EmailChallengeRequest) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
 
-func NewPopulatedEntry(r randyClient, easy bool) *Entry {
-	this := &Entry{}
-	v9 := r.Intn(100)
-	this.Index = make([]byte, v9)
-	for i := 0; i < v9; i++ {
-		this.Index[i] = byte(r.Intn(256))
+	that1, ok := that.(*EmailChallengeRequest)
+	if !ok {
+		that2, ok := that.(EmailChallengeRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *EmailChallengeRequest")
+		}
 	}
-	this.Version = uint64(uint64(r.Uint32()))
-	if r.Intn(10) == 0 {
-		this.UpdatePolicy = NewPopulatedAuthorizationPolicy(r, easy)
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *EmailChallengeRequest but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *EmailChallengeRequest but is not nil && this == nil")
 	}
-	v10 := r.Intn(100)
-	this.ProfileCommitment = make([]byte, v10)
-	for i := 0; i < v10; i++ {
-		this.ProfileCommitment[i] = byte(r.Intn(256))
+	if this.UserId != that1.UserId {
+		return fmt.Errorf("UserId this(%v) Not Equal that(%v)", this.UserId, that1.UserId)
 	}
-	if !easy && r.Intn(10) != 0 {
+	if !bytes.Equal(this.EntryHash, that1.EntryHash) {
+		return fmt.Errorf("EntryHash this(%v) Not Equal that(%v)", this.EntryHash, that1.EntryHash)
 	}
-	return this
+	return nil
 }
-
-func NewPopulatedSignedEntryUpdate(r randyClient, easy bool) *SignedEntryUpdate {
-	this := &SignedEntryUpdate{}
-	v11 := NewPopulatedEncodedEntry(r, easy)
-	this.NewEntry = *v11
-	if r.Intn(10) != 0 {
-		v12 := r.Intn(10)
-		this.Signatures = make(map[uint64][]byte)
-		for i := 0; i < v12; i++ {
-			v13 := r.Intn(100)
-			v14 := uint64(uint64(r.Uint32()))
-			this.Signatures[v14] = make([]byte, v13)
-			for i := 0; i < v13; i++ {
-				this.Signatures[v14][i] = byte(r.Intn(256))
-			}
+func (this *EmailChallengeRequest) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
 		}
+		return false
 	}
-	if !easy && r.Intn(10) != 0 {
-	}
-	return this
-}
 
-func NewPopulatedProfile(r randyClient, easy bool) *Profile {
-	this := &Profile{}
-	v15 := r.Intn(100)
-	this.Nonce = make([]byte, v15)
-	for i := 0; i < v15; i++ {
-		this.Nonce[i] = byte(r.Intn(256))
+	that1, ok := that.(*EmailChallengeRequest)
+	if !ok {
+		that2, ok := that.(EmailChallengeRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if r.Intn(10) != 0 {
-		v16 := r.Intn(10)
-		this.Keys = make(map[string][]byte)
-		for i := 0; i < v16; i++ {
-			v17 := r.Intn(100)
-			v18 := randStringClient(r)
-			this.Keys[v18] = make([]byte, v17)
-			for i := 0; i < v17; i++ {
-				this.Keys[v18][i] = byte(r.Intn(256))
-			}
+	if that1 == nil {
+		if this == nil {
+			return true
 		}
+		return false
+	} else if this == nil {
+		return false
 	}
-	if !easy && r.Intn(10) != 0 {
-	}
-	return this
-}
-
-func NewPopulatedSignedEpochHead(r randyClient, easy bool) *SignedEpochHead {
-	this := &SignedEpochHead{}
-	v19 := NewPopulatedEncodedTimestampedEpochHead(r, easy)
-	this.Head = *v19
-	if r.Intn(10) != 0 {
-		v20 := r.Intn(10)
-		this.Signatures = make(map[uint64][]byte)
-		for i := 0; i < v20; i++ {
-			v21 := r.Intn(100)
-			v22 := uint64(uint64(r.Uint32()))
-			this.Signatures[v22] = make([]byte, v21)
-			for i := 0; i < v21; i++ {
-				this.Signatures[v22][i] = byte(r.Intn(256))
-			}
-		}
+	if this.UserId != that1.UserId {
+		return false
 	}
-	if !easy && r.Intn(10) != 0 {
+	if !bytes.Equal(this.EntryHash, that1.EntryHash) {
+		return false
 	}
-	return this
+	return true
 }
-
-func NewPopulatedTimestampedEpochHead(r randyClient, easy bool) *TimestampedEpochHead {
-	this := &TimestampedEpochHead{}
-	v23 := NewPopulatedEncodedEpochHead(r, easy)
-	this.Head = *v23
-	v24 := NewPopulatedTimestamp(r, easy)
-	this.Timestamp = *v24
-	if !easy && r.Intn(10) != 0 {
+func (this *EmailChallengeResponse) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
 	}
-	return this
-}
 
-func NewPopulatedEpochHead(r randyClient, easy bool) *EpochHead {
-	this := &EpochHead{}
-	this.Realm = randStringClient(r)
-	this.Epoch = uint64(uint64(r.Uint32()))
-	v25 := r.Intn(100)
-	this.RootHash = make([]byte, v25)
-	for i := 0; i < v25; i++ {
-		this.RootHash[i] = byte(r.Intn(256))
+	that1, ok := that.(*EmailChallengeResponse)
+	if !ok {
+		that2, ok := that.(EmailChallengeResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *EmailChallengeResponse")
+		}
 	}
-	v26 := NewPopulatedTimestamp(r, easy)
-	this.IssueTime = *v26
-	v27 := r.Intn(100)
-	this.PreviousSummaryHash = make([]byte, v27)
-	for i := 0; i < v27; i++ {
-		this.PreviousSummaryHash[i] = byte(r.Intn(256))
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *EmailChallengeResponse but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *EmailChallengeResponse but is not nil && this == nil")
 	}
-	v28 := NewPopulatedAuthorizationPolicy(r, easy)
-	this.NextEpochPolicy = *v28
-	if !easy && r.Intn(10) != 0 {
+	if !this.Expiration.Equal(&that1.Expiration) {
+		return fmt.Errorf("Expiration this(%v) Not Equal that(%v)", this.Expiration, that1.Expiration)
 	}
-	return this
+	return nil
 }
+func (this *EmailChallengeResponse) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
 
-func NewPopulatedAuthorizationPolicy(r randyClient, easy bool) *AuthorizationPolicy {
-	this := &AuthorizationPolicy{}
-	if r.Intn(10) != 0 {
-		v29 := r.Intn(10)
-		this.PublicKeys = make(map[uint64]*PublicKey)
-		for i := 0; i < v29; i++ {
-			this.PublicKeys[uint64(uint64(r.Uint32()))] = NewPopulatedPublicKey(r, easy)
+	that1, ok := that.(*EmailChallengeResponse)
+	if !ok {
+		that2, ok := that.(EmailChallengeResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	oneofNumber_PolicyType := []int32{2}[r.Intn(1)]
-	switch oneofNumber_PolicyType {
-	case 2:
-		this.PolicyType = NewPopulatedAuthorizationPolicy_Quorum(r, easy)
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
 	}
-	if !easy && r.Intn(10) != 0 {
+	if !this.Expiration.Equal(&that1.Expiration) {
+		return false
 	}
-	return this
+	return true
 }
+func (this *ListVerifiersRequest) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
 
-func NewPopulatedAuthorizationPolicy_Quorum(r randyClient, easy bool) *AuthorizationPolicy_Quorum {
-	this := &AuthorizationPolicy_Quorum{}
-	this.Quorum = NewPopulatedQuorumExpr(r, easy)
-	return this
-}
-func NewPopulatedPublicKey(r randyClient, easy bool) *PublicKey {
-	this := &PublicKey{}
-	oneofNumber_PubkeyType := []int32{1}[r.Intn(1)]
-	switch oneofNumber_PubkeyType {
-	case 1:
-		this.PubkeyType = NewPopulatedPublicKey_Ed25519(r, easy)
+	that1, ok := that.(*ListVerifiersRequest)
+	if !ok {
+		that2, ok := that.(ListVerifiersRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *ListVerifiersRequest")
+		}
 	}
-	if !easy && r.Intn(10) != 0 {
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *ListVerifiersRequest but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *ListVerifiersRequest but is not nil && this == nil")
 	}
-	return this
+	return nil
 }
+func (this *ListVerifiersRequest) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
 
-func NewPopulatedPublicKey_Ed25519(r randyClient, easy bool) *PublicKey_Ed25519 {
-	this := &PublicKey_Ed25519{}
-	v30 := r.Intn(100)
-	this.Ed25519 = make([]byte, v30)
-	for i := 0; i < v30; i++ {
-		this.Ed25519[i] = byte(r.Intn(256))
+	that1, ok := that.(*ListVerifiersRequest)
+	if !ok {
+		that2, ok := that.(ListVerifiersRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return this
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	return true
 }
-func NewPopulatedQuorumExpr(r randyClient, easy bool) *QuorumExpr {
-	this := &QuorumExpr{}
-	this.Threshold = uint32(r.Uint32())
-	v31 := r.Intn(2)
-	this.Candidates = make([]uint64, v31)
-	for i := 0; i < v31; i++ {
-		this.Candidates[i] = uint64(uint64(r.Uint32()))
+func (this *ListVerifiersResponse) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
 	}
-	if r.Intn(10) == 0 {
-		v32 := r.Intn(5)
-		this.Subexpressions = make([]*QuorumExpr, v32)
-		for i := 0; i < v32; i++ {
-			this.Subexpressions[i] = NewPopulatedQuorumExpr(r, easy)
+
+	that1, ok := that.(*ListVerifiersResponse)
+	if !ok {
+		that2, ok := that.(ListVerifiersResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *ListVerifiersResponse")
 		}
 	}
-	if !easy && r.Intn(10) != 0 {
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *ListVerifiersResponse but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *ListVerifiersResponse but is not nil && this == nil")
 	}
-	return this
-}
-
-func NewPopulatedEmailProof(r randyClient, easy bool) *EmailProof {
-	this := &EmailProof{}
-	oneofNumber_ProofType := []int32{1, 2, 3}[r.Intn(3)]
-	switch oneofNumber_ProofType {
-	case 1:
-		this.ProofType = NewPopulatedEmailProof_DKIMProof(r, easy)
-	case 2:
-		this.ProofType = NewPopulatedEmailProof_OIDCToken(r, easy)
-	case 3:
-		this.ProofType = NewPopulatedEmailProof_SAMLResponse(r, easy)
+	if len(this.Verifiers) != len(that1.Verifiers) {
+		return fmt.Errorf("Verifiers this(%v) Not Equal that(%v)", len(this.Verifiers), len(that1.Verifiers))
 	}
-	if !easy && r.Intn(10) != 0 {
+	for i := range this.Verifiers {
+		if !this.Verifiers[i].Equal(that1.Verifiers[i]) {
+			return fmt.Errorf("Verifiers this[%v](%v) Not Equal that[%v](%v)", i, this.Verifiers[i], i, that1.Verifiers[i])
+		}
 	}
-	return this
+	return nil
 }
-
-func NewPopulatedEmailProof_DKIMProof(r randyClient, easy bool) *EmailProof_DKIMProof {
-	this := &EmailProof_DKIMProof{}
-	v33 := r.Intn(100)
-	this.DKIMProof = make([]byte, v33)
-	for i := 0; i < v33; i++ {
-		this.DKIMProof[i] = byte(r.Intn(256))
+func (this *ListVerifiersResponse) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
 	}
-	return this
-}
-func NewPopulatedEmailProof_OIDCToken(r randyClient, easy bool) *EmailProof_OIDCToken {
-	this := &EmailProof_OIDCToken{}
-	this.OIDCToken = randStringClient(r)
-	return this
-}
-func NewPopulatedEmailProof_SAMLResponse(r randyClient, easy bool) *EmailProof_SAMLResponse {
-	this := &EmailProof_SAMLResponse{}
-	this.SAMLResponse = randStringClient(r)
-	return this
-}
-
-type randyClient interface {
-	Float32() float32
-	Float64() float64
-	Int63() int64
-	Int31() int32
-	Uint32() uint32
-	Intn(n int) int
-}
 
-func randUTF8RuneClient(r randyClient) rune {
-	ru := r.Intn(62)
-	if ru < 10 {
-		return rune(ru + 48)
-	} else if ru < 36 {
-		return rune(ru + 55)
+	that1, ok := that.(*ListVerifiersResponse)
+	if !ok {
+		that2, ok := that.(ListVerifiersResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return rune(ru + 61)
-}
-func randStringClient(r randyClient) string {
-	v34 := r.Intn(100)
-	tmps := make([]rune, v34)
-	for i := 0; i < v34; i++ {
-		tmps[i] = randUTF8RuneClient(r)
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
 	}
-	return string(tmps)
-}
-func randUnrecognizedClient(r randyClient, maxFieldNumber int) (data []byte) {
-	l := r.Intn(5)
-	for i := 0; i < l; i++ {
-		wire := r.Intn(4)
-		if wire == 3 {
-			wire = 5
+	if len(this.Verifiers) != len(that1.Verifiers) {
+		return false
+	}
+	for i := range this.Verifiers {
+		if !this.Verifiers[i].Equal(that1.Verifiers[i]) {
+			return false
 		}
-		fieldNumber := maxFieldNumber + r.Intn(100)
-		data = randFieldClient(data, r, fieldNumber, wire)
 	}
-	return data
+	return true
 }
-func randFieldClient(data []byte, r randyClient, fieldNumber int, wire int) []byte {
-	key := uint32(fieldNumber)<<3 | uint32(wire)
-	switch wire {
-	case 0:
-		data = encodeVarintPopulateClient(data, uint64(key))
-		v35 := r.Int63()
-		if r.Intn(2) == 0 {
-			v35 *= -1
+func (this *VerifierInfo) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
 		}
-		data = encodeVarintPopulateClient(data, uint64(v35))
-	case 1:
-		data = encodeVarintPopulateClient(data, uint64(key))
-		data = append(data, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
-	case 2:
-		data = encodeVarintPopulateClient(data, uint64(key))
-		ll := r.Intn(100)
-		data = encodeVarintPopulateClient(data, uint64(ll))
-		for j := 0; j < ll; j++ {
-			data = append(data, byte(r.Intn(256)))
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*VerifierInfo)
+	if !ok {
+		that2, ok := that.(VerifierInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *VerifierInfo")
 		}
-	default:
-		data = encodeVarintPopulateClient(data, uint64(key))
-		data = append(data, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
 	}
-	return data
-}
-func encodeVarintPopulateClient(data []byte, v uint64) []byte {
-	for v >= 1<<7 {
-		data = append(data, uint8(uint64(v)&0x7f|0x80))
-		v >>= 7
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *VerifierInfo but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *VerifierInfo but is not nil && this == nil")
 	}
-	data = append(data, uint8(v))
-	return data
-}
-func (m *LookupRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.Epoch != 0 {
-		n += 1 + sovClient(uint64(m.Epoch))
+	if this.ID != that1.ID {
+		return fmt.Errorf("ID this(%v) Not Equal that(%v)", this.ID, that1.ID)
 	}
-	l = len(m.UserId)
-	if l > 0 {
-		n += 1 + l + sovClient(uint64(l))
+	if !this.PublicKey.Equal(that1.PublicKey) {
+		return fmt.Errorf("PublicKey this(%v) Not Equal that(%v)", this.PublicKey, that1.PublicKey)
 	}
-	if m.QuorumRequirement != nil {
-		l = m.QuorumRequirement.Size()
-		n += 1 + l + sovClient(uint64(l))
+	if this.LatestRatifiedEpoch != that1.LatestRatifiedEpoch {
+		return fmt.Errorf("LatestRatifiedEpoch this(%v) Not Equal that(%v)", this.LatestRatifiedEpoch, that1.LatestRatifiedEpoch)
 	}
-	return n
+	return nil
 }
+func (this *VerifierInfo) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
 
-func (m *UpdateRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.Update != nil {
-		l = m.Update.Size()
-		n += 1 + l + sovClient(uint64(l))
+	that1, ok := that.(*VerifierInfo)
+	if !ok {
+		that2, ok := that.(VerifierInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	l = m.Profile.Size()
-	n += 1 + l + sovClient(uint64(l))
-	if m.LookupParameters != nil {
-		l = m.LookupParameters.Size()
-		n += 1 + l + sovClient(uint64(l))
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
 	}
-	if m.EmailProof != nil {
-		l = m.EmailProof.Size()
-		n += 2 + l + sovClient(uint64(l))
+	if this.ID != that1.ID {
+		return false
 	}
-	return n
-}
-
-func (m *LookupProof) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.UserId)
-	if l > 0 {
-		n += 1 + l + sovClient(uint64(l))
+	if !this.PublicKey.Equal(that1.PublicKey) {
+		return false
 	}
-	l = len(m.Index)
-	if l > 0 {
-		n += 1 + l + sovClient(uint64(l))
+	if this.LatestRatifiedEpoch != that1.LatestRatifiedEpoch {
+		return false
 	}
-	l = len(m.IndexProof)
-	if l > 0 {
-		n += 1 + l + sovClient(uint64(l))
+	return true
+}
+func (this *LookupRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if len(m.Ratifications) > 0 {
-		for _, e := range m.Ratifications {
-			l = e.Size()
-			n += 1 + l + sovClient(uint64(l))
-		}
+	s := make([]string, 0, 8)
+	s = append(s, "&proto.LookupRequest{")
+	s = append(s, "Epoch: "+fmt.Sprintf("%#v", this.Epoch)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	if this.QuorumRequirement != nil {
+		s = append(s, "QuorumRequirement: "+fmt.Sprintf("%#v", this.QuorumRequirement)+",\n")
 	}
-	if m.TreeProof != nil {
-		l = m.TreeProof.Size()
-		n += 1 + l + sovClient(uint64(l))
+	s = append(s, "AllowPartialRatification: "+fmt.Sprintf("%#v", this.AllowPartialRatification)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *UpdateRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.Entry != nil {
-		l = m.Entry.Size()
-		n += 1 + l + sovClient(uint64(l))
+	s := make([]string, 0, 8)
+	s = append(s, "&proto.UpdateRequest{")
+	if this.Update != nil {
+		s = append(s, "Update: "+fmt.Sprintf("%#v", this.Update)+",\n")
 	}
-	if m.Profile != nil {
-		l = m.Profile.Size()
-		n += 1 + l + sovClient(uint64(l))
+	s = append(s, "Profile: "+strings.Replace(this.Profile.GoString(), `&`, ``, 1)+",\n")
+	if this.LookupParameters != nil {
+		s = append(s, "LookupParameters: "+fmt.Sprintf("%#v", this.LookupParameters)+",\n")
 	}
-	return n
+	if this.EmailProof != nil {
+		s = append(s, "EmailProof: "+fmt.Sprintf("%#v", this.EmailProof)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *TreeProof) Size() (n int) {
-	var l int
-	_ = l
-	if len(m.Neighbors) > 0 {
-		for _, b := range m.Neighbors {
-			l = len(b)
-			n += 1 + l + sovClient(uint64(l))
-		}
+func (this *LookupProof) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	l = len(m.ExistingIndex)
-	if l > 0 {
-		n += 1 + l + sovClient(uint64(l))
+	s := make([]string, 0, 13)
+	s = append(s, "&proto.LookupProof{")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "Index: "+fmt.Sprintf("%#v", this.Index)+",\n")
+	s = append(s, "IndexProof: "+fmt.Sprintf("%#v", this.IndexProof)+",\n")
+	if this.Ratifications != nil {
+		s = append(s, "Ratifications: "+fmt.Sprintf("%#v", this.Ratifications)+",\n")
 	}
-	l = len(m.ExistingEntryHash)
-	if l > 0 {
-		n += 1 + l + sovClient(uint64(l))
+	if this.TreeProof != nil {
+		s = append(s, "TreeProof: "+fmt.Sprintf("%#v", this.TreeProof)+",\n")
 	}
-	return n
+	if this.Entry != nil {
+		s = append(s, "Entry: "+fmt.Sprintf("%#v", this.Entry)+",\n")
+	}
+	if this.Profile != nil {
+		s = append(s, "Profile: "+fmt.Sprintf("%#v", this.Profile)+",\n")
+	}
+	if this.PendingRecovery != nil {
+		s = append(s, "PendingRecovery: "+fmt.Sprintf("%#v", this.PendingRecovery)+",\n")
+	}
+	s = append(s, "QuorumNotMet: "+fmt.Sprintf("%#v", this.QuorumNotMet)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *Entry) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Index)
-	if l > 0 {
-		n += 1 + l + sovClient(uint64(l))
+func (this *TreeProof) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.Version != 0 {
-		n += 1 + sovClient(uint64(m.Version))
+	s := make([]string, 0, 7)
+	s = append(s, "&proto.TreeProof{")
+	s = append(s, "Neighbors: "+fmt.Sprintf("%#v", this.Neighbors)+",\n")
+	s = append(s, "ExistingIndex: "+fmt.Sprintf("%#v", this.ExistingIndex)+",\n")
+	s = append(s, "ExistingEntryHash: "+fmt.Sprintf("%#v", this.ExistingEntryHash)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Entry) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.UpdatePolicy != nil {
-		l = m.UpdatePolicy.Size()
-		n += 1 + l + sovClient(uint64(l))
+	s := make([]string, 0, 10)
+	s = append(s, "&proto.Entry{")
+	s = append(s, "Index: "+fmt.Sprintf("%#v", this.Index)+",\n")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	if this.UpdatePolicy != nil {
+		s = append(s, "UpdatePolicy: "+fmt.Sprintf("%#v", this.UpdatePolicy)+",\n")
 	}
-	l = len(m.ProfileCommitment)
-	if l > 0 {
-		n += 1 + l + sovClient(uint64(l))
+	s = append(s, "ProfileCommitment: "+fmt.Sprintf("%#v", this.ProfileCommitment)+",\n")
+	if this.RecoveryPolicy != nil {
+		s = append(s, "RecoveryPolicy: "+fmt.Sprintf("%#v", this.RecoveryPolicy)+",\n")
 	}
-	return n
+	s = append(s, "Deleted: "+fmt.Sprintf("%#v", this.Deleted)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *SignedEntryUpdate) Size() (n int) {
-	var l int
-	_ = l
-	l = m.NewEntry.Size()
-	n += 1 + l + sovClient(uint64(l))
-	if len(m.Signatures) > 0 {
-		for k, v := range m.Signatures {
-			_ = k
-			_ = v
-			l = 0
-			if len(v) > 0 {
-				l = 1 + len(v) + sovClient(uint64(len(v)))
-			}
-			mapEntrySize := 1 + 8 + l
-			n += mapEntrySize + 1 + sovClient(uint64(mapEntrySize))
-		}
+func (this *RecoveryPolicy) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return n
+	s := make([]string, 0, 5)
+	s = append(s, "&proto.RecoveryPolicy{")
+	s = append(s, "DelaySeconds: "+fmt.Sprintf("%#v", this.DelaySeconds)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *Profile) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Nonce)
-	if l > 0 {
-		n += 1 + l + sovClient(uint64(l))
+func (this *PendingRecovery) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if len(m.Keys) > 0 {
-		for k, v := range m.Keys {
-			_ = k
-			_ = v
-			l = 0
-			if len(v) > 0 {
-				l = 1 + len(v) + sovClient(uint64(len(v)))
-			}
-			mapEntrySize := 1 + len(k) + sovClient(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovClient(uint64(mapEntrySize))
-		}
+	s := make([]string, 0, 6)
+	s = append(s, "&proto.PendingRecovery{")
+	if this.Update != nil {
+		s = append(s, "Update: "+fmt.Sprintf("%#v", this.Update)+",\n")
 	}
-	return n
-}
-
-func (m *SignedEpochHead) Size() (n int) {
-	var l int
-	_ = l
-	l = m.Head.Size()
-	n += 1 + l + sovClient(uint64(l))
-	if len(m.Signatures) > 0 {
-		for k, v := range m.Signatures {
-			_ = k
-			_ = v
-			l = 0
-			if len(v) > 0 {
-				l = 1 + len(v) + sovClient(uint64(len(v)))
-			}
-			mapEntrySize := 1 + 8 + l
-			n += mapEntrySize + 1 + sovClient(uint64(mapEntrySize))
-		}
-	}
-	return n
-}
-
-func (m *TimestampedEpochHead) Size() (n int) {
-	var l int
-	_ = l
-	l = m.Head.Size()
-	n += 1 + l + sovClient(uint64(l))
-	l = m.Timestamp.Size()
-	n += 1 + l + sovClient(uint64(l))
-	return n
+	s = append(s, "NotBefore: "+strings.Replace(this.NotBefore.GoString(), `&`, ``, 1)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *EpochHead) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Realm)
-	if l > 0 {
-		n += 1 + l + sovClient(uint64(l))
+func (this *RecoveryVeto) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.Epoch != 0 {
-		n += 1 + sovClient(uint64(m.Epoch))
+	s := make([]string, 0, 7)
+	s = append(s, "&proto.RecoveryVeto{")
+	s = append(s, "Index: "+fmt.Sprintf("%#v", this.Index)+",\n")
+	s = append(s, "EntryHash: "+fmt.Sprintf("%#v", this.EntryHash)+",\n")
+	keysForSignatures := make([]uint64, 0, len(this.Signatures))
+	for k, _ := range this.Signatures {
+		keysForSignatures = append(keysForSignatures, k)
 	}
-	l = len(m.RootHash)
-	if l > 0 {
-		n += 1 + l + sovClient(uint64(l))
+	github_com_maditya_protobuf_sortkeys.Uint64s(keysForSignatures)
+	mapStringForSignatures := "map[uint64][]byte{"
+	for _, k := range keysForSignatures {
+		mapStringForSignatures += fmt.Sprintf("%#v: %#v,", k, this.Signatures[k])
 	}
-	l = m.IssueTime.Size()
-	n += 1 + l + sovClient(uint64(l))
-	l = len(m.PreviousSummaryHash)
-	if l > 0 {
-		n += 1 + l + sovClient(uint64(l))
+	mapStringForSignatures += "}"
+	if this.Signatures != nil {
+		s = append(s, "Signatures: "+mapStringForSignatures+",\n")
 	}
-	l = m.NextEpochPolicy.Size()
-	n += 1 + l + sovClient(uint64(l))
-	return n
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *AuthorizationPolicy) Size() (n int) {
-	var l int
-	_ = l
-	if len(m.PublicKeys) > 0 {
-		for k, v := range m.PublicKeys {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovClient(uint64(l))
-			}
-			mapEntrySize := 1 + 8 + l
-			n += mapEntrySize + 1 + sovClient(uint64(mapEntrySize))
-		}
+func (this *SignedEntryUpdate) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.PolicyType != nil {
-		n += m.PolicyType.Size()
+	s := make([]string, 0, 6)
+	s = append(s, "&proto.SignedEntryUpdate{")
+	s = append(s, "NewEntry: "+strings.Replace(this.NewEntry.GoString(), `&`, ``, 1)+",\n")
+	keysForSignatures := make([]uint64, 0, len(this.Signatures))
+	for k, _ := range this.Signatures {
+		keysForSignatures = append(keysForSignatures, k)
 	}
-	return n
-}
-
-func (m *AuthorizationPolicy_Quorum) Size() (n int) {
-	var l int
-	_ = l
-	if m.Quorum != nil {
-		l = m.Quorum.Size()
-		n += 1 + l + sovClient(uint64(l))
+	github_com_maditya_protobuf_sortkeys.Uint64s(keysForSignatures)
+	mapStringForSignatures := "map[uint64][]byte{"
+	for _, k := range keysForSignatures {
+		mapStringForSignatures += fmt.Sprintf("%#v: %#v,", k, this.Signatures[k])
 	}
-	return n
-}
-func (m *PublicKey) Size() (n int) {
-	var l int
-	_ = l
-	if m.PubkeyType != nil {
-		n += m.PubkeyType.Size()
+	mapStringForSignatures += "}"
+	if this.Signatures != nil {
+		s = append(s, "Signatures: "+mapStringForSignatures+",\n")
 	}
-	return n
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *PublicKey_Ed25519) Size() (n int) {
-	var l int
-	_ = l
-	if m.Ed25519 != nil {
-		l = len(m.Ed25519)
-		n += 1 + l + sovClient(uint64(l))
+func (this *Profile) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return n
-}
-func (m *QuorumExpr) Size() (n int) {
-	var l int
-	_ = l
-	if m.Threshold != 0 {
-		n += 1 + sovClient(uint64(m.Threshold))
+	s := make([]string, 0, 6)
+	s = append(s, "&proto.Profile{")
+	s = append(s, "Nonce: "+fmt.Sprintf("%#v", this.Nonce)+",\n")
+	keysForKeys := make([]string, 0, len(this.Keys))
+	for k, _ := range this.Keys {
+		keysForKeys = append(keysForKeys, k)
 	}
-	if len(m.Candidates) > 0 {
-		n += 1 + sovClient(uint64(len(m.Candidates)*8)) + len(m.Candidates)*8
+	github_com_maditya_protobuf_sortkeys.Strings(keysForKeys)
+	mapStringForKeys := "map[string][]byte{"
+	for _, k := range keysForKeys {
+		mapStringForKeys += fmt.Sprintf("%#v: %#v,", k, this.Keys[k])
 	}
-	if len(m.Subexpressions) > 0 {
-		for _, e := range m.Subexpressions {
-			l = e.Size()
-			n += 1 + l + sovClient(uint64(l))
-		}
+	mapStringForKeys += "}"
+	if this.Keys != nil {
+		s = append(s, "Keys: "+mapStringForKeys+",\n")
 	}
-	return n
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *EmailProof) Size() (n int) {
-	var l int
-	_ = l
-	if m.ProofType != nil {
-		n += m.ProofType.Size()
+func (this *SignedEpochHead) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return n
-}
-
-func (m *EmailProof_DKIMProof) Size() (n int) {
-	var l int
-	_ = l
-	if m.DKIMProof != nil {
-		l = len(m.DKIMProof)
-		n += 1 + l + sovClient(uint64(l))
+	s := make([]string, 0, 6)
+	s = append(s, "&proto.SignedEpochHead{")
+	s = append(s, "Head: "+strings.Replace(this.Head.GoString(), `&`, ``, 1)+",\n")
+	keysForSignatures := make([]uint64, 0, len(this.Signatures))
+	for k, _ := range this.Signatures {
+		keysForSignatures = append(keysForSignatures, k)
 	}
-	return n
-}
-func (m *EmailProof_OIDCToken) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.OIDCToken)
-	n += 1 + l + sovClient(uint64(l))
-	return n
-}
-func (m *EmailProof_SAMLResponse) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.SAMLResponse)
-	n += 1 + l + sovClient(uint64(l))
-	return n
-}
-
-func sovClient(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
+	github_com_maditya_protobuf_sortkeys.Uint64s(keysForSignatures)
+	mapStringForSignatures := "map[uint64][]byte{"
+	for _, k := range keysForSignatures {
+		mapStringForSignatures += fmt.Sprintf("%#v: %#v,", k, this.Signatures[k])
 	}
-	return n
-}
-func sozClient(x uint64) (n int) {
-	return sovClient(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (this *LookupRequest) String() string {
-	if this == nil {
-		return "nil"
+	mapStringForSignatures += "}"
+	if this.Signatures != nil {
+		s = append(s, "Signatures: "+mapStringForSignatures+",\n")
 	}
-	s := strings.Join([]string{`&LookupRequest{`,
-		`Epoch:` + fmt.Sprintf("%v", this.Epoch) + `,`,
-		`UserId:` + fmt.Sprintf("%v", this.UserId) + `,`,
-		`QuorumRequirement:` + strings.Replace(fmt.Sprintf("%v", this.QuorumRequirement), "QuorumExpr", "QuorumExpr", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (this *UpdateRequest) String() string {
+func (this *TimestampedEpochHead) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&UpdateRequest{`,
-		`Update:` + strings.Replace(fmt.Sprintf("%v", this.Update), "SignedEntryUpdate", "SignedEntryUpdate", 1) + `,`,
-		`Profile:` + strings.Replace(strings.Replace(this.Profile.String(), "Profile", "Profile", 1), `&`, ``, 1) + `,`,
-		`LookupParameters:` + strings.Replace(fmt.Sprintf("%v", this.LookupParameters), "LookupRequest", "LookupRequest", 1) + `,`,
-		`EmailProof:` + strings.Replace(fmt.Sprintf("%v", this.EmailProof), "EmailProof", "EmailProof", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	s := make([]string, 0, 7)
+	s = append(s, "&proto.TimestampedEpochHead{")
+	s = append(s, "Head: "+strings.Replace(this.Head.GoString(), `&`, ``, 1)+",\n")
+	s = append(s, "Timestamp: "+strings.Replace(this.Timestamp.GoString(), `&`, ``, 1)+",\n")
+	s = append(s, "Refresh: "+fmt.Sprintf("%#v", this.Refresh)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (this *LookupProof) String() string {
+func (this *EpochHead) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&LookupProof{`,
-		`UserId:` + fmt.Sprintf("%v", this.UserId) + `,`,
-		`Index:` + fmt.Sprintf("%v", this.Index) + `,`,
-		`IndexProof:` + fmt.Sprintf("%v", this.IndexProof) + `,`,
-		`Ratifications:` + strings.Replace(fmt.Sprintf("%v", this.Ratifications), "SignedEpochHead", "SignedEpochHead", 1) + `,`,
-		`TreeProof:` + strings.Replace(fmt.Sprintf("%v", this.TreeProof), "TreeProof", "TreeProof", 1) + `,`,
-		`Entry:` + strings.Replace(fmt.Sprintf("%v", this.Entry), "Entry", "Entry", 1) + `,`,
-		`Profile:` + strings.Replace(fmt.Sprintf("%v", this.Profile), "Profile", "Profile", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	s := make([]string, 0, 12)
+	s = append(s, "&proto.EpochHead{")
+	s = append(s, "Realm: "+fmt.Sprintf("%#v", this.Realm)+",\n")
+	s = append(s, "Epoch: "+fmt.Sprintf("%#v", this.Epoch)+",\n")
+	s = append(s, "RootHash: "+fmt.Sprintf("%#v", this.RootHash)+",\n")
+	s = append(s, "IssueTime: "+strings.Replace(this.IssueTime.GoString(), `&`, ``, 1)+",\n")
+	s = append(s, "PreviousSummaryHash: "+fmt.Sprintf("%#v", this.PreviousSummaryHash)+",\n")
+	s = append(s, "NextEpochPolicy: "+strings.Replace(this.NextEpochPolicy.GoString(), `&`, ``, 1)+",\n")
+	s = append(s, "VRFPublic: "+fmt.Sprintf("%#v", this.VRFPublic)+",\n")
+	if this.VRFRotationTime != nil {
+		s = append(s, "VRFRotationTime: "+fmt.Sprintf("%#v", this.VRFRotationTime)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (this *TreeProof) String() string {
+func (this *VRFRotation) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&TreeProof{`,
-		`Neighbors:` + fmt.Sprintf("%v", this.Neighbors) + `,`,
-		`ExistingIndex:` + fmt.Sprintf("%v", this.ExistingIndex) + `,`,
-		`ExistingEntryHash:` + fmt.Sprintf("%v", this.ExistingEntryHash) + `,`,
-		`}`,
-	}, "")
-	return s
+	s := make([]string, 0, 7)
+	s = append(s, "&proto.VRFRotation{")
+	s = append(s, "VRFPublic: "+fmt.Sprintf("%#v", this.VRFPublic)+",\n")
+	if this.Moves != nil {
+		s = append(s, "Moves: "+fmt.Sprintf("%#v", this.Moves)+",\n")
+	}
+	s = append(s, "More: "+fmt.Sprintf("%#v", this.More)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (this *Entry) String() string {
+func (this *VRFMove) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&Entry{`,
-		`Index:` + fmt.Sprintf("%v", this.Index) + `,`,
-		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
-		`UpdatePolicy:` + strings.Replace(fmt.Sprintf("%v", this.UpdatePolicy), "AuthorizationPolicy", "AuthorizationPolicy", 1) + `,`,
-		`ProfileCommitment:` + fmt.Sprintf("%v", this.ProfileCommitment) + `,`,
-		`}`,
-	}, "")
-	return s
+	s := make([]string, 0, 9)
+	s = append(s, "&proto.VRFMove{")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "OldIndex: "+fmt.Sprintf("%#v", this.OldIndex)+",\n")
+	s = append(s, "OldIndexProof: "+fmt.Sprintf("%#v", this.OldIndexProof)+",\n")
+	s = append(s, "NewIndex: "+fmt.Sprintf("%#v", this.NewIndex)+",\n")
+	s = append(s, "NewIndexProof: "+fmt.Sprintf("%#v", this.NewIndexProof)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (this *SignedEntryUpdate) String() string {
+func (this *AuthorizationPolicy) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	keysForSignatures := make([]uint64, 0, len(this.Signatures))
-	for k, _ := range this.Signatures {
-		keysForSignatures = append(keysForSignatures, k)
+	s := make([]string, 0, 6)
+	s = append(s, "&proto.AuthorizationPolicy{")
+	keysForPublicKeys := make([]uint64, 0, len(this.PublicKeys))
+	for k, _ := range this.PublicKeys {
+		keysForPublicKeys = append(keysForPublicKeys, k)
 	}
-	github_com_maditya_protobuf_sortkeys.Uint64s(keysForSignatures)
-	mapStringForSignatures := "map[uint64][]byte{"
-	for _, k := range keysForSignatures {
-		mapStringForSignatures += fmt.Sprintf("%v: %v,", k, this.Signatures[k])
+	github_com_maditya_protobuf_sortkeys.Uint64s(keysForPublicKeys)
+	mapStringForPublicKeys := "map[uint64]*PublicKey{"
+	for _, k := range keysForPublicKeys {
+		mapStringForPublicKeys += fmt.Sprintf("%#v: %#v,", k, this.PublicKeys[k])
 	}
-	mapStringForSignatures += "}"
-	s := strings.Join([]string{`&SignedEntryUpdate{`,
-		`NewEntry:` + strings.Replace(strings.Replace(this.NewEntry.String(), "Entry", "Entry", 1), `&`, ``, 1) + `,`,
-		`Signatures:` + mapStringForSignatures + `,`,
-		`}`,
-	}, "")
-	return s
+	mapStringForPublicKeys += "}"
+	if this.PublicKeys != nil {
+		s = append(s, "PublicKeys: "+mapStringForPublicKeys+",\n")
+	}
+	if this.PolicyType != nil {
+		s = append(s, "PolicyType: "+fmt.Sprintf("%#v", this.PolicyType)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (this *Profile) String() string {
+func (this *AuthorizationPolicy_Quorum) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	keysForKeys := make([]string, 0, len(this.Keys))
-	for k, _ := range this.Keys {
-		keysForKeys = append(keysForKeys, k)
+	s := strings.Join([]string{`&proto.AuthorizationPolicy_Quorum{` +
+		`Quorum:` + fmt.Sprintf("%#v", this.Quorum) + `}`}, ", ")
+	return s
+}
+func (this *DomainAdminPolicy) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	github_com_maditya_protobuf_sortkeys.Strings(keysForKeys)
-	mapStringForKeys := "map[string][]byte{"
-	for _, k := range keysForKeys {
-		mapStringForKeys += fmt.Sprintf("%v: %v,", k, this.Keys[k])
+	s := make([]string, 0, 6)
+	s = append(s, "&proto.DomainAdminPolicy{")
+	s = append(s, "Domains: "+fmt.Sprintf("%#v", this.Domains)+",\n")
+	if this.Policy != nil {
+		s = append(s, "Policy: "+fmt.Sprintf("%#v", this.Policy)+",\n")
 	}
-	mapStringForKeys += "}"
-	s := strings.Join([]string{`&Profile{`,
-		`Nonce:` + fmt.Sprintf("%v", this.Nonce) + `,`,
-		`Keys:` + mapStringForKeys + `,`,
-		`}`,
-	}, "")
-	return s
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (this *SignedEpochHead) String() string {
+func (this *PublicKey) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	keysForSignatures := make([]uint64, 0, len(this.Signatures))
-	for k, _ := range this.Signatures {
-		keysForSignatures = append(keysForSignatures, k)
+	s := make([]string, 0, 7)
+	s = append(s, "&proto.PublicKey{")
+	if this.PubkeyType != nil {
+		s = append(s, "PubkeyType: "+fmt.Sprintf("%#v", this.PubkeyType)+",\n")
 	}
-	github_com_maditya_protobuf_sortkeys.Uint64s(keysForSignatures)
-	mapStringForSignatures := "map[uint64][]byte{"
-	for _, k := range keysForSignatures {
-		mapStringForSignatures += fmt.Sprintf("%v: %v,", k, this.Signatures[k])
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PublicKey_Ed25519) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	mapStringForSignatures += "}"
-	s := strings.Join([]string{`&SignedEpochHead{`,
-		`Head:` + strings.Replace(strings.Replace(this.Head.String(), "TimestampedEpochHead", "TimestampedEpochHead", 1), `&`, ``, 1) + `,`,
-		`Signatures:` + mapStringForSignatures + `,`,
-		`}`,
-	}, "")
+	s := strings.Join([]string{`&proto.PublicKey_Ed25519{` +
+		`Ed25519:` + fmt.Sprintf("%#v", this.Ed25519) + `}`}, ", ")
 	return s
 }
-func (this *TimestampedEpochHead) String() string {
+func (this *PublicKey_ECDSAP256SHA256) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&TimestampedEpochHead{`,
-		`Head:` + strings.Replace(strings.Replace(this.Head.String(), "EpochHead", "EpochHead", 1), `&`, ``, 1) + `,`,
-		`Timestamp:` + strings.Replace(strings.Replace(this.Timestamp.String(), "Timestamp", "Timestamp", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
+	s := strings.Join([]string{`&proto.PublicKey_ECDSAP256SHA256{` +
+		`ECDSAP256SHA256:` + fmt.Sprintf("%#v", this.ECDSAP256SHA256) + `}`}, ", ")
 	return s
 }
-func (this *EpochHead) String() string {
+func (this *PublicKey_RSAPSSSHA256) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&EpochHead{`,
-		`Realm:` + fmt.Sprintf("%v", this.Realm) + `,`,
-		`Epoch:` + fmt.Sprintf("%v", this.Epoch) + `,`,
-		`RootHash:` + fmt.Sprintf("%v", this.RootHash) + `,`,
-		`IssueTime:` + strings.Replace(strings.Replace(this.IssueTime.String(), "Timestamp", "Timestamp", 1), `&`, ``, 1) + `,`,
-		`PreviousSummaryHash:` + fmt.Sprintf("%v", this.PreviousSummaryHash) + `,`,
-		`NextEpochPolicy:` + strings.Replace(strings.Replace(this.NextEpochPolicy.String(), "AuthorizationPolicy", "AuthorizationPolicy", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
+	s := strings.Join([]string{`&proto.PublicKey_RSAPSSSHA256{` +
+		`RSAPSSSHA256:` + fmt.Sprintf("%#v", this.RSAPSSSHA256) + `}`}, ", ")
 	return s
 }
-func (this *AuthorizationPolicy) String() string {
+func (this *QuorumExpr) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	keysForPublicKeys := make([]uint64, 0, len(this.PublicKeys))
-	for k, _ := range this.PublicKeys {
-		keysForPublicKeys = append(keysForPublicKeys, k)
+	s := make([]string, 0, 9)
+	s = append(s, "&proto.QuorumExpr{")
+	s = append(s, "Threshold: "+fmt.Sprintf("%#v", this.Threshold)+",\n")
+	s = append(s, "Candidates: "+fmt.Sprintf("%#v", this.Candidates)+",\n")
+	if this.Subexpressions != nil {
+		s = append(s, "Subexpressions: "+fmt.Sprintf("%#v", this.Subexpressions)+",\n")
 	}
-	github_com_maditya_protobuf_sortkeys.Uint64s(keysForPublicKeys)
-	mapStringForPublicKeys := "map[uint64]*PublicKey{"
-	for _, k := range keysForPublicKeys {
-		mapStringForPublicKeys += fmt.Sprintf("%v: %v,", k, this.PublicKeys[k])
+	if this.WeightedCandidates != nil {
+		s = append(s, "WeightedCandidates: "+fmt.Sprintf("%#v", this.WeightedCandidates)+",\n")
 	}
-	mapStringForPublicKeys += "}"
-	s := strings.Join([]string{`&AuthorizationPolicy{`,
-		`PublicKeys:` + mapStringForPublicKeys + `,`,
-		`PolicyType:` + fmt.Sprintf("%v", this.PolicyType) + `,`,
-		`}`,
-	}, "")
-	return s
+	s = append(s, "Op: "+fmt.Sprintf("%#v", this.Op)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (this *AuthorizationPolicy_Quorum) String() string {
+func (this *WeightedCandidate) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&AuthorizationPolicy_Quorum{`,
-		`Quorum:` + strings.Replace(fmt.Sprintf("%v", this.Quorum), "QuorumExpr", "QuorumExpr", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	s := make([]string, 0, 6)
+	s = append(s, "&proto.WeightedCandidate{")
+	s = append(s, "ID: "+fmt.Sprintf("%#v", this.ID)+",\n")
+	s = append(s, "Weight: "+fmt.Sprintf("%#v", this.Weight)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (this *PublicKey) String() string {
+func (this *EmailProof) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&PublicKey{`,
-		`PubkeyType:` + fmt.Sprintf("%v", this.PubkeyType) + `,`,
-		`}`,
-	}, "")
-	return s
+	s := make([]string, 0, 10)
+	s = append(s, "&proto.EmailProof{")
+	if this.ProofType != nil {
+		s = append(s, "ProofType: "+fmt.Sprintf("%#v", this.ProofType)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (this *PublicKey_Ed25519) String() string {
+func (this *EmailProof_DKIMProof) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&PublicKey_Ed25519{`,
-		`Ed25519:` + fmt.Sprintf("%v", this.Ed25519) + `,`,
-		`}`,
-	}, "")
+	s := strings.Join([]string{`&proto.EmailProof_DKIMProof{` +
+		`DKIMProof:` + fmt.Sprintf("%#v", this.DKIMProof) + `}`}, ", ")
 	return s
 }
-func (this *QuorumExpr) String() string {
+func (this *EmailProof_OIDCToken) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&QuorumExpr{`,
-		`Threshold:` + fmt.Sprintf("%v", this.Threshold) + `,`,
-		`Candidates:` + fmt.Sprintf("%v", this.Candidates) + `,`,
-		`Subexpressions:` + strings.Replace(fmt.Sprintf("%v", this.Subexpressions), "QuorumExpr", "QuorumExpr", 1) + `,`,
-		`}`,
-	}, "")
+	s := strings.Join([]string{`&proto.EmailProof_OIDCToken{` +
+		`OIDCToken:` + fmt.Sprintf("%#v", this.OIDCToken) + `}`}, ", ")
 	return s
 }
-func (this *EmailProof) String() string {
+func (this *EmailProof_SAMLResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&EmailProof{`,
-		`ProofType:` + fmt.Sprintf("%v", this.ProofType) + `,`,
-		`}`,
-	}, "")
+	s := strings.Join([]string{`&proto.EmailProof_SAMLResponse{` +
+		`SAMLResponse:` + fmt.Sprintf("%#v", this.SAMLResponse) + `}`}, ", ")
 	return s
 }
-func (this *EmailProof_DKIMProof) String() string {
+func (this *EmailProof_ChallengeCode) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&EmailProof_DKIMProof{`,
-		`DKIMProof:` + fmt.Sprintf("%v", this.DKIMProof) + `,`,
-		`}`,
-	}, "")
+	s := strings.Join([]string{`&proto.EmailProof_ChallengeCode{` +
+		`ChallengeCode:` + fmt.Sprintf("%#v", this.ChallengeCode) + `}`}, ", ")
 	return s
 }
-func (this *EmailProof_OIDCToken) String() string {
+func (this *EmailProof_ClientCert) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&EmailProof_OIDCToken{`,
-		`OIDCToken:` + fmt.Sprintf("%v", this.OIDCToken) + `,`,
-		`}`,
-	}, "")
+	s := strings.Join([]string{`&proto.EmailProof_ClientCert{` +
+		`ClientCert:` + fmt.Sprintf("%#v", this.ClientCert) + `}`}, ", ")
 	return s
 }
-func (this *EmailProof_SAMLResponse) String() string {
+func (this *EmailProof_External) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&EmailProof_SAMLResponse{`,
-		`SAMLResponse:` + fmt.Sprintf("%v", this.SAMLResponse) + `,`,
-		`}`,
-	}, "")
+	s := strings.Join([]string{`&proto.EmailProof_External{` +
+		`External:` + fmt.Sprintf("%#v", this.External) + `}`}, ", ")
 	return s
 }
-func valueToStringClient(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
+func (this *ExternalProof) GoString() string {
+	if this == nil {
 		return "nil"
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	s := make([]string, 0, 6)
+	s = append(s, "&proto.ExternalProof{")
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	s = append(s, "Proof: "+fmt.Sprintf("%#v", this.Proof)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (m *LookupRequest) Unmarshal(data []byte) error {
-	l := len(data)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowClient
+func (this *ClientCertProof) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&proto.ClientCertProof{")
+	s = append(s, "Certificates: "+fmt.Sprintf("%#v", this.Certificates)+",\n")
+	s = append(s, "Signature: "+fmt.Sprintf("%#v", this.Signature)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *EmailChallengeRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&proto.EmailChallengeRequest{")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "EntryHash: "+fmt.Sprintf("%#v", this.EntryHash)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *EmailChallengeResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&proto.EmailChallengeResponse{")
+	s = append(s, "Expiration: "+strings.Replace(this.Expiration.GoString(), `&`, ``, 1)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ListVerifiersRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&proto.ListVerifiersRequest{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ListVerifiersResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&proto.ListVerifiersResponse{")
+	if this.Verifiers != nil {
+		s = append(s, "Verifiers: "+fmt.Sprintf("%#v", this.Verifiers)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *VerifierInfo) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&proto.VerifierInfo{")
+	s = append(s, "ID: "+fmt.Sprintf("%#v", this.ID)+",\n")
+	if this.PublicKey != nil {
+		s = append(s, "PublicKey: "+fmt.Sprintf("%#v", this.PublicKey)+",\n")
+	}
+	s = append(s, "LatestRatifiedEpoch: "+fmt.Sprintf("%#v", this.LatestRatifiedEpoch)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringClient(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func extensionToGoStringClient(m github_com_maditya_protobuf_proto.Message) string {
+	e := github_com_maditya_protobuf_proto.GetUnsafeExtensionsMap(m)
+	if e == nil {
+		return "nil"
+	}
+	s := "proto.NewUnsafeXXX_InternalExtensions(map[int32]proto.Extension{"
+	keys := make([]int, 0, len(e))
+	for k := range e {
+		keys = append(keys, int(k))
+	}
+	sort.Ints(keys)
+	ss := []string{}
+	for _, k := range keys {
+		ss = append(ss, strconv.Itoa(k)+": "+e[int32(k)].GoString())
+	}
+	s += strings.Join(ss, ",") + "})"
+	return s
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for E2EKSPublic service
+
+type E2EKSPublicClient interface {
+	Lookup(ctx context.Context, in *LookupRequest, opts ...grpc.CallOption) (*LookupProof, error)
+	Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*LookupProof, error)
+	RequestEmailChallenge(ctx context.Context, in *EmailChallengeRequest, opts ...grpc.CallOption) (*EmailChallengeResponse, error)
+	StartRecovery(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*PendingRecovery, error)
+	VetoRecovery(ctx context.Context, in *RecoveryVeto, opts ...grpc.CallOption) (*PendingRecovery, error)
+	// ListVerifiers returns the verifiers of the realm known to the
+	// keyserver, so that clients can choose the quorum_requirement of their
+	// lookups.
+	ListVerifiers(ctx context.Context, in *ListVerifiersRequest, opts ...grpc.CallOption) (*ListVerifiersResponse, error)
+}
+
+type e2EKSPublicClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewE2EKSPublicClient(cc *grpc.ClientConn) E2EKSPublicClient {
+	return &e2EKSPublicClient{cc}
+}
+
+func (c *e2EKSPublicClient) Lookup(ctx context.Context, in *LookupRequest, opts ...grpc.CallOption) (*LookupProof, error) {
+	out := new(LookupProof)
+	err := grpc.Invoke(ctx, "/proto.E2EKSPublic/Lookup", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *e2EKSPublicClient) Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*LookupProof, error) {
+	out := new(LookupProof)
+	err := grpc.Invoke(ctx, "/proto.E2EKSPublic/Update", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *e2EKSPublicClient) RequestEmailChallenge(ctx context.Context, in *EmailChallengeRequest, opts ...grpc.CallOption) (*EmailChallengeResponse, error) {
+	out := new(EmailChallengeResponse)
+	err := grpc.Invoke(ctx, "/proto.E2EKSPublic/RequestEmailChallenge", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *e2EKSPublicClient) StartRecovery(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*PendingRecovery, error) {
+	out := new(PendingRecovery)
+	err := grpc.Invoke(ctx, "/proto.E2EKSPublic/StartRecovery", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *e2EKSPublicClient) VetoRecovery(ctx context.Context, in *RecoveryVeto, opts ...grpc.CallOption) (*PendingRecovery, error) {
+	out := new(PendingRecovery)
+	err := grpc.Invoke(ctx, "/proto.E2EKSPublic/VetoRecovery", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *e2EKSPublicClient) ListVerifiers(ctx context.Context, in *ListVerifiersRequest, opts ...grpc.CallOption) (*ListVerifiersResponse, error) {
+	out := new(ListVerifiersResponse)
+	err := grpc.Invoke(ctx, "/proto.E2EKSPublic/ListVerifiers", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for E2EKSPublic service
+
+type E2EKSPublicServer interface {
+	Lookup(context.Context, *LookupRequest) (*LookupProof, error)
+	Update(context.Context, *UpdateRequest) (*LookupProof, error)
+	RequestEmailChallenge(context.Context, *EmailChallengeRequest) (*EmailChallengeResponse, error)
+	StartRecovery(context.Context, *UpdateRequest) (*PendingRecovery, error)
+	VetoRecovery(context.Context, *RecoveryVeto) (*PendingRecovery, error)
+	// ListVerifiers returns the verifiers of the realm known to the
+	// keyserver, so that clients can choose the quorum_requirement of their
+	// lookups.
+	ListVerifiers(context.Context, *ListVerifiersRequest) (*ListVerifiersResponse, error)
+}
+
+func RegisterE2EKSPublicServer(s *grpc.Server, srv E2EKSPublicServer) {
+	s.RegisterService(&_E2EKSPublic_serviceDesc, srv)
+}
+
+func _E2EKSPublic_Lookup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LookupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(E2EKSPublicServer).Lookup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.E2EKSPublic/Lookup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(E2EKSPublicServer).Lookup(ctx, req.(*LookupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _E2EKSPublic_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(E2EKSPublicServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.E2EKSPublic/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(E2EKSPublicServer).Update(ctx, req.(*UpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _E2EKSPublic_RequestEmailChallenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmailChallengeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(E2EKSPublicServer).RequestEmailChallenge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.E2EKSPublic/RequestEmailChallenge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(E2EKSPublicServer).RequestEmailChallenge(ctx, req.(*EmailChallengeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _E2EKSPublic_StartRecovery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(E2EKSPublicServer).StartRecovery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.E2EKSPublic/StartRecovery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(E2EKSPublicServer).StartRecovery(ctx, req.(*UpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _E2EKSPublic_VetoRecovery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecoveryVeto)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(E2EKSPublicServer).VetoRecovery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.E2EKSPublic/VetoRecovery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(E2EKSPublicServer).VetoRecovery(ctx, req.(*RecoveryVeto))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _E2EKSPublic_ListVerifiers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVerifiersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(E2EKSPublicServer).ListVerifiers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.E2EKSPublic/ListVerifiers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(E2EKSPublicServer).ListVerifiers(ctx, req.(*ListVerifiersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _E2EKSPublic_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.E2EKSPublic",
+	HandlerType: (*E2EKSPublicServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Lookup",
+			Handler:    _E2EKSPublic_Lookup_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _E2EKSPublic_Update_Handler,
+		},
+		{
+			MethodName: "RequestEmailChallenge",
+			Handler:    _E2EKSPublic_RequestEmailChallenge_Handler,
+		},
+		{
+			MethodName: "StartRecovery",
+			Handler:    _E2EKSPublic_StartRecovery_Handler,
+		},
+		{
+			MethodName: "VetoRecovery",
+			Handler:    _E2EKSPublic_VetoRecovery_Handler,
+		},
+		{
+			MethodName: "ListVerifiers",
+			Handler:    _E2EKSPublic_ListVerifiers_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: fileDescriptorClient,
+}
+
+func (m *LookupRequest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *LookupRequest) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		data[i] = 0x8
+		i++
+		i = encodeVarintClient(data, i, uint64(m.Epoch))
+	}
+	if len(m.UserId) > 0 {
+		data[i] = 0x12
+		i++
+		i = encodeVarintClient(data, i, uint64(len(m.UserId)))
+		i += copy(data[i:], m.UserId)
+	}
+	if m.QuorumRequirement != nil {
+		data[i] = 0x22
+		i++
+		i = encodeVarintClient(data, i, uint64(m.QuorumRequirement.Size()))
+		n1, err := m.QuorumRequirement.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	if m.AllowPartialRatification {
+		data[i] = 0x28
+		i++
+		if m.AllowPartialRatification {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *UpdateRequest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *UpdateRequest) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Update != nil {
+		data[i] = 0xa
+		i++
+		i = encodeVarintClient(data, i, uint64(m.Update.Size()))
+		n2, err := m.Update.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	data[i] = 0x12
+	i++
+	i = encodeVarintClient(data, i, uint64(m.Profile.Size()))
+	n3, err := m.Profile.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n3
+	if m.LookupParameters != nil {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintClient(data, i, uint64(m.LookupParameters.Size()))
+		n4, err := m.LookupParameters.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	if m.EmailProof != nil {
+		data[i] = 0xc2
+		i++
+		data[i] = 0x3e
+		i++
+		i = encodeVarintClient(data, i, uint64(m.EmailProof.Size()))
+		n5, err := m.EmailProof.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	return i, nil
+}
+
+func (m *LookupProof) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *LookupProof) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.UserId) > 0 {
+		data[i] = 0xa
+		i++
+		i = encodeVarintClient(data, i, uint64(len(m.UserId)))
+		i += copy(data[i:], m.UserId)
+	}
+	if len(m.Index) > 0 {
+		data[i] = 0x12
+		i++
+		i = encodeVarintClient(data, i, uint64(len(m.Index)))
+		i += copy(data[i:], m.Index)
+	}
+	if len(m.IndexProof) > 0 {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintClient(data, i, uint64(len(m.IndexProof)))
+		i += copy(data[i:], m.IndexProof)
+	}
+	if len(m.Ratifications) > 0 {
+		for _, msg := range m.Ratifications {
+			data[i] = 0x22
+			i++
+			i = encodeVarintClient(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.TreeProof != nil {
+		data[i] = 0x2a
+		i++
+		i = encodeVarintClient(data, i, uint64(m.TreeProof.Size()))
+		n6, err := m.TreeProof.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
+	if m.Entry != nil {
+		data[i] = 0x32
+		i++
+		i = encodeVarintClient(data, i, uint64(m.Entry.Size()))
+		n7, err := m.Entry.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n7
+	}
+	if m.Profile != nil {
+		data[i] = 0x3a
+		i++
+		i = encodeVarintClient(data, i, uint64(m.Profile.Size()))
+		n8, err := m.Profile.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n8
+	}
+	if m.PendingRecovery != nil {
+		data[i] = 0x42
+		i++
+		i = encodeVarintClient(data, i, uint64(m.PendingRecovery.Size()))
+		n9, err := m.PendingRecovery.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n9
+	}
+	if m.QuorumNotMet {
+		data[i] = 0x48
+		i++
+		if m.QuorumNotMet {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *TreeProof) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *TreeProof) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Neighbors) > 0 {
+		for _, b := range m.Neighbors {
+			data[i] = 0xa
+			i++
+			i = encodeVarintClient(data, i, uint64(len(b)))
+			i += copy(data[i:], b)
+		}
+	}
+	if len(m.ExistingIndex) > 0 {
+		data[i] = 0x12
+		i++
+		i = encodeVarintClient(data, i, uint64(len(m.ExistingIndex)))
+		i += copy(data[i:], m.ExistingIndex)
+	}
+	if len(m.ExistingEntryHash) > 0 {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintClient(data, i, uint64(len(m.ExistingEntryHash)))
+		i += copy(data[i:], m.ExistingEntryHash)
+	}
+	return i, nil
+}
+
+func (m *Entry) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *Entry) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Index) > 0 {
+		data[i] = 0xa
+		i++
+		i = encodeVarintClient(data, i, uint64(len(m.Index)))
+		i += copy(data[i:], m.Index)
+	}
+	if m.Version != 0 {
+		data[i] = 0x10
+		i++
+		i = encodeVarintClient(data, i, uint64(m.Version))
+	}
+	if m.UpdatePolicy != nil {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintClient(data, i, uint64(m.UpdatePolicy.Size()))
+		n10, err := m.UpdatePolicy.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n10
+	}
+	if len(m.ProfileCommitment) > 0 {
+		data[i] = 0x22
+		i++
+		i = encodeVarintClient(data, i, uint64(len(m.ProfileCommitment)))
+		i += copy(data[i:], m.ProfileCommitment)
+	}
+	if m.RecoveryPolicy != nil {
+		data[i] = 0x2a
+		i++
+		i = encodeVarintClient(data, i, uint64(m.RecoveryPolicy.Size()))
+		n11, err := m.RecoveryPolicy.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n11
+	}
+	if m.Deleted {
+		data[i] = 0x30
+		i++
+		if m.Deleted {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *RecoveryPolicy) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *RecoveryPolicy) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.DelaySeconds != 0 {
+		data[i] = 0x8
+		i++
+		i = encodeVarintClient(data, i, uint64(m.DelaySeconds))
+	}
+	return i, nil
+}
+
+func (m *PendingRecovery) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *PendingRecovery) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Update != nil {
+		data[i] = 0xa
+		i++
+		i = encodeVarintClient(data, i, uint64(m.Update.Size()))
+		n12, err := m.Update.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n12
+	}
+	data[i] = 0x12
+	i++
+	i = encodeVarintClient(data, i, uint64(m.NotBefore.Size()))
+	n13, err := m.NotBefore.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n13
+	return i, nil
+}
+
+func (m *RecoveryVeto) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *RecoveryVeto) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Index) > 0 {
+		data[i] = 0xa
+		i++
+		i = encodeVarintClient(data, i, uint64(len(m.Index)))
+		i += copy(data[i:], m.Index)
+	}
+	if len(m.EntryHash) > 0 {
+		data[i] = 0x12
+		i++
+		i = encodeVarintClient(data, i, uint64(len(m.EntryHash)))
+		i += copy(data[i:], m.EntryHash)
+	}
+	if len(m.Signatures) > 0 {
+		for k, _ := range m.Signatures {
+			data[i] = 0x1a
+			i++
+			v := m.Signatures[k]
+			byteSize := 0
+			if len(v) > 0 {
+				byteSize = 1 + len(v) + sovClient(uint64(len(v)))
+			}
+			mapSize := 1 + 8 + byteSize
+			i = encodeVarintClient(data, i, uint64(mapSize))
+			data[i] = 0x9
+			i++
+			i = encodeFixed64Client(data, i, uint64(k))
+			if len(v) > 0 {
+				data[i] = 0x12
+				i++
+				i = encodeVarintClient(data, i, uint64(len(v)))
+				i += copy(data[i:], v)
+			}
+		}
+	}
+	return i, nil
+}
+
+func (m *SignedEntryUpdate) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *SignedEntryUpdate) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintClient(data, i, uint64(m.NewEntry.Size()))
+	n14, err := m.NewEntry.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n14
+	if len(m.Signatures) > 0 {
+		for k, _ := range m.Signatures {
+			data[i] = 0x12
+			i++
+			v := m.Signatures[k]
+			byteSize := 0
+			if len(v) > 0 {
+				byteSize = 1 + len(v) + sovClient(uint64(len(v)))
+			}
+			mapSize := 1 + 8 + byteSize
+			i = encodeVarintClient(data, i, uint64(mapSize))
+			data[i] = 0x9
+			i++
+			i = encodeFixed64Client(data, i, uint64(k))
+			if len(v) > 0 {
+				data[i] = 0x12
+				i++
+				i = encodeVarintClient(data, i, uint64(len(v)))
+				i += copy(data[i:], v)
+			}
+		}
+	}
+	return i, nil
+}
+
+func (m *Profile) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *Profile) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Nonce) > 0 {
+		data[i] = 0xa
+		i++
+		i = encodeVarintClient(data, i, uint64(len(m.Nonce)))
+		i += copy(data[i:], m.Nonce)
+	}
+	if len(m.Keys) > 0 {
+		for k, _ := range m.Keys {
+			data[i] = 0x12
+			i++
+			v := m.Keys[k]
+			byteSize := 0
+			if len(v) > 0 {
+				byteSize = 1 + len(v) + sovClient(uint64(len(v)))
+			}
+			mapSize := 1 + len(k) + sovClient(uint64(len(k))) + byteSize
+			i = encodeVarintClient(data, i, uint64(mapSize))
+			data[i] = 0xa
+			i++
+			i = encodeVarintClient(data, i, uint64(len(k)))
+			i += copy(data[i:], k)
+			if len(v) > 0 {
+				data[i] = 0x12
+				i++
+				i = encodeVarintClient(data, i, uint64(len(v)))
+				i += copy(data[i:], v)
+			}
+		}
+	}
+	return i, nil
+}
+
+func (m *SignedEpochHead) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *SignedEpochHead) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintClient(data, i, uint64(m.Head.Size()))
+	n15, err := m.Head.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n15
+	if len(m.Signatures) > 0 {
+		for k, _ := range m.Signatures {
+			data[i] = 0x12
+			i++
+			v := m.Signatures[k]
+			byteSize := 0
+			if len(v) > 0 {
+				byteSize = 1 + len(v) + sovClient(uint64(len(v)))
+			}
+			mapSize := 1 + 8 + byteSize
+			i = encodeVarintClient(data, i, uint64(mapSize))
+			data[i] = 0x9
+			i++
+			i = encodeFixed64Client(data, i, uint64(k))
+			if len(v) > 0 {
+				data[i] = 0x12
+				i++
+				i = encodeVarintClient(data, i, uint64(len(v)))
+				i += copy(data[i:], v)
+			}
+		}
+	}
+	return i, nil
+}
+
+func (m *TimestampedEpochHead) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *TimestampedEpochHead) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintClient(data, i, uint64(m.Head.Size()))
+	n16, err := m.Head.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n16
+	data[i] = 0x12
+	i++
+	i = encodeVarintClient(data, i, uint64(m.Timestamp.Size()))
+	n17, err := m.Timestamp.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n17
+	if m.Refresh {
+		data[i] = 0x18
+		i++
+		if m.Refresh {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *EpochHead) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *EpochHead) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Realm) > 0 {
+		data[i] = 0xa
+		i++
+		i = encodeVarintClient(data, i, uint64(len(m.Realm)))
+		i += copy(data[i:], m.Realm)
+	}
+	if m.Epoch != 0 {
+		data[i] = 0x10
+		i++
+		i = encodeVarintClient(data, i, uint64(m.Epoch))
+	}
+	if len(m.RootHash) > 0 {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintClient(data, i, uint64(len(m.RootHash)))
+		i += copy(data[i:], m.RootHash)
+	}
+	data[i] = 0x22
+	i++
+	i = encodeVarintClient(data, i, uint64(m.IssueTime.Size()))
+	n18, err := m.IssueTime.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n18
+	if len(m.PreviousSummaryHash) > 0 {
+		data[i] = 0x2a
+		i++
+		i = encodeVarintClient(data, i, uint64(len(m.PreviousSummaryHash)))
+		i += copy(data[i:], m.PreviousSummaryHash)
+	}
+	data[i] = 0x32
+	i++
+	i = encodeVarintClient(data, i, uint64(m.NextEpochPolicy.Size()))
+	n19, err := m.NextEpochPolicy.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n19
+	if len(m.VRFPublic) > 0 {
+		data[i] = 0x3a
+		i++
+		i = encodeVarintClient(data, i, uint64(len(m.VRFPublic)))
+		i += copy(data[i:], m.VRFPublic)
+	}
+	if m.VRFRotationTime != nil {
+		data[i] = 0x42
+		i++
+		i = encodeVarintClient(data, i, uint64(m.VRFRotationTime.Size()))
+		n20, err := m.VRFRotationTime.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n20
+	}
+	return i, nil
+}
+
+func (m *VRFRotation) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *VRFRotation) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.VRFPublic) > 0 {
+		data[i] = 0xa
+		i++
+		i = encodeVarintClient(data, i, uint64(len(m.VRFPublic)))
+		i += copy(data[i:], m.VRFPublic)
+	}
+	if len(m.Moves) > 0 {
+		for _, msg := range m.Moves {
+			data[i] = 0x22
+			i++
+			i = encodeVarintClient(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.More {
+		data[i] = 0x28
+		i++
+		if m.More {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *VRFMove) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *VRFMove) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.UserId) > 0 {
+		data[i] = 0xa
+		i++
+		i = encodeVarintClient(data, i, uint64(len(m.UserId)))
+		i += copy(data[i:], m.UserId)
+	}
+	if len(m.OldIndex) > 0 {
+		data[i] = 0x12
+		i++
+		i = encodeVarintClient(data, i, uint64(len(m.OldIndex)))
+		i += copy(data[i:], m.OldIndex)
+	}
+	if len(m.OldIndexProof) > 0 {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintClient(data, i, uint64(len(m.OldIndexProof)))
+		i += copy(data[i:], m.OldIndexProof)
+	}
+	if len(m.NewIndex) > 0 {
+		data[i] = 0x22
+		i++
+		i = encodeVarintClient(data, i, uint64(len(m.NewIndex)))
+		i += copy(data[i:], m.NewIndex)
+	}
+	if len(m.NewIndexProof) > 0 {
+		data[i] = 0x2a
+		i++
+		i = encodeVarintClient(data, i, uint64(len(m.NewIndexProof)))
+		i += copy(data[i:], m.NewIndexProof)
+	}
+	return i, nil
+}
+
+func (m *AuthorizationPolicy) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *AuthorizationPolicy) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.PublicKeys) > 0 {
+		for k, _ := range m.PublicKeys {
+			data[i] = 0xa
+			i++
+			v := m.PublicKeys[k]
+			msgSize := 0
+			if v != nil {
+				msgSize = v.Size()
+				msgSize += 1 + sovClient(uint64(msgSize))
+			}
+			mapSize := 1 + 8 + msgSize
+			i = encodeVarintClient(data, i, uint64(mapSize))
+			data[i] = 0x9
+			i++
+			i = encodeFixed64Client(data, i, uint64(k))
+			if v != nil {
+				data[i] = 0x12
+				i++
+				i = encodeVarintClient(data, i, uint64(v.Size()))
+				n21, err := v.MarshalTo(data[i:])
+				if err != nil {
+					return 0, err
+				}
+				i += n21
+			}
+		}
+	}
+	if m.PolicyType != nil {
+		nn22, err := m.PolicyType.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += nn22
+	}
+	return i, nil
+}
+
+func (m *AuthorizationPolicy_Quorum) MarshalTo(data []byte) (int, error) {
+	i := 0
+	if m.Quorum != nil {
+		data[i] = 0x12
+		i++
+		i = encodeVarintClient(data, i, uint64(m.Quorum.Size()))
+		n23, err := m.Quorum.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n23
+	}
+	return i, nil
+}
+func (m *DomainAdminPolicy) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *DomainAdminPolicy) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Domains) > 0 {
+		for _, s := range m.Domains {
+			data[i] = 0xa
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				data[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			data[i] = uint8(l)
+			i++
+			i += copy(data[i:], s)
+		}
+	}
+	if m.Policy != nil {
+		data[i] = 0x12
+		i++
+		i = encodeVarintClient(data, i, uint64(m.Policy.Size()))
+		n24, err := m.Policy.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n24
+	}
+	return i, nil
+}
+
+func (m *PublicKey) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *PublicKey) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.PubkeyType != nil {
+		nn25, err := m.PubkeyType.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += nn25
+	}
+	return i, nil
+}
+
+func (m *PublicKey_Ed25519) MarshalTo(data []byte) (int, error) {
+	i := 0
+	if m.Ed25519 != nil {
+		data[i] = 0xa
+		i++
+		i = encodeVarintClient(data, i, uint64(len(m.Ed25519)))
+		i += copy(data[i:], m.Ed25519)
+	}
+	return i, nil
+}
+func (m *PublicKey_ECDSAP256SHA256) MarshalTo(data []byte) (int, error) {
+	i := 0
+	if m.ECDSAP256SHA256 != nil {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintClient(data, i, uint64(len(m.ECDSAP256SHA256)))
+		i += copy(data[i:], m.ECDSAP256SHA256)
+	}
+	return i, nil
+}
+func (m *PublicKey_RSAPSSSHA256) MarshalTo(data []byte) (int, error) {
+	i := 0
+	if m.RSAPSSSHA256 != nil {
+		data[i] = 0x22
+		i++
+		i = encodeVarintClient(data, i, uint64(len(m.RSAPSSSHA256)))
+		i += copy(data[i:], m.RSAPSSSHA256)
+	}
+	return i, nil
+}
+func (m *QuorumExpr) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *QuorumExpr) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Threshold != 0 {
+		data[i] = 0x8
+		i++
+		i = encodeVarintClient(data, i, uint64(m.Threshold))
+	}
+	if len(m.Candidates) > 0 {
+		data[i] = 0x12
+		i++
+		i = encodeVarintClient(data, i, uint64(len(m.Candidates)*8))
+		for _, num := range m.Candidates {
+			data[i] = uint8(num)
+			i++
+			data[i] = uint8(num >> 8)
+			i++
+			data[i] = uint8(num >> 16)
+			i++
+			data[i] = uint8(num >> 24)
+			i++
+			data[i] = uint8(num >> 32)
+			i++
+			data[i] = uint8(num >> 40)
+			i++
+			data[i] = uint8(num >> 48)
+			i++
+			data[i] = uint8(num >> 56)
+			i++
+		}
+	}
+	if len(m.Subexpressions) > 0 {
+		for _, msg := range m.Subexpressions {
+			data[i] = 0x1a
+			i++
+			i = encodeVarintClient(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.WeightedCandidates) > 0 {
+		for _, msg := range m.WeightedCandidates {
+			data[i] = 0x22
+			i++
+			i = encodeVarintClient(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Op != 0 {
+		data[i] = 0x28
+		i++
+		i = encodeVarintClient(data, i, uint64(m.Op))
+	}
+	return i, nil
+}
+
+func (m *WeightedCandidate) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *WeightedCandidate) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.ID != 0 {
+		data[i] = 0x9
+		i++
+		i = encodeFixed64Client(data, i, uint64(m.ID))
+	}
+	if m.Weight != 0 {
+		data[i] = 0x10
+		i++
+		i = encodeVarintClient(data, i, uint64(m.Weight))
+	}
+	return i, nil
+}
+
+func (m *EmailProof) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *EmailProof) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.ProofType != nil {
+		nn26, err := m.ProofType.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += nn26
+	}
+	return i, nil
+}
+
+func (m *EmailProof_DKIMProof) MarshalTo(data []byte) (int, error) {
+	i := 0
+	if m.DKIMProof != nil {
+		data[i] = 0xa
+		i++
+		i = encodeVarintClient(data, i, uint64(len(m.DKIMProof)))
+		i += copy(data[i:], m.DKIMProof)
+	}
+	return i, nil
+}
+func (m *EmailProof_OIDCToken) MarshalTo(data []byte) (int, error) {
+	i := 0
+	data[i] = 0x12
+	i++
+	i = encodeVarintClient(data, i, uint64(len(m.OIDCToken)))
+	i += copy(data[i:], m.OIDCToken)
+	return i, nil
+}
+func (m *EmailProof_SAMLResponse) MarshalTo(data []byte) (int, error) {
+	i := 0
+	data[i] = 0x1a
+	i++
+	i = encodeVarintClient(data, i, uint64(len(m.SAMLResponse)))
+	i += copy(data[i:], m.SAMLResponse)
+	return i, nil
+}
+func (m *EmailProof_ChallengeCode) MarshalTo(data []byte) (int, error) {
+	i := 0
+	data[i] = 0x22
+	i++
+	i = encodeVarintClient(data, i, uint64(len(m.ChallengeCode)))
+	i += copy(data[i:], m.ChallengeCode)
+	return i, nil
+}
+func (m *EmailProof_ClientCert) MarshalTo(data []byte) (int, error) {
+	i := 0
+	if m.ClientCert != nil {
+		data[i] = 0x2a
+		i++
+		i = encodeVarintClient(data, i, uint64(m.ClientCert.Size()))
+		n27, err := m.ClientCert.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n27
+	}
+	return i, nil
+}
+func (m *EmailProof_External) MarshalTo(data []byte) (int, error) {
+	i := 0
+	if m.External != nil {
+		data[i] = 0x32
+		i++
+		i = encodeVarintClient(data, i, uint64(m.External.Size()))
+		n28, err := m.External.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n28
+	}
+	return i, nil
+}
+func (m *ExternalProof) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *ExternalProof) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Type) > 0 {
+		data[i] = 0xa
+		i++
+		i = encodeVarintClient(data, i, uint64(len(m.Type)))
+		i += copy(data[i:], m.Type)
+	}
+	if len(m.Proof) > 0 {
+		data[i] = 0x12
+		i++
+		i = encodeVarintClient(data, i, uint64(len(m.Proof)))
+		i += copy(data[i:], m.Proof)
+	}
+	return i, nil
+}
+
+func (m *ClientCertProof) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *ClientCertProof) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Certificates) > 0 {
+		for _, b := range m.Certificates {
+			data[i] = 0xa
+			i++
+			i = encodeVarintClient(data, i, uint64(len(b)))
+			i += copy(data[i:], b)
+		}
+	}
+	if len(m.Signature) > 0 {
+		data[i] = 0x12
+		i++
+		i = encodeVarintClient(data, i, uint64(len(m.Signature)))
+		i += copy(data[i:], m.Signature)
+	}
+	return i, nil
+}
+
+func (m *EmailChallengeRequest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *EmailChallengeRequest) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.UserId) > 0 {
+		data[i] = 0xa
+		i++
+		i = encodeVarintClient(data, i, uint64(len(m.UserId)))
+		i += copy(data[i:], m.UserId)
+	}
+	if len(m.EntryHash) > 0 {
+		data[i] = 0x12
+		i++
+		i = encodeVarintClient(data, i, uint64(len(m.EntryHash)))
+		i += copy(data[i:], m.EntryHash)
+	}
+	return i, nil
+}
+
+func (m *EmailChallengeResponse) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *EmailChallengeResponse) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintClient(data, i, uint64(m.Expiration.Size()))
+	n29, err := m.Expiration.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n29
+	return i, nil
+}
+
+func (m *ListVerifiersRequest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *ListVerifiersRequest) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *ListVerifiersResponse) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *ListVerifiersResponse) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Verifiers) > 0 {
+		for _, msg := range m.Verifiers {
+			data[i] = 0xa
+			i++
+			i = encodeVarintClient(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *VerifierInfo) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *VerifierInfo) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.ID != 0 {
+		data[i] = 0x9
+		i++
+		i = encodeFixed64Client(data, i, uint64(m.ID))
+	}
+	if m.PublicKey != nil {
+		data[i] = 0x12
+		i++
+		i = encodeVarintClient(data, i, uint64(m.PublicKey.Size()))
+		n30, err := m.PublicKey.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n30
+	}
+	if m.LatestRatifiedEpoch != 0 {
+		data[i] = 0x18
+		i++
+		i = encodeVarintClient(data, i, uint64(m.LatestRatifiedEpoch))
+	}
+	return i, nil
+}
+
+func encodeFixed64Client(data []byte, offset int, v uint64) int {
+	data[offset] = uint8(v)
+	data[offset+1] = uint8(v >> 8)
+	data[offset+2] = uint8(v >> 16)
+	data[offset+3] = uint8(v >> 24)
+	data[offset+4] = uint8(v >> 32)
+	data[offset+5] = uint8(v >> 40)
+	data[offset+6] = uint8(v >> 48)
+	data[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32Client(data []byte, offset int, v uint32) int {
+	data[offset] = uint8(v)
+	data[offset+1] = uint8(v >> 8)
+	data[offset+2] = uint8(v >> 16)
+	data[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintClient(data []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		data[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	data[offset] = uint8(v)
+	return offset + 1
+}
+func NewPopulatedLookupRequest(r randyClient, easy bool) *LookupRequest {
+	this := &LookupRequest{}
+	this.Epoch = uint64(uint64(r.Uint32()))
+	this.UserId = randStringClient(r)
+	if r.Intn(10) == 0 {
+		this.QuorumRequirement = NewPopulatedQuorumExpr(r, easy)
+	}
+	this.AllowPartialRatification = bool(bool(r.Intn(2) == 0))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedUpdateRequest(r randyClient, easy bool) *UpdateRequest {
+	this := &UpdateRequest{}
+	if r.Intn(10) == 0 {
+		this.Update = NewPopulatedSignedEntryUpdate(r, easy)
+	}
+	v1 := NewPopulatedEncodedProfile(r, easy)
+	this.Profile = *v1
+	if r.Intn(10) == 0 {
+		this.LookupParameters = NewPopulatedLookupRequest(r, easy)
+	}
+	if r.Intn(10) != 0 {
+		this.EmailProof = NewPopulatedEmailProof(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedLookupProof(r randyClient, easy bool) *LookupProof {
+	this := &LookupProof{}
+	this.UserId = randStringClient(r)
+	v2 := r.Intn(100)
+	this.Index = make([]byte, v2)
+	for i := 0; i < v2; i++ {
+		this.Index[i] = byte(r.Intn(256))
+	}
+	v3 := r.Intn(100)
+	this.IndexProof = make([]byte, v3)
+	for i := 0; i < v3; i++ {
+		this.IndexProof[i] = byte(r.Intn(256))
+	}
+	if r.Intn(10) == 0 {
+		v4 := r.Intn(5)
+		this.Ratifications = make([]*SignedEpochHead, v4)
+		for i := 0; i < v4; i++ {
+			this.Ratifications[i] = NewPopulatedSignedEpochHead(r, easy)
+		}
+	}
+	if r.Intn(10) != 0 {
+		this.TreeProof = NewPopulatedTreeProof(r, easy)
+	}
+	if r.Intn(10) == 0 {
+		this.Entry = NewPopulatedEncodedEntry(r, easy)
+	}
+	if r.Intn(10) != 0 {
+		this.Profile = NewPopulatedEncodedProfile(r, easy)
+	}
+	if r.Intn(10) == 0 {
+		this.PendingRecovery = NewPopulatedPendingRecovery(r, easy)
+	}
+	this.QuorumNotMet = bool(bool(r.Intn(2) == 0))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedTreeProof(r randyClient, easy bool) *TreeProof {
+	this := &TreeProof{}
+	v5 := r.Intn(10)
+	this.Neighbors = make([][]byte, v5)
+	for i := 0; i < v5; i++ {
+		v6 := r.Intn(100)
+		this.Neighbors[i] = make([]byte, v6)
+		for j := 0; j < v6; j++ {
+			this.Neighbors[i][j] = byte(r.Intn(256))
+		}
+	}
+	v7 := r.Intn(100)
+	this.ExistingIndex = make([]byte, v7)
+	for i := 0; i < v7; i++ {
+		this.ExistingIndex[i] = byte(r.Intn(256))
+	}
+	v8 := r.Intn(100)
+	this.ExistingEntryHash = make([]byte, v8)
+	for i := 0; i < v8; i++ {
+		this.ExistingEntryHash[i] = byte(r.Intn(256))
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedEntry(r randyClient, easy bool) *Entry {
+	this := &Entry{}
+	v9 := r.Intn(100)
+	this.Index = make([]byte, v9)
+	for i := 0; i < v9; i++ {
+		this.Index[i] = byte(r.Intn(256))
+	}
+	this.Version = uint64(uint64(r.Uint32()))
+	if r.Intn(10) == 0 {
+		this.UpdatePolicy = NewPopulatedAuthorizationPolicy(r, easy)
+	}
+	v10 := r.Intn(100)
+	this.ProfileCommitment = make([]byte, v10)
+	for i := 0; i < v10; i++ {
+		this.ProfileCommitment[i] = byte(r.Intn(256))
+	}
+	if r.Intn(10) != 0 {
+		this.RecoveryPolicy = NewPopulatedRecoveryPolicy(r, easy)
+	}
+	this.Deleted = bool(bool(r.Intn(2) == 0))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedRecoveryPolicy(r randyClient, easy bool) *RecoveryPolicy {
+	this := &RecoveryPolicy{}
+	this.DelaySeconds = uint64(uint64(r.Uint32()))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedPendingRecovery(r randyClient, easy bool) *PendingRecovery {
+	this := &PendingRecovery{}
+	if r.Intn(10) == 0 {
+		this.Update = NewPopulatedSignedEntryUpdate(r, easy)
+	}
+	v11 := NewPopulatedTimestamp(r, easy)
+	this.NotBefore = *v11
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedRecoveryVeto(r randyClient, easy bool) *RecoveryVeto {
+	this := &RecoveryVeto{}
+	v12 := r.Intn(100)
+	this.Index = make([]byte, v12)
+	for i := 0; i < v12; i++ {
+		this.Index[i] = byte(r.Intn(256))
+	}
+	v13 := r.Intn(100)
+	this.EntryHash = make([]byte, v13)
+	for i := 0; i < v13; i++ {
+		this.EntryHash[i] = byte(r.Intn(256))
+	}
+	if r.Intn(10) != 0 {
+		v14 := r.Intn(10)
+		this.Signatures = make(map[uint64][]byte)
+		for i := 0; i < v14; i++ {
+			v15 := r.Intn(100)
+			v16 := uint64(uint64(r.Uint32()))
+			this.Signatures[v16] = make([]byte, v15)
+			for i := 0; i < v15; i++ {
+				this.Signatures[v16][i] = byte(r.Intn(256))
+			}
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedSignedEntryUpdate(r randyClient, easy bool) *SignedEntryUpdate {
+	this := &SignedEntryUpdate{}
+	v17 := NewPopulatedEncodedEntry(r, easy)
+	this.NewEntry = *v17
+	if r.Intn(10) != 0 {
+		v18 := r.Intn(10)
+		this.Signatures = make(map[uint64][]byte)
+		for i := 0; i < v18; i++ {
+			v19 := r.Intn(100)
+			v20 := uint64(uint64(r.Uint32()))
+			this.Signatures[v20] = make([]byte, v19)
+			for i := 0; i < v19; i++ {
+				this.Signatures[v20][i] = byte(r.Intn(256))
+			}
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedProfile(r randyClient, easy bool) *Profile {
+	this := &Profile{}
+	v21 := r.Intn(100)
+	this.Nonce = make([]byte, v21)
+	for i := 0; i < v21; i++ {
+		this.Nonce[i] = byte(r.Intn(256))
+	}
+	if r.Intn(10) != 0 {
+		v22 := r.Intn(10)
+		this.Keys = make(map[string][]byte)
+		for i := 0; i < v22; i++ {
+			v23 := r.Intn(100)
+			v24 := randStringClient(r)
+			this.Keys[v24] = make([]byte, v23)
+			for i := 0; i < v23; i++ {
+				this.Keys[v24][i] = byte(r.Intn(256))
+			}
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedSignedEpochHead(r randyClient, easy bool) *SignedEpochHead {
+	this := &SignedEpochHead{}
+	v25 := NewPopulatedEncodedTimestampedEpochHead(r, easy)
+	this.Head = *v25
+	if r.Intn(10) != 0 {
+		v26 := r.Intn(10)
+		this.Signatures = make(map[uint64][]byte)
+		for i := 0; i < v26; i++ {
+			v27 := r.Intn(100)
+			v28 := uint64(uint64(r.Uint32()))
+			this.Signatures[v28] = make([]byte, v27)
+			for i := 0; i < v27; i++ {
+				this.Signatures[v28][i] = byte(r.Intn(256))
+			}
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedTimestampedEpochHead(r randyClient, easy bool) *TimestampedEpochHead {
+	this := &TimestampedEpochHead{}
+	v29 := NewPopulatedEncodedEpochHead(r, easy)
+	this.Head = *v29
+	v30 := NewPopulatedTimestamp(r, easy)
+	this.Timestamp = *v30
+	this.Refresh = bool(bool(r.Intn(2) == 0))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedEpochHead(r randyClient, easy bool) *EpochHead {
+	this := &EpochHead{}
+	this.Realm = randStringClient(r)
+	this.Epoch = uint64(uint64(r.Uint32()))
+	v31 := r.Intn(100)
+	this.RootHash = make([]byte, v31)
+	for i := 0; i < v31; i++ {
+		this.RootHash[i] = byte(r.Intn(256))
+	}
+	v32 := NewPopulatedTimestamp(r, easy)
+	this.IssueTime = *v32
+	v33 := r.Intn(100)
+	this.PreviousSummaryHash = make([]byte, v33)
+	for i := 0; i < v33; i++ {
+		this.PreviousSummaryHash[i] = byte(r.Intn(256))
+	}
+	v34 := NewPopulatedAuthorizationPolicy(r, easy)
+	this.NextEpochPolicy = *v34
+	v35 := r.Intn(100)
+	this.VRFPublic = make([]byte, v35)
+	for i := 0; i < v35; i++ {
+		this.VRFPublic[i] = byte(r.Intn(256))
+	}
+	if r.Intn(10) != 0 {
+		this.VRFRotationTime = NewPopulatedTimestamp(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedVRFRotation(r randyClient, easy bool) *VRFRotation {
+	this := &VRFRotation{}
+	v36 := r.Intn(100)
+	this.VRFPublic = make([]byte, v36)
+	for i := 0; i < v36; i++ {
+		this.VRFPublic[i] = byte(r.Intn(256))
+	}
+	if r.Intn(10) != 0 {
+		v37 := r.Intn(5)
+		this.Moves = make([]*VRFMove, v37)
+		for i := 0; i < v37; i++ {
+			this.Moves[i] = NewPopulatedVRFMove(r, easy)
+		}
+	}
+	this.More = bool(bool(r.Intn(2) == 0))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedVRFMove(r randyClient, easy bool) *VRFMove {
+	this := &VRFMove{}
+	this.UserId = randStringClient(r)
+	v38 := r.Intn(100)
+	this.OldIndex = make([]byte, v38)
+	for i := 0; i < v38; i++ {
+		this.OldIndex[i] = byte(r.Intn(256))
+	}
+	v39 := r.Intn(100)
+	this.OldIndexProof = make([]byte, v39)
+	for i := 0; i < v39; i++ {
+		this.OldIndexProof[i] = byte(r.Intn(256))
+	}
+	v40 := r.Intn(100)
+	this.NewIndex = make([]byte, v40)
+	for i := 0; i < v40; i++ {
+		this.NewIndex[i] = byte(r.Intn(256))
+	}
+	v41 := r.Intn(100)
+	this.NewIndexProof = make([]byte, v41)
+	for i := 0; i < v41; i++ {
+		this.NewIndexProof[i] = byte(r.Intn(256))
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedAuthorizationPolicy(r randyClient, easy bool) *AuthorizationPolicy {
+	this := &AuthorizationPolicy{}
+	if r.Intn(10) != 0 {
+		v42 := r.Intn(10)
+		this.PublicKeys = make(map[uint64]*PublicKey)
+		for i := 0; i < v42; i++ {
+			this.PublicKeys[uint64(uint64(r.Uint32()))] = NewPopulatedPublicKey(r, easy)
+		}
+	}
+	oneofNumber_PolicyType := []int32{2}[r.Intn(1)]
+	switch oneofNumber_PolicyType {
+	case 2:
+		this.PolicyType = NewPopulatedAuthorizationPolicy_Quorum(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedAuthorizationPolicy_Quorum(r randyClient, easy bool) *AuthorizationPolicy_Quorum {
+	this := &AuthorizationPolicy_Quorum{}
+	this.Quorum = NewPopulatedQuorumExpr(r, easy)
+	return this
+}
+func NewPopulatedDomainAdminPolicy(r randyClient, easy bool) *DomainAdminPolicy {
+	this := &DomainAdminPolicy{}
+	v43 := r.Intn(10)
+	this.Domains = make([]string, v43)
+	for i := 0; i < v43; i++ {
+		this.Domains[i] = randStringClient(r)
+	}
+	if r.Intn(10) == 0 {
+		this.Policy = NewPopulatedAuthorizationPolicy(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedPublicKey(r randyClient, easy bool) *PublicKey {
+	this := &PublicKey{}
+	oneofNumber_PubkeyType := []int32{1, 3, 4}[r.Intn(3)]
+	switch oneofNumber_PubkeyType {
+	case 1:
+		this.PubkeyType = NewPopulatedPublicKey_Ed25519(r, easy)
+	case 3:
+		this.PubkeyType = NewPopulatedPublicKey_ECDSAP256SHA256(r, easy)
+	case 4:
+		this.PubkeyType = NewPopulatedPublicKey_RSAPSSSHA256(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedPublicKey_Ed25519(r randyClient, easy bool) *PublicKey_Ed25519 {
+	this := &PublicKey_Ed25519{}
+	v44 := r.Intn(100)
+	this.Ed25519 = make([]byte, v44)
+	for i := 0; i < v44; i++ {
+		this.Ed25519[i] = byte(r.Intn(256))
+	}
+	return this
+}
+func NewPopulatedPublicKey_ECDSAP256SHA256(r randyClient, easy bool) *PublicKey_ECDSAP256SHA256 {
+	this := &PublicKey_ECDSAP256SHA256{}
+	v45 := r.Intn(100)
+	this.ECDSAP256SHA256 = make([]byte, v45)
+	for i := 0; i < v45; i++ {
+		this.ECDSAP256SHA256[i] = byte(r.Intn(256))
+	}
+	return this
+}
+func NewPopulatedPublicKey_RSAPSSSHA256(r randyClient, easy bool) *PublicKey_RSAPSSSHA256 {
+	this := &PublicKey_RSAPSSSHA256{}
+	v46 := r.Intn(100)
+	this.RSAPSSSHA256 = make([]byte, v46)
+	for i := 0; i < v46; i++ {
+		this.RSAPSSSHA256[i] = byte(r.Intn(256))
+	}
+	return this
+}
+func NewPopulatedQuorumExpr(r randyClient, easy bool) *QuorumExpr {
+	this := &QuorumExpr{}
+	this.Threshold = uint32(r.Uint32())
+	v47 := r.Intn(2)
+	this.Candidates = make([]uint64, v47)
+	for i := 0; i < v47; i++ {
+		this.Candidates[i] = uint64(uint64(r.Uint32()))
+	}
+	if r.Intn(10) == 0 {
+		v48 := r.Intn(5)
+		this.Subexpressions = make([]*QuorumExpr, v48)
+		for i := 0; i < v48; i++ {
+			this.Subexpressions[i] = NewPopulatedQuorumExpr(r, easy)
+		}
+	}
+	if r.Intn(10) != 0 {
+		v49 := r.Intn(5)
+		this.WeightedCandidates = make([]*WeightedCandidate, v49)
+		for i := 0; i < v49; i++ {
+			this.WeightedCandidates[i] = NewPopulatedWeightedCandidate(r, easy)
+		}
+	}
+	this.Op = QuorumOp([]int32{0, 1, 2}[r.Intn(3)])
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedWeightedCandidate(r randyClient, easy bool) *WeightedCandidate {
+	this := &WeightedCandidate{}
+	this.ID = uint64(uint64(r.Uint32()))
+	this.Weight = uint32(r.Uint32())
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedEmailProof(r randyClient, easy bool) *EmailProof {
+	this := &EmailProof{}
+	oneofNumber_ProofType := []int32{1, 2, 3, 4, 5, 6}[r.Intn(6)]
+	switch oneofNumber_ProofType {
+	case 1:
+		this.ProofType = NewPopulatedEmailProof_DKIMProof(r, easy)
+	case 2:
+		this.ProofType = NewPopulatedEmailProof_OIDCToken(r, easy)
+	case 3:
+		this.ProofType = NewPopulatedEmailProof_SAMLResponse(r, easy)
+	case 4:
+		this.ProofType = NewPopulatedEmailProof_ChallengeCode(r, easy)
+	case 5:
+		this.ProofType = NewPopulatedEmailProof_ClientCert(r, easy)
+	case 6:
+		this.ProofType = NewPopulatedEmailProof_External(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedEmailProof_DKIMProof(r randyClient, easy bool) *EmailProof_DKIMProof {
+	this := &EmailProof_DKIMProof{}
+	v50 := r.Intn(100)
+	this.DKIMProof = make([]byte, v50)
+	for i := 0; i < v50; i++ {
+		this.DKIMProof[i] = byte(r.Intn(256))
+	}
+	return this
+}
+func NewPopulatedEmailProof_OIDCToken(r randyClient, easy bool) *EmailProof_OIDCToken {
+	this := &EmailProof_OIDCToken{}
+	this.OIDCToken = randStringClient(r)
+	return this
+}
+func NewPopulatedEmailProof_SAMLResponse(r randyClient, easy bool) *EmailProof_SAMLResponse {
+	this := &EmailProof_SAMLResponse{}
+	this.SAMLResponse = randStringClient(r)
+	return this
+}
+func NewPopulatedEmailProof_ChallengeCode(r randyClient, easy bool) *EmailProof_ChallengeCode {
+	this := &EmailProof_ChallengeCode{}
+	this.ChallengeCode = randStringClient(r)
+	return this
+}
+func NewPopulatedEmailProof_ClientCert(r randyClient, easy bool) *EmailProof_ClientCert {
+	this := &EmailProof_ClientCert{}
+	this.ClientCert = NewPopulatedClientCertProof(r, easy)
+	return this
+}
+func NewPopulatedEmailProof_External(r randyClient, easy bool) *EmailProof_External {
+	this := &EmailProof_External{}
+	this.External = NewPopulatedExternalProof(r, easy)
+	return this
+}
+func NewPopulatedExternalProof(r randyClient, easy bool) *ExternalProof {
+	this := &ExternalProof{}
+	this.Type = randStringClient(r)
+	v51 := r.Intn(100)
+	this.Proof = make([]byte, v51)
+	for i := 0; i < v51; i++ {
+		this.Proof[i] = byte(r.Intn(256))
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedClientCertProof(r randyClient, easy bool) *ClientCertProof {
+	this := &ClientCertProof{}
+	v52 := r.Intn(10)
+	this.Certificates = make([][]byte, v52)
+	for i := 0; i < v52; i++ {
+		v53 := r.Intn(100)
+		this.Certificates[i] = make([]byte, v53)
+		for j := 0; j < v53; j++ {
+			this.Certificates[i][j] = byte(r.Intn(256))
+		}
+	}
+	v54 := r.Intn(100)
+	this.Signature = make([]byte, v54)
+	for i := 0; i < v54; i++ {
+		this.Signature[i] = byte(r.Intn(256))
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedEmailChallengeRequest(r randyClient, easy bool) *EmailChallengeRequest {
+	this := &EmailChallengeRequest{}
+	this.UserId = randStringClient(r)
+	v55 := r.Intn(100)
+	this.EntryHash = make([]byte, v55)
+	for i := 0; i < v55; i++ {
+		this.EntryHash[i] = byte(r.Intn(256))
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedEmailChallengeResponse(r randyClient, easy bool) *EmailChallengeResponse {
+	this := &EmailChallengeResponse{}
+	v56 := NewPopulatedTimestamp(r, easy)
+	this.Expiration = *v56
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedListVerifiersRequest(r randyClient, easy bool) *ListVerifiersRequest {
+	this := &ListVerifiersRequest{}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedListVerifiersResponse(r randyClient, easy bool) *ListVerifiersResponse {
+	this := &ListVerifiersResponse{}
+	if r.Intn(10) != 0 {
+		v57 := r.Intn(5)
+		this.Verifiers = make([]*VerifierInfo, v57)
+		for i := 0; i < v57; i++ {
+			this.Verifiers[i] = NewPopulatedVerifierInfo(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedVerifierInfo(r randyClient, easy bool) *VerifierInfo {
+	this := &VerifierInfo{}
+	this.ID = uint64(uint64(r.Uint32()))
+	if r.Intn(10) != 0 {
+		this.PublicKey = NewPopulatedPublicKey(r, easy)
+	}
+	this.LatestRatifiedEpoch = uint64(uint64(r.Uint32()))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+type randyClient interface {
+	Float32() float32
+	Float64() float64
+	Int63() int64
+	Int31() int32
+	Uint32() uint32
+	Intn(n int) int
+}
+
+func randUTF8RuneClient(r randyClient) rune {
+	ru := r.Intn(62)
+	if ru < 10 {
+		return rune(ru + 48)
+	} else if ru < 36 {
+		return rune(ru + 55)
+	}
+	return rune(ru + 61)
+}
+func randStringClient(r randyClient) string {
+	v58 := r.Intn(100)
+	tmps := make([]rune, v58)
+	for i := 0; i < v58; i++ {
+		tmps[i] = randUTF8RuneClient(r)
+	}
+	return string(tmps)
+}
+func randUnrecognizedClient(r randyClient, maxFieldNumber int) (data []byte) {
+	l := r.Intn(5)
+	for i := 0; i < l; i++ {
+		wire := r.Intn(4)
+		if wire == 3 {
+			wire = 5
+		}
+		fieldNumber := maxFieldNumber + r.Intn(100)
+		data = randFieldClient(data, r, fieldNumber, wire)
+	}
+	return data
+}
+func randFieldClient(data []byte, r randyClient, fieldNumber int, wire int) []byte {
+	key := uint32(fieldNumber)<<3 | uint32(wire)
+	switch wire {
+	case 0:
+		data = encodeVarintPopulateClient(data, uint64(key))
+		v59 := r.Int63()
+		if r.Intn(2) == 0 {
+			v59 *= -1
+		}
+		data = encodeVarintPopulateClient(data, uint64(v59))
+	case 1:
+		data = encodeVarintPopulateClient(data, uint64(key))
+		data = append(data, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	case 2:
+		data = encodeVarintPopulateClient(data, uint64(key))
+		ll := r.Intn(100)
+		data = encodeVarintPopulateClient(data, uint64(ll))
+		for j := 0; j < ll; j++ {
+			data = append(data, byte(r.Intn(256)))
+		}
+	default:
+		data = encodeVarintPopulateClient(data, uint64(key))
+		data = append(data, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	}
+	return data
+}
+func encodeVarintPopulateClient(data []byte, v uint64) []byte {
+	for v >= 1<<7 {
+		data = append(data, uint8(uint64(v)&0x7f|0x80))
+		v >>= 7
+	}
+	data = append(data, uint8(v))
+	return data
+}
+func (m *LookupRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovClient(uint64(m.Epoch))
+	}
+	l = len(m.UserId)
+	if l > 0 {
+		n += 1 + l + sovClient(uint64(l))
+	}
+	if m.QuorumRequirement != nil {
+		l = m.QuorumRequirement.Size()
+		n += 1 + l + sovClient(uint64(l))
+	}
+	if m.AllowPartialRatification {
+		n += 2
+	}
+	return n
+}
+
+func (m *UpdateRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Update != nil {
+		l = m.Update.Size()
+		n += 1 + l + sovClient(uint64(l))
+	}
+	l = m.Profile.Size()
+	n += 1 + l + sovClient(uint64(l))
+	if m.LookupParameters != nil {
+		l = m.LookupParameters.Size()
+		n += 1 + l + sovClient(uint64(l))
+	}
+	if m.EmailProof != nil {
+		l = m.EmailProof.Size()
+		n += 2 + l + sovClient(uint64(l))
+	}
+	return n
+}
+
+func (m *LookupProof) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.UserId)
+	if l > 0 {
+		n += 1 + l + sovClient(uint64(l))
+	}
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovClient(uint64(l))
+	}
+	l = len(m.IndexProof)
+	if l > 0 {
+		n += 1 + l + sovClient(uint64(l))
+	}
+	if len(m.Ratifications) > 0 {
+		for _, e := range m.Ratifications {
+			l = e.Size()
+			n += 1 + l + sovClient(uint64(l))
+		}
+	}
+	if m.TreeProof != nil {
+		l = m.TreeProof.Size()
+		n += 1 + l + sovClient(uint64(l))
+	}
+	if m.Entry != nil {
+		l = m.Entry.Size()
+		n += 1 + l + sovClient(uint64(l))
+	}
+	if m.Profile != nil {
+		l = m.Profile.Size()
+		n += 1 + l + sovClient(uint64(l))
+	}
+	if m.PendingRecovery != nil {
+		l = m.PendingRecovery.Size()
+		n += 1 + l + sovClient(uint64(l))
+	}
+	if m.QuorumNotMet {
+		n += 2
+	}
+	return n
+}
+
+func (m *TreeProof) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Neighbors) > 0 {
+		for _, b := range m.Neighbors {
+			l = len(b)
+			n += 1 + l + sovClient(uint64(l))
+		}
+	}
+	l = len(m.ExistingIndex)
+	if l > 0 {
+		n += 1 + l + sovClient(uint64(l))
+	}
+	l = len(m.ExistingEntryHash)
+	if l > 0 {
+		n += 1 + l + sovClient(uint64(l))
+	}
+	return n
+}
+
+func (m *Entry) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovClient(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovClient(uint64(m.Version))
+	}
+	if m.UpdatePolicy != nil {
+		l = m.UpdatePolicy.Size()
+		n += 1 + l + sovClient(uint64(l))
+	}
+	l = len(m.ProfileCommitment)
+	if l > 0 {
+		n += 1 + l + sovClient(uint64(l))
+	}
+	if m.RecoveryPolicy != nil {
+		l = m.RecoveryPolicy.Size()
+		n += 1 + l + sovClient(uint64(l))
+	}
+	if m.Deleted {
+		n += 2
+	}
+	return n
+}
+
+func (m *RecoveryPolicy) Size() (n int) {
+	var l int
+	_ = l
+	if m.DelaySeconds != 0 {
+		n += 1 + sovClient(uint64(m.DelaySeconds))
+	}
+	return n
+}
+
+func (m *PendingRecovery) Size() (n int) {
+	var l int
+	_ = l
+	if m.Update != nil {
+		l = m.Update.Size()
+		n += 1 + l + sovClient(uint64(l))
+	}
+	l = m.NotBefore.Size()
+	n += 1 + l + sovClient(uint64(l))
+	return n
+}
+
+func (m *RecoveryVeto) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovClient(uint64(l))
+	}
+	l = len(m.EntryHash)
+	if l > 0 {
+		n += 1 + l + sovClient(uint64(l))
+	}
+	if len(m.Signatures) > 0 {
+		for k, v := range m.Signatures {
+			_ = k
+			_ = v
+			l = 0
+			if len(v) > 0 {
+				l = 1 + len(v) + sovClient(uint64(len(v)))
+			}
+			mapEntrySize := 1 + 8 + l
+			n += mapEntrySize + 1 + sovClient(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *SignedEntryUpdate) Size() (n int) {
+	var l int
+	_ = l
+	l = m.NewEntry.Size()
+	n += 1 + l + sovClient(uint64(l))
+	if len(m.Signatures) > 0 {
+		for k, v := range m.Signatures {
+			_ = k
+			_ = v
+			l = 0
+			if len(v) > 0 {
+				l = 1 + len(v) + sovClient(uint64(len(v)))
+			}
+			mapEntrySize := 1 + 8 + l
+			n += mapEntrySize + 1 + sovClient(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *Profile) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Nonce)
+	if l > 0 {
+		n += 1 + l + sovClient(uint64(l))
+	}
+	if len(m.Keys) > 0 {
+		for k, v := range m.Keys {
+			_ = k
+			_ = v
+			l = 0
+			if len(v) > 0 {
+				l = 1 + len(v) + sovClient(uint64(len(v)))
+			}
+			mapEntrySize := 1 + len(k) + sovClient(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovClient(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *SignedEpochHead) Size() (n int) {
+	var l int
+	_ = l
+	l = m.Head.Size()
+	n += 1 + l + sovClient(uint64(l))
+	if len(m.Signatures) > 0 {
+		for k, v := range m.Signatures {
+			_ = k
+			_ = v
+			l = 0
+			if len(v) > 0 {
+				l = 1 + len(v) + sovClient(uint64(len(v)))
+			}
+			mapEntrySize := 1 + 8 + l
+			n += mapEntrySize + 1 + sovClient(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *TimestampedEpochHead) Size() (n int) {
+	var l int
+	_ = l
+	l = m.Head.Size()
+	n += 1 + l + sovClient(uint64(l))
+	l = m.Timestamp.Size()
+	n += 1 + l + sovClient(uint64(l))
+	if m.Refresh {
+		n += 2
+	}
+	return n
+}
+
+func (m *EpochHead) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Realm)
+	if l > 0 {
+		n += 1 + l + sovClient(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovClient(uint64(m.Epoch))
+	}
+	l = len(m.RootHash)
+	if l > 0 {
+		n += 1 + l + sovClient(uint64(l))
+	}
+	l = m.IssueTime.Size()
+	n += 1 + l + sovClient(uint64(l))
+	l = len(m.PreviousSummaryHash)
+	if l > 0 {
+		n += 1 + l + sovClient(uint64(l))
+	}
+	l = m.NextEpochPolicy.Size()
+	n += 1 + l + sovClient(uint64(l))
+	l = len(m.VRFPublic)
+	if l > 0 {
+		n += 1 + l + sovClient(uint64(l))
+	}
+	if m.VRFRotationTime != nil {
+		l = m.VRFRotationTime.Size()
+		n += 1 + l + sovClient(uint64(l))
+	}
+	return n
+}
+
+func (m *VRFRotation) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.VRFPublic)
+	if l > 0 {
+		n += 1 + l + sovClient(uint64(l))
+	}
+	if len(m.Moves) > 0 {
+		for _, e := range m.Moves {
+			l = e.Size()
+			n += 1 + l + sovClient(uint64(l))
+		}
+	}
+	if m.More {
+		n += 2
+	}
+	return n
+}
+
+func (m *VRFMove) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.UserId)
+	if l > 0 {
+		n += 1 + l + sovClient(uint64(l))
+	}
+	l = len(m.OldIndex)
+	if l > 0 {
+		n += 1 + l + sovClient(uint64(l))
+	}
+	l = len(m.OldIndexProof)
+	if l > 0 {
+		n += 1 + l + sovClient(uint64(l))
+	}
+	l = len(m.NewIndex)
+	if l > 0 {
+		n += 1 + l + sovClient(uint64(l))
+	}
+	l = len(m.NewIndexProof)
+	if l > 0 {
+		n += 1 + l + sovClient(uint64(l))
+	}
+	return n
+}
+
+func (m *AuthorizationPolicy) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.PublicKeys) > 0 {
+		for k, v := range m.PublicKeys {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovClient(uint64(l))
+			}
+			mapEntrySize := 1 + 8 + l
+			n += mapEntrySize + 1 + sovClient(uint64(mapEntrySize))
+		}
+	}
+	if m.PolicyType != nil {
+		n += m.PolicyType.Size()
+	}
+	return n
+}
+
+func (m *AuthorizationPolicy_Quorum) Size() (n int) {
+	var l int
+	_ = l
+	if m.Quorum != nil {
+		l = m.Quorum.Size()
+		n += 1 + l + sovClient(uint64(l))
+	}
+	return n
+}
+func (m *DomainAdminPolicy) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Domains) > 0 {
+		for _, s := range m.Domains {
+			l = len(s)
+			n += 1 + l + sovClient(uint64(l))
+		}
+	}
+	if m.Policy != nil {
+		l = m.Policy.Size()
+		n += 1 + l + sovClient(uint64(l))
+	}
+	return n
+}
+
+func (m *PublicKey) Size() (n int) {
+	var l int
+	_ = l
+	if m.PubkeyType != nil {
+		n += m.PubkeyType.Size()
+	}
+	return n
+}
+
+func (m *PublicKey_Ed25519) Size() (n int) {
+	var l int
+	_ = l
+	if m.Ed25519 != nil {
+		l = len(m.Ed25519)
+		n += 1 + l + sovClient(uint64(l))
+	}
+	return n
+}
+func (m *PublicKey_ECDSAP256SHA256) Size() (n int) {
+	var l int
+	_ = l
+	if m.ECDSAP256SHA256 != nil {
+		l = len(m.ECDSAP256SHA256)
+		n += 1 + l + sovClient(uint64(l))
+	}
+	return n
+}
+func (m *PublicKey_RSAPSSSHA256) Size() (n int) {
+	var l int
+	_ = l
+	if m.RSAPSSSHA256 != nil {
+		l = len(m.RSAPSSSHA256)
+		n += 1 + l + sovClient(uint64(l))
+	}
+	return n
+}
+func (m *QuorumExpr) Size() (n int) {
+	var l int
+	_ = l
+	if m.Threshold != 0 {
+		n += 1 + sovClient(uint64(m.Threshold))
+	}
+	if len(m.Candidates) > 0 {
+		n += 1 + sovClient(uint64(len(m.Candidates)*8)) + len(m.Candidates)*8
+	}
+	if len(m.Subexpressions) > 0 {
+		for _, e := range m.Subexpressions {
+			l = e.Size()
+			n += 1 + l + sovClient(uint64(l))
+		}
+	}
+	if len(m.WeightedCandidates) > 0 {
+		for _, e := range m.WeightedCandidates {
+			l = e.Size()
+			n += 1 + l + sovClient(uint64(l))
+		}
+	}
+	if m.Op != 0 {
+		n += 1 + sovClient(uint64(m.Op))
+	}
+	return n
+}
+
+func (m *WeightedCandidate) Size() (n int) {
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 9
+	}
+	if m.Weight != 0 {
+		n += 1 + sovClient(uint64(m.Weight))
+	}
+	return n
+}
+
+func (m *EmailProof) Size() (n int) {
+	var l int
+	_ = l
+	if m.ProofType != nil {
+		n += m.ProofType.Size()
+	}
+	return n
+}
+
+func (m *EmailProof_DKIMProof) Size() (n int) {
+	var l int
+	_ = l
+	if m.DKIMProof != nil {
+		l = len(m.DKIMProof)
+		n += 1 + l + sovClient(uint64(l))
+	}
+	return n
+}
+func (m *EmailProof_OIDCToken) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.OIDCToken)
+	n += 1 + l + sovClient(uint64(l))
+	return n
+}
+func (m *EmailProof_SAMLResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.SAMLResponse)
+	n += 1 + l + sovClient(uint64(l))
+	return n
+}
+func (m *EmailProof_ChallengeCode) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ChallengeCode)
+	n += 1 + l + sovClient(uint64(l))
+	return n
+}
+func (m *EmailProof_ClientCert) Size() (n int) {
+	var l int
+	_ = l
+	if m.ClientCert != nil {
+		l = m.ClientCert.Size()
+		n += 1 + l + sovClient(uint64(l))
+	}
+	return n
+}
+func (m *EmailProof_External) Size() (n int) {
+	var l int
+	_ = l
+	if m.External != nil {
+		l = m.External.Size()
+		n += 1 + l + sovClient(uint64(l))
+	}
+	return n
+}
+func (m *ExternalProof) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovClient(uint64(l))
+	}
+	l = len(m.Proof)
+	if l > 0 {
+		n += 1 + l + sovClient(uint64(l))
+	}
+	return n
+}
+
+func (m *ClientCertProof) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Certificates) > 0 {
+		for _, b := range m.Certificates {
+			l = len(b)
+			n += 1 + l + sovClient(uint64(l))
+		}
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovClient(uint64(l))
+	}
+	return n
+}
+
+func (m *EmailChallengeRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.UserId)
+	if l > 0 {
+		n += 1 + l + sovClient(uint64(l))
+	}
+	l = len(m.EntryHash)
+	if l > 0 {
+		n += 1 + l + sovClient(uint64(l))
+	}
+	return n
+}
+
+func (m *EmailChallengeResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = m.Expiration.Size()
+	n += 1 + l + sovClient(uint64(l))
+	return n
+}
+
+func (m *ListVerifiersRequest) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ListVerifiersResponse) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Verifiers) > 0 {
+		for _, e := range m.Verifiers {
+			l = e.Size()
+			n += 1 + l + sovClient(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *VerifierInfo) Size() (n int) {
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 9
+	}
+	if m.PublicKey != nil {
+		l = m.PublicKey.Size()
+		n += 1 + l + sovClient(uint64(l))
+	}
+	if m.LatestRatifiedEpoch != 0 {
+		n += 1 + sovClient(uint64(m.LatestRatifiedEpoch))
+	}
+	return n
+}
+
+func sovClient(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozClient(x uint64) (n int) {
+	return sovClient(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *LookupRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&LookupRequest{`,
+		`Epoch:` + fmt.Sprintf("%v", this.Epoch) + `,`,
+		`UserId:` + fmt.Sprintf("%v", this.UserId) + `,`,
+		`QuorumRequirement:` + strings.Replace(fmt.Sprintf("%v", this.QuorumRequirement), "QuorumExpr", "QuorumExpr", 1) + `,`,
+		`AllowPartialRatification:` + fmt.Sprintf("%v", this.AllowPartialRatification) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpdateRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpdateRequest{`,
+		`Update:` + strings.Replace(fmt.Sprintf("%v", this.Update), "SignedEntryUpdate", "SignedEntryUpdate", 1) + `,`,
+		`Profile:` + strings.Replace(strings.Replace(this.Profile.String(), "Profile", "Profile", 1), `&`, ``, 1) + `,`,
+		`LookupParameters:` + strings.Replace(fmt.Sprintf("%v", this.LookupParameters), "LookupRequest", "LookupRequest", 1) + `,`,
+		`EmailProof:` + strings.Replace(fmt.Sprintf("%v", this.EmailProof), "EmailProof", "EmailProof", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *LookupProof) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&LookupProof{`,
+		`UserId:` + fmt.Sprintf("%v", this.UserId) + `,`,
+		`Index:` + fmt.Sprintf("%v", this.Index) + `,`,
+		`IndexProof:` + fmt.Sprintf("%v", this.IndexProof) + `,`,
+		`Ratifications:` + strings.Replace(fmt.Sprintf("%v", this.Ratifications), "SignedEpochHead", "SignedEpochHead", 1) + `,`,
+		`TreeProof:` + strings.Replace(fmt.Sprintf("%v", this.TreeProof), "TreeProof", "TreeProof", 1) + `,`,
+		`Entry:` + strings.Replace(fmt.Sprintf("%v", this.Entry), "Entry", "Entry", 1) + `,`,
+		`Profile:` + strings.Replace(fmt.Sprintf("%v", this.Profile), "Profile", "Profile", 1) + `,`,
+		`PendingRecovery:` + strings.Replace(fmt.Sprintf("%v", this.PendingRecovery), "PendingRecovery", "PendingRecovery", 1) + `,`,
+		`QuorumNotMet:` + fmt.Sprintf("%v", this.QuorumNotMet) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TreeProof) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TreeProof{`,
+		`Neighbors:` + fmt.Sprintf("%v", this.Neighbors) + `,`,
+		`ExistingIndex:` + fmt.Sprintf("%v", this.ExistingIndex) + `,`,
+		`ExistingEntryHash:` + fmt.Sprintf("%v", this.ExistingEntryHash) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Entry) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Entry{`,
+		`Index:` + fmt.Sprintf("%v", this.Index) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`UpdatePolicy:` + strings.Replace(fmt.Sprintf("%v", this.UpdatePolicy), "AuthorizationPolicy", "AuthorizationPolicy", 1) + `,`,
+		`ProfileCommitment:` + fmt.Sprintf("%v", this.ProfileCommitment) + `,`,
+		`RecoveryPolicy:` + strings.Replace(fmt.Sprintf("%v", this.RecoveryPolicy), "RecoveryPolicy", "RecoveryPolicy", 1) + `,`,
+		`Deleted:` + fmt.Sprintf("%v", this.Deleted) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RecoveryPolicy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RecoveryPolicy{`,
+		`DelaySeconds:` + fmt.Sprintf("%v", this.DelaySeconds) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PendingRecovery) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PendingRecovery{`,
+		`Update:` + strings.Replace(fmt.Sprintf("%v", this.Update), "SignedEntryUpdate", "SignedEntryUpdate", 1) + `,`,
+		`NotBefore:` + strings.Replace(strings.Replace(this.NotBefore.String(), "Timestamp", "Timestamp", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RecoveryVeto) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForSignatures := make([]uint64, 0, len(this.Signatures))
+	for k, _ := range this.Signatures {
+		keysForSignatures = append(keysForSignatures, k)
+	}
+	github_com_maditya_protobuf_sortkeys.Uint64s(keysForSignatures)
+	mapStringForSignatures := "map[uint64][]byte{"
+	for _, k := range keysForSignatures {
+		mapStringForSignatures += fmt.Sprintf("%v: %v,", k, this.Signatures[k])
+	}
+	mapStringForSignatures += "}"
+	s := strings.Join([]string{`&RecoveryVeto{`,
+		`Index:` + fmt.Sprintf("%v", this.Index) + `,`,
+		`EntryHash:` + fmt.Sprintf("%v", this.EntryHash) + `,`,
+		`Signatures:` + mapStringForSignatures + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SignedEntryUpdate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForSignatures := make([]uint64, 0, len(this.Signatures))
+	for k, _ := range this.Signatures {
+		keysForSignatures = append(keysForSignatures, k)
+	}
+	github_com_maditya_protobuf_sortkeys.Uint64s(keysForSignatures)
+	mapStringForSignatures := "map[uint64][]byte{"
+	for _, k := range keysForSignatures {
+		mapStringForSignatures += fmt.Sprintf("%v: %v,", k, this.Signatures[k])
+	}
+	mapStringForSignatures += "}"
+	s := strings.Join([]string{`&SignedEntryUpdate{`,
+		`NewEntry:` + strings.Replace(strings.Replace(this.NewEntry.String(), "Entry", "Entry", 1), `&`, ``, 1) + `,`,
+		`Signatures:` + mapStringForSignatures + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Profile) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForKeys := make([]string, 0, len(this.Keys))
+	for k, _ := range this.Keys {
+		keysForKeys = append(keysForKeys, k)
+	}
+	github_com_maditya_protobuf_sortkeys.Strings(keysForKeys)
+	mapStringForKeys := "map[string][]byte{"
+	for _, k := range keysForKeys {
+		mapStringForKeys += fmt.Sprintf("%v: %v,", k, this.Keys[k])
+	}
+	mapStringForKeys += "}"
+	s := strings.Join([]string{`&Profile{`,
+		`Nonce:` + fmt.Sprintf("%v", this.Nonce) + `,`,
+		`Keys:` + mapStringForKeys + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SignedEpochHead) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForSignatures := make([]uint64, 0, len(this.Signatures))
+	for k, _ := range this.Signatures {
+		keysForSignatures = append(keysForSignatures, k)
+	}
+	github_com_maditya_protobuf_sortkeys.Uint64s(keysForSignatures)
+	mapStringForSignatures := "map[uint64][]byte{"
+	for _, k := range keysForSignatures {
+		mapStringForSignatures += fmt.Sprintf("%v: %v,", k, this.Signatures[k])
+	}
+	mapStringForSignatures += "}"
+	s := strings.Join([]string{`&SignedEpochHead{`,
+		`Head:` + strings.Replace(strings.Replace(this.Head.String(), "TimestampedEpochHead", "TimestampedEpochHead", 1), `&`, ``, 1) + `,`,
+		`Signatures:` + mapStringForSignatures + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TimestampedEpochHead) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TimestampedEpochHead{`,
+		`Head:` + strings.Replace(strings.Replace(this.Head.String(), "EpochHead", "EpochHead", 1), `&`, ``, 1) + `,`,
+		`Timestamp:` + strings.Replace(strings.Replace(this.Timestamp.String(), "Timestamp", "Timestamp", 1), `&`, ``, 1) + `,`,
+		`Refresh:` + fmt.Sprintf("%v", this.Refresh) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EpochHead) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EpochHead{`,
+		`Realm:` + fmt.Sprintf("%v", this.Realm) + `,`,
+		`Epoch:` + fmt.Sprintf("%v", this.Epoch) + `,`,
+		`RootHash:` + fmt.Sprintf("%v", this.RootHash) + `,`,
+		`IssueTime:` + strings.Replace(strings.Replace(this.IssueTime.String(), "Timestamp", "Timestamp", 1), `&`, ``, 1) + `,`,
+		`PreviousSummaryHash:` + fmt.Sprintf("%v", this.PreviousSummaryHash) + `,`,
+		`NextEpochPolicy:` + strings.Replace(strings.Replace(this.NextEpochPolicy.String(), "AuthorizationPolicy", "AuthorizationPolicy", 1), `&`, ``, 1) + `,`,
+		`VRFPublic:` + fmt.Sprintf("%v", this.VRFPublic) + `,`,
+		`VRFRotationTime:` + strings.Replace(fmt.Sprintf("%v", this.VRFRotationTime), "Timestamp", "Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *VRFRotation) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&VRFRotation{`,
+		`VRFPublic:` + fmt.Sprintf("%v", this.VRFPublic) + `,`,
+		`Moves:` + strings.Replace(fmt.Sprintf("%v", this.Moves), "VRFMove", "VRFMove", 1) + `,`,
+		`More:` + fmt.Sprintf("%v", this.More) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *VRFMove) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&VRFMove{`,
+		`UserId:` + fmt.Sprintf("%v", this.UserId) + `,`,
+		`OldIndex:` + fmt.Sprintf("%v", this.OldIndex) + `,`,
+		`OldIndexProof:` + fmt.Sprintf("%v", this.OldIndexProof) + `,`,
+		`NewIndex:` + fmt.Sprintf("%v", this.NewIndex) + `,`,
+		`NewIndexProof:` + fmt.Sprintf("%v", this.NewIndexProof) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AuthorizationPolicy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForPublicKeys := make([]uint64, 0, len(this.PublicKeys))
+	for k, _ := range this.PublicKeys {
+		keysForPublicKeys = append(keysForPublicKeys, k)
+	}
+	github_com_maditya_protobuf_sortkeys.Uint64s(keysForPublicKeys)
+	mapStringForPublicKeys := "map[uint64]*PublicKey{"
+	for _, k := range keysForPublicKeys {
+		mapStringForPublicKeys += fmt.Sprintf("%v: %v,", k, this.PublicKeys[k])
+	}
+	mapStringForPublicKeys += "}"
+	s := strings.Join([]string{`&AuthorizationPolicy{`,
+		`PublicKeys:` + mapStringForPublicKeys + `,`,
+		`PolicyType:` + fmt.Sprintf("%v", this.PolicyType) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AuthorizationPolicy_Quorum) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AuthorizationPolicy_Quorum{`,
+		`Quorum:` + strings.Replace(fmt.Sprintf("%v", this.Quorum), "QuorumExpr", "QuorumExpr", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DomainAdminPolicy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DomainAdminPolicy{`,
+		`Domains:` + fmt.Sprintf("%v", this.Domains) + `,`,
+		`Policy:` + strings.Replace(fmt.Sprintf("%v", this.Policy), "AuthorizationPolicy", "AuthorizationPolicy", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PublicKey) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PublicKey{`,
+		`PubkeyType:` + fmt.Sprintf("%v", this.PubkeyType) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PublicKey_Ed25519) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PublicKey_Ed25519{`,
+		`Ed25519:` + fmt.Sprintf("%v", this.Ed25519) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PublicKey_ECDSAP256SHA256) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PublicKey_ECDSAP256SHA256{`,
+		`ECDSAP256SHA256:` + fmt.Sprintf("%v", this.ECDSAP256SHA256) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PublicKey_RSAPSSSHA256) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PublicKey_RSAPSSSHA256{`,
+		`RSAPSSSHA256:` + fmt.Sprintf("%v", this.RSAPSSSHA256) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *QuorumExpr) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&QuorumExpr{`,
+		`Threshold:` + fmt.Sprintf("%v", this.Threshold) + `,`,
+		`Candidates:` + fmt.Sprintf("%v", this.Candidates) + `,`,
+		`Subexpressions:` + strings.Replace(fmt.Sprintf("%v", this.Subexpressions), "QuorumExpr", "QuorumExpr", 1) + `,`,
+		`WeightedCandidates:` + strings.Replace(fmt.Sprintf("%v", this.WeightedCandidates), "WeightedCandidate", "WeightedCandidate", 1) + `,`,
+		`Op:` + fmt.Sprintf("%v", this.Op) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WeightedCandidate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WeightedCandidate{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`Weight:` + fmt.Sprintf("%v", this.Weight) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EmailProof) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EmailProof{`,
+		`ProofType:` + fmt.Sprintf("%v", this.ProofType) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EmailProof_DKIMProof) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EmailProof_DKIMProof{`,
+		`DKIMProof:` + fmt.Sprintf("%v", this.DKIMProof) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EmailProof_OIDCToken) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EmailProof_OIDCToken{`,
+		`OIDCToken:` + fmt.Sprintf("%v", this.OIDCToken) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EmailProof_SAMLResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EmailProof_SAMLResponse{`,
+		`SAMLResponse:` + fmt.Sprintf("%v", this.SAMLResponse) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EmailProof_ChallengeCode) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EmailProof_ChallengeCode{`,
+		`ChallengeCode:` + fmt.Sprintf("%v", this.ChallengeCode) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EmailProof_ClientCert) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EmailProof_ClientCert{`,
+		`ClientCert:` + strings.Replace(fmt.Sprintf("%v", this.ClientCert), "ClientCertProof", "ClientCertProof", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EmailProof_External) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EmailProof_External{`,
+		`External:` + strings.Replace(fmt.Sprintf("%v", this.External), "ExternalProof", "ExternalProof", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ExternalProof) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ExternalProof{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Proof:` + fmt.Sprintf("%v", this.Proof) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ClientCertProof) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ClientCertProof{`,
+		`Certificates:` + fmt.Sprintf("%v", this.Certificates) + `,`,
+		`Signature:` + fmt.Sprintf("%v", this.Signature) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EmailChallengeRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EmailChallengeRequest{`,
+		`UserId:` + fmt.Sprintf("%v", this.UserId) + `,`,
+		`EntryHash:` + fmt.Sprintf("%v", this.EntryHash) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EmailChallengeResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EmailChallengeResponse{`,
+		`Expiration:` + strings.Replace(strings.Replace(this.Expiration.String(), "Timestamp", "Timestamp", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListVerifiersRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListVerifiersRequest{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListVerifiersResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListVerifiersResponse{`,
+		`Verifiers:` + strings.Replace(fmt.Sprintf("%v", this.Verifiers), "VerifierInfo", "VerifierInfo", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *VerifierInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&VerifierInfo{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`PublicKey:` + strings.Replace(fmt.Sprintf("%v", this.PublicKey), "PublicKey", "PublicKey", 1) + `,`,
+		`LatestRatifiedEpoch:` + fmt.Sprintf("%v", this.LatestRatifiedEpoch) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringClient(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *LookupRequest) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClient
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LookupRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LookupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Epoch |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserId = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumRequirement", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.QuorumRequirement == nil {
+				m.QuorumRequirement = &QuorumExpr{}
+			}
+			if err := m.QuorumRequirement.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowPartialRatification", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowPartialRatification = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClient(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthClient
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateRequest) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClient
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Update == nil {
+				m.Update = &SignedEntryUpdate{}
+			}
+			if err := m.Update.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Profile", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Profile.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LookupParameters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LookupParameters == nil {
+				m.LookupParameters = &LookupRequest{}
+			}
+			if err := m.LookupParameters.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 1000:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmailProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EmailProof == nil {
+				m.EmailProof = &EmailProof{}
+			}
+			if err := m.EmailProof.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClient(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthClient
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LookupProof) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClient
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LookupProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LookupProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserId = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = append(m.Index[:0], data[iNdEx:postIndex]...)
+			if m.Index == nil {
+				m.Index = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexProof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IndexProof = append(m.IndexProof[:0], data[iNdEx:postIndex]...)
+			if m.IndexProof == nil {
+				m.IndexProof = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ratifications", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ratifications = append(m.Ratifications, &SignedEpochHead{})
+			if err := m.Ratifications[len(m.Ratifications)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TreeProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TreeProof == nil {
+				m.TreeProof = &TreeProof{}
+			}
+			if err := m.TreeProof.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Entry == nil {
+				m.Entry = &EncodedEntry{}
+			}
+			if err := m.Entry.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Profile", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Profile == nil {
+				m.Profile = &EncodedProfile{}
+			}
+			if err := m.Profile.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingRecovery", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PendingRecovery == nil {
+				m.PendingRecovery = &PendingRecovery{}
+			}
+			if err := m.PendingRecovery.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumNotMet", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.QuorumNotMet = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClient(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthClient
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TreeProof) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClient
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TreeProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TreeProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Neighbors", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Neighbors = append(m.Neighbors, make([]byte, postIndex-iNdEx))
+			copy(m.Neighbors[len(m.Neighbors)-1], data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExistingIndex", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExistingIndex = append(m.ExistingIndex[:0], data[iNdEx:postIndex]...)
+			if m.ExistingIndex == nil {
+				m.ExistingIndex = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExistingEntryHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExistingEntryHash = append(m.ExistingEntryHash[:0], data[iNdEx:postIndex]...)
+			if m.ExistingEntryHash == nil {
+				m.ExistingEntryHash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClient(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthClient
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Entry) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClient
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Entry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Entry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = append(m.Index[:0], data[iNdEx:postIndex]...)
+			if m.Index == nil {
+				m.Index = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Version |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatePolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UpdatePolicy == nil {
+				m.UpdatePolicy = &AuthorizationPolicy{}
+			}
+			if err := m.UpdatePolicy.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProfileCommitment", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProfileCommitment = append(m.ProfileCommitment[:0], data[iNdEx:postIndex]...)
+			if m.ProfileCommitment == nil {
+				m.ProfileCommitment = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecoveryPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RecoveryPolicy == nil {
+				m.RecoveryPolicy = &RecoveryPolicy{}
+			}
+			if err := m.RecoveryPolicy.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deleted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deleted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClient(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthClient
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecoveryPolicy) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClient
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecoveryPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecoveryPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelaySeconds", wireType)
+			}
+			m.DelaySeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.DelaySeconds |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClient(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthClient
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingRecovery) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClient
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingRecovery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingRecovery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Update == nil {
+				m.Update = &SignedEntryUpdate{}
+			}
+			if err := m.Update.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotBefore", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NotBefore.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClient(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthClient
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecoveryVeto) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClient
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecoveryVeto: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecoveryVeto: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = append(m.Index[:0], data[iNdEx:postIndex]...)
+			if m.Index == nil {
+				m.Index = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntryHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EntryHash = append(m.EntryHash[:0], data[iNdEx:postIndex]...)
+			if m.EntryHash == nil {
+				m.EntryHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			var mapkey uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			mapkey = uint64(data[iNdEx-8])
+			mapkey |= uint64(data[iNdEx-7]) << 8
+			mapkey |= uint64(data[iNdEx-6]) << 16
+			mapkey |= uint64(data[iNdEx-5]) << 24
+			mapkey |= uint64(data[iNdEx-4]) << 32
+			mapkey |= uint64(data[iNdEx-3]) << 40
+			mapkey |= uint64(data[iNdEx-2]) << 48
+			mapkey |= uint64(data[iNdEx-1]) << 56
+			if m.Signatures == nil {
+				m.Signatures = make(map[uint64][]byte)
+			}
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowClient
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var mapbyteLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowClient
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[iNdEx]
+					iNdEx++
+					mapbyteLen |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intMapbyteLen := int(mapbyteLen)
+				if intMapbyteLen < 0 {
+					return ErrInvalidLengthClient
+				}
+				postbytesIndex := iNdEx + intMapbyteLen
+				if postbytesIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				mapvalue := make([]byte, mapbyteLen)
+				copy(mapvalue, data[iNdEx:postbytesIndex])
+				iNdEx = postbytesIndex
+				m.Signatures[mapkey] = mapvalue
+			} else {
+				var mapvalue []byte
+				m.Signatures[mapkey] = mapvalue
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClient(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthClient
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignedEntryUpdate) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClient
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignedEntryUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignedEntryUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewEntry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NewEntry.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			var mapkey uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			mapkey = uint64(data[iNdEx-8])
+			mapkey |= uint64(data[iNdEx-7]) << 8
+			mapkey |= uint64(data[iNdEx-6]) << 16
+			mapkey |= uint64(data[iNdEx-5]) << 24
+			mapkey |= uint64(data[iNdEx-4]) << 32
+			mapkey |= uint64(data[iNdEx-3]) << 40
+			mapkey |= uint64(data[iNdEx-2]) << 48
+			mapkey |= uint64(data[iNdEx-1]) << 56
+			if m.Signatures == nil {
+				m.Signatures = make(map[uint64][]byte)
+			}
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowClient
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var mapbyteLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowClient
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[iNdEx]
+					iNdEx++
+					mapbyteLen |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intMapbyteLen := int(mapbyteLen)
+				if intMapbyteLen < 0 {
+					return ErrInvalidLengthClient
+				}
+				postbytesIndex := iNdEx + intMapbyteLen
+				if postbytesIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				mapvalue := make([]byte, mapbyteLen)
+				copy(mapvalue, data[iNdEx:postbytesIndex])
+				iNdEx = postbytesIndex
+				m.Signatures[mapkey] = mapvalue
+			} else {
+				var mapvalue []byte
+				m.Signatures[mapkey] = mapvalue
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClient(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthClient
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Profile) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClient
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Profile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Profile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nonce = append(m.Nonce[:0], data[iNdEx:postIndex]...)
+			if m.Nonce == nil {
+				m.Nonce = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			var stringLenmapkey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenmapkey := int(stringLenmapkey)
+			if intStringLenmapkey < 0 {
+				return ErrInvalidLengthClient
+			}
+			postStringIndexmapkey := iNdEx + intStringLenmapkey
+			if postStringIndexmapkey > l {
+				return io.ErrUnexpectedEOF
+			}
+			mapkey := string(data[iNdEx:postStringIndexmapkey])
+			iNdEx = postStringIndexmapkey
+			if m.Keys == nil {
+				m.Keys = make(map[string][]byte)
+			}
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowClient
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var mapbyteLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowClient
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[iNdEx]
+					iNdEx++
+					mapbyteLen |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intMapbyteLen := int(mapbyteLen)
+				if intMapbyteLen < 0 {
+					return ErrInvalidLengthClient
+				}
+				postbytesIndex := iNdEx + intMapbyteLen
+				if postbytesIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				mapvalue := make([]byte, mapbyteLen)
+				copy(mapvalue, data[iNdEx:postbytesIndex])
+				iNdEx = postbytesIndex
+				m.Keys[mapkey] = mapvalue
+			} else {
+				var mapvalue []byte
+				m.Keys[mapkey] = mapvalue
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClient(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthClient
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignedEpochHead) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClient
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignedEpochHead: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignedEpochHead: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Head", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Head.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			var mapkey uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			mapkey = uint64(data[iNdEx-8])
+			mapkey |= uint64(data[iNdEx-7]) << 8
+			mapkey |= uint64(data[iNdEx-6]) << 16
+			mapkey |= uint64(data[iNdEx-5]) << 24
+			mapkey |= uint64(data[iNdEx-4]) << 32
+			mapkey |= uint64(data[iNdEx-3]) << 40
+			mapkey |= uint64(data[iNdEx-2]) << 48
+			mapkey |= uint64(data[iNdEx-1]) << 56
+			if m.Signatures == nil {
+				m.Signatures = make(map[uint64][]byte)
+			}
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowClient
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var mapbyteLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowClient
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[iNdEx]
+					iNdEx++
+					mapbyteLen |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intMapbyteLen := int(mapbyteLen)
+				if intMapbyteLen < 0 {
+					return ErrInvalidLengthClient
+				}
+				postbytesIndex := iNdEx + intMapbyteLen
+				if postbytesIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				mapvalue := make([]byte, mapbyteLen)
+				copy(mapvalue, data[iNdEx:postbytesIndex])
+				iNdEx = postbytesIndex
+				m.Signatures[mapkey] = mapvalue
+			} else {
+				var mapvalue []byte
+				m.Signatures[mapkey] = mapvalue
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClient(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthClient
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TimestampedEpochHead) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClient
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TimestampedEpochHead: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TimestampedEpochHead: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Head", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Head.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Timestamp.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refresh", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Refresh = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClient(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthClient
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochHead) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClient
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochHead: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochHead: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Realm", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Realm = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Epoch |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RootHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RootHash = append(m.RootHash[:0], data[iNdEx:postIndex]...)
+			if m.RootHash == nil {
+				m.RootHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssueTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClient
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := data[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.IssueTime.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: LookupRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LookupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousSummaryHash", wireType)
 			}
-			m.Epoch = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowClient
@@ -4427,16 +10350,28 @@ func (m *LookupRequest) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.Epoch |= (uint64(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			if byteLen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousSummaryHash = append(m.PreviousSummaryHash[:0], data[iNdEx:postIndex]...)
+			if m.PreviousSummaryHash == nil {
+				m.PreviousSummaryHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextEpochPolicy", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowClient
@@ -4446,24 +10381,56 @@ func (m *LookupRequest) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthClient
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UserId = string(data[iNdEx:postIndex])
+			if err := m.NextEpochPolicy.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuorumRequirement", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VRFPublic", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VRFPublic = append(m.VRFPublic[:0], data[iNdEx:postIndex]...)
+			if m.VRFPublic == nil {
+				m.VRFPublic = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VRFRotationTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4487,10 +10454,10 @@ func (m *LookupRequest) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.QuorumRequirement == nil {
-				m.QuorumRequirement = &QuorumExpr{}
+			if m.VRFRotationTime == nil {
+				m.VRFRotationTime = &Timestamp{}
 			}
-			if err := m.QuorumRequirement.Unmarshal(data[iNdEx:postIndex]); err != nil {
+			if err := m.VRFRotationTime.Unmarshal(data[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4515,7 +10482,7 @@ func (m *LookupRequest) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *UpdateRequest) Unmarshal(data []byte) error {
+func (m *VRFRotation) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4538,50 +10505,17 @@ func (m *UpdateRequest) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: VRFRotation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VRFRotation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowClient
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthClient
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Update == nil {
-				m.Update = &SignedEntryUpdate{}
-			}
-			if err := m.Update.Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Profile", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VRFPublic", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowClient
@@ -4591,25 +10525,26 @@ func (m *UpdateRequest) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthClient
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Profile.Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
+			m.VRFPublic = append(m.VRFPublic[:0], data[iNdEx:postIndex]...)
+			if m.VRFPublic == nil {
+				m.VRFPublic = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LookupParameters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Moves", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4633,18 +10568,16 @@ func (m *UpdateRequest) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LookupParameters == nil {
-				m.LookupParameters = &LookupRequest{}
-			}
-			if err := m.LookupParameters.Unmarshal(data[iNdEx:postIndex]); err != nil {
+			m.Moves = append(m.Moves, &VRFMove{})
+			if err := m.Moves[len(m.Moves)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 1000:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EmailProof", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field More", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowClient
@@ -4654,25 +10587,12 @@ func (m *UpdateRequest) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthClient
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.EmailProof == nil {
-				m.EmailProof = &EmailProof{}
-			}
-			if err := m.EmailProof.Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.More = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipClient(data[iNdEx:])
@@ -4694,7 +10614,7 @@ func (m *UpdateRequest) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *LookupProof) Unmarshal(data []byte) error {
+func (m *VRFMove) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4717,10 +10637,10 @@ func (m *LookupProof) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LookupProof: wiretype end group for non-group")
+			return fmt.Errorf("proto: VRFMove: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LookupProof: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VRFMove: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4754,7 +10674,7 @@ func (m *LookupProof) Unmarshal(data []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldIndex", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4778,14 +10698,76 @@ func (m *LookupProof) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Index = append(m.Index[:0], data[iNdEx:postIndex]...)
-			if m.Index == nil {
-				m.Index = []byte{}
+			m.OldIndex = append(m.OldIndex[:0], data[iNdEx:postIndex]...)
+			if m.OldIndex == nil {
+				m.OldIndex = []byte{}
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IndexProof", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldIndexProof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldIndexProof = append(m.OldIndexProof[:0], data[iNdEx:postIndex]...)
+			if m.OldIndexProof == nil {
+				m.OldIndexProof = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewIndex", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewIndex = append(m.NewIndex[:0], data[iNdEx:postIndex]...)
+			if m.NewIndex == nil {
+				m.NewIndex = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewIndexProof", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4805,18 +10787,68 @@ func (m *LookupProof) Unmarshal(data []byte) error {
 			if byteLen < 0 {
 				return ErrInvalidLengthClient
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewIndexProof = append(m.NewIndexProof[:0], data[iNdEx:postIndex]...)
+			if m.NewIndexProof == nil {
+				m.NewIndexProof = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClient(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthClient
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AuthorizationPolicy) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClient
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IndexProof = append(m.IndexProof[:0], data[iNdEx:postIndex]...)
-			if m.IndexProof == nil {
-				m.IndexProof = []byte{}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 4:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuthorizationPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuthorizationPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ratifications", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4840,16 +10872,7 @@ func (m *LookupProof) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ratifications = append(m.Ratifications, &SignedEpochHead{})
-			if err := m.Ratifications[len(m.Ratifications)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TreeProof", wireType)
-			}
-			var msglen int
+			var keykey uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowClient
@@ -4859,61 +10882,82 @@ func (m *LookupProof) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				keykey |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthClient
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			var mapkey uint64
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TreeProof == nil {
-				m.TreeProof = &TreeProof{}
-			}
-			if err := m.TreeProof.Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entry", wireType)
+			iNdEx += 8
+			mapkey = uint64(data[iNdEx-8])
+			mapkey |= uint64(data[iNdEx-7]) << 8
+			mapkey |= uint64(data[iNdEx-6]) << 16
+			mapkey |= uint64(data[iNdEx-5]) << 24
+			mapkey |= uint64(data[iNdEx-4]) << 32
+			mapkey |= uint64(data[iNdEx-3]) << 40
+			mapkey |= uint64(data[iNdEx-2]) << 48
+			mapkey |= uint64(data[iNdEx-1]) << 56
+			if m.PublicKeys == nil {
+				m.PublicKeys = make(map[uint64]*PublicKey)
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowClient
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowClient
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				var mapmsglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowClient
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[iNdEx]
+					iNdEx++
+					mapmsglen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if mapmsglen < 0 {
+					return ErrInvalidLengthClient
+				}
+				postmsgIndex := iNdEx + mapmsglen
+				if mapmsglen < 0 {
+					return ErrInvalidLengthClient
+				}
+				if postmsgIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := data[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
+				mapvalue := &PublicKey{}
+				if err := mapvalue.Unmarshal(data[iNdEx:postmsgIndex]); err != nil {
+					return err
 				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthClient
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Entry == nil {
-				m.Entry = &EncodedEntry{}
-			}
-			if err := m.Entry.Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
+				iNdEx = postmsgIndex
+				m.PublicKeys[mapkey] = mapvalue
+			} else {
+				var mapvalue *PublicKey
+				m.PublicKeys[mapkey] = mapvalue
 			}
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Profile", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4937,12 +10981,11 @@ func (m *LookupProof) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Profile == nil {
-				m.Profile = &EncodedProfile{}
-			}
-			if err := m.Profile.Unmarshal(data[iNdEx:postIndex]); err != nil {
+			v := &QuorumExpr{}
+			if err := v.Unmarshal(data[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.PolicyType = &AuthorizationPolicy_Quorum{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4965,7 +11008,7 @@ func (m *LookupProof) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *TreeProof) Unmarshal(data []byte) error {
+func (m *DomainAdminPolicy) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4988,17 +11031,17 @@ func (m *TreeProof) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TreeProof: wiretype end group for non-group")
+			return fmt.Errorf("proto: DomainAdminPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TreeProof: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DomainAdminPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Neighbors", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Domains", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowClient
@@ -5008,26 +11051,26 @@ func (m *TreeProof) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthClient
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Neighbors = append(m.Neighbors, make([]byte, postIndex-iNdEx))
-			copy(m.Neighbors[len(m.Neighbors)-1], data[iNdEx:postIndex])
+			m.Domains = append(m.Domains, string(data[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExistingIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowClient
@@ -5037,52 +11080,23 @@ func (m *TreeProof) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthClient
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExistingIndex = append(m.ExistingIndex[:0], data[iNdEx:postIndex]...)
-			if m.ExistingIndex == nil {
-				m.ExistingIndex = []byte{}
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExistingEntryHash", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowClient
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthClient
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if m.Policy == nil {
+				m.Policy = &AuthorizationPolicy{}
 			}
-			m.ExistingEntryHash = append(m.ExistingEntryHash[:0], data[iNdEx:postIndex]...)
-			if m.ExistingEntryHash == nil {
-				m.ExistingEntryHash = []byte{}
+			if err := m.Policy.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -5106,7 +11120,7 @@ func (m *TreeProof) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *Entry) Unmarshal(data []byte) error {
+func (m *PublicKey) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5129,15 +11143,15 @@ func (m *Entry) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Entry: wiretype end group for non-group")
+			return fmt.Errorf("proto: PublicKey: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Entry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PublicKey: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ed25519", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -5161,35 +11175,15 @@ func (m *Entry) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Index = append(m.Index[:0], data[iNdEx:postIndex]...)
-			if m.Index == nil {
-				m.Index = []byte{}
-			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, data[iNdEx:postIndex])
+			m.PubkeyType = &PublicKey_Ed25519{v}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
-			}
-			m.Version = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowClient
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				m.Version |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatePolicy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ECDSAP256SHA256", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowClient
@@ -5199,28 +11193,25 @@ func (m *Entry) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthClient
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UpdatePolicy == nil {
-				m.UpdatePolicy = &AuthorizationPolicy{}
-			}
-			if err := m.UpdatePolicy.Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, data[iNdEx:postIndex])
+			m.PubkeyType = &PublicKey_ECDSAP256SHA256{v}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProfileCommitment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RSAPSSSHA256", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -5244,10 +11235,9 @@ func (m *Entry) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProfileCommitment = append(m.ProfileCommitment[:0], data[iNdEx:postIndex]...)
-			if m.ProfileCommitment == nil {
-				m.ProfileCommitment = []byte{}
-			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, data[iNdEx:postIndex])
+			m.PubkeyType = &PublicKey_RSAPSSSHA256{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5270,7 +11260,7 @@ func (m *Entry) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *SignedEntryUpdate) Unmarshal(data []byte) error {
+func (m *QuorumExpr) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5293,15 +11283,92 @@ func (m *SignedEntryUpdate) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SignedEntryUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuorumExpr: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignedEntryUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuorumExpr: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Threshold |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowClient
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthClient
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += 8
+					v = uint64(data[iNdEx-8])
+					v |= uint64(data[iNdEx-7]) << 8
+					v |= uint64(data[iNdEx-6]) << 16
+					v |= uint64(data[iNdEx-5]) << 24
+					v |= uint64(data[iNdEx-4]) << 32
+					v |= uint64(data[iNdEx-3]) << 40
+					v |= uint64(data[iNdEx-2]) << 48
+					v |= uint64(data[iNdEx-1]) << 56
+					m.Candidates = append(m.Candidates, v)
+				}
+			} else if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				iNdEx += 8
+				v = uint64(data[iNdEx-8])
+				v |= uint64(data[iNdEx-7]) << 8
+				v |= uint64(data[iNdEx-6]) << 16
+				v |= uint64(data[iNdEx-5]) << 24
+				v |= uint64(data[iNdEx-4]) << 32
+				v |= uint64(data[iNdEx-3]) << 40
+				v |= uint64(data[iNdEx-2]) << 48
+				v |= uint64(data[iNdEx-1]) << 56
+				m.Candidates = append(m.Candidates, v)
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Candidates", wireType)
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewEntry", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Subexpressions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5325,13 +11392,14 @@ func (m *SignedEntryUpdate) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NewEntry.Unmarshal(data[iNdEx:postIndex]); err != nil {
+			m.Subexpressions = append(m.Subexpressions, &QuorumExpr{})
+			if err := m.Subexpressions[len(m.Subexpressions)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightedCandidates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5355,7 +11423,16 @@ func (m *SignedEntryUpdate) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var keykey uint64
+			m.WeightedCandidates = append(m.WeightedCandidates, &WeightedCandidate{})
+			if err := m.WeightedCandidates[len(m.WeightedCandidates)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
+			}
+			m.Op = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowClient
@@ -5365,75 +11442,11 @@ func (m *SignedEntryUpdate) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				keykey |= (uint64(b) & 0x7F) << shift
+				m.Op |= (QuorumOp(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			var mapkey uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += 8
-			mapkey = uint64(data[iNdEx-8])
-			mapkey |= uint64(data[iNdEx-7]) << 8
-			mapkey |= uint64(data[iNdEx-6]) << 16
-			mapkey |= uint64(data[iNdEx-5]) << 24
-			mapkey |= uint64(data[iNdEx-4]) << 32
-			mapkey |= uint64(data[iNdEx-3]) << 40
-			mapkey |= uint64(data[iNdEx-2]) << 48
-			mapkey |= uint64(data[iNdEx-1]) << 56
-			if m.Signatures == nil {
-				m.Signatures = make(map[uint64][]byte)
-			}
-			if iNdEx < postIndex {
-				var valuekey uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowClient
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := data[iNdEx]
-					iNdEx++
-					valuekey |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				var mapbyteLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowClient
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := data[iNdEx]
-					iNdEx++
-					mapbyteLen |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intMapbyteLen := int(mapbyteLen)
-				if intMapbyteLen < 0 {
-					return ErrInvalidLengthClient
-				}
-				postbytesIndex := iNdEx + intMapbyteLen
-				if postbytesIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				mapvalue := make([]byte, mapbyteLen)
-				copy(mapvalue, data[iNdEx:postbytesIndex])
-				iNdEx = postbytesIndex
-				m.Signatures[mapkey] = mapvalue
-			} else {
-				var mapvalue []byte
-				m.Signatures[mapkey] = mapvalue
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipClient(data[iNdEx:])
@@ -5455,108 +11468,57 @@ func (m *SignedEntryUpdate) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *Profile) Unmarshal(data []byte) error {
+func (m *WeightedCandidate) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowClient
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := data[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Profile: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Profile: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowClient
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthClient
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Nonce = append(m.Nonce[:0], data[iNdEx:postIndex]...)
-			if m.Nonce == nil {
-				m.Nonce = []byte{}
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowClient
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClient
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthClient
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WeightedCandidate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WeightedCandidate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			var keykey uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowClient
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				keykey |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += 8
+			m.ID = uint64(data[iNdEx-8])
+			m.ID |= uint64(data[iNdEx-7]) << 8
+			m.ID |= uint64(data[iNdEx-6]) << 16
+			m.ID |= uint64(data[iNdEx-5]) << 24
+			m.ID |= uint64(data[iNdEx-4]) << 32
+			m.ID |= uint64(data[iNdEx-3]) << 40
+			m.ID |= uint64(data[iNdEx-2]) << 48
+			m.ID |= uint64(data[iNdEx-1]) << 56
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
 			}
-			var stringLenmapkey uint64
+			m.Weight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowClient
@@ -5566,72 +11528,11 @@ func (m *Profile) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				m.Weight |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLenmapkey := int(stringLenmapkey)
-			if intStringLenmapkey < 0 {
-				return ErrInvalidLengthClient
-			}
-			postStringIndexmapkey := iNdEx + intStringLenmapkey
-			if postStringIndexmapkey > l {
-				return io.ErrUnexpectedEOF
-			}
-			mapkey := string(data[iNdEx:postStringIndexmapkey])
-			iNdEx = postStringIndexmapkey
-			if m.Keys == nil {
-				m.Keys = make(map[string][]byte)
-			}
-			if iNdEx < postIndex {
-				var valuekey uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowClient
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := data[iNdEx]
-					iNdEx++
-					valuekey |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				var mapbyteLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowClient
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := data[iNdEx]
-					iNdEx++
-					mapbyteLen |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intMapbyteLen := int(mapbyteLen)
-				if intMapbyteLen < 0 {
-					return ErrInvalidLengthClient
-				}
-				postbytesIndex := iNdEx + intMapbyteLen
-				if postbytesIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				mapvalue := make([]byte, mapbyteLen)
-				copy(mapvalue, data[iNdEx:postbytesIndex])
-				iNdEx = postbytesIndex
-				m.Keys[mapkey] = mapvalue
-			} else {
-				var mapvalue []byte
-				m.Keys[mapkey] = mapvalue
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipClient(data[iNdEx:])
@@ -5653,7 +11554,7 @@ func (m *Profile) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *SignedEpochHead) Unmarshal(data []byte) error {
+func (m *EmailProof) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5676,17 +11577,17 @@ func (m *SignedEpochHead) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SignedEpochHead: wiretype end group for non-group")
+			return fmt.Errorf("proto: EmailProof: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignedEpochHead: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EmailProof: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Head", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DKIMProof", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowClient
@@ -5696,27 +11597,27 @@ func (m *SignedEpochHead) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthClient
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Head.Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, data[iNdEx:postIndex])
+			m.ProofType = &EmailProof_DKIMProof{v}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OIDCToken", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowClient
@@ -5726,19 +11627,26 @@ func (m *SignedEpochHead) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthClient
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var keykey uint64
+			m.ProofType = &EmailProof_OIDCToken{string(data[iNdEx:postIndex])}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SAMLResponse", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowClient
@@ -5748,128 +11656,53 @@ func (m *SignedEpochHead) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				keykey |= (uint64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			var mapkey uint64
-			if (iNdEx + 8) > l {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += 8
-			mapkey = uint64(data[iNdEx-8])
-			mapkey |= uint64(data[iNdEx-7]) << 8
-			mapkey |= uint64(data[iNdEx-6]) << 16
-			mapkey |= uint64(data[iNdEx-5]) << 24
-			mapkey |= uint64(data[iNdEx-4]) << 32
-			mapkey |= uint64(data[iNdEx-3]) << 40
-			mapkey |= uint64(data[iNdEx-2]) << 48
-			mapkey |= uint64(data[iNdEx-1]) << 56
-			if m.Signatures == nil {
-				m.Signatures = make(map[uint64][]byte)
+			m.ProofType = &EmailProof_SAMLResponse{string(data[iNdEx:postIndex])}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeCode", wireType)
 			}
-			if iNdEx < postIndex {
-				var valuekey uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowClient
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := data[iNdEx]
-					iNdEx++
-					valuekey |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				var mapbyteLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowClient
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := data[iNdEx]
-					iNdEx++
-					mapbyteLen |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intMapbyteLen := int(mapbyteLen)
-				if intMapbyteLen < 0 {
-					return ErrInvalidLengthClient
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
 				}
-				postbytesIndex := iNdEx + intMapbyteLen
-				if postbytesIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				mapvalue := make([]byte, mapbyteLen)
-				copy(mapvalue, data[iNdEx:postbytesIndex])
-				iNdEx = postbytesIndex
-				m.Signatures[mapkey] = mapvalue
-			} else {
-				var mapvalue []byte
-				m.Signatures[mapkey] = mapvalue
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipClient(data[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthClient
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TimestampedEpochHead) Unmarshal(data []byte) error {
-	l := len(data)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowClient
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClient
 			}
-			b := data[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TimestampedEpochHead: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TimestampedEpochHead: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.ProofType = &EmailProof_ChallengeCode{string(data[iNdEx:postIndex])}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Head", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientCert", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5893,13 +11726,15 @@ func (m *TimestampedEpochHead) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Head.Unmarshal(data[iNdEx:postIndex]); err != nil {
+			v := &ClientCertProof{}
+			if err := v.Unmarshal(data[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.ProofType = &EmailProof_ClientCert{v}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field External", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5923,9 +11758,11 @@ func (m *TimestampedEpochHead) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Timestamp.Unmarshal(data[iNdEx:postIndex]); err != nil {
+			v := &ExternalProof{}
+			if err := v.Unmarshal(data[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.ProofType = &EmailProof_External{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5948,7 +11785,7 @@ func (m *TimestampedEpochHead) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *EpochHead) Unmarshal(data []byte) error {
+func (m *ExternalProof) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5971,15 +11808,15 @@ func (m *EpochHead) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EpochHead: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExternalProof: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EpochHead: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExternalProof: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Realm", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6004,30 +11841,11 @@ func (m *EpochHead) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Realm = string(data[iNdEx:postIndex])
+			m.Type = string(data[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
-			}
-			m.Epoch = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowClient
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				m.Epoch |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RootHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -6051,44 +11869,64 @@ func (m *EpochHead) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RootHash = append(m.RootHash[:0], data[iNdEx:postIndex]...)
-			if m.RootHash == nil {
-				m.RootHash = []byte{}
+			m.Proof = append(m.Proof[:0], data[iNdEx:postIndex]...)
+			if m.Proof == nil {
+				m.Proof = []byte{}
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IssueTime", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowClient
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClient(data[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthClient
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.IssueTime.Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClientCertProof) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClient
 			}
-			iNdEx = postIndex
-		case 5:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClientCertProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClientCertProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousSummaryHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Certificates", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -6112,16 +11950,14 @@ func (m *EpochHead) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PreviousSummaryHash = append(m.PreviousSummaryHash[:0], data[iNdEx:postIndex]...)
-			if m.PreviousSummaryHash == nil {
-				m.PreviousSummaryHash = []byte{}
-			}
+			m.Certificates = append(m.Certificates, make([]byte, postIndex-iNdEx))
+			copy(m.Certificates[len(m.Certificates)-1], data[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextEpochPolicy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowClient
@@ -6131,20 +11967,21 @@ func (m *EpochHead) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthClient
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NextEpochPolicy.Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
+			m.Signature = append(m.Signature[:0], data[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -6168,7 +12005,7 @@ func (m *EpochHead) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *AuthorizationPolicy) Unmarshal(data []byte) error {
+func (m *EmailChallengeRequest) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6187,130 +12024,50 @@ func (m *AuthorizationPolicy) Unmarshal(data []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AuthorizationPolicy: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AuthorizationPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowClient
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthClient
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			var keykey uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowClient
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				keykey |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			var mapkey uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += 8
-			mapkey = uint64(data[iNdEx-8])
-			mapkey |= uint64(data[iNdEx-7]) << 8
-			mapkey |= uint64(data[iNdEx-6]) << 16
-			mapkey |= uint64(data[iNdEx-5]) << 24
-			mapkey |= uint64(data[iNdEx-4]) << 32
-			mapkey |= uint64(data[iNdEx-3]) << 40
-			mapkey |= uint64(data[iNdEx-2]) << 48
-			mapkey |= uint64(data[iNdEx-1]) << 56
-			if m.PublicKeys == nil {
-				m.PublicKeys = make(map[uint64]*PublicKey)
-			}
-			if iNdEx < postIndex {
-				var valuekey uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowClient
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := data[iNdEx]
-					iNdEx++
-					valuekey |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				var mapmsglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowClient
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := data[iNdEx]
-					iNdEx++
-					mapmsglen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if mapmsglen < 0 {
-					return ErrInvalidLengthClient
-				}
-				postmsgIndex := iNdEx + mapmsglen
-				if mapmsglen < 0 {
-					return ErrInvalidLengthClient
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EmailChallengeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EmailChallengeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
 				}
-				if postmsgIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				mapvalue := &PublicKey{}
-				if err := mapvalue.Unmarshal(data[iNdEx:postmsgIndex]); err != nil {
-					return err
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				iNdEx = postmsgIndex
-				m.PublicKeys[mapkey] = mapvalue
-			} else {
-				var mapvalue *PublicKey
-				m.PublicKeys[mapkey] = mapvalue
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserId = string(data[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EntryHash", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowClient
@@ -6320,23 +12077,22 @@ func (m *AuthorizationPolicy) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthClient
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &QuorumExpr{}
-			if err := v.Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
+			m.EntryHash = append(m.EntryHash[:0], data[iNdEx:postIndex]...)
+			if m.EntryHash == nil {
+				m.EntryHash = []byte{}
 			}
-			m.PolicyType = &AuthorizationPolicy_Quorum{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6359,7 +12115,7 @@ func (m *AuthorizationPolicy) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *PublicKey) Unmarshal(data []byte) error {
+func (m *EmailChallengeResponse) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6382,17 +12138,17 @@ func (m *PublicKey) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PublicKey: wiretype end group for non-group")
+			return fmt.Errorf("proto: EmailChallengeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PublicKey: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EmailChallengeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ed25519", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowClient
@@ -6402,21 +12158,21 @@ func (m *PublicKey) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthClient
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := make([]byte, postIndex-iNdEx)
-			copy(v, data[iNdEx:postIndex])
-			m.PubkeyType = &PublicKey_Ed25519{v}
+			if err := m.Expiration.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6439,7 +12195,7 @@ func (m *PublicKey) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *QuorumExpr) Unmarshal(data []byte) error {
+func (m *ListVerifiersRequest) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6462,92 +12218,65 @@ func (m *QuorumExpr) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuorumExpr: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListVerifiersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuorumExpr: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListVerifiersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClient(data[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.Threshold = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowClient
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				m.Threshold |= (uint32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if skippy < 0 {
+				return ErrInvalidLengthClient
 			}
-		case 2:
-			if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowClient
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := data[iNdEx]
-					iNdEx++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthClient
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					if (iNdEx + 8) > l {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += 8
-					v = uint64(data[iNdEx-8])
-					v |= uint64(data[iNdEx-7]) << 8
-					v |= uint64(data[iNdEx-6]) << 16
-					v |= uint64(data[iNdEx-5]) << 24
-					v |= uint64(data[iNdEx-4]) << 32
-					v |= uint64(data[iNdEx-3]) << 40
-					v |= uint64(data[iNdEx-2]) << 48
-					v |= uint64(data[iNdEx-1]) << 56
-					m.Candidates = append(m.Candidates, v)
-				}
-			} else if wireType == 1 {
-				var v uint64
-				if (iNdEx + 8) > l {
-					return io.ErrUnexpectedEOF
-				}
-				iNdEx += 8
-				v = uint64(data[iNdEx-8])
-				v |= uint64(data[iNdEx-7]) << 8
-				v |= uint64(data[iNdEx-6]) << 16
-				v |= uint64(data[iNdEx-5]) << 24
-				v |= uint64(data[iNdEx-4]) << 32
-				v |= uint64(data[iNdEx-3]) << 40
-				v |= uint64(data[iNdEx-2]) << 48
-				v |= uint64(data[iNdEx-1]) << 56
-				m.Candidates = append(m.Candidates, v)
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Candidates", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 3:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListVerifiersResponse) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClient
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListVerifiersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListVerifiersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subexpressions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Verifiers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6571,8 +12300,8 @@ func (m *QuorumExpr) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Subexpressions = append(m.Subexpressions, &QuorumExpr{})
-			if err := m.Subexpressions[len(m.Subexpressions)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+			m.Verifiers = append(m.Verifiers, &VerifierInfo{})
+			if err := m.Verifiers[len(m.Verifiers)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6597,7 +12326,7 @@ func (m *QuorumExpr) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *EmailProof) Unmarshal(data []byte) error {
+func (m *VerifierInfo) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6620,47 +12349,34 @@ func (m *EmailProof) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EmailProof: wiretype end group for non-group")
+			return fmt.Errorf("proto: VerifierInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EmailProof: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VerifierInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DKIMProof", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowClient
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthClient
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
+			m.ID = 0
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := make([]byte, postIndex-iNdEx)
-			copy(v, data[iNdEx:postIndex])
-			m.ProofType = &EmailProof_DKIMProof{v}
-			iNdEx = postIndex
+			iNdEx += 8
+			m.ID = uint64(data[iNdEx-8])
+			m.ID |= uint64(data[iNdEx-7]) << 8
+			m.ID |= uint64(data[iNdEx-6]) << 16
+			m.ID |= uint64(data[iNdEx-5]) << 24
+			m.ID |= uint64(data[iNdEx-4]) << 32
+			m.ID |= uint64(data[iNdEx-3]) << 40
+			m.ID |= uint64(data[iNdEx-2]) << 48
+			m.ID |= uint64(data[iNdEx-1]) << 56
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OIDCToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowClient
@@ -6670,26 +12386,30 @@ func (m *EmailProof) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthClient
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProofType = &EmailProof_OIDCToken{string(data[iNdEx:postIndex])}
+			if m.PublicKey == nil {
+				m.PublicKey = &PublicKey{}
+			}
+			if err := m.PublicKey.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SAMLResponse", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestRatifiedEpoch", wireType)
 			}
-			var stringLen uint64
+			m.LatestRatifiedEpoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowClient
@@ -6699,21 +12419,11 @@ func (m *EmailProof) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.LatestRatifiedEpoch |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthClient
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ProofType = &EmailProof_SAMLResponse{string(data[iNdEx:postIndex])}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipClient(data[iNdEx:])
@@ -6843,86 +12553,146 @@ var (
 func init() { proto1.RegisterFile("client.proto", fileDescriptorClient) }
 
 var fileDescriptorClient = []byte{
-	// 1285 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xf7, 0x24, 0xfe, 0xe8, 0x3e, 0xdb, 0x49, 0x3c, 0x0d, 0x65, 0xe5, 0xa2, 0x75, 0x64, 0x44,
-	0x15, 0xf1, 0xe1, 0x14, 0x97, 0xd2, 0x16, 0x01, 0x6d, 0xdd, 0x06, 0x39, 0x4a, 0xab, 0x86, 0x49,
-	0x39, 0xaf, 0xd6, 0xde, 0x89, 0x3d, 0x8a, 0x77, 0x67, 0xbb, 0x1f, 0x69, 0xc2, 0xa9, 0x17, 0x38,
-	0xc1, 0xdf, 0x01, 0x77, 0x2e, 0x1c, 0x39, 0xe6, 0xd8, 0x23, 0xaa, 0x84, 0xd5, 0xf8, 0xd4, 0x13,
-	0xf4, 0x88, 0xc4, 0x05, 0xed, 0xcc, 0xac, 0x77, 0x9d, 0x38, 0xf4, 0xc4, 0x69, 0xf7, 0xbd, 0xf9,
-	0xbd, 0x37, 0xef, 0xfd, 0xde, 0x7b, 0x33, 0x03, 0x95, 0xfe, 0x88, 0x51, 0x37, 0x6c, 0x79, 0x3e,
-	0x0f, 0x39, 0x2e, 0x88, 0x4f, 0xfd, 0xea, 0x80, 0x85, 0xc3, 0xa8, 0xd7, 0xea, 0x73, 0x67, 0xc3,
-	0xb1, 0x6c, 0x16, 0x1e, 0x59, 0x1b, 0x62, 0xa5, 0x17, 0xed, 0x6d, 0x0c, 0xf8, 0x80, 0x0b, 0x41,
-	0xfc, 0x49, 0xc3, 0xfa, 0x72, 0xc8, 0x1c, 0x1a, 0x84, 0x96, 0xe3, 0x49, 0x45, 0xf3, 0x19, 0x82,
-	0xea, 0x03, 0xce, 0xf7, 0x23, 0x8f, 0xd0, 0x27, 0x11, 0x0d, 0x42, 0xbc, 0x0a, 0x05, 0xea, 0xf1,
-	0xfe, 0x50, 0x47, 0x6b, 0x68, 0x3d, 0x4f, 0xa4, 0x80, 0xdf, 0x86, 0x52, 0x14, 0x50, 0xdf, 0x64,
-	0xb6, 0xbe, 0xb0, 0x86, 0xd6, 0x35, 0x52, 0x8c, 0xc5, 0x2d, 0x1b, 0xdf, 0x01, 0xfc, 0x24, 0xe2,
-	0x7e, 0xe4, 0x98, 0x3e, 0x7d, 0x12, 0x31, 0x9f, 0x3a, 0xd4, 0x0d, 0xf5, 0xfc, 0x1a, 0x5a, 0x2f,
-	0xb7, 0x6b, 0x72, 0x93, 0xd6, 0xd7, 0x02, 0xb0, 0x79, 0xe8, 0xf9, 0xa4, 0x26, 0xc1, 0x24, 0xc5,
-	0x36, 0xff, 0x41, 0x50, 0xfd, 0xc6, 0xb3, 0xad, 0x90, 0x26, 0x21, 0x5c, 0x85, 0x62, 0x24, 0x14,
-	0x22, 0x86, 0x72, 0x5b, 0x57, 0x7e, 0x76, 0xd9, 0xc0, 0xa5, 0xf6, 0xa6, 0x1b, 0xfa, 0x47, 0xca,
-	0x40, 0xe1, 0xf0, 0x1d, 0x28, 0x79, 0x3e, 0xdf, 0x63, 0x23, 0x2a, 0xc2, 0x2b, 0xb7, 0x97, 0x94,
-	0xc9, 0x8e, 0xd4, 0x76, 0x2e, 0x1d, 0x8f, 0x1b, 0xb9, 0x17, 0xe3, 0xc6, 0xd2, 0xa6, 0xdb, 0xe7,
-	0x36, 0xb5, 0x95, 0x9e, 0x24, 0x66, 0xf8, 0x2e, 0xd4, 0x46, 0x82, 0x07, 0xd3, 0xb3, 0x7c, 0xcb,
-	0xa1, 0x21, 0xf5, 0x03, 0x7d, 0x51, 0xf8, 0x5a, 0x55, 0xbe, 0x66, 0x78, 0x22, 0x2b, 0x12, 0xbe,
-	0x33, 0x45, 0xe3, 0x6b, 0x50, 0xa6, 0x8e, 0xc5, 0x46, 0xa6, 0xe7, 0x73, 0xbe, 0xa7, 0xbf, 0x2a,
-	0xcd, 0x90, 0xb0, 0x19, 0x2f, 0xed, 0xc4, 0x2b, 0x04, 0xe8, 0xf4, 0xbf, 0x79, 0xbc, 0x00, 0x65,
-	0xe9, 0x58, 0xc8, 0x59, 0xa2, 0xd1, 0x0c, 0xd1, 0xab, 0x50, 0x60, 0xae, 0x4d, 0x0f, 0x45, 0x82,
-	0x15, 0x22, 0x05, 0xdc, 0x80, 0xb2, 0xf8, 0x51, 0x7b, 0x2e, 0x8a, 0x35, 0x10, 0x2a, 0xe9, 0xef,
-	0x73, 0xa8, 0xfa, 0x56, 0xc8, 0xf6, 0x58, 0xdf, 0x0a, 0x19, 0x77, 0x03, 0x3d, 0xbf, 0xb6, 0xb8,
-	0x5e, 0x6e, 0x5f, 0x9a, 0xa5, 0x34, 0xae, 0x71, 0x97, 0x5a, 0x36, 0x99, 0x05, 0xe3, 0x0d, 0x80,
-	0xd0, 0xa7, 0x54, 0x79, 0x2f, 0x88, 0x84, 0x56, 0x94, 0xe9, 0x63, 0x9f, 0x52, 0x99, 0x8f, 0x16,
-	0x26, 0xbf, 0xf8, 0x26, 0x14, 0x68, 0x5c, 0x1f, 0xbd, 0x28, 0xb0, 0x95, 0x24, 0xf9, 0x58, 0xd7,
-	0x59, 0x3d, 0x1e, 0x37, 0xd0, 0x8b, 0x71, 0xa3, 0xa2, 0x8a, 0x20, 0xb4, 0x44, 0x1a, 0x64, 0x4b,
-	0x58, 0x3a, 0xb7, 0x84, 0xe8, 0x3f, 0x4a, 0x18, 0xf7, 0xb2, 0x36, 0x0d, 0x0a, 0xbf, 0x03, 0x9a,
-	0x4b, 0xd9, 0x60, 0xd8, 0xe3, 0x7e, 0xa0, 0xa3, 0xb5, 0xc5, 0xf5, 0x0a, 0x49, 0x15, 0xf8, 0x3d,
-	0x58, 0xa2, 0x87, 0x2c, 0x08, 0x99, 0x3b, 0x30, 0xb3, 0xb4, 0x56, 0x13, 0xed, 0x96, 0xa0, 0xb7,
-	0x05, 0x17, 0xa7, 0x30, 0x11, 0xa6, 0x39, 0xb4, 0x82, 0xa1, 0xa2, 0xb9, 0x96, 0x2c, 0x89, 0x3c,
-	0xba, 0x56, 0x30, 0x6c, 0xfe, 0x84, 0xa0, 0x20, 0xa4, 0xb4, 0x5c, 0x28, 0x5b, 0x2e, 0x1d, 0x4a,
-	0x07, 0xd4, 0x0f, 0x18, 0x77, 0xc5, 0x7e, 0x79, 0x92, 0x88, 0xf8, 0x36, 0x54, 0x65, 0x2f, 0x9b,
-	0x1e, 0x1f, 0xb1, 0xfe, 0x91, 0xea, 0xbd, 0xba, 0x22, 0xe1, 0x6e, 0x14, 0x0e, 0xb9, 0xcf, 0xbe,
-	0x15, 0x75, 0xd9, 0x11, 0x08, 0x52, 0x91, 0x06, 0x52, 0xc2, 0x1f, 0x01, 0x56, 0x44, 0x98, 0x7d,
-	0xee, 0x38, 0x2c, 0x9c, 0x0e, 0x62, 0x85, 0xd4, 0xd4, 0xca, 0xbd, 0xe9, 0x42, 0xf3, 0x0f, 0x04,
-	0xb5, 0x33, 0xf3, 0x84, 0x6f, 0xc7, 0xa4, 0x3d, 0x95, 0xa9, 0xaa, 0xe1, 0x3b, 0x5b, 0xc2, 0xdc,
-	0x99, 0x12, 0x5e, 0x70, 0xe9, 0x53, 0x99, 0x76, 0x17, 0x20, 0x60, 0x03, 0xd7, 0x0a, 0x23, 0x9f,
-	0x06, 0xfa, 0x82, 0xe8, 0xb5, 0xf5, 0xf3, 0xc6, 0x57, 0x68, 0x24, 0x54, 0xfa, 0xc9, 0xd8, 0xd6,
-	0xbf, 0x80, 0xe5, 0x53, 0xcb, 0x78, 0x05, 0x16, 0xf7, 0xa9, 0x8c, 0xab, 0x48, 0xe2, 0xdf, 0x98,
-	0xe5, 0x03, 0x6b, 0x14, 0xd1, 0x64, 0x28, 0x84, 0xf0, 0xd9, 0xc2, 0x4d, 0xd4, 0xfc, 0x1e, 0x41,
-	0x49, 0x75, 0x48, 0x8c, 0x72, 0xb9, 0xdb, 0xa7, 0x49, 0x2d, 0x84, 0x80, 0x3f, 0x84, 0xfc, 0x3e,
-	0x3d, 0x4a, 0x82, 0xd4, 0x67, 0xbb, 0xad, 0xb5, 0x4d, 0x8f, 0x54, 0x50, 0x02, 0x55, 0xbf, 0x01,
-	0xda, 0x54, 0x95, 0x0d, 0x44, 0x7b, 0x53, 0x20, 0x7f, 0x22, 0x99, 0x48, 0x66, 0xca, 0xf0, 0x63,
-	0xc8, 0x0f, 0xa9, 0x65, 0x2b, 0x86, 0x2f, 0x27, 0x03, 0x95, 0x9c, 0xcd, 0x19, 0x68, 0xe7, 0x5d,
-	0x45, 0xf8, 0x65, 0x45, 0xf8, 0x3c, 0x10, 0x11, 0xde, 0xf0, 0x57, 0x73, 0xb8, 0xbf, 0x32, 0x7f,
-	0xce, 0xff, 0x4f, 0xe6, 0x7f, 0x40, 0xb0, 0x3a, 0x2f, 0x4a, 0xfc, 0xe5, 0x4c, 0xd6, 0xc9, 0x31,
-	0x92, 0xa6, 0xaa, 0xab, 0x54, 0x57, 0x92, 0xde, 0x3a, 0x95, 0xdf, 0x27, 0xa0, 0x4d, 0xaf, 0x2f,
-	0x75, 0xcc, 0xaf, 0x9c, 0xa6, 0xae, 0x93, 0x8f, 0x9d, 0x90, 0x14, 0xd8, 0xfc, 0x71, 0x01, 0xb4,
-	0x34, 0x86, 0x55, 0x28, 0xf8, 0xd4, 0x1a, 0x39, 0xaa, 0x76, 0x52, 0x48, 0xef, 0xbc, 0x85, 0xec,
-	0x9d, 0x77, 0x19, 0x34, 0x9f, 0xf3, 0x30, 0x3b, 0xf2, 0x17, 0x62, 0x45, 0x3c, 0xe9, 0xf8, 0x3a,
-	0x00, 0x0b, 0x82, 0x88, 0x9a, 0xf1, 0x4e, 0xea, 0xbe, 0x3b, 0x37, 0x1a, 0x81, 0x8c, 0xb5, 0xb8,
-	0x0d, 0x6f, 0x79, 0x3e, 0x3d, 0x60, 0x3c, 0x0a, 0xcc, 0x20, 0x72, 0x1c, 0x2b, 0x39, 0x52, 0x0a,
-	0xc2, 0xff, 0xc5, 0x64, 0x71, 0x57, 0xae, 0x89, 0xad, 0x1e, 0x40, 0xcd, 0xa5, 0x87, 0xa1, 0x29,
-	0xa2, 0x4a, 0x8e, 0x87, 0xe2, 0x9b, 0x8e, 0x07, 0xb5, 0xf7, 0x72, 0x6c, 0x2a, 0xf2, 0x97, 0xea,
-	0xe6, 0x5f, 0x08, 0x2e, 0xce, 0x81, 0xe3, 0x6d, 0x28, 0x7b, 0x51, 0x6f, 0xc4, 0xfa, 0xa6, 0x98,
-	0x0a, 0x24, 0xda, 0xe7, 0xfd, 0xf3, 0xfd, 0xb7, 0x76, 0x04, 0x3a, 0x9d, 0x13, 0xf0, 0xa6, 0x0a,
-	0xfc, 0x01, 0x14, 0xe5, 0x45, 0xaf, 0xea, 0x74, 0xf6, 0x25, 0xd0, 0xcd, 0x11, 0x05, 0xa9, 0x3f,
-	0x82, 0xe5, 0x53, 0xbe, 0xe6, 0xf4, 0xdb, 0x95, 0x6c, 0xbf, 0xa5, 0x54, 0x4f, 0x0d, 0x33, 0x1d,
-	0xd8, 0xa9, 0x42, 0x59, 0xb2, 0x64, 0x86, 0x47, 0x1e, 0x6d, 0x7e, 0x0a, 0xda, 0x14, 0x86, 0xeb,
-	0x50, 0xa2, 0x76, 0xfb, 0xfa, 0xf5, 0x8f, 0x6f, 0xc9, 0xd3, 0xa0, 0x9b, 0x23, 0x89, 0x42, 0xd8,
-	0x45, 0xbd, 0x7d, 0xaa, 0xec, 0xbe, 0x43, 0x00, 0x69, 0xc0, 0xf1, 0x85, 0x12, 0x0e, 0x7d, 0x1a,
-	0x0c, 0xf9, 0x48, 0xf6, 0x70, 0x95, 0xa4, 0x0a, 0x6c, 0x00, 0xf4, 0x2d, 0xd7, 0x66, 0xf1, 0xb9,
-	0x26, 0x87, 0xaf, 0x48, 0x32, 0x1a, 0x7c, 0x0b, 0x96, 0x82, 0xa8, 0x47, 0x0f, 0x3d, 0x9f, 0x06,
-	0x81, 0xb8, 0x88, 0x17, 0x05, 0xc3, 0x73, 0xde, 0x48, 0xa7, 0x80, 0xcd, 0x5f, 0x10, 0x40, 0xfa,
-	0x7a, 0xc0, 0x2d, 0x00, 0x7b, 0x9f, 0x39, 0xea, 0x4e, 0x16, 0x49, 0x74, 0xaa, 0x93, 0x71, 0x43,
-	0xbb, 0xbf, 0xbd, 0xf5, 0x50, 0x40, 0xba, 0x39, 0xa2, 0xc5, 0x90, 0x29, 0x9e, 0x33, 0xbb, 0x6f,
-	0x86, 0x7c, 0x9f, 0xca, 0x6b, 0x47, 0x93, 0xf8, 0x47, 0x5b, 0xf7, 0xef, 0x3d, 0x8e, 0x95, 0x31,
-	0x3e, 0x86, 0x08, 0x01, 0xdf, 0x80, 0x6a, 0x60, 0x39, 0x23, 0xd3, 0xa7, 0x81, 0xc7, 0xdd, 0x80,
-	0x8a, 0xd6, 0xd7, 0x3a, 0x2b, 0x93, 0x71, 0xa3, 0xb2, 0x7b, 0xf7, 0xe1, 0x03, 0xa2, 0xf4, 0xdd,
-	0x1c, 0xa9, 0xc4, 0xc0, 0x44, 0xee, 0x54, 0x00, 0x44, 0x4c, 0x82, 0xbd, 0x76, 0x04, 0xe5, 0xcd,
-	0xf6, 0xe6, 0xf6, 0xae, 0xa4, 0x1e, 0xb7, 0xa1, 0x28, 0x9f, 0x39, 0x78, 0xee, 0x73, 0xaa, 0x8e,
-	0x67, 0xb4, 0x32, 0xf2, 0x36, 0x14, 0xd5, 0xbd, 0x94, 0xd8, 0xcc, 0xbc, 0x13, 0xe7, 0xd9, 0x74,
-	0x6e, 0x3e, 0x3f, 0x31, 0x72, 0xbf, 0x9f, 0x18, 0xb9, 0x97, 0x27, 0x06, 0x7a, 0x7d, 0x62, 0xa0,
-	0xbf, 0x4f, 0x0c, 0xf4, 0x6c, 0x62, 0xa0, 0x9f, 0x27, 0x06, 0xfa, 0x75, 0x62, 0xa0, 0xdf, 0x26,
-	0x06, 0x3a, 0x9e, 0x18, 0xe8, 0xf9, 0xc4, 0x40, 0x2f, 0x27, 0x06, 0x7a, 0x35, 0x31, 0x72, 0xaf,
-	0x27, 0x06, 0xea, 0x15, 0x85, 0xb3, 0x6b, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x83, 0xf3, 0x7d,
-	0x9c, 0x6b, 0x0b, 0x00, 0x00,
+	// 2245 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xe7, 0x52, 0x12, 0xa5, 0x7d, 0x24, 0x45, 0x6a, 0x24, 0xab, 0x84, 0xec, 0x50, 0xc2, 0x3a,
+	0x75, 0x05, 0x37, 0x95, 0x63, 0xa6, 0x72, 0xec, 0x36, 0x89, 0x23, 0x52, 0x14, 0xa8, 0x58, 0xb2,
+	0xe8, 0xa1, 0xad, 0x1c, 0x17, 0x2b, 0xee, 0x50, 0x5c, 0x88, 0xdc, 0x59, 0xef, 0x2e, 0x65, 0x29,
+	0xa7, 0xf4, 0xd2, 0x4b, 0x81, 0x5e, 0xfa, 0x3f, 0x14, 0x3d, 0xf4, 0x52, 0xf4, 0xd2, 0x53, 0xd1,
+	0x5b, 0x0d, 0xf4, 0x92, 0x63, 0x11, 0xa0, 0x42, 0xcc, 0x53, 0x4e, 0x6d, 0x7a, 0x2b, 0xd0, 0x4b,
+	0x31, 0x5f, 0xcb, 0x5d, 0x9a, 0xb4, 0x81, 0x02, 0xb9, 0x90, 0xfb, 0xde, 0xfb, 0xcd, 0xcc, 0xfb,
+	0x9a, 0x37, 0x6f, 0x06, 0x72, 0xed, 0x9e, 0x43, 0xdc, 0x70, 0xcb, 0xf3, 0x69, 0x48, 0xd1, 0x1c,
+	0xff, 0x5b, 0x7b, 0xff, 0xd4, 0x09, 0xbb, 0x83, 0x93, 0xad, 0x36, 0xed, 0xdf, 0xe9, 0x5b, 0xb6,
+	0x13, 0x5e, 0x5a, 0x77, 0xb8, 0xe4, 0x64, 0xd0, 0xb9, 0x73, 0x4a, 0x4f, 0x29, 0x27, 0xf8, 0x97,
+	0x18, 0xb8, 0x56, 0x08, 0x9d, 0x3e, 0x09, 0x42, 0xab, 0xef, 0x09, 0x86, 0xf1, 0x67, 0x0d, 0xf2,
+	0x07, 0x94, 0x9e, 0x0d, 0x3c, 0x4c, 0x9e, 0x0f, 0x48, 0x10, 0xa2, 0x15, 0x98, 0x23, 0x1e, 0x6d,
+	0x77, 0x4b, 0xda, 0x86, 0xb6, 0x39, 0x8b, 0x05, 0x81, 0x7e, 0x00, 0xf3, 0x83, 0x80, 0xf8, 0xa6,
+	0x63, 0x97, 0xd2, 0x1b, 0xda, 0xa6, 0x8e, 0x33, 0x8c, 0xdc, 0xb7, 0xd1, 0xa7, 0x80, 0x9e, 0x0f,
+	0xa8, 0x3f, 0xe8, 0x9b, 0x3e, 0x79, 0x3e, 0x70, 0x7c, 0xd2, 0x27, 0x6e, 0x58, 0x9a, 0xdd, 0xd0,
+	0x36, 0xb3, 0x95, 0x25, 0xb1, 0xc8, 0xd6, 0x13, 0x0e, 0xa8, 0x5f, 0x78, 0x3e, 0x5e, 0x12, 0x60,
+	0x3c, 0xc2, 0xa2, 0x8f, 0x60, 0xcd, 0xea, 0xf5, 0xe8, 0x0b, 0xd3, 0xb3, 0xfc, 0xd0, 0xb1, 0x7a,
+	0xa6, 0x6f, 0x85, 0x4e, 0xc7, 0x69, 0x5b, 0xa1, 0x43, 0xdd, 0xd2, 0xdc, 0x86, 0xb6, 0xb9, 0x80,
+	0x4b, 0x1c, 0xd1, 0x14, 0x00, 0x1c, 0x93, 0x1b, 0xff, 0xd5, 0x20, 0xff, 0xcc, 0xb3, 0xad, 0x90,
+	0x28, 0x03, 0xde, 0x87, 0xcc, 0x80, 0x33, 0xb8, 0x05, 0xd9, 0x4a, 0x49, 0x6a, 0xd1, 0x72, 0x4e,
+	0x5d, 0x62, 0xd7, 0xdd, 0xd0, 0xbf, 0x94, 0x03, 0x24, 0x0e, 0x7d, 0x0a, 0xf3, 0x9e, 0x4f, 0x3b,
+	0x4e, 0x8f, 0x70, 0xe3, 0xb2, 0x95, 0x45, 0x39, 0xa4, 0x29, 0xb8, 0xd5, 0xd5, 0x97, 0x57, 0xeb,
+	0xa9, 0xaf, 0xaf, 0xd6, 0x17, 0xeb, 0x6e, 0x9b, 0xda, 0xc4, 0x96, 0x7c, 0xac, 0x86, 0xa1, 0x1d,
+	0x58, 0xea, 0x71, 0x2f, 0x32, 0x23, 0xac, 0x3e, 0x09, 0x89, 0x1f, 0x94, 0x66, 0xf8, 0x5c, 0x2b,
+	0x72, 0xae, 0x84, 0x97, 0x71, 0x51, 0xc0, 0x9b, 0x11, 0x1a, 0x7d, 0x00, 0x59, 0xd2, 0xb7, 0x9c,
+	0x9e, 0xe9, 0xf9, 0x94, 0x76, 0x4a, 0xdf, 0xce, 0x27, 0x5c, 0x58, 0x67, 0xa2, 0x26, 0x93, 0x60,
+	0x20, 0xd1, 0xb7, 0xf1, 0x87, 0x19, 0xc8, 0x8a, 0x89, 0x39, 0x1d, 0x0f, 0x93, 0x96, 0x08, 0xd3,
+	0x0a, 0xcc, 0x39, 0xae, 0x4d, 0x2e, 0xb8, 0x81, 0x39, 0x2c, 0x08, 0xb4, 0x0e, 0x59, 0xfe, 0x21,
+	0xd7, 0x9c, 0xe1, 0x32, 0xe0, 0x2c, 0x31, 0xdf, 0x47, 0x90, 0x8f, 0x47, 0x23, 0x28, 0xcd, 0x6e,
+	0xcc, 0x6c, 0x66, 0x2b, 0xab, 0x49, 0x97, 0xb2, 0x0c, 0x69, 0x10, 0xcb, 0xc6, 0x49, 0x30, 0xba,
+	0x03, 0x10, 0xfa, 0x84, 0xc8, 0xd9, 0xe7, 0xb8, 0x41, 0x45, 0x39, 0xf4, 0xa9, 0x4f, 0x88, 0xb0,
+	0x47, 0x0f, 0xd5, 0x27, 0xba, 0x0f, 0x73, 0x84, 0xc5, 0xa7, 0x94, 0xe1, 0xd8, 0x9c, 0x32, 0x9e,
+	0xf1, 0xaa, 0x2b, 0x2f, 0xaf, 0xd6, 0xb5, 0xaf, 0xaf, 0xd6, 0x73, 0x32, 0x08, 0x9c, 0x8b, 0xc5,
+	0x80, 0x78, 0x08, 0xe7, 0xa7, 0x86, 0x50, 0x7b, 0x73, 0x08, 0x8b, 0x1e, 0x71, 0x6d, 0xc7, 0x3d,
+	0x35, 0x7d, 0xd2, 0xa6, 0xe7, 0xc4, 0xbf, 0x2c, 0x2d, 0x6c, 0x68, 0x31, 0x6b, 0x9b, 0x42, 0x8c,
+	0xa5, 0x14, 0x17, 0xbc, 0x24, 0x03, 0xbd, 0x0b, 0x8b, 0x72, 0x2f, 0xb8, 0x34, 0x34, 0xfb, 0x24,
+	0x2c, 0xe9, 0x3c, 0x7b, 0x73, 0x82, 0xfb, 0x98, 0x86, 0x87, 0x24, 0x34, 0xbe, 0xd4, 0x40, 0x8f,
+	0xac, 0x47, 0x37, 0x40, 0x77, 0x89, 0x73, 0xda, 0x3d, 0xa1, 0x7e, 0x50, 0xd2, 0x36, 0x66, 0x36,
+	0x73, 0x78, 0xc4, 0x40, 0x3f, 0x84, 0x45, 0x72, 0xe1, 0x04, 0x21, 0xd3, 0x2a, 0x1e, 0xbf, 0xbc,
+	0xe2, 0xee, 0xf3, 0x38, 0x6e, 0xc1, 0x72, 0x04, 0xe3, 0xfe, 0x30, 0xbb, 0x56, 0xd0, 0x95, 0xf1,
+	0x5c, 0x52, 0x22, 0xee, 0xb0, 0x86, 0x15, 0x74, 0x8d, 0x5f, 0xa4, 0x61, 0x8e, 0x53, 0xa3, 0xbc,
+	0xd0, 0xe2, 0x79, 0x51, 0x82, 0xf9, 0x73, 0xe2, 0x07, 0x6c, 0xff, 0xa5, 0x79, 0x15, 0x50, 0x24,
+	0x7a, 0x08, 0x79, 0xb1, 0x69, 0x4c, 0x8f, 0xf6, 0x9c, 0xf6, 0xa5, 0x4c, 0xf2, 0x35, 0xe9, 0xa2,
+	0x9d, 0x41, 0xd8, 0xa5, 0xbe, 0xf3, 0x05, 0x4f, 0x80, 0x26, 0x47, 0xe0, 0x9c, 0x18, 0x20, 0x28,
+	0xf4, 0x13, 0x40, 0xd2, 0xe3, 0x66, 0x9b, 0xf6, 0xfb, 0x4e, 0x18, 0xd5, 0x8b, 0x1c, 0x5e, 0x92,
+	0x92, 0x5a, 0x24, 0x40, 0x9f, 0x40, 0x41, 0x45, 0x43, 0xad, 0x28, 0xf2, 0xe8, 0x9a, 0x5c, 0x51,
+	0x39, 0x5f, 0x2e, 0xb6, 0xe8, 0x27, 0x68, 0x66, 0x89, 0x4d, 0x7a, 0x24, 0x24, 0x36, 0xcf, 0xa9,
+	0x05, 0xac, 0x48, 0x63, 0x1b, 0x16, 0x93, 0x63, 0xd1, 0x4d, 0xc8, 0xdb, 0xa4, 0x67, 0x5d, 0x9a,
+	0x01, 0x69, 0x53, 0xd7, 0x0e, 0x64, 0x05, 0xcc, 0x71, 0x66, 0x4b, 0xf0, 0x8c, 0x2f, 0xa0, 0x30,
+	0x96, 0x07, 0xff, 0x47, 0xc1, 0xd9, 0x06, 0x60, 0x19, 0x72, 0x42, 0x3a, 0xd4, 0x57, 0x35, 0x27,
+	0xda, 0x18, 0xaa, 0x42, 0x57, 0x67, 0x59, 0xd5, 0xc1, 0xba, 0x4b, 0xc3, 0x2a, 0x07, 0x1a, 0x7f,
+	0xd5, 0x20, 0xa7, 0x56, 0x3d, 0x26, 0x21, 0x9d, 0x12, 0xbd, 0x77, 0x00, 0x62, 0x49, 0x20, 0x12,
+	0x46, 0x27, 0x2a, 0xf8, 0xa8, 0x06, 0x10, 0x38, 0xa7, 0xae, 0x15, 0x0e, 0x7c, 0xc2, 0x8a, 0x14,
+	0xdb, 0xd0, 0x37, 0xc7, 0xbc, 0x79, 0x4c, 0xa4, 0xfe, 0x02, 0x25, 0xb6, 0x5a, 0x6c, 0xd8, 0xda,
+	0xc7, 0x50, 0x18, 0x13, 0xa3, 0x22, 0xcc, 0x9c, 0x91, 0x4b, 0xae, 0x4a, 0x06, 0xb3, 0x4f, 0xa6,
+	0xde, 0xb9, 0xd5, 0x1b, 0x10, 0x55, 0x74, 0x38, 0xf1, 0xb3, 0xf4, 0x7d, 0xcd, 0xf8, 0x87, 0x06,
+	0x4b, 0xaf, 0xb9, 0x07, 0x3d, 0x64, 0x7b, 0xe1, 0x85, 0xc8, 0xe0, 0x92, 0x36, 0xa5, 0x04, 0xa4,
+	0x5e, 0x2b, 0x01, 0x0b, 0x2e, 0x79, 0x21, 0x54, 0x68, 0x24, 0x4c, 0x4b, 0x73, 0xd3, 0x36, 0xa7,
+	0x45, 0xe3, 0xfb, 0xb4, 0xef, 0x97, 0x1a, 0xcc, 0xcb, 0x0a, 0xc3, 0x50, 0x2e, 0x75, 0xdb, 0x44,
+	0x05, 0x89, 0x13, 0xe8, 0x3d, 0x98, 0x3d, 0x23, 0x97, 0x4a, 0xc9, 0x52, 0xb2, 0x5a, 0x6d, 0x3d,
+	0x22, 0x97, 0x52, 0x29, 0x8e, 0x5a, 0xfb, 0x10, 0xf4, 0x88, 0x15, 0x57, 0x44, 0x7f, 0x9b, 0x22,
+	0xff, 0xd4, 0xa0, 0x30, 0x56, 0xa5, 0xd1, 0x53, 0x98, 0xed, 0x12, 0xcb, 0x96, 0x1e, 0xbe, 0x3e,
+	0x9e, 0x77, 0x31, 0x68, 0xf5, 0xa6, 0x74, 0xf8, 0x75, 0xe9, 0xf0, 0x49, 0x20, 0xcc, 0x67, 0x43,
+	0x7b, 0x13, 0x7c, 0x7f, 0x6b, 0xf2, 0x39, 0xf1, 0x7d, 0x7a, 0xfe, 0xb7, 0x1a, 0xac, 0x4c, 0xd2,
+	0x12, 0x7d, 0x92, 0xb0, 0x5a, 0xed, 0xb6, 0x91, 0xa9, 0x25, 0x69, 0x6a, 0x51, 0xe5, 0xd6, 0x98,
+	0x7d, 0x3f, 0x05, 0x3d, 0x6a, 0x9e, 0xde, 0xb6, 0x65, 0x23, 0x20, 0xab, 0x3f, 0x3e, 0xe9, 0xf8,
+	0x44, 0x56, 0xe3, 0x05, 0xac, 0x48, 0xe3, 0xd7, 0x33, 0xa0, 0x8f, 0xb4, 0x5b, 0x81, 0x39, 0x9f,
+	0x58, 0xbd, 0xbe, 0x8c, 0xaa, 0x20, 0x46, 0xbd, 0x58, 0x3a, 0xde, 0x8b, 0x5d, 0x07, 0xdd, 0xa7,
+	0x34, 0x8c, 0xd7, 0xf8, 0x05, 0xc6, 0xe0, 0xbb, 0x7b, 0x1b, 0xc0, 0x09, 0x82, 0x01, 0x31, 0x99,
+	0x0e, 0xa5, 0xd9, 0x37, 0xeb, 0xc9, 0x91, 0x8c, 0x8b, 0x2a, 0x70, 0xcd, 0xf3, 0xc9, 0xb9, 0x43,
+	0x07, 0x81, 0x19, 0x0c, 0xfa, 0x7d, 0x4b, 0x95, 0x8f, 0x39, 0x3e, 0xff, 0xb2, 0x12, 0xb6, 0x84,
+	0x8c, 0x2f, 0x75, 0x00, 0x4b, 0x2e, 0xb9, 0x08, 0x4d, 0xae, 0x95, 0xaa, 0xce, 0x99, 0xb7, 0x9d,
+	0x07, 0x72, 0xed, 0x02, 0x1b, 0xca, 0xed, 0x17, 0x6c, 0xf4, 0x1e, 0xc0, 0xb9, 0xdf, 0x31, 0xbd,
+	0xc1, 0x49, 0xcf, 0x69, 0xf3, 0x43, 0x3c, 0x57, 0xcd, 0x0f, 0xaf, 0xd6, 0xf5, 0x63, 0xbc, 0xd7,
+	0xe4, 0x4c, 0xac, 0x9f, 0xfb, 0x1d, 0xf1, 0x89, 0x9e, 0xc0, 0x12, 0x43, 0xfb, 0x34, 0xe4, 0x53,
+	0x0b, 0x6b, 0x17, 0xa6, 0x58, 0xbb, 0x3c, 0xbc, 0x5a, 0x2f, 0x1c, 0xe3, 0x3d, 0x2c, 0xd1, 0x4c,
+	0x82, 0x0b, 0xe7, 0x7e, 0x27, 0xce, 0x30, 0x06, 0x90, 0x8d, 0x61, 0xc6, 0xf4, 0xd1, 0xde, 0xa2,
+	0xcf, 0xbb, 0x30, 0xd7, 0xa7, 0xe7, 0x44, 0x35, 0x48, 0xaa, 0xfb, 0x38, 0xc6, 0x7b, 0x87, 0xf4,
+	0x9c, 0x60, 0x21, 0x44, 0x08, 0x66, 0xfb, 0xac, 0xe2, 0x8b, 0xa6, 0x96, 0x7f, 0x1b, 0xbf, 0xd7,
+	0x60, 0x5e, 0xc2, 0xa6, 0xb7, 0x6f, 0xd7, 0x41, 0xa7, 0x3d, 0x3b, 0xd1, 0x02, 0x2c, 0xd0, 0x9e,
+	0x2d, 0x4e, 0xff, 0x5b, 0x50, 0x88, 0x84, 0x89, 0x4e, 0x2e, 0xaf, 0x20, 0xa2, 0xd5, 0xb8, 0x2e,
+	0xca, 0xab, 0x98, 0x44, 0x9c, 0xb8, 0xac, 0x74, 0x46, 0x93, 0x44, 0xc2, 0x58, 0xc3, 0x96, 0xc3,
+	0x79, 0x05, 0x11, 0x1d, 0xe7, 0xbf, 0x34, 0x58, 0x9e, 0x10, 0x55, 0xf4, 0x08, 0xb2, 0xc2, 0x55,
+	0x26, 0x2f, 0x6b, 0x1a, 0x77, 0xc3, 0xed, 0xe9, 0x69, 0xb0, 0x25, 0x1c, 0x37, 0x2a, 0x74, 0xe0,
+	0x45, 0x0c, 0xf4, 0x63, 0xc8, 0x88, 0x96, 0xa9, 0x94, 0x4e, 0x74, 0xc1, 0xa3, 0x8b, 0x44, 0x23,
+	0x85, 0x25, 0x64, 0xed, 0x08, 0x0a, 0x63, 0x73, 0x4d, 0x28, 0x18, 0xb7, 0xe2, 0x05, 0x63, 0x94,
+	0x23, 0xd1, 0xc0, 0x58, 0x09, 0xa9, 0xe6, 0x21, 0x2b, 0x92, 0xd9, 0x0c, 0x2f, 0x3d, 0x62, 0x58,
+	0xb0, 0xb4, 0x4b, 0xfb, 0x96, 0xe3, 0xee, 0xd8, 0x7d, 0xc7, 0x8d, 0xf5, 0x15, 0x9c, 0x29, 0x4c,
+	0xd5, 0xb1, 0x22, 0x51, 0x05, 0x32, 0x72, 0x2b, 0xa4, 0xdf, 0xda, 0x1a, 0x49, 0xa4, 0xf1, 0x47,
+	0x0d, 0xf4, 0x48, 0x15, 0xb4, 0x06, 0xf3, 0xc4, 0xae, 0x6c, 0x6f, 0xdf, 0x7d, 0x20, 0xd2, 0xae,
+	0x91, 0xc2, 0x8a, 0xc1, 0x2e, 0x1a, 0xa4, 0x6d, 0x07, 0x96, 0xe9, 0x55, 0xb6, 0xef, 0x99, 0x41,
+	0xd7, 0xaa, 0x6c, 0xdf, 0x13, 0xd1, 0x16, 0x59, 0x5e, 0xaf, 0xed, 0xb6, 0x76, 0x9a, 0x95, 0xed,
+	0x7b, 0xad, 0xc6, 0x4e, 0x65, 0xfb, 0x5e, 0x23, 0x85, 0x0b, 0x1c, 0xcf, 0x59, 0x1c, 0x8d, 0xee,
+	0xc3, 0xa2, 0xcf, 0x26, 0x08, 0x02, 0x35, 0x9e, 0xe7, 0x42, 0xb5, 0x38, 0xbc, 0x5a, 0xcf, 0xe1,
+	0xd6, 0x4e, 0xb3, 0xd5, 0x8a, 0x06, 0xe7, 0xfc, 0xc0, 0x6a, 0x06, 0x81, 0x18, 0xc9, 0x1d, 0x33,
+	0x38, 0x39, 0x23, 0xd2, 0x31, 0xff, 0xd6, 0x00, 0x46, 0x11, 0x61, 0x9d, 0x6c, 0xd8, 0x65, 0xa5,
+	0x8d, 0xf6, 0x44, 0xfa, 0xe6, 0xf1, 0x88, 0x81, 0xca, 0x00, 0x6d, 0xcb, 0xb5, 0x1d, 0x76, 0xf2,
+	0x8a, 0xe3, 0x21, 0x83, 0x63, 0x1c, 0xf4, 0x00, 0x16, 0x83, 0xc1, 0x09, 0xb9, 0xf0, 0x7c, 0x12,
+	0x04, 0xfc, 0xaa, 0x21, 0x3a, 0x93, 0x09, 0x77, 0xc8, 0x31, 0x20, 0xda, 0x87, 0xe5, 0x17, 0xac,
+	0x63, 0x0e, 0x89, 0x6d, 0xc6, 0xd6, 0x98, 0x4d, 0x9c, 0xac, 0x9f, 0x4b, 0x44, 0x4d, 0x01, 0x30,
+	0x7a, 0x31, 0xce, 0x0a, 0xd0, 0x3a, 0xa4, 0xa9, 0xc7, 0x13, 0x7f, 0xb1, 0x52, 0x48, 0xac, 0x7c,
+	0xe4, 0xe1, 0x34, 0xf5, 0x8c, 0x1a, 0x2c, 0xbd, 0x36, 0x13, 0x5a, 0x85, 0xb4, 0xdc, 0xb1, 0x99,
+	0x6a, 0x66, 0x78, 0xb5, 0x9e, 0xde, 0xdf, 0xc5, 0x69, 0xc7, 0x46, 0xab, 0x90, 0x11, 0x6b, 0xf0,
+	0x54, 0xc8, 0x63, 0x49, 0x19, 0x7f, 0x4b, 0x03, 0x8c, 0x2e, 0x74, 0x68, 0x0b, 0xc0, 0x3e, 0x73,
+	0xfa, 0x72, 0xd7, 0xc5, 0x2a, 0xcd, 0xee, 0xa3, 0xfd, 0x43, 0x0e, 0x69, 0xa4, 0xb0, 0xce, 0x20,
+	0x11, 0x9e, 0x3a, 0x76, 0xdb, 0x0c, 0xe9, 0x19, 0x11, 0x0d, 0xba, 0x2e, 0xf0, 0x47, 0xfb, 0xbb,
+	0xb5, 0xa7, 0x8c, 0xc9, 0xf0, 0x0c, 0xc2, 0x09, 0xf4, 0x21, 0xe4, 0x03, 0xab, 0xdf, 0x33, 0x7d,
+	0x12, 0x78, 0xd4, 0x0d, 0x08, 0xcf, 0x17, 0x5d, 0xc4, 0xbb, 0xb5, 0x73, 0x78, 0x80, 0x25, 0x9f,
+	0xc5, 0x9b, 0x01, 0x15, 0x8d, 0x7e, 0x04, 0x8b, 0xed, 0xae, 0xd5, 0xeb, 0x11, 0xf7, 0x94, 0x75,
+	0xeb, 0xb6, 0x38, 0x4f, 0xf4, 0x46, 0x0a, 0xe7, 0x23, 0x7e, 0x8d, 0xda, 0x04, 0x3d, 0x80, 0xac,
+	0x78, 0x9f, 0x30, 0xdb, 0xc4, 0x0f, 0x4b, 0x73, 0x89, 0x6b, 0x53, 0x8d, 0x4b, 0x6a, 0xc4, 0x0f,
+	0x95, 0x2d, 0xd0, 0x8e, 0x58, 0xa8, 0x02, 0x0b, 0xe4, 0x22, 0x24, 0xbe, 0x6b, 0xf5, 0x4a, 0x99,
+	0xc4, 0x85, 0xb9, 0x2e, 0xd9, 0x6a, 0x54, 0x84, 0xab, 0xe6, 0x00, 0xb8, 0xaf, 0x44, 0x1a, 0x3e,
+	0x80, 0x7c, 0x02, 0xca, 0xaa, 0x2c, 0x13, 0xc8, 0x12, 0xca, 0xbf, 0xd9, 0x49, 0x2a, 0xdc, 0x2b,
+	0x1b, 0x06, 0x4e, 0x18, 0x2d, 0x28, 0x8c, 0x69, 0x87, 0x0c, 0xc8, 0x31, 0x1b, 0xc4, 0x2d, 0x96,
+	0xa8, 0x2b, 0x59, 0x82, 0xc7, 0x32, 0x3d, 0x6a, 0x58, 0x54, 0x7f, 0x1d, 0x31, 0x8c, 0x23, 0xb8,
+	0xc6, 0x83, 0x5b, 0x53, 0x2e, 0x52, 0x0f, 0x13, 0x53, 0xab, 0xfb, 0x9b, 0x1b, 0x76, 0xa3, 0x09,
+	0xab, 0xe3, 0x13, 0xca, 0x00, 0xdd, 0x03, 0x20, 0x17, 0x9e, 0xe3, 0x8b, 0xa7, 0x12, 0xed, 0x8d,
+	0x87, 0x7d, 0x0c, 0x69, 0xac, 0xc2, 0xca, 0x81, 0x13, 0x84, 0xc7, 0xc4, 0x77, 0x3a, 0x0e, 0xf1,
+	0x03, 0xa9, 0xa1, 0xf1, 0x19, 0x5c, 0x1b, 0xe3, 0xcb, 0x85, 0xee, 0x82, 0x7e, 0xae, 0x98, 0xb2,
+	0xb6, 0x2f, 0xab, 0x23, 0x4e, 0xf2, 0xf7, 0xdd, 0x0e, 0xc5, 0x23, 0x94, 0xf1, 0x2b, 0x0d, 0x72,
+	0x71, 0xd9, 0xd4, 0x5d, 0x72, 0x07, 0x60, 0x74, 0x72, 0x4c, 0xad, 0xcf, 0x7a, 0x74, 0x3c, 0xb0,
+	0x5e, 0xa5, 0xc7, 0xe2, 0x10, 0xca, 0x97, 0x22, 0x62, 0x8b, 0x16, 0x84, 0xe7, 0xf5, 0x2c, 0x5e,
+	0x16, 0x42, 0x2c, 0x65, 0xbc, 0xc7, 0xb8, 0xfd, 0x10, 0x16, 0xd4, 0x3e, 0x46, 0x2b, 0x50, 0x7c,
+	0xf2, 0xec, 0x08, 0x3f, 0x3b, 0x34, 0x9f, 0x36, 0x70, 0xbd, 0xd5, 0x38, 0x3a, 0xd8, 0x2d, 0xa6,
+	0xd0, 0x22, 0x80, 0xe4, 0xee, 0x3c, 0xde, 0x2d, 0x6a, 0x28, 0x0f, 0xba, 0xa4, 0x8f, 0x70, 0x31,
+	0x7d, 0xbb, 0x0a, 0x0b, 0xc7, 0x78, 0xaf, 0x35, 0x70, 0x42, 0xc2, 0xa0, 0xc7, 0x78, 0xcf, 0xac,
+	0x1d, 0x3d, 0xde, 0x39, 0xac, 0x17, 0x53, 0xe8, 0x16, 0x18, 0x8c, 0xae, 0xd7, 0xf8, 0xef, 0xee,
+	0xe7, 0x3b, 0x78, 0xb7, 0xc5, 0xcb, 0xb5, 0xd9, 0x6a, 0xec, 0x6c, 0xdf, 0xad, 0x98, 0xf5, 0x83,
+	0x83, 0x4a, 0x51, 0xab, 0xfc, 0x66, 0x06, 0xb2, 0xf5, 0x4a, 0xfd, 0x51, 0x4b, 0x36, 0x0d, 0x15,
+	0xc8, 0x88, 0xc7, 0x1b, 0x34, 0xf1, 0x91, 0x68, 0x0d, 0x25, 0xb8, 0x22, 0x3f, 0x2b, 0x90, 0x91,
+	0xb7, 0x25, 0x35, 0x26, 0xf1, 0xfa, 0x35, 0x71, 0xcc, 0x53, 0xb8, 0x26, 0xc5, 0xc9, 0x3c, 0x42,
+	0x37, 0xe2, 0xaf, 0x4b, 0xe3, 0xf9, 0xba, 0xf6, 0xce, 0x14, 0xa9, 0xcc, 0x89, 0x8f, 0x21, 0xdf,
+	0x0a, 0x2d, 0x3f, 0x8c, 0xee, 0xc1, 0x93, 0x15, 0x9a, 0xf2, 0x7a, 0x82, 0x7e, 0xce, 0xd2, 0x23,
+	0xa4, 0x11, 0xbd, 0x3c, 0xe1, 0x0a, 0x3a, 0x75, 0xf0, 0x67, 0x90, 0x4f, 0x24, 0x2a, 0x52, 0xb7,
+	0x98, 0x49, 0x69, 0xbd, 0x76, 0x63, 0xb2, 0x50, 0xd8, 0x51, 0xbd, 0xff, 0xd5, 0xab, 0x72, 0xea,
+	0xef, 0xaf, 0xca, 0xa9, 0x6f, 0x5e, 0x95, 0xb5, 0xef, 0x5e, 0x95, 0xb5, 0xff, 0xbc, 0x2a, 0x6b,
+	0x5f, 0x0e, 0xcb, 0xda, 0xef, 0x86, 0x65, 0xed, 0x4f, 0xc3, 0xb2, 0xf6, 0x97, 0x61, 0x59, 0x7b,
+	0x39, 0x2c, 0x6b, 0x5f, 0x0d, 0xcb, 0xda, 0x37, 0xc3, 0xb2, 0xf6, 0xed, 0xb0, 0x9c, 0xfa, 0x6e,
+	0x58, 0xd6, 0x4e, 0x32, 0x7c, 0xda, 0x0f, 0xfe, 0x17, 0x00, 0x00, 0xff, 0xff, 0xec, 0x76, 0xdf,
+	0x5d, 0x9d, 0x15, 0x00, 0x00,
 }
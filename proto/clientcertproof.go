@@ -0,0 +1,29 @@
+// Copyright 2014-2015 The Dename Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy of
+// the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations under
+// the License.
+
+package proto
+
+import "golang.org/x/crypto/sha3"
+
+// ClientCertProofContext separates signatures in ClientCertProofs from other
+// uses of the same certificate key.
+const ClientCertProofContext = "coname client certificate email proof\x00"
+
+// ClientCertProofMessage returns the message that a ClientCertProof signature
+// covers for registering the entry with the given encoding.
+func ClientCertProofMessage(entryEncoding []byte) []byte {
+	var entryHash [32]byte
+	sha3.ShakeSum256(entryHash[:], entryEncoding)
+	return append([]byte(ClientCertProofContext), entryHash[:]...)
+}
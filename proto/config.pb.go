@@ -62,13 +62,25 @@ type RealmConfig struct {
 	// verified for it to be accepted. Each verifier in VerificationPolicy MUST
 	// have a NoOlderThan entry.
 	VerificationPolicy *AuthorizationPolicy `protobuf:"bytes,6,opt,name=verification_policy,json=verificationPolicy" json:"verification_policy,omitempty"`
-	// EpochTimeToLive specifies the duration for which an epoch is valid after
-	// it has been issued. A client that has access to a clock MUST NOT accept
-	// epoch heads with IssueTime more than EpochTimeToLive in the past.
+	// EpochTimeToLive specifies the duration for which a signature of an epoch
+	// head is valid after it was issued. A client that has access to a clock MUST
+	// NOT count signatures issued more than EpochTimeToLive in the past. A
+	// signature is issued at the IssueTime of the epoch, or at the Timestamp if
+	// the timestamped epoch head is a refresh of the epoch by the keyserver, see
+	// KeyserverConfig.RefreshIdleEpochs.
 	EpochTimeToLive Duration `protobuf:"bytes,7,opt,name=epoch_time_to_live,json=epochTimeToLive" json:"epoch_time_to_live"`
 	// TreeNonce is the global nonce that is hashed into the Merkle tree nodes.
 	TreeNonce []byte     `protobuf:"bytes,8,opt,name=tree_nonce,json=treeNonce,proto3" json:"tree_nonce,omitempty"`
 	ClientTLS *TLSConfig `protobuf:"bytes,9,opt,name=client_tls,json=clientTls" json:"client_tls,omitempty"`
+	// VRFTransition is how long after a rotation of the VRF key of the realm
+	// clients accept index proofs under the new key announced in the ratified
+	// epoch head (EpochHead.VRFPublic) instead of VRFPublic. Clients have to be
+	// given the new VRFPublic within this window. The zero value means that
+	// VRF key rotations are not accepted.
+	VRFTransition Duration `protobuf:"bytes,10,opt,name=vrf_transition,json=vrfTransition" json:"vrf_transition"`
+	// VRFSuite is the verifiable random function that VRFPublic is a key
+	// for, as in KeyserverConfig.VRFSuite.
+	VRFSuite VRFSuite `protobuf:"varint,11,opt,name=vrf_suite,json=vrfSuite,proto3,enum=proto.VRFSuite" json:"vrf_suite,omitempty"`
 }
 
 func (m *RealmConfig) Reset()                    { *m = RealmConfig{} }
@@ -96,6 +108,13 @@ func (m *RealmConfig) GetClientTLS() *TLSConfig {
 	return nil
 }
 
+func (m *RealmConfig) GetVRFTransition() Duration {
+	if m != nil {
+		return m.VRFTransition
+	}
+	return Duration{}
+}
+
 func init() {
 	proto1.RegisterType((*Config)(nil), "proto.Config")
 	proto1.RegisterType((*RealmConfig)(nil), "proto.RealmConfig")
@@ -227,6 +246,12 @@ func (this *RealmConfig) VerboseEqual(that interface{}) error {
 	if !this.ClientTLS.Equal(that1.ClientTLS) {
 		return fmt.Errorf("ClientTLS this(%v) Not Equal that(%v)", this.ClientTLS, that1.ClientTLS)
 	}
+	if !this.VRFTransition.Equal(&that1.VRFTransition) {
+		return fmt.Errorf("VRFTransition this(%v) Not Equal that(%v)", this.VRFTransition, that1.VRFTransition)
+	}
+	if this.VRFSuite != that1.VRFSuite {
+		return fmt.Errorf("VRFSuite this(%v) Not Equal that(%v)", this.VRFSuite, that1.VRFSuite)
+	}
 	return nil
 }
 func (this *RealmConfig) Equal(that interface{}) bool {
@@ -286,6 +311,12 @@ func (this *RealmConfig) Equal(that interface{}) bool {
 	if !this.ClientTLS.Equal(that1.ClientTLS) {
 		return false
 	}
+	if !this.VRFTransition.Equal(&that1.VRFTransition) {
+		return false
+	}
+	if this.VRFSuite != that1.VRFSuite {
+		return false
+	}
 	return true
 }
 func (this *Config) GoString() string {
@@ -304,7 +335,7 @@ func (this *RealmConfig) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 13)
+	s := make([]string, 0, 15)
 	s = append(s, "&proto.RealmConfig{")
 	s = append(s, "RealmName: "+fmt.Sprintf("%#v", this.RealmName)+",\n")
 	s = append(s, "Domains: "+fmt.Sprintf("%#v", this.Domains)+",\n")
@@ -319,6 +350,8 @@ func (this *RealmConfig) GoString() string {
 	if this.ClientTLS != nil {
 		s = append(s, "ClientTLS: "+fmt.Sprintf("%#v", this.ClientTLS)+",\n")
 	}
+	s = append(s, "VRFTransition: "+strings.Replace(this.VRFTransition.GoString(), `&`, ``, 1)+",\n")
+	s = append(s, "VRFSuite: "+fmt.Sprintf("%#v", this.VRFSuite)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -466,6 +499,19 @@ func (m *RealmConfig) MarshalTo(data []byte) (int, error) {
 		}
 		i += n3
 	}
+	data[i] = 0x52
+	i++
+	i = encodeVarintConfig(data, i, uint64(m.VRFTransition.Size()))
+	n4, err := m.VRFTransition.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n4
+	if m.VRFSuite != 0 {
+		data[i] = 0x58
+		i++
+		i = encodeVarintConfig(data, i, uint64(m.VRFSuite))
+	}
 	return i, nil
 }
 
@@ -538,6 +584,9 @@ func NewPopulatedRealmConfig(r randyConfig, easy bool) *RealmConfig {
 	if r.Intn(10) != 0 {
 		this.ClientTLS = NewPopulatedTLSConfig(r, easy)
 	}
+	v6 := NewPopulatedDuration(r, easy)
+	this.VRFTransition = *v6
+	this.VRFSuite = VRFSuite([]int32{0, 1}[r.Intn(2)])
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -562,9 +611,9 @@ func randUTF8RuneConfig(r randyConfig) rune {
 	return rune(ru + 61)
 }
 func randStringConfig(r randyConfig) string {
-	v6 := r.Intn(100)
-	tmps := make([]rune, v6)
-	for i := 0; i < v6; i++ {
+	v7 := r.Intn(100)
+	tmps := make([]rune, v7)
+	for i := 0; i < v7; i++ {
 		tmps[i] = randUTF8RuneConfig(r)
 	}
 	return string(tmps)
@@ -586,11 +635,11 @@ func randFieldConfig(data []byte, r randyConfig, fieldNumber int, wire int) []by
 	switch wire {
 	case 0:
 		data = encodeVarintPopulateConfig(data, uint64(key))
-		v7 := r.Int63()
+		v8 := r.Int63()
 		if r.Intn(2) == 0 {
-			v7 *= -1
+			v8 *= -1
 		}
-		data = encodeVarintPopulateConfig(data, uint64(v7))
+		data = encodeVarintPopulateConfig(data, uint64(v8))
 	case 1:
 		data = encodeVarintPopulateConfig(data, uint64(key))
 		data = append(data, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -666,6 +715,11 @@ func (m *RealmConfig) Size() (n int) {
 		l = m.ClientTLS.Size()
 		n += 1 + l + sovConfig(uint64(l))
 	}
+	l = m.VRFTransition.Size()
+	n += 1 + l + sovConfig(uint64(l))
+	if m.VRFSuite != 0 {
+		n += 1 + sovConfig(uint64(m.VRFSuite))
+	}
 	return n
 }
 
@@ -706,6 +760,8 @@ func (this *RealmConfig) String() string {
 		`EpochTimeToLive:` + strings.Replace(strings.Replace(this.EpochTimeToLive.String(), "Duration", "Duration", 1), `&`, ``, 1) + `,`,
 		`TreeNonce:` + fmt.Sprintf("%v", this.TreeNonce) + `,`,
 		`ClientTLS:` + strings.Replace(fmt.Sprintf("%v", this.ClientTLS), "TLSConfig", "TLSConfig", 1) + `,`,
+		`VRFTransition:` + strings.Replace(strings.Replace(this.VRFTransition.String(), "Duration", "Duration", 1), `&`, ``, 1) + `,`,
+		`VRFSuite:` + fmt.Sprintf("%v", this.VRFSuite) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1102,6 +1158,55 @@ func (m *RealmConfig) Unmarshal(data []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VRFTransition", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VRFTransition.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VRFSuite", wireType)
+			}
+			m.VRFSuite = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.VRFSuite |= (VRFSuite(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipConfig(data[iNdEx:])
@@ -1231,33 +1336,37 @@ var (
 func init() { proto1.RegisterFile("config.proto", fileDescriptorConfig) }
 
 var fileDescriptorConfig = []byte{
-	// 438 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x91, 0xb1, 0x6f, 0xd3, 0x40,
-	0x14, 0xc6, 0xf3, 0x9a, 0x34, 0xc5, 0x97, 0x40, 0xaa, 0x63, 0x39, 0x45, 0x70, 0xb5, 0x3a, 0x59,
-	0x0c, 0x09, 0x2a, 0x0c, 0x4c, 0x48, 0xa4, 0x88, 0x85, 0xa8, 0xaa, 0xae, 0x86, 0xd5, 0x72, 0xec,
-	0x4b, 0x72, 0x92, 0xed, 0x8b, 0xec, 0x73, 0xa4, 0x32, 0xf1, 0xe7, 0xf0, 0x27, 0x30, 0x32, 0x76,
-	0xec, 0xc8, 0x54, 0xd5, 0x37, 0x31, 0x76, 0x84, 0x0d, 0xf9, 0xd9, 0x55, 0x32, 0xf9, 0x7d, 0x3f,
-	0x7f, 0xdf, 0x77, 0xbe, 0x67, 0x32, 0x8c, 0x74, 0xb6, 0x54, 0xab, 0xc9, 0x26, 0xd7, 0x46, 0xd3,
-	0x43, 0x7c, 0x8c, 0x5f, 0xaf, 0x94, 0x59, 0x97, 0x8b, 0x49, 0xa4, 0xd3, 0x69, 0x1a, 0xc6, 0xca,
-	0x5c, 0x87, 0x53, 0x7c, 0xb3, 0x28, 0x97, 0xd3, 0x95, 0x5e, 0x69, 0x14, 0x38, 0x35, 0xc1, 0xf1,
-	0x30, 0x4a, 0x94, 0xcc, 0x4c, 0xab, 0x9e, 0xc5, 0x65, 0x1e, 0x1a, 0xa5, 0xb3, 0x56, 0x8f, 0x4c,
-	0x52, 0xec, 0x9f, 0x73, 0xfa, 0x96, 0xf4, 0xcf, 0x51, 0xd3, 0x57, 0xa4, 0x9f, 0xcb, 0x30, 0x49,
-	0x0b, 0x06, 0x6e, 0xd7, 0x1b, 0x9c, 0xd1, 0xc6, 0x31, 0x11, 0x35, 0x6c, 0x3c, 0xa2, 0x75, 0x9c,
-	0xfe, 0x3b, 0x20, 0x83, 0x3d, 0x4e, 0x5f, 0x10, 0x07, 0xe5, 0x45, 0x98, 0x4a, 0x06, 0x2e, 0x78,
-	0x8e, 0xd8, 0x01, 0xca, 0xc8, 0x51, 0xac, 0xd3, 0x50, 0x65, 0x05, 0x3b, 0x70, 0xbb, 0x9e, 0x23,
-	0x1e, 0x25, 0xa5, 0xa4, 0x17, 0xc6, 0x71, 0xce, 0xba, 0x18, 0xc1, 0x99, 0x1e, 0x93, 0xee, 0x17,
-	0x31, 0x67, 0x3d, 0x44, 0xf5, 0x58, 0xb7, 0x7f, 0x15, 0x9f, 0x2e, 0xcb, 0x45, 0xa2, 0x22, 0x76,
-	0xe8, 0x82, 0x37, 0x14, 0x3b, 0x40, 0x3f, 0x93, 0xe7, 0x5b, 0x99, 0xab, 0xa5, 0x8a, 0xf0, 0xa2,
-	0xc1, 0x46, 0x27, 0x2a, 0xba, 0x66, 0x7d, 0x17, 0xbc, 0xc1, 0xd9, 0xb8, 0xbd, 0xc4, 0x87, 0xd2,
-	0xac, 0x75, 0xae, 0xbe, 0xa1, 0xe5, 0x12, 0x1d, 0x82, 0xee, 0xc7, 0x1a, 0x46, 0x67, 0x84, 0xca,
-	0x8d, 0x8e, 0xd6, 0x81, 0x51, 0xa9, 0x0c, 0x8c, 0x0e, 0x12, 0xb5, 0x95, 0xec, 0x08, 0xbb, 0x46,
-	0x6d, 0xd7, 0xc7, 0x76, 0xa5, 0xb3, 0xde, 0xcd, 0xdd, 0x49, 0x47, 0x8c, 0x30, 0xe0, 0xab, 0x54,
-	0xfa, 0x7a, 0xae, 0xb6, 0x92, 0xbe, 0x24, 0xc4, 0xe4, 0x52, 0x06, 0x99, 0xce, 0x22, 0xc9, 0x9e,
-	0x34, 0xdf, 0x5b, 0x93, 0x8b, 0x1a, 0xd0, 0xf7, 0x84, 0x34, 0xbf, 0x28, 0x30, 0x49, 0xc1, 0x1c,
-	0xac, 0x3e, 0x6e, 0xab, 0xfd, 0xf9, 0x55, 0xb3, 0xd1, 0xd9, 0x53, 0x7b, 0x77, 0xe2, 0x9c, 0xa3,
-	0xcf, 0x9f, 0x5f, 0x09, 0xa7, 0x89, 0xf8, 0x49, 0x31, 0x7b, 0x77, 0x5b, 0xf1, 0xce, 0xef, 0x8a,
-	0x77, 0xee, 0x2b, 0x0e, 0x0f, 0x15, 0x87, 0xbf, 0x15, 0x87, 0xef, 0x96, 0xc3, 0x0f, 0xcb, 0xe1,
-	0xa7, 0xe5, 0xf0, 0xcb, 0x72, 0xb8, 0xb1, 0x1c, 0x6e, 0x2d, 0x87, 0x7b, 0xcb, 0xe1, 0x8f, 0xe5,
-	0x9d, 0x07, 0xcb, 0x61, 0xd1, 0xc7, 0x43, 0xde, 0xfc, 0x0f, 0x00, 0x00, 0xff, 0xff, 0x8f, 0xbb,
-	0x30, 0x18, 0x6a, 0x02, 0x00, 0x00,
+	// 506 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xbf, 0x6f, 0xd3, 0x40,
+	0x1c, 0xc5, 0x73, 0x24, 0x4d, 0xeb, 0xcb, 0xaf, 0xea, 0x10, 0xd2, 0x29, 0x82, 0x8b, 0xd5, 0xc9,
+	0x62, 0x48, 0x50, 0x61, 0x40, 0x0c, 0x48, 0xa4, 0x28, 0x0b, 0xa1, 0xaa, 0x2e, 0x26, 0xab, 0xe5,
+	0x38, 0xe7, 0xe4, 0x24, 0xdb, 0x17, 0x9d, 0xcf, 0x96, 0xca, 0xc4, 0xc0, 0x1f, 0xc3, 0x9f, 0xc0,
+	0xc8, 0xd8, 0xb1, 0x23, 0x53, 0xd4, 0xdc, 0xc4, 0xd8, 0x91, 0x11, 0xf9, 0xec, 0x36, 0x19, 0x98,
+	0xf2, 0x7d, 0x9f, 0xbc, 0xf7, 0xee, 0x7b, 0x67, 0xd8, 0x0e, 0x44, 0x12, 0xf2, 0xd5, 0x70, 0x23,
+	0x85, 0x12, 0xe8, 0xc8, 0xfc, 0xf4, 0x5f, 0xad, 0xb8, 0x5a, 0x67, 0x8b, 0x61, 0x20, 0xe2, 0x51,
+	0xec, 0x2f, 0xb9, 0xba, 0xf6, 0x47, 0xe6, 0x9f, 0x45, 0x16, 0x8e, 0x56, 0x62, 0x25, 0x8c, 0x30,
+	0x53, 0x19, 0xec, 0xb7, 0x83, 0x88, 0xb3, 0x44, 0x55, 0xaa, 0xbb, 0xcc, 0xa4, 0xaf, 0xb8, 0x48,
+	0x2a, 0xdd, 0x53, 0x51, 0x7a, 0x78, 0xce, 0xd9, 0x1b, 0xd8, 0xbc, 0x30, 0x1a, 0xbd, 0x84, 0x4d,
+	0xc9, 0xfc, 0x28, 0x4e, 0x31, 0xb0, 0xeb, 0x4e, 0xeb, 0x1c, 0x95, 0x8e, 0x21, 0x2d, 0x60, 0xe9,
+	0xa1, 0x95, 0xe3, 0xec, 0x7b, 0x03, 0xb6, 0x0e, 0x38, 0x7a, 0x0e, 0x2d, 0x23, 0x2f, 0xfd, 0x98,
+	0x61, 0x60, 0x03, 0xc7, 0xa2, 0x7b, 0x80, 0x30, 0x3c, 0x5e, 0x8a, 0xd8, 0xe7, 0x49, 0x8a, 0x9f,
+	0xd8, 0x75, 0xc7, 0xa2, 0x0f, 0x12, 0x21, 0xd8, 0xf0, 0x97, 0x4b, 0x89, 0xeb, 0x26, 0x62, 0x66,
+	0x74, 0x0a, 0xeb, 0x5f, 0xe8, 0x14, 0x37, 0x0c, 0x2a, 0xc6, 0xa2, 0x7d, 0x4e, 0x27, 0x57, 0xd9,
+	0x22, 0xe2, 0x01, 0x3e, 0xb2, 0x81, 0xd3, 0xa6, 0x7b, 0x80, 0x3e, 0xc1, 0xa7, 0x39, 0x93, 0x3c,
+	0xe4, 0x81, 0xb9, 0xa8, 0xb7, 0x11, 0x11, 0x0f, 0xae, 0x71, 0xd3, 0x06, 0x4e, 0xeb, 0xbc, 0x5f,
+	0x5d, 0xe2, 0x43, 0xa6, 0xd6, 0x42, 0xf2, 0xaf, 0xc6, 0x72, 0x65, 0x1c, 0x14, 0x1d, 0xc6, 0x4a,
+	0x86, 0xc6, 0x10, 0xb1, 0x8d, 0x08, 0xd6, 0x9e, 0xe2, 0x31, 0xf3, 0x94, 0xf0, 0x22, 0x9e, 0x33,
+	0x7c, 0x6c, 0xba, 0x7a, 0x55, 0xd7, 0xc7, 0xea, 0x49, 0xc7, 0x8d, 0x9b, 0xed, 0xa0, 0x46, 0x7b,
+	0x26, 0xe0, 0xf2, 0x98, 0xb9, 0x62, 0xca, 0x73, 0x86, 0x5e, 0x40, 0xa8, 0x24, 0x63, 0x5e, 0x22,
+	0x92, 0x80, 0xe1, 0x93, 0x72, 0xdf, 0x82, 0x5c, 0x16, 0x00, 0xbd, 0x87, 0xb0, 0xfc, 0x44, 0x9e,
+	0x8a, 0x52, 0x6c, 0x99, 0xea, 0xd3, 0xaa, 0xda, 0x9d, 0xce, 0xca, 0x17, 0x1d, 0x77, 0xf4, 0x76,
+	0x60, 0x5d, 0x18, 0x9f, 0x3b, 0x9d, 0x51, 0xab, 0x8c, 0xb8, 0x51, 0x8a, 0x3e, 0xc3, 0x6e, 0x2e,
+	0x43, 0x4f, 0x49, 0x3f, 0x49, 0x79, 0xb1, 0x07, 0x86, 0xff, 0x5f, 0xef, 0x59, 0xb1, 0x9e, 0xde,
+	0x0e, 0x3a, 0x73, 0x3a, 0x71, 0x1f, 0xdd, 0xb4, 0x93, 0xcb, 0x70, 0x2f, 0xd1, 0x3b, 0x68, 0x15,
+	0x75, 0x69, 0xc6, 0x15, 0xc3, 0x2d, 0x1b, 0x38, 0xdd, 0xc7, 0xa6, 0x39, 0x9d, 0xcc, 0x0a, 0x3c,
+	0x6e, 0xeb, 0xed, 0xe0, 0xe4, 0x41, 0xd1, 0x93, 0x5c, 0x86, 0x25, 0x7f, 0x7b, 0xbb, 0x23, 0xb5,
+	0xdf, 0x3b, 0x52, 0xbb, 0xdb, 0x11, 0x70, 0xbf, 0x23, 0xe0, 0xef, 0x8e, 0x80, 0x6f, 0x9a, 0x80,
+	0x1f, 0x9a, 0x80, 0x9f, 0x9a, 0x80, 0x5f, 0x9a, 0x80, 0x1b, 0x4d, 0xc0, 0xad, 0x26, 0xe0, 0x4e,
+	0x13, 0xf0, 0x47, 0x93, 0xda, 0xbd, 0x26, 0x60, 0xd1, 0x34, 0x27, 0xbc, 0xfe, 0x17, 0x00, 0x00,
+	0xff, 0xff, 0x30, 0x1f, 0x9d, 0xb7, 0xf5, 0x02, 0x00, 0x00,
 }
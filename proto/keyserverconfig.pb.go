@@ -68,6 +68,21 @@ type ReplicaConfig struct {
 	// to allow from the start of a client request to until it is handled. The
 	// zero value means no limit.
 	ClientTimeout Duration `protobuf:"bytes,17,opt,name=client_timeout,json=clientTimeout" json:"client_timeout"`
+	// SMTPAddr specifies where to accept email proofs for EmailProofByDKIM
+	// registrations sent to ToAddr. Mail is accepted over plain SMTP; the
+	// proofs are authenticated by their DKIM signatures only. If SMTPAddr is
+	// empty, email proofs can only be submitted as a part of an UpdateRequest.
+	SMTPAddr string `protobuf:"bytes,18,opt,name=smtp_addr,json=smtpAddr,proto3" json:"smtp_addr,omitempty"`
+	// AccountRecovery enables StartRecovery for entries that have a
+	// RecoveryPolicy. If it is not set, recoveries are rejected.
+	AccountRecovery *AccountRecoveryConfig `protobuf:"bytes,19,opt,name=account_recovery,json=accountRecovery" json:"account_recovery,omitempty"`
+	// ClientIPRateLimit limits the lookups and updates accepted from a
+	// single client IP address over the public gRPC interface, HTTPFront and
+	// HKP. If it is not set, there is no limit.
+	ClientIPRateLimit *RateLimit `protobuf:"bytes,20,opt,name=client_ip_rate_limit,json=clientIpRateLimit" json:"client_ip_rate_limit,omitempty"`
+	// UserIDRateLimit limits the lookups and updates accepted for a single
+	// user ID. If it is not set, there is no limit.
+	UserIDRateLimit *RateLimit `protobuf:"bytes,21,opt,name=user_id_rate_limit,json=userIdRateLimit" json:"user_id_rate_limit,omitempty"`
 }
 
 func (m *ReplicaConfig) Reset()                    { *m = ReplicaConfig{} }
@@ -123,6 +138,27 @@ func (m *ReplicaConfig) GetClientTimeout() Duration {
 	return Duration{}
 }
 
+func (m *ReplicaConfig) GetAccountRecovery() *AccountRecoveryConfig {
+	if m != nil {
+		return m.AccountRecovery
+	}
+	return nil
+}
+
+func (m *ReplicaConfig) GetClientIPRateLimit() *RateLimit {
+	if m != nil {
+		return m.ClientIPRateLimit
+	}
+	return nil
+}
+
+func (m *ReplicaConfig) GetUserIDRateLimit() *RateLimit {
+	if m != nil {
+		return m.UserIDRateLimit
+	}
+	return nil
+}
+
 // KeyserverConfig describes the keyserver-wide configuration. All replicas
 // MUST use the same KeyserverConfig.
 type KeyserverConfig struct {
@@ -139,6 +175,18 @@ type KeyserverConfig struct {
 	// scenario; by default, the key identifier is a path to a file containing
 	// the key.
 	VRFKeyID string `protobuf:"bytes,3,opt,name=vrf_key_id,json=vrfKeyId,proto3" json:"vrf_key_id,omitempty"`
+	// NextVRFKeyID specifies a VRF key to rotate to, by reference like
+	// VRFKeyID. Once it is set, the directory is re-indexed under the new key
+	// before the next epoch; VRFKeyID is still used to serve lookups in the
+	// epochs before that. All replicas must have the new key before any of them
+	// is configured with it. After the rotation, NextVRFKeyID can be moved to
+	// VRFKeyID.
+	NextVRFKeyID string `protobuf:"bytes,14,opt,name=next_vrf_key_id,json=nextVrfKeyId,proto3" json:"next_vrf_key_id,omitempty"`
+	// VRFSuite specifies the verifiable random function that user IDs are
+	// mapped to indices with. The VRF keys must be keys for it, and it can
+	// not be changed once the keyserver has been started: the directory is
+	// not re-indexed when it changes.
+	VRFSuite VRFSuite `protobuf:"varint,15,opt,name=vrf_suite,json=vrfSuite,proto3,enum=proto.VRFSuite" json:"vrf_suite,omitempty"`
 	// MinEpochInterval specifies the time for which the keyserver stops
 	// proposing new epochs once an epoch has been committed. The zero value
 	// means no delay. After MinEpochInterval since the last epoch, the
@@ -168,6 +216,32 @@ type KeyserverConfig struct {
 	// Keyserver may support multiple registration policies at a time.
 	// A policy is acceptable only for the domains it supports.
 	RegistrationPolicy []*RegistrationPolicy `protobuf:"bytes,8,rep,name=registration_policy,json=registrationPolicy" json:"registration_policy,omitempty"`
+	// RefreshIdleEpochs makes the keyserver refresh the last epoch instead of
+	// proposing a new one when MaxEpochInterval has passed without any
+	// updates. A refresh is a new signature over the unchanged epoch head
+	// with a later timestamp; verifiers that have verified that epoch sign the
+	// refresh as well. Refreshes do not add a merkle tree snapshot, epoch head
+	// or verifier log entry to the database, so an idle realm stops growing.
+	// This MUST be the same for all replicas.
+	RefreshIdleEpochs bool `protobuf:"varint,9,opt,name=refresh_idle_epochs,json=refreshIdleEpochs,proto3" json:"refresh_idle_epochs,omitempty"`
+	// DeletionPolicy, if set, authorizes deleting any entry of the realm in
+	// addition to the update policy of the entry itself. It MUST match the
+	// deletion policy of the verifiers of this realm.
+	DeletionPolicy *AuthorizationPolicy `protobuf:"bytes,10,opt,name=deletion_policy,json=deletionPolicy" json:"deletion_policy,omitempty"`
+	// DomainAdminPolicies lets administrators update the entries of their
+	// domains. Updates authorized this way are flagged as AdminUpdate steps in
+	// the verifier log. They MUST match the domain admin policies of the
+	// verifiers of this realm.
+	DomainAdminPolicies []*DomainAdminPolicy `protobuf:"bytes,11,rep,name=domain_admin_policies,json=domainAdminPolicies" json:"domain_admin_policies,omitempty"`
+	// RegistrationsPerDomainPerEpoch limits the number of user IDs of a
+	// single domain that can be registered in one epoch. Registrations over
+	// the limit are rejected by all replicas, so it MUST be the same for all
+	// replicas. The zero value means no limit.
+	RegistrationsPerDomainPerEpoch uint64 `protobuf:"varint,12,opt,name=registrations_per_domain_per_epoch,json=registrationsPerDomainPerEpoch,proto3" json:"registrations_per_domain_per_epoch,omitempty"`
+	// VerifierPublicKeys lists the signing keys of the verifiers of this
+	// realm to be advertised by ListVerifiers. The ID of a verifier is the
+	// KeyID of its key.
+	VerifierPublicKeys []*PublicKey `protobuf:"bytes,13,rep,name=verifier_public_keys,json=verifierPublicKeys" json:"verifier_public_keys,omitempty"`
 }
 
 func (m *KeyserverConfig) Reset()                    { *m = KeyserverConfig{} }
@@ -209,6 +283,27 @@ func (m *KeyserverConfig) GetRegistrationPolicy() []*RegistrationPolicy {
 	return nil
 }
 
+func (m *KeyserverConfig) GetDeletionPolicy() *AuthorizationPolicy {
+	if m != nil {
+		return m.DeletionPolicy
+	}
+	return nil
+}
+
+func (m *KeyserverConfig) GetDomainAdminPolicies() []*DomainAdminPolicy {
+	if m != nil {
+		return m.DomainAdminPolicies
+	}
+	return nil
+}
+
+func (m *KeyserverConfig) GetVerifierPublicKeys() []*PublicKey {
+	if m != nil {
+		return m.VerifierPublicKeys
+	}
+	return nil
+}
+
 // RegistrationPolicy specifies the list of policies the keyserver may support,
 // to confirm the ownership of an email address
 type RegistrationPolicy struct {
@@ -218,6 +313,8 @@ type RegistrationPolicy struct {
 	//	*RegistrationPolicy_EmailProofByClientCert
 	//	*RegistrationPolicy_EmailProofByOIDC
 	//	*RegistrationPolicy_EmailProofBySAML
+	//	*RegistrationPolicy_EmailProofByChallenge
+	//	*RegistrationPolicy_EmailProofByExternalVerifier
 	PolicyType isRegistrationPolicy_PolicyType `protobuf_oneof:"policy_type"`
 }
 
@@ -250,12 +347,20 @@ type RegistrationPolicy_EmailProofByOIDC struct {
 type RegistrationPolicy_EmailProofBySAML struct {
 	EmailProofBySAML *EmailProofBySAML `protobuf:"bytes,5,opt,name=email_proof_by_saml,json=emailProofBySaml,oneof"`
 }
+type RegistrationPolicy_EmailProofByChallenge struct {
+	EmailProofByChallenge *EmailProofByChallenge `protobuf:"bytes,6,opt,name=email_proof_by_challenge,json=emailProofByChallenge,oneof"`
+}
+type RegistrationPolicy_EmailProofByExternalVerifier struct {
+	EmailProofByExternalVerifier *EmailProofByExternalVerifier `protobuf:"bytes,7,opt,name=email_proof_by_external_verifier,json=emailProofByExternalVerifier,oneof"`
+}
 
-func (*RegistrationPolicy_InsecureSkipEmailProof) isRegistrationPolicy_PolicyType() {}
-func (*RegistrationPolicy_EmailProofByDKIM) isRegistrationPolicy_PolicyType()       {}
-func (*RegistrationPolicy_EmailProofByClientCert) isRegistrationPolicy_PolicyType() {}
-func (*RegistrationPolicy_EmailProofByOIDC) isRegistrationPolicy_PolicyType()       {}
-func (*RegistrationPolicy_EmailProofBySAML) isRegistrationPolicy_PolicyType()       {}
+func (*RegistrationPolicy_InsecureSkipEmailProof) isRegistrationPolicy_PolicyType()       {}
+func (*RegistrationPolicy_EmailProofByDKIM) isRegistrationPolicy_PolicyType()             {}
+func (*RegistrationPolicy_EmailProofByClientCert) isRegistrationPolicy_PolicyType()       {}
+func (*RegistrationPolicy_EmailProofByOIDC) isRegistrationPolicy_PolicyType()             {}
+func (*RegistrationPolicy_EmailProofBySAML) isRegistrationPolicy_PolicyType()             {}
+func (*RegistrationPolicy_EmailProofByChallenge) isRegistrationPolicy_PolicyType()        {}
+func (*RegistrationPolicy_EmailProofByExternalVerifier) isRegistrationPolicy_PolicyType() {}
 
 func (m *RegistrationPolicy) GetPolicyType() isRegistrationPolicy_PolicyType {
 	if m != nil {
@@ -299,6 +404,20 @@ func (m *RegistrationPolicy) GetEmailProofBySAML() *EmailProofBySAML {
 	return nil
 }
 
+func (m *RegistrationPolicy) GetEmailProofByChallenge() *EmailProofByChallenge {
+	if x, ok := m.GetPolicyType().(*RegistrationPolicy_EmailProofByChallenge); ok {
+		return x.EmailProofByChallenge
+	}
+	return nil
+}
+
+func (m *RegistrationPolicy) GetEmailProofByExternalVerifier() *EmailProofByExternalVerifier {
+	if x, ok := m.GetPolicyType().(*RegistrationPolicy_EmailProofByExternalVerifier); ok {
+		return x.EmailProofByExternalVerifier
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*RegistrationPolicy) XXX_OneofFuncs() (func(msg proto1.Message, b *proto1.Buffer) error, func(msg proto1.Message, tag, wire int, b *proto1.Buffer) (bool, error), func(msg proto1.Message) (n int), []interface{}) {
 	return _RegistrationPolicy_OneofMarshaler, _RegistrationPolicy_OneofUnmarshaler, _RegistrationPolicy_OneofSizer, []interface{}{
@@ -307,6 +426,8 @@ func (*RegistrationPolicy) XXX_OneofFuncs() (func(msg proto1.Message, b *proto1.
 		(*RegistrationPolicy_EmailProofByClientCert)(nil),
 		(*RegistrationPolicy_EmailProofByOIDC)(nil),
 		(*RegistrationPolicy_EmailProofBySAML)(nil),
+		(*RegistrationPolicy_EmailProofByChallenge)(nil),
+		(*RegistrationPolicy_EmailProofByExternalVerifier)(nil),
 	}
 }
 
@@ -341,6 +462,16 @@ func _RegistrationPolicy_OneofMarshaler(msg proto1.Message, b *proto1.Buffer) er
 		if err := b.EncodeMessage(x.EmailProofBySAML); err != nil {
 			return err
 		}
+	case *RegistrationPolicy_EmailProofByChallenge:
+		_ = b.EncodeVarint(6<<3 | proto1.WireBytes)
+		if err := b.EncodeMessage(x.EmailProofByChallenge); err != nil {
+			return err
+		}
+	case *RegistrationPolicy_EmailProofByExternalVerifier:
+		_ = b.EncodeVarint(7<<3 | proto1.WireBytes)
+		if err := b.EncodeMessage(x.EmailProofByExternalVerifier); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("RegistrationPolicy.PolicyType has unexpected type %T", x)
@@ -390,6 +521,22 @@ func _RegistrationPolicy_OneofUnmarshaler(msg proto1.Message, tag, wire int, b *
 		err := b.DecodeMessage(msg)
 		m.PolicyType = &RegistrationPolicy_EmailProofBySAML{msg}
 		return true, err
+	case 6: // policy_type.email_proof_by_challenge
+		if wire != proto1.WireBytes {
+			return true, proto1.ErrInternalBadWireType
+		}
+		msg := new(EmailProofByChallenge)
+		err := b.DecodeMessage(msg)
+		m.PolicyType = &RegistrationPolicy_EmailProofByChallenge{msg}
+		return true, err
+	case 7: // policy_type.email_proof_by_external_verifier
+		if wire != proto1.WireBytes {
+			return true, proto1.ErrInternalBadWireType
+		}
+		msg := new(EmailProofByExternalVerifier)
+		err := b.DecodeMessage(msg)
+		m.PolicyType = &RegistrationPolicy_EmailProofByExternalVerifier{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -422,6 +569,16 @@ func _RegistrationPolicy_OneofSizer(msg proto1.Message) (n int) {
 		n += proto1.SizeVarint(5<<3 | proto1.WireBytes)
 		n += proto1.SizeVarint(uint64(s))
 		n += s
+	case *RegistrationPolicy_EmailProofByChallenge:
+		s := proto1.Size(x.EmailProofByChallenge)
+		n += proto1.SizeVarint(6<<3 | proto1.WireBytes)
+		n += proto1.SizeVarint(uint64(s))
+		n += s
+	case *RegistrationPolicy_EmailProofByExternalVerifier:
+		s := proto1.Size(x.EmailProofByExternalVerifier)
+		n += proto1.SizeVarint(7<<3 | proto1.WireBytes)
+		n += proto1.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -436,8 +593,7 @@ type EmailProofByDKIM struct {
 	// ToAddr specifies the additional allowed to address in email proofs. By
 	// default, only proofs sent to the user being registered all accepted.
 	// This option can be used to allow proofs emailed directly to the
-	// keyserver to be accepted (but the keyserver does NOT implement a SMTP
-	// interface right now).
+	// keyserver to be accepted, see ReplicaConfig.SMTPAddr.
 	ToAddr string `protobuf:"bytes,2,opt,name=to_addr,json=toAddr,proto3" json:"to_addr,omitempty"`
 	// SubjectPrefix is used for DKIM-based email address registration.  The
 	// proof challenge is sent in the subject line, with an optional string
@@ -445,17 +601,32 @@ type EmailProofByDKIM struct {
 	// verification: ", then the proof email needs to have a subject line
 	// "account verification: abcd" for verify challenge abcd.
 	SubjectPrefix string `protobuf:"bytes,3,opt,name=subject_prefix,json=subjectPrefix,proto3" json:"subject_prefix,omitempty"`
+	// PendingUpdateValidity specifies how long a registration submitted with
+	// SubmitPendingUpdate waits for its emailed proof. A zero value means a
+	// day.
+	PendingUpdateValidity Duration `protobuf:"bytes,4,opt,name=pending_update_validity,json=pendingUpdateValidity" json:"pending_update_validity"`
+	// MaxEmailSize is the largest email proof in bytes accepted at SMTPAddr. A
+	// zero value means the smtpfront default.
+	MaxEmailSize uint32 `protobuf:"varint,5,opt,name=max_email_size,json=maxEmailSize,proto3" json:"max_email_size,omitempty"`
 }
 
 func (m *EmailProofByDKIM) Reset()                    { *m = EmailProofByDKIM{} }
 func (*EmailProofByDKIM) ProtoMessage()               {}
 func (*EmailProofByDKIM) Descriptor() ([]byte, []int) { return fileDescriptorKeyserverconfig, []int{3} }
 
+func (m *EmailProofByDKIM) GetPendingUpdateValidity() Duration {
+	if m != nil {
+		return m.PendingUpdateValidity
+	}
+	return Duration{}
+}
+
 // EmailProofByClientCert accepts a certificate signed by an authority trusted
 // with handling registration as sufficient confirmation of ownership of an
-// email address. The emailAddress value in the certificate's DistinguishedName
-// field is allowed to be registered by the holder of the key specified in the
-// certificate.
+// email address. The email addresses in the certificate's SubjectAltName
+// extension are allowed to be registered by the holder of the key specified in
+// the certificate, see ClientCertProof. To use the certificates presented in
+// TLS handshakes, PublicTLS and HTTPFrontTLS must request client certificates.
 type EmailProofByClientCert struct {
 	// AllowedDomains specifies the domains for which this keyserver accepts
 	// email address registrations by this policy.
@@ -493,12 +664,20 @@ func (m *EmailProofByOIDC) GetOIDCConfig() []*OIDCConfig {
 // as a sufficient confirmation of ownership of an email address. The email address
 // must match the value of EmailAddress attribute in a valid SAMLResponse
 type EmailProofBySAML struct {
-	// AllowedDomains specifies the domains for which this keyserver accepts
-	// email address registrations by this policy.
+	// AllowedDomains and IDPMetadataURL configure a single identity provider.
+	// They are equivalent to a SAMLConfig entry with the same fields and are
+	// kept for existing configurations; new ones should use SAMLConfig.
 	AllowedDomains []string `protobuf:"bytes,1,rep,name=allowed_domains,json=allowedDomains" json:"allowed_domains,omitempty"`
-	// IdPMetadataURL specifies the location of Identity Provider metadata
-	// Identity provider's x509 cert and SSO URL is included in this metadata
-	IDPMetadataURL string `protobuf:"bytes,2,opt,name=idp_metadata_url,json=idpMetadataUrl,proto3" json:"idp_metadata_url,omitempty"`
+	IDPMetadataURL string   `protobuf:"bytes,2,opt,name=idp_metadata_url,json=idpMetadataUrl,proto3" json:"idp_metadata_url,omitempty"`
+	// SAMLConfig lists the identity providers accepted by this policy. The
+	// provider for an email address is chosen by its domain.
+	SAMLConfig []*SAMLConfig `protobuf:"bytes,7,rep,name=saml_config,json=samlConfig" json:"saml_config,omitempty"`
+	// MetadataRefreshInterval specifies how often the metadata of each
+	// identity provider is fetched again. When the certificate in the
+	// metadata changes, the previous one is still accepted until the next
+	// refresh so that a rollover does not interrupt registrations. A zero
+	// value means the metadata is fetched only once at startup.
+	MetadataRefreshInterval Duration `protobuf:"bytes,8,opt,name=metadata_refresh_interval,json=metadataRefreshInterval" json:"metadata_refresh_interval"`
 	// ConsumerServiceURL contains the AssertionConsumerServiceURL
 	ConsumerServiceURL string    `protobuf:"bytes,4,opt,name=consumer_service_url,json=consumerServiceUrl,proto3" json:"consumer_service_url,omitempty"`
 	ServiceProviderTLS TLSConfig `protobuf:"bytes,5,opt,name=service_provider_tls,json=serviceProviderTls" json:"service_provider_tls"`
@@ -513,6 +692,20 @@ func (m *EmailProofBySAML) Reset()                    { *m = EmailProofBySAML{}
 func (*EmailProofBySAML) ProtoMessage()               {}
 func (*EmailProofBySAML) Descriptor() ([]byte, []int) { return fileDescriptorKeyserverconfig, []int{6} }
 
+func (m *EmailProofBySAML) GetSAMLConfig() []*SAMLConfig {
+	if m != nil {
+		return m.SAMLConfig
+	}
+	return nil
+}
+
+func (m *EmailProofBySAML) GetMetadataRefreshInterval() Duration {
+	if m != nil {
+		return m.MetadataRefreshInterval
+	}
+	return Duration{}
+}
+
 func (m *EmailProofBySAML) GetServiceProviderTLS() TLSConfig {
 	if m != nil {
 		return m.ServiceProviderTLS
@@ -527,6 +720,120 @@ func (m *EmailProofBySAML) GetValidity() Duration {
 	return Duration{}
 }
 
+// EmailProofByChallenge makes the keyserver email a one-time code to the
+// address being registered and accepts that code as a sufficient confirmation
+// of ownership of the address. The code is bound to the hash of the entry
+// being registered.
+type EmailProofByChallenge struct {
+	// AllowedDomains specifies the domains for which this keyserver accepts
+	// email address registrations by this policy.
+	AllowedDomains []string `protobuf:"bytes,1,rep,name=allowed_domains,json=allowedDomains" json:"allowed_domains,omitempty"`
+	// SMTPRelay is the host:port of the mail relay the codes are sent
+	// through. The relay must accept mail from the keyserver without
+	// authentication.
+	SMTPRelay string `protobuf:"bytes,2,opt,name=smtp_relay,json=smtpRelay,proto3" json:"smtp_relay,omitempty"`
+	// FromAddr is the envelope and header sender of the challenge emails.
+	FromAddr string `protobuf:"bytes,3,opt,name=from_addr,json=fromAddr,proto3" json:"from_addr,omitempty"`
+	// Subject is the subject line of the challenge emails.
+	Subject string `protobuf:"bytes,4,opt,name=subject,proto3" json:"subject,omitempty"`
+	// Validity specifies how long a code can be used after it was requested.
+	Validity Duration `protobuf:"bytes,5,opt,name=validity" json:"validity"`
+}
+
+func (m *EmailProofByChallenge) Reset()      { *m = EmailProofByChallenge{} }
+func (*EmailProofByChallenge) ProtoMessage() {}
+func (*EmailProofByChallenge) Descriptor() ([]byte, []int) {
+	return fileDescriptorKeyserverconfig, []int{7}
+}
+
+func (m *EmailProofByChallenge) GetValidity() Duration {
+	if m != nil {
+		return m.Validity
+	}
+	return Duration{}
+}
+
+// AccountRecoveryConfig configures the recovery of entries whose update
+// policy can no longer be satisfied. The email proof of a recovery is checked
+// like that of a registration. A recovery can be completed once an epoch has
+// been issued after its delay, so with RefreshIdleEpochs it also waits for an
+// update that ends the idle period.
+type AccountRecoveryConfig struct {
+	// MinDelay is the shortest delay a recovery policy may specify for this
+	// keyserver to accept recoveries of the entry.
+	MinDelay Duration `protobuf:"bytes,1,opt,name=min_delay,json=minDelay" json:"min_delay"`
+	// SMTPRelay is the host:port of the mail relay through which the owner
+	// of an entry is notified of a started recovery. If it is empty, no
+	// notifications are sent.
+	SMTPRelay string `protobuf:"bytes,2,opt,name=smtp_relay,json=smtpRelay,proto3" json:"smtp_relay,omitempty"`
+	// FromAddr is the envelope and header sender of the notifications.
+	FromAddr string `protobuf:"bytes,3,opt,name=from_addr,json=fromAddr,proto3" json:"from_addr,omitempty"`
+	// Subject is the subject line of the notifications.
+	Subject string `protobuf:"bytes,4,opt,name=subject,proto3" json:"subject,omitempty"`
+}
+
+func (m *AccountRecoveryConfig) Reset()      { *m = AccountRecoveryConfig{} }
+func (*AccountRecoveryConfig) ProtoMessage() {}
+func (*AccountRecoveryConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptorKeyserverconfig, []int{8}
+}
+
+func (m *AccountRecoveryConfig) GetMinDelay() Duration {
+	if m != nil {
+		return m.MinDelay
+	}
+	return Duration{}
+}
+
+// RateLimit is a token bucket: up to Burst requests are allowed at once, and
+// the allowance grows back by one request per Interval.
+type RateLimit struct {
+	Interval Duration `protobuf:"bytes,1,opt,name=interval" json:"interval"`
+	Burst    uint64   `protobuf:"varint,2,opt,name=burst,proto3" json:"burst,omitempty"`
+}
+
+func (m *RateLimit) Reset()                    { *m = RateLimit{} }
+func (*RateLimit) ProtoMessage()               {}
+func (*RateLimit) Descriptor() ([]byte, []int) { return fileDescriptorKeyserverconfig, []int{9} }
+
+func (m *RateLimit) GetInterval() Duration {
+	if m != nil {
+		return m.Interval
+	}
+	return Duration{}
+}
+
+// SAMLConfig describes a SAML2.0 Identity Provider and the domains it
+// vouches for.
+type SAMLConfig struct {
+	// AllowedDomains specifies the domains for which this keyserver accepts
+	// email address registrations by this identity provider.
+	AllowedDomains []string `protobuf:"bytes,1,rep,name=allowed_domains,json=allowedDomains" json:"allowed_domains,omitempty"`
+	// IdPMetadataURL specifies the location of Identity Provider metadata
+	// Identity provider's x509 cert and SSO URL is included in this metadata
+	IDPMetadataURL string `protobuf:"bytes,2,opt,name=idp_metadata_url,json=idpMetadataUrl,proto3" json:"idp_metadata_url,omitempty"`
+}
+
+func (m *SAMLConfig) Reset()                    { *m = SAMLConfig{} }
+func (*SAMLConfig) ProtoMessage()               {}
+func (*SAMLConfig) Descriptor() ([]byte, []int) { return fileDescriptorKeyserverconfig, []int{10} }
+
+// EmailProofByExternalVerifier accepts ExternalProofs of the given type, as
+// checked by the RegistrationVerifier that was registered under that type
+// with keyserver.RegisterVerifier before the keyserver was opened.
+type EmailProofByExternalVerifier struct {
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// AllowedDomains specifies the domains for which this keyserver accepts
+	// email address registrations by this policy.
+	AllowedDomains []string `protobuf:"bytes,2,rep,name=allowed_domains,json=allowedDomains" json:"allowed_domains,omitempty"`
+}
+
+func (m *EmailProofByExternalVerifier) Reset()      { *m = EmailProofByExternalVerifier{} }
+func (*EmailProofByExternalVerifier) ProtoMessage() {}
+func (*EmailProofByExternalVerifier) Descriptor() ([]byte, []int) {
+	return fileDescriptorKeyserverconfig, []int{11}
+}
+
 // OIDCConfig contains the OpenID Connect client configuration which is used to
 // validate the token received from the keyserver client.
 type OIDCConfig struct {
@@ -546,11 +853,19 @@ type OIDCConfig struct {
 	Validity Duration `protobuf:"bytes,5,opt,name=validity" json:"validity"`
 	// Scope specifies the OIDC scope
 	Scope string `protobuf:"bytes,6,opt,name=scope,proto3" json:"scope,omitempty"`
+	// KeyRefreshInterval specifies how often the provider keys are fetched
+	// again when the JWKS response does not carry cache headers. A zero value
+	// means once an hour. Tokens signed with a key the keyserver has not seen
+	// yet also cause the keys to be fetched again, at most once a minute.
+	KeyRefreshInterval Duration `protobuf:"bytes,7,opt,name=key_refresh_interval,json=keyRefreshInterval" json:"key_refresh_interval"`
+	// ClientSecret is used to authenticate to the provider when exchanging
+	// an authorization code for an ID token.
+	ClientSecret string `protobuf:"bytes,8,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
 }
 
 func (m *OIDCConfig) Reset()                    { *m = OIDCConfig{} }
 func (*OIDCConfig) ProtoMessage()               {}
-func (*OIDCConfig) Descriptor() ([]byte, []int) { return fileDescriptorKeyserverconfig, []int{7} }
+func (*OIDCConfig) Descriptor() ([]byte, []int) { return fileDescriptorKeyserverconfig, []int{12} }
 
 func (m *OIDCConfig) GetValidity() Duration {
 	if m != nil {
@@ -559,6 +874,13 @@ func (m *OIDCConfig) GetValidity() Duration {
 	return Duration{}
 }
 
+func (m *OIDCConfig) GetKeyRefreshInterval() Duration {
+	if m != nil {
+		return m.KeyRefreshInterval
+	}
+	return Duration{}
+}
+
 type Replica struct {
 	// Id is used to distinguish between nodes during consistent replication.
 	// All node ID-s MUST be unique, MUST NOT be reused (e.g., using IP-s or
@@ -576,7 +898,7 @@ type Replica struct {
 
 func (m *Replica) Reset()                    { *m = Replica{} }
 func (*Replica) ProtoMessage()               {}
-func (*Replica) Descriptor() ([]byte, []int) { return fileDescriptorKeyserverconfig, []int{8} }
+func (*Replica) Descriptor() ([]byte, []int) { return fileDescriptorKeyserverconfig, []int{13} }
 
 func (m *Replica) GetPublicKeys() []*PublicKey {
 	if m != nil {
@@ -593,6 +915,11 @@ func init() {
 	proto1.RegisterType((*EmailProofByClientCert)(nil), "proto.EmailProofByClientCert")
 	proto1.RegisterType((*EmailProofByOIDC)(nil), "proto.EmailProofByOIDC")
 	proto1.RegisterType((*EmailProofBySAML)(nil), "proto.EmailProofBySAML")
+	proto1.RegisterType((*EmailProofByChallenge)(nil), "proto.EmailProofByChallenge")
+	proto1.RegisterType((*AccountRecoveryConfig)(nil), "proto.AccountRecoveryConfig")
+	proto1.RegisterType((*RateLimit)(nil), "proto.RateLimit")
+	proto1.RegisterType((*SAMLConfig)(nil), "proto.SAMLConfig")
+	proto1.RegisterType((*EmailProofByExternalVerifier)(nil), "proto.EmailProofByExternalVerifier")
 	proto1.RegisterType((*OIDCConfig)(nil), "proto.OIDCConfig")
 	proto1.RegisterType((*Replica)(nil), "proto.Replica")
 }
@@ -672,6 +999,18 @@ func (this *ReplicaConfig) VerboseEqual(that interface{}) error {
 	if !this.ClientTimeout.Equal(&that1.ClientTimeout) {
 		return fmt.Errorf("ClientTimeout this(%v) Not Equal that(%v)", this.ClientTimeout, that1.ClientTimeout)
 	}
+	if this.SMTPAddr != that1.SMTPAddr {
+		return fmt.Errorf("SMTPAddr this(%v) Not Equal that(%v)", this.SMTPAddr, that1.SMTPAddr)
+	}
+	if !this.AccountRecovery.Equal(that1.AccountRecovery) {
+		return fmt.Errorf("AccountRecovery this(%v) Not Equal that(%v)", this.AccountRecovery, that1.AccountRecovery)
+	}
+	if !this.ClientIPRateLimit.Equal(that1.ClientIPRateLimit) {
+		return fmt.Errorf("ClientIPRateLimit this(%v) Not Equal that(%v)", this.ClientIPRateLimit, that1.ClientIPRateLimit)
+	}
+	if !this.UserIDRateLimit.Equal(that1.UserIDRateLimit) {
+		return fmt.Errorf("UserIDRateLimit this(%v) Not Equal that(%v)", this.UserIDRateLimit, that1.UserIDRateLimit)
+	}
 	return nil
 }
 func (this *ReplicaConfig) Equal(that interface{}) bool {
@@ -750,6 +1089,18 @@ func (this *ReplicaConfig) Equal(that interface{}) bool {
 	if !this.ClientTimeout.Equal(&that1.ClientTimeout) {
 		return false
 	}
+	if this.SMTPAddr != that1.SMTPAddr {
+		return false
+	}
+	if !this.AccountRecovery.Equal(that1.AccountRecovery) {
+		return false
+	}
+	if !this.ClientIPRateLimit.Equal(that1.ClientIPRateLimit) {
+		return false
+	}
+	if !this.UserIDRateLimit.Equal(that1.UserIDRateLimit) {
+		return false
+	}
 	return true
 }
 func (this *KeyserverConfig) VerboseEqual(that interface{}) error {
@@ -786,6 +1137,12 @@ func (this *KeyserverConfig) VerboseEqual(that interface{}) error {
 	if this.VRFKeyID != that1.VRFKeyID {
 		return fmt.Errorf("VRFKeyID this(%v) Not Equal that(%v)", this.VRFKeyID, that1.VRFKeyID)
 	}
+	if this.NextVRFKeyID != that1.NextVRFKeyID {
+		return fmt.Errorf("NextVRFKeyID this(%v) Not Equal that(%v)", this.NextVRFKeyID, that1.NextVRFKeyID)
+	}
+	if this.VRFSuite != that1.VRFSuite {
+		return fmt.Errorf("VRFSuite this(%v) Not Equal that(%v)", this.VRFSuite, that1.VRFSuite)
+	}
 	if !this.MinEpochInterval.Equal(&that1.MinEpochInterval) {
 		return fmt.Errorf("MinEpochInterval this(%v) Not Equal that(%v)", this.MinEpochInterval, that1.MinEpochInterval)
 	}
@@ -811,6 +1168,31 @@ func (this *KeyserverConfig) VerboseEqual(that interface{}) error {
 			return fmt.Errorf("RegistrationPolicy this[%v](%v) Not Equal that[%v](%v)", i, this.RegistrationPolicy[i], i, that1.RegistrationPolicy[i])
 		}
 	}
+	if this.RefreshIdleEpochs != that1.RefreshIdleEpochs {
+		return fmt.Errorf("RefreshIdleEpochs this(%v) Not Equal that(%v)", this.RefreshIdleEpochs, that1.RefreshIdleEpochs)
+	}
+	if !this.DeletionPolicy.Equal(that1.DeletionPolicy) {
+		return fmt.Errorf("DeletionPolicy this(%v) Not Equal that(%v)", this.DeletionPolicy, that1.DeletionPolicy)
+	}
+	if len(this.DomainAdminPolicies) != len(that1.DomainAdminPolicies) {
+		return fmt.Errorf("DomainAdminPolicies this(%v) Not Equal that(%v)", len(this.DomainAdminPolicies), len(that1.DomainAdminPolicies))
+	}
+	for i := range this.DomainAdminPolicies {
+		if !this.DomainAdminPolicies[i].Equal(that1.DomainAdminPolicies[i]) {
+			return fmt.Errorf("DomainAdminPolicies this[%v](%v) Not Equal that[%v](%v)", i, this.DomainAdminPolicies[i], i, that1.DomainAdminPolicies[i])
+		}
+	}
+	if this.RegistrationsPerDomainPerEpoch != that1.RegistrationsPerDomainPerEpoch {
+		return fmt.Errorf("RegistrationsPerDomainPerEpoch this(%v) Not Equal that(%v)", this.RegistrationsPerDomainPerEpoch, that1.RegistrationsPerDomainPerEpoch)
+	}
+	if len(this.VerifierPublicKeys) != len(that1.VerifierPublicKeys) {
+		return fmt.Errorf("VerifierPublicKeys this(%v) Not Equal that(%v)", len(this.VerifierPublicKeys), len(that1.VerifierPublicKeys))
+	}
+	for i := range this.VerifierPublicKeys {
+		if !this.VerifierPublicKeys[i].Equal(that1.VerifierPublicKeys[i]) {
+			return fmt.Errorf("VerifierPublicKeys this[%v](%v) Not Equal that[%v](%v)", i, this.VerifierPublicKeys[i], i, that1.VerifierPublicKeys[i])
+		}
+	}
 	return nil
 }
 func (this *KeyserverConfig) Equal(that interface{}) bool {
@@ -847,6 +1229,12 @@ func (this *KeyserverConfig) Equal(that interface{}) bool {
 	if this.VRFKeyID != that1.VRFKeyID {
 		return false
 	}
+	if this.NextVRFKeyID != that1.NextVRFKeyID {
+		return false
+	}
+	if this.VRFSuite != that1.VRFSuite {
+		return false
+	}
 	if !this.MinEpochInterval.Equal(&that1.MinEpochInterval) {
 		return false
 	}
@@ -872,6 +1260,31 @@ func (this *KeyserverConfig) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.RefreshIdleEpochs != that1.RefreshIdleEpochs {
+		return false
+	}
+	if !this.DeletionPolicy.Equal(that1.DeletionPolicy) {
+		return false
+	}
+	if len(this.DomainAdminPolicies) != len(that1.DomainAdminPolicies) {
+		return false
+	}
+	for i := range this.DomainAdminPolicies {
+		if !this.DomainAdminPolicies[i].Equal(that1.DomainAdminPolicies[i]) {
+			return false
+		}
+	}
+	if this.RegistrationsPerDomainPerEpoch != that1.RegistrationsPerDomainPerEpoch {
+		return false
+	}
+	if len(this.VerifierPublicKeys) != len(that1.VerifierPublicKeys) {
+		return false
+	}
+	for i := range this.VerifierPublicKeys {
+		if !this.VerifierPublicKeys[i].Equal(that1.VerifierPublicKeys[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *RegistrationPolicy) VerboseEqual(that interface{}) error {
@@ -1060,6 +1473,66 @@ func (this *RegistrationPolicy_EmailProofBySAML) VerboseEqual(that interface{})
 	}
 	return nil
 }
+func (this *RegistrationPolicy_EmailProofByChallenge) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*RegistrationPolicy_EmailProofByChallenge)
+	if !ok {
+		that2, ok := that.(RegistrationPolicy_EmailProofByChallenge)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *RegistrationPolicy_EmailProofByChallenge")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *RegistrationPolicy_EmailProofByChallenge but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *RegistrationPolicy_EmailProofByChallenge but is not nil && this == nil")
+	}
+	if !this.EmailProofByChallenge.Equal(that1.EmailProofByChallenge) {
+		return fmt.Errorf("EmailProofByChallenge this(%v) Not Equal that(%v)", this.EmailProofByChallenge, that1.EmailProofByChallenge)
+	}
+	return nil
+}
+func (this *RegistrationPolicy_EmailProofByExternalVerifier) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*RegistrationPolicy_EmailProofByExternalVerifier)
+	if !ok {
+		that2, ok := that.(RegistrationPolicy_EmailProofByExternalVerifier)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *RegistrationPolicy_EmailProofByExternalVerifier")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *RegistrationPolicy_EmailProofByExternalVerifier but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *RegistrationPolicy_EmailProofByExternalVerifier but is not nil && this == nil")
+	}
+	if !this.EmailProofByExternalVerifier.Equal(that1.EmailProofByExternalVerifier) {
+		return fmt.Errorf("EmailProofByExternalVerifier this(%v) Not Equal that(%v)", this.EmailProofByExternalVerifier, that1.EmailProofByExternalVerifier)
+	}
+	return nil
+}
 func (this *RegistrationPolicy) Equal(that interface{}) bool {
 	if that == nil {
 		if this == nil {
@@ -1246,48 +1719,37 @@ func (this *RegistrationPolicy_EmailProofBySAML) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *EmailProofByDKIM) VerboseEqual(that interface{}) error {
+func (this *RegistrationPolicy_EmailProofByChallenge) Equal(that interface{}) bool {
 	if that == nil {
 		if this == nil {
-			return nil
+			return true
 		}
-		return fmt.Errorf("that == nil && this != nil")
+		return false
 	}
 
-	that1, ok := that.(*EmailProofByDKIM)
+	that1, ok := that.(*RegistrationPolicy_EmailProofByChallenge)
 	if !ok {
-		that2, ok := that.(EmailProofByDKIM)
+		that2, ok := that.(RegistrationPolicy_EmailProofByChallenge)
 		if ok {
 			that1 = &that2
 		} else {
-			return fmt.Errorf("that is not of type *EmailProofByDKIM")
+			return false
 		}
 	}
 	if that1 == nil {
 		if this == nil {
-			return nil
+			return true
 		}
-		return fmt.Errorf("that is type *EmailProofByDKIM but is nil && this != nil")
+		return false
 	} else if this == nil {
-		return fmt.Errorf("that is type *EmailProofByDKIM but is not nil && this == nil")
-	}
-	if len(this.AllowedDomains) != len(that1.AllowedDomains) {
-		return fmt.Errorf("AllowedDomains this(%v) Not Equal that(%v)", len(this.AllowedDomains), len(that1.AllowedDomains))
-	}
-	for i := range this.AllowedDomains {
-		if this.AllowedDomains[i] != that1.AllowedDomains[i] {
-			return fmt.Errorf("AllowedDomains this[%v](%v) Not Equal that[%v](%v)", i, this.AllowedDomains[i], i, that1.AllowedDomains[i])
-		}
-	}
-	if this.ToAddr != that1.ToAddr {
-		return fmt.Errorf("ToAddr this(%v) Not Equal that(%v)", this.ToAddr, that1.ToAddr)
+		return false
 	}
-	if this.SubjectPrefix != that1.SubjectPrefix {
-		return fmt.Errorf("SubjectPrefix this(%v) Not Equal that(%v)", this.SubjectPrefix, that1.SubjectPrefix)
+	if !this.EmailProofByChallenge.Equal(that1.EmailProofByChallenge) {
+		return false
 	}
-	return nil
+	return true
 }
-func (this *EmailProofByDKIM) Equal(that interface{}) bool {
+func (this *RegistrationPolicy_EmailProofByExternalVerifier) Equal(that interface{}) bool {
 	if that == nil {
 		if this == nil {
 			return true
@@ -1295,9 +1757,9 @@ func (this *EmailProofByDKIM) Equal(that interface{}) bool {
 		return false
 	}
 
-	that1, ok := that.(*EmailProofByDKIM)
+	that1, ok := that.(*RegistrationPolicy_EmailProofByExternalVerifier)
 	if !ok {
-		that2, ok := that.(EmailProofByDKIM)
+		that2, ok := that.(RegistrationPolicy_EmailProofByExternalVerifier)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1312,12 +1774,89 @@ func (this *EmailProofByDKIM) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if len(this.AllowedDomains) != len(that1.AllowedDomains) {
+	if !this.EmailProofByExternalVerifier.Equal(that1.EmailProofByExternalVerifier) {
 		return false
 	}
-	for i := range this.AllowedDomains {
-		if this.AllowedDomains[i] != that1.AllowedDomains[i] {
-			return false
+	return true
+}
+func (this *EmailProofByDKIM) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*EmailProofByDKIM)
+	if !ok {
+		that2, ok := that.(EmailProofByDKIM)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *EmailProofByDKIM")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *EmailProofByDKIM but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *EmailProofByDKIM but is not nil && this == nil")
+	}
+	if len(this.AllowedDomains) != len(that1.AllowedDomains) {
+		return fmt.Errorf("AllowedDomains this(%v) Not Equal that(%v)", len(this.AllowedDomains), len(that1.AllowedDomains))
+	}
+	for i := range this.AllowedDomains {
+		if this.AllowedDomains[i] != that1.AllowedDomains[i] {
+			return fmt.Errorf("AllowedDomains this[%v](%v) Not Equal that[%v](%v)", i, this.AllowedDomains[i], i, that1.AllowedDomains[i])
+		}
+	}
+	if this.ToAddr != that1.ToAddr {
+		return fmt.Errorf("ToAddr this(%v) Not Equal that(%v)", this.ToAddr, that1.ToAddr)
+	}
+	if this.SubjectPrefix != that1.SubjectPrefix {
+		return fmt.Errorf("SubjectPrefix this(%v) Not Equal that(%v)", this.SubjectPrefix, that1.SubjectPrefix)
+	}
+	if !this.PendingUpdateValidity.Equal(&that1.PendingUpdateValidity) {
+		return fmt.Errorf("PendingUpdateValidity this(%v) Not Equal that(%v)", this.PendingUpdateValidity, that1.PendingUpdateValidity)
+	}
+	if this.MaxEmailSize != that1.MaxEmailSize {
+		return fmt.Errorf("MaxEmailSize this(%v) Not Equal that(%v)", this.MaxEmailSize, that1.MaxEmailSize)
+	}
+	return nil
+}
+func (this *EmailProofByDKIM) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*EmailProofByDKIM)
+	if !ok {
+		that2, ok := that.(EmailProofByDKIM)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if len(this.AllowedDomains) != len(that1.AllowedDomains) {
+		return false
+	}
+	for i := range this.AllowedDomains {
+		if this.AllowedDomains[i] != that1.AllowedDomains[i] {
+			return false
 		}
 	}
 	if this.ToAddr != that1.ToAddr {
@@ -1326,6 +1865,12 @@ func (this *EmailProofByDKIM) Equal(that interface{}) bool {
 	if this.SubjectPrefix != that1.SubjectPrefix {
 		return false
 	}
+	if !this.PendingUpdateValidity.Equal(&that1.PendingUpdateValidity) {
+		return false
+	}
+	if this.MaxEmailSize != that1.MaxEmailSize {
+		return false
+	}
 	return true
 }
 func (this *EmailProofByClientCert) VerboseEqual(that interface{}) error {
@@ -1510,6 +2055,17 @@ func (this *EmailProofBySAML) VerboseEqual(that interface{}) error {
 	if this.IDPMetadataURL != that1.IDPMetadataURL {
 		return fmt.Errorf("IDPMetadataURL this(%v) Not Equal that(%v)", this.IDPMetadataURL, that1.IDPMetadataURL)
 	}
+	if len(this.SAMLConfig) != len(that1.SAMLConfig) {
+		return fmt.Errorf("SAMLConfig this(%v) Not Equal that(%v)", len(this.SAMLConfig), len(that1.SAMLConfig))
+	}
+	for i := range this.SAMLConfig {
+		if !this.SAMLConfig[i].Equal(that1.SAMLConfig[i]) {
+			return fmt.Errorf("SAMLConfig this[%v](%v) Not Equal that[%v](%v)", i, this.SAMLConfig[i], i, that1.SAMLConfig[i])
+		}
+	}
+	if !this.MetadataRefreshInterval.Equal(&that1.MetadataRefreshInterval) {
+		return fmt.Errorf("MetadataRefreshInterval this(%v) Not Equal that(%v)", this.MetadataRefreshInterval, that1.MetadataRefreshInterval)
+	}
 	if this.ConsumerServiceURL != that1.ConsumerServiceURL {
 		return fmt.Errorf("ConsumerServiceURL this(%v) Not Equal that(%v)", this.ConsumerServiceURL, that1.ConsumerServiceURL)
 	}
@@ -1557,6 +2113,17 @@ func (this *EmailProofBySAML) Equal(that interface{}) bool {
 	if this.IDPMetadataURL != that1.IDPMetadataURL {
 		return false
 	}
+	if len(this.SAMLConfig) != len(that1.SAMLConfig) {
+		return false
+	}
+	for i := range this.SAMLConfig {
+		if !this.SAMLConfig[i].Equal(that1.SAMLConfig[i]) {
+			return false
+		}
+	}
+	if !this.MetadataRefreshInterval.Equal(&that1.MetadataRefreshInterval) {
+		return false
+	}
 	if this.ConsumerServiceURL != that1.ConsumerServiceURL {
 		return false
 	}
@@ -1568,7 +2135,7 @@ func (this *EmailProofBySAML) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *OIDCConfig) VerboseEqual(that interface{}) error {
+func (this *EmailProofByChallenge) VerboseEqual(that interface{}) error {
 	if that == nil {
 		if this == nil {
 			return nil
@@ -1576,22 +2143,22 @@ func (this *OIDCConfig) VerboseEqual(that interface{}) error {
 		return fmt.Errorf("that == nil && this != nil")
 	}
 
-	that1, ok := that.(*OIDCConfig)
+	that1, ok := that.(*EmailProofByChallenge)
 	if !ok {
-		that2, ok := that.(OIDCConfig)
+		that2, ok := that.(EmailProofByChallenge)
 		if ok {
 			that1 = &that2
 		} else {
-			return fmt.Errorf("that is not of type *OIDCConfig")
+			return fmt.Errorf("that is not of type *EmailProofByChallenge")
 		}
 	}
 	if that1 == nil {
 		if this == nil {
 			return nil
 		}
-		return fmt.Errorf("that is type *OIDCConfig but is nil && this != nil")
+		return fmt.Errorf("that is type *EmailProofByChallenge but is nil && this != nil")
 	} else if this == nil {
-		return fmt.Errorf("that is type *OIDCConfig but is not nil && this == nil")
+		return fmt.Errorf("that is type *EmailProofByChallenge but is not nil && this == nil")
 	}
 	if len(this.AllowedDomains) != len(that1.AllowedDomains) {
 		return fmt.Errorf("AllowedDomains this(%v) Not Equal that(%v)", len(this.AllowedDomains), len(that1.AllowedDomains))
@@ -1601,24 +2168,21 @@ func (this *OIDCConfig) VerboseEqual(that interface{}) error {
 			return fmt.Errorf("AllowedDomains this[%v](%v) Not Equal that[%v](%v)", i, this.AllowedDomains[i], i, that1.AllowedDomains[i])
 		}
 	}
-	if this.DiscoveryURL != that1.DiscoveryURL {
-		return fmt.Errorf("DiscoveryURL this(%v) Not Equal that(%v)", this.DiscoveryURL, that1.DiscoveryURL)
+	if this.SMTPRelay != that1.SMTPRelay {
+		return fmt.Errorf("SMTPRelay this(%v) Not Equal that(%v)", this.SMTPRelay, that1.SMTPRelay)
 	}
-	if this.Issuer != that1.Issuer {
-		return fmt.Errorf("Issuer this(%v) Not Equal that(%v)", this.Issuer, that1.Issuer)
+	if this.FromAddr != that1.FromAddr {
+		return fmt.Errorf("FromAddr this(%v) Not Equal that(%v)", this.FromAddr, that1.FromAddr)
 	}
-	if this.ClientID != that1.ClientID {
-		return fmt.Errorf("ClientID this(%v) Not Equal that(%v)", this.ClientID, that1.ClientID)
+	if this.Subject != that1.Subject {
+		return fmt.Errorf("Subject this(%v) Not Equal that(%v)", this.Subject, that1.Subject)
 	}
 	if !this.Validity.Equal(&that1.Validity) {
 		return fmt.Errorf("Validity this(%v) Not Equal that(%v)", this.Validity, that1.Validity)
 	}
-	if this.Scope != that1.Scope {
-		return fmt.Errorf("Scope this(%v) Not Equal that(%v)", this.Scope, that1.Scope)
-	}
 	return nil
 }
-func (this *OIDCConfig) Equal(that interface{}) bool {
+func (this *EmailProofByChallenge) Equal(that interface{}) bool {
 	if that == nil {
 		if this == nil {
 			return true
@@ -1626,9 +2190,9 @@ func (this *OIDCConfig) Equal(that interface{}) bool {
 		return false
 	}
 
-	that1, ok := that.(*OIDCConfig)
+	that1, ok := that.(*EmailProofByChallenge)
 	if !ok {
-		that2, ok := that.(OIDCConfig)
+		that2, ok := that.(EmailProofByChallenge)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1651,24 +2215,21 @@ func (this *OIDCConfig) Equal(that interface{}) bool {
 			return false
 		}
 	}
-	if this.DiscoveryURL != that1.DiscoveryURL {
+	if this.SMTPRelay != that1.SMTPRelay {
 		return false
 	}
-	if this.Issuer != that1.Issuer {
+	if this.FromAddr != that1.FromAddr {
 		return false
 	}
-	if this.ClientID != that1.ClientID {
+	if this.Subject != that1.Subject {
 		return false
 	}
 	if !this.Validity.Equal(&that1.Validity) {
 		return false
 	}
-	if this.Scope != that1.Scope {
-		return false
-	}
 	return true
 }
-func (this *Replica) VerboseEqual(that interface{}) error {
+func (this *AccountRecoveryConfig) VerboseEqual(that interface{}) error {
 	if that == nil {
 		if this == nil {
 			return nil
@@ -1676,40 +2237,38 @@ func (this *Replica) VerboseEqual(that interface{}) error {
 		return fmt.Errorf("that == nil && this != nil")
 	}
 
-	that1, ok := that.(*Replica)
+	that1, ok := that.(*AccountRecoveryConfig)
 	if !ok {
-		that2, ok := that.(Replica)
+		that2, ok := that.(AccountRecoveryConfig)
 		if ok {
 			that1 = &that2
 		} else {
-			return fmt.Errorf("that is not of type *Replica")
+			return fmt.Errorf("that is not of type *AccountRecoveryConfig")
 		}
 	}
 	if that1 == nil {
 		if this == nil {
 			return nil
 		}
-		return fmt.Errorf("that is type *Replica but is nil && this != nil")
+		return fmt.Errorf("that is type *AccountRecoveryConfig but is nil && this != nil")
 	} else if this == nil {
-		return fmt.Errorf("that is type *Replica but is not nil && this == nil")
+		return fmt.Errorf("that is type *AccountRecoveryConfig but is not nil && this == nil")
 	}
-	if this.ID != that1.ID {
-		return fmt.Errorf("ID this(%v) Not Equal that(%v)", this.ID, that1.ID)
+	if !this.MinDelay.Equal(&that1.MinDelay) {
+		return fmt.Errorf("MinDelay this(%v) Not Equal that(%v)", this.MinDelay, that1.MinDelay)
 	}
-	if len(this.PublicKeys) != len(that1.PublicKeys) {
-		return fmt.Errorf("PublicKeys this(%v) Not Equal that(%v)", len(this.PublicKeys), len(that1.PublicKeys))
+	if this.SMTPRelay != that1.SMTPRelay {
+		return fmt.Errorf("SMTPRelay this(%v) Not Equal that(%v)", this.SMTPRelay, that1.SMTPRelay)
 	}
-	for i := range this.PublicKeys {
-		if !this.PublicKeys[i].Equal(that1.PublicKeys[i]) {
-			return fmt.Errorf("PublicKeys this[%v](%v) Not Equal that[%v](%v)", i, this.PublicKeys[i], i, that1.PublicKeys[i])
-		}
+	if this.FromAddr != that1.FromAddr {
+		return fmt.Errorf("FromAddr this(%v) Not Equal that(%v)", this.FromAddr, that1.FromAddr)
 	}
-	if this.RaftAddr != that1.RaftAddr {
-		return fmt.Errorf("RaftAddr this(%v) Not Equal that(%v)", this.RaftAddr, that1.RaftAddr)
+	if this.Subject != that1.Subject {
+		return fmt.Errorf("Subject this(%v) Not Equal that(%v)", this.Subject, that1.Subject)
 	}
 	return nil
 }
-func (this *Replica) Equal(that interface{}) bool {
+func (this *AccountRecoveryConfig) Equal(that interface{}) bool {
 	if that == nil {
 		if this == nil {
 			return true
@@ -1717,9 +2276,9 @@ func (this *Replica) Equal(that interface{}) bool {
 		return false
 	}
 
-	that1, ok := that.(*Replica)
+	that1, ok := that.(*AccountRecoveryConfig)
 	if !ok {
-		that2, ok := that.(Replica)
+		that2, ok := that.(AccountRecoveryConfig)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1734,1585 +2293,3545 @@ func (this *Replica) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.ID != that1.ID {
+	if !this.MinDelay.Equal(&that1.MinDelay) {
 		return false
 	}
-	if len(this.PublicKeys) != len(that1.PublicKeys) {
+	if this.SMTPRelay != that1.SMTPRelay {
 		return false
 	}
-	for i := range this.PublicKeys {
-		if !this.PublicKeys[i].Equal(that1.PublicKeys[i]) {
-			return false
-		}
+	if this.FromAddr != that1.FromAddr {
+		return false
 	}
-	if this.RaftAddr != that1.RaftAddr {
+	if this.Subject != that1.Subject {
 		return false
 	}
 	return true
 }
-func (this *ReplicaConfig) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *RateLimit) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
 	}
-	s := make([]string, 0, 21)
-	s = append(s, "&proto.ReplicaConfig{")
-	s = append(s, "KeyserverConfig: "+strings.Replace(this.KeyserverConfig.GoString(), `&`, ``, 1)+",\n")
-	s = append(s, "ReplicaID: "+fmt.Sprintf("%#v", this.ReplicaID)+",\n")
-	s = append(s, "SigningKeyID: "+fmt.Sprintf("%#v", this.SigningKeyID)+",\n")
-	s = append(s, "PublicAddr: "+fmt.Sprintf("%#v", this.PublicAddr)+",\n")
-	s = append(s, "PublicTLS: "+strings.Replace(this.PublicTLS.GoString(), `&`, ``, 1)+",\n")
-	s = append(s, "VerifierAddr: "+fmt.Sprintf("%#v", this.VerifierAddr)+",\n")
-	s = append(s, "VerifierTLS: "+strings.Replace(this.VerifierTLS.GoString(), `&`, ``, 1)+",\n")
-	s = append(s, "HKPAddr: "+fmt.Sprintf("%#v", this.HKPAddr)+",\n")
-	s = append(s, "HKPTLS: "+strings.Replace(this.HKPTLS.GoString(), `&`, ``, 1)+",\n")
-	s = append(s, "HTTPFrontAddr: "+fmt.Sprintf("%#v", this.HTTPFrontAddr)+",\n")
-	s = append(s, "HTTPFrontTLS: "+strings.Replace(this.HTTPFrontTLS.GoString(), `&`, ``, 1)+",\n")
-	s = append(s, "RaftAddr: "+fmt.Sprintf("%#v", this.RaftAddr)+",\n")
-	s = append(s, "RaftTLS: "+strings.Replace(this.RaftTLS.GoString(), `&`, ``, 1)+",\n")
-	s = append(s, "LevelDBPath: "+fmt.Sprintf("%#v", this.LevelDBPath)+",\n")
-	s = append(s, "RaftHeartbeat: "+strings.Replace(this.RaftHeartbeat.GoString(), `&`, ``, 1)+",\n")
-	s = append(s, "LaggingVerifierScan: "+fmt.Sprintf("%#v", this.LaggingVerifierScan)+",\n")
-	s = append(s, "ClientTimeout: "+strings.Replace(this.ClientTimeout.GoString(), `&`, ``, 1)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *KeyserverConfig) GoString() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*RateLimit)
+	if !ok {
+		that2, ok := that.(RateLimit)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *RateLimit")
+		}
 	}
-	s := make([]string, 0, 12)
-	s = append(s, "&proto.KeyserverConfig{")
-	s = append(s, "ServerID: "+fmt.Sprintf("%#v", this.ServerID)+",\n")
-	s = append(s, "Realm: "+fmt.Sprintf("%#v", this.Realm)+",\n")
-	s = append(s, "VRFKeyID: "+fmt.Sprintf("%#v", this.VRFKeyID)+",\n")
-	s = append(s, "MinEpochInterval: "+strings.Replace(this.MinEpochInterval.GoString(), `&`, ``, 1)+",\n")
-	s = append(s, "MaxEpochInterval: "+strings.Replace(this.MaxEpochInterval.GoString(), `&`, ``, 1)+",\n")
-	s = append(s, "ProposalRetryInterval: "+strings.Replace(this.ProposalRetryInterval.GoString(), `&`, ``, 1)+",\n")
-	if this.InitialReplicas != nil {
-		s = append(s, "InitialReplicas: "+fmt.Sprintf("%#v", this.InitialReplicas)+",\n")
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *RateLimit but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *RateLimit but is not nil && this == nil")
 	}
-	if this.RegistrationPolicy != nil {
-		s = append(s, "RegistrationPolicy: "+fmt.Sprintf("%#v", this.RegistrationPolicy)+",\n")
+	if !this.Interval.Equal(&that1.Interval) {
+		return fmt.Errorf("Interval this(%v) Not Equal that(%v)", this.Interval, that1.Interval)
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	if this.Burst != that1.Burst {
+		return fmt.Errorf("Burst this(%v) Not Equal that(%v)", this.Burst, that1.Burst)
+	}
+	return nil
 }
-func (this *RegistrationPolicy) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *RateLimit) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
 	}
-	s := make([]string, 0, 9)
-	s = append(s, "&proto.RegistrationPolicy{")
-	if this.PolicyType != nil {
-		s = append(s, "PolicyType: "+fmt.Sprintf("%#v", this.PolicyType)+",\n")
+
+	that1, ok := that.(*RateLimit)
+	if !ok {
+		that2, ok := that.(RateLimit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *RegistrationPolicy_InsecureSkipEmailProof) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
 	}
-	s := strings.Join([]string{`&proto.RegistrationPolicy_InsecureSkipEmailProof{` +
-		`InsecureSkipEmailProof:` + fmt.Sprintf("%#v", this.InsecureSkipEmailProof) + `}`}, ", ")
-	return s
-}
-func (this *RegistrationPolicy_EmailProofByDKIM) GoString() string {
-	if this == nil {
-		return "nil"
+	if !this.Interval.Equal(&that1.Interval) {
+		return false
 	}
-	s := strings.Join([]string{`&proto.RegistrationPolicy_EmailProofByDKIM{` +
-		`EmailProofByDKIM:` + fmt.Sprintf("%#v", this.EmailProofByDKIM) + `}`}, ", ")
-	return s
-}
-func (this *RegistrationPolicy_EmailProofByClientCert) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.Burst != that1.Burst {
+		return false
 	}
-	s := strings.Join([]string{`&proto.RegistrationPolicy_EmailProofByClientCert{` +
-		`EmailProofByClientCert:` + fmt.Sprintf("%#v", this.EmailProofByClientCert) + `}`}, ", ")
-	return s
+	return true
 }
-func (this *RegistrationPolicy_EmailProofByOIDC) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *SAMLConfig) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
 	}
-	s := strings.Join([]string{`&proto.RegistrationPolicy_EmailProofByOIDC{` +
-		`EmailProofByOIDC:` + fmt.Sprintf("%#v", this.EmailProofByOIDC) + `}`}, ", ")
-	return s
-}
-func (this *RegistrationPolicy_EmailProofBySAML) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&proto.RegistrationPolicy_EmailProofBySAML{` +
-		`EmailProofBySAML:` + fmt.Sprintf("%#v", this.EmailProofBySAML) + `}`}, ", ")
-	return s
-}
-func (this *EmailProofByDKIM) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 7)
-	s = append(s, "&proto.EmailProofByDKIM{")
-	s = append(s, "AllowedDomains: "+fmt.Sprintf("%#v", this.AllowedDomains)+",\n")
-	s = append(s, "ToAddr: "+fmt.Sprintf("%#v", this.ToAddr)+",\n")
-	s = append(s, "SubjectPrefix: "+fmt.Sprintf("%#v", this.SubjectPrefix)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *EmailProofByClientCert) GoString() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*SAMLConfig)
+	if !ok {
+		that2, ok := that.(SAMLConfig)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *SAMLConfig")
+		}
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&proto.EmailProofByClientCert{")
-	s = append(s, "AllowedDomains: "+fmt.Sprintf("%#v", this.AllowedDomains)+",\n")
-	s = append(s, "CaCert: "+fmt.Sprintf("%#v", this.CaCert)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *EmailProofByOIDC) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *SAMLConfig but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *SAMLConfig but is not nil && this == nil")
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&proto.EmailProofByOIDC{")
-	if this.OIDCConfig != nil {
-		s = append(s, "OIDCConfig: "+fmt.Sprintf("%#v", this.OIDCConfig)+",\n")
+	if len(this.AllowedDomains) != len(that1.AllowedDomains) {
+		return fmt.Errorf("AllowedDomains this(%v) Not Equal that(%v)", len(this.AllowedDomains), len(that1.AllowedDomains))
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *EmailProofBySAML) GoString() string {
-	if this == nil {
-		return "nil"
+	for i := range this.AllowedDomains {
+		if this.AllowedDomains[i] != that1.AllowedDomains[i] {
+			return fmt.Errorf("AllowedDomains this[%v](%v) Not Equal that[%v](%v)", i, this.AllowedDomains[i], i, that1.AllowedDomains[i])
+		}
 	}
-	s := make([]string, 0, 9)
-	s = append(s, "&proto.EmailProofBySAML{")
-	s = append(s, "AllowedDomains: "+fmt.Sprintf("%#v", this.AllowedDomains)+",\n")
-	s = append(s, "IDPMetadataURL: "+fmt.Sprintf("%#v", this.IDPMetadataURL)+",\n")
-	s = append(s, "ConsumerServiceURL: "+fmt.Sprintf("%#v", this.ConsumerServiceURL)+",\n")
-	s = append(s, "ServiceProviderTLS: "+strings.Replace(this.ServiceProviderTLS.GoString(), `&`, ``, 1)+",\n")
-	s = append(s, "Validity: "+strings.Replace(this.Validity.GoString(), `&`, ``, 1)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *OIDCConfig) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.IDPMetadataURL != that1.IDPMetadataURL {
+		return fmt.Errorf("IDPMetadataURL this(%v) Not Equal that(%v)", this.IDPMetadataURL, that1.IDPMetadataURL)
 	}
-	s := make([]string, 0, 10)
-	s = append(s, "&proto.OIDCConfig{")
-	s = append(s, "AllowedDomains: "+fmt.Sprintf("%#v", this.AllowedDomains)+",\n")
-	s = append(s, "DiscoveryURL: "+fmt.Sprintf("%#v", this.DiscoveryURL)+",\n")
-	s = append(s, "Issuer: "+fmt.Sprintf("%#v", this.Issuer)+",\n")
-	s = append(s, "ClientID: "+fmt.Sprintf("%#v", this.ClientID)+",\n")
-	s = append(s, "Validity: "+strings.Replace(this.Validity.GoString(), `&`, ``, 1)+",\n")
-	s = append(s, "Scope: "+fmt.Sprintf("%#v", this.Scope)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return nil
 }
-func (this *Replica) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *SAMLConfig) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&proto.Replica{")
-	s = append(s, "ID: "+fmt.Sprintf("%#v", this.ID)+",\n")
-	if this.PublicKeys != nil {
-		s = append(s, "PublicKeys: "+fmt.Sprintf("%#v", this.PublicKeys)+",\n")
+
+	that1, ok := that.(*SAMLConfig)
+	if !ok {
+		that2, ok := that.(SAMLConfig)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "RaftAddr: "+fmt.Sprintf("%#v", this.RaftAddr)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func valueToGoStringKeyserverconfig(v interface{}, typ string) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
-}
-func extensionToGoStringKeyserverconfig(m github_com_maditya_protobuf_proto.Message) string {
-	e := github_com_maditya_protobuf_proto.GetUnsafeExtensionsMap(m)
-	if e == nil {
-		return "nil"
+	if len(this.AllowedDomains) != len(that1.AllowedDomains) {
+		return false
 	}
-	s := "proto.NewUnsafeXXX_InternalExtensions(map[int32]proto.Extension{"
-	keys := make([]int, 0, len(e))
-	for k := range e {
-		keys = append(keys, int(k))
+	for i := range this.AllowedDomains {
+		if this.AllowedDomains[i] != that1.AllowedDomains[i] {
+			return false
+		}
 	}
-	sort.Ints(keys)
-	ss := []string{}
-	for _, k := range keys {
-		ss = append(ss, strconv.Itoa(k)+": "+e[int32(k)].GoString())
+	if this.IDPMetadataURL != that1.IDPMetadataURL {
+		return false
 	}
-	s += strings.Join(ss, ",") + "})"
-	return s
+	return true
 }
-func (m *ReplicaConfig) Marshal() (data []byte, err error) {
-	size := m.Size()
-	data = make([]byte, size)
-	n, err := m.MarshalTo(data)
-	if err != nil {
-		return nil, err
+func (this *EmailProofByExternalVerifier) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
 	}
-	return data[:n], nil
-}
 
-func (m *ReplicaConfig) MarshalTo(data []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	data[i] = 0xa
-	i++
-	i = encodeVarintKeyserverconfig(data, i, uint64(m.KeyserverConfig.Size()))
-	n1, err := m.KeyserverConfig.MarshalTo(data[i:])
-	if err != nil {
-		return 0, err
+	that1, ok := that.(*EmailProofByExternalVerifier)
+	if !ok {
+		that2, ok := that.(EmailProofByExternalVerifier)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *EmailProofByExternalVerifier")
+		}
 	}
-	i += n1
-	if m.ReplicaID != 0 {
-		data[i] = 0x10
-		i++
-		i = encodeVarintKeyserverconfig(data, i, uint64(m.ReplicaID))
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *EmailProofByExternalVerifier but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *EmailProofByExternalVerifier but is not nil && this == nil")
 	}
-	if len(m.SigningKeyID) > 0 {
-		data[i] = 0x1a
-		i++
-		i = encodeVarintKeyserverconfig(data, i, uint64(len(m.SigningKeyID)))
-		i += copy(data[i:], m.SigningKeyID)
+	if this.Type != that1.Type {
+		return fmt.Errorf("Type this(%v) Not Equal that(%v)", this.Type, that1.Type)
 	}
-	if len(m.PublicAddr) > 0 {
-		data[i] = 0x22
-		i++
-		i = encodeVarintKeyserverconfig(data, i, uint64(len(m.PublicAddr)))
-		i += copy(data[i:], m.PublicAddr)
+	if len(this.AllowedDomains) != len(that1.AllowedDomains) {
+		return fmt.Errorf("AllowedDomains this(%v) Not Equal that(%v)", len(this.AllowedDomains), len(that1.AllowedDomains))
 	}
-	data[i] = 0x2a
-	i++
-	i = encodeVarintKeyserverconfig(data, i, uint64(m.PublicTLS.Size()))
-	n2, err := m.PublicTLS.MarshalTo(data[i:])
-	if err != nil {
-		return 0, err
+	for i := range this.AllowedDomains {
+		if this.AllowedDomains[i] != that1.AllowedDomains[i] {
+			return fmt.Errorf("AllowedDomains this[%v](%v) Not Equal that[%v](%v)", i, this.AllowedDomains[i], i, that1.AllowedDomains[i])
+		}
 	}
-	i += n2
-	if len(m.VerifierAddr) > 0 {
-		data[i] = 0x32
-		i++
-		i = encodeVarintKeyserverconfig(data, i, uint64(len(m.VerifierAddr)))
-		i += copy(data[i:], m.VerifierAddr)
+	return nil
+}
+func (this *EmailProofByExternalVerifier) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
 	}
-	data[i] = 0x3a
-	i++
-	i = encodeVarintKeyserverconfig(data, i, uint64(m.VerifierTLS.Size()))
-	n3, err := m.VerifierTLS.MarshalTo(data[i:])
-	if err != nil {
-		return 0, err
+
+	that1, ok := that.(*EmailProofByExternalVerifier)
+	if !ok {
+		that2, ok := that.(EmailProofByExternalVerifier)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	i += n3
-	if len(m.HKPAddr) > 0 {
-		data[i] = 0x42
-		i++
-		i = encodeVarintKeyserverconfig(data, i, uint64(len(m.HKPAddr)))
-		i += copy(data[i:], m.HKPAddr)
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
 	}
-	data[i] = 0x4a
-	i++
-	i = encodeVarintKeyserverconfig(data, i, uint64(m.HKPTLS.Size()))
-	n4, err := m.HKPTLS.MarshalTo(data[i:])
-	if err != nil {
-		return 0, err
+	if this.Type != that1.Type {
+		return false
 	}
-	i += n4
-	if len(m.HTTPFrontAddr) > 0 {
-		data[i] = 0x52
-		i++
-		i = encodeVarintKeyserverconfig(data, i, uint64(len(m.HTTPFrontAddr)))
-		i += copy(data[i:], m.HTTPFrontAddr)
+	if len(this.AllowedDomains) != len(that1.AllowedDomains) {
+		return false
 	}
-	data[i] = 0x5a
-	i++
-	i = encodeVarintKeyserverconfig(data, i, uint64(m.HTTPFrontTLS.Size()))
-	n5, err := m.HTTPFrontTLS.MarshalTo(data[i:])
-	if err != nil {
-		return 0, err
+	for i := range this.AllowedDomains {
+		if this.AllowedDomains[i] != that1.AllowedDomains[i] {
+			return false
+		}
 	}
-	i += n5
-	if len(m.RaftAddr) > 0 {
-		data[i] = 0x62
-		i++
-		i = encodeVarintKeyserverconfig(data, i, uint64(len(m.RaftAddr)))
-		i += copy(data[i:], m.RaftAddr)
+	return true
+}
+func (this *OIDCConfig) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
 	}
-	data[i] = 0x6a
-	i++
-	i = encodeVarintKeyserverconfig(data, i, uint64(m.RaftTLS.Size()))
-	n6, err := m.RaftTLS.MarshalTo(data[i:])
-	if err != nil {
-		return 0, err
+
+	that1, ok := that.(*OIDCConfig)
+	if !ok {
+		that2, ok := that.(OIDCConfig)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *OIDCConfig")
+		}
 	}
-	i += n6
-	if len(m.LevelDBPath) > 0 {
-		data[i] = 0x72
-		i++
-		i = encodeVarintKeyserverconfig(data, i, uint64(len(m.LevelDBPath)))
-		i += copy(data[i:], m.LevelDBPath)
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *OIDCConfig but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *OIDCConfig but is not nil && this == nil")
 	}
-	data[i] = 0x7a
-	i++
-	i = encodeVarintKeyserverconfig(data, i, uint64(m.RaftHeartbeat.Size()))
-	n7, err := m.RaftHeartbeat.MarshalTo(data[i:])
-	if err != nil {
-		return 0, err
+	if len(this.AllowedDomains) != len(that1.AllowedDomains) {
+		return fmt.Errorf("AllowedDomains this(%v) Not Equal that(%v)", len(this.AllowedDomains), len(that1.AllowedDomains))
 	}
-	i += n7
-	if m.LaggingVerifierScan != 0 {
-		data[i] = 0x80
-		i++
-		data[i] = 0x1
-		i++
-		i = encodeVarintKeyserverconfig(data, i, uint64(m.LaggingVerifierScan))
+	for i := range this.AllowedDomains {
+		if this.AllowedDomains[i] != that1.AllowedDomains[i] {
+			return fmt.Errorf("AllowedDomains this[%v](%v) Not Equal that[%v](%v)", i, this.AllowedDomains[i], i, that1.AllowedDomains[i])
+		}
 	}
-	data[i] = 0x8a
-	i++
-	data[i] = 0x1
-	i++
-	i = encodeVarintKeyserverconfig(data, i, uint64(m.ClientTimeout.Size()))
-	n8, err := m.ClientTimeout.MarshalTo(data[i:])
-	if err != nil {
-		return 0, err
+	if this.DiscoveryURL != that1.DiscoveryURL {
+		return fmt.Errorf("DiscoveryURL this(%v) Not Equal that(%v)", this.DiscoveryURL, that1.DiscoveryURL)
 	}
-	i += n8
-	return i, nil
-}
-
-func (m *KeyserverConfig) Marshal() (data []byte, err error) {
-	size := m.Size()
-	data = make([]byte, size)
-	n, err := m.MarshalTo(data)
-	if err != nil {
-		return nil, err
+	if this.Issuer != that1.Issuer {
+		return fmt.Errorf("Issuer this(%v) Not Equal that(%v)", this.Issuer, that1.Issuer)
 	}
-	return data[:n], nil
-}
-
-func (m *KeyserverConfig) MarshalTo(data []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.ServerID != 0 {
-		data[i] = 0x8
-		i++
-		i = encodeVarintKeyserverconfig(data, i, uint64(m.ServerID))
+	if this.ClientID != that1.ClientID {
+		return fmt.Errorf("ClientID this(%v) Not Equal that(%v)", this.ClientID, that1.ClientID)
 	}
-	if len(m.Realm) > 0 {
-		data[i] = 0x12
-		i++
-		i = encodeVarintKeyserverconfig(data, i, uint64(len(m.Realm)))
-		i += copy(data[i:], m.Realm)
+	if !this.Validity.Equal(&that1.Validity) {
+		return fmt.Errorf("Validity this(%v) Not Equal that(%v)", this.Validity, that1.Validity)
 	}
-	if len(m.VRFKeyID) > 0 {
-		data[i] = 0x1a
-		i++
-		i = encodeVarintKeyserverconfig(data, i, uint64(len(m.VRFKeyID)))
-		i += copy(data[i:], m.VRFKeyID)
+	if this.Scope != that1.Scope {
+		return fmt.Errorf("Scope this(%v) Not Equal that(%v)", this.Scope, that1.Scope)
 	}
-	data[i] = 0x22
-	i++
-	i = encodeVarintKeyserverconfig(data, i, uint64(m.MinEpochInterval.Size()))
-	n9, err := m.MinEpochInterval.MarshalTo(data[i:])
-	if err != nil {
-		return 0, err
+	if !this.KeyRefreshInterval.Equal(&that1.KeyRefreshInterval) {
+		return fmt.Errorf("KeyRefreshInterval this(%v) Not Equal that(%v)", this.KeyRefreshInterval, that1.KeyRefreshInterval)
 	}
-	i += n9
-	data[i] = 0x2a
-	i++
-	i = encodeVarintKeyserverconfig(data, i, uint64(m.MaxEpochInterval.Size()))
-	n10, err := m.MaxEpochInterval.MarshalTo(data[i:])
-	if err != nil {
-		return 0, err
+	if this.ClientSecret != that1.ClientSecret {
+		return fmt.Errorf("ClientSecret this(%v) Not Equal that(%v)", this.ClientSecret, that1.ClientSecret)
 	}
-	i += n10
-	data[i] = 0x32
-	i++
-	i = encodeVarintKeyserverconfig(data, i, uint64(m.ProposalRetryInterval.Size()))
-	n11, err := m.ProposalRetryInterval.MarshalTo(data[i:])
-	if err != nil {
-		return 0, err
+	return nil
+}
+func (this *OIDCConfig) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
 	}
-	i += n11
-	if len(m.InitialReplicas) > 0 {
-		for _, msg := range m.InitialReplicas {
-			data[i] = 0x3a
-			i++
-			i = encodeVarintKeyserverconfig(data, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(data[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+
+	that1, ok := that.(*OIDCConfig)
+	if !ok {
+		that2, ok := that.(OIDCConfig)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if len(m.RegistrationPolicy) > 0 {
-		for _, msg := range m.RegistrationPolicy {
-			data[i] = 0x42
-			i++
-			i = encodeVarintKeyserverconfig(data, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(data[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+	if that1 == nil {
+		if this == nil {
+			return true
 		}
+		return false
+	} else if this == nil {
+		return false
 	}
-	return i, nil
-}
-
-func (m *RegistrationPolicy) Marshal() (data []byte, err error) {
-	size := m.Size()
-	data = make([]byte, size)
-	n, err := m.MarshalTo(data)
-	if err != nil {
-		return nil, err
+	if len(this.AllowedDomains) != len(that1.AllowedDomains) {
+		return false
 	}
-	return data[:n], nil
-}
-
-func (m *RegistrationPolicy) MarshalTo(data []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.PolicyType != nil {
-		nn12, err := m.PolicyType.MarshalTo(data[i:])
-		if err != nil {
-			return 0, err
+	for i := range this.AllowedDomains {
+		if this.AllowedDomains[i] != that1.AllowedDomains[i] {
+			return false
 		}
-		i += nn12
 	}
-	return i, nil
-}
-
-func (m *RegistrationPolicy_InsecureSkipEmailProof) MarshalTo(data []byte) (int, error) {
-	i := 0
-	data[i] = 0x8
-	i++
-	if m.InsecureSkipEmailProof {
-		data[i] = 1
-	} else {
-		data[i] = 0
+	if this.DiscoveryURL != that1.DiscoveryURL {
+		return false
 	}
-	i++
-	return i, nil
+	if this.Issuer != that1.Issuer {
+		return false
+	}
+	if this.ClientID != that1.ClientID {
+		return false
+	}
+	if !this.Validity.Equal(&that1.Validity) {
+		return false
+	}
+	if this.Scope != that1.Scope {
+		return false
+	}
+	if !this.KeyRefreshInterval.Equal(&that1.KeyRefreshInterval) {
+		return false
+	}
+	if this.ClientSecret != that1.ClientSecret {
+		return false
+	}
+	return true
 }
-func (m *RegistrationPolicy_EmailProofByDKIM) MarshalTo(data []byte) (int, error) {
-	i := 0
-	if m.EmailProofByDKIM != nil {
-		data[i] = 0x12
-		i++
-		i = encodeVarintKeyserverconfig(data, i, uint64(m.EmailProofByDKIM.Size()))
-		n13, err := m.EmailProofByDKIM.MarshalTo(data[i:])
-		if err != nil {
-			return 0, err
+func (this *Replica) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
 		}
-		i += n13
+		return fmt.Errorf("that == nil && this != nil")
 	}
-	return i, nil
-}
-func (m *RegistrationPolicy_EmailProofByClientCert) MarshalTo(data []byte) (int, error) {
-	i := 0
-	if m.EmailProofByClientCert != nil {
-		data[i] = 0x1a
-		i++
-		i = encodeVarintKeyserverconfig(data, i, uint64(m.EmailProofByClientCert.Size()))
-		n14, err := m.EmailProofByClientCert.MarshalTo(data[i:])
-		if err != nil {
-			return 0, err
+
+	that1, ok := that.(*Replica)
+	if !ok {
+		that2, ok := that.(Replica)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *Replica")
 		}
-		i += n14
 	}
-	return i, nil
-}
-func (m *RegistrationPolicy_EmailProofByOIDC) MarshalTo(data []byte) (int, error) {
-	i := 0
-	if m.EmailProofByOIDC != nil {
-		data[i] = 0x22
-		i++
-		i = encodeVarintKeyserverconfig(data, i, uint64(m.EmailProofByOIDC.Size()))
-		n15, err := m.EmailProofByOIDC.MarshalTo(data[i:])
-		if err != nil {
-			return 0, err
+	if that1 == nil {
+		if this == nil {
+			return nil
 		}
-		i += n15
+		return fmt.Errorf("that is type *Replica but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *Replica but is not nil && this == nil")
 	}
-	return i, nil
-}
-func (m *RegistrationPolicy_EmailProofBySAML) MarshalTo(data []byte) (int, error) {
-	i := 0
-	if m.EmailProofBySAML != nil {
-		data[i] = 0x2a
-		i++
-		i = encodeVarintKeyserverconfig(data, i, uint64(m.EmailProofBySAML.Size()))
-		n16, err := m.EmailProofBySAML.MarshalTo(data[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n16
+	if this.ID != that1.ID {
+		return fmt.Errorf("ID this(%v) Not Equal that(%v)", this.ID, that1.ID)
 	}
-	return i, nil
-}
-func (m *EmailProofByDKIM) Marshal() (data []byte, err error) {
-	size := m.Size()
-	data = make([]byte, size)
-	n, err := m.MarshalTo(data)
-	if err != nil {
-		return nil, err
+	if len(this.PublicKeys) != len(that1.PublicKeys) {
+		return fmt.Errorf("PublicKeys this(%v) Not Equal that(%v)", len(this.PublicKeys), len(that1.PublicKeys))
 	}
-	return data[:n], nil
-}
-
-func (m *EmailProofByDKIM) MarshalTo(data []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.AllowedDomains) > 0 {
-		for _, s := range m.AllowedDomains {
-			data[i] = 0xa
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				data[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			data[i] = uint8(l)
-			i++
-			i += copy(data[i:], s)
+	for i := range this.PublicKeys {
+		if !this.PublicKeys[i].Equal(that1.PublicKeys[i]) {
+			return fmt.Errorf("PublicKeys this[%v](%v) Not Equal that[%v](%v)", i, this.PublicKeys[i], i, that1.PublicKeys[i])
 		}
 	}
-	if len(m.ToAddr) > 0 {
-		data[i] = 0x12
-		i++
-		i = encodeVarintKeyserverconfig(data, i, uint64(len(m.ToAddr)))
-		i += copy(data[i:], m.ToAddr)
-	}
-	if len(m.SubjectPrefix) > 0 {
-		data[i] = 0x1a
-		i++
-		i = encodeVarintKeyserverconfig(data, i, uint64(len(m.SubjectPrefix)))
-		i += copy(data[i:], m.SubjectPrefix)
+	if this.RaftAddr != that1.RaftAddr {
+		return fmt.Errorf("RaftAddr this(%v) Not Equal that(%v)", this.RaftAddr, that1.RaftAddr)
 	}
-	return i, nil
+	return nil
 }
-
-func (m *EmailProofByClientCert) Marshal() (data []byte, err error) {
-	size := m.Size()
-	data = make([]byte, size)
-	n, err := m.MarshalTo(data)
-	if err != nil {
-		return nil, err
+func (this *Replica) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
 	}
-	return data[:n], nil
-}
 
-func (m *EmailProofByClientCert) MarshalTo(data []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.AllowedDomains) > 0 {
-		for _, s := range m.AllowedDomains {
-			data[i] = 0xa
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				data[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			data[i] = uint8(l)
-			i++
-			i += copy(data[i:], s)
+	that1, ok := that.(*Replica)
+	if !ok {
+		that2, ok := that.(Replica)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if len(m.CaCert) > 0 {
-		data[i] = 0x12
-		i++
-		i = encodeVarintKeyserverconfig(data, i, uint64(len(m.CaCert)))
-		i += copy(data[i:], m.CaCert)
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
 	}
-	return i, nil
-}
-
-func (m *EmailProofByOIDC) Marshal() (data []byte, err error) {
-	size := m.Size()
-	data = make([]byte, size)
-	n, err := m.MarshalTo(data)
-	if err != nil {
-		return nil, err
+	if this.ID != that1.ID {
+		return false
 	}
-	return data[:n], nil
-}
-
-func (m *EmailProofByOIDC) MarshalTo(data []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.OIDCConfig) > 0 {
-		for _, msg := range m.OIDCConfig {
-			data[i] = 0xa
-			i++
-			i = encodeVarintKeyserverconfig(data, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(data[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+	if len(this.PublicKeys) != len(that1.PublicKeys) {
+		return false
+	}
+	for i := range this.PublicKeys {
+		if !this.PublicKeys[i].Equal(that1.PublicKeys[i]) {
+			return false
 		}
 	}
-	return i, nil
-}
-
-func (m *EmailProofBySAML) Marshal() (data []byte, err error) {
-	size := m.Size()
-	data = make([]byte, size)
-	n, err := m.MarshalTo(data)
-	if err != nil {
-		return nil, err
+	if this.RaftAddr != that1.RaftAddr {
+		return false
 	}
-	return data[:n], nil
+	return true
 }
-
-func (m *EmailProofBySAML) MarshalTo(data []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.AllowedDomains) > 0 {
-		for _, s := range m.AllowedDomains {
-			data[i] = 0xa
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				data[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			data[i] = uint8(l)
-			i++
-			i += copy(data[i:], s)
-		}
+func (this *ReplicaConfig) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if len(m.IDPMetadataURL) > 0 {
-		data[i] = 0x12
-		i++
-		i = encodeVarintKeyserverconfig(data, i, uint64(len(m.IDPMetadataURL)))
-		i += copy(data[i:], m.IDPMetadataURL)
+	s := make([]string, 0, 25)
+	s = append(s, "&proto.ReplicaConfig{")
+	s = append(s, "KeyserverConfig: "+strings.Replace(this.KeyserverConfig.GoString(), `&`, ``, 1)+",\n")
+	s = append(s, "ReplicaID: "+fmt.Sprintf("%#v", this.ReplicaID)+",\n")
+	s = append(s, "SigningKeyID: "+fmt.Sprintf("%#v", this.SigningKeyID)+",\n")
+	s = append(s, "PublicAddr: "+fmt.Sprintf("%#v", this.PublicAddr)+",\n")
+	s = append(s, "PublicTLS: "+strings.Replace(this.PublicTLS.GoString(), `&`, ``, 1)+",\n")
+	s = append(s, "VerifierAddr: "+fmt.Sprintf("%#v", this.VerifierAddr)+",\n")
+	s = append(s, "VerifierTLS: "+strings.Replace(this.VerifierTLS.GoString(), `&`, ``, 1)+",\n")
+	s = append(s, "HKPAddr: "+fmt.Sprintf("%#v", this.HKPAddr)+",\n")
+	s = append(s, "HKPTLS: "+strings.Replace(this.HKPTLS.GoString(), `&`, ``, 1)+",\n")
+	s = append(s, "HTTPFrontAddr: "+fmt.Sprintf("%#v", this.HTTPFrontAddr)+",\n")
+	s = append(s, "HTTPFrontTLS: "+strings.Replace(this.HTTPFrontTLS.GoString(), `&`, ``, 1)+",\n")
+	s = append(s, "RaftAddr: "+fmt.Sprintf("%#v", this.RaftAddr)+",\n")
+	s = append(s, "RaftTLS: "+strings.Replace(this.RaftTLS.GoString(), `&`, ``, 1)+",\n")
+	s = append(s, "LevelDBPath: "+fmt.Sprintf("%#v", this.LevelDBPath)+",\n")
+	s = append(s, "RaftHeartbeat: "+strings.Replace(this.RaftHeartbeat.GoString(), `&`, ``, 1)+",\n")
+	s = append(s, "LaggingVerifierScan: "+fmt.Sprintf("%#v", this.LaggingVerifierScan)+",\n")
+	s = append(s, "ClientTimeout: "+strings.Replace(this.ClientTimeout.GoString(), `&`, ``, 1)+",\n")
+	s = append(s, "SMTPAddr: "+fmt.Sprintf("%#v", this.SMTPAddr)+",\n")
+	if this.AccountRecovery != nil {
+		s = append(s, "AccountRecovery: "+fmt.Sprintf("%#v", this.AccountRecovery)+",\n")
 	}
-	if len(m.ConsumerServiceURL) > 0 {
-		data[i] = 0x22
-		i++
-		i = encodeVarintKeyserverconfig(data, i, uint64(len(m.ConsumerServiceURL)))
-		i += copy(data[i:], m.ConsumerServiceURL)
+	if this.ClientIPRateLimit != nil {
+		s = append(s, "ClientIPRateLimit: "+fmt.Sprintf("%#v", this.ClientIPRateLimit)+",\n")
 	}
-	data[i] = 0x2a
-	i++
-	i = encodeVarintKeyserverconfig(data, i, uint64(m.ServiceProviderTLS.Size()))
-	n17, err := m.ServiceProviderTLS.MarshalTo(data[i:])
-	if err != nil {
-		return 0, err
+	if this.UserIDRateLimit != nil {
+		s = append(s, "UserIDRateLimit: "+fmt.Sprintf("%#v", this.UserIDRateLimit)+",\n")
 	}
-	i += n17
-	data[i] = 0x32
-	i++
-	i = encodeVarintKeyserverconfig(data, i, uint64(m.Validity.Size()))
-	n18, err := m.Validity.MarshalTo(data[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n18
-	return i, nil
-}
-
-func (m *OIDCConfig) Marshal() (data []byte, err error) {
-	size := m.Size()
-	data = make([]byte, size)
-	n, err := m.MarshalTo(data)
-	if err != nil {
-		return nil, err
-	}
-	return data[:n], nil
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *OIDCConfig) MarshalTo(data []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.AllowedDomains) > 0 {
-		for _, s := range m.AllowedDomains {
-			data[i] = 0xa
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				data[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			data[i] = uint8(l)
-			i++
-			i += copy(data[i:], s)
-		}
-	}
-	if len(m.DiscoveryURL) > 0 {
-		data[i] = 0x12
-		i++
-		i = encodeVarintKeyserverconfig(data, i, uint64(len(m.DiscoveryURL)))
-		i += copy(data[i:], m.DiscoveryURL)
+func (this *KeyserverConfig) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if len(m.Issuer) > 0 {
-		data[i] = 0x1a
-		i++
-		i = encodeVarintKeyserverconfig(data, i, uint64(len(m.Issuer)))
-		i += copy(data[i:], m.Issuer)
+	s := make([]string, 0, 19)
+	s = append(s, "&proto.KeyserverConfig{")
+	s = append(s, "ServerID: "+fmt.Sprintf("%#v", this.ServerID)+",\n")
+	s = append(s, "Realm: "+fmt.Sprintf("%#v", this.Realm)+",\n")
+	s = append(s, "VRFKeyID: "+fmt.Sprintf("%#v", this.VRFKeyID)+",\n")
+	s = append(s, "NextVRFKeyID: "+fmt.Sprintf("%#v", this.NextVRFKeyID)+",\n")
+	s = append(s, "VRFSuite: "+fmt.Sprintf("%#v", this.VRFSuite)+",\n")
+	s = append(s, "MinEpochInterval: "+strings.Replace(this.MinEpochInterval.GoString(), `&`, ``, 1)+",\n")
+	s = append(s, "MaxEpochInterval: "+strings.Replace(this.MaxEpochInterval.GoString(), `&`, ``, 1)+",\n")
+	s = append(s, "ProposalRetryInterval: "+strings.Replace(this.ProposalRetryInterval.GoString(), `&`, ``, 1)+",\n")
+	if this.InitialReplicas != nil {
+		s = append(s, "InitialReplicas: "+fmt.Sprintf("%#v", this.InitialReplicas)+",\n")
 	}
-	if len(m.ClientID) > 0 {
-		data[i] = 0x22
-		i++
-		i = encodeVarintKeyserverconfig(data, i, uint64(len(m.ClientID)))
-		i += copy(data[i:], m.ClientID)
+	if this.RegistrationPolicy != nil {
+		s = append(s, "RegistrationPolicy: "+fmt.Sprintf("%#v", this.RegistrationPolicy)+",\n")
 	}
-	data[i] = 0x2a
-	i++
-	i = encodeVarintKeyserverconfig(data, i, uint64(m.Validity.Size()))
-	n19, err := m.Validity.MarshalTo(data[i:])
-	if err != nil {
-		return 0, err
+	s = append(s, "RefreshIdleEpochs: "+fmt.Sprintf("%#v", this.RefreshIdleEpochs)+",\n")
+	if this.DeletionPolicy != nil {
+		s = append(s, "DeletionPolicy: "+fmt.Sprintf("%#v", this.DeletionPolicy)+",\n")
 	}
-	i += n19
-	if len(m.Scope) > 0 {
-		data[i] = 0x32
-		i++
-		i = encodeVarintKeyserverconfig(data, i, uint64(len(m.Scope)))
-		i += copy(data[i:], m.Scope)
+	if this.DomainAdminPolicies != nil {
+		s = append(s, "DomainAdminPolicies: "+fmt.Sprintf("%#v", this.DomainAdminPolicies)+",\n")
 	}
-	return i, nil
-}
-
-func (m *Replica) Marshal() (data []byte, err error) {
-	size := m.Size()
-	data = make([]byte, size)
-	n, err := m.MarshalTo(data)
-	if err != nil {
-		return nil, err
+	s = append(s, "RegistrationsPerDomainPerEpoch: "+fmt.Sprintf("%#v", this.RegistrationsPerDomainPerEpoch)+",\n")
+	if this.VerifierPublicKeys != nil {
+		s = append(s, "VerifierPublicKeys: "+fmt.Sprintf("%#v", this.VerifierPublicKeys)+",\n")
 	}
-	return data[:n], nil
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *Replica) MarshalTo(data []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.ID != 0 {
-		data[i] = 0x8
-		i++
-		i = encodeVarintKeyserverconfig(data, i, uint64(m.ID))
+func (this *RegistrationPolicy) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if len(m.PublicKeys) > 0 {
-		for _, msg := range m.PublicKeys {
-			data[i] = 0x12
-			i++
-			i = encodeVarintKeyserverconfig(data, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(data[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	s := make([]string, 0, 11)
+	s = append(s, "&proto.RegistrationPolicy{")
+	if this.PolicyType != nil {
+		s = append(s, "PolicyType: "+fmt.Sprintf("%#v", this.PolicyType)+",\n")
 	}
-	if len(m.RaftAddr) > 0 {
-		data[i] = 0x1a
-		i++
-		i = encodeVarintKeyserverconfig(data, i, uint64(len(m.RaftAddr)))
-		i += copy(data[i:], m.RaftAddr)
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RegistrationPolicy_InsecureSkipEmailProof) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return i, nil
+	s := strings.Join([]string{`&proto.RegistrationPolicy_InsecureSkipEmailProof{` +
+		`InsecureSkipEmailProof:` + fmt.Sprintf("%#v", this.InsecureSkipEmailProof) + `}`}, ", ")
+	return s
 }
-
-func encodeFixed64Keyserverconfig(data []byte, offset int, v uint64) int {
-	data[offset] = uint8(v)
-	data[offset+1] = uint8(v >> 8)
-	data[offset+2] = uint8(v >> 16)
-	data[offset+3] = uint8(v >> 24)
-	data[offset+4] = uint8(v >> 32)
-	data[offset+5] = uint8(v >> 40)
-	data[offset+6] = uint8(v >> 48)
-	data[offset+7] = uint8(v >> 56)
-	return offset + 8
+func (this *RegistrationPolicy_EmailProofByDKIM) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&proto.RegistrationPolicy_EmailProofByDKIM{` +
+		`EmailProofByDKIM:` + fmt.Sprintf("%#v", this.EmailProofByDKIM) + `}`}, ", ")
+	return s
 }
-func encodeFixed32Keyserverconfig(data []byte, offset int, v uint32) int {
-	data[offset] = uint8(v)
-	data[offset+1] = uint8(v >> 8)
-	data[offset+2] = uint8(v >> 16)
-	data[offset+3] = uint8(v >> 24)
-	return offset + 4
+func (this *RegistrationPolicy_EmailProofByClientCert) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&proto.RegistrationPolicy_EmailProofByClientCert{` +
+		`EmailProofByClientCert:` + fmt.Sprintf("%#v", this.EmailProofByClientCert) + `}`}, ", ")
+	return s
 }
-func encodeVarintKeyserverconfig(data []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		data[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (this *RegistrationPolicy_EmailProofByOIDC) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	data[offset] = uint8(v)
-	return offset + 1
+	s := strings.Join([]string{`&proto.RegistrationPolicy_EmailProofByOIDC{` +
+		`EmailProofByOIDC:` + fmt.Sprintf("%#v", this.EmailProofByOIDC) + `}`}, ", ")
+	return s
 }
-func NewPopulatedReplicaConfig(r randyKeyserverconfig, easy bool) *ReplicaConfig {
-	this := &ReplicaConfig{}
-	v1 := NewPopulatedKeyserverConfig(r, easy)
-	this.KeyserverConfig = *v1
-	this.ReplicaID = uint64(uint64(r.Uint32()))
-	this.SigningKeyID = randStringKeyserverconfig(r)
-	this.PublicAddr = randStringKeyserverconfig(r)
-	v2 := NewPopulatedTLSConfig(r, easy)
-	this.PublicTLS = *v2
-	this.VerifierAddr = randStringKeyserverconfig(r)
-	v3 := NewPopulatedTLSConfig(r, easy)
-	this.VerifierTLS = *v3
-	this.HKPAddr = randStringKeyserverconfig(r)
-	v4 := NewPopulatedTLSConfig(r, easy)
-	this.HKPTLS = *v4
-	this.HTTPFrontAddr = randStringKeyserverconfig(r)
-	v5 := NewPopulatedTLSConfig(r, easy)
-	this.HTTPFrontTLS = *v5
-	this.RaftAddr = randStringKeyserverconfig(r)
-	v6 := NewPopulatedTLSConfig(r, easy)
-	this.RaftTLS = *v6
-	this.LevelDBPath = randStringKeyserverconfig(r)
-	v7 := NewPopulatedDuration(r, easy)
-	this.RaftHeartbeat = *v7
-	this.LaggingVerifierScan = uint64(uint64(r.Uint32()))
-	v8 := NewPopulatedDuration(r, easy)
-	this.ClientTimeout = *v8
-	if !easy && r.Intn(10) != 0 {
+func (this *RegistrationPolicy_EmailProofBySAML) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return this
+	s := strings.Join([]string{`&proto.RegistrationPolicy_EmailProofBySAML{` +
+		`EmailProofBySAML:` + fmt.Sprintf("%#v", this.EmailProofBySAML) + `}`}, ", ")
+	return s
 }
-
-func NewPopulatedKeyserverConfig(r randyKeyserverconfig, easy bool) *KeyserverConfig {
-	this := &KeyserverConfig{}
-	this.ServerID = uint64(uint64(r.Uint32()))
-	this.Realm = randStringKeyserverconfig(r)
-	this.VRFKeyID = randStringKeyserverconfig(r)
-	v9 := NewPopulatedDuration(r, easy)
-	this.MinEpochInterval = *v9
-	v10 := NewPopulatedDuration(r, easy)
-	this.MaxEpochInterval = *v10
-	v11 := NewPopulatedDuration(r, easy)
-	this.ProposalRetryInterval = *v11
-	if r.Intn(10) != 0 {
-		v12 := r.Intn(5)
-		this.InitialReplicas = make([]*Replica, v12)
-		for i := 0; i < v12; i++ {
-			this.InitialReplicas[i] = NewPopulatedReplica(r, easy)
-		}
+func (this *RegistrationPolicy_EmailProofByChallenge) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if r.Intn(10) != 0 {
-		v13 := r.Intn(5)
-		this.RegistrationPolicy = make([]*RegistrationPolicy, v13)
-		for i := 0; i < v13; i++ {
-			this.RegistrationPolicy[i] = NewPopulatedRegistrationPolicy(r, easy)
-		}
+	s := strings.Join([]string{`&proto.RegistrationPolicy_EmailProofByChallenge{` +
+		`EmailProofByChallenge:` + fmt.Sprintf("%#v", this.EmailProofByChallenge) + `}`}, ", ")
+	return s
+}
+func (this *RegistrationPolicy_EmailProofByExternalVerifier) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if !easy && r.Intn(10) != 0 {
+	s := strings.Join([]string{`&proto.RegistrationPolicy_EmailProofByExternalVerifier{` +
+		`EmailProofByExternalVerifier:` + fmt.Sprintf("%#v", this.EmailProofByExternalVerifier) + `}`}, ", ")
+	return s
+}
+func (this *EmailProofByDKIM) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return this
+	s := make([]string, 0, 9)
+	s = append(s, "&proto.EmailProofByDKIM{")
+	s = append(s, "AllowedDomains: "+fmt.Sprintf("%#v", this.AllowedDomains)+",\n")
+	s = append(s, "ToAddr: "+fmt.Sprintf("%#v", this.ToAddr)+",\n")
+	s = append(s, "SubjectPrefix: "+fmt.Sprintf("%#v", this.SubjectPrefix)+",\n")
+	s = append(s, "PendingUpdateValidity: "+strings.Replace(this.PendingUpdateValidity.GoString(), `&`, ``, 1)+",\n")
+	s = append(s, "MaxEmailSize: "+fmt.Sprintf("%#v", this.MaxEmailSize)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func NewPopulatedRegistrationPolicy(r randyKeyserverconfig, easy bool) *RegistrationPolicy {
-	this := &RegistrationPolicy{}
-	oneofNumber_PolicyType := []int32{1, 2, 3, 4, 5}[r.Intn(5)]
-	switch oneofNumber_PolicyType {
-	case 1:
-		this.PolicyType = NewPopulatedRegistrationPolicy_InsecureSkipEmailProof(r, easy)
-	case 2:
-		this.PolicyType = NewPopulatedRegistrationPolicy_EmailProofByDKIM(r, easy)
-	case 3:
-		this.PolicyType = NewPopulatedRegistrationPolicy_EmailProofByClientCert(r, easy)
-	case 4:
-		this.PolicyType = NewPopulatedRegistrationPolicy_EmailProofByOIDC(r, easy)
-	case 5:
-		this.PolicyType = NewPopulatedRegistrationPolicy_EmailProofBySAML(r, easy)
-	}
-	if !easy && r.Intn(10) != 0 {
+func (this *EmailProofByClientCert) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return this
-}
-
-func NewPopulatedRegistrationPolicy_InsecureSkipEmailProof(r randyKeyserverconfig, easy bool) *RegistrationPolicy_InsecureSkipEmailProof {
-	this := &RegistrationPolicy_InsecureSkipEmailProof{}
-	this.InsecureSkipEmailProof = bool(bool(r.Intn(2) == 0))
-	return this
-}
-func NewPopulatedRegistrationPolicy_EmailProofByDKIM(r randyKeyserverconfig, easy bool) *RegistrationPolicy_EmailProofByDKIM {
-	this := &RegistrationPolicy_EmailProofByDKIM{}
-	this.EmailProofByDKIM = NewPopulatedEmailProofByDKIM(r, easy)
-	return this
-}
-func NewPopulatedRegistrationPolicy_EmailProofByClientCert(r randyKeyserverconfig, easy bool) *RegistrationPolicy_EmailProofByClientCert {
-	this := &RegistrationPolicy_EmailProofByClientCert{}
-	this.EmailProofByClientCert = NewPopulatedEmailProofByClientCert(r, easy)
-	return this
-}
-func NewPopulatedRegistrationPolicy_EmailProofByOIDC(r randyKeyserverconfig, easy bool) *RegistrationPolicy_EmailProofByOIDC {
-	this := &RegistrationPolicy_EmailProofByOIDC{}
-	this.EmailProofByOIDC = NewPopulatedEmailProofByOIDC(r, easy)
-	return this
-}
-func NewPopulatedRegistrationPolicy_EmailProofBySAML(r randyKeyserverconfig, easy bool) *RegistrationPolicy_EmailProofBySAML {
-	this := &RegistrationPolicy_EmailProofBySAML{}
-	this.EmailProofBySAML = NewPopulatedEmailProofBySAML(r, easy)
-	return this
+	s := make([]string, 0, 6)
+	s = append(s, "&proto.EmailProofByClientCert{")
+	s = append(s, "AllowedDomains: "+fmt.Sprintf("%#v", this.AllowedDomains)+",\n")
+	s = append(s, "CaCert: "+fmt.Sprintf("%#v", this.CaCert)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func NewPopulatedEmailProofByDKIM(r randyKeyserverconfig, easy bool) *EmailProofByDKIM {
-	this := &EmailProofByDKIM{}
-	v14 := r.Intn(10)
-	this.AllowedDomains = make([]string, v14)
-	for i := 0; i < v14; i++ {
-		this.AllowedDomains[i] = randStringKeyserverconfig(r)
+func (this *EmailProofByOIDC) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	this.ToAddr = randStringKeyserverconfig(r)
-	this.SubjectPrefix = randStringKeyserverconfig(r)
-	if !easy && r.Intn(10) != 0 {
+	s := make([]string, 0, 5)
+	s = append(s, "&proto.EmailProofByOIDC{")
+	if this.OIDCConfig != nil {
+		s = append(s, "OIDCConfig: "+fmt.Sprintf("%#v", this.OIDCConfig)+",\n")
 	}
-	return this
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func NewPopulatedEmailProofByClientCert(r randyKeyserverconfig, easy bool) *EmailProofByClientCert {
-	this := &EmailProofByClientCert{}
-	v15 := r.Intn(10)
-	this.AllowedDomains = make([]string, v15)
-	for i := 0; i < v15; i++ {
-		this.AllowedDomains[i] = randStringKeyserverconfig(r)
-	}
-	v16 := r.Intn(100)
-	this.CaCert = make([]byte, v16)
-	for i := 0; i < v16; i++ {
-		this.CaCert[i] = byte(r.Intn(256))
+func (this *EmailProofBySAML) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if !easy && r.Intn(10) != 0 {
+	s := make([]string, 0, 11)
+	s = append(s, "&proto.EmailProofBySAML{")
+	s = append(s, "AllowedDomains: "+fmt.Sprintf("%#v", this.AllowedDomains)+",\n")
+	s = append(s, "IDPMetadataURL: "+fmt.Sprintf("%#v", this.IDPMetadataURL)+",\n")
+	if this.SAMLConfig != nil {
+		s = append(s, "SAMLConfig: "+fmt.Sprintf("%#v", this.SAMLConfig)+",\n")
 	}
-	return this
+	s = append(s, "MetadataRefreshInterval: "+strings.Replace(this.MetadataRefreshInterval.GoString(), `&`, ``, 1)+",\n")
+	s = append(s, "ConsumerServiceURL: "+fmt.Sprintf("%#v", this.ConsumerServiceURL)+",\n")
+	s = append(s, "ServiceProviderTLS: "+strings.Replace(this.ServiceProviderTLS.GoString(), `&`, ``, 1)+",\n")
+	s = append(s, "Validity: "+strings.Replace(this.Validity.GoString(), `&`, ``, 1)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func NewPopulatedEmailProofByOIDC(r randyKeyserverconfig, easy bool) *EmailProofByOIDC {
-	this := &EmailProofByOIDC{}
-	if r.Intn(10) != 0 {
-		v17 := r.Intn(5)
-		this.OIDCConfig = make([]*OIDCConfig, v17)
-		for i := 0; i < v17; i++ {
-			this.OIDCConfig[i] = NewPopulatedOIDCConfig(r, easy)
-		}
+func (this *EmailProofByChallenge) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if !easy && r.Intn(10) != 0 {
+	s := make([]string, 0, 9)
+	s = append(s, "&proto.EmailProofByChallenge{")
+	s = append(s, "AllowedDomains: "+fmt.Sprintf("%#v", this.AllowedDomains)+",\n")
+	s = append(s, "SMTPRelay: "+fmt.Sprintf("%#v", this.SMTPRelay)+",\n")
+	s = append(s, "FromAddr: "+fmt.Sprintf("%#v", this.FromAddr)+",\n")
+	s = append(s, "Subject: "+fmt.Sprintf("%#v", this.Subject)+",\n")
+	s = append(s, "Validity: "+strings.Replace(this.Validity.GoString(), `&`, ``, 1)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AccountRecoveryConfig) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return this
+	s := make([]string, 0, 8)
+	s = append(s, "&proto.AccountRecoveryConfig{")
+	s = append(s, "MinDelay: "+strings.Replace(this.MinDelay.GoString(), `&`, ``, 1)+",\n")
+	s = append(s, "SMTPRelay: "+fmt.Sprintf("%#v", this.SMTPRelay)+",\n")
+	s = append(s, "FromAddr: "+fmt.Sprintf("%#v", this.FromAddr)+",\n")
+	s = append(s, "Subject: "+fmt.Sprintf("%#v", this.Subject)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func NewPopulatedEmailProofBySAML(r randyKeyserverconfig, easy bool) *EmailProofBySAML {
-	this := &EmailProofBySAML{}
-	v18 := r.Intn(10)
-	this.AllowedDomains = make([]string, v18)
-	for i := 0; i < v18; i++ {
-		this.AllowedDomains[i] = randStringKeyserverconfig(r)
+func (this *RateLimit) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	this.IDPMetadataURL = randStringKeyserverconfig(r)
-	this.ConsumerServiceURL = randStringKeyserverconfig(r)
-	v19 := NewPopulatedTLSConfig(r, easy)
-	this.ServiceProviderTLS = *v19
-	v20 := NewPopulatedDuration(r, easy)
-	this.Validity = *v20
-	if !easy && r.Intn(10) != 0 {
+	s := make([]string, 0, 6)
+	s = append(s, "&proto.RateLimit{")
+	s = append(s, "Interval: "+strings.Replace(this.Interval.GoString(), `&`, ``, 1)+",\n")
+	s = append(s, "Burst: "+fmt.Sprintf("%#v", this.Burst)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SAMLConfig) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return this
+	s := make([]string, 0, 6)
+	s = append(s, "&proto.SAMLConfig{")
+	s = append(s, "AllowedDomains: "+fmt.Sprintf("%#v", this.AllowedDomains)+",\n")
+	s = append(s, "IDPMetadataURL: "+fmt.Sprintf("%#v", this.IDPMetadataURL)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func NewPopulatedOIDCConfig(r randyKeyserverconfig, easy bool) *OIDCConfig {
-	this := &OIDCConfig{}
-	v21 := r.Intn(10)
-	this.AllowedDomains = make([]string, v21)
-	for i := 0; i < v21; i++ {
-		this.AllowedDomains[i] = randStringKeyserverconfig(r)
+func (this *EmailProofByExternalVerifier) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	this.DiscoveryURL = randStringKeyserverconfig(r)
-	this.Issuer = randStringKeyserverconfig(r)
-	this.ClientID = randStringKeyserverconfig(r)
-	v22 := NewPopulatedDuration(r, easy)
-	this.Validity = *v22
-	this.Scope = randStringKeyserverconfig(r)
-	if !easy && r.Intn(10) != 0 {
+	s := make([]string, 0, 6)
+	s = append(s, "&proto.EmailProofByExternalVerifier{")
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	s = append(s, "AllowedDomains: "+fmt.Sprintf("%#v", this.AllowedDomains)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *OIDCConfig) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return this
+	s := make([]string, 0, 12)
+	s = append(s, "&proto.OIDCConfig{")
+	s = append(s, "AllowedDomains: "+fmt.Sprintf("%#v", this.AllowedDomains)+",\n")
+	s = append(s, "DiscoveryURL: "+fmt.Sprintf("%#v", this.DiscoveryURL)+",\n")
+	s = append(s, "Issuer: "+fmt.Sprintf("%#v", this.Issuer)+",\n")
+	s = append(s, "ClientID: "+fmt.Sprintf("%#v", this.ClientID)+",\n")
+	s = append(s, "Validity: "+strings.Replace(this.Validity.GoString(), `&`, ``, 1)+",\n")
+	s = append(s, "Scope: "+fmt.Sprintf("%#v", this.Scope)+",\n")
+	s = append(s, "KeyRefreshInterval: "+strings.Replace(this.KeyRefreshInterval.GoString(), `&`, ``, 1)+",\n")
+	s = append(s, "ClientSecret: "+fmt.Sprintf("%#v", this.ClientSecret)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func NewPopulatedReplica(r randyKeyserverconfig, easy bool) *Replica {
-	this := &Replica{}
-	this.ID = uint64(uint64(r.Uint32()))
-	if r.Intn(10) != 0 {
-		v23 := r.Intn(5)
-		this.PublicKeys = make([]*PublicKey, v23)
-		for i := 0; i < v23; i++ {
-			this.PublicKeys[i] = NewPopulatedPublicKey(r, easy)
-		}
+func (this *Replica) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	this.RaftAddr = randStringKeyserverconfig(r)
-	if !easy && r.Intn(10) != 0 {
+	s := make([]string, 0, 7)
+	s = append(s, "&proto.Replica{")
+	s = append(s, "ID: "+fmt.Sprintf("%#v", this.ID)+",\n")
+	if this.PublicKeys != nil {
+		s = append(s, "PublicKeys: "+fmt.Sprintf("%#v", this.PublicKeys)+",\n")
 	}
-	return this
-}
-
-type randyKeyserverconfig interface {
-	Float32() float32
-	Float64() float64
-	Int63() int64
-	Int31() int32
-	Uint32() uint32
-	Intn(n int) int
+	s = append(s, "RaftAddr: "+fmt.Sprintf("%#v", this.RaftAddr)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func randUTF8RuneKeyserverconfig(r randyKeyserverconfig) rune {
-	ru := r.Intn(62)
-	if ru < 10 {
-		return rune(ru + 48)
-	} else if ru < 36 {
-		return rune(ru + 55)
+func valueToGoStringKeyserverconfig(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
 	}
-	return rune(ru + 61)
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
 }
-func randStringKeyserverconfig(r randyKeyserverconfig) string {
-	v24 := r.Intn(100)
-	tmps := make([]rune, v24)
-	for i := 0; i < v24; i++ {
-		tmps[i] = randUTF8RuneKeyserverconfig(r)
+func extensionToGoStringKeyserverconfig(m github_com_maditya_protobuf_proto.Message) string {
+	e := github_com_maditya_protobuf_proto.GetUnsafeExtensionsMap(m)
+	if e == nil {
+		return "nil"
 	}
-	return string(tmps)
-}
-func randUnrecognizedKeyserverconfig(r randyKeyserverconfig, maxFieldNumber int) (data []byte) {
-	l := r.Intn(5)
-	for i := 0; i < l; i++ {
-		wire := r.Intn(4)
-		if wire == 3 {
-			wire = 5
-		}
-		fieldNumber := maxFieldNumber + r.Intn(100)
-		data = randFieldKeyserverconfig(data, r, fieldNumber, wire)
+	s := "proto.NewUnsafeXXX_InternalExtensions(map[int32]proto.Extension{"
+	keys := make([]int, 0, len(e))
+	for k := range e {
+		keys = append(keys, int(k))
 	}
-	return data
-}
-func randFieldKeyserverconfig(data []byte, r randyKeyserverconfig, fieldNumber int, wire int) []byte {
-	key := uint32(fieldNumber)<<3 | uint32(wire)
-	switch wire {
-	case 0:
-		data = encodeVarintPopulateKeyserverconfig(data, uint64(key))
-		v25 := r.Int63()
-		if r.Intn(2) == 0 {
-			v25 *= -1
-		}
-		data = encodeVarintPopulateKeyserverconfig(data, uint64(v25))
-	case 1:
-		data = encodeVarintPopulateKeyserverconfig(data, uint64(key))
-		data = append(data, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
-	case 2:
-		data = encodeVarintPopulateKeyserverconfig(data, uint64(key))
-		ll := r.Intn(100)
-		data = encodeVarintPopulateKeyserverconfig(data, uint64(ll))
-		for j := 0; j < ll; j++ {
-			data = append(data, byte(r.Intn(256)))
-		}
-	default:
-		data = encodeVarintPopulateKeyserverconfig(data, uint64(key))
-		data = append(data, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	sort.Ints(keys)
+	ss := []string{}
+	for _, k := range keys {
+		ss = append(ss, strconv.Itoa(k)+": "+e[int32(k)].GoString())
 	}
-	return data
+	s += strings.Join(ss, ",") + "})"
+	return s
 }
-func encodeVarintPopulateKeyserverconfig(data []byte, v uint64) []byte {
-	for v >= 1<<7 {
-		data = append(data, uint8(uint64(v)&0x7f|0x80))
-		v >>= 7
+func (m *ReplicaConfig) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
 	}
-	data = append(data, uint8(v))
-	return data
+	return data[:n], nil
 }
-func (m *ReplicaConfig) Size() (n int) {
+
+func (m *ReplicaConfig) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = m.KeyserverConfig.Size()
-	n += 1 + l + sovKeyserverconfig(uint64(l))
+	data[i] = 0xa
+	i++
+	i = encodeVarintKeyserverconfig(data, i, uint64(m.KeyserverConfig.Size()))
+	n1, err := m.KeyserverConfig.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n1
 	if m.ReplicaID != 0 {
-		n += 1 + sovKeyserverconfig(uint64(m.ReplicaID))
+		data[i] = 0x10
+		i++
+		i = encodeVarintKeyserverconfig(data, i, uint64(m.ReplicaID))
 	}
-	l = len(m.SigningKeyID)
-	if l > 0 {
-		n += 1 + l + sovKeyserverconfig(uint64(l))
+	if len(m.SigningKeyID) > 0 {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintKeyserverconfig(data, i, uint64(len(m.SigningKeyID)))
+		i += copy(data[i:], m.SigningKeyID)
 	}
-	l = len(m.PublicAddr)
-	if l > 0 {
-		n += 1 + l + sovKeyserverconfig(uint64(l))
+	if len(m.PublicAddr) > 0 {
+		data[i] = 0x22
+		i++
+		i = encodeVarintKeyserverconfig(data, i, uint64(len(m.PublicAddr)))
+		i += copy(data[i:], m.PublicAddr)
 	}
-	l = m.PublicTLS.Size()
-	n += 1 + l + sovKeyserverconfig(uint64(l))
-	l = len(m.VerifierAddr)
-	if l > 0 {
-		n += 1 + l + sovKeyserverconfig(uint64(l))
+	data[i] = 0x2a
+	i++
+	i = encodeVarintKeyserverconfig(data, i, uint64(m.PublicTLS.Size()))
+	n2, err := m.PublicTLS.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
 	}
-	l = m.VerifierTLS.Size()
-	n += 1 + l + sovKeyserverconfig(uint64(l))
-	l = len(m.HKPAddr)
-	if l > 0 {
-		n += 1 + l + sovKeyserverconfig(uint64(l))
+	i += n2
+	if len(m.VerifierAddr) > 0 {
+		data[i] = 0x32
+		i++
+		i = encodeVarintKeyserverconfig(data, i, uint64(len(m.VerifierAddr)))
+		i += copy(data[i:], m.VerifierAddr)
 	}
-	l = m.HKPTLS.Size()
-	n += 1 + l + sovKeyserverconfig(uint64(l))
-	l = len(m.HTTPFrontAddr)
-	if l > 0 {
-		n += 1 + l + sovKeyserverconfig(uint64(l))
+	data[i] = 0x3a
+	i++
+	i = encodeVarintKeyserverconfig(data, i, uint64(m.VerifierTLS.Size()))
+	n3, err := m.VerifierTLS.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
 	}
-	l = m.HTTPFrontTLS.Size()
-	n += 1 + l + sovKeyserverconfig(uint64(l))
-	l = len(m.RaftAddr)
-	if l > 0 {
-		n += 1 + l + sovKeyserverconfig(uint64(l))
+	i += n3
+	if len(m.HKPAddr) > 0 {
+		data[i] = 0x42
+		i++
+		i = encodeVarintKeyserverconfig(data, i, uint64(len(m.HKPAddr)))
+		i += copy(data[i:], m.HKPAddr)
 	}
-	l = m.RaftTLS.Size()
-	n += 1 + l + sovKeyserverconfig(uint64(l))
-	l = len(m.LevelDBPath)
-	if l > 0 {
-		n += 1 + l + sovKeyserverconfig(uint64(l))
+	data[i] = 0x4a
+	i++
+	i = encodeVarintKeyserverconfig(data, i, uint64(m.HKPTLS.Size()))
+	n4, err := m.HKPTLS.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
 	}
-	l = m.RaftHeartbeat.Size()
-	n += 1 + l + sovKeyserverconfig(uint64(l))
+	i += n4
+	if len(m.HTTPFrontAddr) > 0 {
+		data[i] = 0x52
+		i++
+		i = encodeVarintKeyserverconfig(data, i, uint64(len(m.HTTPFrontAddr)))
+		i += copy(data[i:], m.HTTPFrontAddr)
+	}
+	data[i] = 0x5a
+	i++
+	i = encodeVarintKeyserverconfig(data, i, uint64(m.HTTPFrontTLS.Size()))
+	n5, err := m.HTTPFrontTLS.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n5
+	if len(m.RaftAddr) > 0 {
+		data[i] = 0x62
+		i++
+		i = encodeVarintKeyserverconfig(data, i, uint64(len(m.RaftAddr)))
+		i += copy(data[i:], m.RaftAddr)
+	}
+	data[i] = 0x6a
+	i++
+	i = encodeVarintKeyserverconfig(data, i, uint64(m.RaftTLS.Size()))
+	n6, err := m.RaftTLS.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n6
+	if len(m.LevelDBPath) > 0 {
+		data[i] = 0x72
+		i++
+		i = encodeVarintKeyserverconfig(data, i, uint64(len(m.LevelDBPath)))
+		i += copy(data[i:], m.LevelDBPath)
+	}
+	data[i] = 0x7a
+	i++
+	i = encodeVarintKeyserverconfig(data, i, uint64(m.RaftHeartbeat.Size()))
+	n7, err := m.RaftHeartbeat.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n7
 	if m.LaggingVerifierScan != 0 {
-		n += 2 + sovKeyserverconfig(uint64(m.LaggingVerifierScan))
+		data[i] = 0x80
+		i++
+		data[i] = 0x1
+		i++
+		i = encodeVarintKeyserverconfig(data, i, uint64(m.LaggingVerifierScan))
 	}
-	l = m.ClientTimeout.Size()
-	n += 2 + l + sovKeyserverconfig(uint64(l))
-	return n
-}
-
-func (m *KeyserverConfig) Size() (n int) {
-	var l int
-	_ = l
-	if m.ServerID != 0 {
-		n += 1 + sovKeyserverconfig(uint64(m.ServerID))
+	data[i] = 0x8a
+	i++
+	data[i] = 0x1
+	i++
+	i = encodeVarintKeyserverconfig(data, i, uint64(m.ClientTimeout.Size()))
+	n8, err := m.ClientTimeout.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
 	}
-	l = len(m.Realm)
-	if l > 0 {
-		n += 1 + l + sovKeyserverconfig(uint64(l))
+	i += n8
+	if len(m.SMTPAddr) > 0 {
+		data[i] = 0x92
+		i++
+		data[i] = 0x1
+		i++
+		i = encodeVarintKeyserverconfig(data, i, uint64(len(m.SMTPAddr)))
+		i += copy(data[i:], m.SMTPAddr)
 	}
-	l = len(m.VRFKeyID)
-	if l > 0 {
-		n += 1 + l + sovKeyserverconfig(uint64(l))
+	if m.AccountRecovery != nil {
+		data[i] = 0x9a
+		i++
+		data[i] = 0x1
+		i++
+		i = encodeVarintKeyserverconfig(data, i, uint64(m.AccountRecovery.Size()))
+		n9, err := m.AccountRecovery.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n9
 	}
-	l = m.MinEpochInterval.Size()
-	n += 1 + l + sovKeyserverconfig(uint64(l))
-	l = m.MaxEpochInterval.Size()
-	n += 1 + l + sovKeyserverconfig(uint64(l))
-	l = m.ProposalRetryInterval.Size()
-	n += 1 + l + sovKeyserverconfig(uint64(l))
-	if len(m.InitialReplicas) > 0 {
-		for _, e := range m.InitialReplicas {
-			l = e.Size()
-			n += 1 + l + sovKeyserverconfig(uint64(l))
+	if m.ClientIPRateLimit != nil {
+		data[i] = 0xa2
+		i++
+		data[i] = 0x1
+		i++
+		i = encodeVarintKeyserverconfig(data, i, uint64(m.ClientIPRateLimit.Size()))
+		n10, err := m.ClientIPRateLimit.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n10
 	}
-	if len(m.RegistrationPolicy) > 0 {
-		for _, e := range m.RegistrationPolicy {
-			l = e.Size()
-			n += 1 + l + sovKeyserverconfig(uint64(l))
+	if m.UserIDRateLimit != nil {
+		data[i] = 0xaa
+		i++
+		data[i] = 0x1
+		i++
+		i = encodeVarintKeyserverconfig(data, i, uint64(m.UserIDRateLimit.Size()))
+		n11, err := m.UserIDRateLimit.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n11
 	}
-	return n
+	return i, nil
 }
 
-func (m *RegistrationPolicy) Size() (n int) {
-	var l int
-	_ = l
-	if m.PolicyType != nil {
-		n += m.PolicyType.Size()
+func (m *KeyserverConfig) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return data[:n], nil
 }
 
-func (m *RegistrationPolicy_InsecureSkipEmailProof) Size() (n int) {
-	var l int
-	_ = l
-	n += 2
-	return n
-}
-func (m *RegistrationPolicy_EmailProofByDKIM) Size() (n int) {
+func (m *KeyserverConfig) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.EmailProofByDKIM != nil {
-		l = m.EmailProofByDKIM.Size()
-		n += 1 + l + sovKeyserverconfig(uint64(l))
+	if m.ServerID != 0 {
+		data[i] = 0x8
+		i++
+		i = encodeVarintKeyserverconfig(data, i, uint64(m.ServerID))
 	}
-	return n
-}
-func (m *RegistrationPolicy_EmailProofByClientCert) Size() (n int) {
-	var l int
-	_ = l
-	if m.EmailProofByClientCert != nil {
-		l = m.EmailProofByClientCert.Size()
-		n += 1 + l + sovKeyserverconfig(uint64(l))
+	if len(m.Realm) > 0 {
+		data[i] = 0x12
+		i++
+		i = encodeVarintKeyserverconfig(data, i, uint64(len(m.Realm)))
+		i += copy(data[i:], m.Realm)
 	}
-	return n
-}
-func (m *RegistrationPolicy_EmailProofByOIDC) Size() (n int) {
-	var l int
-	_ = l
-	if m.EmailProofByOIDC != nil {
-		l = m.EmailProofByOIDC.Size()
-		n += 1 + l + sovKeyserverconfig(uint64(l))
-	}
-	return n
-}
-func (m *RegistrationPolicy_EmailProofBySAML) Size() (n int) {
-	var l int
-	_ = l
-	if m.EmailProofBySAML != nil {
-		l = m.EmailProofBySAML.Size()
-		n += 1 + l + sovKeyserverconfig(uint64(l))
+	if len(m.VRFKeyID) > 0 {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintKeyserverconfig(data, i, uint64(len(m.VRFKeyID)))
+		i += copy(data[i:], m.VRFKeyID)
 	}
-	return n
-}
-func (m *EmailProofByDKIM) Size() (n int) {
-	var l int
-	_ = l
-	if len(m.AllowedDomains) > 0 {
-		for _, s := range m.AllowedDomains {
-			l = len(s)
-			n += 1 + l + sovKeyserverconfig(uint64(l))
-		}
+	if len(m.NextVRFKeyID) > 0 {
+		data[i] = 0x72
+		i++
+		i = encodeVarintKeyserverconfig(data, i, uint64(len(m.NextVRFKeyID)))
+		i += copy(data[i:], m.NextVRFKeyID)
 	}
-	l = len(m.ToAddr)
-	if l > 0 {
-		n += 1 + l + sovKeyserverconfig(uint64(l))
+	if m.VRFSuite != 0 {
+		data[i] = 0x78
+		i++
+		i = encodeVarintKeyserverconfig(data, i, uint64(m.VRFSuite))
 	}
-	l = len(m.SubjectPrefix)
-	if l > 0 {
-		n += 1 + l + sovKeyserverconfig(uint64(l))
+	data[i] = 0x22
+	i++
+	i = encodeVarintKeyserverconfig(data, i, uint64(m.MinEpochInterval.Size()))
+	n12, err := m.MinEpochInterval.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
 	}
-	return n
-}
-
-func (m *EmailProofByClientCert) Size() (n int) {
-	var l int
-	_ = l
-	if len(m.AllowedDomains) > 0 {
-		for _, s := range m.AllowedDomains {
-			l = len(s)
-			n += 1 + l + sovKeyserverconfig(uint64(l))
-		}
+	i += n12
+	data[i] = 0x2a
+	i++
+	i = encodeVarintKeyserverconfig(data, i, uint64(m.MaxEpochInterval.Size()))
+	n13, err := m.MaxEpochInterval.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
 	}
-	l = len(m.CaCert)
-	if l > 0 {
-		n += 1 + l + sovKeyserverconfig(uint64(l))
+	i += n13
+	data[i] = 0x32
+	i++
+	i = encodeVarintKeyserverconfig(data, i, uint64(m.ProposalRetryInterval.Size()))
+	n14, err := m.ProposalRetryInterval.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
 	}
-	return n
-}
-
-func (m *EmailProofByOIDC) Size() (n int) {
-	var l int
-	_ = l
-	if len(m.OIDCConfig) > 0 {
-		for _, e := range m.OIDCConfig {
-			l = e.Size()
-			n += 1 + l + sovKeyserverconfig(uint64(l))
+	i += n14
+	if len(m.InitialReplicas) > 0 {
+		for _, msg := range m.InitialReplicas {
+			data[i] = 0x3a
+			i++
+			i = encodeVarintKeyserverconfig(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
-	return n
-}
-
-func (m *EmailProofBySAML) Size() (n int) {
-	var l int
-	_ = l
-	if len(m.AllowedDomains) > 0 {
-		for _, s := range m.AllowedDomains {
-			l = len(s)
-			n += 1 + l + sovKeyserverconfig(uint64(l))
+	if len(m.RegistrationPolicy) > 0 {
+		for _, msg := range m.RegistrationPolicy {
+			data[i] = 0x42
+			i++
+			i = encodeVarintKeyserverconfig(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
-	l = len(m.IDPMetadataURL)
-	if l > 0 {
-		n += 1 + l + sovKeyserverconfig(uint64(l))
-	}
-	l = len(m.ConsumerServiceURL)
-	if l > 0 {
-		n += 1 + l + sovKeyserverconfig(uint64(l))
+	if m.RefreshIdleEpochs {
+		data[i] = 0x48
+		i++
+		if m.RefreshIdleEpochs {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
 	}
-	l = m.ServiceProviderTLS.Size()
-	n += 1 + l + sovKeyserverconfig(uint64(l))
-	l = m.Validity.Size()
-	n += 1 + l + sovKeyserverconfig(uint64(l))
-	return n
-}
-
-func (m *OIDCConfig) Size() (n int) {
-	var l int
-	_ = l
-	if len(m.AllowedDomains) > 0 {
-		for _, s := range m.AllowedDomains {
-			l = len(s)
-			n += 1 + l + sovKeyserverconfig(uint64(l))
+	if m.DeletionPolicy != nil {
+		data[i] = 0x52
+		i++
+		i = encodeVarintKeyserverconfig(data, i, uint64(m.DeletionPolicy.Size()))
+		n15, err := m.DeletionPolicy.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n15
 	}
-	l = len(m.DiscoveryURL)
-	if l > 0 {
-		n += 1 + l + sovKeyserverconfig(uint64(l))
+	if len(m.DomainAdminPolicies) > 0 {
+		for _, msg := range m.DomainAdminPolicies {
+			data[i] = 0x5a
+			i++
+			i = encodeVarintKeyserverconfig(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	l = len(m.Issuer)
-	if l > 0 {
-		n += 1 + l + sovKeyserverconfig(uint64(l))
+	if m.RegistrationsPerDomainPerEpoch != 0 {
+		data[i] = 0x60
+		i++
+		i = encodeVarintKeyserverconfig(data, i, uint64(m.RegistrationsPerDomainPerEpoch))
 	}
-	l = len(m.ClientID)
-	if l > 0 {
-		n += 1 + l + sovKeyserverconfig(uint64(l))
+	if len(m.VerifierPublicKeys) > 0 {
+		for _, msg := range m.VerifierPublicKeys {
+			data[i] = 0x6a
+			i++
+			i = encodeVarintKeyserverconfig(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	l = m.Validity.Size()
-	n += 1 + l + sovKeyserverconfig(uint64(l))
-	l = len(m.Scope)
-	if l > 0 {
-		n += 1 + l + sovKeyserverconfig(uint64(l))
+	return i, nil
+}
+
+func (m *RegistrationPolicy) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return data[:n], nil
 }
 
-func (m *Replica) Size() (n int) {
+func (m *RegistrationPolicy) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.ID != 0 {
-		n += 1 + sovKeyserverconfig(uint64(m.ID))
-	}
-	if len(m.PublicKeys) > 0 {
-		for _, e := range m.PublicKeys {
-			l = e.Size()
-			n += 1 + l + sovKeyserverconfig(uint64(l))
+	if m.PolicyType != nil {
+		nn16, err := m.PolicyType.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += nn16
 	}
-	l = len(m.RaftAddr)
-	if l > 0 {
-		n += 1 + l + sovKeyserverconfig(uint64(l))
-	}
-	return n
+	return i, nil
 }
 
-func sovKeyserverconfig(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
+func (m *RegistrationPolicy_InsecureSkipEmailProof) MarshalTo(data []byte) (int, error) {
+	i := 0
+	data[i] = 0x8
+	i++
+	if m.InsecureSkipEmailProof {
+		data[i] = 1
+	} else {
+		data[i] = 0
+	}
+	i++
+	return i, nil
+}
+func (m *RegistrationPolicy_EmailProofByDKIM) MarshalTo(data []byte) (int, error) {
+	i := 0
+	if m.EmailProofByDKIM != nil {
+		data[i] = 0x12
+		i++
+		i = encodeVarintKeyserverconfig(data, i, uint64(m.EmailProofByDKIM.Size()))
+		n17, err := m.EmailProofByDKIM.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n17
 	}
-	return n
+	return i, nil
 }
-func sozKeyserverconfig(x uint64) (n int) {
-	return sovKeyserverconfig(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *RegistrationPolicy_EmailProofByClientCert) MarshalTo(data []byte) (int, error) {
+	i := 0
+	if m.EmailProofByClientCert != nil {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintKeyserverconfig(data, i, uint64(m.EmailProofByClientCert.Size()))
+		n18, err := m.EmailProofByClientCert.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n18
+	}
+	return i, nil
 }
-func (this *ReplicaConfig) String() string {
-	if this == nil {
-		return "nil"
+func (m *RegistrationPolicy_EmailProofByOIDC) MarshalTo(data []byte) (int, error) {
+	i := 0
+	if m.EmailProofByOIDC != nil {
+		data[i] = 0x22
+		i++
+		i = encodeVarintKeyserverconfig(data, i, uint64(m.EmailProofByOIDC.Size()))
+		n19, err := m.EmailProofByOIDC.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n19
 	}
-	s := strings.Join([]string{`&ReplicaConfig{`,
-		`KeyserverConfig:` + strings.Replace(strings.Replace(this.KeyserverConfig.String(), "KeyserverConfig", "KeyserverConfig", 1), `&`, ``, 1) + `,`,
-		`ReplicaID:` + fmt.Sprintf("%v", this.ReplicaID) + `,`,
-		`SigningKeyID:` + fmt.Sprintf("%v", this.SigningKeyID) + `,`,
-		`PublicAddr:` + fmt.Sprintf("%v", this.PublicAddr) + `,`,
-		`PublicTLS:` + strings.Replace(strings.Replace(this.PublicTLS.String(), "TLSConfig", "TLSConfig", 1), `&`, ``, 1) + `,`,
-		`VerifierAddr:` + fmt.Sprintf("%v", this.VerifierAddr) + `,`,
-		`VerifierTLS:` + strings.Replace(strings.Replace(this.VerifierTLS.String(), "TLSConfig", "TLSConfig", 1), `&`, ``, 1) + `,`,
-		`HKPAddr:` + fmt.Sprintf("%v", this.HKPAddr) + `,`,
-		`HKPTLS:` + strings.Replace(strings.Replace(this.HKPTLS.String(), "TLSConfig", "TLSConfig", 1), `&`, ``, 1) + `,`,
-		`HTTPFrontAddr:` + fmt.Sprintf("%v", this.HTTPFrontAddr) + `,`,
-		`HTTPFrontTLS:` + strings.Replace(strings.Replace(this.HTTPFrontTLS.String(), "TLSConfig", "TLSConfig", 1), `&`, ``, 1) + `,`,
-		`RaftAddr:` + fmt.Sprintf("%v", this.RaftAddr) + `,`,
-		`RaftTLS:` + strings.Replace(strings.Replace(this.RaftTLS.String(), "TLSConfig", "TLSConfig", 1), `&`, ``, 1) + `,`,
-		`LevelDBPath:` + fmt.Sprintf("%v", this.LevelDBPath) + `,`,
-		`RaftHeartbeat:` + strings.Replace(strings.Replace(this.RaftHeartbeat.String(), "Duration", "Duration", 1), `&`, ``, 1) + `,`,
-		`LaggingVerifierScan:` + fmt.Sprintf("%v", this.LaggingVerifierScan) + `,`,
-		`ClientTimeout:` + strings.Replace(strings.Replace(this.ClientTimeout.String(), "Duration", "Duration", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return i, nil
 }
-func (this *KeyserverConfig) String() string {
-	if this == nil {
-		return "nil"
+func (m *RegistrationPolicy_EmailProofBySAML) MarshalTo(data []byte) (int, error) {
+	i := 0
+	if m.EmailProofBySAML != nil {
+		data[i] = 0x2a
+		i++
+		i = encodeVarintKeyserverconfig(data, i, uint64(m.EmailProofBySAML.Size()))
+		n20, err := m.EmailProofBySAML.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n20
 	}
-	s := strings.Join([]string{`&KeyserverConfig{`,
-		`ServerID:` + fmt.Sprintf("%v", this.ServerID) + `,`,
-		`Realm:` + fmt.Sprintf("%v", this.Realm) + `,`,
-		`VRFKeyID:` + fmt.Sprintf("%v", this.VRFKeyID) + `,`,
-		`MinEpochInterval:` + strings.Replace(strings.Replace(this.MinEpochInterval.String(), "Duration", "Duration", 1), `&`, ``, 1) + `,`,
-		`MaxEpochInterval:` + strings.Replace(strings.Replace(this.MaxEpochInterval.String(), "Duration", "Duration", 1), `&`, ``, 1) + `,`,
-		`ProposalRetryInterval:` + strings.Replace(strings.Replace(this.ProposalRetryInterval.String(), "Duration", "Duration", 1), `&`, ``, 1) + `,`,
-		`InitialReplicas:` + strings.Replace(fmt.Sprintf("%v", this.InitialReplicas), "Replica", "Replica", 1) + `,`,
-		`RegistrationPolicy:` + strings.Replace(fmt.Sprintf("%v", this.RegistrationPolicy), "RegistrationPolicy", "RegistrationPolicy", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return i, nil
 }
-func (this *RegistrationPolicy) String() string {
-	if this == nil {
-		return "nil"
+func (m *RegistrationPolicy_EmailProofByChallenge) MarshalTo(data []byte) (int, error) {
+	i := 0
+	if m.EmailProofByChallenge != nil {
+		data[i] = 0x32
+		i++
+		i = encodeVarintKeyserverconfig(data, i, uint64(m.EmailProofByChallenge.Size()))
+		n21, err := m.EmailProofByChallenge.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n21
 	}
-	s := strings.Join([]string{`&RegistrationPolicy{`,
-		`PolicyType:` + fmt.Sprintf("%v", this.PolicyType) + `,`,
-		`}`,
-	}, "")
-	return s
+	return i, nil
 }
-func (this *RegistrationPolicy_InsecureSkipEmailProof) String() string {
-	if this == nil {
-		return "nil"
+func (m *RegistrationPolicy_EmailProofByExternalVerifier) MarshalTo(data []byte) (int, error) {
+	i := 0
+	if m.EmailProofByExternalVerifier != nil {
+		data[i] = 0x3a
+		i++
+		i = encodeVarintKeyserverconfig(data, i, uint64(m.EmailProofByExternalVerifier.Size()))
+		n22, err := m.EmailProofByExternalVerifier.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n22
 	}
-	s := strings.Join([]string{`&RegistrationPolicy_InsecureSkipEmailProof{`,
-		`InsecureSkipEmailProof:` + fmt.Sprintf("%v", this.InsecureSkipEmailProof) + `,`,
-		`}`,
-	}, "")
-	return s
+	return i, nil
 }
-func (this *RegistrationPolicy_EmailProofByDKIM) String() string {
-	if this == nil {
-		return "nil"
+func (m *EmailProofByDKIM) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&RegistrationPolicy_EmailProofByDKIM{`,
-		`EmailProofByDKIM:` + strings.Replace(fmt.Sprintf("%v", this.EmailProofByDKIM), "EmailProofByDKIM", "EmailProofByDKIM", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return data[:n], nil
 }
-func (this *RegistrationPolicy_EmailProofByClientCert) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *EmailProofByDKIM) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedDomains) > 0 {
+		for _, s := range m.AllowedDomains {
+			data[i] = 0xa
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				data[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			data[i] = uint8(l)
+			i++
+			i += copy(data[i:], s)
+		}
 	}
-	s := strings.Join([]string{`&RegistrationPolicy_EmailProofByClientCert{`,
-		`EmailProofByClientCert:` + strings.Replace(fmt.Sprintf("%v", this.EmailProofByClientCert), "EmailProofByClientCert", "EmailProofByClientCert", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *RegistrationPolicy_EmailProofByOIDC) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.ToAddr) > 0 {
+		data[i] = 0x12
+		i++
+		i = encodeVarintKeyserverconfig(data, i, uint64(len(m.ToAddr)))
+		i += copy(data[i:], m.ToAddr)
 	}
-	s := strings.Join([]string{`&RegistrationPolicy_EmailProofByOIDC{`,
-		`EmailProofByOIDC:` + strings.Replace(fmt.Sprintf("%v", this.EmailProofByOIDC), "EmailProofByOIDC", "EmailProofByOIDC", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *RegistrationPolicy_EmailProofBySAML) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.SubjectPrefix) > 0 {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintKeyserverconfig(data, i, uint64(len(m.SubjectPrefix)))
+		i += copy(data[i:], m.SubjectPrefix)
 	}
-	s := strings.Join([]string{`&RegistrationPolicy_EmailProofBySAML{`,
-		`EmailProofBySAML:` + strings.Replace(fmt.Sprintf("%v", this.EmailProofBySAML), "EmailProofBySAML", "EmailProofBySAML", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *EmailProofByDKIM) String() string {
-	if this == nil {
-		return "nil"
+	data[i] = 0x22
+	i++
+	i = encodeVarintKeyserverconfig(data, i, uint64(m.PendingUpdateValidity.Size()))
+	n23, err := m.PendingUpdateValidity.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
 	}
-	s := strings.Join([]string{`&EmailProofByDKIM{`,
-		`AllowedDomains:` + fmt.Sprintf("%v", this.AllowedDomains) + `,`,
-		`ToAddr:` + fmt.Sprintf("%v", this.ToAddr) + `,`,
-		`SubjectPrefix:` + fmt.Sprintf("%v", this.SubjectPrefix) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *EmailProofByClientCert) String() string {
-	if this == nil {
-		return "nil"
+	i += n23
+	if m.MaxEmailSize != 0 {
+		data[i] = 0x28
+		i++
+		i = encodeVarintKeyserverconfig(data, i, uint64(m.MaxEmailSize))
 	}
-	s := strings.Join([]string{`&EmailProofByClientCert{`,
-		`AllowedDomains:` + fmt.Sprintf("%v", this.AllowedDomains) + `,`,
-		`CaCert:` + fmt.Sprintf("%v", this.CaCert) + `,`,
-		`}`,
-	}, "")
-	return s
+	return i, nil
 }
-func (this *EmailProofByOIDC) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *EmailProofByClientCert) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&EmailProofByOIDC{`,
-		`OIDCConfig:` + strings.Replace(fmt.Sprintf("%v", this.OIDCConfig), "OIDCConfig", "OIDCConfig", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return data[:n], nil
 }
-func (this *EmailProofBySAML) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *EmailProofByClientCert) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedDomains) > 0 {
+		for _, s := range m.AllowedDomains {
+			data[i] = 0xa
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				data[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			data[i] = uint8(l)
+			i++
+			i += copy(data[i:], s)
+		}
 	}
-	s := strings.Join([]string{`&EmailProofBySAML{`,
-		`AllowedDomains:` + fmt.Sprintf("%v", this.AllowedDomains) + `,`,
-		`IDPMetadataURL:` + fmt.Sprintf("%v", this.IDPMetadataURL) + `,`,
-		`ConsumerServiceURL:` + fmt.Sprintf("%v", this.ConsumerServiceURL) + `,`,
-		`ServiceProviderTLS:` + strings.Replace(strings.Replace(this.ServiceProviderTLS.String(), "TLSConfig", "TLSConfig", 1), `&`, ``, 1) + `,`,
-		`Validity:` + strings.Replace(strings.Replace(this.Validity.String(), "Duration", "Duration", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *OIDCConfig) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.CaCert) > 0 {
+		data[i] = 0x12
+		i++
+		i = encodeVarintKeyserverconfig(data, i, uint64(len(m.CaCert)))
+		i += copy(data[i:], m.CaCert)
 	}
-	s := strings.Join([]string{`&OIDCConfig{`,
-		`AllowedDomains:` + fmt.Sprintf("%v", this.AllowedDomains) + `,`,
-		`DiscoveryURL:` + fmt.Sprintf("%v", this.DiscoveryURL) + `,`,
-		`Issuer:` + fmt.Sprintf("%v", this.Issuer) + `,`,
-		`ClientID:` + fmt.Sprintf("%v", this.ClientID) + `,`,
-		`Validity:` + strings.Replace(strings.Replace(this.Validity.String(), "Duration", "Duration", 1), `&`, ``, 1) + `,`,
-		`Scope:` + fmt.Sprintf("%v", this.Scope) + `,`,
-		`}`,
-	}, "")
-	return s
+	return i, nil
 }
-func (this *Replica) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *EmailProofByOIDC) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&Replica{`,
-		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
-		`PublicKeys:` + strings.Replace(fmt.Sprintf("%v", this.PublicKeys), "PublicKey", "PublicKey", 1) + `,`,
-		`RaftAddr:` + fmt.Sprintf("%v", this.RaftAddr) + `,`,
-		`}`,
-	}, "")
-	return s
+	return data[:n], nil
 }
-func valueToStringKeyserverconfig(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+
+func (m *EmailProofByOIDC) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.OIDCConfig) > 0 {
+		for _, msg := range m.OIDCConfig {
+			data[i] = 0xa
+			i++
+			i = encodeVarintKeyserverconfig(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *EmailProofBySAML) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *EmailProofBySAML) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedDomains) > 0 {
+		for _, s := range m.AllowedDomains {
+			data[i] = 0xa
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				data[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			data[i] = uint8(l)
+			i++
+			i += copy(data[i:], s)
+		}
+	}
+	if len(m.IDPMetadataURL) > 0 {
+		data[i] = 0x12
+		i++
+		i = encodeVarintKeyserverconfig(data, i, uint64(len(m.IDPMetadataURL)))
+		i += copy(data[i:], m.IDPMetadataURL)
+	}
+	if len(m.SAMLConfig) > 0 {
+		for _, msg := range m.SAMLConfig {
+			data[i] = 0x3a
+			i++
+			i = encodeVarintKeyserverconfig(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	data[i] = 0x42
+	i++
+	i = encodeVarintKeyserverconfig(data, i, uint64(m.MetadataRefreshInterval.Size()))
+	n24, err := m.MetadataRefreshInterval.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n24
+	if len(m.ConsumerServiceURL) > 0 {
+		data[i] = 0x22
+		i++
+		i = encodeVarintKeyserverconfig(data, i, uint64(len(m.ConsumerServiceURL)))
+		i += copy(data[i:], m.ConsumerServiceURL)
+	}
+	data[i] = 0x2a
+	i++
+	i = encodeVarintKeyserverconfig(data, i, uint64(m.ServiceProviderTLS.Size()))
+	n25, err := m.ServiceProviderTLS.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n25
+	data[i] = 0x32
+	i++
+	i = encodeVarintKeyserverconfig(data, i, uint64(m.Validity.Size()))
+	n26, err := m.Validity.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n26
+	return i, nil
+}
+
+func (m *EmailProofByChallenge) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *EmailProofByChallenge) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedDomains) > 0 {
+		for _, s := range m.AllowedDomains {
+			data[i] = 0xa
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				data[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			data[i] = uint8(l)
+			i++
+			i += copy(data[i:], s)
+		}
+	}
+	if len(m.SMTPRelay) > 0 {
+		data[i] = 0x12
+		i++
+		i = encodeVarintKeyserverconfig(data, i, uint64(len(m.SMTPRelay)))
+		i += copy(data[i:], m.SMTPRelay)
+	}
+	if len(m.FromAddr) > 0 {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintKeyserverconfig(data, i, uint64(len(m.FromAddr)))
+		i += copy(data[i:], m.FromAddr)
+	}
+	if len(m.Subject) > 0 {
+		data[i] = 0x22
+		i++
+		i = encodeVarintKeyserverconfig(data, i, uint64(len(m.Subject)))
+		i += copy(data[i:], m.Subject)
+	}
+	data[i] = 0x2a
+	i++
+	i = encodeVarintKeyserverconfig(data, i, uint64(m.Validity.Size()))
+	n27, err := m.Validity.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n27
+	return i, nil
+}
+
+func (m *AccountRecoveryConfig) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *AccountRecoveryConfig) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintKeyserverconfig(data, i, uint64(m.MinDelay.Size()))
+	n28, err := m.MinDelay.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n28
+	if len(m.SMTPRelay) > 0 {
+		data[i] = 0x12
+		i++
+		i = encodeVarintKeyserverconfig(data, i, uint64(len(m.SMTPRelay)))
+		i += copy(data[i:], m.SMTPRelay)
+	}
+	if len(m.FromAddr) > 0 {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintKeyserverconfig(data, i, uint64(len(m.FromAddr)))
+		i += copy(data[i:], m.FromAddr)
+	}
+	if len(m.Subject) > 0 {
+		data[i] = 0x22
+		i++
+		i = encodeVarintKeyserverconfig(data, i, uint64(len(m.Subject)))
+		i += copy(data[i:], m.Subject)
+	}
+	return i, nil
+}
+
+func (m *RateLimit) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *RateLimit) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintKeyserverconfig(data, i, uint64(m.Interval.Size()))
+	n29, err := m.Interval.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n29
+	if m.Burst != 0 {
+		data[i] = 0x10
+		i++
+		i = encodeVarintKeyserverconfig(data, i, uint64(m.Burst))
+	}
+	return i, nil
+}
+
+func (m *SAMLConfig) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *SAMLConfig) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedDomains) > 0 {
+		for _, s := range m.AllowedDomains {
+			data[i] = 0xa
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				data[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			data[i] = uint8(l)
+			i++
+			i += copy(data[i:], s)
+		}
+	}
+	if len(m.IDPMetadataURL) > 0 {
+		data[i] = 0x12
+		i++
+		i = encodeVarintKeyserverconfig(data, i, uint64(len(m.IDPMetadataURL)))
+		i += copy(data[i:], m.IDPMetadataURL)
+	}
+	return i, nil
+}
+
+func (m *EmailProofByExternalVerifier) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *EmailProofByExternalVerifier) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Type) > 0 {
+		data[i] = 0xa
+		i++
+		i = encodeVarintKeyserverconfig(data, i, uint64(len(m.Type)))
+		i += copy(data[i:], m.Type)
+	}
+	if len(m.AllowedDomains) > 0 {
+		for _, s := range m.AllowedDomains {
+			data[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				data[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			data[i] = uint8(l)
+			i++
+			i += copy(data[i:], s)
+		}
+	}
+	return i, nil
+}
+
+func (m *OIDCConfig) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *OIDCConfig) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedDomains) > 0 {
+		for _, s := range m.AllowedDomains {
+			data[i] = 0xa
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				data[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			data[i] = uint8(l)
+			i++
+			i += copy(data[i:], s)
+		}
+	}
+	if len(m.DiscoveryURL) > 0 {
+		data[i] = 0x12
+		i++
+		i = encodeVarintKeyserverconfig(data, i, uint64(len(m.DiscoveryURL)))
+		i += copy(data[i:], m.DiscoveryURL)
+	}
+	if len(m.Issuer) > 0 {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintKeyserverconfig(data, i, uint64(len(m.Issuer)))
+		i += copy(data[i:], m.Issuer)
+	}
+	if len(m.ClientID) > 0 {
+		data[i] = 0x22
+		i++
+		i = encodeVarintKeyserverconfig(data, i, uint64(len(m.ClientID)))
+		i += copy(data[i:], m.ClientID)
+	}
+	data[i] = 0x2a
+	i++
+	i = encodeVarintKeyserverconfig(data, i, uint64(m.Validity.Size()))
+	n30, err := m.Validity.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n30
+	if len(m.Scope) > 0 {
+		data[i] = 0x32
+		i++
+		i = encodeVarintKeyserverconfig(data, i, uint64(len(m.Scope)))
+		i += copy(data[i:], m.Scope)
+	}
+	data[i] = 0x3a
+	i++
+	i = encodeVarintKeyserverconfig(data, i, uint64(m.KeyRefreshInterval.Size()))
+	n31, err := m.KeyRefreshInterval.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n31
+	if len(m.ClientSecret) > 0 {
+		data[i] = 0x42
+		i++
+		i = encodeVarintKeyserverconfig(data, i, uint64(len(m.ClientSecret)))
+		i += copy(data[i:], m.ClientSecret)
+	}
+	return i, nil
+}
+
+func (m *Replica) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *Replica) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.ID != 0 {
+		data[i] = 0x8
+		i++
+		i = encodeVarintKeyserverconfig(data, i, uint64(m.ID))
+	}
+	if len(m.PublicKeys) > 0 {
+		for _, msg := range m.PublicKeys {
+			data[i] = 0x12
+			i++
+			i = encodeVarintKeyserverconfig(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.RaftAddr) > 0 {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintKeyserverconfig(data, i, uint64(len(m.RaftAddr)))
+		i += copy(data[i:], m.RaftAddr)
+	}
+	return i, nil
+}
+
+func encodeFixed64Keyserverconfig(data []byte, offset int, v uint64) int {
+	data[offset] = uint8(v)
+	data[offset+1] = uint8(v >> 8)
+	data[offset+2] = uint8(v >> 16)
+	data[offset+3] = uint8(v >> 24)
+	data[offset+4] = uint8(v >> 32)
+	data[offset+5] = uint8(v >> 40)
+	data[offset+6] = uint8(v >> 48)
+	data[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32Keyserverconfig(data []byte, offset int, v uint32) int {
+	data[offset] = uint8(v)
+	data[offset+1] = uint8(v >> 8)
+	data[offset+2] = uint8(v >> 16)
+	data[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintKeyserverconfig(data []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		data[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	data[offset] = uint8(v)
+	return offset + 1
+}
+func NewPopulatedReplicaConfig(r randyKeyserverconfig, easy bool) *ReplicaConfig {
+	this := &ReplicaConfig{}
+	v1 := NewPopulatedKeyserverConfig(r, easy)
+	this.KeyserverConfig = *v1
+	this.ReplicaID = uint64(uint64(r.Uint32()))
+	this.SigningKeyID = randStringKeyserverconfig(r)
+	this.PublicAddr = randStringKeyserverconfig(r)
+	v2 := NewPopulatedTLSConfig(r, easy)
+	this.PublicTLS = *v2
+	this.VerifierAddr = randStringKeyserverconfig(r)
+	v3 := NewPopulatedTLSConfig(r, easy)
+	this.VerifierTLS = *v3
+	this.HKPAddr = randStringKeyserverconfig(r)
+	v4 := NewPopulatedTLSConfig(r, easy)
+	this.HKPTLS = *v4
+	this.HTTPFrontAddr = randStringKeyserverconfig(r)
+	v5 := NewPopulatedTLSConfig(r, easy)
+	this.HTTPFrontTLS = *v5
+	this.RaftAddr = randStringKeyserverconfig(r)
+	v6 := NewPopulatedTLSConfig(r, easy)
+	this.RaftTLS = *v6
+	this.LevelDBPath = randStringKeyserverconfig(r)
+	v7 := NewPopulatedDuration(r, easy)
+	this.RaftHeartbeat = *v7
+	this.LaggingVerifierScan = uint64(uint64(r.Uint32()))
+	v8 := NewPopulatedDuration(r, easy)
+	this.ClientTimeout = *v8
+	this.SMTPAddr = randStringKeyserverconfig(r)
+	if r.Intn(10) != 0 {
+		this.AccountRecovery = NewPopulatedAccountRecoveryConfig(r, easy)
+	}
+	if r.Intn(10) != 0 {
+		this.ClientIPRateLimit = NewPopulatedRateLimit(r, easy)
+	}
+	if r.Intn(10) != 0 {
+		this.UserIDRateLimit = NewPopulatedRateLimit(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedKeyserverConfig(r randyKeyserverconfig, easy bool) *KeyserverConfig {
+	this := &KeyserverConfig{}
+	this.ServerID = uint64(uint64(r.Uint32()))
+	this.Realm = randStringKeyserverconfig(r)
+	this.VRFKeyID = randStringKeyserverconfig(r)
+	this.NextVRFKeyID = randStringKeyserverconfig(r)
+	this.VRFSuite = VRFSuite([]int32{0, 1}[r.Intn(2)])
+	v9 := NewPopulatedDuration(r, easy)
+	this.MinEpochInterval = *v9
+	v10 := NewPopulatedDuration(r, easy)
+	this.MaxEpochInterval = *v10
+	v11 := NewPopulatedDuration(r, easy)
+	this.ProposalRetryInterval = *v11
+	if r.Intn(10) != 0 {
+		v12 := r.Intn(5)
+		this.InitialReplicas = make([]*Replica, v12)
+		for i := 0; i < v12; i++ {
+			this.InitialReplicas[i] = NewPopulatedReplica(r, easy)
+		}
+	}
+	if r.Intn(10) != 0 {
+		v13 := r.Intn(5)
+		this.RegistrationPolicy = make([]*RegistrationPolicy, v13)
+		for i := 0; i < v13; i++ {
+			this.RegistrationPolicy[i] = NewPopulatedRegistrationPolicy(r, easy)
+		}
+	}
+	this.RefreshIdleEpochs = bool(bool(r.Intn(2) == 0))
+	if r.Intn(10) == 0 {
+		this.DeletionPolicy = NewPopulatedAuthorizationPolicy(r, easy)
+	}
+	if r.Intn(10) == 0 {
+		v14 := r.Intn(5)
+		this.DomainAdminPolicies = make([]*DomainAdminPolicy, v14)
+		for i := 0; i < v14; i++ {
+			this.DomainAdminPolicies[i] = NewPopulatedDomainAdminPolicy(r, easy)
+		}
+	}
+	this.RegistrationsPerDomainPerEpoch = uint64(uint64(r.Uint32()))
+	if r.Intn(10) != 0 {
+		v15 := r.Intn(5)
+		this.VerifierPublicKeys = make([]*PublicKey, v15)
+		for i := 0; i < v15; i++ {
+			this.VerifierPublicKeys[i] = NewPopulatedPublicKey(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedRegistrationPolicy(r randyKeyserverconfig, easy bool) *RegistrationPolicy {
+	this := &RegistrationPolicy{}
+	oneofNumber_PolicyType := []int32{1, 2, 3, 4, 5, 6, 7}[r.Intn(7)]
+	switch oneofNumber_PolicyType {
+	case 1:
+		this.PolicyType = NewPopulatedRegistrationPolicy_InsecureSkipEmailProof(r, easy)
+	case 2:
+		this.PolicyType = NewPopulatedRegistrationPolicy_EmailProofByDKIM(r, easy)
+	case 3:
+		this.PolicyType = NewPopulatedRegistrationPolicy_EmailProofByClientCert(r, easy)
+	case 4:
+		this.PolicyType = NewPopulatedRegistrationPolicy_EmailProofByOIDC(r, easy)
+	case 5:
+		this.PolicyType = NewPopulatedRegistrationPolicy_EmailProofBySAML(r, easy)
+	case 6:
+		this.PolicyType = NewPopulatedRegistrationPolicy_EmailProofByChallenge(r, easy)
+	case 7:
+		this.PolicyType = NewPopulatedRegistrationPolicy_EmailProofByExternalVerifier(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedRegistrationPolicy_InsecureSkipEmailProof(r randyKeyserverconfig, easy bool) *RegistrationPolicy_InsecureSkipEmailProof {
+	this := &RegistrationPolicy_InsecureSkipEmailProof{}
+	this.InsecureSkipEmailProof = bool(bool(r.Intn(2) == 0))
+	return this
+}
+func NewPopulatedRegistrationPolicy_EmailProofByDKIM(r randyKeyserverconfig, easy bool) *RegistrationPolicy_EmailProofByDKIM {
+	this := &RegistrationPolicy_EmailProofByDKIM{}
+	this.EmailProofByDKIM = NewPopulatedEmailProofByDKIM(r, easy)
+	return this
+}
+func NewPopulatedRegistrationPolicy_EmailProofByClientCert(r randyKeyserverconfig, easy bool) *RegistrationPolicy_EmailProofByClientCert {
+	this := &RegistrationPolicy_EmailProofByClientCert{}
+	this.EmailProofByClientCert = NewPopulatedEmailProofByClientCert(r, easy)
+	return this
+}
+func NewPopulatedRegistrationPolicy_EmailProofByOIDC(r randyKeyserverconfig, easy bool) *RegistrationPolicy_EmailProofByOIDC {
+	this := &RegistrationPolicy_EmailProofByOIDC{}
+	this.EmailProofByOIDC = NewPopulatedEmailProofByOIDC(r, easy)
+	return this
+}
+func NewPopulatedRegistrationPolicy_EmailProofBySAML(r randyKeyserverconfig, easy bool) *RegistrationPolicy_EmailProofBySAML {
+	this := &RegistrationPolicy_EmailProofBySAML{}
+	this.EmailProofBySAML = NewPopulatedEmailProofBySAML(r, easy)
+	return this
+}
+func NewPopulatedRegistrationPolicy_EmailProofByChallenge(r randyKeyserverconfig, easy bool) *RegistrationPolicy_EmailProofByChallenge {
+	this := &RegistrationPolicy_EmailProofByChallenge{}
+	this.EmailProofByChallenge = NewPopulatedEmailProofByChallenge(r, easy)
+	return this
+}
+func NewPopulatedRegistrationPolicy_EmailProofByExternalVerifier(r randyKeyserverconfig, easy bool) *RegistrationPolicy_EmailProofByExternalVerifier {
+	this := &RegistrationPolicy_EmailProofByExternalVerifier{}
+	this.EmailProofByExternalVerifier = NewPopulatedEmailProofByExternalVerifier(r, easy)
+	return this
+}
+func NewPopulatedEmailProofByDKIM(r randyKeyserverconfig, easy bool) *EmailProofByDKIM {
+	this := &EmailProofByDKIM{}
+	v16 := r.Intn(10)
+	this.AllowedDomains = make([]string, v16)
+	for i := 0; i < v16; i++ {
+		this.AllowedDomains[i] = randStringKeyserverconfig(r)
+	}
+	this.ToAddr = randStringKeyserverconfig(r)
+	this.SubjectPrefix = randStringKeyserverconfig(r)
+	v17 := NewPopulatedDuration(r, easy)
+	this.PendingUpdateValidity = *v17
+	this.MaxEmailSize = uint32(r.Uint32())
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedEmailProofByClientCert(r randyKeyserverconfig, easy bool) *EmailProofByClientCert {
+	this := &EmailProofByClientCert{}
+	v18 := r.Intn(10)
+	this.AllowedDomains = make([]string, v18)
+	for i := 0; i < v18; i++ {
+		this.AllowedDomains[i] = randStringKeyserverconfig(r)
+	}
+	v19 := r.Intn(100)
+	this.CaCert = make([]byte, v19)
+	for i := 0; i < v19; i++ {
+		this.CaCert[i] = byte(r.Intn(256))
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedEmailProofByOIDC(r randyKeyserverconfig, easy bool) *EmailProofByOIDC {
+	this := &EmailProofByOIDC{}
+	if r.Intn(10) != 0 {
+		v20 := r.Intn(5)
+		this.OIDCConfig = make([]*OIDCConfig, v20)
+		for i := 0; i < v20; i++ {
+			this.OIDCConfig[i] = NewPopulatedOIDCConfig(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedEmailProofBySAML(r randyKeyserverconfig, easy bool) *EmailProofBySAML {
+	this := &EmailProofBySAML{}
+	v21 := r.Intn(10)
+	this.AllowedDomains = make([]string, v21)
+	for i := 0; i < v21; i++ {
+		this.AllowedDomains[i] = randStringKeyserverconfig(r)
+	}
+	this.IDPMetadataURL = randStringKeyserverconfig(r)
+	if r.Intn(10) != 0 {
+		v22 := r.Intn(5)
+		this.SAMLConfig = make([]*SAMLConfig, v22)
+		for i := 0; i < v22; i++ {
+			this.SAMLConfig[i] = NewPopulatedSAMLConfig(r, easy)
+		}
+	}
+	v23 := NewPopulatedDuration(r, easy)
+	this.MetadataRefreshInterval = *v23
+	this.ConsumerServiceURL = randStringKeyserverconfig(r)
+	v24 := NewPopulatedTLSConfig(r, easy)
+	this.ServiceProviderTLS = *v24
+	v25 := NewPopulatedDuration(r, easy)
+	this.Validity = *v25
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedEmailProofByChallenge(r randyKeyserverconfig, easy bool) *EmailProofByChallenge {
+	this := &EmailProofByChallenge{}
+	v26 := r.Intn(10)
+	this.AllowedDomains = make([]string, v26)
+	for i := 0; i < v26; i++ {
+		this.AllowedDomains[i] = randStringKeyserverconfig(r)
+	}
+	this.SMTPRelay = randStringKeyserverconfig(r)
+	this.FromAddr = randStringKeyserverconfig(r)
+	this.Subject = randStringKeyserverconfig(r)
+	v27 := NewPopulatedDuration(r, easy)
+	this.Validity = *v27
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedAccountRecoveryConfig(r randyKeyserverconfig, easy bool) *AccountRecoveryConfig {
+	this := &AccountRecoveryConfig{}
+	v28 := NewPopulatedDuration(r, easy)
+	this.MinDelay = *v28
+	this.SMTPRelay = randStringKeyserverconfig(r)
+	this.FromAddr = randStringKeyserverconfig(r)
+	this.Subject = randStringKeyserverconfig(r)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedRateLimit(r randyKeyserverconfig, easy bool) *RateLimit {
+	this := &RateLimit{}
+	v29 := NewPopulatedDuration(r, easy)
+	this.Interval = *v29
+	this.Burst = uint64(uint64(r.Uint32()))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedSAMLConfig(r randyKeyserverconfig, easy bool) *SAMLConfig {
+	this := &SAMLConfig{}
+	v30 := r.Intn(10)
+	this.AllowedDomains = make([]string, v30)
+	for i := 0; i < v30; i++ {
+		this.AllowedDomains[i] = randStringKeyserverconfig(r)
+	}
+	this.IDPMetadataURL = randStringKeyserverconfig(r)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedEmailProofByExternalVerifier(r randyKeyserverconfig, easy bool) *EmailProofByExternalVerifier {
+	this := &EmailProofByExternalVerifier{}
+	this.Type = randStringKeyserverconfig(r)
+	v31 := r.Intn(10)
+	this.AllowedDomains = make([]string, v31)
+	for i := 0; i < v31; i++ {
+		this.AllowedDomains[i] = randStringKeyserverconfig(r)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedOIDCConfig(r randyKeyserverconfig, easy bool) *OIDCConfig {
+	this := &OIDCConfig{}
+	v32 := r.Intn(10)
+	this.AllowedDomains = make([]string, v32)
+	for i := 0; i < v32; i++ {
+		this.AllowedDomains[i] = randStringKeyserverconfig(r)
+	}
+	this.DiscoveryURL = randStringKeyserverconfig(r)
+	this.Issuer = randStringKeyserverconfig(r)
+	this.ClientID = randStringKeyserverconfig(r)
+	v33 := NewPopulatedDuration(r, easy)
+	this.Validity = *v33
+	this.Scope = randStringKeyserverconfig(r)
+	v34 := NewPopulatedDuration(r, easy)
+	this.KeyRefreshInterval = *v34
+	this.ClientSecret = randStringKeyserverconfig(r)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedReplica(r randyKeyserverconfig, easy bool) *Replica {
+	this := &Replica{}
+	this.ID = uint64(uint64(r.Uint32()))
+	if r.Intn(10) != 0 {
+		v35 := r.Intn(5)
+		this.PublicKeys = make([]*PublicKey, v35)
+		for i := 0; i < v35; i++ {
+			this.PublicKeys[i] = NewPopulatedPublicKey(r, easy)
+		}
+	}
+	this.RaftAddr = randStringKeyserverconfig(r)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+type randyKeyserverconfig interface {
+	Float32() float32
+	Float64() float64
+	Int63() int64
+	Int31() int32
+	Uint32() uint32
+	Intn(n int) int
+}
+
+func randUTF8RuneKeyserverconfig(r randyKeyserverconfig) rune {
+	ru := r.Intn(62)
+	if ru < 10 {
+		return rune(ru + 48)
+	} else if ru < 36 {
+		return rune(ru + 55)
+	}
+	return rune(ru + 61)
+}
+func randStringKeyserverconfig(r randyKeyserverconfig) string {
+	v36 := r.Intn(100)
+	tmps := make([]rune, v36)
+	for i := 0; i < v36; i++ {
+		tmps[i] = randUTF8RuneKeyserverconfig(r)
+	}
+	return string(tmps)
+}
+func randUnrecognizedKeyserverconfig(r randyKeyserverconfig, maxFieldNumber int) (data []byte) {
+	l := r.Intn(5)
+	for i := 0; i < l; i++ {
+		wire := r.Intn(4)
+		if wire == 3 {
+			wire = 5
+		}
+		fieldNumber := maxFieldNumber + r.Intn(100)
+		data = randFieldKeyserverconfig(data, r, fieldNumber, wire)
+	}
+	return data
+}
+func randFieldKeyserverconfig(data []byte, r randyKeyserverconfig, fieldNumber int, wire int) []byte {
+	key := uint32(fieldNumber)<<3 | uint32(wire)
+	switch wire {
+	case 0:
+		data = encodeVarintPopulateKeyserverconfig(data, uint64(key))
+		v37 := r.Int63()
+		if r.Intn(2) == 0 {
+			v37 *= -1
+		}
+		data = encodeVarintPopulateKeyserverconfig(data, uint64(v37))
+	case 1:
+		data = encodeVarintPopulateKeyserverconfig(data, uint64(key))
+		data = append(data, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	case 2:
+		data = encodeVarintPopulateKeyserverconfig(data, uint64(key))
+		ll := r.Intn(100)
+		data = encodeVarintPopulateKeyserverconfig(data, uint64(ll))
+		for j := 0; j < ll; j++ {
+			data = append(data, byte(r.Intn(256)))
+		}
+	default:
+		data = encodeVarintPopulateKeyserverconfig(data, uint64(key))
+		data = append(data, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	}
+	return data
+}
+func encodeVarintPopulateKeyserverconfig(data []byte, v uint64) []byte {
+	for v >= 1<<7 {
+		data = append(data, uint8(uint64(v)&0x7f|0x80))
+		v >>= 7
+	}
+	data = append(data, uint8(v))
+	return data
+}
+func (m *ReplicaConfig) Size() (n int) {
+	var l int
+	_ = l
+	l = m.KeyserverConfig.Size()
+	n += 1 + l + sovKeyserverconfig(uint64(l))
+	if m.ReplicaID != 0 {
+		n += 1 + sovKeyserverconfig(uint64(m.ReplicaID))
+	}
+	l = len(m.SigningKeyID)
+	if l > 0 {
+		n += 1 + l + sovKeyserverconfig(uint64(l))
+	}
+	l = len(m.PublicAddr)
+	if l > 0 {
+		n += 1 + l + sovKeyserverconfig(uint64(l))
+	}
+	l = m.PublicTLS.Size()
+	n += 1 + l + sovKeyserverconfig(uint64(l))
+	l = len(m.VerifierAddr)
+	if l > 0 {
+		n += 1 + l + sovKeyserverconfig(uint64(l))
+	}
+	l = m.VerifierTLS.Size()
+	n += 1 + l + sovKeyserverconfig(uint64(l))
+	l = len(m.HKPAddr)
+	if l > 0 {
+		n += 1 + l + sovKeyserverconfig(uint64(l))
+	}
+	l = m.HKPTLS.Size()
+	n += 1 + l + sovKeyserverconfig(uint64(l))
+	l = len(m.HTTPFrontAddr)
+	if l > 0 {
+		n += 1 + l + sovKeyserverconfig(uint64(l))
+	}
+	l = m.HTTPFrontTLS.Size()
+	n += 1 + l + sovKeyserverconfig(uint64(l))
+	l = len(m.RaftAddr)
+	if l > 0 {
+		n += 1 + l + sovKeyserverconfig(uint64(l))
+	}
+	l = m.RaftTLS.Size()
+	n += 1 + l + sovKeyserverconfig(uint64(l))
+	l = len(m.LevelDBPath)
+	if l > 0 {
+		n += 1 + l + sovKeyserverconfig(uint64(l))
+	}
+	l = m.RaftHeartbeat.Size()
+	n += 1 + l + sovKeyserverconfig(uint64(l))
+	if m.LaggingVerifierScan != 0 {
+		n += 2 + sovKeyserverconfig(uint64(m.LaggingVerifierScan))
+	}
+	l = m.ClientTimeout.Size()
+	n += 2 + l + sovKeyserverconfig(uint64(l))
+	l = len(m.SMTPAddr)
+	if l > 0 {
+		n += 2 + l + sovKeyserverconfig(uint64(l))
+	}
+	if m.AccountRecovery != nil {
+		l = m.AccountRecovery.Size()
+		n += 2 + l + sovKeyserverconfig(uint64(l))
+	}
+	if m.ClientIPRateLimit != nil {
+		l = m.ClientIPRateLimit.Size()
+		n += 2 + l + sovKeyserverconfig(uint64(l))
+	}
+	if m.UserIDRateLimit != nil {
+		l = m.UserIDRateLimit.Size()
+		n += 2 + l + sovKeyserverconfig(uint64(l))
+	}
+	return n
+}
+
+func (m *KeyserverConfig) Size() (n int) {
+	var l int
+	_ = l
+	if m.ServerID != 0 {
+		n += 1 + sovKeyserverconfig(uint64(m.ServerID))
+	}
+	l = len(m.Realm)
+	if l > 0 {
+		n += 1 + l + sovKeyserverconfig(uint64(l))
+	}
+	l = len(m.VRFKeyID)
+	if l > 0 {
+		n += 1 + l + sovKeyserverconfig(uint64(l))
+	}
+	l = len(m.NextVRFKeyID)
+	if l > 0 {
+		n += 1 + l + sovKeyserverconfig(uint64(l))
+	}
+	if m.VRFSuite != 0 {
+		n += 1 + sovKeyserverconfig(uint64(m.VRFSuite))
+	}
+	l = m.MinEpochInterval.Size()
+	n += 1 + l + sovKeyserverconfig(uint64(l))
+	l = m.MaxEpochInterval.Size()
+	n += 1 + l + sovKeyserverconfig(uint64(l))
+	l = m.ProposalRetryInterval.Size()
+	n += 1 + l + sovKeyserverconfig(uint64(l))
+	if len(m.InitialReplicas) > 0 {
+		for _, e := range m.InitialReplicas {
+			l = e.Size()
+			n += 1 + l + sovKeyserverconfig(uint64(l))
+		}
+	}
+	if len(m.RegistrationPolicy) > 0 {
+		for _, e := range m.RegistrationPolicy {
+			l = e.Size()
+			n += 1 + l + sovKeyserverconfig(uint64(l))
+		}
+	}
+	if m.RefreshIdleEpochs {
+		n += 2
+	}
+	if m.DeletionPolicy != nil {
+		l = m.DeletionPolicy.Size()
+		n += 1 + l + sovKeyserverconfig(uint64(l))
+	}
+	if len(m.DomainAdminPolicies) > 0 {
+		for _, e := range m.DomainAdminPolicies {
+			l = e.Size()
+			n += 1 + l + sovKeyserverconfig(uint64(l))
+		}
+	}
+	if m.RegistrationsPerDomainPerEpoch != 0 {
+		n += 1 + sovKeyserverconfig(uint64(m.RegistrationsPerDomainPerEpoch))
+	}
+	if len(m.VerifierPublicKeys) > 0 {
+		for _, e := range m.VerifierPublicKeys {
+			l = e.Size()
+			n += 1 + l + sovKeyserverconfig(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RegistrationPolicy) Size() (n int) {
+	var l int
+	_ = l
+	if m.PolicyType != nil {
+		n += m.PolicyType.Size()
+	}
+	return n
+}
+
+func (m *RegistrationPolicy_InsecureSkipEmailProof) Size() (n int) {
+	var l int
+	_ = l
+	n += 2
+	return n
+}
+func (m *RegistrationPolicy_EmailProofByDKIM) Size() (n int) {
+	var l int
+	_ = l
+	if m.EmailProofByDKIM != nil {
+		l = m.EmailProofByDKIM.Size()
+		n += 1 + l + sovKeyserverconfig(uint64(l))
+	}
+	return n
+}
+func (m *RegistrationPolicy_EmailProofByClientCert) Size() (n int) {
+	var l int
+	_ = l
+	if m.EmailProofByClientCert != nil {
+		l = m.EmailProofByClientCert.Size()
+		n += 1 + l + sovKeyserverconfig(uint64(l))
+	}
+	return n
+}
+func (m *RegistrationPolicy_EmailProofByOIDC) Size() (n int) {
+	var l int
+	_ = l
+	if m.EmailProofByOIDC != nil {
+		l = m.EmailProofByOIDC.Size()
+		n += 1 + l + sovKeyserverconfig(uint64(l))
+	}
+	return n
+}
+func (m *RegistrationPolicy_EmailProofBySAML) Size() (n int) {
+	var l int
+	_ = l
+	if m.EmailProofBySAML != nil {
+		l = m.EmailProofBySAML.Size()
+		n += 1 + l + sovKeyserverconfig(uint64(l))
+	}
+	return n
+}
+func (m *RegistrationPolicy_EmailProofByChallenge) Size() (n int) {
+	var l int
+	_ = l
+	if m.EmailProofByChallenge != nil {
+		l = m.EmailProofByChallenge.Size()
+		n += 1 + l + sovKeyserverconfig(uint64(l))
+	}
+	return n
+}
+func (m *RegistrationPolicy_EmailProofByExternalVerifier) Size() (n int) {
+	var l int
+	_ = l
+	if m.EmailProofByExternalVerifier != nil {
+		l = m.EmailProofByExternalVerifier.Size()
+		n += 1 + l + sovKeyserverconfig(uint64(l))
+	}
+	return n
+}
+func (m *EmailProofByDKIM) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.AllowedDomains) > 0 {
+		for _, s := range m.AllowedDomains {
+			l = len(s)
+			n += 1 + l + sovKeyserverconfig(uint64(l))
+		}
+	}
+	l = len(m.ToAddr)
+	if l > 0 {
+		n += 1 + l + sovKeyserverconfig(uint64(l))
+	}
+	l = len(m.SubjectPrefix)
+	if l > 0 {
+		n += 1 + l + sovKeyserverconfig(uint64(l))
+	}
+	l = m.PendingUpdateValidity.Size()
+	n += 1 + l + sovKeyserverconfig(uint64(l))
+	if m.MaxEmailSize != 0 {
+		n += 1 + sovKeyserverconfig(uint64(m.MaxEmailSize))
+	}
+	return n
+}
+
+func (m *EmailProofByClientCert) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.AllowedDomains) > 0 {
+		for _, s := range m.AllowedDomains {
+			l = len(s)
+			n += 1 + l + sovKeyserverconfig(uint64(l))
+		}
+	}
+	l = len(m.CaCert)
+	if l > 0 {
+		n += 1 + l + sovKeyserverconfig(uint64(l))
+	}
+	return n
+}
+
+func (m *EmailProofByOIDC) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.OIDCConfig) > 0 {
+		for _, e := range m.OIDCConfig {
+			l = e.Size()
+			n += 1 + l + sovKeyserverconfig(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EmailProofBySAML) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.AllowedDomains) > 0 {
+		for _, s := range m.AllowedDomains {
+			l = len(s)
+			n += 1 + l + sovKeyserverconfig(uint64(l))
+		}
+	}
+	l = len(m.IDPMetadataURL)
+	if l > 0 {
+		n += 1 + l + sovKeyserverconfig(uint64(l))
+	}
+	if len(m.SAMLConfig) > 0 {
+		for _, e := range m.SAMLConfig {
+			l = e.Size()
+			n += 1 + l + sovKeyserverconfig(uint64(l))
+		}
+	}
+	l = m.MetadataRefreshInterval.Size()
+	n += 1 + l + sovKeyserverconfig(uint64(l))
+	l = len(m.ConsumerServiceURL)
+	if l > 0 {
+		n += 1 + l + sovKeyserverconfig(uint64(l))
+	}
+	l = m.ServiceProviderTLS.Size()
+	n += 1 + l + sovKeyserverconfig(uint64(l))
+	l = m.Validity.Size()
+	n += 1 + l + sovKeyserverconfig(uint64(l))
+	return n
+}
+
+func (m *EmailProofByChallenge) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.AllowedDomains) > 0 {
+		for _, s := range m.AllowedDomains {
+			l = len(s)
+			n += 1 + l + sovKeyserverconfig(uint64(l))
+		}
+	}
+	l = len(m.SMTPRelay)
+	if l > 0 {
+		n += 1 + l + sovKeyserverconfig(uint64(l))
+	}
+	l = len(m.FromAddr)
+	if l > 0 {
+		n += 1 + l + sovKeyserverconfig(uint64(l))
+	}
+	l = len(m.Subject)
+	if l > 0 {
+		n += 1 + l + sovKeyserverconfig(uint64(l))
+	}
+	l = m.Validity.Size()
+	n += 1 + l + sovKeyserverconfig(uint64(l))
+	return n
+}
+
+func (m *AccountRecoveryConfig) Size() (n int) {
+	var l int
+	_ = l
+	l = m.MinDelay.Size()
+	n += 1 + l + sovKeyserverconfig(uint64(l))
+	l = len(m.SMTPRelay)
+	if l > 0 {
+		n += 1 + l + sovKeyserverconfig(uint64(l))
+	}
+	l = len(m.FromAddr)
+	if l > 0 {
+		n += 1 + l + sovKeyserverconfig(uint64(l))
+	}
+	l = len(m.Subject)
+	if l > 0 {
+		n += 1 + l + sovKeyserverconfig(uint64(l))
+	}
+	return n
+}
+
+func (m *RateLimit) Size() (n int) {
+	var l int
+	_ = l
+	l = m.Interval.Size()
+	n += 1 + l + sovKeyserverconfig(uint64(l))
+	if m.Burst != 0 {
+		n += 1 + sovKeyserverconfig(uint64(m.Burst))
+	}
+	return n
+}
+
+func (m *SAMLConfig) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.AllowedDomains) > 0 {
+		for _, s := range m.AllowedDomains {
+			l = len(s)
+			n += 1 + l + sovKeyserverconfig(uint64(l))
+		}
+	}
+	l = len(m.IDPMetadataURL)
+	if l > 0 {
+		n += 1 + l + sovKeyserverconfig(uint64(l))
+	}
+	return n
+}
+
+func (m *EmailProofByExternalVerifier) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovKeyserverconfig(uint64(l))
+	}
+	if len(m.AllowedDomains) > 0 {
+		for _, s := range m.AllowedDomains {
+			l = len(s)
+			n += 1 + l + sovKeyserverconfig(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OIDCConfig) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.AllowedDomains) > 0 {
+		for _, s := range m.AllowedDomains {
+			l = len(s)
+			n += 1 + l + sovKeyserverconfig(uint64(l))
+		}
+	}
+	l = len(m.DiscoveryURL)
+	if l > 0 {
+		n += 1 + l + sovKeyserverconfig(uint64(l))
+	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovKeyserverconfig(uint64(l))
+	}
+	l = len(m.ClientID)
+	if l > 0 {
+		n += 1 + l + sovKeyserverconfig(uint64(l))
+	}
+	l = m.Validity.Size()
+	n += 1 + l + sovKeyserverconfig(uint64(l))
+	l = len(m.Scope)
+	if l > 0 {
+		n += 1 + l + sovKeyserverconfig(uint64(l))
+	}
+	l = m.KeyRefreshInterval.Size()
+	n += 1 + l + sovKeyserverconfig(uint64(l))
+	l = len(m.ClientSecret)
+	if l > 0 {
+		n += 1 + l + sovKeyserverconfig(uint64(l))
+	}
+	return n
+}
+
+func (m *Replica) Size() (n int) {
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovKeyserverconfig(uint64(m.ID))
+	}
+	if len(m.PublicKeys) > 0 {
+		for _, e := range m.PublicKeys {
+			l = e.Size()
+			n += 1 + l + sovKeyserverconfig(uint64(l))
+		}
+	}
+	l = len(m.RaftAddr)
+	if l > 0 {
+		n += 1 + l + sovKeyserverconfig(uint64(l))
+	}
+	return n
+}
+
+func sovKeyserverconfig(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozKeyserverconfig(x uint64) (n int) {
+	return sovKeyserverconfig(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *ReplicaConfig) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReplicaConfig{`,
+		`KeyserverConfig:` + strings.Replace(strings.Replace(this.KeyserverConfig.String(), "KeyserverConfig", "KeyserverConfig", 1), `&`, ``, 1) + `,`,
+		`ReplicaID:` + fmt.Sprintf("%v", this.ReplicaID) + `,`,
+		`SigningKeyID:` + fmt.Sprintf("%v", this.SigningKeyID) + `,`,
+		`PublicAddr:` + fmt.Sprintf("%v", this.PublicAddr) + `,`,
+		`PublicTLS:` + strings.Replace(strings.Replace(this.PublicTLS.String(), "TLSConfig", "TLSConfig", 1), `&`, ``, 1) + `,`,
+		`VerifierAddr:` + fmt.Sprintf("%v", this.VerifierAddr) + `,`,
+		`VerifierTLS:` + strings.Replace(strings.Replace(this.VerifierTLS.String(), "TLSConfig", "TLSConfig", 1), `&`, ``, 1) + `,`,
+		`HKPAddr:` + fmt.Sprintf("%v", this.HKPAddr) + `,`,
+		`HKPTLS:` + strings.Replace(strings.Replace(this.HKPTLS.String(), "TLSConfig", "TLSConfig", 1), `&`, ``, 1) + `,`,
+		`HTTPFrontAddr:` + fmt.Sprintf("%v", this.HTTPFrontAddr) + `,`,
+		`HTTPFrontTLS:` + strings.Replace(strings.Replace(this.HTTPFrontTLS.String(), "TLSConfig", "TLSConfig", 1), `&`, ``, 1) + `,`,
+		`RaftAddr:` + fmt.Sprintf("%v", this.RaftAddr) + `,`,
+		`RaftTLS:` + strings.Replace(strings.Replace(this.RaftTLS.String(), "TLSConfig", "TLSConfig", 1), `&`, ``, 1) + `,`,
+		`LevelDBPath:` + fmt.Sprintf("%v", this.LevelDBPath) + `,`,
+		`RaftHeartbeat:` + strings.Replace(strings.Replace(this.RaftHeartbeat.String(), "Duration", "Duration", 1), `&`, ``, 1) + `,`,
+		`LaggingVerifierScan:` + fmt.Sprintf("%v", this.LaggingVerifierScan) + `,`,
+		`ClientTimeout:` + strings.Replace(strings.Replace(this.ClientTimeout.String(), "Duration", "Duration", 1), `&`, ``, 1) + `,`,
+		`SMTPAddr:` + fmt.Sprintf("%v", this.SMTPAddr) + `,`,
+		`AccountRecovery:` + strings.Replace(fmt.Sprintf("%v", this.AccountRecovery), "AccountRecoveryConfig", "AccountRecoveryConfig", 1) + `,`,
+		`ClientIPRateLimit:` + strings.Replace(fmt.Sprintf("%v", this.ClientIPRateLimit), "RateLimit", "RateLimit", 1) + `,`,
+		`UserIDRateLimit:` + strings.Replace(fmt.Sprintf("%v", this.UserIDRateLimit), "RateLimit", "RateLimit", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *KeyserverConfig) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&KeyserverConfig{`,
+		`ServerID:` + fmt.Sprintf("%v", this.ServerID) + `,`,
+		`Realm:` + fmt.Sprintf("%v", this.Realm) + `,`,
+		`VRFKeyID:` + fmt.Sprintf("%v", this.VRFKeyID) + `,`,
+		`NextVRFKeyID:` + fmt.Sprintf("%v", this.NextVRFKeyID) + `,`,
+		`VRFSuite:` + fmt.Sprintf("%v", this.VRFSuite) + `,`,
+		`MinEpochInterval:` + strings.Replace(strings.Replace(this.MinEpochInterval.String(), "Duration", "Duration", 1), `&`, ``, 1) + `,`,
+		`MaxEpochInterval:` + strings.Replace(strings.Replace(this.MaxEpochInterval.String(), "Duration", "Duration", 1), `&`, ``, 1) + `,`,
+		`ProposalRetryInterval:` + strings.Replace(strings.Replace(this.ProposalRetryInterval.String(), "Duration", "Duration", 1), `&`, ``, 1) + `,`,
+		`InitialReplicas:` + strings.Replace(fmt.Sprintf("%v", this.InitialReplicas), "Replica", "Replica", 1) + `,`,
+		`RegistrationPolicy:` + strings.Replace(fmt.Sprintf("%v", this.RegistrationPolicy), "RegistrationPolicy", "RegistrationPolicy", 1) + `,`,
+		`RefreshIdleEpochs:` + fmt.Sprintf("%v", this.RefreshIdleEpochs) + `,`,
+		`DeletionPolicy:` + strings.Replace(fmt.Sprintf("%v", this.DeletionPolicy), "AuthorizationPolicy", "AuthorizationPolicy", 1) + `,`,
+		`DomainAdminPolicies:` + strings.Replace(fmt.Sprintf("%v", this.DomainAdminPolicies), "DomainAdminPolicy", "DomainAdminPolicy", 1) + `,`,
+		`RegistrationsPerDomainPerEpoch:` + fmt.Sprintf("%v", this.RegistrationsPerDomainPerEpoch) + `,`,
+		`VerifierPublicKeys:` + strings.Replace(fmt.Sprintf("%v", this.VerifierPublicKeys), "PublicKey", "PublicKey", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RegistrationPolicy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RegistrationPolicy{`,
+		`PolicyType:` + fmt.Sprintf("%v", this.PolicyType) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RegistrationPolicy_InsecureSkipEmailProof) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RegistrationPolicy_InsecureSkipEmailProof{`,
+		`InsecureSkipEmailProof:` + fmt.Sprintf("%v", this.InsecureSkipEmailProof) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RegistrationPolicy_EmailProofByDKIM) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RegistrationPolicy_EmailProofByDKIM{`,
+		`EmailProofByDKIM:` + strings.Replace(fmt.Sprintf("%v", this.EmailProofByDKIM), "EmailProofByDKIM", "EmailProofByDKIM", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RegistrationPolicy_EmailProofByClientCert) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RegistrationPolicy_EmailProofByClientCert{`,
+		`EmailProofByClientCert:` + strings.Replace(fmt.Sprintf("%v", this.EmailProofByClientCert), "EmailProofByClientCert", "EmailProofByClientCert", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RegistrationPolicy_EmailProofByOIDC) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RegistrationPolicy_EmailProofByOIDC{`,
+		`EmailProofByOIDC:` + strings.Replace(fmt.Sprintf("%v", this.EmailProofByOIDC), "EmailProofByOIDC", "EmailProofByOIDC", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RegistrationPolicy_EmailProofBySAML) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RegistrationPolicy_EmailProofBySAML{`,
+		`EmailProofBySAML:` + strings.Replace(fmt.Sprintf("%v", this.EmailProofBySAML), "EmailProofBySAML", "EmailProofBySAML", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RegistrationPolicy_EmailProofByChallenge) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RegistrationPolicy_EmailProofByChallenge{`,
+		`EmailProofByChallenge:` + strings.Replace(fmt.Sprintf("%v", this.EmailProofByChallenge), "EmailProofByChallenge", "EmailProofByChallenge", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RegistrationPolicy_EmailProofByExternalVerifier) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RegistrationPolicy_EmailProofByExternalVerifier{`,
+		`EmailProofByExternalVerifier:` + strings.Replace(fmt.Sprintf("%v", this.EmailProofByExternalVerifier), "EmailProofByExternalVerifier", "EmailProofByExternalVerifier", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EmailProofByDKIM) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EmailProofByDKIM{`,
+		`AllowedDomains:` + fmt.Sprintf("%v", this.AllowedDomains) + `,`,
+		`ToAddr:` + fmt.Sprintf("%v", this.ToAddr) + `,`,
+		`SubjectPrefix:` + fmt.Sprintf("%v", this.SubjectPrefix) + `,`,
+		`PendingUpdateValidity:` + strings.Replace(strings.Replace(this.PendingUpdateValidity.String(), "Duration", "Duration", 1), `&`, ``, 1) + `,`,
+		`MaxEmailSize:` + fmt.Sprintf("%v", this.MaxEmailSize) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EmailProofByClientCert) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EmailProofByClientCert{`,
+		`AllowedDomains:` + fmt.Sprintf("%v", this.AllowedDomains) + `,`,
+		`CaCert:` + fmt.Sprintf("%v", this.CaCert) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EmailProofByOIDC) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EmailProofByOIDC{`,
+		`OIDCConfig:` + strings.Replace(fmt.Sprintf("%v", this.OIDCConfig), "OIDCConfig", "OIDCConfig", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EmailProofBySAML) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EmailProofBySAML{`,
+		`AllowedDomains:` + fmt.Sprintf("%v", this.AllowedDomains) + `,`,
+		`IDPMetadataURL:` + fmt.Sprintf("%v", this.IDPMetadataURL) + `,`,
+		`SAMLConfig:` + strings.Replace(fmt.Sprintf("%v", this.SAMLConfig), "SAMLConfig", "SAMLConfig", 1) + `,`,
+		`MetadataRefreshInterval:` + strings.Replace(strings.Replace(this.MetadataRefreshInterval.String(), "Duration", "Duration", 1), `&`, ``, 1) + `,`,
+		`ConsumerServiceURL:` + fmt.Sprintf("%v", this.ConsumerServiceURL) + `,`,
+		`ServiceProviderTLS:` + strings.Replace(strings.Replace(this.ServiceProviderTLS.String(), "TLSConfig", "TLSConfig", 1), `&`, ``, 1) + `,`,
+		`Validity:` + strings.Replace(strings.Replace(this.Validity.String(), "Duration", "Duration", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EmailProofByChallenge) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EmailProofByChallenge{`,
+		`AllowedDomains:` + fmt.Sprintf("%v", this.AllowedDomains) + `,`,
+		`SMTPRelay:` + fmt.Sprintf("%v", this.SMTPRelay) + `,`,
+		`FromAddr:` + fmt.Sprintf("%v", this.FromAddr) + `,`,
+		`Subject:` + fmt.Sprintf("%v", this.Subject) + `,`,
+		`Validity:` + strings.Replace(strings.Replace(this.Validity.String(), "Duration", "Duration", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AccountRecoveryConfig) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AccountRecoveryConfig{`,
+		`MinDelay:` + strings.Replace(strings.Replace(this.MinDelay.String(), "Duration", "Duration", 1), `&`, ``, 1) + `,`,
+		`SMTPRelay:` + fmt.Sprintf("%v", this.SMTPRelay) + `,`,
+		`FromAddr:` + fmt.Sprintf("%v", this.FromAddr) + `,`,
+		`Subject:` + fmt.Sprintf("%v", this.Subject) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RateLimit) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RateLimit{`,
+		`Interval:` + strings.Replace(strings.Replace(this.Interval.String(), "Duration", "Duration", 1), `&`, ``, 1) + `,`,
+		`Burst:` + fmt.Sprintf("%v", this.Burst) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SAMLConfig) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SAMLConfig{`,
+		`AllowedDomains:` + fmt.Sprintf("%v", this.AllowedDomains) + `,`,
+		`IDPMetadataURL:` + fmt.Sprintf("%v", this.IDPMetadataURL) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EmailProofByExternalVerifier) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EmailProofByExternalVerifier{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`AllowedDomains:` + fmt.Sprintf("%v", this.AllowedDomains) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *OIDCConfig) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&OIDCConfig{`,
+		`AllowedDomains:` + fmt.Sprintf("%v", this.AllowedDomains) + `,`,
+		`DiscoveryURL:` + fmt.Sprintf("%v", this.DiscoveryURL) + `,`,
+		`Issuer:` + fmt.Sprintf("%v", this.Issuer) + `,`,
+		`ClientID:` + fmt.Sprintf("%v", this.ClientID) + `,`,
+		`Validity:` + strings.Replace(strings.Replace(this.Validity.String(), "Duration", "Duration", 1), `&`, ``, 1) + `,`,
+		`Scope:` + fmt.Sprintf("%v", this.Scope) + `,`,
+		`KeyRefreshInterval:` + strings.Replace(strings.Replace(this.KeyRefreshInterval.String(), "Duration", "Duration", 1), `&`, ``, 1) + `,`,
+		`ClientSecret:` + fmt.Sprintf("%v", this.ClientSecret) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Replica) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Replica{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`PublicKeys:` + strings.Replace(fmt.Sprintf("%v", this.PublicKeys), "PublicKey", "PublicKey", 1) + `,`,
+		`RaftAddr:` + fmt.Sprintf("%v", this.RaftAddr) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringKeyserverconfig(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *ReplicaConfig) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeyserverconfig
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReplicaConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReplicaConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyserverConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyserverconfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeyserverconfig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.KeyserverConfig.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicaID", wireType)
+			}
+			m.ReplicaID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyserverconfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.ReplicaID |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningKeyID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyserverconfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeyserverconfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SigningKeyID = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyserverconfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeyserverconfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicAddr = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicTLS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyserverconfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeyserverconfig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PublicTLS.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifierAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyserverconfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeyserverconfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerifierAddr = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifierTLS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyserverconfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeyserverconfig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VerifierTLS.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HKPAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyserverconfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeyserverconfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HKPAddr = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HKPTLS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyserverconfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeyserverconfig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HKPTLS.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HTTPFrontAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyserverconfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeyserverconfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HTTPFrontAddr = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HTTPFrontTLS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyserverconfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeyserverconfig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HTTPFrontTLS.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RaftAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyserverconfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeyserverconfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RaftAddr = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RaftTLS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyserverconfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeyserverconfig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RaftTLS.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LevelDBPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyserverconfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeyserverconfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LevelDBPath = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RaftHeartbeat", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyserverconfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeyserverconfig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RaftHeartbeat.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LaggingVerifierScan", wireType)
+			}
+			m.LaggingVerifierScan = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyserverconfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.LaggingVerifierScan |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyserverconfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeyserverconfig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ClientTimeout.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SMTPAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyserverconfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeyserverconfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SMTPAddr = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountRecovery", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyserverconfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeyserverconfig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AccountRecovery == nil {
+				m.AccountRecovery = &AccountRecoveryConfig{}
+			}
+			if err := m.AccountRecovery.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientIPRateLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyserverconfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeyserverconfig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ClientIPRateLimit == nil {
+				m.ClientIPRateLimit = &RateLimit{}
+			}
+			if err := m.ClientIPRateLimit.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserIDRateLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyserverconfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeyserverconfig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UserIDRateLimit == nil {
+				m.UserIDRateLimit = &RateLimit{}
+			}
+			if err := m.UserIDRateLimit.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeyserverconfig(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKeyserverconfig
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *ReplicaConfig) Unmarshal(data []byte) error {
+func (m *KeyserverConfig) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3322,28 +5841,213 @@ func (m *ReplicaConfig) Unmarshal(data []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowKeyserverconfig
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KeyserverConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KeyserverConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerID", wireType)
+			}
+			m.ServerID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyserverconfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.ServerID |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Realm", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyserverconfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeyserverconfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Realm = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VRFKeyID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyserverconfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeyserverconfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VRFKeyID = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextVRFKeyID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyserverconfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeyserverconfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextVRFKeyID = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VRFSuite", wireType)
+			}
+			m.VRFSuite = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyserverconfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.VRFSuite |= (VRFSuite(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinEpochInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyserverconfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeyserverconfig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinEpochInterval.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEpochInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyserverconfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeyserverconfig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := data[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.MaxEpochInterval.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ReplicaConfig: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReplicaConfig: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyserverConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalRetryInterval", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3367,15 +6071,77 @@ func (m *ReplicaConfig) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.KeyserverConfig.Unmarshal(data[iNdEx:postIndex]); err != nil {
+			if err := m.ProposalRetryInterval.Unmarshal(data[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialReplicas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyserverconfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeyserverconfig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InitialReplicas = append(m.InitialReplicas, &Replica{})
+			if err := m.InitialReplicas[len(m.InitialReplicas)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyserverconfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeyserverconfig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegistrationPolicy = append(m.RegistrationPolicy, &RegistrationPolicy{})
+			if err := m.RegistrationPolicy[len(m.RegistrationPolicy)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReplicaID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RefreshIdleEpochs", wireType)
 			}
-			m.ReplicaID = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeyserverconfig
@@ -3385,16 +6151,181 @@ func (m *ReplicaConfig) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.ReplicaID |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			m.RefreshIdleEpochs = bool(v != 0)
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SigningKeyID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletionPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyserverconfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeyserverconfig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DeletionPolicy == nil {
+				m.DeletionPolicy = &AuthorizationPolicy{}
+			}
+			if err := m.DeletionPolicy.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainAdminPolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyserverconfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeyserverconfig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DomainAdminPolicies = append(m.DomainAdminPolicies, &DomainAdminPolicy{})
+			if err := m.DomainAdminPolicies[len(m.DomainAdminPolicies)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationsPerDomainPerEpoch", wireType)
+			}
+			m.RegistrationsPerDomainPerEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyserverconfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.RegistrationsPerDomainPerEpoch |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifierPublicKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyserverconfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeyserverconfig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerifierPublicKeys = append(m.VerifierPublicKeys, &PublicKey{})
+			if err := m.VerifierPublicKeys[len(m.VerifierPublicKeys)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeyserverconfig(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKeyserverconfig
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegistrationPolicy) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeyserverconfig
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegistrationPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegistrationPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsecureSkipEmailProof", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeyserverconfig
@@ -3404,26 +6335,18 @@ func (m *ReplicaConfig) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthKeyserverconfig
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SigningKeyID = string(data[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			b := bool(v != 0)
+			m.PolicyType = &RegistrationPolicy_InsecureSkipEmailProof{b}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EmailProofByDKIM", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeyserverconfig
@@ -3433,24 +6356,27 @@ func (m *ReplicaConfig) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthKeyserverconfig
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PublicAddr = string(data[iNdEx:postIndex])
+			v := &EmailProofByDKIM{}
+			if err := v.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.PolicyType = &RegistrationPolicy_EmailProofByDKIM{v}
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicTLS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EmailProofByClientCert", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3474,15 +6400,17 @@ func (m *ReplicaConfig) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PublicTLS.Unmarshal(data[iNdEx:postIndex]); err != nil {
+			v := &EmailProofByClientCert{}
+			if err := v.Unmarshal(data[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.PolicyType = &RegistrationPolicy_EmailProofByClientCert{v}
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VerifierAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EmailProofByOIDC", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeyserverconfig
@@ -3492,24 +6420,27 @@ func (m *ReplicaConfig) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthKeyserverconfig
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VerifierAddr = string(data[iNdEx:postIndex])
+			v := &EmailProofByOIDC{}
+			if err := v.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.PolicyType = &RegistrationPolicy_EmailProofByOIDC{v}
 			iNdEx = postIndex
-		case 7:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VerifierTLS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EmailProofBySAML", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3533,15 +6464,17 @@ func (m *ReplicaConfig) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.VerifierTLS.Unmarshal(data[iNdEx:postIndex]); err != nil {
+			v := &EmailProofBySAML{}
+			if err := v.Unmarshal(data[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.PolicyType = &RegistrationPolicy_EmailProofBySAML{v}
 			iNdEx = postIndex
-		case 8:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HKPAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EmailProofByChallenge", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeyserverconfig
@@ -3551,24 +6484,27 @@ func (m *ReplicaConfig) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthKeyserverconfig
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HKPAddr = string(data[iNdEx:postIndex])
+			v := &EmailProofByChallenge{}
+			if err := v.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.PolicyType = &RegistrationPolicy_EmailProofByChallenge{v}
 			iNdEx = postIndex
-		case 9:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HKPTLS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EmailProofByExternalVerifier", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3592,13 +6528,65 @@ func (m *ReplicaConfig) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.HKPTLS.Unmarshal(data[iNdEx:postIndex]); err != nil {
+			v := &EmailProofByExternalVerifier{}
+			if err := v.Unmarshal(data[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.PolicyType = &RegistrationPolicy_EmailProofByExternalVerifier{v}
 			iNdEx = postIndex
-		case 10:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeyserverconfig(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKeyserverconfig
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EmailProofByDKIM) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeyserverconfig
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EmailProofByDKIM: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EmailProofByDKIM: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HTTPFrontAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDomains", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3623,13 +6611,13 @@ func (m *ReplicaConfig) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HTTPFrontAddr = string(data[iNdEx:postIndex])
+			m.AllowedDomains = append(m.AllowedDomains, string(data[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 11:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HTTPFrontTLS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddr", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeyserverconfig
@@ -3639,25 +6627,24 @@ func (m *ReplicaConfig) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthKeyserverconfig
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.HTTPFrontTLS.Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ToAddr = string(data[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RaftAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubjectPrefix", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3682,11 +6669,11 @@ func (m *ReplicaConfig) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RaftAddr = string(data[iNdEx:postIndex])
+			m.SubjectPrefix = string(data[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RaftTLS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingUpdateValidity", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3710,15 +6697,15 @@ func (m *ReplicaConfig) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RaftTLS.Unmarshal(data[iNdEx:postIndex]); err != nil {
+			if err := m.PendingUpdateValidity.Unmarshal(data[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LevelDBPath", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEmailSize", wireType)
 			}
-			var stringLen uint64
+			m.MaxEmailSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeyserverconfig
@@ -3728,26 +6715,66 @@ func (m *ReplicaConfig) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.MaxEmailSize |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeyserverconfig(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthKeyserverconfig
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LevelDBPath = string(data[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 15:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EmailProofByClientCert) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeyserverconfig
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EmailProofByClientCert: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EmailProofByClientCert: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RaftHeartbeat", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDomains", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeyserverconfig
@@ -3757,27 +6784,26 @@ func (m *ReplicaConfig) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthKeyserverconfig
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RaftHeartbeat.Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AllowedDomains = append(m.AllowedDomains, string(data[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 16:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LaggingVerifierScan", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CaCert", wireType)
 			}
-			m.LaggingVerifierScan = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeyserverconfig
@@ -3787,14 +6813,76 @@ func (m *ReplicaConfig) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.LaggingVerifierScan |= (uint64(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 17:
+			if byteLen < 0 {
+				return ErrInvalidLengthKeyserverconfig
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CaCert = append(m.CaCert[:0], data[iNdEx:postIndex]...)
+			if m.CaCert == nil {
+				m.CaCert = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeyserverconfig(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKeyserverconfig
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EmailProofByOIDC) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeyserverconfig
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EmailProofByOIDC: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EmailProofByOIDC: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OIDCConfig", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3818,7 +6906,8 @@ func (m *ReplicaConfig) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ClientTimeout.Unmarshal(data[iNdEx:postIndex]); err != nil {
+			m.OIDCConfig = append(m.OIDCConfig, &OIDCConfig{})
+			if err := m.OIDCConfig[len(m.OIDCConfig)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3843,7 +6932,7 @@ func (m *ReplicaConfig) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *KeyserverConfig) Unmarshal(data []byte) error {
+func (m *EmailProofBySAML) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3866,34 +6955,15 @@ func (m *KeyserverConfig) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KeyserverConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: EmailProofBySAML: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KeyserverConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EmailProofBySAML: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServerID", wireType)
-			}
-			m.ServerID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeyserverconfig
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				m.ServerID |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Realm", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDomains", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3918,11 +6988,11 @@ func (m *KeyserverConfig) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Realm = string(data[iNdEx:postIndex])
+			m.AllowedDomains = append(m.AllowedDomains, string(data[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VRFKeyID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IDPMetadataURL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3947,11 +7017,11 @@ func (m *KeyserverConfig) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VRFKeyID = string(data[iNdEx:postIndex])
+			m.IDPMetadataURL = string(data[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinEpochInterval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SAMLConfig", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3975,13 +7045,14 @@ func (m *KeyserverConfig) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinEpochInterval.Unmarshal(data[iNdEx:postIndex]); err != nil {
+			m.SAMLConfig = append(m.SAMLConfig, &SAMLConfig{})
+			if err := m.SAMLConfig[len(m.SAMLConfig)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxEpochInterval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataRefreshInterval", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4005,15 +7076,15 @@ func (m *KeyserverConfig) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxEpochInterval.Unmarshal(data[iNdEx:postIndex]); err != nil {
+			if err := m.MetadataRefreshInterval.Unmarshal(data[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalRetryInterval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerServiceURL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeyserverconfig
@@ -4023,25 +7094,24 @@ func (m *KeyserverConfig) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthKeyserverconfig
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ProposalRetryInterval.Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ConsumerServiceURL = string(data[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialReplicas", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceProviderTLS", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4065,14 +7135,13 @@ func (m *KeyserverConfig) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InitialReplicas = append(m.InitialReplicas, &Replica{})
-			if err := m.InitialReplicas[len(m.InitialReplicas)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+			if err := m.ServiceProviderTLS.Unmarshal(data[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationPolicy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validity", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4096,8 +7165,7 @@ func (m *KeyserverConfig) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RegistrationPolicy = append(m.RegistrationPolicy, &RegistrationPolicy{})
-			if err := m.RegistrationPolicy[len(m.RegistrationPolicy)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+			if err := m.Validity.Unmarshal(data[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4122,7 +7190,7 @@ func (m *KeyserverConfig) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *RegistrationPolicy) Unmarshal(data []byte) error {
+func (m *EmailProofByChallenge) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4145,17 +7213,17 @@ func (m *RegistrationPolicy) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegistrationPolicy: wiretype end group for non-group")
+			return fmt.Errorf("proto: EmailProofByChallenge: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegistrationPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EmailProofByChallenge: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InsecureSkipEmailProof", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDomains", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeyserverconfig
@@ -4165,18 +7233,26 @@ func (m *RegistrationPolicy) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.PolicyType = &RegistrationPolicy_InsecureSkipEmailProof{b}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeyserverconfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedDomains = append(m.AllowedDomains, string(data[iNdEx:postIndex]))
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EmailProofByDKIM", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SMTPRelay", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeyserverconfig
@@ -4186,29 +7262,26 @@ func (m *RegistrationPolicy) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthKeyserverconfig
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &EmailProofByDKIM{}
-			if err := v.Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.PolicyType = &RegistrationPolicy_EmailProofByDKIM{v}
+			m.SMTPRelay = string(data[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EmailProofByClientCert", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddr", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeyserverconfig
@@ -4218,29 +7291,26 @@ func (m *RegistrationPolicy) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthKeyserverconfig
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &EmailProofByClientCert{}
-			if err := v.Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.PolicyType = &RegistrationPolicy_EmailProofByClientCert{v}
+			m.FromAddr = string(data[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EmailProofByOIDC", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeyserverconfig
@@ -4250,27 +7320,24 @@ func (m *RegistrationPolicy) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthKeyserverconfig
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &EmailProofByOIDC{}
-			if err := v.Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.PolicyType = &RegistrationPolicy_EmailProofByOIDC{v}
+			m.Subject = string(data[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EmailProofBySAML", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validity", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4294,11 +7361,9 @@ func (m *RegistrationPolicy) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &EmailProofBySAML{}
-			if err := v.Unmarshal(data[iNdEx:postIndex]); err != nil {
+			if err := m.Validity.Unmarshal(data[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.PolicyType = &RegistrationPolicy_EmailProofBySAML{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4321,7 +7386,7 @@ func (m *RegistrationPolicy) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *EmailProofByDKIM) Unmarshal(data []byte) error {
+func (m *AccountRecoveryConfig) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4340,19 +7405,49 @@ func (m *EmailProofByDKIM) Unmarshal(data []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EmailProofByDKIM: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EmailProofByDKIM: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountRecoveryConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountRecoveryConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDelay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyserverconfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeyserverconfig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinDelay.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDomains", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SMTPRelay", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4377,11 +7472,11 @@ func (m *EmailProofByDKIM) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AllowedDomains = append(m.AllowedDomains, string(data[iNdEx:postIndex]))
+			m.SMTPRelay = string(data[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4406,11 +7501,11 @@ func (m *EmailProofByDKIM) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToAddr = string(data[iNdEx:postIndex])
+			m.FromAddr = string(data[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubjectPrefix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4435,7 +7530,7 @@ func (m *EmailProofByDKIM) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SubjectPrefix = string(data[iNdEx:postIndex])
+			m.Subject = string(data[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4458,7 +7553,7 @@ func (m *EmailProofByDKIM) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *EmailProofByClientCert) Unmarshal(data []byte) error {
+func (m *RateLimit) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4481,17 +7576,17 @@ func (m *EmailProofByClientCert) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EmailProofByClientCert: wiretype end group for non-group")
+			return fmt.Errorf("proto: RateLimit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EmailProofByClientCert: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDomains", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeyserverconfig
@@ -4501,26 +7596,27 @@ func (m *EmailProofByClientCert) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthKeyserverconfig
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AllowedDomains = append(m.AllowedDomains, string(data[iNdEx:postIndex]))
+			if err := m.Interval.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CaCert", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burst", wireType)
 			}
-			var byteLen int
+			m.Burst = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeyserverconfig
@@ -4530,23 +7626,11 @@ func (m *EmailProofByClientCert) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				m.Burst |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthKeyserverconfig
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CaCert = append(m.CaCert[:0], data[iNdEx:postIndex]...)
-			if m.CaCert == nil {
-				m.CaCert = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKeyserverconfig(data[iNdEx:])
@@ -4568,7 +7652,7 @@ func (m *EmailProofByClientCert) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *EmailProofByOIDC) Unmarshal(data []byte) error {
+func (m *SAMLConfig) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4591,17 +7675,17 @@ func (m *EmailProofByOIDC) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EmailProofByOIDC: wiretype end group for non-group")
+			return fmt.Errorf("proto: SAMLConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EmailProofByOIDC: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SAMLConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OIDCConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDomains", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeyserverconfig
@@ -4611,22 +7695,49 @@ func (m *EmailProofByOIDC) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthKeyserverconfig
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OIDCConfig = append(m.OIDCConfig, &OIDCConfig{})
-			if err := m.OIDCConfig[len(m.OIDCConfig)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
+			m.AllowedDomains = append(m.AllowedDomains, string(data[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IDPMetadataURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyserverconfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeyserverconfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.IDPMetadataURL = string(data[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4649,7 +7760,7 @@ func (m *EmailProofByOIDC) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *EmailProofBySAML) Unmarshal(data []byte) error {
+func (m *EmailProofByExternalVerifier) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4672,15 +7783,15 @@ func (m *EmailProofBySAML) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EmailProofBySAML: wiretype end group for non-group")
+			return fmt.Errorf("proto: EmailProofByExternalVerifier: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EmailProofBySAML: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EmailProofByExternalVerifier: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDomains", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4705,40 +7816,11 @@ func (m *EmailProofBySAML) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AllowedDomains = append(m.AllowedDomains, string(data[iNdEx:postIndex]))
+			m.Type = string(data[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IDPMetadataURL", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeyserverconfig
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthKeyserverconfig
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.IDPMetadataURL = string(data[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerServiceURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDomains", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4763,67 +7845,7 @@ func (m *EmailProofBySAML) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsumerServiceURL = string(data[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceProviderTLS", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeyserverconfig
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthKeyserverconfig
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ServiceProviderTLS.Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validity", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeyserverconfig
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthKeyserverconfig
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Validity.Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AllowedDomains = append(m.AllowedDomains, string(data[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5050,6 +8072,65 @@ func (m *OIDCConfig) Unmarshal(data []byte) error {
 			}
 			m.Scope = string(data[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyRefreshInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyserverconfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeyserverconfig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.KeyRefreshInterval.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientSecret", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyserverconfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeyserverconfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientSecret = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKeyserverconfig(data[iNdEx:])
@@ -5308,92 +8389,132 @@ var (
 func init() { proto1.RegisterFile("keyserverconfig.proto", fileDescriptorKeyserverconfig) }
 
 var fileDescriptorKeyserverconfig = []byte{
-	// 1386 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0x4b, 0x6f, 0x1b, 0xd5,
-	0x17, 0xcf, 0xe4, 0xe1, 0xc7, 0xf1, 0x33, 0x37, 0x69, 0xea, 0xe6, 0xaf, 0xbf, 0x1d, 0x19, 0x01,
-	0x01, 0xa1, 0x96, 0x06, 0x81, 0x5a, 0xd1, 0x4d, 0x27, 0x6e, 0x65, 0xe3, 0x44, 0xb5, 0xc6, 0xa1,
-	0x0b, 0x90, 0x3a, 0xba, 0x9e, 0xb9, 0xb6, 0x2f, 0x1e, 0xcf, 0x0c, 0x77, 0xae, 0x4d, 0x2d, 0x36,
-	0x7c, 0x1c, 0x3e, 0x02, 0x3b, 0x58, 0x76, 0xd9, 0x15, 0x62, 0x83, 0xd5, 0xcc, 0x8a, 0x65, 0x77,
-	0xb0, 0x44, 0xf7, 0xe1, 0x49, 0xe2, 0x3c, 0x54, 0x56, 0xf6, 0x79, 0xfd, 0x7e, 0xe7, 0x9e, 0x39,
-	0xe7, 0xde, 0x03, 0xb7, 0x46, 0x64, 0x16, 0x11, 0x36, 0x25, 0xcc, 0x09, 0xfc, 0x3e, 0x1d, 0xdc,
-	0x0d, 0x59, 0xc0, 0x03, 0xb4, 0x21, 0x7f, 0x76, 0x3f, 0x1d, 0x50, 0x3e, 0x9c, 0xf4, 0xee, 0x3a,
-	0xc1, 0xf8, 0xde, 0x18, 0xbb, 0x94, 0xcf, 0xf0, 0x3d, 0x69, 0xe9, 0x4d, 0xfa, 0xf7, 0x06, 0xc1,
-	0x20, 0x90, 0x82, 0xfc, 0xa7, 0x02, 0x77, 0x4b, 0xdc, 0x8b, 0xce, 0x23, 0xed, 0x16, 0xdd, 0x09,
-	0xc3, 0x9c, 0x06, 0xbe, 0x96, 0xf3, 0x8e, 0x47, 0x89, 0xcf, 0x95, 0x54, 0xff, 0x35, 0x0d, 0x05,
-	0x8b, 0x84, 0x1e, 0x75, 0xf0, 0xa1, 0x8c, 0x42, 0x6d, 0x28, 0x27, 0x29, 0xd9, 0x0a, 0xa9, 0x62,
-	0xec, 0x19, 0xfb, 0xb9, 0x83, 0x1d, 0x15, 0x73, 0xb7, 0xbd, 0x30, 0xab, 0x08, 0x33, 0xf3, 0x6a,
-	0x5e, 0x5b, 0x79, 0x3d, 0xaf, 0x19, 0x56, 0x69, 0x74, 0xd1, 0x84, 0x3e, 0x01, 0x60, 0x0a, 0xdd,
-	0xa6, 0x6e, 0x65, 0x75, 0xcf, 0xd8, 0x5f, 0x37, 0x0b, 0xf1, 0xbc, 0x96, 0xd5, 0x9c, 0xad, 0x86,
-	0x95, 0xd5, 0x0e, 0x2d, 0x17, 0x7d, 0x01, 0xc5, 0x88, 0x0e, 0x7c, 0xea, 0x0f, 0xec, 0x11, 0x99,
-	0x89, 0x88, 0xb5, 0x3d, 0x63, 0x3f, 0x6b, 0x96, 0xe3, 0x79, 0x2d, 0xdf, 0x55, 0x96, 0x36, 0x99,
-	0xb5, 0x1a, 0x56, 0x3e, 0x3a, 0x93, 0x5c, 0x54, 0x83, 0x5c, 0x38, 0xe9, 0x79, 0xd4, 0xb1, 0xb1,
-	0xeb, 0xb2, 0xca, 0xba, 0x08, 0xb2, 0x40, 0xa9, 0x1e, 0xbb, 0x2e, 0x43, 0x26, 0x68, 0xc9, 0xe6,
-	0x5e, 0x54, 0xd9, 0x90, 0xa7, 0x29, 0xeb, 0xd3, 0x9c, 0x1c, 0x75, 0xf5, 0x39, 0x36, 0xc5, 0x39,
-	0x44, 0x72, 0x1d, 0xe9, 0x7b, 0x72, 0xd4, 0xb5, 0xb2, 0x2a, 0xec, 0xc4, 0x8b, 0xd0, 0x7b, 0x50,
-	0x98, 0x12, 0x46, 0xfb, 0x94, 0x30, 0x45, 0x93, 0x92, 0x34, 0xf9, 0x85, 0x52, 0x12, 0x35, 0x21,
-	0x91, 0x25, 0x55, 0xfa, 0x1a, 0xaa, 0x2d, 0x4d, 0x95, 0x7b, 0xae, 0xbd, 0x05, 0x59, 0x6e, 0x11,
-	0x2a, 0xe8, 0x3e, 0x80, 0xcc, 0x70, 0x14, 0x2a, 0xa6, 0x8c, 0xac, 0x42, 0x2e, 0x9e, 0xd7, 0xd2,
-	0xcd, 0x76, 0x47, 0x10, 0x59, 0xe9, 0xe1, 0x28, 0x94, 0x8c, 0x0f, 0x41, 0xfc, 0x95, 0x64, 0xd9,
-	0x6b, 0xc8, 0x8a, 0x9a, 0x2c, 0xd5, 0x6c, 0x77, 0x04, 0x4f, 0x6a, 0x38, 0x0a, 0x05, 0xc5, 0x03,
-	0x28, 0x0e, 0x39, 0x0f, 0xfb, 0x2c, 0xf0, 0xb9, 0x22, 0x02, 0x49, 0xb4, 0x19, 0xcf, 0x6b, 0x85,
-	0xe6, 0xc9, 0x49, 0xe7, 0xa9, 0xb0, 0x48, 0xba, 0x42, 0xe2, 0x28, 0x49, 0xdb, 0x70, 0xa6, 0x90,
-	0xd4, 0xb9, 0x6b, 0xa8, 0xb7, 0x35, 0x75, 0x3e, 0x81, 0x13, 0x09, 0xe4, 0x93, 0x60, 0x91, 0xc6,
-	0xff, 0x20, 0xcb, 0x70, 0x5f, 0x67, 0x90, 0x97, 0x45, 0xcd, 0x08, 0x85, 0x64, 0x7a, 0x04, 0xf2,
-	0xbf, 0x24, 0x29, 0x5c, 0x43, 0x52, 0xd2, 0x24, 0x69, 0x0b, 0xf7, 0x25, 0x7e, 0x5a, 0x84, 0x08,
-	0xe8, 0x03, 0xc8, 0x7b, 0x64, 0x4a, 0x3c, 0xb7, 0x67, 0x87, 0x98, 0x0f, 0x2b, 0x45, 0x79, 0xbe,
-	0x92, 0x28, 0xfc, 0x91, 0xd0, 0x37, 0xcc, 0x0e, 0xe6, 0x43, 0x2b, 0xa7, 0x9d, 0x84, 0x80, 0x1e,
-	0x41, 0x51, 0x32, 0x0e, 0x09, 0x66, 0xbc, 0x47, 0x30, 0xaf, 0x94, 0x24, 0x6f, 0x49, 0xf3, 0x36,
-	0xf4, 0x38, 0x99, 0xeb, 0x82, 0xd6, 0x2a, 0x08, 0xe7, 0xe6, 0xc2, 0x17, 0x1d, 0xc0, 0x2d, 0x0f,
-	0x0f, 0x06, 0xa2, 0x85, 0x93, 0x46, 0x88, 0x1c, 0xec, 0x57, 0xca, 0xa2, 0xf7, 0xad, 0x2d, 0x6d,
-	0x5c, 0x7c, 0xf6, 0xae, 0x83, 0x7d, 0xc1, 0xa8, 0x66, 0xd2, 0xe6, 0x74, 0x4c, 0x82, 0x09, 0xaf,
-	0x6c, 0xde, 0xc8, 0xa8, 0x9c, 0x4f, 0x94, 0x6f, 0x7d, 0xbe, 0x06, 0xa5, 0xa5, 0x89, 0x44, 0x1f,
-	0x41, 0x56, 0x0f, 0x30, 0x75, 0xe5, 0xf0, 0xae, 0x9b, 0xf9, 0x78, 0x5e, 0xcb, 0x74, 0xa5, 0xb2,
-	0xd5, 0xb0, 0x32, 0xca, 0xdc, 0x72, 0xd1, 0x36, 0x6c, 0x30, 0x82, 0xbd, 0xb1, 0x1c, 0xce, 0xac,
-	0xa5, 0x04, 0xf4, 0x31, 0xc0, 0x94, 0xf5, 0x2f, 0x4e, 0xa1, 0x44, 0x78, 0x6e, 0x3d, 0x55, 0x13,
-	0x98, 0x99, 0xb2, 0xbe, 0x9a, 0xbe, 0x43, 0x40, 0x63, 0xea, 0xdb, 0x24, 0x0c, 0x9c, 0xa1, 0x4d,
-	0x7d, 0x4e, 0xd8, 0x14, 0x7b, 0x72, 0x08, 0xaf, 0x3d, 0x42, 0x79, 0x4c, 0xfd, 0x27, 0xc2, 0xbf,
-	0xa5, 0xdd, 0x25, 0x08, 0x7e, 0xb9, 0x0c, 0xb2, 0x71, 0x33, 0x08, 0x7e, 0x79, 0x11, 0xe4, 0x18,
-	0x6e, 0x87, 0x2c, 0x08, 0x83, 0x08, 0x7b, 0x36, 0x23, 0x9c, 0xcd, 0xce, 0x90, 0x52, 0x37, 0x21,
-	0xdd, 0x5a, 0x44, 0x59, 0x22, 0x28, 0x81, 0x7b, 0x08, 0x65, 0xea, 0x53, 0x4e, 0x25, 0x9a, 0xbc,
-	0xa3, 0xc4, 0x40, 0xaf, 0xed, 0xe7, 0x0e, 0x8a, 0x1a, 0x47, 0xdf, 0x62, 0x56, 0x49, 0xfb, 0x69,
-	0x39, 0x42, 0x5f, 0xc1, 0x16, 0x23, 0x03, 0x1a, 0x71, 0xc5, 0x63, 0x87, 0x81, 0x47, 0x9d, 0x59,
-	0x25, 0x23, 0xa3, 0xef, 0x24, 0xd1, 0x67, 0x1e, 0x1d, 0xe9, 0x60, 0x21, 0x76, 0x49, 0x57, 0xff,
-	0x73, 0x0d, 0xd0, 0x65, 0x57, 0xf4, 0x25, 0xdc, 0xa1, 0x7e, 0x44, 0x9c, 0x09, 0x23, 0x76, 0x34,
-	0xa2, 0xa1, 0x4d, 0xc6, 0x98, 0x7a, 0x76, 0xc8, 0x82, 0xa0, 0x2f, 0xbf, 0x79, 0xa6, 0xb9, 0x62,
-	0xed, 0x2c, 0x5c, 0xba, 0x23, 0x1a, 0x3e, 0x11, 0x0e, 0x1d, 0x61, 0x47, 0x2f, 0x60, 0xeb, 0x9c,
-	0xbb, 0xdd, 0x9b, 0xd9, 0xee, 0x88, 0xaa, 0x1e, 0xc8, 0x1d, 0xdc, 0xd6, 0xf9, 0x9d, 0xf9, 0x9b,
-	0xb3, 0x46, 0xbb, 0x75, 0x6c, 0x6e, 0xc7, 0xf3, 0x5a, 0x79, 0x59, 0xdb, 0x5c, 0xb1, 0xca, 0xe4,
-	0xbc, 0x6e, 0x44, 0xc7, 0xe8, 0x5b, 0xd8, 0x5d, 0xc2, 0xd7, 0x1d, 0xee, 0x10, 0xc6, 0x65, 0x3f,
-	0xe5, 0x0e, 0xfe, 0x7f, 0x05, 0xcd, 0xa1, 0xf4, 0x3a, 0x24, 0x8c, 0x8b, 0xe4, 0xc9, 0x95, 0x96,
-	0x2b, 0x92, 0x0f, 0xa8, 0xeb, 0xe8, 0x8e, 0xbb, 0x2a, 0xf9, 0x67, 0xad, 0xc6, 0xe1, 0xe5, 0xe4,
-	0x85, 0x76, 0x39, 0xf9, 0x67, 0xd4, 0x75, 0xae, 0xc0, 0x8f, 0xf0, 0x78, 0xd1, 0x8c, 0x57, 0xe1,
-	0x77, 0x1f, 0x1f, 0x1f, 0x5d, 0xc6, 0x17, 0xda, 0x65, 0xfc, 0x2e, 0x1e, 0x7b, 0x66, 0x01, 0x72,
-	0xaa, 0x1f, 0x6c, 0x3e, 0x0b, 0x49, 0xfd, 0x47, 0xb8, 0x54, 0x53, 0xf4, 0x21, 0x94, 0xb0, 0xe7,
-	0x05, 0x3f, 0x10, 0xd7, 0x76, 0x83, 0x31, 0xa6, 0x7e, 0x54, 0x31, 0xf6, 0xd6, 0xf6, 0xb3, 0x56,
-	0x51, 0xab, 0x1b, 0x4a, 0x8b, 0x6e, 0x43, 0x9a, 0x07, 0xea, 0xea, 0x54, 0x03, 0x9c, 0xe2, 0x81,
-	0xbc, 0x38, 0xdf, 0x87, 0x62, 0x34, 0xe9, 0x7d, 0x47, 0x1c, 0x6e, 0x87, 0x8c, 0xf4, 0xe9, 0x4b,
-	0x35, 0xc5, 0x56, 0x41, 0x6b, 0x3b, 0x52, 0x59, 0xff, 0x06, 0x76, 0xae, 0xae, 0xff, 0x7f, 0x4a,
-	0xc1, 0xc1, 0xea, 0xc3, 0x8a, 0x14, 0xf2, 0x56, 0xca, 0xc1, 0x02, 0xa1, 0xfe, 0x1c, 0x2e, 0xd5,
-	0x1b, 0x99, 0x90, 0x13, 0x1f, 0xeb, 0x6c, 0xb1, 0x10, 0x03, 0xb1, 0xa9, 0x6b, 0x2a, 0x3c, 0x16,
-	0x6f, 0x56, 0x3c, 0xaf, 0xc1, 0x99, 0x6c, 0x81, 0x88, 0x52, 0xff, 0xeb, 0xbf, 0xaf, 0xc2, 0xa5,
-	0x42, 0xbf, 0x7b, 0xba, 0x8f, 0xa0, 0x4c, 0xdd, 0xd0, 0x1e, 0x13, 0x8e, 0x5d, 0xcc, 0xb1, 0x3d,
-	0x61, 0x9e, 0x2a, 0x9d, 0x89, 0xe2, 0x79, 0xad, 0xd8, 0x6a, 0x74, 0x8e, 0xb5, 0xe9, 0x6b, 0xeb,
-	0xc8, 0x2a, 0x52, 0x37, 0x4c, 0x64, 0xe6, 0xa1, 0x26, 0x6c, 0x3b, 0x81, 0x1f, 0x4d, 0xc6, 0xe2,
-	0x5e, 0x27, 0x6c, 0x4a, 0x1d, 0x22, 0x11, 0xe4, 0xce, 0x61, 0xee, 0xc4, 0xf3, 0x1a, 0x3a, 0xd4,
-	0xf6, 0xae, 0x32, 0x0b, 0x14, 0xe4, 0x2c, 0xe9, 0x98, 0x87, 0x5e, 0xc0, 0xf6, 0x02, 0x20, 0x64,
-	0xc1, 0x94, 0xba, 0x7a, 0x65, 0xb8, 0x6e, 0x3b, 0xd9, 0xd5, 0xaf, 0x1c, 0xd2, 0x18, 0x1d, 0x1d,
-	0x24, 0x1e, 0x3c, 0x14, 0x2d, 0xe9, 0xbc, 0x08, 0xdd, 0x87, 0xcc, 0x14, 0x7b, 0x54, 0xec, 0x8c,
-	0x37, 0xdf, 0x7e, 0x89, 0x5b, 0xfd, 0x6f, 0x03, 0xce, 0xd5, 0xfc, 0xdd, 0x4b, 0xfa, 0x39, 0x14,
-	0x5c, 0x1a, 0x39, 0xc1, 0x94, 0xb0, 0xd9, 0xb9, 0x7a, 0xca, 0xb5, 0xad, 0xb1, 0x30, 0x88, 0x3a,
-	0xe4, 0x13, 0x37, 0x51, 0x81, 0x1d, 0x48, 0xd1, 0x28, 0x9a, 0x10, 0xa6, 0x5b, 0x53, 0x4b, 0x68,
-	0x1f, 0x32, 0xea, 0xb6, 0x68, 0x35, 0x74, 0x5d, 0xe5, 0xd3, 0x73, 0xa8, 0x75, 0x56, 0x62, 0xbd,
-	0x70, 0xc6, 0x8d, 0x77, 0x3a, 0xa3, 0x78, 0xef, 0x22, 0x27, 0x08, 0x89, 0x5e, 0xdf, 0x94, 0x50,
-	0xff, 0x1e, 0xd2, 0xfa, 0xee, 0x46, 0x3b, 0xb0, 0x9a, 0x3c, 0x9a, 0xa9, 0x78, 0x5e, 0x5b, 0x6d,
-	0x35, 0xac, 0x55, 0xea, 0xa2, 0xfb, 0xc9, 0x92, 0x29, 0x96, 0xdc, 0xca, 0xaa, 0xec, 0xdc, 0xc5,
-	0x67, 0x52, 0x1b, 0x63, 0x9b, 0xcc, 0x16, 0x6b, 0xa7, 0x78, 0x91, 0x2f, 0x6e, 0x36, 0x6b, 0x17,
-	0x37, 0x1b, 0xf3, 0xc1, 0xeb, 0xd3, 0xea, 0xca, 0x1f, 0xa7, 0xd5, 0x95, 0x37, 0xa7, 0x55, 0xe3,
-	0xed, 0x69, 0xd5, 0xf8, 0xe7, 0xb4, 0x6a, 0xfc, 0x14, 0x57, 0x8d, 0x9f, 0xe3, 0xaa, 0xf1, 0x4b,
-	0x5c, 0x35, 0x7e, 0x8b, 0xab, 0xc6, 0xab, 0xb8, 0x6a, 0xbc, 0x8e, 0xab, 0xc6, 0x9b, 0xb8, 0x6a,
-	0xfc, 0x15, 0x57, 0x57, 0xde, 0xc6, 0x55, 0xa3, 0x97, 0x92, 0x9c, 0x9f, 0xfd, 0x1b, 0x00, 0x00,
-	0xff, 0xff, 0x00, 0x59, 0xd5, 0xc0, 0x3b, 0x0c, 0x00, 0x00,
+	// 2020 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcd, 0x6f, 0x23, 0x49,
+	0x15, 0x4f, 0xe7, 0xcb, 0xf6, 0xf3, 0x57, 0x52, 0xf9, 0x18, 0x4f, 0x18, 0xec, 0xc8, 0xc3, 0x47,
+	0x40, 0xab, 0x19, 0x26, 0x08, 0xd8, 0x85, 0xb9, 0x8c, 0xe3, 0x99, 0xb5, 0x49, 0x86, 0x35, 0xe5,
+	0x4c, 0x40, 0xac, 0xb4, 0xad, 0x4e, 0x77, 0xd9, 0x2e, 0xdc, 0xee, 0x6e, 0xaa, 0xcb, 0x26, 0x9e,
+	0x13, 0xff, 0x0c, 0x88, 0x23, 0x47, 0x8e, 0x1c, 0xf7, 0x38, 0xc7, 0x3d, 0x59, 0x9b, 0x96, 0x90,
+	0xb8, 0x20, 0xcd, 0x91, 0x23, 0xaa, 0x8f, 0x6e, 0x7f, 0xc4, 0xb1, 0x06, 0x0e, 0x7b, 0x72, 0xd7,
+	0xfb, 0xfa, 0xbd, 0x57, 0xf5, 0xde, 0xab, 0x57, 0x86, 0x83, 0x3e, 0x19, 0x87, 0x84, 0x8d, 0x08,
+	0xb3, 0x7d, 0xaf, 0x43, 0xbb, 0x4f, 0x02, 0xe6, 0x73, 0x1f, 0x6d, 0xc9, 0x9f, 0xa3, 0x1f, 0x75,
+	0x29, 0xef, 0x0d, 0xaf, 0x9f, 0xd8, 0xfe, 0xe0, 0xe9, 0xc0, 0x72, 0x28, 0x1f, 0x5b, 0x4f, 0x25,
+	0xe7, 0x7a, 0xd8, 0x79, 0xda, 0xf5, 0xbb, 0xbe, 0x5c, 0xc8, 0x2f, 0xa5, 0x78, 0x54, 0xe4, 0x6e,
+	0x38, 0x6b, 0xe9, 0xa8, 0xe0, 0x0c, 0x99, 0xc5, 0xa9, 0xef, 0xe9, 0x75, 0xce, 0x76, 0x29, 0xf1,
+	0xb8, 0x5a, 0x55, 0x6f, 0x33, 0x90, 0xc7, 0x24, 0x70, 0xa9, 0x6d, 0x9d, 0x49, 0x2d, 0x74, 0x0e,
+	0x3b, 0x89, 0x4b, 0xa6, 0xb2, 0x54, 0x32, 0x8e, 0x8d, 0x93, 0xec, 0xe9, 0xa1, 0xd2, 0x79, 0x72,
+	0x1e, 0xb3, 0x95, 0x46, 0x2d, 0xfd, 0xe5, 0xa4, 0xb2, 0xf6, 0x6e, 0x52, 0x31, 0x70, 0xb1, 0x3f,
+	0xcf, 0x42, 0x1f, 0x01, 0x30, 0x65, 0xdd, 0xa4, 0x4e, 0x69, 0xfd, 0xd8, 0x38, 0xd9, 0xac, 0xe5,
+	0xa3, 0x49, 0x25, 0xa3, 0x31, 0x9b, 0x75, 0x9c, 0xd1, 0x02, 0x4d, 0x07, 0xfd, 0x14, 0x0a, 0x21,
+	0xed, 0x7a, 0xd4, 0xeb, 0x9a, 0x7d, 0x32, 0x16, 0x1a, 0x1b, 0xc7, 0xc6, 0x49, 0xa6, 0xb6, 0x13,
+	0x4d, 0x2a, 0xb9, 0xb6, 0xe2, 0x9c, 0x93, 0x71, 0xb3, 0x8e, 0x73, 0xe1, 0x74, 0xe5, 0xa0, 0x0a,
+	0x64, 0x83, 0xe1, 0xb5, 0x4b, 0x6d, 0xd3, 0x72, 0x1c, 0x56, 0xda, 0x14, 0x4a, 0x18, 0x14, 0xe9,
+	0x85, 0xe3, 0x30, 0x54, 0x03, 0xbd, 0x32, 0xb9, 0x1b, 0x96, 0xb6, 0x64, 0x34, 0x3b, 0x3a, 0x9a,
+	0xcb, 0x8b, 0xb6, 0x8e, 0x63, 0x57, 0xc4, 0x21, 0x9c, 0x6b, 0x49, 0xd9, 0xcb, 0x8b, 0x36, 0xce,
+	0x28, 0xb5, 0x4b, 0x37, 0x44, 0x8f, 0x21, 0x3f, 0x22, 0x8c, 0x76, 0x28, 0x61, 0x0a, 0x66, 0x5b,
+	0xc2, 0xe4, 0x62, 0xa2, 0x04, 0x6a, 0x40, 0xb2, 0x96, 0x50, 0xa9, 0x7b, 0xa0, 0xf6, 0x34, 0x54,
+	0xf6, 0x4a, 0x4b, 0x0b, 0xb0, 0x6c, 0xac, 0x2a, 0xe0, 0xbe, 0x07, 0xe9, 0x5e, 0x3f, 0x50, 0x48,
+	0x69, 0xb9, 0x0b, 0xd9, 0x68, 0x52, 0x49, 0x35, 0xce, 0x5b, 0x02, 0x08, 0xa7, 0x7a, 0xfd, 0x40,
+	0x22, 0x7e, 0x02, 0xe2, 0x53, 0x82, 0x65, 0xee, 0x01, 0x2b, 0x68, 0xb0, 0xed, 0xc6, 0x79, 0x4b,
+	0xe0, 0x6c, 0xf7, 0xfa, 0x81, 0x80, 0xf8, 0x18, 0x0a, 0x3d, 0xce, 0x83, 0x0e, 0xf3, 0x3d, 0xae,
+	0x80, 0x40, 0x02, 0xed, 0x46, 0x93, 0x4a, 0xbe, 0x71, 0x79, 0xd9, 0x7a, 0x25, 0x38, 0x12, 0x2e,
+	0x9f, 0x08, 0x4a, 0xd0, 0x73, 0x98, 0x12, 0x24, 0x74, 0xf6, 0x1e, 0xe8, 0x7d, 0x0d, 0x9d, 0x4b,
+	0xcc, 0x09, 0x07, 0x72, 0x89, 0xb2, 0x70, 0xe3, 0x5b, 0x90, 0x61, 0x56, 0x47, 0x7b, 0x90, 0x93,
+	0x9b, 0x9a, 0x16, 0x04, 0x89, 0xf4, 0x1c, 0xe4, 0xb7, 0x04, 0xc9, 0xdf, 0x03, 0x52, 0xd4, 0x20,
+	0x29, 0x6c, 0x75, 0xa4, 0xfd, 0x94, 0x50, 0x11, 0xa6, 0x4f, 0x21, 0xe7, 0x92, 0x11, 0x71, 0x9d,
+	0x6b, 0x33, 0xb0, 0x78, 0xaf, 0x54, 0x90, 0xf1, 0x15, 0xc5, 0xc6, 0x5f, 0x08, 0x7a, 0xbd, 0xd6,
+	0xb2, 0x78, 0x0f, 0x67, 0xb5, 0x90, 0x58, 0xa0, 0xe7, 0x50, 0x90, 0x88, 0x3d, 0x62, 0x31, 0x7e,
+	0x4d, 0x2c, 0x5e, 0x2a, 0x4a, 0xdc, 0xa2, 0xc6, 0xad, 0xeb, 0x72, 0xaa, 0x6d, 0x0a, 0x58, 0x9c,
+	0x17, 0xc2, 0x8d, 0x58, 0x16, 0x9d, 0xc2, 0x81, 0x6b, 0x75, 0xbb, 0x22, 0x85, 0x93, 0x44, 0x08,
+	0x6d, 0xcb, 0x2b, 0xed, 0x88, 0xdc, 0xc7, 0x7b, 0x9a, 0x19, 0x1f, 0x7b, 0xdb, 0xb6, 0x3c, 0x81,
+	0xa8, 0x6a, 0xd2, 0xe4, 0x74, 0x40, 0xfc, 0x21, 0x2f, 0xed, 0xae, 0x44, 0x54, 0xc2, 0x97, 0x4a,
+	0x16, 0xfd, 0x00, 0x32, 0xe1, 0x80, 0xeb, 0x4c, 0x41, 0x32, 0xc0, 0x5c, 0x34, 0xa9, 0xa4, 0xdb,
+	0xaf, 0x2f, 0x55, 0xaa, 0xa4, 0x05, 0x5b, 0x6e, 0xe6, 0xa7, 0xb0, 0x63, 0xd9, 0xb6, 0x3f, 0xf4,
+	0xb8, 0xc9, 0x88, 0xed, 0x8f, 0x08, 0x1b, 0x97, 0xf6, 0x24, 0xd4, 0x23, 0x0d, 0xf5, 0x42, 0xb1,
+	0xb1, 0xe6, 0xaa, 0x0d, 0xc6, 0x45, 0x6b, 0x9e, 0x8c, 0x7e, 0x0b, 0xfb, 0xda, 0x63, 0x1a, 0x98,
+	0xcc, 0xe2, 0xc4, 0x74, 0xe9, 0x80, 0xf2, 0xd2, 0xfe, 0xdc, 0x09, 0x61, 0x8b, 0x93, 0x0b, 0x41,
+	0xaf, 0x1d, 0x44, 0x93, 0xca, 0xee, 0x99, 0xd4, 0x68, 0xb6, 0x12, 0x32, 0xde, 0x55, 0x46, 0x9a,
+	0x41, 0x42, 0x42, 0x18, 0xd0, 0x30, 0x24, 0xcc, 0xa4, 0xce, 0xac, 0xdd, 0x83, 0x7b, 0xec, 0xee,
+	0x45, 0x93, 0x4a, 0xf1, 0x4d, 0x48, 0x58, 0xb3, 0x3e, 0xb5, 0x5a, 0x14, 0x06, 0x9a, 0x4e, 0x42,
+	0xa8, 0xfe, 0x25, 0x05, 0xc5, 0x85, 0x9e, 0x25, 0x77, 0x4d, 0xae, 0x45, 0x97, 0x31, 0x64, 0x5f,
+	0x52, 0xbb, 0x26, 0x89, 0xcd, 0x3a, 0x4e, 0x2b, 0x76, 0xd3, 0x41, 0xfb, 0xb0, 0xc5, 0x88, 0xe5,
+	0x0e, 0x64, 0xfb, 0xca, 0x60, 0xb5, 0x40, 0x3f, 0x04, 0x18, 0xb1, 0xce, 0x7c, 0x9f, 0x92, 0x16,
+	0xae, 0xf0, 0x2b, 0xd5, 0xa3, 0xd2, 0x23, 0xd6, 0x51, 0xfd, 0xe9, 0x67, 0x50, 0xf4, 0xc8, 0x0d,
+	0x37, 0x67, 0x14, 0x0a, 0xd3, 0xc6, 0xf6, 0x2b, 0x72, 0xc3, 0x13, 0xa5, 0x9c, 0x10, 0xbc, 0x8a,
+	0x15, 0x7f, 0x0e, 0x19, 0xa1, 0x13, 0x0e, 0x29, 0x27, 0x32, 0x0d, 0x0b, 0x49, 0x52, 0x5c, 0xe1,
+	0x57, 0x6d, 0x41, 0x4e, 0x40, 0xe5, 0x4a, 0x82, 0xca, 0x2f, 0x74, 0x06, 0x68, 0x40, 0x3d, 0x93,
+	0x04, 0xbe, 0xdd, 0x33, 0xa9, 0xc7, 0x09, 0x1b, 0x59, 0x6e, 0x69, 0x73, 0x55, 0x66, 0xed, 0x0c,
+	0xa8, 0xf7, 0x52, 0xc8, 0x37, 0xb5, 0xb8, 0x34, 0x62, 0xdd, 0x2c, 0x1a, 0xd9, 0x5a, 0x6d, 0xc4,
+	0xba, 0x99, 0x37, 0xf2, 0x1a, 0x1e, 0x04, 0xcc, 0x0f, 0xfc, 0xd0, 0x72, 0x4d, 0x46, 0x38, 0x1b,
+	0x4f, 0x2d, 0x6d, 0xaf, 0xb2, 0x74, 0x10, 0x6b, 0x61, 0xa1, 0x94, 0x98, 0xfb, 0x04, 0x76, 0xa8,
+	0x47, 0x39, 0x95, 0xd6, 0xe4, 0xd5, 0x21, 0xfa, 0xec, 0xc6, 0x49, 0xf6, 0xb4, 0x10, 0x27, 0x88,
+	0x22, 0xe3, 0xa2, 0x96, 0xd3, 0xeb, 0x10, 0xfd, 0x12, 0xf6, 0x18, 0xe9, 0xd2, 0x90, 0x2b, 0x1c,
+	0x33, 0xf0, 0x5d, 0x6a, 0x8f, 0x4b, 0x69, 0xa9, 0xfd, 0x30, 0xd1, 0x9e, 0x4a, 0xb4, 0xa4, 0x00,
+	0x46, 0xec, 0x0e, 0x0d, 0x3d, 0x11, 0xb6, 0x3a, 0x8c, 0x84, 0x3d, 0x93, 0x3a, 0x2e, 0x51, 0x7b,
+	0xa4, 0x9a, 0x70, 0x1a, 0xef, 0x6a, 0x56, 0xd3, 0x71, 0x89, 0xdc, 0x8c, 0x10, 0x9d, 0x41, 0xd1,
+	0x21, 0x2e, 0x99, 0xc5, 0x05, 0x19, 0xfd, 0x51, 0x5c, 0x7b, 0x43, 0xde, 0xf3, 0x19, 0x7d, 0x3b,
+	0x0b, 0x5c, 0x88, 0x55, 0x34, 0xe8, 0x05, 0x1c, 0x38, 0xfe, 0xc0, 0xa2, 0x9e, 0x69, 0x39, 0x03,
+	0xaa, 0x0d, 0x51, 0x22, 0x1a, 0xb0, 0x08, 0xa1, 0x14, 0x6f, 0xa4, 0x94, 0x79, 0x21, 0x44, 0xb4,
+	0xa1, 0x3d, 0x67, 0x81, 0x44, 0x89, 0xd8, 0x8e, 0xea, 0x6c, 0x60, 0xa1, 0x19, 0x10, 0x66, 0x6a,
+	0xfb, 0xe2, 0x53, 0x86, 0x24, 0x5b, 0xf2, 0x26, 0x2e, 0xcf, 0x49, 0xb6, 0x08, 0x53, 0x18, 0x2d,
+	0xc2, 0x64, 0x7c, 0xa8, 0x06, 0xfb, 0x49, 0xc3, 0xd3, 0x77, 0xad, 0x18, 0x06, 0x4a, 0xf9, 0xe3,
+	0x8d, 0x99, 0xd2, 0x55, 0x37, 0xeb, 0x39, 0x19, 0x63, 0x14, 0x4b, 0x27, 0xa4, 0xb0, 0xfa, 0xe7,
+	0x2d, 0x40, 0x77, 0x77, 0x1f, 0xfd, 0x02, 0x1e, 0x52, 0x2f, 0x24, 0xf6, 0x90, 0x11, 0x33, 0xec,
+	0xd3, 0xc0, 0x24, 0x03, 0x8b, 0xba, 0x66, 0xc0, 0x7c, 0xbf, 0x23, 0x6b, 0x37, 0xdd, 0x58, 0xc3,
+	0x87, 0xb1, 0x48, 0xbb, 0x4f, 0x83, 0x97, 0x42, 0xa0, 0x25, 0xf8, 0xe8, 0x0b, 0xd8, 0x9b, 0x11,
+	0x37, 0xaf, 0xc7, 0xa6, 0xd3, 0xa7, 0xaa, 0x96, 0xb3, 0xa7, 0x0f, 0xb4, 0x5b, 0x53, 0xf9, 0xda,
+	0xb8, 0x7e, 0xde, 0x7c, 0x5d, 0xdb, 0x8f, 0x26, 0x95, 0x9d, 0x45, 0x6a, 0x63, 0x0d, 0xef, 0x90,
+	0x59, 0x5a, 0x9f, 0x0e, 0xd0, 0xe7, 0x70, 0xb4, 0x60, 0x5f, 0x77, 0x46, 0x9b, 0x30, 0x2e, 0xfb,
+	0x42, 0xf6, 0xf4, 0xdb, 0x4b, 0x60, 0x54, 0x37, 0x3c, 0x23, 0x8c, 0x0b, 0xe7, 0xc9, 0x52, 0xce,
+	0x12, 0xe7, 0x7d, 0xea, 0xd8, 0xa5, 0xcd, 0x7b, 0x9d, 0xff, 0xac, 0x59, 0x3f, 0xbb, 0xeb, 0xbc,
+	0xa0, 0x2e, 0x3a, 0xff, 0x19, 0x75, 0xec, 0x25, 0xf6, 0x43, 0x6b, 0x10, 0xd7, 0xf7, 0x32, 0xfb,
+	0xed, 0x17, 0xaf, 0x2f, 0xee, 0xda, 0x17, 0xd4, 0x45, 0xfb, 0x6d, 0x6b, 0xe0, 0xa2, 0xdf, 0x40,
+	0x69, 0x71, 0x73, 0x7a, 0x96, 0xeb, 0x12, 0xaf, 0x4b, 0x4a, 0xdb, 0x73, 0x17, 0xcf, 0xdc, 0xd6,
+	0xc4, 0x32, 0x8d, 0x35, 0x7c, 0x40, 0x96, 0x31, 0xd0, 0x00, 0x8e, 0x17, 0x0c, 0x93, 0x1b, 0x4e,
+	0x98, 0x67, 0xb9, 0xc9, 0xb5, 0xab, 0x67, 0xaf, 0xc7, 0x4b, 0x00, 0x5e, 0x6a, 0xd9, 0xf8, 0x16,
+	0x6e, 0xac, 0xe1, 0x47, 0x64, 0x05, 0xbf, 0x96, 0x87, 0xac, 0x2a, 0x59, 0x93, 0x8f, 0x03, 0x52,
+	0x7d, 0x6f, 0xc0, 0x9d, 0xe4, 0x40, 0xdf, 0x87, 0xa2, 0xe5, 0xba, 0xfe, 0x1f, 0x89, 0xa3, 0x4b,
+	0x28, 0x2c, 0x19, 0xc7, 0x1b, 0x27, 0x19, 0x5c, 0xd0, 0x64, 0x55, 0x30, 0x21, 0x7a, 0x00, 0x29,
+	0xee, 0xab, 0xeb, 0x5a, 0xdd, 0x28, 0xdb, 0xdc, 0x97, 0xd7, 0xf3, 0x77, 0xa1, 0x10, 0x0e, 0xaf,
+	0x7f, 0x4f, 0x6c, 0x6e, 0x06, 0x8c, 0x74, 0xe8, 0x8d, 0xba, 0x56, 0x70, 0x5e, 0x53, 0x5b, 0x92,
+	0x28, 0xdb, 0x29, 0xf1, 0x1c, 0x31, 0x62, 0x0c, 0x03, 0x47, 0x5c, 0x92, 0x23, 0xcb, 0xa5, 0xe2,
+	0x6d, 0xb0, 0xba, 0xbb, 0x1f, 0x68, 0xad, 0x37, 0x52, 0xe9, 0x4a, 0xeb, 0xa0, 0xef, 0x40, 0x41,
+	0xb6, 0x78, 0xb9, 0x9d, 0x21, 0x7d, 0x4b, 0xe4, 0xf1, 0xe7, 0x71, 0x4e, 0xf4, 0x71, 0x41, 0x6c,
+	0xd3, 0xb7, 0xa4, 0xfa, 0x3b, 0x38, 0x5c, 0x9e, 0xbd, 0xff, 0x53, 0xdc, 0xb6, 0xa5, 0xca, 0x42,
+	0xc4, 0x9d, 0xc3, 0xdb, 0xb6, 0x25, 0x2c, 0x54, 0xaf, 0xe0, 0x4e, 0xb6, 0xa2, 0x1a, 0x64, 0x45,
+	0xaa, 0x4f, 0x1f, 0x20, 0xa2, 0x8b, 0xec, 0xea, 0xc0, 0x84, 0x44, 0x3c, 0xdb, 0x46, 0x93, 0x0a,
+	0x4c, 0xd7, 0x18, 0x84, 0x96, 0xfa, 0xae, 0xfe, 0x7b, 0x03, 0xee, 0xa4, 0xe9, 0x87, 0xbb, 0xfb,
+	0x1c, 0x76, 0xa8, 0x13, 0x98, 0x03, 0xc2, 0x2d, 0xc7, 0xe2, 0x96, 0x39, 0x64, 0xae, 0x3a, 0xaf,
+	0x1a, 0x8a, 0x26, 0x95, 0x42, 0xb3, 0xde, 0x7a, 0xad, 0x59, 0x6f, 0xf0, 0x05, 0x2e, 0x50, 0x27,
+	0x48, 0xd6, 0xcc, 0x15, 0xfe, 0x8b, 0x52, 0x8a, 0xfd, 0x4f, 0xcd, 0xf9, 0x2f, 0x1c, 0x99, 0xf5,
+	0x7f, 0xba, 0xc6, 0x20, 0xb4, 0xd4, 0x37, 0xfa, 0x35, 0x3c, 0x4c, 0xd0, 0x93, 0xab, 0x26, 0xbe,
+	0x39, 0xd3, 0xab, 0x8e, 0xfa, 0x41, 0xac, 0x87, 0xf5, 0x35, 0x14, 0xdf, 0x9d, 0x0d, 0xd8, 0xb7,
+	0x7d, 0x2f, 0x1c, 0x0e, 0xc4, 0x58, 0x4a, 0xd8, 0x88, 0xda, 0x44, 0x06, 0x26, 0x9f, 0x4c, 0xb5,
+	0xc3, 0x68, 0x52, 0x41, 0x67, 0x9a, 0xdf, 0x56, 0x6c, 0x11, 0x1c, 0xb2, 0x17, 0x68, 0xcc, 0x45,
+	0x5f, 0xc0, 0x7e, 0x6c, 0x20, 0x60, 0xfe, 0x88, 0x3a, 0xfa, 0xc5, 0x73, 0xdf, 0xe3, 0xea, 0x48,
+	0x0f, 0xe9, 0x48, 0xdb, 0x68, 0x69, 0x25, 0x31, 0xaf, 0xa3, 0x70, 0x81, 0xe6, 0x86, 0xe8, 0x19,
+	0xa4, 0x93, 0xb4, 0x5e, 0x39, 0x25, 0x24, 0x62, 0xd5, 0xaf, 0x0c, 0x38, 0x58, 0xda, 0x47, 0x3e,
+	0xfc, 0xd0, 0x3f, 0x02, 0x90, 0xc3, 0x34, 0x23, 0xae, 0x35, 0xd6, 0xc7, 0x2d, 0xdf, 0xab, 0x62,
+	0x9a, 0xc6, 0x82, 0x88, 0xe5, 0xb4, 0x2d, 0x3f, 0xc5, 0xcb, 0xa5, 0xc3, 0xfc, 0x81, 0xaa, 0x65,
+	0x55, 0xab, 0x69, 0x41, 0x90, 0xd5, 0x5c, 0x82, 0x94, 0xae, 0x5b, 0xfd, 0x20, 0x8d, 0x97, 0x73,
+	0xa1, 0x6d, 0x7d, 0x58, 0x68, 0x7f, 0x33, 0xe0, 0x60, 0xe9, 0x6c, 0x8e, 0x4e, 0x21, 0x23, 0x06,
+	0x01, 0x47, 0x3a, 0x6c, 0xac, 0xb4, 0x36, 0xa0, 0x5e, 0x5d, 0xfa, 0xfd, 0x4d, 0x44, 0x59, 0xbd,
+	0x84, 0xcc, 0x74, 0xac, 0x7f, 0x06, 0xe9, 0x24, 0x73, 0x57, 0x3b, 0x19, 0x8b, 0x89, 0xb1, 0xfb,
+	0x7a, 0xc8, 0x42, 0xd5, 0x2c, 0x36, 0xb1, 0x5a, 0x54, 0x43, 0x98, 0xa9, 0x96, 0x6f, 0xa8, 0x98,
+	0xab, 0x9f, 0xc3, 0xa3, 0x55, 0xd7, 0x07, 0x42, 0xb0, 0x29, 0xee, 0x05, 0x19, 0x59, 0x06, 0xcb,
+	0xef, 0x65, 0xae, 0xad, 0x2f, 0x73, 0xad, 0xfa, 0xcf, 0x75, 0x98, 0x69, 0x60, 0x1f, 0x1e, 0xd2,
+	0x4f, 0x20, 0xef, 0xd0, 0x50, 0xe5, 0xc2, 0x4c, 0x3c, 0xf2, 0x49, 0x51, 0x8f, 0x19, 0x22, 0x9a,
+	0x5c, 0x22, 0x26, 0xea, 0xf6, 0x10, 0xb6, 0x69, 0x18, 0x0e, 0x49, 0x7c, 0x94, 0x7a, 0x85, 0x4e,
+	0x20, 0xad, 0x5f, 0x63, 0x75, 0xdd, 0x0d, 0xe4, 0xc3, 0x42, 0x3f, 0xda, 0xea, 0x38, 0xe1, 0xfe,
+	0x1f, 0xe9, 0x2b, 0xce, 0x32, 0xb4, 0xfd, 0x80, 0xe8, 0xff, 0x4c, 0xd4, 0x02, 0x7d, 0x0a, 0xfb,
+	0xe2, 0x35, 0x74, 0xa7, 0xb5, 0xa5, 0x56, 0x19, 0x45, 0x7d, 0x32, 0x5e, 0xec, 0x6a, 0x8f, 0x41,
+	0xbf, 0x89, 0xcd, 0x90, 0xd8, 0x8c, 0x70, 0xf5, 0x87, 0x09, 0xd6, 0xff, 0x74, 0xb5, 0x25, 0xad,
+	0xfa, 0x07, 0x48, 0xe9, 0x77, 0x00, 0x3a, 0x84, 0xf5, 0xe4, 0xd5, 0xb7, 0x1d, 0x4d, 0x2a, 0xeb,
+	0xcd, 0x3a, 0x5e, 0xa7, 0x0e, 0x7a, 0x06, 0xd9, 0xd9, 0xd1, 0x75, 0xfd, 0x9e, 0xd1, 0x15, 0x82,
+	0x64, 0x64, 0x9d, 0xff, 0xf3, 0x62, 0x63, 0xfe, 0xcf, 0x8b, 0xda, 0xc7, 0xef, 0x6e, 0xcb, 0x6b,
+	0x5f, 0xdd, 0x96, 0xd7, 0xbe, 0xbe, 0x2d, 0x1b, 0xef, 0x6f, 0xcb, 0xc6, 0x7f, 0x6e, 0xcb, 0xc6,
+	0x9f, 0xa2, 0xb2, 0xf1, 0xd7, 0xa8, 0x6c, 0xfc, 0x3d, 0x2a, 0x1b, 0xff, 0x88, 0xca, 0xc6, 0x97,
+	0x51, 0xd9, 0x78, 0x17, 0x95, 0x8d, 0xaf, 0xa3, 0xb2, 0xf1, 0xaf, 0xa8, 0xbc, 0xf6, 0x3e, 0x2a,
+	0x1b, 0xd7, 0xdb, 0x12, 0xf3, 0xc7, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x1f, 0x59, 0x87, 0xea,
+	0x1e, 0x14, 0x00, 0x00,
 }
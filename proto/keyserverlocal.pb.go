@@ -37,6 +37,18 @@ type ReplicaState struct {
 	// local variables
 	LatestTreeSnapshot         uint64 `protobuf:"varint,7,opt,name=latest_tree_snapshot,json=latestTreeSnapshot,proto3" json:"latest_tree_snapshot,omitempty"`
 	LastEpochNeedsRatification bool   `protobuf:"varint,8,opt,name=last_epoch_needs_ratification,json=lastEpochNeedsRatification,proto3" json:"last_epoch_needs_ratification,omitempty"`
+	// timestamp of the last epoch_refresh of the last epoch, zero if the last
+	// epoch has not been refreshed
+	LastEpochRefresh Timestamp `protobuf:"bytes,9,opt,name=last_epoch_refresh,json=lastEpochRefresh" json:"last_epoch_refresh"`
+	// the last refresh has not been signed by the keyserver yet, so the last
+	// epoch must not be refreshed again
+	LastEpochRefreshNeedsRatification bool `protobuf:"varint,10,opt,name=last_epoch_refresh_needs_ratification,json=lastEpochRefreshNeedsRatification,proto3" json:"last_epoch_refresh_needs_ratification,omitempty"`
+	// public key of the VRF that indexes the latest tree, empty if it has never
+	// been rotated (in which case it is that of KeyserverConfig.VRFKeyID)
+	VRFPublic []byte `protobuf:"bytes,11,opt,name=vrf_public,json=vrfPublic,proto3" json:"vrf_public,omitempty"`
+	// the first epoch indexed by vrf_public and its issue time, once issued
+	VRFRotationEpoch uint64     `protobuf:"varint,12,opt,name=vrf_rotation_epoch,json=vrfRotationEpoch,proto3" json:"vrf_rotation_epoch,omitempty"`
+	VRFRotationTime  *Timestamp `protobuf:"bytes,13,opt,name=vrf_rotation_time,json=vrfRotationTime" json:"vrf_rotation_time,omitempty"`
 }
 
 func (m *ReplicaState) Reset()                    { *m = ReplicaState{} }
@@ -50,6 +62,20 @@ func (m *ReplicaState) GetLastEpochDelimiter() EpochDelimiter {
 	return EpochDelimiter{}
 }
 
+func (m *ReplicaState) GetLastEpochRefresh() Timestamp {
+	if m != nil {
+		return m.LastEpochRefresh
+	}
+	return Timestamp{}
+}
+
+func (m *ReplicaState) GetVRFRotationTime() *Timestamp {
+	if m != nil {
+		return m.VRFRotationTime
+	}
+	return nil
+}
+
 func init() {
 	proto1.RegisterType((*ReplicaState)(nil), "proto.ReplicaState")
 }
@@ -102,6 +128,21 @@ func (this *ReplicaState) VerboseEqual(that interface{}) error {
 	if this.LastEpochNeedsRatification != that1.LastEpochNeedsRatification {
 		return fmt.Errorf("LastEpochNeedsRatification this(%v) Not Equal that(%v)", this.LastEpochNeedsRatification, that1.LastEpochNeedsRatification)
 	}
+	if !this.LastEpochRefresh.Equal(&that1.LastEpochRefresh) {
+		return fmt.Errorf("LastEpochRefresh this(%v) Not Equal that(%v)", this.LastEpochRefresh, that1.LastEpochRefresh)
+	}
+	if this.LastEpochRefreshNeedsRatification != that1.LastEpochRefreshNeedsRatification {
+		return fmt.Errorf("LastEpochRefreshNeedsRatification this(%v) Not Equal that(%v)", this.LastEpochRefreshNeedsRatification, that1.LastEpochRefreshNeedsRatification)
+	}
+	if !bytes.Equal(this.VRFPublic, that1.VRFPublic) {
+		return fmt.Errorf("VRFPublic this(%v) Not Equal that(%v)", this.VRFPublic, that1.VRFPublic)
+	}
+	if this.VRFRotationEpoch != that1.VRFRotationEpoch {
+		return fmt.Errorf("VRFRotationEpoch this(%v) Not Equal that(%v)", this.VRFRotationEpoch, that1.VRFRotationEpoch)
+	}
+	if !this.VRFRotationTime.Equal(that1.VRFRotationTime) {
+		return fmt.Errorf("VRFRotationTime this(%v) Not Equal that(%v)", this.VRFRotationTime, that1.VRFRotationTime)
+	}
 	return nil
 }
 func (this *ReplicaState) Equal(that interface{}) bool {
@@ -153,13 +194,28 @@ func (this *ReplicaState) Equal(that interface{}) bool {
 	if this.LastEpochNeedsRatification != that1.LastEpochNeedsRatification {
 		return false
 	}
+	if !this.LastEpochRefresh.Equal(&that1.LastEpochRefresh) {
+		return false
+	}
+	if this.LastEpochRefreshNeedsRatification != that1.LastEpochRefreshNeedsRatification {
+		return false
+	}
+	if !bytes.Equal(this.VRFPublic, that1.VRFPublic) {
+		return false
+	}
+	if this.VRFRotationEpoch != that1.VRFRotationEpoch {
+		return false
+	}
+	if !this.VRFRotationTime.Equal(that1.VRFRotationTime) {
+		return false
+	}
 	return true
 }
 func (this *ReplicaState) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 12)
+	s := make([]string, 0, 17)
 	s = append(s, "&proto.ReplicaState{")
 	s = append(s, "NextIndexLog: "+fmt.Sprintf("%#v", this.NextIndexLog)+",\n")
 	s = append(s, "NextIndexVerifier: "+fmt.Sprintf("%#v", this.NextIndexVerifier)+",\n")
@@ -169,6 +225,13 @@ func (this *ReplicaState) GoString() string {
 	s = append(s, "PendingUpdates: "+fmt.Sprintf("%#v", this.PendingUpdates)+",\n")
 	s = append(s, "LatestTreeSnapshot: "+fmt.Sprintf("%#v", this.LatestTreeSnapshot)+",\n")
 	s = append(s, "LastEpochNeedsRatification: "+fmt.Sprintf("%#v", this.LastEpochNeedsRatification)+",\n")
+	s = append(s, "LastEpochRefresh: "+strings.Replace(this.LastEpochRefresh.GoString(), `&`, ``, 1)+",\n")
+	s = append(s, "LastEpochRefreshNeedsRatification: "+fmt.Sprintf("%#v", this.LastEpochRefreshNeedsRatification)+",\n")
+	s = append(s, "VRFPublic: "+fmt.Sprintf("%#v", this.VRFPublic)+",\n")
+	s = append(s, "VRFRotationEpoch: "+fmt.Sprintf("%#v", this.VRFRotationEpoch)+",\n")
+	if this.VRFRotationTime != nil {
+		s = append(s, "VRFRotationTime: "+fmt.Sprintf("%#v", this.VRFRotationTime)+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -272,6 +335,45 @@ func (m *ReplicaState) MarshalTo(data []byte) (int, error) {
 		}
 		i++
 	}
+	data[i] = 0x4a
+	i++
+	i = encodeVarintKeyserverlocal(data, i, uint64(m.LastEpochRefresh.Size()))
+	n2, err := m.LastEpochRefresh.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n2
+	if m.LastEpochRefreshNeedsRatification {
+		data[i] = 0x50
+		i++
+		if m.LastEpochRefreshNeedsRatification {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
+	if len(m.VRFPublic) > 0 {
+		data[i] = 0x5a
+		i++
+		i = encodeVarintKeyserverlocal(data, i, uint64(len(m.VRFPublic)))
+		i += copy(data[i:], m.VRFPublic)
+	}
+	if m.VRFRotationEpoch != 0 {
+		data[i] = 0x60
+		i++
+		i = encodeVarintKeyserverlocal(data, i, uint64(m.VRFRotationEpoch))
+	}
+	if m.VRFRotationTime != nil {
+		data[i] = 0x6a
+		i++
+		i = encodeVarintKeyserverlocal(data, i, uint64(m.VRFRotationTime.Size()))
+		n3, err := m.VRFRotationTime.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
 	return i, nil
 }
 
@@ -317,6 +419,18 @@ func NewPopulatedReplicaState(r randyKeyserverlocal, easy bool) *ReplicaState {
 	this.PendingUpdates = bool(bool(r.Intn(2) == 0))
 	this.LatestTreeSnapshot = uint64(uint64(r.Uint32()))
 	this.LastEpochNeedsRatification = bool(bool(r.Intn(2) == 0))
+	v3 := NewPopulatedTimestamp(r, easy)
+	this.LastEpochRefresh = *v3
+	this.LastEpochRefreshNeedsRatification = bool(bool(r.Intn(2) == 0))
+	v4 := r.Intn(100)
+	this.VRFPublic = make([]byte, v4)
+	for i := 0; i < v4; i++ {
+		this.VRFPublic[i] = byte(r.Intn(256))
+	}
+	this.VRFRotationEpoch = uint64(uint64(r.Uint32()))
+	if r.Intn(10) != 0 {
+		this.VRFRotationTime = NewPopulatedTimestamp(r, easy)
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -341,9 +455,9 @@ func randUTF8RuneKeyserverlocal(r randyKeyserverlocal) rune {
 	return rune(ru + 61)
 }
 func randStringKeyserverlocal(r randyKeyserverlocal) string {
-	v3 := r.Intn(100)
-	tmps := make([]rune, v3)
-	for i := 0; i < v3; i++ {
+	v5 := r.Intn(100)
+	tmps := make([]rune, v5)
+	for i := 0; i < v5; i++ {
 		tmps[i] = randUTF8RuneKeyserverlocal(r)
 	}
 	return string(tmps)
@@ -365,11 +479,11 @@ func randFieldKeyserverlocal(data []byte, r randyKeyserverlocal, fieldNumber int
 	switch wire {
 	case 0:
 		data = encodeVarintPopulateKeyserverlocal(data, uint64(key))
-		v4 := r.Int63()
+		v6 := r.Int63()
 		if r.Intn(2) == 0 {
-			v4 *= -1
+			v6 *= -1
 		}
-		data = encodeVarintPopulateKeyserverlocal(data, uint64(v4))
+		data = encodeVarintPopulateKeyserverlocal(data, uint64(v6))
 	case 1:
 		data = encodeVarintPopulateKeyserverlocal(data, uint64(key))
 		data = append(data, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -421,6 +535,22 @@ func (m *ReplicaState) Size() (n int) {
 	if m.LastEpochNeedsRatification {
 		n += 2
 	}
+	l = m.LastEpochRefresh.Size()
+	n += 1 + l + sovKeyserverlocal(uint64(l))
+	if m.LastEpochRefreshNeedsRatification {
+		n += 2
+	}
+	l = len(m.VRFPublic)
+	if l > 0 {
+		n += 1 + l + sovKeyserverlocal(uint64(l))
+	}
+	if m.VRFRotationEpoch != 0 {
+		n += 1 + sovKeyserverlocal(uint64(m.VRFRotationEpoch))
+	}
+	if m.VRFRotationTime != nil {
+		l = m.VRFRotationTime.Size()
+		n += 1 + l + sovKeyserverlocal(uint64(l))
+	}
 	return n
 }
 
@@ -450,6 +580,11 @@ func (this *ReplicaState) String() string {
 		`PendingUpdates:` + fmt.Sprintf("%v", this.PendingUpdates) + `,`,
 		`LatestTreeSnapshot:` + fmt.Sprintf("%v", this.LatestTreeSnapshot) + `,`,
 		`LastEpochNeedsRatification:` + fmt.Sprintf("%v", this.LastEpochNeedsRatification) + `,`,
+		`LastEpochRefresh:` + strings.Replace(strings.Replace(this.LastEpochRefresh.String(), "Timestamp", "Timestamp", 1), `&`, ``, 1) + `,`,
+		`LastEpochRefreshNeedsRatification:` + fmt.Sprintf("%v", this.LastEpochRefreshNeedsRatification) + `,`,
+		`VRFPublic:` + fmt.Sprintf("%v", this.VRFPublic) + `,`,
+		`VRFRotationEpoch:` + fmt.Sprintf("%v", this.VRFRotationEpoch) + `,`,
+		`VRFRotationTime:` + strings.Replace(fmt.Sprintf("%v", this.VRFRotationTime), "Timestamp", "Timestamp", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -669,6 +804,139 @@ func (m *ReplicaState) Unmarshal(data []byte) error {
 				}
 			}
 			m.LastEpochNeedsRatification = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastEpochRefresh", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyserverlocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeyserverlocal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastEpochRefresh.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastEpochRefreshNeedsRatification", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyserverlocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LastEpochRefreshNeedsRatification = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VRFPublic", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyserverlocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthKeyserverlocal
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VRFPublic = append(m.VRFPublic[:0], data[iNdEx:postIndex]...)
+			if m.VRFPublic == nil {
+				m.VRFPublic = []byte{}
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VRFRotationEpoch", wireType)
+			}
+			m.VRFRotationEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyserverlocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.VRFRotationEpoch |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VRFRotationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyserverlocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeyserverlocal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VRFRotationTime == nil {
+				m.VRFRotationTime = &Timestamp{}
+			}
+			if err := m.VRFRotationTime.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKeyserverlocal(data[iNdEx:])
@@ -798,33 +1066,42 @@ var (
 func init() { proto1.RegisterFile("keyserverlocal.proto", fileDescriptorKeyserverlocal) }
 
 var fileDescriptorKeyserverlocal = []byte{
-	// 447 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0xb1, 0x6f, 0xd3, 0x40,
-	0x14, 0xc6, 0x73, 0x34, 0x2d, 0xd5, 0x11, 0x15, 0xf5, 0xda, 0x4a, 0x56, 0x24, 0xae, 0x11, 0x02,
-	0x91, 0x29, 0xa9, 0xca, 0xc2, 0x4a, 0x05, 0x12, 0x48, 0xa5, 0x83, 0x53, 0x58, 0x4f, 0x97, 0xf8,
-	0xc5, 0x3e, 0xe1, 0xdc, 0x59, 0x77, 0xcf, 0x51, 0xb3, 0xf1, 0xe7, 0xf0, 0x27, 0x30, 0x32, 0x76,
-	0xec, 0x82, 0xc4, 0x84, 0xea, 0x9b, 0x18, 0x3b, 0x32, 0x22, 0x9f, 0x9d, 0x12, 0x26, 0xdb, 0xdf,
-	0xef, 0x7b, 0xbe, 0xf7, 0x7d, 0x47, 0x0f, 0x3f, 0xc3, 0xca, 0x81, 0x5d, 0x82, 0xcd, 0xcd, 0x4c,
-	0xe6, 0xa3, 0xc2, 0x1a, 0x34, 0x6c, 0x3b, 0x3c, 0xfa, 0x27, 0xa9, 0xc2, 0xac, 0x9c, 0x8e, 0x66,
-	0x66, 0x31, 0x5e, 0xc8, 0x44, 0xe1, 0x4a, 0x8e, 0x03, 0x99, 0x96, 0xf3, 0x71, 0x6a, 0x52, 0x13,
-	0x3e, 0xc2, 0x5b, 0x33, 0xd8, 0xdf, 0xb7, 0x50, 0xe4, 0x6a, 0x26, 0x51, 0x19, 0xdd, 0x48, 0x4f,
-	0x7f, 0x6c, 0xd1, 0x5e, 0xdc, 0xa8, 0x13, 0x94, 0x08, 0xec, 0x19, 0xdd, 0xd3, 0x70, 0x85, 0x42,
-	0xe9, 0x04, 0xae, 0x44, 0x6e, 0xd2, 0x88, 0x0c, 0xc8, 0xb0, 0x1b, 0xf7, 0x6a, 0xf5, 0x7d, 0x2d,
-	0x9e, 0x9b, 0x94, 0x8d, 0xe8, 0xc1, 0x86, 0x6b, 0x09, 0x56, 0xcd, 0x15, 0xd8, 0xe8, 0x41, 0xb0,
-	0xee, 0xdf, 0x5b, 0x3f, 0xb5, 0x80, 0x9d, 0xd2, 0xa3, 0xc2, 0xc2, 0x52, 0x99, 0xd2, 0x09, 0x57,
-	0x2e, 0x16, 0xd2, 0xae, 0x44, 0x26, 0x5d, 0x16, 0x6d, 0x0d, 0xc8, 0xb0, 0x17, 0x1f, 0xac, 0xe1,
-	0xa4, 0x61, 0xef, 0xa4, 0xcb, 0xd8, 0x07, 0x7a, 0x98, 0x4b, 0x87, 0x02, 0x0a, 0x33, 0xcb, 0x44,
-	0x02, 0xb9, 0x5a, 0x28, 0x04, 0x1b, 0x75, 0x07, 0x64, 0xf8, 0xe8, 0xf4, 0xa8, 0x09, 0x30, 0x7a,
-	0x5b, 0xd3, 0x37, 0x6b, 0x78, 0xd6, 0xbd, 0xfe, 0x75, 0xdc, 0x89, 0x59, 0x3d, 0xf8, 0x3f, 0x61,
-	0x17, 0xf4, 0x39, 0x66, 0xca, 0x89, 0xb6, 0x03, 0xa1, 0x01, 0x12, 0x27, 0xd0, 0x08, 0xa7, 0x52,
-	0x2d, 0xfe, 0x9d, 0x14, 0x6d, 0x0f, 0xc8, 0x70, 0x37, 0x3e, 0xae, 0xcd, 0x6d, 0x33, 0x17, 0xb5,
-	0xf5, 0xd2, 0x4c, 0x54, 0xaa, 0xcf, 0xd7, 0x3f, 0x66, 0x2f, 0xe8, 0xe3, 0x02, 0x74, 0xa2, 0x74,
-	0x2a, 0xca, 0x22, 0x91, 0x08, 0x2e, 0xda, 0x09, 0x93, 0x7b, 0xad, 0xfc, 0xb1, 0x51, 0xd9, 0x49,
-	0x9d, 0x03, 0xc1, 0xa1, 0x40, 0x0b, 0x20, 0x9c, 0x96, 0x85, 0xcb, 0x0c, 0x46, 0x0f, 0x43, 0x59,
-	0xac, 0x61, 0x97, 0x16, 0x60, 0xd2, 0x12, 0xf6, 0x9a, 0x3e, 0xd9, 0x48, 0xde, 0x2c, 0x6a, 0x25,
-	0xaa, 0x79, 0x7b, 0x77, 0xd1, 0x6e, 0x38, 0xa8, 0x7f, 0x9f, 0x32, 0x2c, 0x18, 0x6f, 0x38, 0xce,
-	0x5e, 0xdd, 0x54, 0xbc, 0xf3, 0xb3, 0xe2, 0x9d, 0xdb, 0x8a, 0x93, 0xbb, 0x8a, 0x93, 0x3f, 0x15,
-	0x27, 0x5f, 0x3c, 0x27, 0x5f, 0x3d, 0x27, 0xdf, 0x3c, 0x27, 0xdf, 0x3d, 0x27, 0xd7, 0x9e, 0x93,
-	0x1b, 0xcf, 0xc9, 0xad, 0xe7, 0xe4, 0xb7, 0xe7, 0x9d, 0x3b, 0xcf, 0xc9, 0x74, 0x27, 0xf4, 0xfa,
-	0xf2, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf4, 0x94, 0xcf, 0xd3, 0x7c, 0x02, 0x00, 0x00,
+	// 589 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x53, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xce, 0x41, 0x5b, 0xda, 0x6b, 0xda, 0xa4, 0xd7, 0x56, 0xb2, 0x2a, 0x71, 0x09, 0x88, 0x8a,
+	0x0c, 0x28, 0xad, 0xca, 0xc2, 0x4a, 0x54, 0x2a, 0x90, 0x4a, 0x55, 0x9c, 0xd2, 0xf5, 0x74, 0x49,
+	0x9e, 0xed, 0x13, 0xb6, 0xcf, 0xba, 0xbb, 0x44, 0xed, 0xc6, 0xcf, 0x61, 0x66, 0x62, 0x64, 0xec,
+	0xd8, 0x91, 0x29, 0x6a, 0x3c, 0x31, 0x76, 0x64, 0x44, 0x77, 0x76, 0x4a, 0x42, 0x61, 0xf2, 0xf9,
+	0xfb, 0xbe, 0xf7, 0xbd, 0xf7, 0xbe, 0xb3, 0xf1, 0xd6, 0x27, 0xb8, 0xd4, 0xa0, 0x46, 0xa0, 0x62,
+	0xd9, 0xe7, 0x71, 0x3b, 0x53, 0xd2, 0x48, 0xb2, 0xe8, 0x1e, 0x3b, 0xfb, 0xa1, 0x30, 0xd1, 0xb0,
+	0xd7, 0xee, 0xcb, 0x64, 0x2f, 0xe1, 0x03, 0x61, 0x2e, 0xf9, 0x9e, 0x63, 0x7a, 0xc3, 0x60, 0x2f,
+	0x94, 0xa1, 0x74, 0x2f, 0xee, 0x54, 0x14, 0xee, 0x6c, 0x28, 0xc8, 0x62, 0xd1, 0xe7, 0x46, 0xc8,
+	0xb4, 0x84, 0x6a, 0x46, 0x24, 0xa0, 0x0d, 0x4f, 0xb2, 0x02, 0x78, 0xfa, 0x75, 0x09, 0x57, 0xfd,
+	0x42, 0xd6, 0x35, 0xdc, 0x00, 0x79, 0x86, 0xd7, 0x53, 0xb8, 0x30, 0x4c, 0xa4, 0x03, 0xb8, 0x60,
+	0xb1, 0x0c, 0x3d, 0xd4, 0x44, 0xad, 0x05, 0xbf, 0x6a, 0xd1, 0x77, 0x16, 0x3c, 0x96, 0x21, 0x69,
+	0xe3, 0xcd, 0x19, 0xd5, 0x08, 0x94, 0x08, 0x04, 0x28, 0xef, 0x81, 0x93, 0x6e, 0xdc, 0x49, 0xcf,
+	0x4b, 0x82, 0x1c, 0xe0, 0xed, 0x4c, 0xc1, 0x48, 0xc8, 0xa1, 0x66, 0x7a, 0x98, 0x24, 0x5c, 0x5d,
+	0xb2, 0x88, 0xeb, 0xc8, 0x7b, 0xd8, 0x44, 0xad, 0xaa, 0xbf, 0x39, 0x25, 0xbb, 0x05, 0xf7, 0x96,
+	0xeb, 0x88, 0xbc, 0xc7, 0x5b, 0x31, 0xd7, 0x86, 0x41, 0x26, 0xfb, 0x11, 0x1b, 0x40, 0x2c, 0x12,
+	0x61, 0x40, 0x79, 0x0b, 0x4d, 0xd4, 0x5a, 0x3d, 0xd8, 0x2e, 0x16, 0x68, 0xbf, 0xb1, 0xec, 0xe1,
+	0x94, 0xec, 0x2c, 0x5c, 0x8d, 0x1b, 0x15, 0x9f, 0xd8, 0xc2, 0x79, 0x86, 0x9c, 0xe0, 0x5d, 0x13,
+	0x09, 0xcd, 0xca, 0x50, 0x58, 0x0a, 0x30, 0xd0, 0xcc, 0x48, 0xa6, 0x45, 0x98, 0xb2, 0x3f, 0x9d,
+	0xbc, 0xc5, 0x26, 0x6a, 0x2d, 0xfb, 0x0d, 0x2b, 0x2e, 0x93, 0x39, 0xb1, 0xd2, 0x33, 0xd9, 0x15,
+	0x61, 0x7a, 0x3c, 0x35, 0x26, 0xcf, 0x71, 0x2d, 0x83, 0x74, 0x20, 0xd2, 0x90, 0x0d, 0xb3, 0x01,
+	0x37, 0xa0, 0xbd, 0x25, 0x57, 0xb9, 0x5e, 0xc2, 0x1f, 0x0b, 0x94, 0xec, 0xdb, 0x3d, 0x0c, 0x68,
+	0xc3, 0x8c, 0x02, 0x60, 0x3a, 0xe5, 0x99, 0x8e, 0xa4, 0xf1, 0x1e, 0xb9, 0xb0, 0x48, 0xc1, 0x9d,
+	0x29, 0x80, 0x6e, 0xc9, 0x90, 0xd7, 0xf8, 0xf1, 0xcc, 0xe6, 0xc5, 0xa0, 0x8a, 0x1b, 0x11, 0x94,
+	0x97, 0xe9, 0x2d, 0xbb, 0x46, 0x3b, 0x77, 0x5b, 0xba, 0x01, 0xfd, 0x19, 0x05, 0x39, 0xc4, 0x64,
+	0xc6, 0x42, 0x41, 0xa0, 0x40, 0x47, 0xde, 0x8a, 0x8b, 0xae, 0x5e, 0x46, 0x77, 0x36, 0xfd, 0x16,
+	0xca, 0xd4, 0xea, 0x77, 0x7e, 0x7e, 0xa1, 0x27, 0xa7, 0x78, 0xf7, 0xbe, 0xcb, 0xbf, 0x06, 0xc2,
+	0x6e, 0xa0, 0x27, 0x7f, 0x1b, 0xdc, 0x9f, 0xeb, 0x05, 0xc6, 0x23, 0x15, 0xb0, 0x6c, 0xd8, 0x8b,
+	0x45, 0xdf, 0x5b, 0xb5, 0xb7, 0xdf, 0x59, 0xcb, 0xc7, 0x8d, 0x95, 0x73, 0xff, 0xe8, 0xd4, 0x81,
+	0xfe, 0xca, 0x48, 0x05, 0xc5, 0x91, 0x74, 0x30, 0xb1, 0x6a, 0x25, 0x8d, 0xab, 0x2e, 0x2f, 0xa8,
+	0x6a, 0x83, 0xeb, 0x6c, 0xe5, 0xe3, 0x46, 0xfd, 0xdc, 0x3f, 0xf2, 0x4b, 0xb2, 0xe8, 0x5b, 0x1f,
+	0xa9, 0x60, 0x0e, 0x21, 0x1f, 0xf0, 0xc6, 0x9c, 0x87, 0xfd, 0x03, 0xbc, 0xb5, 0xff, 0x04, 0xb1,
+	0x99, 0x8f, 0x1b, 0xb5, 0x19, 0x53, 0xcb, 0xf8, 0xb5, 0x19, 0x4f, 0x0b, 0x74, 0x5e, 0x5d, 0x4f,
+	0x68, 0xe5, 0xc7, 0x84, 0x56, 0x6e, 0x26, 0x14, 0xdd, 0x4e, 0x28, 0xfa, 0x35, 0xa1, 0xe8, 0x73,
+	0x4e, 0xd1, 0x97, 0x9c, 0xa2, 0x6f, 0x39, 0x45, 0xdf, 0x73, 0x8a, 0xae, 0x72, 0x8a, 0xae, 0x73,
+	0x8a, 0x6e, 0x72, 0x8a, 0x7e, 0xe6, 0xb4, 0x72, 0x9b, 0x53, 0xd4, 0x5b, 0x72, 0x0d, 0x5f, 0xfe,
+	0x0e, 0x00, 0x00, 0xff, 0xff, 0x80, 0xab, 0x64, 0x12, 0xea, 0x03, 0x00, 0x00,
 }
@@ -0,0 +1,26 @@
+// Copyright 2014-2015 The Dename Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy of
+// the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations under
+// the License.
+
+package proto
+
+// RecoveryVetoContext separates RecoveryVeto signatures from signatures of
+// entries by the same update policy.
+const RecoveryVetoContext = "coname recovery veto\x00"
+
+// RecoveryVetoMessage returns the message that the signatures of a
+// RecoveryVeto cover for cancelling the recovery to the entry whose encoding
+// hashes to entryHash.
+func RecoveryVetoMessage(entryHash []byte) []byte {
+	return append([]byte(RecoveryVetoContext), entryHash...)
+}
@@ -9,6 +9,8 @@ import fmt "fmt"
 import math "math"
 import _ "github.com/maditya/protobuf/gogoproto"
 
+import bytes "bytes"
+
 import strings "strings"
 import github_com_maditya_protobuf_proto "github.com/maditya/protobuf/proto"
 import sort "sort"
@@ -35,6 +37,12 @@ type KeyserverStep struct {
 	//	*KeyserverStep_EpochDelimiter
 	//	*KeyserverStep_ReplicaSigned
 	//	*KeyserverStep_VerifierSigned
+	//	*KeyserverStep_EpochRefresh
+	//	*KeyserverStep_EmailChallenge
+	//	*KeyserverStep_PendingUpdate
+	//	*KeyserverStep_RecoveryStart
+	//	*KeyserverStep_RecoveryVeto
+	//	*KeyserverStep_VrfRotation
 	Type isKeyserverStep_Type `protobuf_oneof:"type"`
 }
 
@@ -62,11 +70,35 @@ type KeyserverStep_ReplicaSigned struct {
 type KeyserverStep_VerifierSigned struct {
 	VerifierSigned *SignedEpochHead `protobuf:"bytes,5,opt,name=verifier_signed,json=verifierSigned,oneof"`
 }
+type KeyserverStep_EpochRefresh struct {
+	EpochRefresh *EpochDelimiter `protobuf:"bytes,6,opt,name=epoch_refresh,json=epochRefresh,oneof"`
+}
+type KeyserverStep_EmailChallenge struct {
+	EmailChallenge *EmailChallenge `protobuf:"bytes,7,opt,name=email_challenge,json=emailChallenge,oneof"`
+}
+type KeyserverStep_PendingUpdate struct {
+	PendingUpdate *UpdateRequest `protobuf:"bytes,8,opt,name=pending_update,json=pendingUpdate,oneof"`
+}
+type KeyserverStep_RecoveryStart struct {
+	RecoveryStart *UpdateRequest `protobuf:"bytes,9,opt,name=recovery_start,json=recoveryStart,oneof"`
+}
+type KeyserverStep_RecoveryVeto struct {
+	RecoveryVeto *RecoveryVeto `protobuf:"bytes,10,opt,name=recovery_veto,json=recoveryVeto,oneof"`
+}
+type KeyserverStep_VrfRotation struct {
+	VrfRotation *VRFRotation `protobuf:"bytes,11,opt,name=vrf_rotation,json=vrfRotation,oneof"`
+}
 
 func (*KeyserverStep_Update) isKeyserverStep_Type()         {}
 func (*KeyserverStep_EpochDelimiter) isKeyserverStep_Type() {}
 func (*KeyserverStep_ReplicaSigned) isKeyserverStep_Type()  {}
 func (*KeyserverStep_VerifierSigned) isKeyserverStep_Type() {}
+func (*KeyserverStep_EpochRefresh) isKeyserverStep_Type()   {}
+func (*KeyserverStep_EmailChallenge) isKeyserverStep_Type() {}
+func (*KeyserverStep_PendingUpdate) isKeyserverStep_Type()  {}
+func (*KeyserverStep_RecoveryStart) isKeyserverStep_Type()  {}
+func (*KeyserverStep_RecoveryVeto) isKeyserverStep_Type()   {}
+func (*KeyserverStep_VrfRotation) isKeyserverStep_Type()    {}
 
 func (m *KeyserverStep) GetType() isKeyserverStep_Type {
 	if m != nil {
@@ -103,6 +135,48 @@ func (m *KeyserverStep) GetVerifierSigned() *SignedEpochHead {
 	return nil
 }
 
+func (m *KeyserverStep) GetEpochRefresh() *EpochDelimiter {
+	if x, ok := m.GetType().(*KeyserverStep_EpochRefresh); ok {
+		return x.EpochRefresh
+	}
+	return nil
+}
+
+func (m *KeyserverStep) GetEmailChallenge() *EmailChallenge {
+	if x, ok := m.GetType().(*KeyserverStep_EmailChallenge); ok {
+		return x.EmailChallenge
+	}
+	return nil
+}
+
+func (m *KeyserverStep) GetPendingUpdate() *UpdateRequest {
+	if x, ok := m.GetType().(*KeyserverStep_PendingUpdate); ok {
+		return x.PendingUpdate
+	}
+	return nil
+}
+
+func (m *KeyserverStep) GetRecoveryStart() *UpdateRequest {
+	if x, ok := m.GetType().(*KeyserverStep_RecoveryStart); ok {
+		return x.RecoveryStart
+	}
+	return nil
+}
+
+func (m *KeyserverStep) GetRecoveryVeto() *RecoveryVeto {
+	if x, ok := m.GetType().(*KeyserverStep_RecoveryVeto); ok {
+		return x.RecoveryVeto
+	}
+	return nil
+}
+
+func (m *KeyserverStep) GetVrfRotation() *VRFRotation {
+	if x, ok := m.GetType().(*KeyserverStep_VrfRotation); ok {
+		return x.VrfRotation
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*KeyserverStep) XXX_OneofFuncs() (func(msg proto1.Message, b *proto1.Buffer) error, func(msg proto1.Message, tag, wire int, b *proto1.Buffer) (bool, error), func(msg proto1.Message) (n int), []interface{}) {
 	return _KeyserverStep_OneofMarshaler, _KeyserverStep_OneofUnmarshaler, _KeyserverStep_OneofSizer, []interface{}{
@@ -110,6 +184,12 @@ func (*KeyserverStep) XXX_OneofFuncs() (func(msg proto1.Message, b *proto1.Buffe
 		(*KeyserverStep_EpochDelimiter)(nil),
 		(*KeyserverStep_ReplicaSigned)(nil),
 		(*KeyserverStep_VerifierSigned)(nil),
+		(*KeyserverStep_EpochRefresh)(nil),
+		(*KeyserverStep_EmailChallenge)(nil),
+		(*KeyserverStep_PendingUpdate)(nil),
+		(*KeyserverStep_RecoveryStart)(nil),
+		(*KeyserverStep_RecoveryVeto)(nil),
+		(*KeyserverStep_VrfRotation)(nil),
 	}
 }
 
@@ -137,6 +217,36 @@ func _KeyserverStep_OneofMarshaler(msg proto1.Message, b *proto1.Buffer) error {
 		if err := b.EncodeMessage(x.VerifierSigned); err != nil {
 			return err
 		}
+	case *KeyserverStep_EpochRefresh:
+		_ = b.EncodeVarint(6<<3 | proto1.WireBytes)
+		if err := b.EncodeMessage(x.EpochRefresh); err != nil {
+			return err
+		}
+	case *KeyserverStep_EmailChallenge:
+		_ = b.EncodeVarint(7<<3 | proto1.WireBytes)
+		if err := b.EncodeMessage(x.EmailChallenge); err != nil {
+			return err
+		}
+	case *KeyserverStep_PendingUpdate:
+		_ = b.EncodeVarint(8<<3 | proto1.WireBytes)
+		if err := b.EncodeMessage(x.PendingUpdate); err != nil {
+			return err
+		}
+	case *KeyserverStep_RecoveryStart:
+		_ = b.EncodeVarint(9<<3 | proto1.WireBytes)
+		if err := b.EncodeMessage(x.RecoveryStart); err != nil {
+			return err
+		}
+	case *KeyserverStep_RecoveryVeto:
+		_ = b.EncodeVarint(10<<3 | proto1.WireBytes)
+		if err := b.EncodeMessage(x.RecoveryVeto); err != nil {
+			return err
+		}
+	case *KeyserverStep_VrfRotation:
+		_ = b.EncodeVarint(11<<3 | proto1.WireBytes)
+		if err := b.EncodeMessage(x.VrfRotation); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("KeyserverStep.Type has unexpected type %T", x)
@@ -179,6 +289,54 @@ func _KeyserverStep_OneofUnmarshaler(msg proto1.Message, tag, wire int, b *proto
 		err := b.DecodeMessage(msg)
 		m.Type = &KeyserverStep_VerifierSigned{msg}
 		return true, err
+	case 6: // type.epoch_refresh
+		if wire != proto1.WireBytes {
+			return true, proto1.ErrInternalBadWireType
+		}
+		msg := new(EpochDelimiter)
+		err := b.DecodeMessage(msg)
+		m.Type = &KeyserverStep_EpochRefresh{msg}
+		return true, err
+	case 7: // type.email_challenge
+		if wire != proto1.WireBytes {
+			return true, proto1.ErrInternalBadWireType
+		}
+		msg := new(EmailChallenge)
+		err := b.DecodeMessage(msg)
+		m.Type = &KeyserverStep_EmailChallenge{msg}
+		return true, err
+	case 8: // type.pending_update
+		if wire != proto1.WireBytes {
+			return true, proto1.ErrInternalBadWireType
+		}
+		msg := new(UpdateRequest)
+		err := b.DecodeMessage(msg)
+		m.Type = &KeyserverStep_PendingUpdate{msg}
+		return true, err
+	case 9: // type.recovery_start
+		if wire != proto1.WireBytes {
+			return true, proto1.ErrInternalBadWireType
+		}
+		msg := new(UpdateRequest)
+		err := b.DecodeMessage(msg)
+		m.Type = &KeyserverStep_RecoveryStart{msg}
+		return true, err
+	case 10: // type.recovery_veto
+		if wire != proto1.WireBytes {
+			return true, proto1.ErrInternalBadWireType
+		}
+		msg := new(RecoveryVeto)
+		err := b.DecodeMessage(msg)
+		m.Type = &KeyserverStep_RecoveryVeto{msg}
+		return true, err
+	case 11: // type.vrf_rotation
+		if wire != proto1.WireBytes {
+			return true, proto1.ErrInternalBadWireType
+		}
+		msg := new(VRFRotation)
+		err := b.DecodeMessage(msg)
+		m.Type = &KeyserverStep_VrfRotation{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -208,6 +366,36 @@ func _KeyserverStep_OneofSizer(msg proto1.Message) (n int) {
 		n += proto1.SizeVarint(5<<3 | proto1.WireBytes)
 		n += proto1.SizeVarint(uint64(s))
 		n += s
+	case *KeyserverStep_EpochRefresh:
+		s := proto1.Size(x.EpochRefresh)
+		n += proto1.SizeVarint(6<<3 | proto1.WireBytes)
+		n += proto1.SizeVarint(uint64(s))
+		n += s
+	case *KeyserverStep_EmailChallenge:
+		s := proto1.Size(x.EmailChallenge)
+		n += proto1.SizeVarint(7<<3 | proto1.WireBytes)
+		n += proto1.SizeVarint(uint64(s))
+		n += s
+	case *KeyserverStep_PendingUpdate:
+		s := proto1.Size(x.PendingUpdate)
+		n += proto1.SizeVarint(8<<3 | proto1.WireBytes)
+		n += proto1.SizeVarint(uint64(s))
+		n += s
+	case *KeyserverStep_RecoveryStart:
+		s := proto1.Size(x.RecoveryStart)
+		n += proto1.SizeVarint(9<<3 | proto1.WireBytes)
+		n += proto1.SizeVarint(uint64(s))
+		n += s
+	case *KeyserverStep_RecoveryVeto:
+		s := proto1.Size(x.RecoveryVeto)
+		n += proto1.SizeVarint(10<<3 | proto1.WireBytes)
+		n += proto1.SizeVarint(uint64(s))
+		n += s
+	case *KeyserverStep_VrfRotation:
+		s := proto1.Size(x.VrfRotation)
+		n += proto1.SizeVarint(11<<3 | proto1.WireBytes)
+		n += proto1.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -231,9 +419,60 @@ func (m *EpochDelimiter) GetTimestamp() Timestamp {
 	return Timestamp{}
 }
 
+// EmailChallenge records an outstanding registration challenge sent by email.
+type EmailChallenge struct {
+	// Index is the VRF index of the user the code was sent to.
+	Index []byte `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	// EntryHash is the hash of the entry the code can be used to register.
+	EntryHash []byte `protobuf:"bytes,2,opt,name=entry_hash,json=entryHash,proto3" json:"entry_hash,omitempty"`
+	// CodeHash is the SHAKE256 hash (32 bytes) of the emailed code.
+	CodeHash   []byte    `protobuf:"bytes,3,opt,name=code_hash,json=codeHash,proto3" json:"code_hash,omitempty"`
+	Expiration Timestamp `protobuf:"bytes,4,opt,name=expiration" json:"expiration"`
+}
+
+func (m *EmailChallenge) Reset()                    { *m = EmailChallenge{} }
+func (*EmailChallenge) ProtoMessage()               {}
+func (*EmailChallenge) Descriptor() ([]byte, []int) { return fileDescriptorReplication, []int{2} }
+
+func (m *EmailChallenge) GetExpiration() Timestamp {
+	if m != nil {
+		return m.Expiration
+	}
+	return Timestamp{}
+}
+
+// PendingUpdate records a registration waiting for an emailed DKIM proof.
+type PendingUpdate struct {
+	Update *UpdateRequest `protobuf:"bytes,1,opt,name=update" json:"update,omitempty"`
+	// Expiration is the time after which the registration is deleted. It is
+	// counted from the time of the last epoch when the registration was
+	// submitted, so that all replicas agree on it.
+	Expiration Timestamp `protobuf:"bytes,2,opt,name=expiration" json:"expiration"`
+}
+
+func (m *PendingUpdate) Reset()                    { *m = PendingUpdate{} }
+func (*PendingUpdate) ProtoMessage()               {}
+func (*PendingUpdate) Descriptor() ([]byte, []int) { return fileDescriptorReplication, []int{3} }
+
+func (m *PendingUpdate) GetUpdate() *UpdateRequest {
+	if m != nil {
+		return m.Update
+	}
+	return nil
+}
+
+func (m *PendingUpdate) GetExpiration() Timestamp {
+	if m != nil {
+		return m.Expiration
+	}
+	return Timestamp{}
+}
+
 func init() {
 	proto1.RegisterType((*KeyserverStep)(nil), "proto.KeyserverStep")
 	proto1.RegisterType((*EpochDelimiter)(nil), "proto.EpochDelimiter")
+	proto1.RegisterType((*EmailChallenge)(nil), "proto.EmailChallenge")
+	proto1.RegisterType((*PendingUpdate)(nil), "proto.PendingUpdate")
 }
 func (this *KeyserverStep) VerboseEqual(that interface{}) error {
 	if that == nil {
@@ -394,6 +633,186 @@ func (this *KeyserverStep_VerifierSigned) VerboseEqual(that interface{}) error {
 	}
 	return nil
 }
+func (this *KeyserverStep_EpochRefresh) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*KeyserverStep_EpochRefresh)
+	if !ok {
+		that2, ok := that.(KeyserverStep_EpochRefresh)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *KeyserverStep_EpochRefresh")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *KeyserverStep_EpochRefresh but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *KeyserverStep_EpochRefresh but is not nil && this == nil")
+	}
+	if !this.EpochRefresh.Equal(that1.EpochRefresh) {
+		return fmt.Errorf("EpochRefresh this(%v) Not Equal that(%v)", this.EpochRefresh, that1.EpochRefresh)
+	}
+	return nil
+}
+func (this *KeyserverStep_EmailChallenge) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*KeyserverStep_EmailChallenge)
+	if !ok {
+		that2, ok := that.(KeyserverStep_EmailChallenge)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *KeyserverStep_EmailChallenge")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *KeyserverStep_EmailChallenge but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *KeyserverStep_EmailChallenge but is not nil && this == nil")
+	}
+	if !this.EmailChallenge.Equal(that1.EmailChallenge) {
+		return fmt.Errorf("EmailChallenge this(%v) Not Equal that(%v)", this.EmailChallenge, that1.EmailChallenge)
+	}
+	return nil
+}
+func (this *KeyserverStep_PendingUpdate) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*KeyserverStep_PendingUpdate)
+	if !ok {
+		that2, ok := that.(KeyserverStep_PendingUpdate)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *KeyserverStep_PendingUpdate")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *KeyserverStep_PendingUpdate but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *KeyserverStep_PendingUpdate but is not nil && this == nil")
+	}
+	if !this.PendingUpdate.Equal(that1.PendingUpdate) {
+		return fmt.Errorf("PendingUpdate this(%v) Not Equal that(%v)", this.PendingUpdate, that1.PendingUpdate)
+	}
+	return nil
+}
+func (this *KeyserverStep_RecoveryStart) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*KeyserverStep_RecoveryStart)
+	if !ok {
+		that2, ok := that.(KeyserverStep_RecoveryStart)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *KeyserverStep_RecoveryStart")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *KeyserverStep_RecoveryStart but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *KeyserverStep_RecoveryStart but is not nil && this == nil")
+	}
+	if !this.RecoveryStart.Equal(that1.RecoveryStart) {
+		return fmt.Errorf("RecoveryStart this(%v) Not Equal that(%v)", this.RecoveryStart, that1.RecoveryStart)
+	}
+	return nil
+}
+func (this *KeyserverStep_RecoveryVeto) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*KeyserverStep_RecoveryVeto)
+	if !ok {
+		that2, ok := that.(KeyserverStep_RecoveryVeto)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *KeyserverStep_RecoveryVeto")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *KeyserverStep_RecoveryVeto but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *KeyserverStep_RecoveryVeto but is not nil && this == nil")
+	}
+	if !this.RecoveryVeto.Equal(that1.RecoveryVeto) {
+		return fmt.Errorf("RecoveryVeto this(%v) Not Equal that(%v)", this.RecoveryVeto, that1.RecoveryVeto)
+	}
+	return nil
+}
+func (this *KeyserverStep_VrfRotation) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*KeyserverStep_VrfRotation)
+	if !ok {
+		that2, ok := that.(KeyserverStep_VrfRotation)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *KeyserverStep_VrfRotation")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *KeyserverStep_VrfRotation but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *KeyserverStep_VrfRotation but is not nil && this == nil")
+	}
+	if !this.VrfRotation.Equal(that1.VrfRotation) {
+		return fmt.Errorf("VrfRotation this(%v) Not Equal that(%v)", this.VrfRotation, that1.VrfRotation)
+	}
+	return nil
+}
 func (this *KeyserverStep) Equal(that interface{}) bool {
 	if that == nil {
 		if this == nil {
@@ -553,40 +972,37 @@ func (this *KeyserverStep_VerifierSigned) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *EpochDelimiter) VerboseEqual(that interface{}) error {
+func (this *KeyserverStep_EpochRefresh) Equal(that interface{}) bool {
 	if that == nil {
 		if this == nil {
-			return nil
+			return true
 		}
-		return fmt.Errorf("that == nil && this != nil")
+		return false
 	}
 
-	that1, ok := that.(*EpochDelimiter)
+	that1, ok := that.(*KeyserverStep_EpochRefresh)
 	if !ok {
-		that2, ok := that.(EpochDelimiter)
+		that2, ok := that.(KeyserverStep_EpochRefresh)
 		if ok {
 			that1 = &that2
 		} else {
-			return fmt.Errorf("that is not of type *EpochDelimiter")
+			return false
 		}
 	}
 	if that1 == nil {
 		if this == nil {
-			return nil
+			return true
 		}
-		return fmt.Errorf("that is type *EpochDelimiter but is nil && this != nil")
+		return false
 	} else if this == nil {
-		return fmt.Errorf("that is type *EpochDelimiter but is not nil && this == nil")
+		return false
 	}
-	if this.EpochNumber != that1.EpochNumber {
-		return fmt.Errorf("EpochNumber this(%v) Not Equal that(%v)", this.EpochNumber, that1.EpochNumber)
-	}
-	if !this.Timestamp.Equal(&that1.Timestamp) {
-		return fmt.Errorf("Timestamp this(%v) Not Equal that(%v)", this.Timestamp, that1.Timestamp)
+	if !this.EpochRefresh.Equal(that1.EpochRefresh) {
+		return false
 	}
-	return nil
+	return true
 }
-func (this *EpochDelimiter) Equal(that interface{}) bool {
+func (this *KeyserverStep_EmailChallenge) Equal(that interface{}) bool {
 	if that == nil {
 		if this == nil {
 			return true
@@ -594,9 +1010,9 @@ func (this *EpochDelimiter) Equal(that interface{}) bool {
 		return false
 	}
 
-	that1, ok := that.(*EpochDelimiter)
+	that1, ok := that.(*KeyserverStep_EmailChallenge)
 	if !ok {
-		that2, ok := that.(EpochDelimiter)
+		that2, ok := that.(KeyserverStep_EmailChallenge)
 		if ok {
 			that1 = &that2
 		} else {
@@ -611,504 +1027,1704 @@ func (this *EpochDelimiter) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.EpochNumber != that1.EpochNumber {
-		return false
-	}
-	if !this.Timestamp.Equal(&that1.Timestamp) {
+	if !this.EmailChallenge.Equal(that1.EmailChallenge) {
 		return false
 	}
 	return true
 }
-func (this *KeyserverStep) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *KeyserverStep_PendingUpdate) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
 	}
-	s := make([]string, 0, 9)
-	s = append(s, "&proto.KeyserverStep{")
-	s = append(s, "UID: "+fmt.Sprintf("%#v", this.UID)+",\n")
-	if this.Type != nil {
-		s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+
+	that1, ok := that.(*KeyserverStep_PendingUpdate)
+	if !ok {
+		that2, ok := that.(KeyserverStep_PendingUpdate)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *KeyserverStep_Update) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
 	}
-	s := strings.Join([]string{`&proto.KeyserverStep_Update{` +
-		`Update:` + fmt.Sprintf("%#v", this.Update) + `}`}, ", ")
-	return s
-}
-func (this *KeyserverStep_EpochDelimiter) GoString() string {
-	if this == nil {
-		return "nil"
+	if !this.PendingUpdate.Equal(that1.PendingUpdate) {
+		return false
 	}
-	s := strings.Join([]string{`&proto.KeyserverStep_EpochDelimiter{` +
-		`EpochDelimiter:` + fmt.Sprintf("%#v", this.EpochDelimiter) + `}`}, ", ")
-	return s
+	return true
 }
-func (this *KeyserverStep_ReplicaSigned) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *KeyserverStep_RecoveryStart) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
 	}
-	s := strings.Join([]string{`&proto.KeyserverStep_ReplicaSigned{` +
-		`ReplicaSigned:` + fmt.Sprintf("%#v", this.ReplicaSigned) + `}`}, ", ")
-	return s
-}
-func (this *KeyserverStep_VerifierSigned) GoString() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*KeyserverStep_RecoveryStart)
+	if !ok {
+		that2, ok := that.(KeyserverStep_RecoveryStart)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := strings.Join([]string{`&proto.KeyserverStep_VerifierSigned{` +
-		`VerifierSigned:` + fmt.Sprintf("%#v", this.VerifierSigned) + `}`}, ", ")
-	return s
-}
-func (this *EpochDelimiter) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&proto.EpochDelimiter{")
-	s = append(s, "EpochNumber: "+fmt.Sprintf("%#v", this.EpochNumber)+",\n")
-	s = append(s, "Timestamp: "+strings.Replace(this.Timestamp.GoString(), `&`, ``, 1)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func valueToGoStringReplication(v interface{}, typ string) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+	if !this.RecoveryStart.Equal(that1.RecoveryStart) {
+		return false
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+	return true
 }
-func extensionToGoStringReplication(m github_com_maditya_protobuf_proto.Message) string {
-	e := github_com_maditya_protobuf_proto.GetUnsafeExtensionsMap(m)
-	if e == nil {
-		return "nil"
+func (this *KeyserverStep_RecoveryVeto) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
 	}
-	s := "proto.NewUnsafeXXX_InternalExtensions(map[int32]proto.Extension{"
-	keys := make([]int, 0, len(e))
-	for k := range e {
-		keys = append(keys, int(k))
+
+	that1, ok := that.(*KeyserverStep_RecoveryVeto)
+	if !ok {
+		that2, ok := that.(KeyserverStep_RecoveryVeto)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	sort.Ints(keys)
-	ss := []string{}
-	for _, k := range keys {
-		ss = append(ss, strconv.Itoa(k)+": "+e[int32(k)].GoString())
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
 	}
-	s += strings.Join(ss, ",") + "})"
-	return s
-}
-func (m *KeyserverStep) Marshal() (data []byte, err error) {
-	size := m.Size()
-	data = make([]byte, size)
-	n, err := m.MarshalTo(data)
-	if err != nil {
-		return nil, err
+	if !this.RecoveryVeto.Equal(that1.RecoveryVeto) {
+		return false
 	}
-	return data[:n], nil
+	return true
 }
+func (this *KeyserverStep_VrfRotation) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
 
-func (m *KeyserverStep) MarshalTo(data []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.UID != 0 {
-		data[i] = 0x9
-		i++
-		i = encodeFixed64Replication(data, i, uint64(m.UID))
+	that1, ok := that.(*KeyserverStep_VrfRotation)
+	if !ok {
+		that2, ok := that.(KeyserverStep_VrfRotation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.Type != nil {
-		nn1, err := m.Type.MarshalTo(data[i:])
-		if err != nil {
-			return 0, err
+	if that1 == nil {
+		if this == nil {
+			return true
 		}
-		i += nn1
+		return false
+	} else if this == nil {
+		return false
 	}
-	return i, nil
+	if !this.VrfRotation.Equal(that1.VrfRotation) {
+		return false
+	}
+	return true
 }
+func (this *EpochDelimiter) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
 
-func (m *KeyserverStep_Update) MarshalTo(data []byte) (int, error) {
-	i := 0
-	if m.Update != nil {
-		data[i] = 0x12
-		i++
-		i = encodeVarintReplication(data, i, uint64(m.Update.Size()))
-		n2, err := m.Update.MarshalTo(data[i:])
-		if err != nil {
-			return 0, err
+	that1, ok := that.(*EpochDelimiter)
+	if !ok {
+		that2, ok := that.(EpochDelimiter)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *EpochDelimiter")
 		}
-		i += n2
 	}
-	return i, nil
-}
-func (m *KeyserverStep_EpochDelimiter) MarshalTo(data []byte) (int, error) {
-	i := 0
-	if m.EpochDelimiter != nil {
-		data[i] = 0x1a
-		i++
-		i = encodeVarintReplication(data, i, uint64(m.EpochDelimiter.Size()))
-		n3, err := m.EpochDelimiter.MarshalTo(data[i:])
-		if err != nil {
-			return 0, err
+	if that1 == nil {
+		if this == nil {
+			return nil
 		}
-		i += n3
+		return fmt.Errorf("that is type *EpochDelimiter but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *EpochDelimiter but is not nil && this == nil")
 	}
-	return i, nil
+	if this.EpochNumber != that1.EpochNumber {
+		return fmt.Errorf("EpochNumber this(%v) Not Equal that(%v)", this.EpochNumber, that1.EpochNumber)
+	}
+	if !this.Timestamp.Equal(&that1.Timestamp) {
+		return fmt.Errorf("Timestamp this(%v) Not Equal that(%v)", this.Timestamp, that1.Timestamp)
+	}
+	return nil
 }
-func (m *KeyserverStep_ReplicaSigned) MarshalTo(data []byte) (int, error) {
-	i := 0
-	if m.ReplicaSigned != nil {
-		data[i] = 0x22
-		i++
-		i = encodeVarintReplication(data, i, uint64(m.ReplicaSigned.Size()))
-		n4, err := m.ReplicaSigned.MarshalTo(data[i:])
-		if err != nil {
-			return 0, err
+func (this *EpochDelimiter) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
 		}
-		i += n4
+		return false
 	}
-	return i, nil
-}
-func (m *KeyserverStep_VerifierSigned) MarshalTo(data []byte) (int, error) {
-	i := 0
-	if m.VerifierSigned != nil {
-		data[i] = 0x2a
-		i++
-		i = encodeVarintReplication(data, i, uint64(m.VerifierSigned.Size()))
-		n5, err := m.VerifierSigned.MarshalTo(data[i:])
-		if err != nil {
-			return 0, err
+
+	that1, ok := that.(*EpochDelimiter)
+	if !ok {
+		that2, ok := that.(EpochDelimiter)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i += n5
 	}
-	return i, nil
-}
-func (m *EpochDelimiter) Marshal() (data []byte, err error) {
-	size := m.Size()
-	data = make([]byte, size)
-	n, err := m.MarshalTo(data)
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
 	}
-	return data[:n], nil
-}
-
-func (m *EpochDelimiter) MarshalTo(data []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.EpochNumber != 0 {
-		data[i] = 0x8
-		i++
-		i = encodeVarintReplication(data, i, uint64(m.EpochNumber))
+	if this.EpochNumber != that1.EpochNumber {
+		return false
 	}
-	data[i] = 0x12
-	i++
-	i = encodeVarintReplication(data, i, uint64(m.Timestamp.Size()))
-	n6, err := m.Timestamp.MarshalTo(data[i:])
-	if err != nil {
-		return 0, err
+	if !this.Timestamp.Equal(&that1.Timestamp) {
+		return false
 	}
-	i += n6
-	return i, nil
+	return true
 }
+func (this *EmailChallenge) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
 
-func encodeFixed64Replication(data []byte, offset int, v uint64) int {
-	data[offset] = uint8(v)
-	data[offset+1] = uint8(v >> 8)
-	data[offset+2] = uint8(v >> 16)
-	data[offset+3] = uint8(v >> 24)
-	data[offset+4] = uint8(v >> 32)
-	data[offset+5] = uint8(v >> 40)
-	data[offset+6] = uint8(v >> 48)
-	data[offset+7] = uint8(v >> 56)
-	return offset + 8
-}
-func encodeFixed32Replication(data []byte, offset int, v uint32) int {
-	data[offset] = uint8(v)
-	data[offset+1] = uint8(v >> 8)
-	data[offset+2] = uint8(v >> 16)
-	data[offset+3] = uint8(v >> 24)
-	return offset + 4
-}
-func encodeVarintReplication(data []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		data[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	that1, ok := that.(*EmailChallenge)
+	if !ok {
+		that2, ok := that.(EmailChallenge)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *EmailChallenge")
+		}
 	}
-	data[offset] = uint8(v)
-	return offset + 1
-}
-func NewPopulatedKeyserverStep(r randyReplication, easy bool) *KeyserverStep {
-	this := &KeyserverStep{}
-	this.UID = uint64(uint64(r.Uint32()))
-	oneofNumber_Type := []int32{2, 3, 4, 5}[r.Intn(4)]
-	switch oneofNumber_Type {
-	case 2:
-		this.Type = NewPopulatedKeyserverStep_Update(r, easy)
-	case 3:
-		this.Type = NewPopulatedKeyserverStep_EpochDelimiter(r, easy)
-	case 4:
-		this.Type = NewPopulatedKeyserverStep_ReplicaSigned(r, easy)
-	case 5:
-		this.Type = NewPopulatedKeyserverStep_VerifierSigned(r, easy)
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *EmailChallenge but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *EmailChallenge but is not nil && this == nil")
 	}
-	if !easy && r.Intn(10) != 0 {
+	if !bytes.Equal(this.Index, that1.Index) {
+		return fmt.Errorf("Index this(%v) Not Equal that(%v)", this.Index, that1.Index)
 	}
-	return this
-}
-
-func NewPopulatedKeyserverStep_Update(r randyReplication, easy bool) *KeyserverStep_Update {
-	this := &KeyserverStep_Update{}
-	this.Update = NewPopulatedUpdateRequest(r, easy)
-	return this
-}
-func NewPopulatedKeyserverStep_EpochDelimiter(r randyReplication, easy bool) *KeyserverStep_EpochDelimiter {
-	this := &KeyserverStep_EpochDelimiter{}
-	this.EpochDelimiter = NewPopulatedEpochDelimiter(r, easy)
-	return this
-}
-func NewPopulatedKeyserverStep_ReplicaSigned(r randyReplication, easy bool) *KeyserverStep_ReplicaSigned {
-	this := &KeyserverStep_ReplicaSigned{}
-	this.ReplicaSigned = NewPopulatedSignedEpochHead(r, easy)
-	return this
-}
-func NewPopulatedKeyserverStep_VerifierSigned(r randyReplication, easy bool) *KeyserverStep_VerifierSigned {
-	this := &KeyserverStep_VerifierSigned{}
-	this.VerifierSigned = NewPopulatedSignedEpochHead(r, easy)
-	return this
-}
-func NewPopulatedEpochDelimiter(r randyReplication, easy bool) *EpochDelimiter {
-	this := &EpochDelimiter{}
-	this.EpochNumber = uint64(uint64(r.Uint32()))
-	v1 := NewPopulatedTimestamp(r, easy)
-	this.Timestamp = *v1
-	if !easy && r.Intn(10) != 0 {
+	if !bytes.Equal(this.EntryHash, that1.EntryHash) {
+		return fmt.Errorf("EntryHash this(%v) Not Equal that(%v)", this.EntryHash, that1.EntryHash)
 	}
-	return this
-}
-
-type randyReplication interface {
-	Float32() float32
-	Float64() float64
-	Int63() int64
-	Int31() int32
-	Uint32() uint32
-	Intn(n int) int
-}
-
-func randUTF8RuneReplication(r randyReplication) rune {
-	ru := r.Intn(62)
-	if ru < 10 {
-		return rune(ru + 48)
-	} else if ru < 36 {
-		return rune(ru + 55)
+	if !bytes.Equal(this.CodeHash, that1.CodeHash) {
+		return fmt.Errorf("CodeHash this(%v) Not Equal that(%v)", this.CodeHash, that1.CodeHash)
 	}
-	return rune(ru + 61)
-}
-func randStringReplication(r randyReplication) string {
-	v2 := r.Intn(100)
-	tmps := make([]rune, v2)
-	for i := 0; i < v2; i++ {
-		tmps[i] = randUTF8RuneReplication(r)
+	if !this.Expiration.Equal(&that1.Expiration) {
+		return fmt.Errorf("Expiration this(%v) Not Equal that(%v)", this.Expiration, that1.Expiration)
 	}
-	return string(tmps)
+	return nil
 }
-func randUnrecognizedReplication(r randyReplication, maxFieldNumber int) (data []byte) {
-	l := r.Intn(5)
-	for i := 0; i < l; i++ {
-		wire := r.Intn(4)
-		if wire == 3 {
-			wire = 5
+func (this *EmailChallenge) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
 		}
-		fieldNumber := maxFieldNumber + r.Intn(100)
-		data = randFieldReplication(data, r, fieldNumber, wire)
+		return false
 	}
-	return data
-}
-func randFieldReplication(data []byte, r randyReplication, fieldNumber int, wire int) []byte {
-	key := uint32(fieldNumber)<<3 | uint32(wire)
-	switch wire {
-	case 0:
-		data = encodeVarintPopulateReplication(data, uint64(key))
-		v3 := r.Int63()
-		if r.Intn(2) == 0 {
-			v3 *= -1
+
+	that1, ok := that.(*EmailChallenge)
+	if !ok {
+		that2, ok := that.(EmailChallenge)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		data = encodeVarintPopulateReplication(data, uint64(v3))
-	case 1:
-		data = encodeVarintPopulateReplication(data, uint64(key))
-		data = append(data, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
-	case 2:
-		data = encodeVarintPopulateReplication(data, uint64(key))
-		ll := r.Intn(100)
-		data = encodeVarintPopulateReplication(data, uint64(ll))
-		for j := 0; j < ll; j++ {
-			data = append(data, byte(r.Intn(256)))
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
 		}
-	default:
-		data = encodeVarintPopulateReplication(data, uint64(key))
-		data = append(data, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+		return false
+	} else if this == nil {
+		return false
 	}
-	return data
-}
-func encodeVarintPopulateReplication(data []byte, v uint64) []byte {
-	for v >= 1<<7 {
-		data = append(data, uint8(uint64(v)&0x7f|0x80))
-		v >>= 7
+	if !bytes.Equal(this.Index, that1.Index) {
+		return false
 	}
-	data = append(data, uint8(v))
-	return data
-}
-func (m *KeyserverStep) Size() (n int) {
-	var l int
-	_ = l
-	if m.UID != 0 {
-		n += 9
+	if !bytes.Equal(this.EntryHash, that1.EntryHash) {
+		return false
 	}
-	if m.Type != nil {
-		n += m.Type.Size()
+	if !bytes.Equal(this.CodeHash, that1.CodeHash) {
+		return false
 	}
-	return n
-}
-
-func (m *KeyserverStep_Update) Size() (n int) {
-	var l int
-	_ = l
-	if m.Update != nil {
-		l = m.Update.Size()
-		n += 1 + l + sovReplication(uint64(l))
+	if !this.Expiration.Equal(&that1.Expiration) {
+		return false
 	}
-	return n
+	return true
 }
-func (m *KeyserverStep_EpochDelimiter) Size() (n int) {
-	var l int
-	_ = l
-	if m.EpochDelimiter != nil {
-		l = m.EpochDelimiter.Size()
-		n += 1 + l + sovReplication(uint64(l))
+func (this *PendingUpdate) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
 	}
-	return n
-}
-func (m *KeyserverStep_ReplicaSigned) Size() (n int) {
-	var l int
-	_ = l
-	if m.ReplicaSigned != nil {
-		l = m.ReplicaSigned.Size()
-		n += 1 + l + sovReplication(uint64(l))
+
+	that1, ok := that.(*PendingUpdate)
+	if !ok {
+		that2, ok := that.(PendingUpdate)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *PendingUpdate")
+		}
 	}
-	return n
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *PendingUpdate but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *PendingUpdate but is not nil && this == nil")
+	}
+	if !this.Update.Equal(that1.Update) {
+		return fmt.Errorf("Update this(%v) Not Equal that(%v)", this.Update, that1.Update)
+	}
+	if !this.Expiration.Equal(&that1.Expiration) {
+		return fmt.Errorf("Expiration this(%v) Not Equal that(%v)", this.Expiration, that1.Expiration)
+	}
+	return nil
 }
-func (m *KeyserverStep_VerifierSigned) Size() (n int) {
-	var l int
-	_ = l
-	if m.VerifierSigned != nil {
-		l = m.VerifierSigned.Size()
-		n += 1 + l + sovReplication(uint64(l))
+func (this *PendingUpdate) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
 	}
-	return n
+
+	that1, ok := that.(*PendingUpdate)
+	if !ok {
+		that2, ok := that.(PendingUpdate)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if !this.Update.Equal(that1.Update) {
+		return false
+	}
+	if !this.Expiration.Equal(&that1.Expiration) {
+		return false
+	}
+	return true
 }
-func (m *EpochDelimiter) Size() (n int) {
+func (this *KeyserverStep) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 15)
+	s = append(s, "&proto.KeyserverStep{")
+	s = append(s, "UID: "+fmt.Sprintf("%#v", this.UID)+",\n")
+	if this.Type != nil {
+		s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *KeyserverStep_Update) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&proto.KeyserverStep_Update{` +
+		`Update:` + fmt.Sprintf("%#v", this.Update) + `}`}, ", ")
+	return s
+}
+func (this *KeyserverStep_EpochDelimiter) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&proto.KeyserverStep_EpochDelimiter{` +
+		`EpochDelimiter:` + fmt.Sprintf("%#v", this.EpochDelimiter) + `}`}, ", ")
+	return s
+}
+func (this *KeyserverStep_ReplicaSigned) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&proto.KeyserverStep_ReplicaSigned{` +
+		`ReplicaSigned:` + fmt.Sprintf("%#v", this.ReplicaSigned) + `}`}, ", ")
+	return s
+}
+func (this *KeyserverStep_VerifierSigned) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&proto.KeyserverStep_VerifierSigned{` +
+		`VerifierSigned:` + fmt.Sprintf("%#v", this.VerifierSigned) + `}`}, ", ")
+	return s
+}
+func (this *KeyserverStep_EpochRefresh) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&proto.KeyserverStep_EpochRefresh{` +
+		`EpochRefresh:` + fmt.Sprintf("%#v", this.EpochRefresh) + `}`}, ", ")
+	return s
+}
+func (this *KeyserverStep_EmailChallenge) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&proto.KeyserverStep_EmailChallenge{` +
+		`EmailChallenge:` + fmt.Sprintf("%#v", this.EmailChallenge) + `}`}, ", ")
+	return s
+}
+func (this *KeyserverStep_PendingUpdate) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&proto.KeyserverStep_PendingUpdate{` +
+		`PendingUpdate:` + fmt.Sprintf("%#v", this.PendingUpdate) + `}`}, ", ")
+	return s
+}
+func (this *KeyserverStep_RecoveryStart) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&proto.KeyserverStep_RecoveryStart{` +
+		`RecoveryStart:` + fmt.Sprintf("%#v", this.RecoveryStart) + `}`}, ", ")
+	return s
+}
+func (this *KeyserverStep_RecoveryVeto) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&proto.KeyserverStep_RecoveryVeto{` +
+		`RecoveryVeto:` + fmt.Sprintf("%#v", this.RecoveryVeto) + `}`}, ", ")
+	return s
+}
+func (this *KeyserverStep_VrfRotation) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&proto.KeyserverStep_VrfRotation{` +
+		`VrfRotation:` + fmt.Sprintf("%#v", this.VrfRotation) + `}`}, ", ")
+	return s
+}
+func (this *EpochDelimiter) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&proto.EpochDelimiter{")
+	s = append(s, "EpochNumber: "+fmt.Sprintf("%#v", this.EpochNumber)+",\n")
+	s = append(s, "Timestamp: "+strings.Replace(this.Timestamp.GoString(), `&`, ``, 1)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *EmailChallenge) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&proto.EmailChallenge{")
+	s = append(s, "Index: "+fmt.Sprintf("%#v", this.Index)+",\n")
+	s = append(s, "EntryHash: "+fmt.Sprintf("%#v", this.EntryHash)+",\n")
+	s = append(s, "CodeHash: "+fmt.Sprintf("%#v", this.CodeHash)+",\n")
+	s = append(s, "Expiration: "+strings.Replace(this.Expiration.GoString(), `&`, ``, 1)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PendingUpdate) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&proto.PendingUpdate{")
+	if this.Update != nil {
+		s = append(s, "Update: "+fmt.Sprintf("%#v", this.Update)+",\n")
+	}
+	s = append(s, "Expiration: "+strings.Replace(this.Expiration.GoString(), `&`, ``, 1)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringReplication(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func extensionToGoStringReplication(m github_com_maditya_protobuf_proto.Message) string {
+	e := github_com_maditya_protobuf_proto.GetUnsafeExtensionsMap(m)
+	if e == nil {
+		return "nil"
+	}
+	s := "proto.NewUnsafeXXX_InternalExtensions(map[int32]proto.Extension{"
+	keys := make([]int, 0, len(e))
+	for k := range e {
+		keys = append(keys, int(k))
+	}
+	sort.Ints(keys)
+	ss := []string{}
+	for _, k := range keys {
+		ss = append(ss, strconv.Itoa(k)+": "+e[int32(k)].GoString())
+	}
+	s += strings.Join(ss, ",") + "})"
+	return s
+}
+func (m *KeyserverStep) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *KeyserverStep) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.EpochNumber != 0 {
-		n += 1 + sovReplication(uint64(m.EpochNumber))
+	if m.UID != 0 {
+		data[i] = 0x9
+		i++
+		i = encodeFixed64Replication(data, i, uint64(m.UID))
 	}
-	l = m.Timestamp.Size()
-	n += 1 + l + sovReplication(uint64(l))
-	return n
+	if m.Type != nil {
+		nn1, err := m.Type.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += nn1
+	}
+	return i, nil
 }
 
-func sovReplication(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
+func (m *KeyserverStep_Update) MarshalTo(data []byte) (int, error) {
+	i := 0
+	if m.Update != nil {
+		data[i] = 0x12
+		i++
+		i = encodeVarintReplication(data, i, uint64(m.Update.Size()))
+		n2, err := m.Update.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	return i, nil
+}
+func (m *KeyserverStep_EpochDelimiter) MarshalTo(data []byte) (int, error) {
+	i := 0
+	if m.EpochDelimiter != nil {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintReplication(data, i, uint64(m.EpochDelimiter.Size()))
+		n3, err := m.EpochDelimiter.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	return i, nil
+}
+func (m *KeyserverStep_ReplicaSigned) MarshalTo(data []byte) (int, error) {
+	i := 0
+	if m.ReplicaSigned != nil {
+		data[i] = 0x22
+		i++
+		i = encodeVarintReplication(data, i, uint64(m.ReplicaSigned.Size()))
+		n4, err := m.ReplicaSigned.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	return i, nil
+}
+func (m *KeyserverStep_VerifierSigned) MarshalTo(data []byte) (int, error) {
+	i := 0
+	if m.VerifierSigned != nil {
+		data[i] = 0x2a
+		i++
+		i = encodeVarintReplication(data, i, uint64(m.VerifierSigned.Size()))
+		n5, err := m.VerifierSigned.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	return i, nil
+}
+func (m *KeyserverStep_EpochRefresh) MarshalTo(data []byte) (int, error) {
+	i := 0
+	if m.EpochRefresh != nil {
+		data[i] = 0x32
+		i++
+		i = encodeVarintReplication(data, i, uint64(m.EpochRefresh.Size()))
+		n6, err := m.EpochRefresh.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
+	return i, nil
+}
+func (m *KeyserverStep_EmailChallenge) MarshalTo(data []byte) (int, error) {
+	i := 0
+	if m.EmailChallenge != nil {
+		data[i] = 0x3a
+		i++
+		i = encodeVarintReplication(data, i, uint64(m.EmailChallenge.Size()))
+		n7, err := m.EmailChallenge.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n7
+	}
+	return i, nil
+}
+func (m *KeyserverStep_PendingUpdate) MarshalTo(data []byte) (int, error) {
+	i := 0
+	if m.PendingUpdate != nil {
+		data[i] = 0x42
+		i++
+		i = encodeVarintReplication(data, i, uint64(m.PendingUpdate.Size()))
+		n8, err := m.PendingUpdate.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n8
+	}
+	return i, nil
+}
+func (m *KeyserverStep_RecoveryStart) MarshalTo(data []byte) (int, error) {
+	i := 0
+	if m.RecoveryStart != nil {
+		data[i] = 0x4a
+		i++
+		i = encodeVarintReplication(data, i, uint64(m.RecoveryStart.Size()))
+		n9, err := m.RecoveryStart.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n9
+	}
+	return i, nil
+}
+func (m *KeyserverStep_RecoveryVeto) MarshalTo(data []byte) (int, error) {
+	i := 0
+	if m.RecoveryVeto != nil {
+		data[i] = 0x52
+		i++
+		i = encodeVarintReplication(data, i, uint64(m.RecoveryVeto.Size()))
+		n10, err := m.RecoveryVeto.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n10
+	}
+	return i, nil
+}
+func (m *KeyserverStep_VrfRotation) MarshalTo(data []byte) (int, error) {
+	i := 0
+	if m.VrfRotation != nil {
+		data[i] = 0x5a
+		i++
+		i = encodeVarintReplication(data, i, uint64(m.VrfRotation.Size()))
+		n11, err := m.VrfRotation.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n11
+	}
+	return i, nil
+}
+func (m *EpochDelimiter) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *EpochDelimiter) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		data[i] = 0x8
+		i++
+		i = encodeVarintReplication(data, i, uint64(m.EpochNumber))
+	}
+	data[i] = 0x12
+	i++
+	i = encodeVarintReplication(data, i, uint64(m.Timestamp.Size()))
+	n12, err := m.Timestamp.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n12
+	return i, nil
+}
+
+func (m *EmailChallenge) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *EmailChallenge) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Index) > 0 {
+		data[i] = 0xa
+		i++
+		i = encodeVarintReplication(data, i, uint64(len(m.Index)))
+		i += copy(data[i:], m.Index)
+	}
+	if len(m.EntryHash) > 0 {
+		data[i] = 0x12
+		i++
+		i = encodeVarintReplication(data, i, uint64(len(m.EntryHash)))
+		i += copy(data[i:], m.EntryHash)
+	}
+	if len(m.CodeHash) > 0 {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintReplication(data, i, uint64(len(m.CodeHash)))
+		i += copy(data[i:], m.CodeHash)
+	}
+	data[i] = 0x22
+	i++
+	i = encodeVarintReplication(data, i, uint64(m.Expiration.Size()))
+	n13, err := m.Expiration.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n13
+	return i, nil
+}
+
+func (m *PendingUpdate) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *PendingUpdate) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Update != nil {
+		data[i] = 0xa
+		i++
+		i = encodeVarintReplication(data, i, uint64(m.Update.Size()))
+		n14, err := m.Update.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n14
+	}
+	data[i] = 0x12
+	i++
+	i = encodeVarintReplication(data, i, uint64(m.Expiration.Size()))
+	n15, err := m.Expiration.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n15
+	return i, nil
+}
+
+func encodeFixed64Replication(data []byte, offset int, v uint64) int {
+	data[offset] = uint8(v)
+	data[offset+1] = uint8(v >> 8)
+	data[offset+2] = uint8(v >> 16)
+	data[offset+3] = uint8(v >> 24)
+	data[offset+4] = uint8(v >> 32)
+	data[offset+5] = uint8(v >> 40)
+	data[offset+6] = uint8(v >> 48)
+	data[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32Replication(data []byte, offset int, v uint32) int {
+	data[offset] = uint8(v)
+	data[offset+1] = uint8(v >> 8)
+	data[offset+2] = uint8(v >> 16)
+	data[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintReplication(data []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		data[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	data[offset] = uint8(v)
+	return offset + 1
+}
+func NewPopulatedKeyserverStep(r randyReplication, easy bool) *KeyserverStep {
+	this := &KeyserverStep{}
+	this.UID = uint64(uint64(r.Uint32()))
+	oneofNumber_Type := []int32{2, 3, 4, 5, 6, 7, 8, 9, 10, 11}[r.Intn(10)]
+	switch oneofNumber_Type {
+	case 2:
+		this.Type = NewPopulatedKeyserverStep_Update(r, easy)
+	case 3:
+		this.Type = NewPopulatedKeyserverStep_EpochDelimiter(r, easy)
+	case 4:
+		this.Type = NewPopulatedKeyserverStep_ReplicaSigned(r, easy)
+	case 5:
+		this.Type = NewPopulatedKeyserverStep_VerifierSigned(r, easy)
+	case 6:
+		this.Type = NewPopulatedKeyserverStep_EpochRefresh(r, easy)
+	case 7:
+		this.Type = NewPopulatedKeyserverStep_EmailChallenge(r, easy)
+	case 8:
+		this.Type = NewPopulatedKeyserverStep_PendingUpdate(r, easy)
+	case 9:
+		this.Type = NewPopulatedKeyserverStep_RecoveryStart(r, easy)
+	case 10:
+		this.Type = NewPopulatedKeyserverStep_RecoveryVeto(r, easy)
+	case 11:
+		this.Type = NewPopulatedKeyserverStep_VrfRotation(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedKeyserverStep_Update(r randyReplication, easy bool) *KeyserverStep_Update {
+	this := &KeyserverStep_Update{}
+	this.Update = NewPopulatedUpdateRequest(r, easy)
+	return this
+}
+func NewPopulatedKeyserverStep_EpochDelimiter(r randyReplication, easy bool) *KeyserverStep_EpochDelimiter {
+	this := &KeyserverStep_EpochDelimiter{}
+	this.EpochDelimiter = NewPopulatedEpochDelimiter(r, easy)
+	return this
+}
+func NewPopulatedKeyserverStep_ReplicaSigned(r randyReplication, easy bool) *KeyserverStep_ReplicaSigned {
+	this := &KeyserverStep_ReplicaSigned{}
+	this.ReplicaSigned = NewPopulatedSignedEpochHead(r, easy)
+	return this
+}
+func NewPopulatedKeyserverStep_VerifierSigned(r randyReplication, easy bool) *KeyserverStep_VerifierSigned {
+	this := &KeyserverStep_VerifierSigned{}
+	this.VerifierSigned = NewPopulatedSignedEpochHead(r, easy)
+	return this
+}
+func NewPopulatedKeyserverStep_EpochRefresh(r randyReplication, easy bool) *KeyserverStep_EpochRefresh {
+	this := &KeyserverStep_EpochRefresh{}
+	this.EpochRefresh = NewPopulatedEpochDelimiter(r, easy)
+	return this
+}
+func NewPopulatedKeyserverStep_EmailChallenge(r randyReplication, easy bool) *KeyserverStep_EmailChallenge {
+	this := &KeyserverStep_EmailChallenge{}
+	this.EmailChallenge = NewPopulatedEmailChallenge(r, easy)
+	return this
+}
+func NewPopulatedKeyserverStep_PendingUpdate(r randyReplication, easy bool) *KeyserverStep_PendingUpdate {
+	this := &KeyserverStep_PendingUpdate{}
+	this.PendingUpdate = NewPopulatedUpdateRequest(r, easy)
+	return this
+}
+func NewPopulatedKeyserverStep_RecoveryStart(r randyReplication, easy bool) *KeyserverStep_RecoveryStart {
+	this := &KeyserverStep_RecoveryStart{}
+	this.RecoveryStart = NewPopulatedUpdateRequest(r, easy)
+	return this
+}
+func NewPopulatedKeyserverStep_RecoveryVeto(r randyReplication, easy bool) *KeyserverStep_RecoveryVeto {
+	this := &KeyserverStep_RecoveryVeto{}
+	this.RecoveryVeto = NewPopulatedRecoveryVeto(r, easy)
+	return this
+}
+func NewPopulatedKeyserverStep_VrfRotation(r randyReplication, easy bool) *KeyserverStep_VrfRotation {
+	this := &KeyserverStep_VrfRotation{}
+	this.VrfRotation = NewPopulatedVRFRotation(r, easy)
+	return this
+}
+func NewPopulatedEpochDelimiter(r randyReplication, easy bool) *EpochDelimiter {
+	this := &EpochDelimiter{}
+	this.EpochNumber = uint64(uint64(r.Uint32()))
+	v1 := NewPopulatedTimestamp(r, easy)
+	this.Timestamp = *v1
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedEmailChallenge(r randyReplication, easy bool) *EmailChallenge {
+	this := &EmailChallenge{}
+	v2 := r.Intn(100)
+	this.Index = make([]byte, v2)
+	for i := 0; i < v2; i++ {
+		this.Index[i] = byte(r.Intn(256))
+	}
+	v3 := r.Intn(100)
+	this.EntryHash = make([]byte, v3)
+	for i := 0; i < v3; i++ {
+		this.EntryHash[i] = byte(r.Intn(256))
+	}
+	v4 := r.Intn(100)
+	this.CodeHash = make([]byte, v4)
+	for i := 0; i < v4; i++ {
+		this.CodeHash[i] = byte(r.Intn(256))
+	}
+	v5 := NewPopulatedTimestamp(r, easy)
+	this.Expiration = *v5
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedPendingUpdate(r randyReplication, easy bool) *PendingUpdate {
+	this := &PendingUpdate{}
+	if r.Intn(10) == 0 {
+		this.Update = NewPopulatedUpdateRequest(r, easy)
+	}
+	v6 := NewPopulatedTimestamp(r, easy)
+	this.Expiration = *v6
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+type randyReplication interface {
+	Float32() float32
+	Float64() float64
+	Int63() int64
+	Int31() int32
+	Uint32() uint32
+	Intn(n int) int
+}
+
+func randUTF8RuneReplication(r randyReplication) rune {
+	ru := r.Intn(62)
+	if ru < 10 {
+		return rune(ru + 48)
+	} else if ru < 36 {
+		return rune(ru + 55)
+	}
+	return rune(ru + 61)
+}
+func randStringReplication(r randyReplication) string {
+	v7 := r.Intn(100)
+	tmps := make([]rune, v7)
+	for i := 0; i < v7; i++ {
+		tmps[i] = randUTF8RuneReplication(r)
+	}
+	return string(tmps)
+}
+func randUnrecognizedReplication(r randyReplication, maxFieldNumber int) (data []byte) {
+	l := r.Intn(5)
+	for i := 0; i < l; i++ {
+		wire := r.Intn(4)
+		if wire == 3 {
+			wire = 5
+		}
+		fieldNumber := maxFieldNumber + r.Intn(100)
+		data = randFieldReplication(data, r, fieldNumber, wire)
+	}
+	return data
+}
+func randFieldReplication(data []byte, r randyReplication, fieldNumber int, wire int) []byte {
+	key := uint32(fieldNumber)<<3 | uint32(wire)
+	switch wire {
+	case 0:
+		data = encodeVarintPopulateReplication(data, uint64(key))
+		v8 := r.Int63()
+		if r.Intn(2) == 0 {
+			v8 *= -1
+		}
+		data = encodeVarintPopulateReplication(data, uint64(v8))
+	case 1:
+		data = encodeVarintPopulateReplication(data, uint64(key))
+		data = append(data, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	case 2:
+		data = encodeVarintPopulateReplication(data, uint64(key))
+		ll := r.Intn(100)
+		data = encodeVarintPopulateReplication(data, uint64(ll))
+		for j := 0; j < ll; j++ {
+			data = append(data, byte(r.Intn(256)))
+		}
+	default:
+		data = encodeVarintPopulateReplication(data, uint64(key))
+		data = append(data, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	}
+	return data
+}
+func encodeVarintPopulateReplication(data []byte, v uint64) []byte {
+	for v >= 1<<7 {
+		data = append(data, uint8(uint64(v)&0x7f|0x80))
+		v >>= 7
+	}
+	data = append(data, uint8(v))
+	return data
+}
+func (m *KeyserverStep) Size() (n int) {
+	var l int
+	_ = l
+	if m.UID != 0 {
+		n += 9
+	}
+	if m.Type != nil {
+		n += m.Type.Size()
+	}
+	return n
+}
+
+func (m *KeyserverStep_Update) Size() (n int) {
+	var l int
+	_ = l
+	if m.Update != nil {
+		l = m.Update.Size()
+		n += 1 + l + sovReplication(uint64(l))
+	}
+	return n
+}
+func (m *KeyserverStep_EpochDelimiter) Size() (n int) {
+	var l int
+	_ = l
+	if m.EpochDelimiter != nil {
+		l = m.EpochDelimiter.Size()
+		n += 1 + l + sovReplication(uint64(l))
+	}
+	return n
+}
+func (m *KeyserverStep_ReplicaSigned) Size() (n int) {
+	var l int
+	_ = l
+	if m.ReplicaSigned != nil {
+		l = m.ReplicaSigned.Size()
+		n += 1 + l + sovReplication(uint64(l))
+	}
+	return n
+}
+func (m *KeyserverStep_VerifierSigned) Size() (n int) {
+	var l int
+	_ = l
+	if m.VerifierSigned != nil {
+		l = m.VerifierSigned.Size()
+		n += 1 + l + sovReplication(uint64(l))
+	}
+	return n
+}
+func (m *KeyserverStep_EpochRefresh) Size() (n int) {
+	var l int
+	_ = l
+	if m.EpochRefresh != nil {
+		l = m.EpochRefresh.Size()
+		n += 1 + l + sovReplication(uint64(l))
+	}
+	return n
+}
+func (m *KeyserverStep_EmailChallenge) Size() (n int) {
+	var l int
+	_ = l
+	if m.EmailChallenge != nil {
+		l = m.EmailChallenge.Size()
+		n += 1 + l + sovReplication(uint64(l))
+	}
+	return n
+}
+func (m *KeyserverStep_PendingUpdate) Size() (n int) {
+	var l int
+	_ = l
+	if m.PendingUpdate != nil {
+		l = m.PendingUpdate.Size()
+		n += 1 + l + sovReplication(uint64(l))
+	}
+	return n
+}
+func (m *KeyserverStep_RecoveryStart) Size() (n int) {
+	var l int
+	_ = l
+	if m.RecoveryStart != nil {
+		l = m.RecoveryStart.Size()
+		n += 1 + l + sovReplication(uint64(l))
+	}
+	return n
+}
+func (m *KeyserverStep_RecoveryVeto) Size() (n int) {
+	var l int
+	_ = l
+	if m.RecoveryVeto != nil {
+		l = m.RecoveryVeto.Size()
+		n += 1 + l + sovReplication(uint64(l))
+	}
+	return n
+}
+func (m *KeyserverStep_VrfRotation) Size() (n int) {
+	var l int
+	_ = l
+	if m.VrfRotation != nil {
+		l = m.VrfRotation.Size()
+		n += 1 + l + sovReplication(uint64(l))
+	}
+	return n
+}
+func (m *EpochDelimiter) Size() (n int) {
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovReplication(uint64(m.EpochNumber))
+	}
+	l = m.Timestamp.Size()
+	n += 1 + l + sovReplication(uint64(l))
+	return n
+}
+
+func (m *EmailChallenge) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovReplication(uint64(l))
+	}
+	l = len(m.EntryHash)
+	if l > 0 {
+		n += 1 + l + sovReplication(uint64(l))
+	}
+	l = len(m.CodeHash)
+	if l > 0 {
+		n += 1 + l + sovReplication(uint64(l))
+	}
+	l = m.Expiration.Size()
+	n += 1 + l + sovReplication(uint64(l))
+	return n
+}
+
+func (m *PendingUpdate) Size() (n int) {
+	var l int
+	_ = l
+	if m.Update != nil {
+		l = m.Update.Size()
+		n += 1 + l + sovReplication(uint64(l))
+	}
+	l = m.Expiration.Size()
+	n += 1 + l + sovReplication(uint64(l))
+	return n
+}
+
+func sovReplication(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozReplication(x uint64) (n int) {
+	return sovReplication(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *KeyserverStep) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&KeyserverStep{`,
+		`UID:` + fmt.Sprintf("%v", this.UID) + `,`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *KeyserverStep_Update) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&KeyserverStep_Update{`,
+		`Update:` + strings.Replace(fmt.Sprintf("%v", this.Update), "UpdateRequest", "UpdateRequest", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *KeyserverStep_EpochDelimiter) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&KeyserverStep_EpochDelimiter{`,
+		`EpochDelimiter:` + strings.Replace(fmt.Sprintf("%v", this.EpochDelimiter), "EpochDelimiter", "EpochDelimiter", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *KeyserverStep_ReplicaSigned) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&KeyserverStep_ReplicaSigned{`,
+		`ReplicaSigned:` + strings.Replace(fmt.Sprintf("%v", this.ReplicaSigned), "SignedEpochHead", "SignedEpochHead", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *KeyserverStep_VerifierSigned) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&KeyserverStep_VerifierSigned{`,
+		`VerifierSigned:` + strings.Replace(fmt.Sprintf("%v", this.VerifierSigned), "SignedEpochHead", "SignedEpochHead", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *KeyserverStep_EpochRefresh) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&KeyserverStep_EpochRefresh{`,
+		`EpochRefresh:` + strings.Replace(fmt.Sprintf("%v", this.EpochRefresh), "EpochDelimiter", "EpochDelimiter", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *KeyserverStep_EmailChallenge) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&KeyserverStep_EmailChallenge{`,
+		`EmailChallenge:` + strings.Replace(fmt.Sprintf("%v", this.EmailChallenge), "EmailChallenge", "EmailChallenge", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *KeyserverStep_PendingUpdate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&KeyserverStep_PendingUpdate{`,
+		`PendingUpdate:` + strings.Replace(fmt.Sprintf("%v", this.PendingUpdate), "UpdateRequest", "UpdateRequest", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *KeyserverStep_RecoveryStart) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&KeyserverStep_RecoveryStart{`,
+		`RecoveryStart:` + strings.Replace(fmt.Sprintf("%v", this.RecoveryStart), "UpdateRequest", "UpdateRequest", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *KeyserverStep_RecoveryVeto) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&KeyserverStep_RecoveryVeto{`,
+		`RecoveryVeto:` + strings.Replace(fmt.Sprintf("%v", this.RecoveryVeto), "RecoveryVeto", "RecoveryVeto", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *KeyserverStep_VrfRotation) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&KeyserverStep_VrfRotation{`,
+		`VrfRotation:` + strings.Replace(fmt.Sprintf("%v", this.VrfRotation), "VRFRotation", "VRFRotation", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EpochDelimiter) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EpochDelimiter{`,
+		`EpochNumber:` + fmt.Sprintf("%v", this.EpochNumber) + `,`,
+		`Timestamp:` + strings.Replace(strings.Replace(this.Timestamp.String(), "Timestamp", "Timestamp", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EmailChallenge) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EmailChallenge{`,
+		`Index:` + fmt.Sprintf("%v", this.Index) + `,`,
+		`EntryHash:` + fmt.Sprintf("%v", this.EntryHash) + `,`,
+		`CodeHash:` + fmt.Sprintf("%v", this.CodeHash) + `,`,
+		`Expiration:` + strings.Replace(strings.Replace(this.Expiration.String(), "Timestamp", "Timestamp", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PendingUpdate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PendingUpdate{`,
+		`Update:` + strings.Replace(fmt.Sprintf("%v", this.Update), "UpdateRequest", "UpdateRequest", 1) + `,`,
+		`Expiration:` + strings.Replace(strings.Replace(this.Expiration.String(), "Timestamp", "Timestamp", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringReplication(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *KeyserverStep) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KeyserverStep: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KeyserverStep: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UID", wireType)
+			}
+			m.UID = 0
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			m.UID = uint64(data[iNdEx-8])
+			m.UID |= uint64(data[iNdEx-7]) << 8
+			m.UID |= uint64(data[iNdEx-6]) << 16
+			m.UID |= uint64(data[iNdEx-5]) << 24
+			m.UID |= uint64(data[iNdEx-4]) << 32
+			m.UID |= uint64(data[iNdEx-3]) << 40
+			m.UID |= uint64(data[iNdEx-2]) << 48
+			m.UID |= uint64(data[iNdEx-1]) << 56
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &UpdateRequest{}
+			if err := v.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Type = &KeyserverStep_Update{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochDelimiter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &EpochDelimiter{}
+			if err := v.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Type = &KeyserverStep_EpochDelimiter{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicaSigned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &SignedEpochHead{}
+			if err := v.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Type = &KeyserverStep_ReplicaSigned{v}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifierSigned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &SignedEpochHead{}
+			if err := v.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Type = &KeyserverStep_VerifierSigned{v}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochRefresh", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &EpochDelimiter{}
+			if err := v.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Type = &KeyserverStep_EpochRefresh{v}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmailChallenge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &EmailChallenge{}
+			if err := v.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Type = &KeyserverStep_EmailChallenge{v}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &UpdateRequest{}
+			if err := v.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Type = &KeyserverStep_PendingUpdate{v}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecoveryStart", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &UpdateRequest{}
+			if err := v.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Type = &KeyserverStep_RecoveryStart{v}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecoveryVeto", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &RecoveryVeto{}
+			if err := v.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Type = &KeyserverStep_RecoveryVeto{v}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VrfRotation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &VRFRotation{}
+			if err := v.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Type = &KeyserverStep_VrfRotation{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReplication(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthReplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-func sozReplication(x uint64) (n int) {
-	return sovReplication(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (this *KeyserverStep) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&KeyserverStep{`,
-		`UID:` + fmt.Sprintf("%v", this.UID) + `,`,
-		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *KeyserverStep_Update) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&KeyserverStep_Update{`,
-		`Update:` + strings.Replace(fmt.Sprintf("%v", this.Update), "UpdateRequest", "UpdateRequest", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *KeyserverStep_EpochDelimiter) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&KeyserverStep_EpochDelimiter{`,
-		`EpochDelimiter:` + strings.Replace(fmt.Sprintf("%v", this.EpochDelimiter), "EpochDelimiter", "EpochDelimiter", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *KeyserverStep_ReplicaSigned) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&KeyserverStep_ReplicaSigned{`,
-		`ReplicaSigned:` + strings.Replace(fmt.Sprintf("%v", this.ReplicaSigned), "SignedEpochHead", "SignedEpochHead", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *KeyserverStep_VerifierSigned) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&KeyserverStep_VerifierSigned{`,
-		`VerifierSigned:` + strings.Replace(fmt.Sprintf("%v", this.VerifierSigned), "SignedEpochHead", "SignedEpochHead", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *EpochDelimiter) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&EpochDelimiter{`,
-		`EpochNumber:` + fmt.Sprintf("%v", this.EpochNumber) + `,`,
-		`Timestamp:` + strings.Replace(strings.Replace(this.Timestamp.String(), "Timestamp", "Timestamp", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringReplication(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return nil
 }
-func (m *KeyserverStep) Unmarshal(data []byte) error {
+func (m *EpochDelimiter) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1131,32 +2747,34 @@ func (m *KeyserverStep) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KeyserverStep: wiretype end group for non-group")
+			return fmt.Errorf("proto: EpochDelimiter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KeyserverStep: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EpochDelimiter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UID", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
 			}
-			m.UID = 0
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.EpochNumber |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += 8
-			m.UID = uint64(data[iNdEx-8])
-			m.UID |= uint64(data[iNdEx-7]) << 8
-			m.UID |= uint64(data[iNdEx-6]) << 16
-			m.UID |= uint64(data[iNdEx-5]) << 24
-			m.UID |= uint64(data[iNdEx-4]) << 32
-			m.UID |= uint64(data[iNdEx-3]) << 40
-			m.UID |= uint64(data[iNdEx-2]) << 48
-			m.UID |= uint64(data[iNdEx-1]) << 56
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1180,17 +2798,65 @@ func (m *KeyserverStep) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &UpdateRequest{}
-			if err := v.Unmarshal(data[iNdEx:postIndex]); err != nil {
+			if err := m.Timestamp.Unmarshal(data[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Type = &KeyserverStep_Update{v}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReplication(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthReplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EmailChallenge) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EmailChallenge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EmailChallenge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochDelimiter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowReplication
@@ -1200,29 +2866,28 @@ func (m *KeyserverStep) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthReplication
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &EpochDelimiter{}
-			if err := v.Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
+			m.Index = append(m.Index[:0], data[iNdEx:postIndex]...)
+			if m.Index == nil {
+				m.Index = []byte{}
 			}
-			m.Type = &KeyserverStep_EpochDelimiter{v}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReplicaSigned", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EntryHash", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowReplication
@@ -1232,27 +2897,57 @@ func (m *KeyserverStep) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthReplication
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &SignedEpochHead{}
-			if err := v.Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
+			m.EntryHash = append(m.EntryHash[:0], data[iNdEx:postIndex]...)
+			if m.EntryHash == nil {
+				m.EntryHash = []byte{}
 			}
-			m.Type = &KeyserverStep_ReplicaSigned{v}
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VerifierSigned", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthReplication
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeHash = append(m.CodeHash[:0], data[iNdEx:postIndex]...)
+			if m.CodeHash == nil {
+				m.CodeHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1276,11 +2971,9 @@ func (m *KeyserverStep) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &SignedEpochHead{}
-			if err := v.Unmarshal(data[iNdEx:postIndex]); err != nil {
+			if err := m.Expiration.Unmarshal(data[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Type = &KeyserverStep_VerifierSigned{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1303,7 +2996,7 @@ func (m *KeyserverStep) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *EpochDelimiter) Unmarshal(data []byte) error {
+func (m *PendingUpdate) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1326,17 +3019,17 @@ func (m *EpochDelimiter) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EpochDelimiter: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingUpdate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EpochDelimiter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
 			}
-			m.EpochNumber = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowReplication
@@ -1346,14 +3039,28 @@ func (m *EpochDelimiter) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.EpochNumber |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthReplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Update == nil {
+				m.Update = &UpdateRequest{}
+			}
+			if err := m.Update.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1377,7 +3084,7 @@ func (m *EpochDelimiter) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Timestamp.Unmarshal(data[iNdEx:postIndex]); err != nil {
+			if err := m.Expiration.Unmarshal(data[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1510,30 +3217,44 @@ var (
 func init() { proto1.RegisterFile("replication.proto", fileDescriptorReplication) }
 
 var fileDescriptorReplication = []byte{
-	// 385 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x90, 0xbf, 0xef, 0xd2, 0x40,
-	0x18, 0xc6, 0x7b, 0xdf, 0x6f, 0xbf, 0x4d, 0x3c, 0xa0, 0xe0, 0x45, 0x4d, 0xc3, 0x70, 0x22, 0x13,
-	0x53, 0x31, 0xea, 0xe0, 0xa6, 0x12, 0x4c, 0x6a, 0x4c, 0x1c, 0x8a, 0xcc, 0xa4, 0x3f, 0x5e, 0xca,
-	0x25, 0xf4, 0x87, 0xd7, 0x2b, 0x09, 0x9b, 0xff, 0x89, 0xab, 0x7f, 0x82, 0xa3, 0x23, 0x23, 0xa3,
-	0x93, 0xa1, 0x37, 0x39, 0x32, 0x3a, 0x1a, 0xae, 0x57, 0x0d, 0x93, 0x53, 0xdf, 0xf7, 0xf3, 0xbc,
-	0xcf, 0xd3, 0xf6, 0xc1, 0xf7, 0x39, 0x14, 0x5b, 0x16, 0x05, 0x82, 0xe5, 0x99, 0x5b, 0xf0, 0x5c,
-	0xe4, 0xe4, 0x4e, 0x3d, 0x86, 0x4f, 0x13, 0x26, 0x36, 0x55, 0xe8, 0x46, 0x79, 0x3a, 0x4d, 0x83,
-	0x98, 0x89, 0x7d, 0x30, 0x55, 0x4a, 0x58, 0xad, 0xa7, 0x49, 0x9e, 0xe4, 0x6a, 0x51, 0x53, 0x63,
-	0x1c, 0x76, 0xa3, 0x2d, 0x83, 0x4c, 0xe8, 0xad, 0x2f, 0x58, 0x0a, 0xa5, 0x08, 0xd2, 0xa2, 0x01,
-	0xe3, 0x2f, 0x37, 0xb8, 0xf7, 0x1e, 0xf6, 0x25, 0xf0, 0x1d, 0xf0, 0x85, 0x80, 0x82, 0x0c, 0xf0,
-	0xed, 0xf2, 0xdd, 0xdc, 0x41, 0x23, 0x34, 0xb1, 0xfc, 0xcb, 0x48, 0x5c, 0x6c, 0x55, 0x45, 0x1c,
-	0x08, 0x70, 0x6e, 0x46, 0x68, 0xd2, 0x79, 0xf6, 0xa0, 0xf1, 0xba, 0x4b, 0x05, 0x7d, 0xf8, 0x54,
-	0x41, 0x29, 0x3c, 0xc3, 0xd7, 0x57, 0xe4, 0x35, 0xee, 0x43, 0x91, 0x47, 0x9b, 0x55, 0x0c, 0x5b,
-	0x96, 0x32, 0x01, 0xdc, 0xb9, 0x55, 0xc6, 0x87, 0xda, 0xf8, 0xf6, 0xa2, 0xce, 0x5b, 0xd1, 0x33,
-	0x7c, 0x1b, 0xae, 0x08, 0x79, 0x85, 0x6d, 0x5d, 0xc1, 0xaa, 0x64, 0x49, 0x06, 0xb1, 0x63, 0xaa,
-	0x80, 0x47, 0x3a, 0x60, 0xa1, 0xa0, 0x8a, 0xf1, 0x20, 0x88, 0x3d, 0xc3, 0xef, 0xe9, 0xfb, 0x46,
-	0x21, 0x6f, 0x70, 0x7f, 0x07, 0x9c, 0xad, 0x19, 0xf0, 0x36, 0xe1, 0xee, 0x3f, 0x09, 0x76, 0x6b,
-	0x68, 0xa4, 0x99, 0x85, 0x4d, 0xb1, 0x2f, 0x60, 0xcc, 0xb0, 0x7d, 0xfd, 0xbd, 0xe4, 0x09, 0xee,
-	0x36, 0xff, 0x97, 0x55, 0x69, 0x08, 0x5c, 0x55, 0x65, 0xfa, 0x1d, 0xc5, 0x3e, 0x28, 0x44, 0x5e,
-	0xe0, 0x7b, 0x7f, 0x9b, 0xd6, 0xad, 0x0d, 0xf4, 0x9b, 0x3f, 0xb6, 0x7c, 0x66, 0x1e, 0x7e, 0x3e,
-	0x36, 0xfc, 0x7f, 0x87, 0xb3, 0x97, 0xc7, 0x9a, 0x1a, 0x3f, 0x6a, 0x6a, 0x9c, 0x6a, 0x8a, 0xce,
-	0x35, 0x45, 0xbf, 0x6b, 0x8a, 0x3e, 0x4b, 0x8a, 0xbe, 0x4a, 0x8a, 0xbe, 0x49, 0x8a, 0xbe, 0x4b,
-	0x8a, 0x0e, 0x92, 0xa2, 0xa3, 0xa4, 0xe8, 0x24, 0x29, 0xfa, 0x25, 0xa9, 0x71, 0x96, 0x14, 0x85,
-	0x96, 0xca, 0x7e, 0xfe, 0x27, 0x00, 0x00, 0xff, 0xff, 0x7f, 0x3d, 0xa8, 0x5f, 0x3a, 0x02, 0x00,
-	0x00,
+	// 614 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x3d, 0x6f, 0xd3, 0x4c,
+	0x1c, 0xf7, 0xb5, 0x49, 0x9e, 0xe6, 0xf2, 0xd2, 0x3e, 0x47, 0x41, 0x56, 0x11, 0x47, 0xc9, 0xd4,
+	0x01, 0xa5, 0x08, 0x10, 0x20, 0x04, 0x02, 0x4a, 0x41, 0x41, 0x48, 0x08, 0x5d, 0x69, 0x57, 0xcb,
+	0xb1, 0xff, 0x89, 0x4f, 0x8a, 0x7d, 0xe6, 0x7c, 0xb1, 0x9a, 0x8d, 0xcf, 0xc0, 0xc8, 0x27, 0xe0,
+	0x23, 0x30, 0x32, 0x76, 0xec, 0xc8, 0x84, 0x1a, 0x4f, 0x8c, 0x1d, 0x19, 0x91, 0xcf, 0xe7, 0x50,
+	0x0f, 0x04, 0xa6, 0xf8, 0xff, 0x7b, 0xbb, 0xdc, 0xef, 0xee, 0xf0, 0xff, 0x12, 0xe2, 0x09, 0xf7,
+	0x5c, 0xc5, 0x45, 0xd4, 0x8f, 0xa5, 0x50, 0x82, 0xd4, 0xf5, 0xcf, 0xd6, 0xad, 0x31, 0x57, 0xc1,
+	0x74, 0xd8, 0xf7, 0x44, 0xb8, 0x1b, 0xba, 0x3e, 0x57, 0x33, 0x77, 0x57, 0x33, 0xc3, 0xe9, 0x68,
+	0x77, 0x2c, 0xc6, 0x42, 0x0f, 0xfa, 0xab, 0x30, 0x6e, 0xb5, 0xbd, 0x09, 0x87, 0x48, 0x99, 0x69,
+	0x5d, 0xf1, 0x10, 0x12, 0xe5, 0x86, 0x71, 0x01, 0xf4, 0x3e, 0xd6, 0x71, 0xe7, 0x35, 0xcc, 0x12,
+	0x90, 0x29, 0xc8, 0x03, 0x05, 0x31, 0xd9, 0xc0, 0xab, 0x87, 0xaf, 0xf6, 0x6d, 0xb4, 0x8d, 0x76,
+	0x1a, 0x2c, 0xff, 0x24, 0x7d, 0xdc, 0x98, 0xc6, 0xbe, 0xab, 0xc0, 0x5e, 0xd9, 0x46, 0x3b, 0xad,
+	0xdb, 0x9b, 0x85, 0xb7, 0x7f, 0xa8, 0x41, 0x06, 0xef, 0xa7, 0x90, 0xa8, 0x81, 0xc5, 0x8c, 0x8a,
+	0x3c, 0xc5, 0xeb, 0x10, 0x0b, 0x2f, 0x70, 0x7c, 0x98, 0xf0, 0x90, 0x2b, 0x90, 0xf6, 0xaa, 0x36,
+	0x5e, 0x36, 0xc6, 0x17, 0x39, 0xbb, 0x5f, 0x92, 0x03, 0x8b, 0x75, 0xa1, 0x82, 0x90, 0x27, 0xb8,
+	0x6b, 0x2a, 0x70, 0x12, 0x3e, 0x8e, 0xc0, 0xb7, 0x6b, 0x3a, 0xe0, 0x8a, 0x09, 0x38, 0xd0, 0xa0,
+	0x8e, 0x19, 0x80, 0xeb, 0x0f, 0x2c, 0xd6, 0x31, 0xfa, 0x82, 0x21, 0xcf, 0xf0, 0x7a, 0x0a, 0x92,
+	0x8f, 0x38, 0xc8, 0x32, 0xa1, 0xfe, 0x97, 0x84, 0x6e, 0x69, 0x30, 0x11, 0x8f, 0x70, 0xa7, 0xd8,
+	0x85, 0x84, 0x91, 0x84, 0x24, 0xb0, 0x1b, 0xcb, 0xf7, 0xd0, 0xd6, 0x6a, 0x56, 0x88, 0x75, 0x07,
+	0xa1, 0xcb, 0x27, 0x8e, 0x17, 0xb8, 0x93, 0x09, 0x44, 0x63, 0xb0, 0xff, 0xab, 0xfa, 0x73, 0xf6,
+	0x79, 0x49, 0xea, 0x0e, 0x2a, 0x08, 0x79, 0x8c, 0xbb, 0x31, 0x44, 0x3e, 0x8f, 0xc6, 0x8e, 0x69,
+	0x7f, 0x6d, 0x69, 0xfb, 0x1d, 0xa3, 0x2e, 0xf0, 0xdc, 0x2e, 0xc1, 0x13, 0x29, 0xc8, 0x99, 0x93,
+	0x28, 0x57, 0x2a, 0xbb, 0xb9, 0xdc, 0x5e, 0xaa, 0x0f, 0x72, 0x31, 0x79, 0x88, 0x17, 0x80, 0x93,
+	0x82, 0x12, 0x36, 0xd6, 0xee, 0x4b, 0xc6, 0xcd, 0x0c, 0x77, 0x04, 0x4a, 0xe4, 0x7b, 0x97, 0x17,
+	0x66, 0x72, 0x1f, 0xb7, 0x53, 0x39, 0x72, 0xa4, 0x50, 0xfa, 0x06, 0xdb, 0x2d, 0x6d, 0x25, 0xc6,
+	0x7a, 0xc4, 0x5e, 0x32, 0xc3, 0x0c, 0x2c, 0xd6, 0x4a, 0xe5, 0xa8, 0x1c, 0xf7, 0x1a, 0xb8, 0xa6,
+	0x66, 0x31, 0xf4, 0x38, 0xee, 0x56, 0xeb, 0x25, 0x37, 0x70, 0x51, 0xaf, 0x13, 0x4d, 0xc3, 0x21,
+	0x48, 0x7d, 0x3b, 0x6b, 0xac, 0xa5, 0xb1, 0x37, 0x1a, 0x22, 0x77, 0x71, 0x73, 0x71, 0xb9, 0xcd,
+	0x45, 0xdd, 0x30, 0x4b, 0xbe, 0x2b, 0xf1, 0xbd, 0xda, 0xc9, 0xf7, 0xeb, 0x16, 0xfb, 0x2d, 0xec,
+	0x7d, 0x42, 0xb8, 0x5b, 0x3d, 0x0a, 0xb2, 0x89, 0xeb, 0x3c, 0xf2, 0xe1, 0x58, 0x2f, 0xd2, 0x66,
+	0xc5, 0x40, 0xae, 0x61, 0x0c, 0x91, 0x92, 0x33, 0x27, 0x70, 0x93, 0x40, 0xe7, 0xb7, 0x59, 0x53,
+	0x23, 0x03, 0x37, 0x09, 0xc8, 0x55, 0xdc, 0xf4, 0x84, 0x0f, 0x05, 0xbb, 0xaa, 0xd9, 0xb5, 0x1c,
+	0xd0, 0xe4, 0x3d, 0x8c, 0xe1, 0x38, 0xe6, 0xb2, 0xa8, 0xa3, 0xb6, 0xf4, 0xbf, 0x5d, 0x50, 0xf6,
+	0xa6, 0xb8, 0xf3, 0xb6, 0x72, 0xa8, 0x37, 0x17, 0x2f, 0x11, 0xfd, 0xf9, 0x30, 0x17, 0xef, 0xb0,
+	0xba, 0xec, 0xca, 0xbf, 0x2e, 0xbb, 0xf7, 0xe0, 0x74, 0x4e, 0xad, 0x6f, 0x73, 0x6a, 0x9d, 0xcd,
+	0x29, 0x3a, 0x9f, 0x53, 0xf4, 0x73, 0x4e, 0xd1, 0x87, 0x8c, 0xa2, 0xcf, 0x19, 0x45, 0x5f, 0x32,
+	0x8a, 0xbe, 0x66, 0x14, 0x9d, 0x64, 0x14, 0x9d, 0x66, 0x14, 0x9d, 0x65, 0x14, 0xfd, 0xc8, 0xa8,
+	0x75, 0x9e, 0x51, 0x34, 0x6c, 0xe8, 0xf0, 0x3b, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x4d, 0xd4,
+	0x20, 0xbf, 0xc1, 0x04, 0x00, 0x00,
 }
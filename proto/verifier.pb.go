@@ -9,6 +9,8 @@ import fmt "fmt"
 import math "math"
 import _ "github.com/maditya/protobuf/gogoproto"
 
+import bytes "bytes"
+
 import strings "strings"
 import github_com_maditya_protobuf_proto "github.com/maditya/protobuf/proto"
 import sort "sort"
@@ -27,6 +29,24 @@ var _ = proto1.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
 
+type Compression int32
+
+const (
+	UNCOMPRESSED Compression = 0
+	DEFLATE      Compression = 1
+)
+
+var Compression_name = map[int32]string{
+	0: "UNCOMPRESSED",
+	1: "DEFLATE",
+}
+var Compression_value = map[string]int32{
+	"UNCOMPRESSED": 0,
+	"DEFLATE":      1,
+}
+
+func (Compression) EnumDescriptor() ([]byte, []int) { return fileDescriptorVerifier, []int{0} }
+
 // UpdateRequest streams a specified number of committed updates or
 // ratifications. See replication.GetCommitted and replication.WaitCommitted.
 type VerifierStreamRequest struct {
@@ -36,24 +56,191 @@ type VerifierStreamRequest struct {
 	// PageSize specifies number of entries to be returned, MaxUint64 for
 	// unlimited.
 	PageSize uint64 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// BatchSize is the maximum number of steps in a VerifierStepBatch, 0 for
+	// the keyserver's default. Ignored by VerifierStream.
+	BatchSize uint64 `protobuf:"varint,3,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	// Window is the maximum number of batches the keyserver sends before
+	// waiting for an acknowledgement, 0 for 1. Ignored by VerifierStream.
+	Window uint64 `protobuf:"varint,4,opt,name=window,proto3" json:"window,omitempty"`
+	// Compression selects the encoding of VerifierStepBatch.steps. Ignored by
+	// VerifierStream.
+	Compression Compression `protobuf:"varint,5,opt,name=compression,proto3,enum=proto.Compression" json:"compression,omitempty"`
 }
 
 func (m *VerifierStreamRequest) Reset()                    { *m = VerifierStreamRequest{} }
 func (*VerifierStreamRequest) ProtoMessage()               {}
 func (*VerifierStreamRequest) Descriptor() ([]byte, []int) { return fileDescriptorVerifier, []int{0} }
 
+// VerifierBatchStreamRequest is sent by the verifier on a VerifierBatchStream.
+type VerifierBatchStreamRequest struct {
+	// Open is set in the first message of a stream, and only in it.
+	Open *VerifierStreamRequest `protobuf:"bytes,1,opt,name=open" json:"open,omitempty"`
+	// Ack acknowledges all batches whose next_index is not greater than ack.
+	Ack uint64 `protobuf:"varint,2,opt,name=ack,proto3" json:"ack,omitempty"`
+}
+
+func (m *VerifierBatchStreamRequest) Reset()      { *m = VerifierBatchStreamRequest{} }
+func (*VerifierBatchStreamRequest) ProtoMessage() {}
+func (*VerifierBatchStreamRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptorVerifier, []int{1}
+}
+
+func (m *VerifierBatchStreamRequest) GetOpen() *VerifierStreamRequest {
+	if m != nil {
+		return m.Open
+	}
+	return nil
+}
+
+// VerifierStepBatch holds the verifier steps [start, next_index).
+type VerifierStepBatch struct {
+	Start uint64 `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	// NextIndex is the checkpoint of this batch: once the steps in it have
+	// been persisted, the verifier acknowledges it by sending next_index and
+	// resumes from next_index after a reconnect.
+	NextIndex   uint64      `protobuf:"varint,2,opt,name=next_index,json=nextIndex,proto3" json:"next_index,omitempty"`
+	Compression Compression `protobuf:"varint,3,opt,name=compression,proto3,enum=proto.Compression" json:"compression,omitempty"`
+	// Steps contains the marshaled VerifierSteps, each prefixed with its
+	// length as a uvarint, compressed as specified by compression.
+	Steps []byte `protobuf:"bytes,4,opt,name=steps,proto3" json:"steps,omitempty"`
+}
+
+func (m *VerifierStepBatch) Reset()                    { *m = VerifierStepBatch{} }
+func (*VerifierStepBatch) ProtoMessage()               {}
+func (*VerifierStepBatch) Descriptor() ([]byte, []int) { return fileDescriptorVerifier, []int{2} }
+
+// CheckpointRequest asks for a checkpoint of the directory.
+type CheckpointRequest struct {
+	// QuorumRequirement specifies which verifiers must have ratified the
+	// epoch of the checkpoint. The latest such epoch is returned.
+	QuorumRequirement *QuorumExpr `protobuf:"bytes,1,opt,name=quorum_requirement,json=quorumRequirement" json:"quorum_requirement,omitempty"`
+}
+
+func (m *CheckpointRequest) Reset()                    { *m = CheckpointRequest{} }
+func (*CheckpointRequest) ProtoMessage()               {}
+func (*CheckpointRequest) Descriptor() ([]byte, []int) { return fileDescriptorVerifier, []int{3} }
+
+func (m *CheckpointRequest) GetQuorumRequirement() *QuorumExpr {
+	if m != nil {
+		return m.QuorumRequirement
+	}
+	return nil
+}
+
+// CheckpointChunk is a part of the response to GetCheckpoint.
+type CheckpointChunk struct {
+	// Ratifications contains all signed epoch heads of the checkpoint epoch,
+	// by both the keyserver and the verifiers. Only set in the first chunk.
+	Ratifications []*SignedEpochHead `protobuf:"bytes,1,rep,name=ratifications" json:"ratifications,omitempty"`
+	// NextIndex is the index of the first VerifierStep after the one that
+	// announced the checkpoint epoch. Only set in the first chunk.
+	NextIndex uint64 `protobuf:"varint,2,opt,name=next_index,json=nextIndex,proto3" json:"next_index,omitempty"`
+	// Entries contains the latest version of each directory entry as of the
+	// checkpoint epoch, at most one per index across all chunks.
+	Entries []*CheckpointEntry `protobuf:"bytes,3,rep,name=entries" json:"entries,omitempty"`
+	// PendingRecoveries contains the recoveries that were pending as of the
+	// checkpoint epoch, at most one per index across all chunks. They are not
+	// covered by the epoch head.
+	PendingRecoveries []*PendingRecovery `protobuf:"bytes,4,rep,name=pending_recoveries,json=pendingRecoveries" json:"pending_recoveries,omitempty"`
+}
+
+func (m *CheckpointChunk) Reset()                    { *m = CheckpointChunk{} }
+func (*CheckpointChunk) ProtoMessage()               {}
+func (*CheckpointChunk) Descriptor() ([]byte, []int) { return fileDescriptorVerifier, []int{4} }
+
+func (m *CheckpointChunk) GetRatifications() []*SignedEpochHead {
+	if m != nil {
+		return m.Ratifications
+	}
+	return nil
+}
+
+func (m *CheckpointChunk) GetEntries() []*CheckpointEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+func (m *CheckpointChunk) GetPendingRecoveries() []*PendingRecovery {
+	if m != nil {
+		return m.PendingRecoveries
+	}
+	return nil
+}
+
+type CheckpointEntry struct {
+	Index []byte       `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	Entry EncodedEntry `protobuf:"bytes,2,opt,name=entry,customtype=EncodedEntry" json:"entry"`
+}
+
+func (m *CheckpointEntry) Reset()                    { *m = CheckpointEntry{} }
+func (*CheckpointEntry) ProtoMessage()               {}
+func (*CheckpointEntry) Descriptor() ([]byte, []int) { return fileDescriptorVerifier, []int{5} }
+
+// EpochHeadGossip carries epoch heads signed by the keyserver, as they appear
+// in VerifierStep.
+type EpochHeadGossip struct {
+	Heads []*SignedEpochHead `protobuf:"bytes,1,rep,name=heads" json:"heads,omitempty"`
+}
+
+func (m *EpochHeadGossip) Reset()                    { *m = EpochHeadGossip{} }
+func (*EpochHeadGossip) ProtoMessage()               {}
+func (*EpochHeadGossip) Descriptor() ([]byte, []int) { return fileDescriptorVerifier, []int{6} }
+
+func (m *EpochHeadGossip) GetHeads() []*SignedEpochHead {
+	if m != nil {
+		return m.Heads
+	}
+	return nil
+}
+
+// EpochHeadEquivocation is evidence that the keyserver signed two different
+// epoch heads for the same epoch of a realm.
+type EpochHeadEquivocation struct {
+	// Ours is the epoch head seen by the verifier that detected the
+	// equivocation.
+	Ours *SignedEpochHead `protobuf:"bytes,1,opt,name=ours" json:"ours,omitempty"`
+	// Theirs is the epoch head received from the peer.
+	Theirs *SignedEpochHead `protobuf:"bytes,2,opt,name=theirs" json:"theirs,omitempty"`
+	Peer   uint64           `protobuf:"varint,3,opt,name=peer,proto3" json:"peer,omitempty"`
+}
+
+func (m *EpochHeadEquivocation) Reset()                    { *m = EpochHeadEquivocation{} }
+func (*EpochHeadEquivocation) ProtoMessage()               {}
+func (*EpochHeadEquivocation) Descriptor() ([]byte, []int) { return fileDescriptorVerifier, []int{7} }
+
+func (m *EpochHeadEquivocation) GetOurs() *SignedEpochHead {
+	if m != nil {
+		return m.Ours
+	}
+	return nil
+}
+
+func (m *EpochHeadEquivocation) GetTheirs() *SignedEpochHead {
+	if m != nil {
+		return m.Theirs
+	}
+	return nil
+}
+
 // VerifierStep denotes the input to a single state transition of the verified
 // part of the keyserver state machine.
 type VerifierStep struct {
 	// Types that are valid to be assigned to Type:
 	//	*VerifierStep_Update
 	//	*VerifierStep_Epoch
+	//	*VerifierStep_RecoveryStart
+	//	*VerifierStep_RecoveryVeto
+	//	*VerifierStep_AdminUpdate
+	//	*VerifierStep_VRFRotation
+	//	*VerifierStep_EpochRefresh
 	Type isVerifierStep_Type `protobuf_oneof:"type"`
 }
 
 func (m *VerifierStep) Reset()                    { *m = VerifierStep{} }
 func (*VerifierStep) ProtoMessage()               {}
-func (*VerifierStep) Descriptor() ([]byte, []int) { return fileDescriptorVerifier, []int{1} }
+func (*VerifierStep) Descriptor() ([]byte, []int) { return fileDescriptorVerifier, []int{8} }
 
 type isVerifierStep_Type interface {
 	isVerifierStep_Type()
@@ -69,9 +256,29 @@ type VerifierStep_Update struct {
 type VerifierStep_Epoch struct {
 	Epoch *SignedEpochHead `protobuf:"bytes,2,opt,name=Epoch,oneof"`
 }
+type VerifierStep_RecoveryStart struct {
+	RecoveryStart *PendingRecovery `protobuf:"bytes,3,opt,name=RecoveryStart,oneof"`
+}
+type VerifierStep_RecoveryVeto struct {
+	RecoveryVeto *RecoveryVeto `protobuf:"bytes,4,opt,name=RecoveryVeto,oneof"`
+}
+type VerifierStep_AdminUpdate struct {
+	AdminUpdate *AdminUpdate `protobuf:"bytes,5,opt,name=AdminUpdate,oneof"`
+}
+type VerifierStep_VRFRotation struct {
+	VRFRotation *VRFRotation `protobuf:"bytes,6,opt,name=VRFRotation,oneof"`
+}
+type VerifierStep_EpochRefresh struct {
+	EpochRefresh *SignedEpochHead `protobuf:"bytes,7,opt,name=EpochRefresh,oneof"`
+}
 
-func (*VerifierStep_Update) isVerifierStep_Type() {}
-func (*VerifierStep_Epoch) isVerifierStep_Type()  {}
+func (*VerifierStep_Update) isVerifierStep_Type()        {}
+func (*VerifierStep_Epoch) isVerifierStep_Type()         {}
+func (*VerifierStep_RecoveryStart) isVerifierStep_Type() {}
+func (*VerifierStep_RecoveryVeto) isVerifierStep_Type()  {}
+func (*VerifierStep_AdminUpdate) isVerifierStep_Type()   {}
+func (*VerifierStep_VRFRotation) isVerifierStep_Type()   {}
+func (*VerifierStep_EpochRefresh) isVerifierStep_Type()  {}
 
 func (m *VerifierStep) GetType() isVerifierStep_Type {
 	if m != nil {
@@ -94,11 +301,51 @@ func (m *VerifierStep) GetEpoch() *SignedEpochHead {
 	return nil
 }
 
+func (m *VerifierStep) GetRecoveryStart() *PendingRecovery {
+	if x, ok := m.GetType().(*VerifierStep_RecoveryStart); ok {
+		return x.RecoveryStart
+	}
+	return nil
+}
+
+func (m *VerifierStep) GetRecoveryVeto() *RecoveryVeto {
+	if x, ok := m.GetType().(*VerifierStep_RecoveryVeto); ok {
+		return x.RecoveryVeto
+	}
+	return nil
+}
+
+func (m *VerifierStep) GetAdminUpdate() *AdminUpdate {
+	if x, ok := m.GetType().(*VerifierStep_AdminUpdate); ok {
+		return x.AdminUpdate
+	}
+	return nil
+}
+
+func (m *VerifierStep) GetVRFRotation() *VRFRotation {
+	if x, ok := m.GetType().(*VerifierStep_VRFRotation); ok {
+		return x.VRFRotation
+	}
+	return nil
+}
+
+func (m *VerifierStep) GetEpochRefresh() *SignedEpochHead {
+	if x, ok := m.GetType().(*VerifierStep_EpochRefresh); ok {
+		return x.EpochRefresh
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*VerifierStep) XXX_OneofFuncs() (func(msg proto1.Message, b *proto1.Buffer) error, func(msg proto1.Message, tag, wire int, b *proto1.Buffer) (bool, error), func(msg proto1.Message) (n int), []interface{}) {
 	return _VerifierStep_OneofMarshaler, _VerifierStep_OneofUnmarshaler, _VerifierStep_OneofSizer, []interface{}{
 		(*VerifierStep_Update)(nil),
 		(*VerifierStep_Epoch)(nil),
+		(*VerifierStep_RecoveryStart)(nil),
+		(*VerifierStep_RecoveryVeto)(nil),
+		(*VerifierStep_AdminUpdate)(nil),
+		(*VerifierStep_VRFRotation)(nil),
+		(*VerifierStep_EpochRefresh)(nil),
 	}
 }
 
@@ -116,6 +363,31 @@ func _VerifierStep_OneofMarshaler(msg proto1.Message, b *proto1.Buffer) error {
 		if err := b.EncodeMessage(x.Epoch); err != nil {
 			return err
 		}
+	case *VerifierStep_RecoveryStart:
+		_ = b.EncodeVarint(3<<3 | proto1.WireBytes)
+		if err := b.EncodeMessage(x.RecoveryStart); err != nil {
+			return err
+		}
+	case *VerifierStep_RecoveryVeto:
+		_ = b.EncodeVarint(4<<3 | proto1.WireBytes)
+		if err := b.EncodeMessage(x.RecoveryVeto); err != nil {
+			return err
+		}
+	case *VerifierStep_AdminUpdate:
+		_ = b.EncodeVarint(5<<3 | proto1.WireBytes)
+		if err := b.EncodeMessage(x.AdminUpdate); err != nil {
+			return err
+		}
+	case *VerifierStep_VRFRotation:
+		_ = b.EncodeVarint(6<<3 | proto1.WireBytes)
+		if err := b.EncodeMessage(x.VRFRotation); err != nil {
+			return err
+		}
+	case *VerifierStep_EpochRefresh:
+		_ = b.EncodeVarint(7<<3 | proto1.WireBytes)
+		if err := b.EncodeMessage(x.EpochRefresh); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("VerifierStep.Type has unexpected type %T", x)
@@ -142,6 +414,46 @@ func _VerifierStep_OneofUnmarshaler(msg proto1.Message, tag, wire int, b *proto1
 		err := b.DecodeMessage(msg)
 		m.Type = &VerifierStep_Epoch{msg}
 		return true, err
+	case 3: // type.RecoveryStart
+		if wire != proto1.WireBytes {
+			return true, proto1.ErrInternalBadWireType
+		}
+		msg := new(PendingRecovery)
+		err := b.DecodeMessage(msg)
+		m.Type = &VerifierStep_RecoveryStart{msg}
+		return true, err
+	case 4: // type.RecoveryVeto
+		if wire != proto1.WireBytes {
+			return true, proto1.ErrInternalBadWireType
+		}
+		msg := new(RecoveryVeto)
+		err := b.DecodeMessage(msg)
+		m.Type = &VerifierStep_RecoveryVeto{msg}
+		return true, err
+	case 5: // type.AdminUpdate
+		if wire != proto1.WireBytes {
+			return true, proto1.ErrInternalBadWireType
+		}
+		msg := new(AdminUpdate)
+		err := b.DecodeMessage(msg)
+		m.Type = &VerifierStep_AdminUpdate{msg}
+		return true, err
+	case 6: // type.VRFRotation
+		if wire != proto1.WireBytes {
+			return true, proto1.ErrInternalBadWireType
+		}
+		msg := new(VRFRotation)
+		err := b.DecodeMessage(msg)
+		m.Type = &VerifierStep_VRFRotation{msg}
+		return true, err
+	case 7: // type.EpochRefresh
+		if wire != proto1.WireBytes {
+			return true, proto1.ErrInternalBadWireType
+		}
+		msg := new(SignedEpochHead)
+		err := b.DecodeMessage(msg)
+		m.Type = &VerifierStep_EpochRefresh{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -161,6 +473,31 @@ func _VerifierStep_OneofSizer(msg proto1.Message) (n int) {
 		n += proto1.SizeVarint(2<<3 | proto1.WireBytes)
 		n += proto1.SizeVarint(uint64(s))
 		n += s
+	case *VerifierStep_RecoveryStart:
+		s := proto1.Size(x.RecoveryStart)
+		n += proto1.SizeVarint(3<<3 | proto1.WireBytes)
+		n += proto1.SizeVarint(uint64(s))
+		n += s
+	case *VerifierStep_RecoveryVeto:
+		s := proto1.Size(x.RecoveryVeto)
+		n += proto1.SizeVarint(4<<3 | proto1.WireBytes)
+		n += proto1.SizeVarint(uint64(s))
+		n += s
+	case *VerifierStep_AdminUpdate:
+		s := proto1.Size(x.AdminUpdate)
+		n += proto1.SizeVarint(5<<3 | proto1.WireBytes)
+		n += proto1.SizeVarint(uint64(s))
+		n += s
+	case *VerifierStep_VRFRotation:
+		s := proto1.Size(x.VRFRotation)
+		n += proto1.SizeVarint(6<<3 | proto1.WireBytes)
+		n += proto1.SizeVarint(uint64(s))
+		n += s
+	case *VerifierStep_EpochRefresh:
+		s := proto1.Size(x.EpochRefresh)
+		n += proto1.SizeVarint(7<<3 | proto1.WireBytes)
+		n += proto1.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -168,17 +505,53 @@ func _VerifierStep_OneofSizer(msg proto1.Message) (n int) {
 	return n
 }
 
+// AdminUpdate flags an update authorized by the domain admin policy for
+// user_id. IndexProof shows that the entry is that of user_id, so that the
+// domain can be checked.
+type AdminUpdate struct {
+	Update     *SignedEntryUpdate `protobuf:"bytes,1,opt,name=update" json:"update,omitempty"`
+	UserId     string             `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	IndexProof []byte             `protobuf:"bytes,3,opt,name=index_proof,json=indexProof,proto3" json:"index_proof,omitempty"`
+}
+
+func (m *AdminUpdate) Reset()                    { *m = AdminUpdate{} }
+func (*AdminUpdate) ProtoMessage()               {}
+func (*AdminUpdate) Descriptor() ([]byte, []int) { return fileDescriptorVerifier, []int{9} }
+
+func (m *AdminUpdate) GetUpdate() *SignedEntryUpdate {
+	if m != nil {
+		return m.Update
+	}
+	return nil
+}
+
 type Nothing struct {
 }
 
 func (m *Nothing) Reset()                    { *m = Nothing{} }
 func (*Nothing) ProtoMessage()               {}
-func (*Nothing) Descriptor() ([]byte, []int) { return fileDescriptorVerifier, []int{2} }
+func (*Nothing) Descriptor() ([]byte, []int) { return fileDescriptorVerifier, []int{10} }
 
 func init() {
 	proto1.RegisterType((*VerifierStreamRequest)(nil), "proto.VerifierStreamRequest")
+	proto1.RegisterType((*VerifierBatchStreamRequest)(nil), "proto.VerifierBatchStreamRequest")
+	proto1.RegisterType((*VerifierStepBatch)(nil), "proto.VerifierStepBatch")
+	proto1.RegisterType((*CheckpointRequest)(nil), "proto.CheckpointRequest")
+	proto1.RegisterType((*CheckpointChunk)(nil), "proto.CheckpointChunk")
+	proto1.RegisterType((*CheckpointEntry)(nil), "proto.CheckpointEntry")
+	proto1.RegisterType((*EpochHeadGossip)(nil), "proto.EpochHeadGossip")
+	proto1.RegisterType((*EpochHeadEquivocation)(nil), "proto.EpochHeadEquivocation")
 	proto1.RegisterType((*VerifierStep)(nil), "proto.VerifierStep")
+	proto1.RegisterType((*AdminUpdate)(nil), "proto.AdminUpdate")
 	proto1.RegisterType((*Nothing)(nil), "proto.Nothing")
+	proto1.RegisterEnum("proto.Compression", Compression_name, Compression_value)
+}
+func (x Compression) String() string {
+	s, ok := Compression_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
 }
 func (this *VerifierStreamRequest) VerboseEqual(that interface{}) error {
 	if that == nil {
@@ -211,6 +584,15 @@ func (this *VerifierStreamRequest) VerboseEqual(that interface{}) error {
 	if this.PageSize != that1.PageSize {
 		return fmt.Errorf("PageSize this(%v) Not Equal that(%v)", this.PageSize, that1.PageSize)
 	}
+	if this.BatchSize != that1.BatchSize {
+		return fmt.Errorf("BatchSize this(%v) Not Equal that(%v)", this.BatchSize, that1.BatchSize)
+	}
+	if this.Window != that1.Window {
+		return fmt.Errorf("Window this(%v) Not Equal that(%v)", this.Window, that1.Window)
+	}
+	if this.Compression != that1.Compression {
+		return fmt.Errorf("Compression this(%v) Not Equal that(%v)", this.Compression, that1.Compression)
+	}
 	return nil
 }
 func (this *VerifierStreamRequest) Equal(that interface{}) bool {
@@ -244,9 +626,18 @@ func (this *VerifierStreamRequest) Equal(that interface{}) bool {
 	if this.PageSize != that1.PageSize {
 		return false
 	}
+	if this.BatchSize != that1.BatchSize {
+		return false
+	}
+	if this.Window != that1.Window {
+		return false
+	}
+	if this.Compression != that1.Compression {
+		return false
+	}
 	return true
 }
-func (this *VerifierStep) VerboseEqual(that interface{}) error {
+func (this *VerifierBatchStreamRequest) VerboseEqual(that interface{}) error {
 	if that == nil {
 		if this == nil {
 			return nil
@@ -254,65 +645,65 @@ func (this *VerifierStep) VerboseEqual(that interface{}) error {
 		return fmt.Errorf("that == nil && this != nil")
 	}
 
-	that1, ok := that.(*VerifierStep)
+	that1, ok := that.(*VerifierBatchStreamRequest)
 	if !ok {
-		that2, ok := that.(VerifierStep)
+		that2, ok := that.(VerifierBatchStreamRequest)
 		if ok {
 			that1 = &that2
 		} else {
-			return fmt.Errorf("that is not of type *VerifierStep")
+			return fmt.Errorf("that is not of type *VerifierBatchStreamRequest")
 		}
 	}
 	if that1 == nil {
 		if this == nil {
 			return nil
 		}
-		return fmt.Errorf("that is type *VerifierStep but is nil && this != nil")
+		return fmt.Errorf("that is type *VerifierBatchStreamRequest but is nil && this != nil")
 	} else if this == nil {
-		return fmt.Errorf("that is type *VerifierStep but is not nil && this == nil")
+		return fmt.Errorf("that is type *VerifierBatchStreamRequest but is not nil && this == nil")
 	}
-	if that1.Type == nil {
-		if this.Type != nil {
-			return fmt.Errorf("this.Type != nil && that1.Type == nil")
-		}
-	} else if this.Type == nil {
-		return fmt.Errorf("this.Type == nil && that1.Type != nil")
-	} else if err := this.Type.VerboseEqual(that1.Type); err != nil {
-		return err
+	if !this.Open.Equal(that1.Open) {
+		return fmt.Errorf("Open this(%v) Not Equal that(%v)", this.Open, that1.Open)
+	}
+	if this.Ack != that1.Ack {
+		return fmt.Errorf("Ack this(%v) Not Equal that(%v)", this.Ack, that1.Ack)
 	}
 	return nil
 }
-func (this *VerifierStep_Update) VerboseEqual(that interface{}) error {
+func (this *VerifierBatchStreamRequest) Equal(that interface{}) bool {
 	if that == nil {
 		if this == nil {
-			return nil
+			return true
 		}
-		return fmt.Errorf("that == nil && this != nil")
+		return false
 	}
 
-	that1, ok := that.(*VerifierStep_Update)
+	that1, ok := that.(*VerifierBatchStreamRequest)
 	if !ok {
-		that2, ok := that.(VerifierStep_Update)
+		that2, ok := that.(VerifierBatchStreamRequest)
 		if ok {
 			that1 = &that2
 		} else {
-			return fmt.Errorf("that is not of type *VerifierStep_Update")
+			return false
 		}
 	}
 	if that1 == nil {
 		if this == nil {
-			return nil
+			return true
 		}
-		return fmt.Errorf("that is type *VerifierStep_Update but is nil && this != nil")
+		return false
 	} else if this == nil {
-		return fmt.Errorf("that is type *VerifierStep_Update but is not nil && this == nil")
+		return false
 	}
-	if !this.Update.Equal(that1.Update) {
-		return fmt.Errorf("Update this(%v) Not Equal that(%v)", this.Update, that1.Update)
+	if !this.Open.Equal(that1.Open) {
+		return false
 	}
-	return nil
+	if this.Ack != that1.Ack {
+		return false
+	}
+	return true
 }
-func (this *VerifierStep_Epoch) VerboseEqual(that interface{}) error {
+func (this *VerifierStepBatch) VerboseEqual(that interface{}) error {
 	if that == nil {
 		if this == nil {
 			return nil
@@ -320,29 +711,38 @@ func (this *VerifierStep_Epoch) VerboseEqual(that interface{}) error {
 		return fmt.Errorf("that == nil && this != nil")
 	}
 
-	that1, ok := that.(*VerifierStep_Epoch)
+	that1, ok := that.(*VerifierStepBatch)
 	if !ok {
-		that2, ok := that.(VerifierStep_Epoch)
+		that2, ok := that.(VerifierStepBatch)
 		if ok {
 			that1 = &that2
 		} else {
-			return fmt.Errorf("that is not of type *VerifierStep_Epoch")
+			return fmt.Errorf("that is not of type *VerifierStepBatch")
 		}
 	}
 	if that1 == nil {
 		if this == nil {
 			return nil
 		}
-		return fmt.Errorf("that is type *VerifierStep_Epoch but is nil && this != nil")
+		return fmt.Errorf("that is type *VerifierStepBatch but is nil && this != nil")
 	} else if this == nil {
-		return fmt.Errorf("that is type *VerifierStep_Epoch but is not nil && this == nil")
+		return fmt.Errorf("that is type *VerifierStepBatch but is not nil && this == nil")
 	}
-	if !this.Epoch.Equal(that1.Epoch) {
-		return fmt.Errorf("Epoch this(%v) Not Equal that(%v)", this.Epoch, that1.Epoch)
+	if this.Start != that1.Start {
+		return fmt.Errorf("Start this(%v) Not Equal that(%v)", this.Start, that1.Start)
+	}
+	if this.NextIndex != that1.NextIndex {
+		return fmt.Errorf("NextIndex this(%v) Not Equal that(%v)", this.NextIndex, that1.NextIndex)
+	}
+	if this.Compression != that1.Compression {
+		return fmt.Errorf("Compression this(%v) Not Equal that(%v)", this.Compression, that1.Compression)
+	}
+	if !bytes.Equal(this.Steps, that1.Steps) {
+		return fmt.Errorf("Steps this(%v) Not Equal that(%v)", this.Steps, that1.Steps)
 	}
 	return nil
 }
-func (this *VerifierStep) Equal(that interface{}) bool {
+func (this *VerifierStepBatch) Equal(that interface{}) bool {
 	if that == nil {
 		if this == nil {
 			return true
@@ -350,9 +750,9 @@ func (this *VerifierStep) Equal(that interface{}) bool {
 		return false
 	}
 
-	that1, ok := that.(*VerifierStep)
+	that1, ok := that.(*VerifierStepBatch)
 	if !ok {
-		that2, ok := that.(VerifierStep)
+		that2, ok := that.(VerifierStepBatch)
 		if ok {
 			that1 = &that2
 		} else {
@@ -367,48 +767,51 @@ func (this *VerifierStep) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if that1.Type == nil {
-		if this.Type != nil {
-			return false
-		}
-	} else if this.Type == nil {
+	if this.Start != that1.Start {
 		return false
-	} else if !this.Type.Equal(that1.Type) {
+	}
+	if this.NextIndex != that1.NextIndex {
+		return false
+	}
+	if this.Compression != that1.Compression {
+		return false
+	}
+	if !bytes.Equal(this.Steps, that1.Steps) {
 		return false
 	}
 	return true
 }
-func (this *VerifierStep_Update) Equal(that interface{}) bool {
+func (this *CheckpointRequest) VerboseEqual(that interface{}) error {
 	if that == nil {
 		if this == nil {
-			return true
+			return nil
 		}
-		return false
+		return fmt.Errorf("that == nil && this != nil")
 	}
 
-	that1, ok := that.(*VerifierStep_Update)
+	that1, ok := that.(*CheckpointRequest)
 	if !ok {
-		that2, ok := that.(VerifierStep_Update)
+		that2, ok := that.(CheckpointRequest)
 		if ok {
 			that1 = &that2
 		} else {
-			return false
+			return fmt.Errorf("that is not of type *CheckpointRequest")
 		}
 	}
 	if that1 == nil {
 		if this == nil {
-			return true
+			return nil
 		}
-		return false
+		return fmt.Errorf("that is type *CheckpointRequest but is nil && this != nil")
 	} else if this == nil {
-		return false
+		return fmt.Errorf("that is type *CheckpointRequest but is not nil && this == nil")
 	}
-	if !this.Update.Equal(that1.Update) {
-		return false
+	if !this.QuorumRequirement.Equal(that1.QuorumRequirement) {
+		return fmt.Errorf("QuorumRequirement this(%v) Not Equal that(%v)", this.QuorumRequirement, that1.QuorumRequirement)
 	}
-	return true
+	return nil
 }
-func (this *VerifierStep_Epoch) Equal(that interface{}) bool {
+func (this *CheckpointRequest) Equal(that interface{}) bool {
 	if that == nil {
 		if this == nil {
 			return true
@@ -416,9 +819,9 @@ func (this *VerifierStep_Epoch) Equal(that interface{}) bool {
 		return false
 	}
 
-	that1, ok := that.(*VerifierStep_Epoch)
+	that1, ok := that.(*CheckpointRequest)
 	if !ok {
-		that2, ok := that.(VerifierStep_Epoch)
+		that2, ok := that.(CheckpointRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -433,12 +836,12 @@ func (this *VerifierStep_Epoch) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.Epoch.Equal(that1.Epoch) {
+	if !this.QuorumRequirement.Equal(that1.QuorumRequirement) {
 		return false
 	}
 	return true
 }
-func (this *Nothing) VerboseEqual(that interface{}) error {
+func (this *CheckpointChunk) VerboseEqual(that interface{}) error {
 	if that == nil {
 		if this == nil {
 			return nil
@@ -446,36 +849,63 @@ func (this *Nothing) VerboseEqual(that interface{}) error {
 		return fmt.Errorf("that == nil && this != nil")
 	}
 
-	that1, ok := that.(*Nothing)
+	that1, ok := that.(*CheckpointChunk)
 	if !ok {
-		that2, ok := that.(Nothing)
+		that2, ok := that.(CheckpointChunk)
 		if ok {
 			that1 = &that2
 		} else {
-			return fmt.Errorf("that is not of type *Nothing")
+			return fmt.Errorf("that is not of type *CheckpointChunk")
 		}
 	}
 	if that1 == nil {
 		if this == nil {
 			return nil
 		}
-		return fmt.Errorf("that is type *Nothing but is nil && this != nil")
+		return fmt.Errorf("that is type *CheckpointChunk but is nil && this != nil")
 	} else if this == nil {
-		return fmt.Errorf("that is type *Nothing but is not nil && this == nil")
+		return fmt.Errorf("that is type *CheckpointChunk but is not nil && this == nil")
 	}
-	return nil
-}
-func (this *Nothing) Equal(that interface{}) bool {
-	if that == nil {
+	if len(this.Ratifications) != len(that1.Ratifications) {
+		return fmt.Errorf("Ratifications this(%v) Not Equal that(%v)", len(this.Ratifications), len(that1.Ratifications))
+	}
+	for i := range this.Ratifications {
+		if !this.Ratifications[i].Equal(that1.Ratifications[i]) {
+			return fmt.Errorf("Ratifications this[%v](%v) Not Equal that[%v](%v)", i, this.Ratifications[i], i, that1.Ratifications[i])
+		}
+	}
+	if this.NextIndex != that1.NextIndex {
+		return fmt.Errorf("NextIndex this(%v) Not Equal that(%v)", this.NextIndex, that1.NextIndex)
+	}
+	if len(this.Entries) != len(that1.Entries) {
+		return fmt.Errorf("Entries this(%v) Not Equal that(%v)", len(this.Entries), len(that1.Entries))
+	}
+	for i := range this.Entries {
+		if !this.Entries[i].Equal(that1.Entries[i]) {
+			return fmt.Errorf("Entries this[%v](%v) Not Equal that[%v](%v)", i, this.Entries[i], i, that1.Entries[i])
+		}
+	}
+	if len(this.PendingRecoveries) != len(that1.PendingRecoveries) {
+		return fmt.Errorf("PendingRecoveries this(%v) Not Equal that(%v)", len(this.PendingRecoveries), len(that1.PendingRecoveries))
+	}
+	for i := range this.PendingRecoveries {
+		if !this.PendingRecoveries[i].Equal(that1.PendingRecoveries[i]) {
+			return fmt.Errorf("PendingRecoveries this[%v](%v) Not Equal that[%v](%v)", i, this.PendingRecoveries[i], i, that1.PendingRecoveries[i])
+		}
+	}
+	return nil
+}
+func (this *CheckpointChunk) Equal(that interface{}) bool {
+	if that == nil {
 		if this == nil {
 			return true
 		}
 		return false
 	}
 
-	that1, ok := that.(*Nothing)
+	that1, ok := that.(*CheckpointChunk)
 	if !ok {
-		that2, ok := that.(Nothing)
+		that2, ok := that.(CheckpointChunk)
 		if ok {
 			that1 = &that2
 		} else {
@@ -490,588 +920,3661 @@ func (this *Nothing) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	return true
-}
-func (this *VerifierStreamRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if len(this.Ratifications) != len(that1.Ratifications) {
+		return false
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&proto.VerifierStreamRequest{")
-	s = append(s, "Start: "+fmt.Sprintf("%#v", this.Start)+",\n")
-	s = append(s, "PageSize: "+fmt.Sprintf("%#v", this.PageSize)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *VerifierStep) GoString() string {
-	if this == nil {
-		return "nil"
+	for i := range this.Ratifications {
+		if !this.Ratifications[i].Equal(that1.Ratifications[i]) {
+			return false
+		}
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&proto.VerifierStep{")
-	if this.Type != nil {
-		s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	if this.NextIndex != that1.NextIndex {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *VerifierStep_Update) GoString() string {
-	if this == nil {
-		return "nil"
+	if len(this.Entries) != len(that1.Entries) {
+		return false
 	}
-	s := strings.Join([]string{`&proto.VerifierStep_Update{` +
-		`Update:` + fmt.Sprintf("%#v", this.Update) + `}`}, ", ")
-	return s
-}
-func (this *VerifierStep_Epoch) GoString() string {
-	if this == nil {
-		return "nil"
+	for i := range this.Entries {
+		if !this.Entries[i].Equal(that1.Entries[i]) {
+			return false
+		}
 	}
-	s := strings.Join([]string{`&proto.VerifierStep_Epoch{` +
-		`Epoch:` + fmt.Sprintf("%#v", this.Epoch) + `}`}, ", ")
-	return s
-}
-func valueToGoStringVerifier(v interface{}, typ string) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+	if len(this.PendingRecoveries) != len(that1.PendingRecoveries) {
+		return false
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+	for i := range this.PendingRecoveries {
+		if !this.PendingRecoveries[i].Equal(that1.PendingRecoveries[i]) {
+			return false
+		}
+	}
+	return true
 }
-func extensionToGoStringVerifier(m github_com_maditya_protobuf_proto.Message) string {
-	e := github_com_maditya_protobuf_proto.GetUnsafeExtensionsMap(m)
-	if e == nil {
-		return "nil"
+func (this *CheckpointEntry) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
 	}
-	s := "proto.NewUnsafeXXX_InternalExtensions(map[int32]proto.Extension{"
-	keys := make([]int, 0, len(e))
-	for k := range e {
-		keys = append(keys, int(k))
+
+	that1, ok := that.(*CheckpointEntry)
+	if !ok {
+		that2, ok := that.(CheckpointEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *CheckpointEntry")
+		}
 	}
-	sort.Ints(keys)
-	ss := []string{}
-	for _, k := range keys {
-		ss = append(ss, strconv.Itoa(k)+": "+e[int32(k)].GoString())
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *CheckpointEntry but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *CheckpointEntry but is not nil && this == nil")
 	}
-	s += strings.Join(ss, ",") + "})"
-	return s
-}
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// Client API for E2EKSVerification service
-
-type E2EKSVerificationClient interface {
-	// VerifierStream accesses the public inputs to a keyserver state machine.
-	// The returned stream is given a limited view of the log which the keyserver
-	// being verified uses to replicate its state internally.  However, private
-	// user data, such as usernames and profile details, is not included (the
-	// relevant fields are set to nil).  note: the keyserver implementation also
-	// uses the same log to persist verifier ratifications, but as they do not
-	// affect any username:profile mappings, they are excluded as well.
-	VerifierStream(ctx context.Context, in *VerifierStreamRequest, opts ...grpc.CallOption) (E2EKSVerification_VerifierStreamClient, error)
-	// PushRatification is called each time a verifier who has been
-	// successfully replaying the log returned by VerifierStream interprets a
-	// keyserver_ratified step and agrees that the keyserver state summarized
-	// by it is the unique result of applying all the previous update steps.
-	// The SignedRatification will be stored by the server and used to
-	// argue the correctness of future lookups in front of clients.
-	PushRatification(ctx context.Context, in *SignedEpochHead, opts ...grpc.CallOption) (*Nothing, error)
-}
-
-type e2EKSVerificationClient struct {
-	cc *grpc.ClientConn
-}
-
-func NewE2EKSVerificationClient(cc *grpc.ClientConn) E2EKSVerificationClient {
-	return &e2EKSVerificationClient{cc}
+	if !bytes.Equal(this.Index, that1.Index) {
+		return fmt.Errorf("Index this(%v) Not Equal that(%v)", this.Index, that1.Index)
+	}
+	if !this.Entry.Equal(that1.Entry) {
+		return fmt.Errorf("Entry this(%v) Not Equal that(%v)", this.Entry, that1.Entry)
+	}
+	return nil
 }
+func (this *CheckpointEntry) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
 
-func (c *e2EKSVerificationClient) VerifierStream(ctx context.Context, in *VerifierStreamRequest, opts ...grpc.CallOption) (E2EKSVerification_VerifierStreamClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_E2EKSVerification_serviceDesc.Streams[0], c.cc, "/proto.E2EKSVerification/VerifierStream", opts...)
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*CheckpointEntry)
+	if !ok {
+		that2, ok := that.(CheckpointEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	x := &e2EKSVerificationVerifierStreamClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
 	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+	if !bytes.Equal(this.Index, that1.Index) {
+		return false
 	}
-	return x, nil
-}
-
-type E2EKSVerification_VerifierStreamClient interface {
-	Recv() (*VerifierStep, error)
-	grpc.ClientStream
-}
-
-type e2EKSVerificationVerifierStreamClient struct {
-	grpc.ClientStream
+	if !this.Entry.Equal(that1.Entry) {
+		return false
+	}
+	return true
 }
+func (this *EpochHeadGossip) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
 
-func (x *e2EKSVerificationVerifierStreamClient) Recv() (*VerifierStep, error) {
-	m := new(VerifierStep)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+	that1, ok := that.(*EpochHeadGossip)
+	if !ok {
+		that2, ok := that.(EpochHeadGossip)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *EpochHeadGossip")
+		}
 	}
-	return m, nil
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *EpochHeadGossip but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *EpochHeadGossip but is not nil && this == nil")
+	}
+	if len(this.Heads) != len(that1.Heads) {
+		return fmt.Errorf("Heads this(%v) Not Equal that(%v)", len(this.Heads), len(that1.Heads))
+	}
+	for i := range this.Heads {
+		if !this.Heads[i].Equal(that1.Heads[i]) {
+			return fmt.Errorf("Heads this[%v](%v) Not Equal that[%v](%v)", i, this.Heads[i], i, that1.Heads[i])
+		}
+	}
+	return nil
 }
+func (this *EpochHeadGossip) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
 
-func (c *e2EKSVerificationClient) PushRatification(ctx context.Context, in *SignedEpochHead, opts ...grpc.CallOption) (*Nothing, error) {
-	out := new(Nothing)
-	err := grpc.Invoke(ctx, "/proto.E2EKSVerification/PushRatification", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*EpochHeadGossip)
+	if !ok {
+		that2, ok := that.(EpochHeadGossip)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return out, nil
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if len(this.Heads) != len(that1.Heads) {
+		return false
+	}
+	for i := range this.Heads {
+		if !this.Heads[i].Equal(that1.Heads[i]) {
+			return false
+		}
+	}
+	return true
 }
+func (this *EpochHeadEquivocation) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
 
-// Server API for E2EKSVerification service
-
-type E2EKSVerificationServer interface {
-	// VerifierStream accesses the public inputs to a keyserver state machine.
-	// The returned stream is given a limited view of the log which the keyserver
-	// being verified uses to replicate its state internally.  However, private
-	// user data, such as usernames and profile details, is not included (the
-	// relevant fields are set to nil).  note: the keyserver implementation also
-	// uses the same log to persist verifier ratifications, but as they do not
-	// affect any username:profile mappings, they are excluded as well.
-	VerifierStream(*VerifierStreamRequest, E2EKSVerification_VerifierStreamServer) error
-	// PushRatification is called each time a verifier who has been
-	// successfully replaying the log returned by VerifierStream interprets a
-	// keyserver_ratified step and agrees that the keyserver state summarized
-	// by it is the unique result of applying all the previous update steps.
-	// The SignedRatification will be stored by the server and used to
-	// argue the correctness of future lookups in front of clients.
-	PushRatification(context.Context, *SignedEpochHead) (*Nothing, error)
-}
-
-func RegisterE2EKSVerificationServer(s *grpc.Server, srv E2EKSVerificationServer) {
-	s.RegisterService(&_E2EKSVerification_serviceDesc, srv)
-}
-
-func _E2EKSVerification_VerifierStream_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(VerifierStreamRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+	that1, ok := that.(*EpochHeadEquivocation)
+	if !ok {
+		that2, ok := that.(EpochHeadEquivocation)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *EpochHeadEquivocation")
+		}
 	}
-	return srv.(E2EKSVerificationServer).VerifierStream(m, &e2EKSVerificationVerifierStreamServer{stream})
-}
-
-type E2EKSVerification_VerifierStreamServer interface {
-	Send(*VerifierStep) error
-	grpc.ServerStream
-}
-
-type e2EKSVerificationVerifierStreamServer struct {
-	grpc.ServerStream
-}
-
-func (x *e2EKSVerificationVerifierStreamServer) Send(m *VerifierStep) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _E2EKSVerification_PushRatification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SignedEpochHead)
-	if err := dec(in); err != nil {
-		return nil, err
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *EpochHeadEquivocation but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *EpochHeadEquivocation but is not nil && this == nil")
 	}
-	if interceptor == nil {
-		return srv.(E2EKSVerificationServer).PushRatification(ctx, in)
+	if !this.Ours.Equal(that1.Ours) {
+		return fmt.Errorf("Ours this(%v) Not Equal that(%v)", this.Ours, that1.Ours)
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/proto.E2EKSVerification/PushRatification",
+	if !this.Theirs.Equal(that1.Theirs) {
+		return fmt.Errorf("Theirs this(%v) Not Equal that(%v)", this.Theirs, that1.Theirs)
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(E2EKSVerificationServer).PushRatification(ctx, req.(*SignedEpochHead))
+	if this.Peer != that1.Peer {
+		return fmt.Errorf("Peer this(%v) Not Equal that(%v)", this.Peer, that1.Peer)
 	}
-	return interceptor(ctx, in, info, handler)
-}
-
-var _E2EKSVerification_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "proto.E2EKSVerification",
-	HandlerType: (*E2EKSVerificationServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "PushRatification",
-			Handler:    _E2EKSVerification_PushRatification_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "VerifierStream",
-			Handler:       _E2EKSVerification_VerifierStream_Handler,
-			ServerStreams: true,
-		},
-	},
-	Metadata: fileDescriptorVerifier,
+	return nil
 }
-
-func (m *VerifierStreamRequest) Marshal() (data []byte, err error) {
-	size := m.Size()
-	data = make([]byte, size)
-	n, err := m.MarshalTo(data)
-	if err != nil {
-		return nil, err
+func (this *EpochHeadEquivocation) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
 	}
-	return data[:n], nil
-}
 
-func (m *VerifierStreamRequest) MarshalTo(data []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Start != 0 {
-		data[i] = 0x8
-		i++
-		i = encodeVarintVerifier(data, i, uint64(m.Start))
+	that1, ok := that.(*EpochHeadEquivocation)
+	if !ok {
+		that2, ok := that.(EpochHeadEquivocation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.PageSize != 0 {
-		data[i] = 0x10
-		i++
-		i = encodeVarintVerifier(data, i, uint64(m.PageSize))
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
 	}
-	return i, nil
+	if !this.Ours.Equal(that1.Ours) {
+		return false
+	}
+	if !this.Theirs.Equal(that1.Theirs) {
+		return false
+	}
+	if this.Peer != that1.Peer {
+		return false
+	}
+	return true
 }
+func (this *VerifierStep) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
 
-func (m *VerifierStep) Marshal() (data []byte, err error) {
-	size := m.Size()
-	data = make([]byte, size)
-	n, err := m.MarshalTo(data)
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*VerifierStep)
+	if !ok {
+		that2, ok := that.(VerifierStep)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *VerifierStep")
+		}
 	}
-	return data[:n], nil
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *VerifierStep but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *VerifierStep but is not nil && this == nil")
+	}
+	if that1.Type == nil {
+		if this.Type != nil {
+			return fmt.Errorf("this.Type != nil && that1.Type == nil")
+		}
+	} else if this.Type == nil {
+		return fmt.Errorf("this.Type == nil && that1.Type != nil")
+	} else if err := this.Type.VerboseEqual(that1.Type); err != nil {
+		return err
+	}
+	return nil
 }
+func (this *VerifierStep_Update) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
 
-func (m *VerifierStep) MarshalTo(data []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Type != nil {
-		nn1, err := m.Type.MarshalTo(data[i:])
-		if err != nil {
-			return 0, err
+	that1, ok := that.(*VerifierStep_Update)
+	if !ok {
+		that2, ok := that.(VerifierStep_Update)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *VerifierStep_Update")
 		}
-		i += nn1
 	}
-	return i, nil
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *VerifierStep_Update but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *VerifierStep_Update but is not nil && this == nil")
+	}
+	if !this.Update.Equal(that1.Update) {
+		return fmt.Errorf("Update this(%v) Not Equal that(%v)", this.Update, that1.Update)
+	}
+	return nil
 }
+func (this *VerifierStep_Epoch) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
 
-func (m *VerifierStep_Update) MarshalTo(data []byte) (int, error) {
-	i := 0
-	if m.Update != nil {
-		data[i] = 0xa
-		i++
-		i = encodeVarintVerifier(data, i, uint64(m.Update.Size()))
-		n2, err := m.Update.MarshalTo(data[i:])
-		if err != nil {
-			return 0, err
+	that1, ok := that.(*VerifierStep_Epoch)
+	if !ok {
+		that2, ok := that.(VerifierStep_Epoch)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *VerifierStep_Epoch")
 		}
-		i += n2
 	}
-	return i, nil
-}
-func (m *VerifierStep_Epoch) MarshalTo(data []byte) (int, error) {
-	i := 0
-	if m.Epoch != nil {
-		data[i] = 0x12
-		i++
-		i = encodeVarintVerifier(data, i, uint64(m.Epoch.Size()))
-		n3, err := m.Epoch.MarshalTo(data[i:])
-		if err != nil {
-			return 0, err
+	if that1 == nil {
+		if this == nil {
+			return nil
 		}
-		i += n3
+		return fmt.Errorf("that is type *VerifierStep_Epoch but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *VerifierStep_Epoch but is not nil && this == nil")
 	}
-	return i, nil
-}
-func (m *Nothing) Marshal() (data []byte, err error) {
-	size := m.Size()
-	data = make([]byte, size)
-	n, err := m.MarshalTo(data)
-	if err != nil {
-		return nil, err
+	if !this.Epoch.Equal(that1.Epoch) {
+		return fmt.Errorf("Epoch this(%v) Not Equal that(%v)", this.Epoch, that1.Epoch)
 	}
-	return data[:n], nil
+	return nil
 }
+func (this *VerifierStep_RecoveryStart) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
 
-func (m *Nothing) MarshalTo(data []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	return i, nil
-}
-
-func encodeFixed64Verifier(data []byte, offset int, v uint64) int {
-	data[offset] = uint8(v)
-	data[offset+1] = uint8(v >> 8)
-	data[offset+2] = uint8(v >> 16)
-	data[offset+3] = uint8(v >> 24)
-	data[offset+4] = uint8(v >> 32)
-	data[offset+5] = uint8(v >> 40)
-	data[offset+6] = uint8(v >> 48)
-	data[offset+7] = uint8(v >> 56)
-	return offset + 8
-}
-func encodeFixed32Verifier(data []byte, offset int, v uint32) int {
-	data[offset] = uint8(v)
-	data[offset+1] = uint8(v >> 8)
-	data[offset+2] = uint8(v >> 16)
-	data[offset+3] = uint8(v >> 24)
-	return offset + 4
-}
-func encodeVarintVerifier(data []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		data[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	that1, ok := that.(*VerifierStep_RecoveryStart)
+	if !ok {
+		that2, ok := that.(VerifierStep_RecoveryStart)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *VerifierStep_RecoveryStart")
+		}
 	}
-	data[offset] = uint8(v)
-	return offset + 1
-}
-func NewPopulatedVerifierStreamRequest(r randyVerifier, easy bool) *VerifierStreamRequest {
-	this := &VerifierStreamRequest{}
-	this.Start = uint64(uint64(r.Uint32()))
-	this.PageSize = uint64(uint64(r.Uint32()))
-	if !easy && r.Intn(10) != 0 {
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *VerifierStep_RecoveryStart but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *VerifierStep_RecoveryStart but is not nil && this == nil")
 	}
-	return this
+	if !this.RecoveryStart.Equal(that1.RecoveryStart) {
+		return fmt.Errorf("RecoveryStart this(%v) Not Equal that(%v)", this.RecoveryStart, that1.RecoveryStart)
+	}
+	return nil
 }
+func (this *VerifierStep_RecoveryVeto) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
 
-func NewPopulatedVerifierStep(r randyVerifier, easy bool) *VerifierStep {
-	this := &VerifierStep{}
-	oneofNumber_Type := []int32{1, 2}[r.Intn(2)]
-	switch oneofNumber_Type {
-	case 1:
-		this.Type = NewPopulatedVerifierStep_Update(r, easy)
-	case 2:
-		this.Type = NewPopulatedVerifierStep_Epoch(r, easy)
+	that1, ok := that.(*VerifierStep_RecoveryVeto)
+	if !ok {
+		that2, ok := that.(VerifierStep_RecoveryVeto)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *VerifierStep_RecoveryVeto")
+		}
 	}
-	if !easy && r.Intn(10) != 0 {
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *VerifierStep_RecoveryVeto but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *VerifierStep_RecoveryVeto but is not nil && this == nil")
 	}
-	return this
-}
-
-func NewPopulatedVerifierStep_Update(r randyVerifier, easy bool) *VerifierStep_Update {
-	this := &VerifierStep_Update{}
-	this.Update = NewPopulatedSignedEntryUpdate(r, easy)
-	return this
-}
-func NewPopulatedVerifierStep_Epoch(r randyVerifier, easy bool) *VerifierStep_Epoch {
-	this := &VerifierStep_Epoch{}
-	this.Epoch = NewPopulatedSignedEpochHead(r, easy)
-	return this
-}
-func NewPopulatedNothing(r randyVerifier, easy bool) *Nothing {
-	this := &Nothing{}
-	if !easy && r.Intn(10) != 0 {
+	if !this.RecoveryVeto.Equal(that1.RecoveryVeto) {
+		return fmt.Errorf("RecoveryVeto this(%v) Not Equal that(%v)", this.RecoveryVeto, that1.RecoveryVeto)
 	}
-	return this
-}
-
-type randyVerifier interface {
-	Float32() float32
-	Float64() float64
-	Int63() int64
-	Int31() int32
-	Uint32() uint32
-	Intn(n int) int
+	return nil
 }
+func (this *VerifierStep_AdminUpdate) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
 
-func randUTF8RuneVerifier(r randyVerifier) rune {
-	ru := r.Intn(62)
-	if ru < 10 {
-		return rune(ru + 48)
-	} else if ru < 36 {
-		return rune(ru + 55)
+	that1, ok := that.(*VerifierStep_AdminUpdate)
+	if !ok {
+		that2, ok := that.(VerifierStep_AdminUpdate)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *VerifierStep_AdminUpdate")
+		}
 	}
-	return rune(ru + 61)
-}
-func randStringVerifier(r randyVerifier) string {
-	v1 := r.Intn(100)
-	tmps := make([]rune, v1)
-	for i := 0; i < v1; i++ {
-		tmps[i] = randUTF8RuneVerifier(r)
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *VerifierStep_AdminUpdate but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *VerifierStep_AdminUpdate but is not nil && this == nil")
 	}
-	return string(tmps)
+	if !this.AdminUpdate.Equal(that1.AdminUpdate) {
+		return fmt.Errorf("AdminUpdate this(%v) Not Equal that(%v)", this.AdminUpdate, that1.AdminUpdate)
+	}
+	return nil
 }
-func randUnrecognizedVerifier(r randyVerifier, maxFieldNumber int) (data []byte) {
-	l := r.Intn(5)
-	for i := 0; i < l; i++ {
-		wire := r.Intn(4)
-		if wire == 3 {
-			wire = 5
+func (this *VerifierStep_VRFRotation) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
 		}
-		fieldNumber := maxFieldNumber + r.Intn(100)
-		data = randFieldVerifier(data, r, fieldNumber, wire)
+		return fmt.Errorf("that == nil && this != nil")
 	}
-	return data
-}
-func randFieldVerifier(data []byte, r randyVerifier, fieldNumber int, wire int) []byte {
-	key := uint32(fieldNumber)<<3 | uint32(wire)
-	switch wire {
-	case 0:
-		data = encodeVarintPopulateVerifier(data, uint64(key))
-		v2 := r.Int63()
-		if r.Intn(2) == 0 {
-			v2 *= -1
+
+	that1, ok := that.(*VerifierStep_VRFRotation)
+	if !ok {
+		that2, ok := that.(VerifierStep_VRFRotation)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *VerifierStep_VRFRotation")
 		}
-		data = encodeVarintPopulateVerifier(data, uint64(v2))
-	case 1:
-		data = encodeVarintPopulateVerifier(data, uint64(key))
-		data = append(data, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
-	case 2:
-		data = encodeVarintPopulateVerifier(data, uint64(key))
-		ll := r.Intn(100)
-		data = encodeVarintPopulateVerifier(data, uint64(ll))
-		for j := 0; j < ll; j++ {
-			data = append(data, byte(r.Intn(256)))
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
 		}
-	default:
-		data = encodeVarintPopulateVerifier(data, uint64(key))
-		data = append(data, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+		return fmt.Errorf("that is type *VerifierStep_VRFRotation but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *VerifierStep_VRFRotation but is not nil && this == nil")
 	}
-	return data
-}
-func encodeVarintPopulateVerifier(data []byte, v uint64) []byte {
-	for v >= 1<<7 {
-		data = append(data, uint8(uint64(v)&0x7f|0x80))
-		v >>= 7
+	if !this.VRFRotation.Equal(that1.VRFRotation) {
+		return fmt.Errorf("VRFRotation this(%v) Not Equal that(%v)", this.VRFRotation, that1.VRFRotation)
 	}
-	data = append(data, uint8(v))
-	return data
+	return nil
 }
-func (m *VerifierStreamRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.Start != 0 {
-		n += 1 + sovVerifier(uint64(m.Start))
-	}
-	if m.PageSize != 0 {
-		n += 1 + sovVerifier(uint64(m.PageSize))
+func (this *VerifierStep_EpochRefresh) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
 	}
-	return n
-}
 
-func (m *VerifierStep) Size() (n int) {
-	var l int
-	_ = l
-	if m.Type != nil {
-		n += m.Type.Size()
+	that1, ok := that.(*VerifierStep_EpochRefresh)
+	if !ok {
+		that2, ok := that.(VerifierStep_EpochRefresh)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *VerifierStep_EpochRefresh")
+		}
 	}
-	return n
-}
-
-func (m *VerifierStep_Update) Size() (n int) {
-	var l int
-	_ = l
-	if m.Update != nil {
-		l = m.Update.Size()
-		n += 1 + l + sovVerifier(uint64(l))
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *VerifierStep_EpochRefresh but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *VerifierStep_EpochRefresh but is not nil && this == nil")
 	}
-	return n
-}
-func (m *VerifierStep_Epoch) Size() (n int) {
-	var l int
-	_ = l
-	if m.Epoch != nil {
-		l = m.Epoch.Size()
-		n += 1 + l + sovVerifier(uint64(l))
+	if !this.EpochRefresh.Equal(that1.EpochRefresh) {
+		return fmt.Errorf("EpochRefresh this(%v) Not Equal that(%v)", this.EpochRefresh, that1.EpochRefresh)
 	}
-	return n
-}
-func (m *Nothing) Size() (n int) {
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func sovVerifier(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
+func (this *VerifierStep) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
 		}
+		return false
 	}
-	return n
-}
-func sozVerifier(x uint64) (n int) {
-	return sovVerifier(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (this *VerifierStreamRequest) String() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*VerifierStep)
+	if !ok {
+		that2, ok := that.(VerifierStep)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := strings.Join([]string{`&VerifierStreamRequest{`,
-		`Start:` + fmt.Sprintf("%v", this.Start) + `,`,
-		`PageSize:` + fmt.Sprintf("%v", this.PageSize) + `,`,
-		`}`,
-	}, "")
-	return s
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if that1.Type == nil {
+		if this.Type != nil {
+			return false
+		}
+	} else if this.Type == nil {
+		return false
+	} else if !this.Type.Equal(that1.Type) {
+		return false
+	}
+	return true
 }
-func (this *VerifierStep) String() string {
-	if this == nil {
-		return "nil"
+func (this *VerifierStep_Update) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
 	}
-	s := strings.Join([]string{`&VerifierStep{`,
-		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
-		`}`,
-	}, "")
-	return s
+
+	that1, ok := that.(*VerifierStep_Update)
+	if !ok {
+		that2, ok := that.(VerifierStep_Update)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if !this.Update.Equal(that1.Update) {
+		return false
+	}
+	return true
 }
-func (this *VerifierStep_Update) String() string {
-	if this == nil {
-		return "nil"
+func (this *VerifierStep_Epoch) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
 	}
-	s := strings.Join([]string{`&VerifierStep_Update{`,
-		`Update:` + strings.Replace(fmt.Sprintf("%v", this.Update), "SignedEntryUpdate", "SignedEntryUpdate", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+
+	that1, ok := that.(*VerifierStep_Epoch)
+	if !ok {
+		that2, ok := that.(VerifierStep_Epoch)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if !this.Epoch.Equal(that1.Epoch) {
+		return false
+	}
+	return true
 }
-func (this *VerifierStep_Epoch) String() string {
-	if this == nil {
-		return "nil"
+func (this *VerifierStep_RecoveryStart) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
 	}
-	s := strings.Join([]string{`&VerifierStep_Epoch{`,
-		`Epoch:` + strings.Replace(fmt.Sprintf("%v", this.Epoch), "SignedEpochHead", "SignedEpochHead", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+
+	that1, ok := that.(*VerifierStep_RecoveryStart)
+	if !ok {
+		that2, ok := that.(VerifierStep_RecoveryStart)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if !this.RecoveryStart.Equal(that1.RecoveryStart) {
+		return false
+	}
+	return true
 }
-func (this *Nothing) String() string {
-	if this == nil {
-		return "nil"
+func (this *VerifierStep_RecoveryVeto) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*VerifierStep_RecoveryVeto)
+	if !ok {
+		that2, ok := that.(VerifierStep_RecoveryVeto)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if !this.RecoveryVeto.Equal(that1.RecoveryVeto) {
+		return false
+	}
+	return true
+}
+func (this *VerifierStep_AdminUpdate) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*VerifierStep_AdminUpdate)
+	if !ok {
+		that2, ok := that.(VerifierStep_AdminUpdate)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if !this.AdminUpdate.Equal(that1.AdminUpdate) {
+		return false
+	}
+	return true
+}
+func (this *VerifierStep_VRFRotation) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*VerifierStep_VRFRotation)
+	if !ok {
+		that2, ok := that.(VerifierStep_VRFRotation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if !this.VRFRotation.Equal(that1.VRFRotation) {
+		return false
+	}
+	return true
+}
+func (this *VerifierStep_EpochRefresh) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*VerifierStep_EpochRefresh)
+	if !ok {
+		that2, ok := that.(VerifierStep_EpochRefresh)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if !this.EpochRefresh.Equal(that1.EpochRefresh) {
+		return false
+	}
+	return true
+}
+func (this *AdminUpdate) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*AdminUpdate)
+	if !ok {
+		that2, ok := that.(AdminUpdate)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *AdminUpdate")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *AdminUpdate but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *AdminUpdate but is not nil && this == nil")
+	}
+	if !this.Update.Equal(that1.Update) {
+		return fmt.Errorf("Update this(%v) Not Equal that(%v)", this.Update, that1.Update)
+	}
+	if this.UserId != that1.UserId {
+		return fmt.Errorf("UserId this(%v) Not Equal that(%v)", this.UserId, that1.UserId)
+	}
+	if !bytes.Equal(this.IndexProof, that1.IndexProof) {
+		return fmt.Errorf("IndexProof this(%v) Not Equal that(%v)", this.IndexProof, that1.IndexProof)
+	}
+	return nil
+}
+func (this *AdminUpdate) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*AdminUpdate)
+	if !ok {
+		that2, ok := that.(AdminUpdate)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if !this.Update.Equal(that1.Update) {
+		return false
+	}
+	if this.UserId != that1.UserId {
+		return false
+	}
+	if !bytes.Equal(this.IndexProof, that1.IndexProof) {
+		return false
+	}
+	return true
+}
+func (this *Nothing) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*Nothing)
+	if !ok {
+		that2, ok := that.(Nothing)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *Nothing")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *Nothing but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *Nothing but is not nil && this == nil")
+	}
+	return nil
+}
+func (this *Nothing) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*Nothing)
+	if !ok {
+		that2, ok := that.(Nothing)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *VerifierStreamRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&proto.VerifierStreamRequest{")
+	s = append(s, "Start: "+fmt.Sprintf("%#v", this.Start)+",\n")
+	s = append(s, "PageSize: "+fmt.Sprintf("%#v", this.PageSize)+",\n")
+	s = append(s, "BatchSize: "+fmt.Sprintf("%#v", this.BatchSize)+",\n")
+	s = append(s, "Window: "+fmt.Sprintf("%#v", this.Window)+",\n")
+	s = append(s, "Compression: "+fmt.Sprintf("%#v", this.Compression)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *VerifierBatchStreamRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&proto.VerifierBatchStreamRequest{")
+	if this.Open != nil {
+		s = append(s, "Open: "+fmt.Sprintf("%#v", this.Open)+",\n")
+	}
+	s = append(s, "Ack: "+fmt.Sprintf("%#v", this.Ack)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *VerifierStepBatch) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&proto.VerifierStepBatch{")
+	s = append(s, "Start: "+fmt.Sprintf("%#v", this.Start)+",\n")
+	s = append(s, "NextIndex: "+fmt.Sprintf("%#v", this.NextIndex)+",\n")
+	s = append(s, "Compression: "+fmt.Sprintf("%#v", this.Compression)+",\n")
+	s = append(s, "Steps: "+fmt.Sprintf("%#v", this.Steps)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CheckpointRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&proto.CheckpointRequest{")
+	if this.QuorumRequirement != nil {
+		s = append(s, "QuorumRequirement: "+fmt.Sprintf("%#v", this.QuorumRequirement)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CheckpointChunk) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&proto.CheckpointChunk{")
+	if this.Ratifications != nil {
+		s = append(s, "Ratifications: "+fmt.Sprintf("%#v", this.Ratifications)+",\n")
+	}
+	s = append(s, "NextIndex: "+fmt.Sprintf("%#v", this.NextIndex)+",\n")
+	if this.Entries != nil {
+		s = append(s, "Entries: "+fmt.Sprintf("%#v", this.Entries)+",\n")
+	}
+	if this.PendingRecoveries != nil {
+		s = append(s, "PendingRecoveries: "+fmt.Sprintf("%#v", this.PendingRecoveries)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CheckpointEntry) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&proto.CheckpointEntry{")
+	s = append(s, "Index: "+fmt.Sprintf("%#v", this.Index)+",\n")
+	s = append(s, "Entry: "+strings.Replace(this.Entry.GoString(), `&`, ``, 1)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *EpochHeadGossip) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&proto.EpochHeadGossip{")
+	if this.Heads != nil {
+		s = append(s, "Heads: "+fmt.Sprintf("%#v", this.Heads)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *EpochHeadEquivocation) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&proto.EpochHeadEquivocation{")
+	if this.Ours != nil {
+		s = append(s, "Ours: "+fmt.Sprintf("%#v", this.Ours)+",\n")
+	}
+	if this.Theirs != nil {
+		s = append(s, "Theirs: "+fmt.Sprintf("%#v", this.Theirs)+",\n")
+	}
+	s = append(s, "Peer: "+fmt.Sprintf("%#v", this.Peer)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *VerifierStep) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 11)
+	s = append(s, "&proto.VerifierStep{")
+	if this.Type != nil {
+		s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *VerifierStep_Update) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&proto.VerifierStep_Update{` +
+		`Update:` + fmt.Sprintf("%#v", this.Update) + `}`}, ", ")
+	return s
+}
+func (this *VerifierStep_Epoch) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&proto.VerifierStep_Epoch{` +
+		`Epoch:` + fmt.Sprintf("%#v", this.Epoch) + `}`}, ", ")
+	return s
+}
+func (this *VerifierStep_RecoveryStart) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&proto.VerifierStep_RecoveryStart{` +
+		`RecoveryStart:` + fmt.Sprintf("%#v", this.RecoveryStart) + `}`}, ", ")
+	return s
+}
+func (this *VerifierStep_RecoveryVeto) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&proto.VerifierStep_RecoveryVeto{` +
+		`RecoveryVeto:` + fmt.Sprintf("%#v", this.RecoveryVeto) + `}`}, ", ")
+	return s
+}
+func (this *VerifierStep_AdminUpdate) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&proto.VerifierStep_AdminUpdate{` +
+		`AdminUpdate:` + fmt.Sprintf("%#v", this.AdminUpdate) + `}`}, ", ")
+	return s
+}
+func (this *VerifierStep_VRFRotation) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&proto.VerifierStep_VRFRotation{` +
+		`VRFRotation:` + fmt.Sprintf("%#v", this.VRFRotation) + `}`}, ", ")
+	return s
+}
+func (this *VerifierStep_EpochRefresh) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&proto.VerifierStep_EpochRefresh{` +
+		`EpochRefresh:` + fmt.Sprintf("%#v", this.EpochRefresh) + `}`}, ", ")
+	return s
+}
+func (this *AdminUpdate) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&proto.AdminUpdate{")
+	if this.Update != nil {
+		s = append(s, "Update: "+fmt.Sprintf("%#v", this.Update)+",\n")
+	}
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "IndexProof: "+fmt.Sprintf("%#v", this.IndexProof)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringVerifier(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func extensionToGoStringVerifier(m github_com_maditya_protobuf_proto.Message) string {
+	e := github_com_maditya_protobuf_proto.GetUnsafeExtensionsMap(m)
+	if e == nil {
+		return "nil"
+	}
+	s := "proto.NewUnsafeXXX_InternalExtensions(map[int32]proto.Extension{"
+	keys := make([]int, 0, len(e))
+	for k := range e {
+		keys = append(keys, int(k))
+	}
+	sort.Ints(keys)
+	ss := []string{}
+	for _, k := range keys {
+		ss = append(ss, strconv.Itoa(k)+": "+e[int32(k)].GoString())
+	}
+	s += strings.Join(ss, ",") + "})"
+	return s
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for E2EKSVerification service
+
+type E2EKSVerificationClient interface {
+	// VerifierStream accesses the public inputs to a keyserver state machine.
+	// The returned stream is given a limited view of the log which the keyserver
+	// being verified uses to replicate its state internally.  However, private
+	// user data, such as usernames and profile details, is not included (the
+	// relevant fields are set to nil).  note: the keyserver implementation also
+	// uses the same log to persist verifier ratifications, but as they do not
+	// affect any username:profile mappings, they are excluded as well.
+	VerifierStream(ctx context.Context, in *VerifierStreamRequest, opts ...grpc.CallOption) (E2EKSVerification_VerifierStreamClient, error)
+	// VerifierBatchStream returns the same steps as VerifierStream, but
+	// packed into (optionally compressed) batches. The first message sent by
+	// the verifier opens the stream, all following messages acknowledge
+	// batches. The keyserver stops sending when the number of unacknowledged
+	// batches reaches the window requested by the verifier. Each batch names
+	// the index a new stream should be started at once it has been processed,
+	// so a verifier that is bootstrapping from index 0 can resume after any
+	// batch it has persisted.
+	VerifierBatchStream(ctx context.Context, opts ...grpc.CallOption) (E2EKSVerification_VerifierBatchStreamClient, error)
+	// PushRatification is called each time a verifier who has been
+	// successfully replaying the log returned by VerifierStream interprets a
+	// keyserver_ratified step and agrees that the keyserver state summarized
+	// by it is the unique result of applying all the previous update steps.
+	// The SignedRatification will be stored by the server and used to
+	// argue the correctness of future lookups in front of clients.
+	PushRatification(ctx context.Context, in *SignedEpochHead, opts ...grpc.CallOption) (*Nothing, error)
+	// GetCheckpoint returns the directory as of the latest epoch that has
+	// been ratified by the requested quorum of verifiers, so that a new
+	// verifier can start verifying after that epoch instead of replaying
+	// VerifierStream from index 0. The first message of the returned stream
+	// contains the ratifications of the epoch; all messages may contain
+	// entries of the directory.
+	GetCheckpoint(ctx context.Context, in *CheckpointRequest, opts ...grpc.CallOption) (E2EKSVerification_GetCheckpointClient, error)
+}
+
+type e2EKSVerificationClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewE2EKSVerificationClient(cc *grpc.ClientConn) E2EKSVerificationClient {
+	return &e2EKSVerificationClient{cc}
+}
+
+func (c *e2EKSVerificationClient) VerifierStream(ctx context.Context, in *VerifierStreamRequest, opts ...grpc.CallOption) (E2EKSVerification_VerifierStreamClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_E2EKSVerification_serviceDesc.Streams[0], c.cc, "/proto.E2EKSVerification/VerifierStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &e2EKSVerificationVerifierStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type E2EKSVerification_VerifierStreamClient interface {
+	Recv() (*VerifierStep, error)
+	grpc.ClientStream
+}
+
+type e2EKSVerificationVerifierStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *e2EKSVerificationVerifierStreamClient) Recv() (*VerifierStep, error) {
+	m := new(VerifierStep)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *e2EKSVerificationClient) VerifierBatchStream(ctx context.Context, opts ...grpc.CallOption) (E2EKSVerification_VerifierBatchStreamClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_E2EKSVerification_serviceDesc.Streams[1], c.cc, "/proto.E2EKSVerification/VerifierBatchStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &e2EKSVerificationVerifierBatchStreamClient{stream}
+	return x, nil
+}
+
+type E2EKSVerification_VerifierBatchStreamClient interface {
+	Send(*VerifierBatchStreamRequest) error
+	Recv() (*VerifierStepBatch, error)
+	grpc.ClientStream
+}
+
+type e2EKSVerificationVerifierBatchStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *e2EKSVerificationVerifierBatchStreamClient) Send(m *VerifierBatchStreamRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *e2EKSVerificationVerifierBatchStreamClient) Recv() (*VerifierStepBatch, error) {
+	m := new(VerifierStepBatch)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *e2EKSVerificationClient) PushRatification(ctx context.Context, in *SignedEpochHead, opts ...grpc.CallOption) (*Nothing, error) {
+	out := new(Nothing)
+	err := grpc.Invoke(ctx, "/proto.E2EKSVerification/PushRatification", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *e2EKSVerificationClient) GetCheckpoint(ctx context.Context, in *CheckpointRequest, opts ...grpc.CallOption) (E2EKSVerification_GetCheckpointClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_E2EKSVerification_serviceDesc.Streams[2], c.cc, "/proto.E2EKSVerification/GetCheckpoint", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &e2EKSVerificationGetCheckpointClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type E2EKSVerification_GetCheckpointClient interface {
+	Recv() (*CheckpointChunk, error)
+	grpc.ClientStream
+}
+
+type e2EKSVerificationGetCheckpointClient struct {
+	grpc.ClientStream
+}
+
+func (x *e2EKSVerificationGetCheckpointClient) Recv() (*CheckpointChunk, error) {
+	m := new(CheckpointChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Server API for E2EKSVerification service
+
+type E2EKSVerificationServer interface {
+	// VerifierStream accesses the public inputs to a keyserver state machine.
+	// The returned stream is given a limited view of the log which the keyserver
+	// being verified uses to replicate its state internally.  However, private
+	// user data, such as usernames and profile details, is not included (the
+	// relevant fields are set to nil).  note: the keyserver implementation also
+	// uses the same log to persist verifier ratifications, but as they do not
+	// affect any username:profile mappings, they are excluded as well.
+	VerifierStream(*VerifierStreamRequest, E2EKSVerification_VerifierStreamServer) error
+	// VerifierBatchStream returns the same steps as VerifierStream, but
+	// packed into (optionally compressed) batches. The first message sent by
+	// the verifier opens the stream, all following messages acknowledge
+	// batches. The keyserver stops sending when the number of unacknowledged
+	// batches reaches the window requested by the verifier. Each batch names
+	// the index a new stream should be started at once it has been processed,
+	// so a verifier that is bootstrapping from index 0 can resume after any
+	// batch it has persisted.
+	VerifierBatchStream(E2EKSVerification_VerifierBatchStreamServer) error
+	// PushRatification is called each time a verifier who has been
+	// successfully replaying the log returned by VerifierStream interprets a
+	// keyserver_ratified step and agrees that the keyserver state summarized
+	// by it is the unique result of applying all the previous update steps.
+	// The SignedRatification will be stored by the server and used to
+	// argue the correctness of future lookups in front of clients.
+	PushRatification(context.Context, *SignedEpochHead) (*Nothing, error)
+	// GetCheckpoint returns the directory as of the latest epoch that has
+	// been ratified by the requested quorum of verifiers, so that a new
+	// verifier can start verifying after that epoch instead of replaying
+	// VerifierStream from index 0. The first message of the returned stream
+	// contains the ratifications of the epoch; all messages may contain
+	// entries of the directory.
+	GetCheckpoint(*CheckpointRequest, E2EKSVerification_GetCheckpointServer) error
+}
+
+func RegisterE2EKSVerificationServer(s *grpc.Server, srv E2EKSVerificationServer) {
+	s.RegisterService(&_E2EKSVerification_serviceDesc, srv)
+}
+
+func _E2EKSVerification_VerifierStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(VerifierStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(E2EKSVerificationServer).VerifierStream(m, &e2EKSVerificationVerifierStreamServer{stream})
+}
+
+type E2EKSVerification_VerifierStreamServer interface {
+	Send(*VerifierStep) error
+	grpc.ServerStream
+}
+
+type e2EKSVerificationVerifierStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *e2EKSVerificationVerifierStreamServer) Send(m *VerifierStep) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _E2EKSVerification_VerifierBatchStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(E2EKSVerificationServer).VerifierBatchStream(&e2EKSVerificationVerifierBatchStreamServer{stream})
+}
+
+type E2EKSVerification_VerifierBatchStreamServer interface {
+	Send(*VerifierStepBatch) error
+	Recv() (*VerifierBatchStreamRequest, error)
+	grpc.ServerStream
+}
+
+type e2EKSVerificationVerifierBatchStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *e2EKSVerificationVerifierBatchStreamServer) Send(m *VerifierStepBatch) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *e2EKSVerificationVerifierBatchStreamServer) Recv() (*VerifierBatchStreamRequest, error) {
+	m := new(VerifierBatchStreamRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _E2EKSVerification_PushRatification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignedEpochHead)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(E2EKSVerificationServer).PushRatification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.E2EKSVerification/PushRatification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(E2EKSVerificationServer).PushRatification(ctx, req.(*SignedEpochHead))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _E2EKSVerification_GetCheckpoint_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(CheckpointRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(E2EKSVerificationServer).GetCheckpoint(m, &e2EKSVerificationGetCheckpointServer{stream})
+}
+
+type E2EKSVerification_GetCheckpointServer interface {
+	Send(*CheckpointChunk) error
+	grpc.ServerStream
+}
+
+type e2EKSVerificationGetCheckpointServer struct {
+	grpc.ServerStream
+}
+
+func (x *e2EKSVerificationGetCheckpointServer) Send(m *CheckpointChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _E2EKSVerification_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.E2EKSVerification",
+	HandlerType: (*E2EKSVerificationServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PushRatification",
+			Handler:    _E2EKSVerification_PushRatification_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "VerifierStream",
+			Handler:       _E2EKSVerification_VerifierStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "VerifierBatchStream",
+			Handler:       _E2EKSVerification_VerifierBatchStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "GetCheckpoint",
+			Handler:       _E2EKSVerification_GetCheckpoint_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: fileDescriptorVerifier,
+}
+
+// Client API for E2EKSVerifierGossip service
+
+type E2EKSVerifierGossipClient interface {
+	// Gossip exchanges the keyserver-signed epoch heads seen by two verifiers
+	// of the same realm, so that a keyserver that shows different verifiers
+	// different histories is caught. The caller sends its latest epoch head;
+	// the callee compares it to its own head of the same epoch and replies
+	// with that head (if it has one) and its own latest head, which the
+	// caller compares in turn. Because each epoch head commits to all
+	// previous ones through previous_summary_hash, agreement on one epoch
+	// implies agreement on all epochs before it.
+	Gossip(ctx context.Context, in *EpochHeadGossip, opts ...grpc.CallOption) (*EpochHeadGossip, error)
+}
+
+type e2EKSVerifierGossipClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewE2EKSVerifierGossipClient(cc *grpc.ClientConn) E2EKSVerifierGossipClient {
+	return &e2EKSVerifierGossipClient{cc}
+}
+
+func (c *e2EKSVerifierGossipClient) Gossip(ctx context.Context, in *EpochHeadGossip, opts ...grpc.CallOption) (*EpochHeadGossip, error) {
+	out := new(EpochHeadGossip)
+	err := grpc.Invoke(ctx, "/proto.E2EKSVerifierGossip/Gossip", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for E2EKSVerifierGossip service
+
+type E2EKSVerifierGossipServer interface {
+	// Gossip exchanges the keyserver-signed epoch heads seen by two verifiers
+	// of the same realm, so that a keyserver that shows different verifiers
+	// different histories is caught. The caller sends its latest epoch head;
+	// the callee compares it to its own head of the same epoch and replies
+	// with that head (if it has one) and its own latest head, which the
+	// caller compares in turn. Because each epoch head commits to all
+	// previous ones through previous_summary_hash, agreement on one epoch
+	// implies agreement on all epochs before it.
+	Gossip(context.Context, *EpochHeadGossip) (*EpochHeadGossip, error)
+}
+
+func RegisterE2EKSVerifierGossipServer(s *grpc.Server, srv E2EKSVerifierGossipServer) {
+	s.RegisterService(&_E2EKSVerifierGossip_serviceDesc, srv)
+}
+
+func _E2EKSVerifierGossip_Gossip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EpochHeadGossip)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(E2EKSVerifierGossipServer).Gossip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.E2EKSVerifierGossip/Gossip",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(E2EKSVerifierGossipServer).Gossip(ctx, req.(*EpochHeadGossip))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _E2EKSVerifierGossip_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.E2EKSVerifierGossip",
+	HandlerType: (*E2EKSVerifierGossipServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Gossip",
+			Handler:    _E2EKSVerifierGossip_Gossip_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: fileDescriptorVerifier,
+}
+
+func (m *VerifierStreamRequest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *VerifierStreamRequest) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Start != 0 {
+		data[i] = 0x8
+		i++
+		i = encodeVarintVerifier(data, i, uint64(m.Start))
+	}
+	if m.PageSize != 0 {
+		data[i] = 0x10
+		i++
+		i = encodeVarintVerifier(data, i, uint64(m.PageSize))
+	}
+	if m.BatchSize != 0 {
+		data[i] = 0x18
+		i++
+		i = encodeVarintVerifier(data, i, uint64(m.BatchSize))
+	}
+	if m.Window != 0 {
+		data[i] = 0x20
+		i++
+		i = encodeVarintVerifier(data, i, uint64(m.Window))
+	}
+	if m.Compression != 0 {
+		data[i] = 0x28
+		i++
+		i = encodeVarintVerifier(data, i, uint64(m.Compression))
+	}
+	return i, nil
+}
+
+func (m *VerifierBatchStreamRequest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *VerifierBatchStreamRequest) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Open != nil {
+		data[i] = 0xa
+		i++
+		i = encodeVarintVerifier(data, i, uint64(m.Open.Size()))
+		n1, err := m.Open.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	if m.Ack != 0 {
+		data[i] = 0x10
+		i++
+		i = encodeVarintVerifier(data, i, uint64(m.Ack))
+	}
+	return i, nil
+}
+
+func (m *VerifierStepBatch) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *VerifierStepBatch) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Start != 0 {
+		data[i] = 0x8
+		i++
+		i = encodeVarintVerifier(data, i, uint64(m.Start))
+	}
+	if m.NextIndex != 0 {
+		data[i] = 0x10
+		i++
+		i = encodeVarintVerifier(data, i, uint64(m.NextIndex))
+	}
+	if m.Compression != 0 {
+		data[i] = 0x18
+		i++
+		i = encodeVarintVerifier(data, i, uint64(m.Compression))
+	}
+	if len(m.Steps) > 0 {
+		data[i] = 0x22
+		i++
+		i = encodeVarintVerifier(data, i, uint64(len(m.Steps)))
+		i += copy(data[i:], m.Steps)
+	}
+	return i, nil
+}
+
+func (m *CheckpointRequest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *CheckpointRequest) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.QuorumRequirement != nil {
+		data[i] = 0xa
+		i++
+		i = encodeVarintVerifier(data, i, uint64(m.QuorumRequirement.Size()))
+		n2, err := m.QuorumRequirement.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	return i, nil
+}
+
+func (m *CheckpointChunk) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *CheckpointChunk) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ratifications) > 0 {
+		for _, msg := range m.Ratifications {
+			data[i] = 0xa
+			i++
+			i = encodeVarintVerifier(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.NextIndex != 0 {
+		data[i] = 0x10
+		i++
+		i = encodeVarintVerifier(data, i, uint64(m.NextIndex))
+	}
+	if len(m.Entries) > 0 {
+		for _, msg := range m.Entries {
+			data[i] = 0x1a
+			i++
+			i = encodeVarintVerifier(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.PendingRecoveries) > 0 {
+		for _, msg := range m.PendingRecoveries {
+			data[i] = 0x22
+			i++
+			i = encodeVarintVerifier(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *CheckpointEntry) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *CheckpointEntry) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Index) > 0 {
+		data[i] = 0xa
+		i++
+		i = encodeVarintVerifier(data, i, uint64(len(m.Index)))
+		i += copy(data[i:], m.Index)
+	}
+	data[i] = 0x12
+	i++
+	i = encodeVarintVerifier(data, i, uint64(m.Entry.Size()))
+	n3, err := m.Entry.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n3
+	return i, nil
+}
+
+func (m *EpochHeadGossip) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *EpochHeadGossip) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Heads) > 0 {
+		for _, msg := range m.Heads {
+			data[i] = 0xa
+			i++
+			i = encodeVarintVerifier(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *EpochHeadEquivocation) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *EpochHeadEquivocation) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Ours != nil {
+		data[i] = 0xa
+		i++
+		i = encodeVarintVerifier(data, i, uint64(m.Ours.Size()))
+		n4, err := m.Ours.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	if m.Theirs != nil {
+		data[i] = 0x12
+		i++
+		i = encodeVarintVerifier(data, i, uint64(m.Theirs.Size()))
+		n5, err := m.Theirs.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	if m.Peer != 0 {
+		data[i] = 0x18
+		i++
+		i = encodeVarintVerifier(data, i, uint64(m.Peer))
+	}
+	return i, nil
+}
+
+func (m *VerifierStep) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *VerifierStep) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Type != nil {
+		nn6, err := m.Type.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += nn6
+	}
+	return i, nil
+}
+
+func (m *VerifierStep_Update) MarshalTo(data []byte) (int, error) {
+	i := 0
+	if m.Update != nil {
+		data[i] = 0xa
+		i++
+		i = encodeVarintVerifier(data, i, uint64(m.Update.Size()))
+		n7, err := m.Update.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n7
+	}
+	return i, nil
+}
+func (m *VerifierStep_Epoch) MarshalTo(data []byte) (int, error) {
+	i := 0
+	if m.Epoch != nil {
+		data[i] = 0x12
+		i++
+		i = encodeVarintVerifier(data, i, uint64(m.Epoch.Size()))
+		n8, err := m.Epoch.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n8
+	}
+	return i, nil
+}
+func (m *VerifierStep_RecoveryStart) MarshalTo(data []byte) (int, error) {
+	i := 0
+	if m.RecoveryStart != nil {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintVerifier(data, i, uint64(m.RecoveryStart.Size()))
+		n9, err := m.RecoveryStart.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n9
+	}
+	return i, nil
+}
+func (m *VerifierStep_RecoveryVeto) MarshalTo(data []byte) (int, error) {
+	i := 0
+	if m.RecoveryVeto != nil {
+		data[i] = 0x22
+		i++
+		i = encodeVarintVerifier(data, i, uint64(m.RecoveryVeto.Size()))
+		n10, err := m.RecoveryVeto.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n10
+	}
+	return i, nil
+}
+func (m *VerifierStep_AdminUpdate) MarshalTo(data []byte) (int, error) {
+	i := 0
+	if m.AdminUpdate != nil {
+		data[i] = 0x2a
+		i++
+		i = encodeVarintVerifier(data, i, uint64(m.AdminUpdate.Size()))
+		n11, err := m.AdminUpdate.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n11
+	}
+	return i, nil
+}
+func (m *VerifierStep_VRFRotation) MarshalTo(data []byte) (int, error) {
+	i := 0
+	if m.VRFRotation != nil {
+		data[i] = 0x32
+		i++
+		i = encodeVarintVerifier(data, i, uint64(m.VRFRotation.Size()))
+		n12, err := m.VRFRotation.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n12
+	}
+	return i, nil
+}
+func (m *VerifierStep_EpochRefresh) MarshalTo(data []byte) (int, error) {
+	i := 0
+	if m.EpochRefresh != nil {
+		data[i] = 0x3a
+		i++
+		i = encodeVarintVerifier(data, i, uint64(m.EpochRefresh.Size()))
+		n13, err := m.EpochRefresh.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n13
+	}
+	return i, nil
+}
+func (m *AdminUpdate) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *AdminUpdate) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Update != nil {
+		data[i] = 0xa
+		i++
+		i = encodeVarintVerifier(data, i, uint64(m.Update.Size()))
+		n14, err := m.Update.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n14
+	}
+	if len(m.UserId) > 0 {
+		data[i] = 0x12
+		i++
+		i = encodeVarintVerifier(data, i, uint64(len(m.UserId)))
+		i += copy(data[i:], m.UserId)
+	}
+	if len(m.IndexProof) > 0 {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintVerifier(data, i, uint64(len(m.IndexProof)))
+		i += copy(data[i:], m.IndexProof)
+	}
+	return i, nil
+}
+
+func (m *Nothing) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *Nothing) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func encodeFixed64Verifier(data []byte, offset int, v uint64) int {
+	data[offset] = uint8(v)
+	data[offset+1] = uint8(v >> 8)
+	data[offset+2] = uint8(v >> 16)
+	data[offset+3] = uint8(v >> 24)
+	data[offset+4] = uint8(v >> 32)
+	data[offset+5] = uint8(v >> 40)
+	data[offset+6] = uint8(v >> 48)
+	data[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32Verifier(data []byte, offset int, v uint32) int {
+	data[offset] = uint8(v)
+	data[offset+1] = uint8(v >> 8)
+	data[offset+2] = uint8(v >> 16)
+	data[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintVerifier(data []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		data[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	data[offset] = uint8(v)
+	return offset + 1
+}
+func NewPopulatedVerifierStreamRequest(r randyVerifier, easy bool) *VerifierStreamRequest {
+	this := &VerifierStreamRequest{}
+	this.Start = uint64(uint64(r.Uint32()))
+	this.PageSize = uint64(uint64(r.Uint32()))
+	this.BatchSize = uint64(uint64(r.Uint32()))
+	this.Window = uint64(uint64(r.Uint32()))
+	this.Compression = Compression([]int32{0, 1}[r.Intn(2)])
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedVerifierBatchStreamRequest(r randyVerifier, easy bool) *VerifierBatchStreamRequest {
+	this := &VerifierBatchStreamRequest{}
+	if r.Intn(10) != 0 {
+		this.Open = NewPopulatedVerifierStreamRequest(r, easy)
+	}
+	this.Ack = uint64(uint64(r.Uint32()))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedVerifierStepBatch(r randyVerifier, easy bool) *VerifierStepBatch {
+	this := &VerifierStepBatch{}
+	this.Start = uint64(uint64(r.Uint32()))
+	this.NextIndex = uint64(uint64(r.Uint32()))
+	this.Compression = Compression([]int32{0, 1}[r.Intn(2)])
+	v1 := r.Intn(100)
+	this.Steps = make([]byte, v1)
+	for i := 0; i < v1; i++ {
+		this.Steps[i] = byte(r.Intn(256))
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedCheckpointRequest(r randyVerifier, easy bool) *CheckpointRequest {
+	this := &CheckpointRequest{}
+	if r.Intn(10) == 0 {
+		this.QuorumRequirement = NewPopulatedQuorumExpr(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedCheckpointChunk(r randyVerifier, easy bool) *CheckpointChunk {
+	this := &CheckpointChunk{}
+	if r.Intn(10) == 0 {
+		v2 := r.Intn(5)
+		this.Ratifications = make([]*SignedEpochHead, v2)
+		for i := 0; i < v2; i++ {
+			this.Ratifications[i] = NewPopulatedSignedEpochHead(r, easy)
+		}
+	}
+	this.NextIndex = uint64(uint64(r.Uint32()))
+	if r.Intn(10) == 0 {
+		v3 := r.Intn(5)
+		this.Entries = make([]*CheckpointEntry, v3)
+		for i := 0; i < v3; i++ {
+			this.Entries[i] = NewPopulatedCheckpointEntry(r, easy)
+		}
+	}
+	if r.Intn(10) == 0 {
+		v4 := r.Intn(5)
+		this.PendingRecoveries = make([]*PendingRecovery, v4)
+		for i := 0; i < v4; i++ {
+			this.PendingRecoveries[i] = NewPopulatedPendingRecovery(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedCheckpointEntry(r randyVerifier, easy bool) *CheckpointEntry {
+	this := &CheckpointEntry{}
+	v5 := r.Intn(100)
+	this.Index = make([]byte, v5)
+	for i := 0; i < v5; i++ {
+		this.Index[i] = byte(r.Intn(256))
+	}
+	v6 := NewPopulatedEncodedEntry(r, easy)
+	this.Entry = *v6
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedEpochHeadGossip(r randyVerifier, easy bool) *EpochHeadGossip {
+	this := &EpochHeadGossip{}
+	if r.Intn(10) == 0 {
+		v7 := r.Intn(5)
+		this.Heads = make([]*SignedEpochHead, v7)
+		for i := 0; i < v7; i++ {
+			this.Heads[i] = NewPopulatedSignedEpochHead(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedEpochHeadEquivocation(r randyVerifier, easy bool) *EpochHeadEquivocation {
+	this := &EpochHeadEquivocation{}
+	if r.Intn(10) == 0 {
+		this.Ours = NewPopulatedSignedEpochHead(r, easy)
+	}
+	if r.Intn(10) == 0 {
+		this.Theirs = NewPopulatedSignedEpochHead(r, easy)
+	}
+	this.Peer = uint64(uint64(r.Uint32()))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedVerifierStep(r randyVerifier, easy bool) *VerifierStep {
+	this := &VerifierStep{}
+	oneofNumber_Type := []int32{1, 2, 3, 4, 5, 6, 7}[r.Intn(7)]
+	switch oneofNumber_Type {
+	case 1:
+		this.Type = NewPopulatedVerifierStep_Update(r, easy)
+	case 2:
+		this.Type = NewPopulatedVerifierStep_Epoch(r, easy)
+	case 3:
+		this.Type = NewPopulatedVerifierStep_RecoveryStart(r, easy)
+	case 4:
+		this.Type = NewPopulatedVerifierStep_RecoveryVeto(r, easy)
+	case 5:
+		this.Type = NewPopulatedVerifierStep_AdminUpdate(r, easy)
+	case 6:
+		this.Type = NewPopulatedVerifierStep_VRFRotation(r, easy)
+	case 7:
+		this.Type = NewPopulatedVerifierStep_EpochRefresh(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedVerifierStep_Update(r randyVerifier, easy bool) *VerifierStep_Update {
+	this := &VerifierStep_Update{}
+	this.Update = NewPopulatedSignedEntryUpdate(r, easy)
+	return this
+}
+func NewPopulatedVerifierStep_Epoch(r randyVerifier, easy bool) *VerifierStep_Epoch {
+	this := &VerifierStep_Epoch{}
+	this.Epoch = NewPopulatedSignedEpochHead(r, easy)
+	return this
+}
+func NewPopulatedVerifierStep_RecoveryStart(r randyVerifier, easy bool) *VerifierStep_RecoveryStart {
+	this := &VerifierStep_RecoveryStart{}
+	this.RecoveryStart = NewPopulatedPendingRecovery(r, easy)
+	return this
+}
+func NewPopulatedVerifierStep_RecoveryVeto(r randyVerifier, easy bool) *VerifierStep_RecoveryVeto {
+	this := &VerifierStep_RecoveryVeto{}
+	this.RecoveryVeto = NewPopulatedRecoveryVeto(r, easy)
+	return this
+}
+func NewPopulatedVerifierStep_AdminUpdate(r randyVerifier, easy bool) *VerifierStep_AdminUpdate {
+	this := &VerifierStep_AdminUpdate{}
+	this.AdminUpdate = NewPopulatedAdminUpdate(r, easy)
+	return this
+}
+func NewPopulatedVerifierStep_VRFRotation(r randyVerifier, easy bool) *VerifierStep_VRFRotation {
+	this := &VerifierStep_VRFRotation{}
+	this.VRFRotation = NewPopulatedVRFRotation(r, easy)
+	return this
+}
+func NewPopulatedVerifierStep_EpochRefresh(r randyVerifier, easy bool) *VerifierStep_EpochRefresh {
+	this := &VerifierStep_EpochRefresh{}
+	this.EpochRefresh = NewPopulatedSignedEpochHead(r, easy)
+	return this
+}
+func NewPopulatedAdminUpdate(r randyVerifier, easy bool) *AdminUpdate {
+	this := &AdminUpdate{}
+	if r.Intn(10) == 0 {
+		this.Update = NewPopulatedSignedEntryUpdate(r, easy)
+	}
+	this.UserId = randStringVerifier(r)
+	v8 := r.Intn(100)
+	this.IndexProof = make([]byte, v8)
+	for i := 0; i < v8; i++ {
+		this.IndexProof[i] = byte(r.Intn(256))
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedNothing(r randyVerifier, easy bool) *Nothing {
+	this := &Nothing{}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+type randyVerifier interface {
+	Float32() float32
+	Float64() float64
+	Int63() int64
+	Int31() int32
+	Uint32() uint32
+	Intn(n int) int
+}
+
+func randUTF8RuneVerifier(r randyVerifier) rune {
+	ru := r.Intn(62)
+	if ru < 10 {
+		return rune(ru + 48)
+	} else if ru < 36 {
+		return rune(ru + 55)
+	}
+	return rune(ru + 61)
+}
+func randStringVerifier(r randyVerifier) string {
+	v9 := r.Intn(100)
+	tmps := make([]rune, v9)
+	for i := 0; i < v9; i++ {
+		tmps[i] = randUTF8RuneVerifier(r)
+	}
+	return string(tmps)
+}
+func randUnrecognizedVerifier(r randyVerifier, maxFieldNumber int) (data []byte) {
+	l := r.Intn(5)
+	for i := 0; i < l; i++ {
+		wire := r.Intn(4)
+		if wire == 3 {
+			wire = 5
+		}
+		fieldNumber := maxFieldNumber + r.Intn(100)
+		data = randFieldVerifier(data, r, fieldNumber, wire)
+	}
+	return data
+}
+func randFieldVerifier(data []byte, r randyVerifier, fieldNumber int, wire int) []byte {
+	key := uint32(fieldNumber)<<3 | uint32(wire)
+	switch wire {
+	case 0:
+		data = encodeVarintPopulateVerifier(data, uint64(key))
+		v10 := r.Int63()
+		if r.Intn(2) == 0 {
+			v10 *= -1
+		}
+		data = encodeVarintPopulateVerifier(data, uint64(v10))
+	case 1:
+		data = encodeVarintPopulateVerifier(data, uint64(key))
+		data = append(data, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	case 2:
+		data = encodeVarintPopulateVerifier(data, uint64(key))
+		ll := r.Intn(100)
+		data = encodeVarintPopulateVerifier(data, uint64(ll))
+		for j := 0; j < ll; j++ {
+			data = append(data, byte(r.Intn(256)))
+		}
+	default:
+		data = encodeVarintPopulateVerifier(data, uint64(key))
+		data = append(data, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	}
+	return data
+}
+func encodeVarintPopulateVerifier(data []byte, v uint64) []byte {
+	for v >= 1<<7 {
+		data = append(data, uint8(uint64(v)&0x7f|0x80))
+		v >>= 7
+	}
+	data = append(data, uint8(v))
+	return data
+}
+func (m *VerifierStreamRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Start != 0 {
+		n += 1 + sovVerifier(uint64(m.Start))
+	}
+	if m.PageSize != 0 {
+		n += 1 + sovVerifier(uint64(m.PageSize))
+	}
+	if m.BatchSize != 0 {
+		n += 1 + sovVerifier(uint64(m.BatchSize))
+	}
+	if m.Window != 0 {
+		n += 1 + sovVerifier(uint64(m.Window))
+	}
+	if m.Compression != 0 {
+		n += 1 + sovVerifier(uint64(m.Compression))
+	}
+	return n
+}
+
+func (m *VerifierBatchStreamRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Open != nil {
+		l = m.Open.Size()
+		n += 1 + l + sovVerifier(uint64(l))
+	}
+	if m.Ack != 0 {
+		n += 1 + sovVerifier(uint64(m.Ack))
+	}
+	return n
+}
+
+func (m *VerifierStepBatch) Size() (n int) {
+	var l int
+	_ = l
+	if m.Start != 0 {
+		n += 1 + sovVerifier(uint64(m.Start))
+	}
+	if m.NextIndex != 0 {
+		n += 1 + sovVerifier(uint64(m.NextIndex))
+	}
+	if m.Compression != 0 {
+		n += 1 + sovVerifier(uint64(m.Compression))
+	}
+	l = len(m.Steps)
+	if l > 0 {
+		n += 1 + l + sovVerifier(uint64(l))
+	}
+	return n
+}
+
+func (m *CheckpointRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.QuorumRequirement != nil {
+		l = m.QuorumRequirement.Size()
+		n += 1 + l + sovVerifier(uint64(l))
+	}
+	return n
+}
+
+func (m *CheckpointChunk) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Ratifications) > 0 {
+		for _, e := range m.Ratifications {
+			l = e.Size()
+			n += 1 + l + sovVerifier(uint64(l))
+		}
+	}
+	if m.NextIndex != 0 {
+		n += 1 + sovVerifier(uint64(m.NextIndex))
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovVerifier(uint64(l))
+		}
+	}
+	if len(m.PendingRecoveries) > 0 {
+		for _, e := range m.PendingRecoveries {
+			l = e.Size()
+			n += 1 + l + sovVerifier(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CheckpointEntry) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovVerifier(uint64(l))
+	}
+	l = m.Entry.Size()
+	n += 1 + l + sovVerifier(uint64(l))
+	return n
+}
+
+func (m *EpochHeadGossip) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Heads) > 0 {
+		for _, e := range m.Heads {
+			l = e.Size()
+			n += 1 + l + sovVerifier(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EpochHeadEquivocation) Size() (n int) {
+	var l int
+	_ = l
+	if m.Ours != nil {
+		l = m.Ours.Size()
+		n += 1 + l + sovVerifier(uint64(l))
+	}
+	if m.Theirs != nil {
+		l = m.Theirs.Size()
+		n += 1 + l + sovVerifier(uint64(l))
+	}
+	if m.Peer != 0 {
+		n += 1 + sovVerifier(uint64(m.Peer))
+	}
+	return n
+}
+
+func (m *VerifierStep) Size() (n int) {
+	var l int
+	_ = l
+	if m.Type != nil {
+		n += m.Type.Size()
+	}
+	return n
+}
+
+func (m *VerifierStep_Update) Size() (n int) {
+	var l int
+	_ = l
+	if m.Update != nil {
+		l = m.Update.Size()
+		n += 1 + l + sovVerifier(uint64(l))
+	}
+	return n
+}
+func (m *VerifierStep_Epoch) Size() (n int) {
+	var l int
+	_ = l
+	if m.Epoch != nil {
+		l = m.Epoch.Size()
+		n += 1 + l + sovVerifier(uint64(l))
+	}
+	return n
+}
+func (m *VerifierStep_RecoveryStart) Size() (n int) {
+	var l int
+	_ = l
+	if m.RecoveryStart != nil {
+		l = m.RecoveryStart.Size()
+		n += 1 + l + sovVerifier(uint64(l))
+	}
+	return n
+}
+func (m *VerifierStep_RecoveryVeto) Size() (n int) {
+	var l int
+	_ = l
+	if m.RecoveryVeto != nil {
+		l = m.RecoveryVeto.Size()
+		n += 1 + l + sovVerifier(uint64(l))
+	}
+	return n
+}
+func (m *VerifierStep_AdminUpdate) Size() (n int) {
+	var l int
+	_ = l
+	if m.AdminUpdate != nil {
+		l = m.AdminUpdate.Size()
+		n += 1 + l + sovVerifier(uint64(l))
+	}
+	return n
+}
+func (m *VerifierStep_VRFRotation) Size() (n int) {
+	var l int
+	_ = l
+	if m.VRFRotation != nil {
+		l = m.VRFRotation.Size()
+		n += 1 + l + sovVerifier(uint64(l))
+	}
+	return n
+}
+func (m *VerifierStep_EpochRefresh) Size() (n int) {
+	var l int
+	_ = l
+	if m.EpochRefresh != nil {
+		l = m.EpochRefresh.Size()
+		n += 1 + l + sovVerifier(uint64(l))
+	}
+	return n
+}
+func (m *AdminUpdate) Size() (n int) {
+	var l int
+	_ = l
+	if m.Update != nil {
+		l = m.Update.Size()
+		n += 1 + l + sovVerifier(uint64(l))
+	}
+	l = len(m.UserId)
+	if l > 0 {
+		n += 1 + l + sovVerifier(uint64(l))
+	}
+	l = len(m.IndexProof)
+	if l > 0 {
+		n += 1 + l + sovVerifier(uint64(l))
+	}
+	return n
+}
+
+func (m *Nothing) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func sovVerifier(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozVerifier(x uint64) (n int) {
+	return sovVerifier(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *VerifierStreamRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&VerifierStreamRequest{`,
+		`Start:` + fmt.Sprintf("%v", this.Start) + `,`,
+		`PageSize:` + fmt.Sprintf("%v", this.PageSize) + `,`,
+		`BatchSize:` + fmt.Sprintf("%v", this.BatchSize) + `,`,
+		`Window:` + fmt.Sprintf("%v", this.Window) + `,`,
+		`Compression:` + fmt.Sprintf("%v", this.Compression) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *VerifierBatchStreamRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&VerifierBatchStreamRequest{`,
+		`Open:` + strings.Replace(fmt.Sprintf("%v", this.Open), "VerifierStreamRequest", "VerifierStreamRequest", 1) + `,`,
+		`Ack:` + fmt.Sprintf("%v", this.Ack) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *VerifierStepBatch) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&VerifierStepBatch{`,
+		`Start:` + fmt.Sprintf("%v", this.Start) + `,`,
+		`NextIndex:` + fmt.Sprintf("%v", this.NextIndex) + `,`,
+		`Compression:` + fmt.Sprintf("%v", this.Compression) + `,`,
+		`Steps:` + fmt.Sprintf("%v", this.Steps) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CheckpointRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CheckpointRequest{`,
+		`QuorumRequirement:` + strings.Replace(fmt.Sprintf("%v", this.QuorumRequirement), "QuorumExpr", "QuorumExpr", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CheckpointChunk) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CheckpointChunk{`,
+		`Ratifications:` + strings.Replace(fmt.Sprintf("%v", this.Ratifications), "SignedEpochHead", "SignedEpochHead", 1) + `,`,
+		`NextIndex:` + fmt.Sprintf("%v", this.NextIndex) + `,`,
+		`Entries:` + strings.Replace(fmt.Sprintf("%v", this.Entries), "CheckpointEntry", "CheckpointEntry", 1) + `,`,
+		`PendingRecoveries:` + strings.Replace(fmt.Sprintf("%v", this.PendingRecoveries), "PendingRecovery", "PendingRecovery", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CheckpointEntry) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CheckpointEntry{`,
+		`Index:` + fmt.Sprintf("%v", this.Index) + `,`,
+		`Entry:` + strings.Replace(strings.Replace(this.Entry.String(), "Entry", "Entry", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EpochHeadGossip) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EpochHeadGossip{`,
+		`Heads:` + strings.Replace(fmt.Sprintf("%v", this.Heads), "SignedEpochHead", "SignedEpochHead", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EpochHeadEquivocation) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EpochHeadEquivocation{`,
+		`Ours:` + strings.Replace(fmt.Sprintf("%v", this.Ours), "SignedEpochHead", "SignedEpochHead", 1) + `,`,
+		`Theirs:` + strings.Replace(fmt.Sprintf("%v", this.Theirs), "SignedEpochHead", "SignedEpochHead", 1) + `,`,
+		`Peer:` + fmt.Sprintf("%v", this.Peer) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *VerifierStep) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&VerifierStep{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *VerifierStep_Update) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&VerifierStep_Update{`,
+		`Update:` + strings.Replace(fmt.Sprintf("%v", this.Update), "SignedEntryUpdate", "SignedEntryUpdate", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *VerifierStep_Epoch) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&VerifierStep_Epoch{`,
+		`Epoch:` + strings.Replace(fmt.Sprintf("%v", this.Epoch), "SignedEpochHead", "SignedEpochHead", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *VerifierStep_RecoveryStart) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&VerifierStep_RecoveryStart{`,
+		`RecoveryStart:` + strings.Replace(fmt.Sprintf("%v", this.RecoveryStart), "PendingRecovery", "PendingRecovery", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *VerifierStep_RecoveryVeto) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&VerifierStep_RecoveryVeto{`,
+		`RecoveryVeto:` + strings.Replace(fmt.Sprintf("%v", this.RecoveryVeto), "RecoveryVeto", "RecoveryVeto", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *VerifierStep_AdminUpdate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&VerifierStep_AdminUpdate{`,
+		`AdminUpdate:` + strings.Replace(fmt.Sprintf("%v", this.AdminUpdate), "AdminUpdate", "AdminUpdate", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *VerifierStep_VRFRotation) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&VerifierStep_VRFRotation{`,
+		`VRFRotation:` + strings.Replace(fmt.Sprintf("%v", this.VRFRotation), "VRFRotation", "VRFRotation", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *VerifierStep_EpochRefresh) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&VerifierStep_EpochRefresh{`,
+		`EpochRefresh:` + strings.Replace(fmt.Sprintf("%v", this.EpochRefresh), "SignedEpochHead", "SignedEpochHead", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AdminUpdate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AdminUpdate{`,
+		`Update:` + strings.Replace(fmt.Sprintf("%v", this.Update), "SignedEntryUpdate", "SignedEntryUpdate", 1) + `,`,
+		`UserId:` + fmt.Sprintf("%v", this.UserId) + `,`,
+		`IndexProof:` + fmt.Sprintf("%v", this.IndexProof) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Nothing) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Nothing{`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringVerifier(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *VerifierStreamRequest) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVerifier
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VerifierStreamRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VerifierStreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			m.Start = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVerifier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Start |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+			}
+			m.PageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVerifier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.PageSize |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchSize", wireType)
+			}
+			m.BatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVerifier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.BatchSize |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVerifier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Window |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compression", wireType)
+			}
+			m.Compression = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVerifier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Compression |= (Compression(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVerifier(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthVerifier
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VerifierBatchStreamRequest) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVerifier
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VerifierBatchStreamRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VerifierBatchStreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Open", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVerifier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVerifier
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Open == nil {
+				m.Open = &VerifierStreamRequest{}
+			}
+			if err := m.Open.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ack", wireType)
+			}
+			m.Ack = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVerifier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Ack |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVerifier(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthVerifier
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VerifierStepBatch) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVerifier
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VerifierStepBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VerifierStepBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			m.Start = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVerifier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Start |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextIndex", wireType)
+			}
+			m.NextIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVerifier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.NextIndex |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compression", wireType)
+			}
+			m.Compression = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVerifier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Compression |= (Compression(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVerifier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthVerifier
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Steps = append(m.Steps[:0], data[iNdEx:postIndex]...)
+			if m.Steps == nil {
+				m.Steps = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVerifier(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthVerifier
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CheckpointRequest) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVerifier
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CheckpointRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CheckpointRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumRequirement", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVerifier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVerifier
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.QuorumRequirement == nil {
+				m.QuorumRequirement = &QuorumExpr{}
+			}
+			if err := m.QuorumRequirement.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVerifier(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthVerifier
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CheckpointChunk) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVerifier
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CheckpointChunk: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CheckpointChunk: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ratifications", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVerifier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVerifier
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ratifications = append(m.Ratifications, &SignedEpochHead{})
+			if err := m.Ratifications[len(m.Ratifications)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextIndex", wireType)
+			}
+			m.NextIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVerifier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.NextIndex |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVerifier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVerifier
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, &CheckpointEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingRecoveries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVerifier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVerifier
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingRecoveries = append(m.PendingRecoveries, &PendingRecovery{})
+			if err := m.PendingRecoveries[len(m.PendingRecoveries)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVerifier(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthVerifier
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CheckpointEntry) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVerifier
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CheckpointEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CheckpointEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVerifier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthVerifier
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = append(m.Index[:0], data[iNdEx:postIndex]...)
+			if m.Index == nil {
+				m.Index = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVerifier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVerifier
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Entry.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVerifier(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthVerifier
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochHeadGossip) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVerifier
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochHeadGossip: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochHeadGossip: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Heads", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVerifier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVerifier
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Heads = append(m.Heads, &SignedEpochHead{})
+			if err := m.Heads[len(m.Heads)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVerifier(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthVerifier
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochHeadEquivocation) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVerifier
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochHeadEquivocation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochHeadEquivocation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ours", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVerifier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVerifier
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Ours == nil {
+				m.Ours = &SignedEpochHead{}
+			}
+			if err := m.Ours.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Theirs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVerifier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVerifier
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Theirs == nil {
+				m.Theirs = &SignedEpochHead{}
+			}
+			if err := m.Theirs.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Peer", wireType)
+			}
+			m.Peer = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVerifier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Peer |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVerifier(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthVerifier
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	s := strings.Join([]string{`&Nothing{`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringVerifier(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return nil
 }
-func (m *VerifierStreamRequest) Unmarshal(data []byte) error {
+func (m *VerifierStep) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1094,17 +4597,17 @@ func (m *VerifierStreamRequest) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VerifierStreamRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: VerifierStep: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VerifierStreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VerifierStep: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
 			}
-			m.Start = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVerifier
@@ -1114,16 +4617,29 @@ func (m *VerifierStreamRequest) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.Start |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthVerifier
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &SignedEntryUpdate{}
+			if err := v.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Type = &VerifierStep_Update{v}
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
-			m.PageSize = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVerifier
@@ -1133,11 +4649,184 @@ func (m *VerifierStreamRequest) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.PageSize |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVerifier
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &SignedEpochHead{}
+			if err := v.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Type = &VerifierStep_Epoch{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecoveryStart", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVerifier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVerifier
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &PendingRecovery{}
+			if err := v.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Type = &VerifierStep_RecoveryStart{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecoveryVeto", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVerifier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVerifier
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &RecoveryVeto{}
+			if err := v.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Type = &VerifierStep_RecoveryVeto{v}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVerifier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVerifier
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &AdminUpdate{}
+			if err := v.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Type = &VerifierStep_AdminUpdate{v}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VRFRotation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVerifier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVerifier
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &VRFRotation{}
+			if err := v.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Type = &VerifierStep_VRFRotation{v}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochRefresh", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVerifier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthVerifier
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &SignedEpochHead{}
+			if err := v.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Type = &VerifierStep_EpochRefresh{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVerifier(data[iNdEx:])
@@ -1159,7 +4848,7 @@ func (m *VerifierStreamRequest) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *VerifierStep) Unmarshal(data []byte) error {
+func (m *AdminUpdate) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1182,10 +4871,10 @@ func (m *VerifierStep) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VerifierStep: wiretype end group for non-group")
+			return fmt.Errorf("proto: AdminUpdate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VerifierStep: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AdminUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1214,17 +4903,18 @@ func (m *VerifierStep) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &SignedEntryUpdate{}
-			if err := v.Unmarshal(data[iNdEx:postIndex]); err != nil {
+			if m.Update == nil {
+				m.Update = &SignedEntryUpdate{}
+			}
+			if err := m.Update.Unmarshal(data[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Type = &VerifierStep_Update{v}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVerifier
@@ -1234,23 +4924,51 @@ func (m *VerifierStep) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthVerifier
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &SignedEpochHead{}
-			if err := v.Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
+			m.UserId = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexProof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVerifier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthVerifier
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IndexProof = append(m.IndexProof[:0], data[iNdEx:postIndex]...)
+			if m.IndexProof == nil {
+				m.IndexProof = []byte{}
 			}
-			m.Type = &VerifierStep_Epoch{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1431,29 +5149,68 @@ var (
 func init() { proto1.RegisterFile("verifier.proto", fileDescriptorVerifier) }
 
 var fileDescriptorVerifier = []byte{
-	// 370 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x90, 0xbf, 0x4f, 0xe3, 0x30,
-	0x14, 0xc7, 0xe3, 0x53, 0xdb, 0xbb, 0x73, 0xab, 0xde, 0x9d, 0xef, 0x87, 0xaa, 0xdc, 0xc9, 0x87,
-	0x3a, 0x31, 0xa5, 0x55, 0x58, 0x50, 0xc7, 0x4a, 0x91, 0x2a, 0x90, 0x10, 0x4a, 0x04, 0x2b, 0x72,
-	0x93, 0xd7, 0xc4, 0x12, 0x8d, 0x43, 0xe2, 0x20, 0xb5, 0x13, 0x7f, 0x05, 0x33, 0x23, 0x23, 0x23,
-	0x23, 0x23, 0x63, 0x47, 0xc6, 0xc6, 0x13, 0x63, 0x47, 0x46, 0x54, 0x27, 0x52, 0x55, 0x01, 0x93,
-	0xfd, 0x7d, 0xdf, 0xf7, 0x3e, 0xef, 0x6b, 0xe3, 0xf6, 0x25, 0xa4, 0x7c, 0xc2, 0x21, 0xb5, 0x92,
-	0x54, 0x48, 0x41, 0xea, 0xfa, 0x30, 0xfb, 0x21, 0x97, 0x51, 0x3e, 0xb6, 0x7c, 0x31, 0xed, 0x4d,
-	0x59, 0xc0, 0xe5, 0x8c, 0xf5, 0xb4, 0x33, 0xce, 0x27, 0xbd, 0x50, 0x84, 0x42, 0x0b, 0x7d, 0x2b,
-	0x07, 0xcd, 0x96, 0x7f, 0xce, 0x21, 0x96, 0xa5, 0xea, 0x1e, 0xe0, 0xdf, 0xa7, 0x15, 0xd8, 0x93,
-	0x29, 0xb0, 0xa9, 0x0b, 0x17, 0x39, 0x64, 0x92, 0xfc, 0xc2, 0xf5, 0x4c, 0xb2, 0x54, 0x76, 0xd0,
-	0x0e, 0xda, 0xad, 0xb9, 0xa5, 0x20, 0x7f, 0xf1, 0xd7, 0x84, 0x85, 0x70, 0x96, 0xf1, 0x39, 0x74,
-	0x3e, 0x69, 0xe7, 0xcb, 0xba, 0xe0, 0xf1, 0x39, 0x74, 0xe7, 0xb8, 0xb5, 0x61, 0x41, 0x42, 0x6c,
-	0xdc, 0x38, 0x49, 0x02, 0x26, 0x41, 0x33, 0x9a, 0x76, 0xa7, 0xdc, 0x69, 0x79, 0x3c, 0x8c, 0x21,
-	0x70, 0x62, 0x99, 0xce, 0x4a, 0x7f, 0x64, 0xb8, 0x55, 0x27, 0xb1, 0x70, 0xdd, 0x49, 0x84, 0x1f,
-	0x69, 0x78, 0xd3, 0xfe, 0xb3, 0x3d, 0xb2, 0x76, 0x46, 0xc0, 0x82, 0x91, 0xe1, 0x96, 0x6d, 0xc3,
-	0x06, 0xae, 0xc9, 0x59, 0x02, 0xdd, 0x6f, 0xf8, 0xf3, 0x91, 0x90, 0x11, 0x8f, 0xc3, 0x41, 0xed,
-	0xee, 0xe6, 0xbf, 0x61, 0x5f, 0x23, 0xfc, 0xc3, 0xb1, 0x9d, 0x43, 0xaf, 0x8c, 0xe4, 0x33, 0xc9,
-	0x45, 0x4c, 0x1c, 0xdc, 0xde, 0x7e, 0x2e, 0xf9, 0x57, 0x6d, 0x78, 0xf7, 0x17, 0xcc, 0x9f, 0x6f,
-	0x5c, 0x48, 0xfa, 0x88, 0x0c, 0xf0, 0xf7, 0xe3, 0x3c, 0x8b, 0x5c, 0x26, 0x37, 0xe8, 0x0f, 0xa2,
-	0x9a, 0xed, 0xaa, 0x5e, 0xc5, 0x1b, 0xee, 0x2f, 0x0a, 0x6a, 0x3c, 0x15, 0xd4, 0x58, 0x16, 0x14,
-	0xad, 0x0a, 0x8a, 0x5e, 0x0a, 0x8a, 0xae, 0x14, 0x45, 0xb7, 0x8a, 0xa2, 0x7b, 0x45, 0xd1, 0x83,
-	0xa2, 0xe8, 0x51, 0x51, 0xb4, 0x50, 0x14, 0x2d, 0x15, 0x45, 0xcf, 0x8a, 0x1a, 0x2b, 0x45, 0xd1,
-	0xb8, 0xa1, 0x41, 0x7b, 0xaf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x57, 0x82, 0xf9, 0xed, 0x0b, 0x02,
-	0x00, 0x00,
+	// 995 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0xd4, 0x1f, 0x21, 0xcf, 0xce, 0x87, 0x27, 0x6d, 0x59, 0x19, 0xba, 0x09, 0x7b, 0x8a,
+	0xaa, 0x2a, 0xb1, 0x0c, 0x42, 0xa1, 0xaa, 0x80, 0x26, 0xdd, 0xd6, 0x15, 0xd0, 0x86, 0x31, 0xc9,
+	0xd5, 0x6c, 0xbc, 0x2f, 0xf6, 0x28, 0x78, 0x67, 0x33, 0x3b, 0xdb, 0xc6, 0x3d, 0x71, 0xe3, 0x1f,
+	0xe0, 0x0f, 0x40, 0xe2, 0xc2, 0x11, 0x89, 0x03, 0x1c, 0x39, 0xf6, 0xd8, 0x23, 0xe2, 0x50, 0x35,
+	0xbe, 0xc0, 0xb1, 0x47, 0x8e, 0x68, 0x67, 0xc7, 0xf5, 0x6e, 0x3e, 0x7b, 0xf2, 0xbc, 0xf7, 0xfb,
+	0xbd, 0x6f, 0xbf, 0xb7, 0x30, 0xff, 0x04, 0x25, 0xdf, 0xe7, 0x28, 0xd7, 0x42, 0x29, 0x94, 0xa0,
+	0x65, 0xfd, 0xd3, 0x68, 0xf6, 0xb9, 0x1a, 0xc4, 0x7b, 0x6b, 0x3d, 0x31, 0x5c, 0x1f, 0x7a, 0x3e,
+	0x57, 0x23, 0x6f, 0x5d, 0x23, 0x7b, 0xf1, 0xfe, 0x7a, 0x5f, 0xf4, 0x85, 0x16, 0xf4, 0x2b, 0x35,
+	0x6c, 0xd4, 0x7a, 0xdf, 0x71, 0x0c, 0x54, 0x2a, 0x39, 0xbf, 0x13, 0xb8, 0xb6, 0x6b, 0x3c, 0x77,
+	0x94, 0x44, 0x6f, 0xc8, 0xf0, 0x30, 0xc6, 0x48, 0xd1, 0xab, 0x50, 0x8e, 0x94, 0x27, 0x95, 0x45,
+	0x56, 0xc8, 0x6a, 0x89, 0xa5, 0x02, 0x7d, 0x0f, 0x66, 0x43, 0xaf, 0x8f, 0xdd, 0x88, 0x3f, 0x43,
+	0xeb, 0x8a, 0x46, 0xde, 0x49, 0x14, 0x1d, 0xfe, 0x0c, 0xe9, 0x0d, 0x80, 0x3d, 0x4f, 0xf5, 0x06,
+	0x29, 0x5a, 0xd4, 0xe8, 0xac, 0xd6, 0x68, 0xf8, 0x3a, 0x54, 0x9e, 0xf2, 0xc0, 0x17, 0x4f, 0xad,
+	0x92, 0x86, 0x8c, 0x44, 0x3f, 0x82, 0x6a, 0x4f, 0x0c, 0x43, 0x89, 0x51, 0xc4, 0x45, 0x60, 0x95,
+	0x57, 0xc8, 0xea, 0x7c, 0x8b, 0xa6, 0x09, 0xae, 0x6d, 0x4d, 0x11, 0x96, 0xa5, 0x39, 0xdf, 0x42,
+	0x63, 0x92, 0xf8, 0xa6, 0x0e, 0x91, 0xcb, 0xbe, 0x09, 0x25, 0x11, 0x62, 0xa0, 0x93, 0xaf, 0xb6,
+	0xde, 0x37, 0xce, 0xce, 0xac, 0x94, 0x69, 0x26, 0x5d, 0x84, 0xa2, 0xd7, 0x3b, 0x30, 0x35, 0x25,
+	0x4f, 0xe7, 0x47, 0x02, 0xf5, 0xa9, 0x05, 0x86, 0x3a, 0xcc, 0x39, 0x7d, 0xb9, 0x01, 0x10, 0xe0,
+	0x91, 0xea, 0xf2, 0xc0, 0xc7, 0x23, 0xe3, 0x64, 0x36, 0xd1, 0x3c, 0x4c, 0x14, 0x27, 0x4b, 0x2c,
+	0xbe, 0x55, 0x89, 0x69, 0x28, 0x0c, 0x23, 0xdd, 0xaf, 0x1a, 0x4b, 0x05, 0x67, 0x07, 0xea, 0x5b,
+	0x03, 0xec, 0x1d, 0x84, 0x82, 0x07, 0x6a, 0x52, 0xef, 0xe7, 0x40, 0x0f, 0x63, 0x21, 0xe3, 0x61,
+	0x57, 0xe2, 0x61, 0xcc, 0x25, 0x0e, 0x31, 0x50, 0xa6, 0xfa, 0xba, 0x89, 0xf3, 0xb5, 0x26, 0xb8,
+	0x47, 0xa1, 0x64, 0xf5, 0x94, 0xcc, 0xa6, 0x5c, 0xe7, 0x1f, 0x02, 0x0b, 0x53, 0xbf, 0x5b, 0x83,
+	0x38, 0x38, 0xa0, 0x77, 0x60, 0x4e, 0x7a, 0x8a, 0xef, 0xf3, 0x9e, 0xa7, 0xb8, 0x08, 0x22, 0x8b,
+	0xac, 0x14, 0x57, 0xab, 0xad, 0xeb, 0xc6, 0x61, 0x87, 0xf7, 0x03, 0xf4, 0xdd, 0x50, 0xf4, 0x06,
+	0x6d, 0xf4, 0x7c, 0x96, 0x27, 0x5f, 0xd6, 0x93, 0x26, 0xcc, 0x60, 0xa0, 0x24, 0xc7, 0xc8, 0x2a,
+	0xe6, 0xdc, 0x4e, 0xb3, 0x70, 0x03, 0x25, 0x47, 0x6c, 0x42, 0xa3, 0x2e, 0xd0, 0x10, 0x03, 0x9f,
+	0x07, 0xfd, 0xae, 0xc4, 0x9e, 0x48, 0x36, 0x02, 0x93, 0xe6, 0x64, 0x8d, 0xb7, 0x53, 0x02, 0x4b,
+	0xf1, 0x11, 0xab, 0x87, 0x39, 0x05, 0xc7, 0xc8, 0xf1, 0x60, 0xe1, 0x44, 0x88, 0xa4, 0xd3, 0x69,
+	0x96, 0x24, 0xed, 0xb4, 0x16, 0xe8, 0x06, 0x94, 0x93, 0xd0, 0x23, 0x9d, 0x7b, 0xb5, 0x55, 0x33,
+	0x21, 0xb4, 0xc9, 0xe6, 0xd5, 0xe7, 0x2f, 0x97, 0x0b, 0x7f, 0xbf, 0x5c, 0xae, 0xb9, 0x41, 0x4f,
+	0xf8, 0xe8, 0xa7, 0xb9, 0xa6, 0x06, 0xce, 0x67, 0xb0, 0xf0, 0xa6, 0x2d, 0x0f, 0x44, 0x14, 0xf1,
+	0x90, 0xde, 0x82, 0xf2, 0x00, 0x3d, 0xff, 0xb2, 0x1e, 0xa6, 0x24, 0xe7, 0x07, 0x02, 0xd7, 0xde,
+	0x28, 0xdd, 0xc3, 0x98, 0x3f, 0x11, 0x69, 0x5b, 0xe9, 0x4d, 0x28, 0x89, 0x58, 0x46, 0x66, 0xb6,
+	0xe7, 0xb9, 0xd1, 0x1c, 0xba, 0x06, 0x15, 0x35, 0x40, 0x2e, 0x23, 0xeb, 0xca, 0x85, 0x6c, 0xc3,
+	0xa2, 0x14, 0x4a, 0x21, 0xa2, 0x34, 0xab, 0xab, 0xdf, 0xce, 0xcf, 0x45, 0xa8, 0x65, 0xb7, 0x80,
+	0xb6, 0xa0, 0xb2, 0x13, 0xfa, 0x9e, 0x42, 0x93, 0x82, 0x95, 0x77, 0x9a, 0xd4, 0x9f, 0xe2, 0xed,
+	0x02, 0x33, 0x4c, 0xba, 0x06, 0x65, 0x1d, 0xed, 0xe2, 0x3c, 0xda, 0x05, 0x96, 0xd2, 0xe8, 0xa7,
+	0x30, 0x37, 0x99, 0x60, 0x47, 0x2f, 0x5b, 0x71, 0x85, 0x9c, 0x3f, 0xe4, 0x76, 0x81, 0xe5, 0xe9,
+	0xf4, 0x13, 0xa8, 0x4d, 0x14, 0xbb, 0xa8, 0x84, 0x5e, 0xa0, 0x6a, 0x6b, 0xc9, 0x98, 0x67, 0xa1,
+	0x76, 0x81, 0xe5, 0xa8, 0xf4, 0x63, 0xa8, 0xde, 0xf5, 0x87, 0x3c, 0x30, 0x35, 0x96, 0xb5, 0xe5,
+	0x64, 0x55, 0x33, 0x48, 0xbb, 0xc0, 0xb2, 0xc4, 0xc4, 0x6e, 0x97, 0xdd, 0x67, 0x42, 0xe9, 0x31,
+	0x59, 0x95, 0x9c, 0x5d, 0x06, 0x49, 0xec, 0x32, 0x22, 0xbd, 0x03, 0x35, 0x5d, 0x33, 0xc3, 0x7d,
+	0x89, 0xd1, 0xc0, 0x9a, 0xb9, 0xa4, 0x43, 0x39, 0xf6, 0x66, 0x05, 0x4a, 0x6a, 0x14, 0xa2, 0x33,
+	0xca, 0x65, 0x4d, 0x9b, 0x50, 0x89, 0xdf, 0x6a, 0x46, 0xcc, 0xf0, 0xe8, 0xbb, 0x30, 0x13, 0x47,
+	0x28, 0xbb, 0xdc, 0xd7, 0x33, 0x9a, 0x65, 0x95, 0x44, 0x7c, 0xe8, 0xd3, 0x65, 0xa8, 0xea, 0x6d,
+	0xe8, 0x86, 0x52, 0x88, 0x7d, 0x3d, 0x88, 0x1a, 0x03, 0xad, 0xda, 0x4e, 0x34, 0xce, 0x02, 0xcc,
+	0x3c, 0x12, 0x6a, 0xc0, 0x83, 0xfe, 0xed, 0xd2, 0xaf, 0x3f, 0x2d, 0x17, 0x6e, 0xde, 0x82, 0x6a,
+	0xe6, 0xa4, 0xd1, 0x45, 0xa8, 0xed, 0x3c, 0xda, 0x7a, 0xfc, 0xd5, 0x36, 0x73, 0x3b, 0x1d, 0xf7,
+	0xde, 0x62, 0x81, 0x56, 0x61, 0xe6, 0x9e, 0x7b, 0xff, 0xcb, 0xbb, 0xdf, 0xb8, 0x8b, 0xa4, 0xf5,
+	0xdb, 0x15, 0xa8, 0xbb, 0x2d, 0xf7, 0x8b, 0x4e, 0xfa, 0x27, 0x33, 0xff, 0x72, 0x17, 0xe6, 0xf3,
+	0xc7, 0x9a, 0x5e, 0x78, 0xc3, 0x1b, 0x4b, 0xa7, 0x50, 0x0c, 0x9b, 0x84, 0xee, 0xc2, 0xd2, 0x19,
+	0x1f, 0x09, 0xfa, 0xc1, 0x09, 0xf6, 0xe9, 0x0f, 0x48, 0xc3, 0x3a, 0xc3, 0xa1, 0xa6, 0xad, 0x92,
+	0x26, 0xa1, 0xb7, 0x61, 0x71, 0x3b, 0x8e, 0x06, 0x2c, 0x73, 0xef, 0xe8, 0x39, 0x23, 0x6b, 0xcc,
+	0x1b, 0xbd, 0x69, 0x12, 0xdd, 0x82, 0xb9, 0x07, 0xa8, 0xa6, 0x17, 0x88, 0x5a, 0xa7, 0xee, 0xde,
+	0x24, 0x89, 0xd3, 0x17, 0x51, 0xdf, 0xe5, 0x26, 0x69, 0x3d, 0x86, 0xa5, 0x4c, 0xd3, 0x50, 0x9a,
+	0x23, 0xb3, 0x01, 0x15, 0xf3, 0x9a, 0x98, 0x9e, 0x38, 0x43, 0x8d, 0x73, 0xf4, 0x9b, 0x1b, 0x2f,
+	0x8e, 0xed, 0xc2, 0x5f, 0xc7, 0x76, 0xe1, 0xd5, 0xb1, 0x4d, 0x5e, 0x1f, 0xdb, 0xe4, 0xbf, 0x63,
+	0x9b, 0x7c, 0x3f, 0xb6, 0xc9, 0x2f, 0x63, 0x9b, 0xfc, 0x31, 0xb6, 0xc9, 0x9f, 0x63, 0x9b, 0x3c,
+	0x1f, 0xdb, 0xe4, 0xc5, 0xd8, 0x26, 0xaf, 0xc6, 0x36, 0xf9, 0x77, 0x6c, 0x17, 0x5e, 0x8f, 0x6d,
+	0xb2, 0x57, 0xd1, 0x0e, 0x3f, 0xfc, 0x3f, 0x00, 0x00, 0xff, 0xff, 0x6d, 0x23, 0x6a, 0x8c, 0xa2,
+	0x08, 0x00, 0x00,
 }
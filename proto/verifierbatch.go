@@ -0,0 +1,105 @@
+// Copyright 2014-2015 The Dename Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy of
+// the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations under
+// the License.
+
+package proto
+
+import (
+	"bufio"
+	"bytes"
+	"compress/flate"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+)
+
+// MaxVerifierStepSize bounds the length of a single marshaled VerifierStep in
+// a VerifierStepBatch so that a corrupt batch cannot cause the decoder to
+// allocate an unbounded buffer.
+const MaxVerifierStepSize = 64 << 20
+
+// EncodeVerifierSteps concatenates the marshaled VerifierSteps in steps, each
+// prefixed with its length as a uvarint, and compresses the result using c.
+// The output is suitable for VerifierStepBatch.Steps.
+func EncodeVerifierSteps(steps [][]byte, c Compression) ([]byte, error) {
+	var buf bytes.Buffer
+	var w io.Writer = &buf
+	var fw *flate.Writer
+	switch c {
+	case UNCOMPRESSED:
+	case DEFLATE:
+		var err error
+		if fw, err = flate.NewWriter(&buf, flate.BestSpeed); err != nil {
+			return nil, err
+		}
+		w = fw
+	default:
+		return nil, fmt.Errorf("unknown compression %d", c)
+	}
+	var lenBuf [binary.MaxVarintLen64]byte
+	for _, step := range steps {
+		n := binary.PutUvarint(lenBuf[:], uint64(len(step)))
+		if _, err := w.Write(lenBuf[:n]); err != nil {
+			return nil, err
+		}
+		if _, err := w.Write(step); err != nil {
+			return nil, err
+		}
+	}
+	if fw != nil {
+		if err := fw.Close(); err != nil {
+			return nil, err
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// DecodeVerifierSteps reverses EncodeVerifierSteps.
+func DecodeVerifierSteps(data []byte, c Compression) ([]*VerifierStep, error) {
+	var r *bufio.Reader
+	switch c {
+	case UNCOMPRESSED:
+		r = bufio.NewReader(bytes.NewReader(data))
+	case DEFLATE:
+		fr := flate.NewReader(bytes.NewReader(data))
+		defer fr.Close()
+		r = bufio.NewReader(fr)
+	default:
+		return nil, fmt.Errorf("unknown compression %d", c)
+	}
+	var ret []*VerifierStep
+	for {
+		l, err := binary.ReadUvarint(r)
+		if err == io.EOF {
+			return ret, nil
+		} else if err != nil {
+			return nil, err
+		}
+		if l > MaxVerifierStepSize {
+			return nil, fmt.Errorf("verifier step %d too large: %d bytes", len(ret), l)
+		}
+		stepBytes, err := ioutil.ReadAll(io.LimitReader(r, int64(l)))
+		if err != nil {
+			return nil, err
+		}
+		if uint64(len(stepBytes)) != l {
+			return nil, fmt.Errorf("verifier step %d truncated", len(ret))
+		}
+		step := new(VerifierStep)
+		if err := step.Unmarshal(stepBytes); err != nil {
+			return nil, err
+		}
+		ret = append(ret, step)
+	}
+}
@@ -0,0 +1,59 @@
+package proto
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestVerifierStepsRoundtrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	var want []*VerifierStep
+	var steps [][]byte
+	for i := 0; i < 100; i++ {
+		step := NewPopulatedVerifierStep(r, false)
+		want = append(want, step)
+		steps = append(steps, MustMarshal(step))
+	}
+	for _, c := range []Compression{UNCOMPRESSED, DEFLATE} {
+		enc, err := EncodeVerifierSteps(steps, c)
+		if err != nil {
+			t.Fatalf("EncodeVerifierSteps(%v): %s", c, err)
+		}
+		dec, err := DecodeVerifierSteps(enc, c)
+		if err != nil {
+			t.Fatalf("DecodeVerifierSteps(%v): %s", c, err)
+		}
+		if got, want := len(dec), len(steps); got != want {
+			t.Fatalf("DecodeVerifierSteps(%v): got %d steps, wanted %d", c, got, want)
+		}
+		for i := range dec {
+			if err := want[i].VerboseEqual(dec[i]); err != nil {
+				t.Errorf("DecodeVerifierSteps(%v): step %d: %s", c, i, err)
+			}
+		}
+	}
+}
+
+func TestVerifierStepsEmpty(t *testing.T) {
+	for _, c := range []Compression{UNCOMPRESSED, DEFLATE} {
+		enc, err := EncodeVerifierSteps(nil, c)
+		if err != nil {
+			t.Fatalf("EncodeVerifierSteps(%v): %s", c, err)
+		}
+		dec, err := DecodeVerifierSteps(enc, c)
+		if err != nil || len(dec) != 0 {
+			t.Fatalf("DecodeVerifierSteps(%v): got %v, %v, wanted no steps", c, dec, err)
+		}
+	}
+}
+
+func TestVerifierStepsTruncated(t *testing.T) {
+	step := MustMarshal(NewPopulatedVerifierStep(rand.New(rand.NewSource(1)), false))
+	enc, err := EncodeVerifierSteps([][]byte{step}, UNCOMPRESSED)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := DecodeVerifierSteps(enc[:len(enc)-1], UNCOMPRESSED); err == nil {
+		t.Fatalf("DecodeVerifierSteps accepted a truncated batch")
+	}
+}
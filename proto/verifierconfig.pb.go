@@ -35,6 +35,43 @@ type VerifierConfig struct {
 	// LevelDBPath specifies the directory in which the database is stored.
 	// Nothing else should use this directory.
 	LevelDBPath string `protobuf:"bytes,8,opt,name=leveldb_path,json=leveldbPath,proto3" json:"leveldb_path,omitempty"`
+	// CheckpointRatifiers, if set, makes a verifier that starts with an empty
+	// database bootstrap from a checkpoint instead of replaying the verifier
+	// log from index 0. The keyserver sends the directory as of the latest
+	// epoch ratified by the quorum of checkpoint_ratifiers. The verifier
+	// checks the signatures of the keyserver and of the quorum on that epoch
+	// and checks that the directory matches the root hash in the epoch head;
+	// it then verifies all later steps as usual.
+	// TRUST ASSUMPTION: the verifier does not itself check how the directory
+	// came to be. It does not ratify the checkpoint epoch or any epoch before
+	// it, and it will not detect a keyserver that misbehaved before the
+	// checkpoint unless at least one verifier in every set of verifiers that
+	// satisfies the quorum is honest. The quorum should therefore only
+	// contain verifiers that are trusted at least as much as this one.
+	CheckpointRatifiers *AuthorizationPolicy `protobuf:"bytes,9,opt,name=checkpoint_ratifiers,json=checkpointRatifiers" json:"checkpoint_ratifiers,omitempty"`
+	// GossipAddr is the TCP (host:port) address on which the verifier
+	// accepts gossip from the verifiers in gossip_peers. Empty to disable.
+	GossipAddr string `protobuf:"bytes,10,opt,name=gossip_addr,json=gossipAddr,proto3" json:"gossip_addr,omitempty"`
+	// GossipTLS configures the gossip server. It should require client
+	// certificates: only the verifiers in gossip_peers are allowed to gossip.
+	GossipTLS *TLSConfig `protobuf:"bytes,11,opt,name=gossip_tls,json=gossipTls" json:"gossip_tls,omitempty"`
+	// GossipPeers lists the verifiers to exchange epoch heads with. They are
+	// contacted using tls.
+	GossipPeers []*GossipPeer `protobuf:"bytes,12,rep,name=gossip_peers,json=gossipPeers" json:"gossip_peers,omitempty"`
+	// GossipInterval specifies how often each peer is contacted.
+	GossipInterval Duration `protobuf:"bytes,13,opt,name=gossip_interval,json=gossipInterval" json:"gossip_interval"`
+	// DeletionPolicy is the deletion policy of the keyserver of the realm
+	// (see KeyserverConfig.DeletionPolicy).
+	DeletionPolicy *AuthorizationPolicy `protobuf:"bytes,14,opt,name=deletion_policy,json=deletionPolicy" json:"deletion_policy,omitempty"`
+	// DomainAdminPolicies are the domain admin policies of the keyserver of
+	// the realm (see KeyserverConfig.DomainAdminPolicies).
+	DomainAdminPolicies []*DomainAdminPolicy `protobuf:"bytes,15,rep,name=domain_admin_policies,json=domainAdminPolicies" json:"domain_admin_policies,omitempty"`
+	// VRFPublic is the public key of the verifiable random function of the
+	// realm. It is used to check which user ID an AdminUpdate is for.
+	VRFPublic []byte `protobuf:"bytes,16,opt,name=vrf_public,json=vrfPublic,proto3" json:"vrf_public,omitempty"`
+	// VRFSuite is the verifiable random function that VRFPublic is a key
+	// for (see KeyserverConfig.VRFSuite).
+	VRFSuite VRFSuite `protobuf:"varint,17,opt,name=vrf_suite,json=vrfSuite,proto3,enum=proto.VRFSuite" json:"vrf_suite,omitempty"`
 }
 
 func (m *VerifierConfig) Reset()                    { *m = VerifierConfig{} }
@@ -55,8 +92,63 @@ func (m *VerifierConfig) GetInitialKeyserverAuth() AuthorizationPolicy {
 	return AuthorizationPolicy{}
 }
 
+func (m *VerifierConfig) GetCheckpointRatifiers() *AuthorizationPolicy {
+	if m != nil {
+		return m.CheckpointRatifiers
+	}
+	return nil
+}
+
+func (m *VerifierConfig) GetGossipTLS() *TLSConfig {
+	if m != nil {
+		return m.GossipTLS
+	}
+	return nil
+}
+
+func (m *VerifierConfig) GetGossipPeers() []*GossipPeer {
+	if m != nil {
+		return m.GossipPeers
+	}
+	return nil
+}
+
+func (m *VerifierConfig) GetGossipInterval() Duration {
+	if m != nil {
+		return m.GossipInterval
+	}
+	return Duration{}
+}
+
+func (m *VerifierConfig) GetDeletionPolicy() *AuthorizationPolicy {
+	if m != nil {
+		return m.DeletionPolicy
+	}
+	return nil
+}
+
+func (m *VerifierConfig) GetDomainAdminPolicies() []*DomainAdminPolicy {
+	if m != nil {
+		return m.DomainAdminPolicies
+	}
+	return nil
+}
+
+// GossipPeer identifies another verifier of the same realm.
+type GossipPeer struct {
+	// ID is the id of the verifier, as in the common name of its
+	// certificate.
+	ID   uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Addr string `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+}
+
+func (m *GossipPeer) Reset()                    { *m = GossipPeer{} }
+func (*GossipPeer) ProtoMessage()               {}
+func (*GossipPeer) Descriptor() ([]byte, []int) { return fileDescriptorVerifierconfig, []int{1} }
+
 func init() {
 	proto1.RegisterType((*VerifierConfig)(nil), "proto.VerifierConfig")
+	proto1.RegisterType((*GossipPeer)(nil), "proto.GossipPeer")
 }
 func (this *VerifierConfig) VerboseEqual(that interface{}) error {
 	if that == nil {
@@ -107,6 +199,43 @@ func (this *VerifierConfig) VerboseEqual(that interface{}) error {
 	if this.LevelDBPath != that1.LevelDBPath {
 		return fmt.Errorf("LevelDBPath this(%v) Not Equal that(%v)", this.LevelDBPath, that1.LevelDBPath)
 	}
+	if !this.CheckpointRatifiers.Equal(that1.CheckpointRatifiers) {
+		return fmt.Errorf("CheckpointRatifiers this(%v) Not Equal that(%v)", this.CheckpointRatifiers, that1.CheckpointRatifiers)
+	}
+	if this.GossipAddr != that1.GossipAddr {
+		return fmt.Errorf("GossipAddr this(%v) Not Equal that(%v)", this.GossipAddr, that1.GossipAddr)
+	}
+	if !this.GossipTLS.Equal(that1.GossipTLS) {
+		return fmt.Errorf("GossipTLS this(%v) Not Equal that(%v)", this.GossipTLS, that1.GossipTLS)
+	}
+	if len(this.GossipPeers) != len(that1.GossipPeers) {
+		return fmt.Errorf("GossipPeers this(%v) Not Equal that(%v)", len(this.GossipPeers), len(that1.GossipPeers))
+	}
+	for i := range this.GossipPeers {
+		if !this.GossipPeers[i].Equal(that1.GossipPeers[i]) {
+			return fmt.Errorf("GossipPeers this[%v](%v) Not Equal that[%v](%v)", i, this.GossipPeers[i], i, that1.GossipPeers[i])
+		}
+	}
+	if !this.GossipInterval.Equal(&that1.GossipInterval) {
+		return fmt.Errorf("GossipInterval this(%v) Not Equal that(%v)", this.GossipInterval, that1.GossipInterval)
+	}
+	if !this.DeletionPolicy.Equal(that1.DeletionPolicy) {
+		return fmt.Errorf("DeletionPolicy this(%v) Not Equal that(%v)", this.DeletionPolicy, that1.DeletionPolicy)
+	}
+	if len(this.DomainAdminPolicies) != len(that1.DomainAdminPolicies) {
+		return fmt.Errorf("DomainAdminPolicies this(%v) Not Equal that(%v)", len(this.DomainAdminPolicies), len(that1.DomainAdminPolicies))
+	}
+	for i := range this.DomainAdminPolicies {
+		if !this.DomainAdminPolicies[i].Equal(that1.DomainAdminPolicies[i]) {
+			return fmt.Errorf("DomainAdminPolicies this[%v](%v) Not Equal that[%v](%v)", i, this.DomainAdminPolicies[i], i, that1.DomainAdminPolicies[i])
+		}
+	}
+	if !bytes.Equal(this.VRFPublic, that1.VRFPublic) {
+		return fmt.Errorf("VRFPublic this(%v) Not Equal that(%v)", this.VRFPublic, that1.VRFPublic)
+	}
+	if this.VRFSuite != that1.VRFSuite {
+		return fmt.Errorf("VRFSuite this(%v) Not Equal that(%v)", this.VRFSuite, that1.VRFSuite)
+	}
 	return nil
 }
 func (this *VerifierConfig) Equal(that interface{}) bool {
@@ -158,13 +287,116 @@ func (this *VerifierConfig) Equal(that interface{}) bool {
 	if this.LevelDBPath != that1.LevelDBPath {
 		return false
 	}
+	if !this.CheckpointRatifiers.Equal(that1.CheckpointRatifiers) {
+		return false
+	}
+	if this.GossipAddr != that1.GossipAddr {
+		return false
+	}
+	if !this.GossipTLS.Equal(that1.GossipTLS) {
+		return false
+	}
+	if len(this.GossipPeers) != len(that1.GossipPeers) {
+		return false
+	}
+	for i := range this.GossipPeers {
+		if !this.GossipPeers[i].Equal(that1.GossipPeers[i]) {
+			return false
+		}
+	}
+	if !this.GossipInterval.Equal(&that1.GossipInterval) {
+		return false
+	}
+	if !this.DeletionPolicy.Equal(that1.DeletionPolicy) {
+		return false
+	}
+	if len(this.DomainAdminPolicies) != len(that1.DomainAdminPolicies) {
+		return false
+	}
+	for i := range this.DomainAdminPolicies {
+		if !this.DomainAdminPolicies[i].Equal(that1.DomainAdminPolicies[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.VRFPublic, that1.VRFPublic) {
+		return false
+	}
+	if this.VRFSuite != that1.VRFSuite {
+		return false
+	}
+	return true
+}
+func (this *GossipPeer) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*GossipPeer)
+	if !ok {
+		that2, ok := that.(GossipPeer)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *GossipPeer")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *GossipPeer but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *GossipPeer but is not nil && this == nil")
+	}
+	if this.ID != that1.ID {
+		return fmt.Errorf("ID this(%v) Not Equal that(%v)", this.ID, that1.ID)
+	}
+	if this.Addr != that1.Addr {
+		return fmt.Errorf("Addr this(%v) Not Equal that(%v)", this.Addr, that1.Addr)
+	}
+	return nil
+}
+func (this *GossipPeer) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*GossipPeer)
+	if !ok {
+		that2, ok := that.(GossipPeer)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.ID != that1.ID {
+		return false
+	}
+	if this.Addr != that1.Addr {
+		return false
+	}
 	return true
 }
 func (this *VerifierConfig) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 12)
+	s := make([]string, 0, 21)
 	s = append(s, "&proto.VerifierConfig{")
 	s = append(s, "ID: "+fmt.Sprintf("%#v", this.ID)+",\n")
 	s = append(s, "SigningKeyID: "+fmt.Sprintf("%#v", this.SigningKeyID)+",\n")
@@ -176,6 +408,36 @@ func (this *VerifierConfig) GoString() string {
 	s = append(s, "InitialKeyserverAuth: "+strings.Replace(this.InitialKeyserverAuth.GoString(), `&`, ``, 1)+",\n")
 	s = append(s, "TreeNonce: "+fmt.Sprintf("%#v", this.TreeNonce)+",\n")
 	s = append(s, "LevelDBPath: "+fmt.Sprintf("%#v", this.LevelDBPath)+",\n")
+	if this.CheckpointRatifiers != nil {
+		s = append(s, "CheckpointRatifiers: "+fmt.Sprintf("%#v", this.CheckpointRatifiers)+",\n")
+	}
+	s = append(s, "GossipAddr: "+fmt.Sprintf("%#v", this.GossipAddr)+",\n")
+	if this.GossipTLS != nil {
+		s = append(s, "GossipTLS: "+fmt.Sprintf("%#v", this.GossipTLS)+",\n")
+	}
+	if this.GossipPeers != nil {
+		s = append(s, "GossipPeers: "+fmt.Sprintf("%#v", this.GossipPeers)+",\n")
+	}
+	s = append(s, "GossipInterval: "+strings.Replace(this.GossipInterval.GoString(), `&`, ``, 1)+",\n")
+	if this.DeletionPolicy != nil {
+		s = append(s, "DeletionPolicy: "+fmt.Sprintf("%#v", this.DeletionPolicy)+",\n")
+	}
+	if this.DomainAdminPolicies != nil {
+		s = append(s, "DomainAdminPolicies: "+fmt.Sprintf("%#v", this.DomainAdminPolicies)+",\n")
+	}
+	s = append(s, "VRFPublic: "+fmt.Sprintf("%#v", this.VRFPublic)+",\n")
+	s = append(s, "VRFSuite: "+fmt.Sprintf("%#v", this.VRFSuite)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GossipPeer) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&proto.GossipPeer{")
+	s = append(s, "ID: "+fmt.Sprintf("%#v", this.ID)+",\n")
+	s = append(s, "Addr: "+fmt.Sprintf("%#v", this.Addr)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -273,6 +535,118 @@ func (m *VerifierConfig) MarshalTo(data []byte) (int, error) {
 		i = encodeVarintVerifierconfig(data, i, uint64(len(m.LevelDBPath)))
 		i += copy(data[i:], m.LevelDBPath)
 	}
+	if m.CheckpointRatifiers != nil {
+		data[i] = 0x4a
+		i++
+		i = encodeVarintVerifierconfig(data, i, uint64(m.CheckpointRatifiers.Size()))
+		n3, err := m.CheckpointRatifiers.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	if len(m.GossipAddr) > 0 {
+		data[i] = 0x52
+		i++
+		i = encodeVarintVerifierconfig(data, i, uint64(len(m.GossipAddr)))
+		i += copy(data[i:], m.GossipAddr)
+	}
+	if m.GossipTLS != nil {
+		data[i] = 0x5a
+		i++
+		i = encodeVarintVerifierconfig(data, i, uint64(m.GossipTLS.Size()))
+		n4, err := m.GossipTLS.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	if len(m.GossipPeers) > 0 {
+		for _, msg := range m.GossipPeers {
+			data[i] = 0x62
+			i++
+			i = encodeVarintVerifierconfig(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	data[i] = 0x6a
+	i++
+	i = encodeVarintVerifierconfig(data, i, uint64(m.GossipInterval.Size()))
+	n5, err := m.GossipInterval.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n5
+	if m.DeletionPolicy != nil {
+		data[i] = 0x72
+		i++
+		i = encodeVarintVerifierconfig(data, i, uint64(m.DeletionPolicy.Size()))
+		n6, err := m.DeletionPolicy.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
+	if len(m.DomainAdminPolicies) > 0 {
+		for _, msg := range m.DomainAdminPolicies {
+			data[i] = 0x7a
+			i++
+			i = encodeVarintVerifierconfig(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.VRFPublic) > 0 {
+		data[i] = 0x82
+		i++
+		data[i] = 0x1
+		i++
+		i = encodeVarintVerifierconfig(data, i, uint64(len(m.VRFPublic)))
+		i += copy(data[i:], m.VRFPublic)
+	}
+	if m.VRFSuite != 0 {
+		data[i] = 0x88
+		i++
+		data[i] = 0x1
+		i++
+		i = encodeVarintVerifierconfig(data, i, uint64(m.VRFSuite))
+	}
+	return i, nil
+}
+
+func (m *GossipPeer) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *GossipPeer) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.ID != 0 {
+		data[i] = 0x8
+		i++
+		i = encodeVarintVerifierconfig(data, i, uint64(m.ID))
+	}
+	if len(m.Addr) > 0 {
+		data[i] = 0x12
+		i++
+		i = encodeVarintVerifierconfig(data, i, uint64(len(m.Addr)))
+		i += copy(data[i:], m.Addr)
+	}
 	return i, nil
 }
 
@@ -320,6 +694,47 @@ func NewPopulatedVerifierConfig(r randyVerifierconfig, easy bool) *VerifierConfi
 		this.TreeNonce[i] = byte(r.Intn(256))
 	}
 	this.LevelDBPath = randStringVerifierconfig(r)
+	if r.Intn(10) == 0 {
+		this.CheckpointRatifiers = NewPopulatedAuthorizationPolicy(r, easy)
+	}
+	this.GossipAddr = randStringVerifierconfig(r)
+	if r.Intn(10) != 0 {
+		this.GossipTLS = NewPopulatedTLSConfig(r, easy)
+	}
+	if r.Intn(10) != 0 {
+		v3 := r.Intn(5)
+		this.GossipPeers = make([]*GossipPeer, v3)
+		for i := 0; i < v3; i++ {
+			this.GossipPeers[i] = NewPopulatedGossipPeer(r, easy)
+		}
+	}
+	v4 := NewPopulatedDuration(r, easy)
+	this.GossipInterval = *v4
+	if r.Intn(10) == 0 {
+		this.DeletionPolicy = NewPopulatedAuthorizationPolicy(r, easy)
+	}
+	if r.Intn(10) == 0 {
+		v5 := r.Intn(5)
+		this.DomainAdminPolicies = make([]*DomainAdminPolicy, v5)
+		for i := 0; i < v5; i++ {
+			this.DomainAdminPolicies[i] = NewPopulatedDomainAdminPolicy(r, easy)
+		}
+	}
+	v6 := r.Intn(100)
+	this.VRFPublic = make([]byte, v6)
+	for i := 0; i < v6; i++ {
+		this.VRFPublic[i] = byte(r.Intn(256))
+	}
+	this.VRFSuite = VRFSuite([]int32{0, 1}[r.Intn(2)])
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGossipPeer(r randyVerifierconfig, easy bool) *GossipPeer {
+	this := &GossipPeer{}
+	this.ID = uint64(uint64(r.Uint32()))
+	this.Addr = randStringVerifierconfig(r)
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -344,9 +759,9 @@ func randUTF8RuneVerifierconfig(r randyVerifierconfig) rune {
 	return rune(ru + 61)
 }
 func randStringVerifierconfig(r randyVerifierconfig) string {
-	v3 := r.Intn(100)
-	tmps := make([]rune, v3)
-	for i := 0; i < v3; i++ {
+	v7 := r.Intn(100)
+	tmps := make([]rune, v7)
+	for i := 0; i < v7; i++ {
 		tmps[i] = randUTF8RuneVerifierconfig(r)
 	}
 	return string(tmps)
@@ -368,11 +783,11 @@ func randFieldVerifierconfig(data []byte, r randyVerifierconfig, fieldNumber int
 	switch wire {
 	case 0:
 		data = encodeVarintPopulateVerifierconfig(data, uint64(key))
-		v4 := r.Int63()
+		v8 := r.Int63()
 		if r.Intn(2) == 0 {
-			v4 *= -1
+			v8 *= -1
 		}
-		data = encodeVarintPopulateVerifierconfig(data, uint64(v4))
+		data = encodeVarintPopulateVerifierconfig(data, uint64(v8))
 	case 1:
 		data = encodeVarintPopulateVerifierconfig(data, uint64(key))
 		data = append(data, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -429,6 +844,56 @@ func (m *VerifierConfig) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovVerifierconfig(uint64(l))
 	}
+	if m.CheckpointRatifiers != nil {
+		l = m.CheckpointRatifiers.Size()
+		n += 1 + l + sovVerifierconfig(uint64(l))
+	}
+	l = len(m.GossipAddr)
+	if l > 0 {
+		n += 1 + l + sovVerifierconfig(uint64(l))
+	}
+	if m.GossipTLS != nil {
+		l = m.GossipTLS.Size()
+		n += 1 + l + sovVerifierconfig(uint64(l))
+	}
+	if len(m.GossipPeers) > 0 {
+		for _, e := range m.GossipPeers {
+			l = e.Size()
+			n += 1 + l + sovVerifierconfig(uint64(l))
+		}
+	}
+	l = m.GossipInterval.Size()
+	n += 1 + l + sovVerifierconfig(uint64(l))
+	if m.DeletionPolicy != nil {
+		l = m.DeletionPolicy.Size()
+		n += 1 + l + sovVerifierconfig(uint64(l))
+	}
+	if len(m.DomainAdminPolicies) > 0 {
+		for _, e := range m.DomainAdminPolicies {
+			l = e.Size()
+			n += 1 + l + sovVerifierconfig(uint64(l))
+		}
+	}
+	l = len(m.VRFPublic)
+	if l > 0 {
+		n += 2 + l + sovVerifierconfig(uint64(l))
+	}
+	if m.VRFSuite != 0 {
+		n += 2 + sovVerifierconfig(uint64(m.VRFSuite))
+	}
+	return n
+}
+
+func (m *GossipPeer) Size() (n int) {
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovVerifierconfig(uint64(m.ID))
+	}
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovVerifierconfig(uint64(l))
+	}
 	return n
 }
 
@@ -458,6 +923,26 @@ func (this *VerifierConfig) String() string {
 		`InitialKeyserverAuth:` + strings.Replace(strings.Replace(this.InitialKeyserverAuth.String(), "AuthorizationPolicy", "AuthorizationPolicy", 1), `&`, ``, 1) + `,`,
 		`TreeNonce:` + fmt.Sprintf("%v", this.TreeNonce) + `,`,
 		`LevelDBPath:` + fmt.Sprintf("%v", this.LevelDBPath) + `,`,
+		`CheckpointRatifiers:` + strings.Replace(fmt.Sprintf("%v", this.CheckpointRatifiers), "AuthorizationPolicy", "AuthorizationPolicy", 1) + `,`,
+		`GossipAddr:` + fmt.Sprintf("%v", this.GossipAddr) + `,`,
+		`GossipTLS:` + strings.Replace(fmt.Sprintf("%v", this.GossipTLS), "TLSConfig", "TLSConfig", 1) + `,`,
+		`GossipPeers:` + strings.Replace(fmt.Sprintf("%v", this.GossipPeers), "GossipPeer", "GossipPeer", 1) + `,`,
+		`GossipInterval:` + strings.Replace(strings.Replace(this.GossipInterval.String(), "Duration", "Duration", 1), `&`, ``, 1) + `,`,
+		`DeletionPolicy:` + strings.Replace(fmt.Sprintf("%v", this.DeletionPolicy), "AuthorizationPolicy", "AuthorizationPolicy", 1) + `,`,
+		`DomainAdminPolicies:` + strings.Replace(fmt.Sprintf("%v", this.DomainAdminPolicies), "DomainAdminPolicy", "DomainAdminPolicy", 1) + `,`,
+		`VRFPublic:` + fmt.Sprintf("%v", this.VRFPublic) + `,`,
+		`VRFSuite:` + fmt.Sprintf("%v", this.VRFSuite) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GossipPeer) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GossipPeer{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`Addr:` + fmt.Sprintf("%v", this.Addr) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -728,6 +1213,374 @@ func (m *VerifierConfig) Unmarshal(data []byte) error {
 			}
 			m.LevelDBPath = string(data[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckpointRatifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVerifierconfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVerifierconfig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CheckpointRatifiers == nil {
+				m.CheckpointRatifiers = &AuthorizationPolicy{}
+			}
+			if err := m.CheckpointRatifiers.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GossipAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVerifierconfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVerifierconfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GossipAddr = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GossipTLS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVerifierconfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVerifierconfig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GossipTLS == nil {
+				m.GossipTLS = &TLSConfig{}
+			}
+			if err := m.GossipTLS.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GossipPeers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVerifierconfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVerifierconfig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GossipPeers = append(m.GossipPeers, &GossipPeer{})
+			if err := m.GossipPeers[len(m.GossipPeers)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GossipInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVerifierconfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVerifierconfig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GossipInterval.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletionPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVerifierconfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVerifierconfig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DeletionPolicy == nil {
+				m.DeletionPolicy = &AuthorizationPolicy{}
+			}
+			if err := m.DeletionPolicy.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainAdminPolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVerifierconfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVerifierconfig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DomainAdminPolicies = append(m.DomainAdminPolicies, &DomainAdminPolicy{})
+			if err := m.DomainAdminPolicies[len(m.DomainAdminPolicies)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VRFPublic", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVerifierconfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthVerifierconfig
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VRFPublic = append(m.VRFPublic[:0], data[iNdEx:postIndex]...)
+			if m.VRFPublic == nil {
+				m.VRFPublic = []byte{}
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VRFSuite", wireType)
+			}
+			m.VRFSuite = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVerifierconfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.VRFSuite |= (VRFSuite(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVerifierconfig(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthVerifierconfig
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GossipPeer) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVerifierconfig
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GossipPeer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GossipPeer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVerifierconfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.ID |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVerifierconfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVerifierconfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVerifierconfig(data[iNdEx:])
@@ -857,33 +1710,49 @@ var (
 func init() { proto1.RegisterFile("verifierconfig.proto", fileDescriptorVerifierconfig) }
 
 var fileDescriptorVerifierconfig = []byte{
-	// 433 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x90, 0xcf, 0x6b, 0xdb, 0x30,
-	0x14, 0xc7, 0xa3, 0xfc, 0xea, 0xaa, 0x78, 0x69, 0x11, 0xa1, 0x98, 0xc0, 0xe4, 0x30, 0x18, 0x04,
-	0x06, 0xc9, 0xe8, 0x60, 0xec, 0x5a, 0x2f, 0x97, 0xd2, 0x30, 0x8a, 0x53, 0x7a, 0x35, 0x8e, 0xa5,
-	0xd8, 0x8f, 0x3a, 0x56, 0x91, 0xe5, 0x80, 0x77, 0xda, 0x9f, 0xb3, 0x3f, 0x61, 0xc7, 0x1d, 0x7b,
-	0xec, 0x71, 0x27, 0x53, 0xeb, 0xb4, 0x63, 0x2f, 0x83, 0x1d, 0x87, 0xe5, 0xd0, 0xf6, 0x24, 0x7d,
-	0xbf, 0xef, 0xf3, 0xde, 0xf7, 0x49, 0x78, 0xb4, 0xe3, 0x12, 0x36, 0xc0, 0x65, 0x28, 0xd2, 0x0d,
-	0x44, 0xb3, 0x5b, 0x29, 0x94, 0x20, 0x3d, 0x73, 0x8c, 0x3f, 0x44, 0xa0, 0xe2, 0x7c, 0x3d, 0x0b,
-	0xc5, 0x76, 0xbe, 0x0d, 0x18, 0xa8, 0x22, 0x98, 0x9b, 0xca, 0x3a, 0xdf, 0xcc, 0x23, 0x11, 0x09,
-	0x23, 0xcc, 0xad, 0x69, 0x1c, 0x1f, 0xa9, 0x24, 0x7b, 0x39, 0x69, 0x6c, 0x85, 0x09, 0xf0, 0x54,
-	0x35, 0xea, 0xed, 0xdf, 0x36, 0x1e, 0x5e, 0xef, 0x03, 0xbf, 0x18, 0x8c, 0x9c, 0xe0, 0x36, 0x30,
-	0x1b, 0x4d, 0xd0, 0xb4, 0xeb, 0xf6, 0x75, 0xe9, 0xb4, 0xcf, 0x17, 0x5e, 0x1b, 0x18, 0xf9, 0x84,
-	0x87, 0x19, 0x44, 0x29, 0xa4, 0x91, 0x7f, 0xc3, 0x0b, 0x1f, 0x98, 0xdd, 0x9e, 0xa0, 0xe9, 0xa1,
-	0x7b, 0xac, 0x4b, 0xc7, 0x5a, 0x35, 0x95, 0x0b, 0x5e, 0x9c, 0x2f, 0x3c, 0x2b, 0x7b, 0x56, 0x8c,
-	0x8c, 0x70, 0x4f, 0xf2, 0x20, 0xd9, 0xda, 0x9d, 0x1a, 0xf7, 0x1a, 0x41, 0xde, 0xe3, 0x8e, 0x4a,
-	0x32, 0xbb, 0x3b, 0x41, 0xd3, 0xc1, 0xe9, 0x71, 0xb3, 0xcd, 0xec, 0x6a, 0xb9, 0x6a, 0x96, 0x70,
-	0x0f, 0x74, 0xe9, 0x74, 0xae, 0x96, 0x2b, 0xaf, 0xa6, 0xc8, 0x3b, 0x3c, 0xbc, 0xe1, 0x45, 0xc6,
-	0xe5, 0x8e, 0x4b, 0x3f, 0x60, 0x4c, 0xda, 0x3d, 0x33, 0xeb, 0xf5, 0x93, 0x7b, 0xc6, 0x98, 0x24,
-	0xd7, 0xf8, 0x04, 0x52, 0x50, 0x10, 0x24, 0xfe, 0x0b, 0x3c, 0x57, 0xb1, 0xdd, 0x37, 0x31, 0xe3,
-	0x7d, 0xcc, 0x59, 0xae, 0x62, 0x21, 0xe1, 0x5b, 0xa0, 0x40, 0xa4, 0x97, 0x22, 0x81, 0xb0, 0x70,
-	0xbb, 0x77, 0xa5, 0xd3, 0xf2, 0x46, 0xfb, 0xfe, 0x8b, 0xa7, 0xb9, 0xb9, 0x8a, 0xc9, 0x1b, 0x8c,
-	0x95, 0xe4, 0xdc, 0x4f, 0x45, 0x1a, 0x72, 0xfb, 0x60, 0x82, 0xa6, 0x96, 0x77, 0x58, 0x3b, 0x5f,
-	0x6b, 0x83, 0x9c, 0x62, 0x2b, 0xe1, 0x3b, 0x9e, 0xb0, 0xb5, 0x7f, 0x1b, 0xa8, 0xd8, 0x7e, 0x65,
-	0xbe, 0xe5, 0x48, 0x97, 0xce, 0x60, 0x59, 0xfb, 0x0b, 0xf7, 0x32, 0x50, 0xb1, 0x37, 0xd8, 0x43,
-	0xb5, 0x70, 0x3f, 0xdf, 0x57, 0xb4, 0xf5, 0xbb, 0xa2, 0xad, 0x87, 0x8a, 0xa2, 0xc7, 0x8a, 0xa2,
-	0x7f, 0x15, 0x45, 0xdf, 0x35, 0x45, 0x3f, 0x34, 0x45, 0x3f, 0x35, 0x45, 0xbf, 0x34, 0x45, 0x77,
-	0x9a, 0xa2, 0x7b, 0x4d, 0xd1, 0x83, 0xa6, 0xe8, 0x8f, 0xa6, 0xad, 0x47, 0x4d, 0xd1, 0xba, 0x6f,
-	0xde, 0xf0, 0xf1, 0x7f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc6, 0x4d, 0x06, 0x6c, 0x28, 0x02, 0x00,
-	0x00,
+	// 698 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0xcd, 0x6a, 0xdb, 0x4a,
+	0x18, 0xf5, 0xd8, 0xce, 0x8f, 0xc7, 0x8a, 0x9c, 0xcc, 0xf5, 0x0d, 0xc2, 0x70, 0x25, 0x13, 0xb8,
+	0x60, 0xb8, 0x97, 0xa4, 0xa4, 0xa5, 0x84, 0x2e, 0x02, 0x71, 0x4c, 0x4a, 0x88, 0x5b, 0xcc, 0x38,
+	0x78, 0x2b, 0x64, 0xcd, 0x58, 0x1e, 0x22, 0x4b, 0x66, 0x34, 0x32, 0xb8, 0xab, 0x3e, 0x46, 0x1f,
+	0xa1, 0x8f, 0xd0, 0x65, 0x97, 0x59, 0x66, 0xd9, 0x95, 0x89, 0x67, 0xd5, 0x65, 0x96, 0x5d, 0x96,
+	0x19, 0xc9, 0x4e, 0x28, 0x84, 0xac, 0xf4, 0x9d, 0xa3, 0x73, 0xbe, 0xf9, 0xe6, 0xcc, 0x0c, 0xac,
+	0xcf, 0x28, 0x67, 0x23, 0x46, 0xb9, 0x1f, 0x47, 0x23, 0x16, 0x1c, 0x4e, 0x79, 0x2c, 0x62, 0xb4,
+	0xa1, 0x3f, 0x8d, 0x57, 0x01, 0x13, 0xe3, 0x74, 0x78, 0xe8, 0xc7, 0x93, 0xa3, 0x89, 0x47, 0x98,
+	0x98, 0x7b, 0x47, 0xfa, 0xcf, 0x30, 0x1d, 0x1d, 0x05, 0x71, 0x10, 0x6b, 0xa0, 0xab, 0xcc, 0xd8,
+	0xa8, 0x89, 0x30, 0x79, 0xda, 0xa9, 0x61, 0x92, 0x94, 0x7b, 0x82, 0xc5, 0x51, 0x8e, 0x0d, 0x3f,
+	0x64, 0x34, 0x12, 0x19, 0x3a, 0xf8, 0xb2, 0x05, 0xcd, 0x41, 0x3e, 0xc0, 0xb9, 0xb6, 0xa1, 0x7d,
+	0x58, 0x64, 0xc4, 0x02, 0x4d, 0xd0, 0x2a, 0xb7, 0x37, 0xe5, 0xc2, 0x29, 0x5e, 0x76, 0x70, 0x91,
+	0x11, 0xf4, 0x16, 0x9a, 0x09, 0x0b, 0x22, 0x16, 0x05, 0xee, 0x0d, 0x9d, 0xbb, 0x8c, 0x58, 0xc5,
+	0x26, 0x68, 0x55, 0xda, 0xbb, 0x72, 0xe1, 0x18, 0xfd, 0xec, 0xcf, 0x15, 0x9d, 0x5f, 0x76, 0xb0,
+	0x91, 0x3c, 0x22, 0x82, 0xea, 0x70, 0x83, 0x53, 0x2f, 0x9c, 0x58, 0x25, 0x25, 0xc7, 0x19, 0x40,
+	0xff, 0xc1, 0x92, 0x08, 0x13, 0xab, 0xdc, 0x04, 0xad, 0xea, 0xf1, 0x6e, 0x36, 0xcd, 0xe1, 0x75,
+	0xb7, 0x9f, 0x0d, 0xd1, 0xde, 0x92, 0x0b, 0xa7, 0x74, 0xdd, 0xed, 0x63, 0xa5, 0x42, 0xff, 0x42,
+	0xf3, 0x86, 0xce, 0x13, 0xca, 0x67, 0x94, 0xbb, 0x1e, 0x21, 0xdc, 0xda, 0xd0, 0xbd, 0x76, 0xd6,
+	0xec, 0x19, 0x21, 0x1c, 0x0d, 0xe0, 0x3e, 0x8b, 0x98, 0x60, 0x5e, 0xe8, 0x3e, 0x91, 0xa7, 0x62,
+	0x6c, 0x6d, 0xea, 0x65, 0x1a, 0xf9, 0x32, 0x67, 0xa9, 0x18, 0xc7, 0x9c, 0x7d, 0xd2, 0xb1, 0xf4,
+	0xe2, 0x90, 0xf9, 0xf3, 0x76, 0xf9, 0x76, 0xe1, 0x14, 0x70, 0x3d, 0xf7, 0x5f, 0xad, 0xfb, 0xa6,
+	0x62, 0x8c, 0xfe, 0x81, 0x50, 0x70, 0x4a, 0xdd, 0x28, 0x8e, 0x7c, 0x6a, 0x6d, 0x35, 0x41, 0xcb,
+	0xc0, 0x15, 0xc5, 0x7c, 0x54, 0x04, 0x3a, 0x86, 0x46, 0x48, 0x67, 0x34, 0x24, 0x43, 0x77, 0xea,
+	0x89, 0xb1, 0xb5, 0xad, 0x63, 0xa9, 0xc9, 0x85, 0x53, 0xed, 0x2a, 0xbe, 0xd3, 0xee, 0x79, 0x62,
+	0x8c, 0xab, 0xb9, 0x48, 0x01, 0xf4, 0x01, 0xd6, 0xfd, 0x31, 0xf5, 0x6f, 0xa6, 0x31, 0x8b, 0x84,
+	0xab, 0x0e, 0x48, 0x9d, 0x40, 0x62, 0x55, 0x5e, 0x1a, 0x14, 0xff, 0xf5, 0xe8, 0xc3, 0x2b, 0x1b,
+	0x72, 0x60, 0x35, 0x88, 0x93, 0x84, 0x4d, 0xb3, 0x74, 0xa0, 0x4e, 0x07, 0x66, 0x94, 0x8e, 0xe6,
+	0x14, 0xe6, 0xc8, 0x55, 0xa9, 0x57, 0x9f, 0x49, 0x7d, 0x47, 0x2e, 0x9c, 0xca, 0x7b, 0xad, 0x53,
+	0xd9, 0x57, 0x32, 0xcb, 0x75, 0x98, 0xa0, 0x37, 0xd0, 0xc8, 0xfd, 0x53, 0xaa, 0xe6, 0x34, 0x9a,
+	0xa5, 0x56, 0xf5, 0x78, 0x2f, 0xef, 0x90, 0x59, 0x7a, 0x94, 0x72, 0x5c, 0x0d, 0xd6, 0x75, 0x82,
+	0x4e, 0x61, 0x2d, 0x77, 0xb1, 0x48, 0x50, 0x3e, 0xf3, 0x42, 0x6b, 0x47, 0x2f, 0x5d, 0xcb, 0x8d,
+	0x9d, 0xfc, 0x6e, 0xe6, 0xf1, 0x9b, 0x99, 0xfa, 0x32, 0x17, 0xa3, 0x73, 0x58, 0x23, 0x34, 0xa4,
+	0x4a, 0xe1, 0x4e, 0xf5, 0xf6, 0x2d, 0xf3, 0xc5, 0x80, 0xcc, 0x95, 0x25, 0xc3, 0xa8, 0x0b, 0xff,
+	0x26, 0xf1, 0xc4, 0x63, 0x91, 0xeb, 0x91, 0x09, 0xcb, 0x1b, 0x31, 0x9a, 0x58, 0x35, 0xbd, 0x07,
+	0x6b, 0x35, 0x8a, 0xd6, 0x9c, 0x29, 0xc9, 0x2a, 0x69, 0xf2, 0x07, 0xc5, 0x68, 0x82, 0xfe, 0x87,
+	0x70, 0xc6, 0x47, 0xee, 0x34, 0x1d, 0x86, 0xcc, 0xb7, 0x76, 0xd5, 0x5d, 0xc8, 0x62, 0x1b, 0xe0,
+	0x8b, 0x9e, 0x26, 0x71, 0x65, 0xc6, 0x47, 0x59, 0x89, 0xde, 0x41, 0x05, 0xdc, 0x24, 0x65, 0x82,
+	0x5a, 0x7b, 0x4d, 0xd0, 0x32, 0xd7, 0x5b, 0x1f, 0xe0, 0x8b, 0xbe, 0xa2, 0xdb, 0x86, 0x5c, 0x38,
+	0xdb, 0x2b, 0x84, 0xb7, 0x67, 0x7c, 0xa4, 0xab, 0x83, 0x13, 0x08, 0x1f, 0x73, 0x7d, 0xf6, 0x55,
+	0x22, 0x58, 0xd6, 0x47, 0xae, 0xdf, 0x22, 0xd6, 0x75, 0xfb, 0xe4, 0x6e, 0x69, 0x17, 0x7e, 0x2c,
+	0xed, 0xc2, 0xfd, 0xd2, 0x06, 0x0f, 0x4b, 0x1b, 0xfc, 0x5a, 0xda, 0xe0, 0xb3, 0xb4, 0xc1, 0x57,
+	0x69, 0x83, 0x6f, 0xd2, 0x06, 0xdf, 0xa5, 0x0d, 0x6e, 0xa5, 0x0d, 0xee, 0xa4, 0x0d, 0xee, 0xa5,
+	0x0d, 0x7e, 0x4a, 0xbb, 0xf0, 0x20, 0x6d, 0x30, 0xdc, 0xd4, 0xb3, 0xbd, 0xfe, 0x1d, 0x00, 0x00,
+	0xff, 0xff, 0x61, 0xc0, 0x87, 0x11, 0x95, 0x04, 0x00, 0x00,
 }
@@ -32,6 +32,28 @@ type VerifierState struct {
 	PreviousSummaryHash []byte               `protobuf:"bytes,3,opt,name=previous_summary_hash,json=previousSummaryHash,proto3" json:"previous_summary_hash,omitempty"`
 	LatestTreeSnapshot  uint64               `protobuf:"varint,4,opt,name=latest_tree_snapshot,json=latestTreeSnapshot,proto3" json:"latest_tree_snapshot,omitempty"`
 	KeyserverAuth       *AuthorizationPolicy `protobuf:"bytes,5,opt,name=keyserver_auth,json=keyserverAuth" json:"keyserver_auth,omitempty"`
+	// VRFPublic is the public key of the VRF that indexes the latest tree,
+	// empty if it is not known yet.
+	VRFPublic []byte `protobuf:"bytes,6,opt,name=vrf_public,json=vrfPublic,proto3" json:"vrf_public,omitempty"`
+	// VRFRotationTime is the issue time of the first epoch after the last VRF
+	// key rotation, nil if there has been none. VRFRotationPending is set
+	// between a rotation and that epoch.
+	VRFRotationTime    *Timestamp `protobuf:"bytes,7,opt,name=vrf_rotation_time,json=vrfRotationTime" json:"vrf_rotation_time,omitempty"`
+	VRFRotationPending bool       `protobuf:"varint,8,opt,name=vrf_rotation_pending,json=vrfRotationPending,proto3" json:"vrf_rotation_pending,omitempty"`
+	// VRFRotatingTo is the VRF public key of the rotation whose steps are
+	// being verified, empty if there is none. The entries up to and including
+	// the old index VRFRotationCursor have been moved to the tree
+	// VRFRotationNewSnapshot so far. VRFRotationCheckSnapshot is the tree of
+	// the same entries at their old indices, which must be the latest tree
+	// once all entries have been moved.
+	VRFRotatingTo            []byte `protobuf:"bytes,9,opt,name=vrf_rotating_to,json=vrfRotatingTo,proto3" json:"vrf_rotating_to,omitempty"`
+	VRFRotationCursor        []byte `protobuf:"bytes,10,opt,name=vrf_rotation_cursor,json=vrfRotationCursor,proto3" json:"vrf_rotation_cursor,omitempty"`
+	VRFRotationNewSnapshot   uint64 `protobuf:"varint,11,opt,name=vrf_rotation_new_snapshot,json=vrfRotationNewSnapshot,proto3" json:"vrf_rotation_new_snapshot,omitempty"`
+	VRFRotationCheckSnapshot uint64 `protobuf:"varint,12,opt,name=vrf_rotation_check_snapshot,json=vrfRotationCheckSnapshot,proto3" json:"vrf_rotation_check_snapshot,omitempty"`
+	// ChangedSinceEpoch is set if a step other than an epoch or a refresh has
+	// been verified since the last epoch. The last epoch can then not be
+	// refreshed anymore.
+	ChangedSinceEpoch bool `protobuf:"varint,13,opt,name=changed_since_epoch,json=changedSinceEpoch,proto3" json:"changed_since_epoch,omitempty"`
 }
 
 func (m *VerifierState) Reset()                    { *m = VerifierState{} }
@@ -45,6 +67,13 @@ func (m *VerifierState) GetKeyserverAuth() *AuthorizationPolicy {
 	return nil
 }
 
+func (m *VerifierState) GetVRFRotationTime() *Timestamp {
+	if m != nil {
+		return m.VRFRotationTime
+	}
+	return nil
+}
+
 func init() {
 	proto1.RegisterType((*VerifierState)(nil), "proto.VerifierState")
 }
@@ -88,6 +117,30 @@ func (this *VerifierState) VerboseEqual(that interface{}) error {
 	if !this.KeyserverAuth.Equal(that1.KeyserverAuth) {
 		return fmt.Errorf("KeyserverAuth this(%v) Not Equal that(%v)", this.KeyserverAuth, that1.KeyserverAuth)
 	}
+	if !bytes.Equal(this.VRFPublic, that1.VRFPublic) {
+		return fmt.Errorf("VRFPublic this(%v) Not Equal that(%v)", this.VRFPublic, that1.VRFPublic)
+	}
+	if !this.VRFRotationTime.Equal(that1.VRFRotationTime) {
+		return fmt.Errorf("VRFRotationTime this(%v) Not Equal that(%v)", this.VRFRotationTime, that1.VRFRotationTime)
+	}
+	if this.VRFRotationPending != that1.VRFRotationPending {
+		return fmt.Errorf("VRFRotationPending this(%v) Not Equal that(%v)", this.VRFRotationPending, that1.VRFRotationPending)
+	}
+	if !bytes.Equal(this.VRFRotatingTo, that1.VRFRotatingTo) {
+		return fmt.Errorf("VRFRotatingTo this(%v) Not Equal that(%v)", this.VRFRotatingTo, that1.VRFRotatingTo)
+	}
+	if !bytes.Equal(this.VRFRotationCursor, that1.VRFRotationCursor) {
+		return fmt.Errorf("VRFRotationCursor this(%v) Not Equal that(%v)", this.VRFRotationCursor, that1.VRFRotationCursor)
+	}
+	if this.VRFRotationNewSnapshot != that1.VRFRotationNewSnapshot {
+		return fmt.Errorf("VRFRotationNewSnapshot this(%v) Not Equal that(%v)", this.VRFRotationNewSnapshot, that1.VRFRotationNewSnapshot)
+	}
+	if this.VRFRotationCheckSnapshot != that1.VRFRotationCheckSnapshot {
+		return fmt.Errorf("VRFRotationCheckSnapshot this(%v) Not Equal that(%v)", this.VRFRotationCheckSnapshot, that1.VRFRotationCheckSnapshot)
+	}
+	if this.ChangedSinceEpoch != that1.ChangedSinceEpoch {
+		return fmt.Errorf("ChangedSinceEpoch this(%v) Not Equal that(%v)", this.ChangedSinceEpoch, that1.ChangedSinceEpoch)
+	}
 	return nil
 }
 func (this *VerifierState) Equal(that interface{}) bool {
@@ -130,13 +183,37 @@ func (this *VerifierState) Equal(that interface{}) bool {
 	if !this.KeyserverAuth.Equal(that1.KeyserverAuth) {
 		return false
 	}
+	if !bytes.Equal(this.VRFPublic, that1.VRFPublic) {
+		return false
+	}
+	if !this.VRFRotationTime.Equal(that1.VRFRotationTime) {
+		return false
+	}
+	if this.VRFRotationPending != that1.VRFRotationPending {
+		return false
+	}
+	if !bytes.Equal(this.VRFRotatingTo, that1.VRFRotatingTo) {
+		return false
+	}
+	if !bytes.Equal(this.VRFRotationCursor, that1.VRFRotationCursor) {
+		return false
+	}
+	if this.VRFRotationNewSnapshot != that1.VRFRotationNewSnapshot {
+		return false
+	}
+	if this.VRFRotationCheckSnapshot != that1.VRFRotationCheckSnapshot {
+		return false
+	}
+	if this.ChangedSinceEpoch != that1.ChangedSinceEpoch {
+		return false
+	}
 	return true
 }
 func (this *VerifierState) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 9)
+	s := make([]string, 0, 17)
 	s = append(s, "&proto.VerifierState{")
 	s = append(s, "NextIndex: "+fmt.Sprintf("%#v", this.NextIndex)+",\n")
 	s = append(s, "NextEpoch: "+fmt.Sprintf("%#v", this.NextEpoch)+",\n")
@@ -145,6 +222,16 @@ func (this *VerifierState) GoString() string {
 	if this.KeyserverAuth != nil {
 		s = append(s, "KeyserverAuth: "+fmt.Sprintf("%#v", this.KeyserverAuth)+",\n")
 	}
+	s = append(s, "VRFPublic: "+fmt.Sprintf("%#v", this.VRFPublic)+",\n")
+	if this.VRFRotationTime != nil {
+		s = append(s, "VRFRotationTime: "+fmt.Sprintf("%#v", this.VRFRotationTime)+",\n")
+	}
+	s = append(s, "VRFRotationPending: "+fmt.Sprintf("%#v", this.VRFRotationPending)+",\n")
+	s = append(s, "VRFRotatingTo: "+fmt.Sprintf("%#v", this.VRFRotatingTo)+",\n")
+	s = append(s, "VRFRotationCursor: "+fmt.Sprintf("%#v", this.VRFRotationCursor)+",\n")
+	s = append(s, "VRFRotationNewSnapshot: "+fmt.Sprintf("%#v", this.VRFRotationNewSnapshot)+",\n")
+	s = append(s, "VRFRotationCheckSnapshot: "+fmt.Sprintf("%#v", this.VRFRotationCheckSnapshot)+",\n")
+	s = append(s, "ChangedSinceEpoch: "+fmt.Sprintf("%#v", this.ChangedSinceEpoch)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -220,6 +307,64 @@ func (m *VerifierState) MarshalTo(data []byte) (int, error) {
 		}
 		i += n1
 	}
+	if len(m.VRFPublic) > 0 {
+		data[i] = 0x32
+		i++
+		i = encodeVarintVerifierlocal(data, i, uint64(len(m.VRFPublic)))
+		i += copy(data[i:], m.VRFPublic)
+	}
+	if m.VRFRotationTime != nil {
+		data[i] = 0x3a
+		i++
+		i = encodeVarintVerifierlocal(data, i, uint64(m.VRFRotationTime.Size()))
+		n2, err := m.VRFRotationTime.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	if m.VRFRotationPending {
+		data[i] = 0x40
+		i++
+		if m.VRFRotationPending {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
+	if len(m.VRFRotatingTo) > 0 {
+		data[i] = 0x4a
+		i++
+		i = encodeVarintVerifierlocal(data, i, uint64(len(m.VRFRotatingTo)))
+		i += copy(data[i:], m.VRFRotatingTo)
+	}
+	if len(m.VRFRotationCursor) > 0 {
+		data[i] = 0x52
+		i++
+		i = encodeVarintVerifierlocal(data, i, uint64(len(m.VRFRotationCursor)))
+		i += copy(data[i:], m.VRFRotationCursor)
+	}
+	if m.VRFRotationNewSnapshot != 0 {
+		data[i] = 0x58
+		i++
+		i = encodeVarintVerifierlocal(data, i, uint64(m.VRFRotationNewSnapshot))
+	}
+	if m.VRFRotationCheckSnapshot != 0 {
+		data[i] = 0x60
+		i++
+		i = encodeVarintVerifierlocal(data, i, uint64(m.VRFRotationCheckSnapshot))
+	}
+	if m.ChangedSinceEpoch {
+		data[i] = 0x68
+		i++
+		if m.ChangedSinceEpoch {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
 	return i, nil
 }
 
@@ -263,6 +408,28 @@ func NewPopulatedVerifierState(r randyVerifierlocal, easy bool) *VerifierState {
 	if r.Intn(10) == 0 {
 		this.KeyserverAuth = NewPopulatedAuthorizationPolicy(r, easy)
 	}
+	v2 := r.Intn(100)
+	this.VRFPublic = make([]byte, v2)
+	for i := 0; i < v2; i++ {
+		this.VRFPublic[i] = byte(r.Intn(256))
+	}
+	if r.Intn(10) != 0 {
+		this.VRFRotationTime = NewPopulatedTimestamp(r, easy)
+	}
+	this.VRFRotationPending = bool(bool(r.Intn(2) == 0))
+	v3 := r.Intn(100)
+	this.VRFRotatingTo = make([]byte, v3)
+	for i := 0; i < v3; i++ {
+		this.VRFRotatingTo[i] = byte(r.Intn(256))
+	}
+	v4 := r.Intn(100)
+	this.VRFRotationCursor = make([]byte, v4)
+	for i := 0; i < v4; i++ {
+		this.VRFRotationCursor[i] = byte(r.Intn(256))
+	}
+	this.VRFRotationNewSnapshot = uint64(uint64(r.Uint32()))
+	this.VRFRotationCheckSnapshot = uint64(uint64(r.Uint32()))
+	this.ChangedSinceEpoch = bool(bool(r.Intn(2) == 0))
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -287,9 +454,9 @@ func randUTF8RuneVerifierlocal(r randyVerifierlocal) rune {
 	return rune(ru + 61)
 }
 func randStringVerifierlocal(r randyVerifierlocal) string {
-	v2 := r.Intn(100)
-	tmps := make([]rune, v2)
-	for i := 0; i < v2; i++ {
+	v5 := r.Intn(100)
+	tmps := make([]rune, v5)
+	for i := 0; i < v5; i++ {
 		tmps[i] = randUTF8RuneVerifierlocal(r)
 	}
 	return string(tmps)
@@ -311,11 +478,11 @@ func randFieldVerifierlocal(data []byte, r randyVerifierlocal, fieldNumber int,
 	switch wire {
 	case 0:
 		data = encodeVarintPopulateVerifierlocal(data, uint64(key))
-		v3 := r.Int63()
+		v6 := r.Int63()
 		if r.Intn(2) == 0 {
-			v3 *= -1
+			v6 *= -1
 		}
-		data = encodeVarintPopulateVerifierlocal(data, uint64(v3))
+		data = encodeVarintPopulateVerifierlocal(data, uint64(v6))
 	case 1:
 		data = encodeVarintPopulateVerifierlocal(data, uint64(key))
 		data = append(data, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -360,6 +527,34 @@ func (m *VerifierState) Size() (n int) {
 		l = m.KeyserverAuth.Size()
 		n += 1 + l + sovVerifierlocal(uint64(l))
 	}
+	l = len(m.VRFPublic)
+	if l > 0 {
+		n += 1 + l + sovVerifierlocal(uint64(l))
+	}
+	if m.VRFRotationTime != nil {
+		l = m.VRFRotationTime.Size()
+		n += 1 + l + sovVerifierlocal(uint64(l))
+	}
+	if m.VRFRotationPending {
+		n += 2
+	}
+	l = len(m.VRFRotatingTo)
+	if l > 0 {
+		n += 1 + l + sovVerifierlocal(uint64(l))
+	}
+	l = len(m.VRFRotationCursor)
+	if l > 0 {
+		n += 1 + l + sovVerifierlocal(uint64(l))
+	}
+	if m.VRFRotationNewSnapshot != 0 {
+		n += 1 + sovVerifierlocal(uint64(m.VRFRotationNewSnapshot))
+	}
+	if m.VRFRotationCheckSnapshot != 0 {
+		n += 1 + sovVerifierlocal(uint64(m.VRFRotationCheckSnapshot))
+	}
+	if m.ChangedSinceEpoch {
+		n += 2
+	}
 	return n
 }
 
@@ -386,6 +581,14 @@ func (this *VerifierState) String() string {
 		`PreviousSummaryHash:` + fmt.Sprintf("%v", this.PreviousSummaryHash) + `,`,
 		`LatestTreeSnapshot:` + fmt.Sprintf("%v", this.LatestTreeSnapshot) + `,`,
 		`KeyserverAuth:` + strings.Replace(fmt.Sprintf("%v", this.KeyserverAuth), "AuthorizationPolicy", "AuthorizationPolicy", 1) + `,`,
+		`VRFPublic:` + fmt.Sprintf("%v", this.VRFPublic) + `,`,
+		`VRFRotationTime:` + strings.Replace(fmt.Sprintf("%v", this.VRFRotationTime), "Timestamp", "Timestamp", 1) + `,`,
+		`VRFRotationPending:` + fmt.Sprintf("%v", this.VRFRotationPending) + `,`,
+		`VRFRotatingTo:` + fmt.Sprintf("%v", this.VRFRotatingTo) + `,`,
+		`VRFRotationCursor:` + fmt.Sprintf("%v", this.VRFRotationCursor) + `,`,
+		`VRFRotationNewSnapshot:` + fmt.Sprintf("%v", this.VRFRotationNewSnapshot) + `,`,
+		`VRFRotationCheckSnapshot:` + fmt.Sprintf("%v", this.VRFRotationCheckSnapshot) + `,`,
+		`ChangedSinceEpoch:` + fmt.Sprintf("%v", this.ChangedSinceEpoch) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -548,6 +751,210 @@ func (m *VerifierState) Unmarshal(data []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VRFPublic", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVerifierlocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthVerifierlocal
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VRFPublic = append(m.VRFPublic[:0], data[iNdEx:postIndex]...)
+			if m.VRFPublic == nil {
+				m.VRFPublic = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VRFRotationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVerifierlocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVerifierlocal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VRFRotationTime == nil {
+				m.VRFRotationTime = &Timestamp{}
+			}
+			if err := m.VRFRotationTime.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VRFRotationPending", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVerifierlocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.VRFRotationPending = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VRFRotatingTo", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVerifierlocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthVerifierlocal
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VRFRotatingTo = append(m.VRFRotatingTo[:0], data[iNdEx:postIndex]...)
+			if m.VRFRotatingTo == nil {
+				m.VRFRotatingTo = []byte{}
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VRFRotationCursor", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVerifierlocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthVerifierlocal
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VRFRotationCursor = append(m.VRFRotationCursor[:0], data[iNdEx:postIndex]...)
+			if m.VRFRotationCursor == nil {
+				m.VRFRotationCursor = []byte{}
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VRFRotationNewSnapshot", wireType)
+			}
+			m.VRFRotationNewSnapshot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVerifierlocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.VRFRotationNewSnapshot |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VRFRotationCheckSnapshot", wireType)
+			}
+			m.VRFRotationCheckSnapshot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVerifierlocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.VRFRotationCheckSnapshot |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangedSinceEpoch", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVerifierlocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ChangedSinceEpoch = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVerifierlocal(data[iNdEx:])
@@ -677,26 +1084,42 @@ var (
 func init() { proto1.RegisterFile("verifierlocal.proto", fileDescriptorVerifierlocal) }
 
 var fileDescriptorVerifierlocal = []byte{
-	// 332 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0xcf, 0x31, 0x4f, 0x22, 0x41,
-	0x14, 0x07, 0x70, 0xde, 0x1d, 0x5c, 0x72, 0x7b, 0x70, 0xc5, 0xa2, 0xc9, 0x86, 0xc4, 0x09, 0xb1,
-	0xa2, 0x02, 0x82, 0x8d, 0x2d, 0x26, 0x26, 0xda, 0x19, 0x30, 0xb6, 0x9b, 0x61, 0x79, 0x30, 0x13,
-	0x77, 0x77, 0x36, 0x33, 0x6f, 0x09, 0x6b, 0xe5, 0xc7, 0xf1, 0x23, 0x58, 0x5a, 0x5a, 0x52, 0x5a,
-	0xb2, 0x53, 0x59, 0x52, 0x19, 0x4b, 0xb3, 0xbb, 0x88, 0xd5, 0xcc, 0xfb, 0xff, 0xf2, 0xfe, 0x93,
-	0x71, 0xda, 0x2b, 0xd4, 0x72, 0x21, 0x51, 0x87, 0x2a, 0xe0, 0x61, 0x3f, 0xd1, 0x8a, 0x94, 0xdb,
-	0x28, 0x8f, 0xce, 0x70, 0x29, 0x49, 0xa4, 0xb3, 0x7e, 0xa0, 0xa2, 0x41, 0xc4, 0xe7, 0x92, 0x32,
-	0x3e, 0x28, 0x65, 0x96, 0x2e, 0x06, 0x4b, 0xb5, 0x54, 0xe5, 0x50, 0xde, 0xaa, 0xc5, 0x4e, 0x33,
-	0x08, 0x25, 0xc6, 0x54, 0x4d, 0xa7, 0x1f, 0xe0, 0xb4, 0xee, 0xf6, 0xf5, 0x53, 0xe2, 0x84, 0xee,
-	0x89, 0xe3, 0xc4, 0xb8, 0x26, 0x5f, 0xc6, 0x73, 0x5c, 0x7b, 0xd0, 0x85, 0x5e, 0x7d, 0xf2, 0xb7,
-	0x48, 0xae, 0x8b, 0xe0, 0xc0, 0x98, 0xa8, 0x40, 0x78, 0xbf, 0x7e, 0xf8, 0xb2, 0x08, 0xdc, 0x91,
-	0x73, 0x9c, 0x68, 0x5c, 0x49, 0x95, 0x1a, 0xdf, 0xa4, 0x51, 0xc4, 0x75, 0xe6, 0x0b, 0x6e, 0x84,
-	0xf7, 0xbb, 0x0b, 0xbd, 0xe6, 0xa4, 0xfd, 0x8d, 0xd3, 0xca, 0xae, 0xb8, 0x11, 0xee, 0xd0, 0x39,
-	0x0a, 0x39, 0xa1, 0x21, 0x9f, 0x34, 0xa2, 0x6f, 0x62, 0x9e, 0x18, 0xa1, 0xc8, 0xab, 0x97, 0xe5,
-	0x6e, 0x65, 0xb7, 0x1a, 0x71, 0xba, 0x17, 0x77, 0xec, 0xfc, 0xbf, 0xc7, 0xcc, 0xa0, 0x5e, 0xa1,
-	0xf6, 0x79, 0x4a, 0xc2, 0x6b, 0x74, 0xa1, 0xf7, 0x6f, 0xd4, 0xa9, 0x7e, 0xd5, 0x1f, 0xa7, 0x24,
-	0x94, 0x96, 0x0f, 0x9c, 0xa4, 0x8a, 0x6f, 0x54, 0x28, 0x83, 0x6c, 0xd2, 0x3a, 0x6c, 0x14, 0x7a,
-	0x71, 0xbe, 0xc9, 0x59, 0xed, 0x2d, 0x67, 0xb5, 0x6d, 0xce, 0x60, 0x97, 0x33, 0xf8, 0xcc, 0x19,
-	0x3c, 0x5a, 0x06, 0x4f, 0x96, 0xc1, 0xb3, 0x65, 0xf0, 0x62, 0x19, 0xbc, 0x5a, 0x06, 0x1b, 0xcb,
-	0x60, 0x6b, 0x19, 0xbc, 0x5b, 0x56, 0xdb, 0x59, 0x06, 0xb3, 0x3f, 0xe5, 0x1b, 0x67, 0x5f, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0x43, 0x87, 0xb1, 0xc6, 0x97, 0x01, 0x00, 0x00,
+	// 584 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x92, 0x31, 0x73, 0xd3, 0x3c,
+	0x18, 0xc7, 0xab, 0xf7, 0x6d, 0x4b, 0xa3, 0x36, 0x94, 0x28, 0x6d, 0xcf, 0x04, 0x90, 0x73, 0x4c,
+	0x19, 0xb8, 0xb4, 0x57, 0x16, 0x18, 0x1b, 0xae, 0xbd, 0xb2, 0x70, 0x45, 0x29, 0x59, 0x18, 0x7c,
+	0x8a, 0xa3, 0xd8, 0xba, 0xc6, 0x96, 0x4f, 0x96, 0xd3, 0x86, 0x89, 0x6f, 0x03, 0x1f, 0x81, 0x91,
+	0x91, 0xb1, 0x23, 0x93, 0xaf, 0xd1, 0xc4, 0xd8, 0x91, 0x91, 0x93, 0xec, 0x26, 0xce, 0x64, 0xe9,
+	0xff, 0xd3, 0xf3, 0xd3, 0x23, 0x4b, 0xb0, 0x39, 0x65, 0x92, 0x8f, 0x39, 0x93, 0x13, 0xe1, 0xd3,
+	0x49, 0x37, 0x91, 0x42, 0x09, 0xb4, 0x61, 0x3f, 0xad, 0xa3, 0x80, 0xab, 0x30, 0x1b, 0x76, 0x7d,
+	0x11, 0x1d, 0x46, 0x74, 0xc4, 0xd5, 0x8c, 0x1e, 0x5a, 0x32, 0xcc, 0xc6, 0x87, 0x81, 0x08, 0x84,
+	0x9d, 0xd8, 0x51, 0x51, 0xd8, 0xda, 0xf1, 0x27, 0x9c, 0xc5, 0xaa, 0x98, 0xbd, 0xfc, 0xb6, 0x09,
+	0xeb, 0x83, 0x52, 0xdf, 0x57, 0x54, 0x31, 0xf4, 0x02, 0xc2, 0x98, 0xdd, 0x28, 0x8f, 0xc7, 0x23,
+	0x76, 0xe3, 0x80, 0x36, 0xe8, 0xac, 0x93, 0x9a, 0x49, 0xde, 0x9b, 0x60, 0x81, 0x59, 0x22, 0xfc,
+	0xd0, 0xf9, 0x6f, 0x89, 0x4f, 0x4d, 0x80, 0x8e, 0xe1, 0x7e, 0x22, 0xd9, 0x94, 0x8b, 0x2c, 0xf5,
+	0xd2, 0x2c, 0x8a, 0xa8, 0x9c, 0x79, 0x21, 0x4d, 0x43, 0xe7, 0xff, 0x36, 0xe8, 0xec, 0x90, 0xe6,
+	0x03, 0xec, 0x17, 0xec, 0x9c, 0xa6, 0x21, 0x3a, 0x82, 0x7b, 0x13, 0xaa, 0x58, 0xaa, 0x3c, 0x25,
+	0x19, 0xf3, 0xd2, 0x98, 0x26, 0x69, 0x28, 0x94, 0xb3, 0x6e, 0xe5, 0xa8, 0x60, 0x97, 0x92, 0xb1,
+	0x7e, 0x49, 0xd0, 0x09, 0x7c, 0x7c, 0xc5, 0x66, 0x29, 0x93, 0x53, 0x26, 0x3d, 0x9a, 0xa9, 0xd0,
+	0xd9, 0x68, 0x83, 0xce, 0xf6, 0x71, 0xab, 0x38, 0x55, 0xf7, 0x24, 0x53, 0xa1, 0x90, 0xfc, 0x0b,
+	0x55, 0x5c, 0xc4, 0x17, 0x62, 0xc2, 0xfd, 0x19, 0xa9, 0x2f, 0x2a, 0x0c, 0x45, 0xaf, 0x20, 0x9c,
+	0xca, 0xb1, 0x97, 0x64, 0xc3, 0x09, 0xf7, 0x9d, 0x4d, 0xd3, 0x5d, 0xaf, 0xae, 0x73, 0xb7, 0x36,
+	0x20, 0x67, 0x17, 0x36, 0x24, 0xb5, 0xa9, 0x1c, 0x17, 0x43, 0xf4, 0x11, 0x36, 0xcc, 0x6a, 0x29,
+	0x94, 0x55, 0x7a, 0x8a, 0x47, 0xcc, 0x79, 0x64, 0xf7, 0x7c, 0x52, 0xee, 0x79, 0xc9, 0x23, 0x96,
+	0x2a, 0x1a, 0x25, 0xbd, 0xa6, 0xce, 0xdd, 0xdd, 0x01, 0x39, 0x23, 0xe5, 0x6a, 0x43, 0xc8, 0xee,
+	0x54, 0x8e, 0xab, 0x01, 0x3a, 0x87, 0x7b, 0x2b, 0xca, 0x84, 0xc5, 0x23, 0x1e, 0x07, 0xce, 0x56,
+	0x1b, 0x74, 0xb6, 0x7a, 0x07, 0x3a, 0x77, 0x51, 0xc5, 0x71, 0x51, 0x50, 0x82, 0x2a, 0x9a, 0x32,
+	0x43, 0x6f, 0xe1, 0xee, 0xd2, 0x14, 0x07, 0x9e, 0x12, 0x4e, 0xcd, 0x9e, 0xa7, 0xa1, 0x73, 0xb7,
+	0xbe, 0x90, 0xc4, 0xc1, 0xa5, 0x20, 0xf5, 0x45, 0xbd, 0x99, 0xa2, 0x53, 0xd8, 0x5c, 0x69, 0xc2,
+	0xcf, 0x64, 0x2a, 0xa4, 0x03, 0x6d, 0xf9, 0xbe, 0xce, 0xdd, 0x46, 0xa5, 0x87, 0x77, 0x16, 0x92,
+	0x46, 0xa5, 0x85, 0x22, 0x42, 0x9f, 0xe0, 0xd3, 0x15, 0x4d, 0xcc, 0xae, 0x97, 0xd7, 0xb8, 0x6d,
+	0xae, 0xb1, 0xd7, 0xd2, 0xb9, 0x7b, 0x50, 0x91, 0x7d, 0x60, 0xd7, 0x0f, 0xd7, 0x49, 0x0e, 0x2a,
+	0xc6, 0x4a, 0x8e, 0x3e, 0xc3, 0x67, 0xab, 0xdd, 0x85, 0xcc, 0xbf, 0x5a, 0x8a, 0x77, 0xac, 0xf8,
+	0xb9, 0xce, 0x5d, 0xa7, 0xda, 0xa5, 0x59, 0xb4, 0x50, 0x3b, 0xd5, 0x66, 0xab, 0x04, 0x75, 0x61,
+	0xd3, 0x0f, 0x69, 0x1c, 0xb0, 0x91, 0x97, 0xf2, 0xd8, 0x67, 0xe5, 0x8b, 0xae, 0x9b, 0xdf, 0x4f,
+	0x1a, 0x25, 0xea, 0x1b, 0x62, 0x5f, 0x76, 0xef, 0xcd, 0xed, 0x1c, 0xaf, 0xfd, 0x9e, 0xe3, 0xb5,
+	0xbb, 0x39, 0x06, 0xf7, 0x73, 0x0c, 0xfe, 0xce, 0x31, 0xf8, 0xaa, 0x31, 0xf8, 0xae, 0x31, 0xf8,
+	0xa1, 0x31, 0xf8, 0xa9, 0x31, 0xf8, 0xa5, 0x31, 0xb8, 0xd5, 0x18, 0xdc, 0x69, 0x0c, 0xfe, 0x68,
+	0xbc, 0x76, 0xaf, 0x31, 0x18, 0x6e, 0xda, 0x07, 0xf2, 0xfa, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff,
+	0xed, 0xee, 0x72, 0xfc, 0xc8, 0x03, 0x00, 0x00,
 }
@@ -0,0 +1,58 @@
+// Copyright 2014-2015 The Dename Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy of
+// the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations under
+// the License.
+
+package coname
+
+import (
+	"fmt"
+
+	"github.com/yahoo/coname/proto"
+)
+
+// QuorumForTrustedVerifiers returns a quorum requirement that is met by the
+// ratifications of any threshold of the verifiers whose keys are in trusted.
+// verifiers is the list returned by the ListVerifiers RPC of the keyserver;
+// an error is returned if fewer than threshold of the trusted verifiers are
+// listed as having ratified an epoch, as lookups with the requirement would
+// fail. The list is not authenticated: it is only used to detect a trust
+// configuration the keyserver cannot satisfy, and the returned requirement
+// does not depend on it.
+// trusted, verifiers : &const // none of the inputs are modified
+func QuorumForTrustedVerifiers(trusted []*proto.PublicKey, threshold uint32, verifiers []*proto.VerifierInfo) (*proto.QuorumExpr, error) {
+	if threshold == 0 || int(threshold) > len(trusted) {
+		return nil, fmt.Errorf("QuorumForTrustedVerifiers: threshold %d out of range for %d trusted verifiers", threshold, len(trusted))
+	}
+	listed := make(map[uint64]*proto.VerifierInfo, len(verifiers))
+	for _, v := range verifiers {
+		listed[v.ID] = v
+	}
+	ret := &proto.QuorumExpr{Threshold: threshold, Candidates: make([]uint64, 0, len(trusted))}
+	have := make(map[uint64]struct{})
+	for _, pk := range trusted {
+		id := proto.KeyID(pk)
+		ret.Candidates = append(ret.Candidates, id)
+		v, ok := listed[id]
+		if !ok || v.LatestRatifiedEpoch == 0 {
+			continue
+		}
+		if v.PublicKey != nil && !v.PublicKey.Equal(pk) {
+			return nil, fmt.Errorf("QuorumForTrustedVerifiers: the keyserver lists a different key for verifier %x", id)
+		}
+		have[id] = struct{}{}
+	}
+	if !CheckQuorum(ret, have) {
+		return nil, fmt.Errorf("QuorumForTrustedVerifiers: only %d of the trusted verifiers have ratified an epoch, need %d", len(have), threshold)
+	}
+	return ret, nil
+}
@@ -0,0 +1,78 @@
+// Copyright 2014-2015 The Dename Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy of
+// the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations under
+// the License.
+
+// Package ratelimit implements token buckets keyed by strings, such as
+// client IP addresses or user IDs.
+package ratelimit
+
+import (
+	"sync"
+	"time"
+
+	"github.com/andres-erbsen/clock"
+)
+
+// Limiter keeps a token bucket for each key. A bucket holds up to burst
+// tokens and gains one token per interval; each allowed request takes one.
+// Full buckets are forgotten, so the memory use is bounded by the number of
+// keys seen within the time it takes to refill a bucket.
+type Limiter struct {
+	interval time.Duration
+	burst    uint64
+	clk      clock.Clock
+
+	mu sync.Mutex
+	// buckets maps a key to the time at which its bucket will be full again
+	buckets   map[string]time.Time
+	lastSweep time.Time
+}
+
+// New returns a Limiter that allows burst requests per key at once and one
+// more every interval.
+func New(interval time.Duration, burst uint64, clk clock.Clock) *Limiter {
+	return &Limiter{
+		interval:  interval,
+		burst:     burst,
+		clk:       clk,
+		buckets:   make(map[string]time.Time),
+		lastSweep: clk.Now(),
+	}
+}
+
+// Allow takes a token from the bucket of key and returns whether there was
+// one.
+func (l *Limiter) Allow(key string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := l.clk.Now()
+	capacity := time.Duration(l.burst) * l.interval
+	if now.Sub(l.lastSweep) > capacity {
+		for k, full := range l.buckets {
+			if !full.After(now) {
+				delete(l.buckets, k)
+			}
+		}
+		l.lastSweep = now
+	}
+	full, ok := l.buckets[key]
+	if !ok || full.Before(now) {
+		full = now
+	}
+	// the bucket is empty when it will take capacity to be full
+	if full.Add(l.interval).Sub(now) > capacity {
+		return false
+	}
+	l.buckets[key] = full.Add(l.interval)
+	return true
+}
@@ -0,0 +1,75 @@
+// Copyright 2014-2015 The Dename Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy of
+// the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations under
+// the License.
+
+package ratelimit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/andres-erbsen/clock"
+)
+
+func TestLimiterBurst(t *testing.T) {
+	clk := clock.NewMock()
+	l := New(time.Second, 3, clk)
+	for i := 0; i < 3; i++ {
+		if !l.Allow("a") {
+			t.Fatalf("request %d of the burst was not allowed", i)
+		}
+	}
+	if l.Allow("a") {
+		t.Fatal("request over the burst was allowed")
+	}
+	if !l.Allow("b") {
+		t.Fatal("request with another key was not allowed")
+	}
+}
+
+func TestLimiterRefill(t *testing.T) {
+	clk := clock.NewMock()
+	l := New(time.Second, 2, clk)
+	l.Allow("a")
+	l.Allow("a")
+	clk.Add(time.Second)
+	if !l.Allow("a") {
+		t.Fatal("request was not allowed after a token was added")
+	}
+	if l.Allow("a") {
+		t.Fatal("request was allowed although only one token was added")
+	}
+	// the bucket does not grow beyond the burst
+	clk.Add(time.Hour)
+	for i := 0; i < 2; i++ {
+		if !l.Allow("a") {
+			t.Fatalf("request %d was not allowed after the bucket was refilled", i)
+		}
+	}
+	if l.Allow("a") {
+		t.Fatal("request over the burst was allowed after the bucket was refilled")
+	}
+}
+
+func TestLimiterForgetsFullBuckets(t *testing.T) {
+	clk := clock.NewMock()
+	l := New(time.Second, 2, clk)
+	l.Allow("a")
+	l.Allow("b")
+	l.Allow("b")
+	clk.Add(3 * time.Second)
+	l.Allow("c")
+	if len(l.buckets) != 1 {
+		t.Fatalf("%d buckets kept, expected only the one that is not full", len(l.buckets))
+	}
+}
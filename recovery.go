@@ -0,0 +1,126 @@
+// Copyright 2014-2015 The Dename Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy of
+// the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations under
+// the License.
+
+package coname
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/yahoo/coname/proto"
+	"golang.org/x/crypto/sha3"
+)
+
+// RecoveryNotBefore returns the earliest epoch head issue time at which a
+// recovery of current started when the last epoch head was issued at
+// lastEpochTime can be completed. Globally deterministic.
+// current : &const
+func RecoveryNotBefore(current *proto.Entry, lastEpochTime time.Time) (time.Time, error) {
+	if current == nil {
+		return time.Time{}, fmt.Errorf("RecoveryNotBefore: there is no entry to recover")
+	}
+	if current.RecoveryPolicy == nil {
+		return time.Time{}, fmt.Errorf("RecoveryNotBefore: the entry does not allow recovery")
+	}
+	if lastEpochTime.IsZero() {
+		return time.Time{}, fmt.Errorf("RecoveryNotBefore: no epoch has been issued yet")
+	}
+	if current.RecoveryPolicy.DelaySeconds > math.MaxInt64/uint64(time.Second) {
+		return time.Time{}, fmt.Errorf("RecoveryNotBefore: recovery delay of %d seconds is too long", current.RecoveryPolicy.DelaySeconds)
+	}
+	return lastEpochTime.Add(time.Duration(current.RecoveryPolicy.DelaySeconds) * time.Second), nil
+}
+
+// VerifyRecoveryStart returns nil iff recovery may be started to replace the
+// entry current, the last epoch head having been issued at lastEpochTime.
+// Unlike VerifyUpdate, this does not require signatures by the update policy
+// of current. Globally deterministic.
+// current, recovery : &const // none of the inputs are modified
+func VerifyRecoveryStart(current *proto.Entry, recovery *proto.PendingRecovery, lastEpochTime time.Time) error {
+	notBefore, err := RecoveryNotBefore(current, lastEpochTime)
+	if err != nil {
+		return err
+	}
+	if recovery.Update == nil {
+		return fmt.Errorf("VerifyRecoveryStart: recovery.Update is nil")
+	}
+	next := &recovery.Update.NewEntry
+	if !bytes.Equal(next.Index, current.Index) {
+		return fmt.Errorf("VerifyRecoveryStart: index %x of the new entry does not match %x", next.Index, current.Index)
+	}
+	if next.Version <= current.Version {
+		return fmt.Errorf("VerifyRecoveryStart: entry version must increase (got %d <= %d)", next.Version, current.Version)
+	}
+	if next.UpdatePolicy == nil {
+		return fmt.Errorf("VerifyRecoveryStart: next.UpdatePolicy is nil")
+	}
+	if !VerifyPolicy(next.UpdatePolicy, next.Encoding, recovery.Update.Signatures) {
+		return fmt.Errorf("VerifyRecoveryStart: recovery needs to be accepted by the new key, but signature verification failed")
+	}
+	if got := recovery.NotBefore.Time(); !got.Equal(notBefore) {
+		return fmt.Errorf("VerifyRecoveryStart: recovery not before %s, expected %s", got, notBefore)
+	}
+	return nil
+}
+
+// VerifyRecoveryVeto returns nil iff veto cancels recovery, the pending
+// recovery of the entry current. Globally deterministic.
+// current, recovery, veto : &const // none of the inputs are modified
+func VerifyRecoveryVeto(current *proto.Entry, recovery *proto.PendingRecovery, veto *proto.RecoveryVeto) error {
+	if current == nil || recovery == nil {
+		return fmt.Errorf("VerifyRecoveryVeto: there is no recovery pending")
+	}
+	if current.UpdatePolicy == nil {
+		return fmt.Errorf("VerifyRecoveryVeto: current.UpdatePolicy is nil")
+	}
+	if !bytes.Equal(veto.Index, current.Index) {
+		return fmt.Errorf("VerifyRecoveryVeto: veto for index %x, expected %x", veto.Index, current.Index)
+	}
+	entryHash := make([]byte, 32)
+	sha3.ShakeSum256(entryHash, recovery.Update.NewEntry.Encoding)
+	if !bytes.Equal(veto.EntryHash, entryHash) {
+		return fmt.Errorf("VerifyRecoveryVeto: veto for entry %x, but the pending recovery is to %x", veto.EntryHash, entryHash)
+	}
+	if !VerifyPolicy(current.UpdatePolicy, proto.RecoveryVetoMessage(veto.EntryHash), veto.Signatures) {
+		return fmt.Errorf("VerifyRecoveryVeto: vetoing a recovery requires authorization from the old key, but signature verification failed")
+	}
+	return nil
+}
+
+// VerifyUpdateOrRecovery is like VerifyUpdate, but also accepts update if it
+// completes recovery (nil if none is pending) of current, the last epoch head
+// having been issued at lastEpochTime. Globally deterministic.
+// current, recovery, update : &const // none of the inputs are modified
+func VerifyUpdateOrRecovery(current *proto.Entry, recovery *proto.PendingRecovery, update *proto.SignedEntryUpdate, lastEpochTime time.Time) error {
+	err := VerifyUpdate(current, update)
+	if err == nil || current == nil || recovery == nil || !bytes.Equal(update.NewEntry.Encoding, recovery.Update.NewEntry.Encoding) {
+		return err
+	}
+	if notBefore := recovery.NotBefore.Time(); lastEpochTime.Before(notBefore) {
+		return fmt.Errorf("VerifyUpdateOrRecovery: the entry is being recovered, but not before %s", notBefore)
+	}
+	next := &update.NewEntry
+	if next.Version <= current.Version {
+		return fmt.Errorf("VerifyUpdateOrRecovery: entry version must increase (got %d <= %d)", next.Version, current.Version)
+	}
+	if next.UpdatePolicy == nil {
+		return fmt.Errorf("VerifyUpdateOrRecovery: next.UpdatePolicy is nil")
+	}
+	if !VerifyPolicy(next.UpdatePolicy, update.NewEntry.Encoding, update.Signatures) {
+		return fmt.Errorf("VerifyUpdateOrRecovery: update needs to be accepted by the new key, but signature verification failed")
+	}
+	return nil
+}
@@ -0,0 +1,261 @@
+// Copyright 2014-2015 The Dename Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy of
+// the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations under
+// the License.
+
+package signer
+
+import (
+	"bytes"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/asn1"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/miekg/pkcs11"
+	"github.com/yahoo/coname/proto"
+)
+
+// oidP256 is the DER encoding of the named curve P-256 as found in
+// CKA_EC_PARAMS.
+var oidP256 = []byte{0x06, 0x08, 0x2a, 0x86, 0x48, 0xce, 0x3d, 0x03, 0x01, 0x07}
+
+// pkcs11Ctx is the part of *pkcs11.Ctx that a pkcs11Signer uses once the
+// session is open. It is an interface so that tests can supply a fake token.
+type pkcs11Ctx interface {
+	FindObjectsInit(sh pkcs11.SessionHandle, temp []*pkcs11.Attribute) error
+	FindObjects(sh pkcs11.SessionHandle, max int) ([]pkcs11.ObjectHandle, bool, error)
+	FindObjectsFinal(sh pkcs11.SessionHandle) error
+	GetAttributeValue(sh pkcs11.SessionHandle, o pkcs11.ObjectHandle, a []*pkcs11.Attribute) ([]*pkcs11.Attribute, error)
+	SignInit(sh pkcs11.SessionHandle, m []*pkcs11.Mechanism, o pkcs11.ObjectHandle) error
+	Sign(sh pkcs11.SessionHandle, message []byte) ([]byte, error)
+}
+
+type pkcs11Signer struct {
+	// mu serializes operations on the session, PKCS#11 sessions can only do
+	// one thing at a time
+	mu      sync.Mutex
+	ctx     pkcs11Ctx
+	session pkcs11.SessionHandle
+	key     pkcs11.ObjectHandle
+	keyType uint // pkcs11.CKK_EC or pkcs11.CKK_RSA
+	pk      *proto.PublicKey
+}
+
+// OpenPKCS11 returns a Signer for a key pair in a PKCS#11 token. attrs is a
+// ';'-separated list of name=value pairs named after RFC 7512:
+//
+//	module-path  the PKCS#11 library to load (required)
+//	token        the label of the token (required)
+//	object       the label of the key pair (required)
+//	pin-source   a file containing the user PIN, or
+//	pin-value    the user PIN
+//
+// For example "module-path=/usr/lib/softhsm/libsofthsm2.so;token=coname;
+// object=replica1;pin-source=/etc/coname/pin". ECDSA P-256 and RSA keys are
+// supported, see coname.Sign. The library stays loaded and the session stays
+// open for the lifetime of the process.
+func OpenPKCS11(attrs string) (Signer, error) {
+	a := make(map[string]string)
+	for _, kv := range strings.Split(attrs, ";") {
+		if kv = strings.TrimSpace(kv); kv == "" {
+			continue
+		}
+		i := strings.IndexByte(kv, '=')
+		if i < 0 {
+			return nil, fmt.Errorf("pkcs11: attribute %q has no value", kv)
+		}
+		a[kv[:i]] = kv[i+1:]
+	}
+	for _, name := range []string{"module-path", "token", "object"} {
+		if a[name] == "" {
+			return nil, fmt.Errorf("pkcs11: missing attribute %s", name)
+		}
+	}
+	pin := a["pin-value"]
+	if a["pin-source"] != "" {
+		pinBytes, err := ioutil.ReadFile(a["pin-source"])
+		if err != nil {
+			return nil, fmt.Errorf("pkcs11: reading PIN: %s", err)
+		}
+		pin = strings.TrimSpace(string(pinBytes))
+	}
+
+	ctx := pkcs11.New(a["module-path"])
+	if ctx == nil {
+		return nil, fmt.Errorf("pkcs11: failed to load module %s", a["module-path"])
+	}
+	if err := ctx.Initialize(); err != nil {
+		return nil, fmt.Errorf("pkcs11: initialize %s: %s", a["module-path"], err)
+	}
+	slots, err := ctx.GetSlotList(true)
+	if err != nil {
+		return nil, fmt.Errorf("pkcs11: list slots: %s", err)
+	}
+	for _, slot := range slots {
+		ti, err := ctx.GetTokenInfo(slot)
+		if err != nil {
+			return nil, fmt.Errorf("pkcs11: token info of slot %d: %s", slot, err)
+		}
+		if ti.Label != a["token"] {
+			continue
+		}
+		session, err := ctx.OpenSession(slot, pkcs11.CKF_SERIAL_SESSION)
+		if err != nil {
+			return nil, fmt.Errorf("pkcs11: open session with token %s: %s", ti.Label, err)
+		}
+		if err := ctx.Login(session, pkcs11.CKU_USER, pin); err != nil && err != pkcs11.Error(pkcs11.CKR_USER_ALREADY_LOGGED_IN) {
+			ctx.CloseSession(session)
+			return nil, fmt.Errorf("pkcs11: login to token %s: %s", ti.Label, err)
+		}
+		s, err := newPKCS11Signer(ctx, session, a["object"])
+		if err != nil {
+			ctx.CloseSession(session)
+			return nil, err
+		}
+		return s, nil
+	}
+	return nil, fmt.Errorf("pkcs11: no token labeled %s", a["token"])
+}
+
+func newPKCS11Signer(ctx pkcs11Ctx, session pkcs11.SessionHandle, label string) (*pkcs11Signer, error) {
+	s := &pkcs11Signer{ctx: ctx, session: session}
+	var err error
+	if s.key, err = s.findObject(pkcs11.CKO_PRIVATE_KEY, label); err != nil {
+		return nil, err
+	}
+	pub, err := s.findObject(pkcs11.CKO_PUBLIC_KEY, label)
+	if err != nil {
+		return nil, err
+	}
+	attrs, err := ctx.GetAttributeValue(session, pub, []*pkcs11.Attribute{pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, nil)})
+	if err != nil {
+		return nil, fmt.Errorf("pkcs11: key type of %s: %s", label, err)
+	}
+	// CK_ULONG values are in native byte order, so compare encodings. Note
+	// that CKK_RSA is 0, the zero value of keyType.
+	var known bool
+	for _, kt := range []uint{pkcs11.CKK_EC, pkcs11.CKK_RSA} {
+		if bytes.Equal(attrs[0].Value, pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, kt).Value) {
+			s.keyType, known = kt, true
+		}
+	}
+	if !known {
+		return nil, fmt.Errorf("pkcs11: unsupported key type %x of %s", attrs[0].Value, label)
+	}
+	switch s.keyType {
+	case pkcs11.CKK_EC:
+		attrs, err := ctx.GetAttributeValue(session, pub, []*pkcs11.Attribute{
+			pkcs11.NewAttribute(pkcs11.CKA_EC_PARAMS, nil),
+			pkcs11.NewAttribute(pkcs11.CKA_EC_POINT, nil),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("pkcs11: public key of %s: %s", label, err)
+		}
+		if !bytes.Equal(attrs[0].Value, oidP256) {
+			return nil, fmt.Errorf("pkcs11: key %s is not on P-256", label)
+		}
+		// CKA_EC_POINT is a DER-encoded OCTET STRING
+		var point []byte
+		if rest, err := asn1.Unmarshal(attrs[1].Value, &point); err != nil || len(rest) != 0 {
+			return nil, fmt.Errorf("pkcs11: bad EC point of %s", label)
+		}
+		if x, _ := elliptic.Unmarshal(elliptic.P256(), point); x == nil {
+			return nil, fmt.Errorf("pkcs11: bad EC point of %s", label)
+		}
+		s.pk = &proto.PublicKey{PubkeyType: &proto.PublicKey_ECDSAP256SHA256{ECDSAP256SHA256: point}}
+	case pkcs11.CKK_RSA:
+		attrs, err := ctx.GetAttributeValue(session, pub, []*pkcs11.Attribute{
+			pkcs11.NewAttribute(pkcs11.CKA_MODULUS, nil),
+			pkcs11.NewAttribute(pkcs11.CKA_PUBLIC_EXPONENT, nil),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("pkcs11: public key of %s: %s", label, err)
+		}
+		e := new(big.Int).SetBytes(attrs[1].Value)
+		if !e.IsInt64() || e.Int64() < 3 || e.Int64() > 1<<31-1 {
+			return nil, fmt.Errorf("pkcs11: bad public exponent of %s", label)
+		}
+		rsapk := &rsa.PublicKey{N: new(big.Int).SetBytes(attrs[0].Value), E: int(e.Int64())}
+		if rsapk.N.BitLen() < minRSABits {
+			return nil, fmt.Errorf("pkcs11: RSA key %s of %d bits is too short", label, rsapk.N.BitLen())
+		}
+		der, err := x509.MarshalPKIXPublicKey(rsapk)
+		if err != nil {
+			return nil, err
+		}
+		s.pk = &proto.PublicKey{PubkeyType: &proto.PublicKey_RSAPSSSHA256{RSAPSSSHA256: der}}
+	}
+	return s, nil
+}
+
+// minRSABits matches the minimum that coname.VerifySignature accepts.
+const minRSABits = 2048
+
+// findObject returns the only object of class with label.
+func (s *pkcs11Signer) findObject(class uint, label string) (pkcs11.ObjectHandle, error) {
+	if err := s.ctx.FindObjectsInit(s.session, []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, class),
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, label),
+	}); err != nil {
+		return 0, fmt.Errorf("pkcs11: find %s: %s", label, err)
+	}
+	objs, _, err := s.ctx.FindObjects(s.session, 2)
+	if err := s.ctx.FindObjectsFinal(s.session); err != nil {
+		return 0, fmt.Errorf("pkcs11: find %s: %s", label, err)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("pkcs11: find %s: %s", label, err)
+	}
+	if len(objs) != 1 {
+		return 0, fmt.Errorf("pkcs11: found %d objects of class %d labeled %s (want 1)", len(objs), class, label)
+	}
+	return objs[0], nil
+}
+
+func (s *pkcs11Signer) PublicKey() *proto.PublicKey { return s.pk }
+
+func (s *pkcs11Signer) Sign(message []byte) ([]byte, error) {
+	var mechanism *pkcs11.Mechanism
+	switch s.keyType {
+	case pkcs11.CKK_EC:
+		// CKM_ECDSA signs a hash and returns r||s, coname uses DER
+		h := sha256.Sum256(message)
+		message = h[:]
+		mechanism = pkcs11.NewMechanism(pkcs11.CKM_ECDSA, nil)
+	default: // pkcs11.CKK_RSA
+		mechanism = pkcs11.NewMechanism(pkcs11.CKM_SHA256_RSA_PKCS_PSS,
+			pkcs11.NewPSSParams(pkcs11.CKM_SHA256, pkcs11.CKG_MGF1_SHA256, sha256.Size))
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.ctx.SignInit(s.session, []*pkcs11.Mechanism{mechanism}, s.key); err != nil {
+		return nil, fmt.Errorf("pkcs11: sign: %s", err)
+	}
+	sig, err := s.ctx.Sign(s.session, message)
+	if err != nil {
+		return nil, fmt.Errorf("pkcs11: sign: %s", err)
+	}
+	if s.keyType == pkcs11.CKK_EC {
+		if len(sig) != 64 {
+			return nil, fmt.Errorf("pkcs11: ECDSA signature of %d bytes", len(sig))
+		}
+		return asn1.Marshal(struct{ R, S *big.Int }{new(big.Int).SetBytes(sig[:32]), new(big.Int).SetBytes(sig[32:])})
+	}
+	return sig, nil
+}
@@ -0,0 +1,189 @@
+// Copyright 2014-2015 The Dename Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy of
+// the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations under
+// the License.
+
+package signer
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"time"
+
+	"github.com/yahoo/coname"
+	"github.com/yahoo/coname/proto"
+)
+
+// The remote signer protocol runs over a stream connection, one request and
+// one response at a time. A request is an op byte followed by a payload, a
+// response is a status byte followed by a payload, and each payload is a
+// uvarint length followed by that many bytes.
+//
+//	opPublicKey, empty       -> statusOK, marshaled proto.PublicKey
+//	opSign,      message     -> statusOK, signature
+//	any,         any         -> statusError, error message
+const (
+	opPublicKey = 1
+	opSign      = 2
+
+	statusOK    = 0
+	statusError = 1
+
+	// maxPayloadSize bounds the memory a peer can make us allocate. Epoch
+	// heads are far smaller.
+	maxPayloadSize = 1 << 20
+
+	remoteTimeout = 10 * time.Second
+)
+
+// remoteSigner dials a new connection for each request, so that the signing
+// daemon can be restarted without restarting the replica or verifier.
+type remoteSigner struct {
+	path string
+	pk   *proto.PublicKey
+}
+
+// DialUnix returns a Signer that forwards signing requests to the signing
+// daemon listening on the Unix socket at path, for example one run by Serve.
+// The public key is fetched once, here.
+func DialUnix(path string) (Signer, error) {
+	s := &remoteSigner{path: path}
+	pkBytes, err := s.do(opPublicKey, nil)
+	if err != nil {
+		return nil, err
+	}
+	pk := new(proto.PublicKey)
+	if err := pk.Unmarshal(pkBytes); err != nil {
+		return nil, fmt.Errorf("remote signer %s: bad public key: %s", path, err)
+	}
+	s.pk = pk
+	return s, nil
+}
+
+func (s *remoteSigner) PublicKey() *proto.PublicKey { return s.pk }
+
+func (s *remoteSigner) Sign(message []byte) ([]byte, error) {
+	sig, err := s.do(opSign, message)
+	if err != nil {
+		return nil, err
+	}
+	// a signature under some other key would only be noticed by whoever
+	// verifies the epoch head, so catch it here
+	if !coname.VerifySignature(s.pk, message, sig) {
+		return nil, fmt.Errorf("remote signer %s returned an invalid signature", s.path)
+	}
+	return sig, nil
+}
+
+func (s *remoteSigner) do(op byte, payload []byte) ([]byte, error) {
+	conn, err := net.DialTimeout("unix", s.path, remoteTimeout)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(remoteTimeout))
+	if err := writeMessage(conn, op, payload); err != nil {
+		return nil, err
+	}
+	status, ret, err := readMessage(bufio.NewReader(conn))
+	if err != nil {
+		return nil, err
+	}
+	switch status {
+	case statusOK:
+		return ret, nil
+	case statusError:
+		return nil, fmt.Errorf("remote signer %s: %s", s.path, ret)
+	default:
+		return nil, fmt.Errorf("remote signer %s: unknown status %d", s.path, status)
+	}
+}
+
+func writeMessage(w io.Writer, kind byte, payload []byte) error {
+	buf := make([]byte, 1+binary.MaxVarintLen64, 1+binary.MaxVarintLen64+len(payload))
+	buf[0] = kind
+	n := binary.PutUvarint(buf[1:], uint64(len(payload)))
+	_, err := w.Write(append(buf[:1+n], payload...))
+	return err
+}
+
+func readMessage(r *bufio.Reader) (kind byte, payload []byte, err error) {
+	if kind, err = r.ReadByte(); err != nil {
+		return 0, nil, err
+	}
+	l, err := binary.ReadUvarint(r)
+	if err != nil {
+		return 0, nil, err
+	}
+	if l > maxPayloadSize {
+		return 0, nil, fmt.Errorf("payload of %d bytes is too large", l)
+	}
+	payload = make([]byte, l)
+	if _, err := io.ReadFull(r, payload); err != nil {
+		return 0, nil, err
+	}
+	return kind, payload, nil
+}
+
+// Serve answers remote signer requests on ln using s until ln is closed. It
+// can be used to implement a signing daemon that holds the key of a replica or
+// verifier in a separate process, or to test against one.
+func Serve(ln net.Listener, s Signer) error {
+	for {
+		conn, err := ln.Accept()
+		if err != nil {
+			if ne, ok := err.(net.Error); ok && ne.Temporary() {
+				continue
+			}
+			return err
+		}
+		go serveConn(conn, s)
+	}
+}
+
+func serveConn(conn net.Conn, s Signer) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	for {
+		conn.SetDeadline(time.Now().Add(remoteTimeout))
+		op, payload, err := readMessage(r)
+		if err != nil {
+			if err != io.EOF {
+				log.Printf("remote signer: reading request: %s", err)
+			}
+			return
+		}
+		var ret []byte
+		switch op {
+		case opPublicKey:
+			ret, err = s.PublicKey().Marshal()
+		case opSign:
+			ret, err = s.Sign(payload)
+		default:
+			err = errors.New("unknown op")
+		}
+		if err != nil {
+			err = writeMessage(conn, statusError, []byte(err.Error()))
+		} else {
+			err = writeMessage(conn, statusOK, ret)
+		}
+		if err != nil {
+			log.Printf("remote signer: writing response: %s", err)
+			return
+		}
+	}
+}
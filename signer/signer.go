@@ -0,0 +1,82 @@
+// Copyright 2014-2015 The Dename Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy of
+// the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations under
+// the License.
+
+// Package signer abstracts the keys that keyserver replicas and verifiers sign
+// epoch heads with, so that they can be kept out of the process: in a separate
+// signing daemon reached over a Unix socket, or in a PKCS#11 token.
+//
+// Key IDs of the form "unix:<path>" and "pkcs11:<attributes>" name external
+// signers; see DialUnix and OpenPKCS11 for their formats.
+package signer
+
+import (
+	"crypto"
+	"fmt"
+	"strings"
+
+	"github.com/yahoo/coname"
+	"github.com/yahoo/coname/proto"
+)
+
+// Signer signs messages with a key that coname.VerifySignature supports.
+// Implementations must be safe for concurrent use.
+type Signer interface {
+	// PublicKey returns the key under which the signatures verify.
+	PublicKey() *proto.PublicKey
+	// Sign returns a signature of message that coname.VerifySignature
+	// accepts under PublicKey().
+	Sign(message []byte) ([]byte, error)
+}
+
+// New returns sk if it already is a Signer. Otherwise sk must be a private key
+// supported by coname.Sign, and New returns a Signer that signs with it.
+func New(sk crypto.PrivateKey) (Signer, error) {
+	if s, ok := sk.(Signer); ok {
+		return s, nil
+	}
+	pk, err := coname.PublicKeyFor(sk)
+	if err != nil {
+		return nil, err
+	}
+	return &localSigner{sk: sk, pk: pk}, nil
+}
+
+type localSigner struct {
+	sk crypto.PrivateKey
+	pk *proto.PublicKey
+}
+
+func (s *localSigner) PublicKey() *proto.PublicKey { return s.pk }
+
+func (s *localSigner) Sign(message []byte) ([]byte, error) {
+	return coname.Sign(s.sk, message)
+}
+
+// IsExternal returns whether keyid names a signer that Open connects to
+// rather than a key file.
+func IsExternal(keyid string) bool {
+	return strings.HasPrefix(keyid, "unix:") || strings.HasPrefix(keyid, "pkcs11:")
+}
+
+// Open connects to the external signer named by keyid.
+func Open(keyid string) (Signer, error) {
+	switch {
+	case strings.HasPrefix(keyid, "unix:"):
+		return DialUnix(strings.TrimPrefix(keyid, "unix:"))
+	case strings.HasPrefix(keyid, "pkcs11:"):
+		return OpenPKCS11(strings.TrimPrefix(keyid, "pkcs11:"))
+	default:
+		return nil, fmt.Errorf("%q does not name an external signer", keyid)
+	}
+}
@@ -0,0 +1,336 @@
+// Copyright 2014-2015 The Dename Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy of
+// the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations under
+// the License.
+
+package signer
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/asn1"
+	"errors"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/agl/ed25519"
+	"github.com/miekg/pkcs11"
+	"github.com/yahoo/coname"
+	"github.com/yahoo/coname/proto"
+)
+
+var message = []byte("message")
+
+func checkSigner(t *testing.T, s Signer, want *proto.PublicKey) {
+	if !s.PublicKey().Equal(want) {
+		t.Errorf("public key %v, want %v", s.PublicKey(), want)
+	}
+	sig, err := s.Sign(message)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !coname.VerifySignature(want, message, sig) {
+		t.Error("signature rejected")
+	}
+}
+
+func TestNew(t *testing.T) {
+	_, edsk, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := New(edsk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pk, err := coname.PublicKeyFor(edsk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkSigner(t, s, pk)
+	if s2, err := New(s); err != nil || s2 != s {
+		t.Errorf("New(Signer) = %v, %v; want the same signer", s2, err)
+	}
+	if _, err := New("not a key"); err == nil {
+		t.Error("New accepted an unsupported key")
+	}
+}
+
+// brokenSigner advertises one key but signs with another, or fails.
+type brokenSigner struct {
+	Signer
+	pk  *proto.PublicKey
+	err error
+}
+
+func (s *brokenSigner) PublicKey() *proto.PublicKey { return s.pk }
+
+func (s *brokenSigner) Sign(message []byte) ([]byte, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	return s.Signer.Sign(message)
+}
+
+func serve(t *testing.T, s Signer) (path string, teardown func()) {
+	dir, err := ioutil.TempDir("", "signer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	path = filepath.Join(dir, "signer.sock")
+	ln, err := net.Listen("unix", path)
+	if err != nil {
+		os.RemoveAll(dir)
+		t.Fatal(err)
+	}
+	go Serve(ln, s)
+	return path, func() { ln.Close(); os.RemoveAll(dir) }
+}
+
+func TestRemoteSigner(t *testing.T) {
+	sk, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	local, err := New(sk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	path, teardown := serve(t, local)
+	defer teardown()
+
+	s, err := Open("unix:" + path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkSigner(t, s, local.PublicKey())
+
+	_, otherSK, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := New(otherSK)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wrongKeyPath, teardown2 := serve(t, &brokenSigner{Signer: other, pk: local.PublicKey()})
+	defer teardown2()
+	s, err = DialUnix(wrongKeyPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Sign(message); err == nil {
+		t.Error("signature by the wrong key accepted")
+	}
+
+	failingPath, teardown3 := serve(t, &brokenSigner{Signer: local, pk: local.PublicKey(), err: errors.New("token removed")})
+	defer teardown3()
+	s, err = DialUnix(failingPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Sign(message); err == nil || !strings.Contains(err.Error(), "token removed") {
+		t.Errorf("Sign error %v, want the error of the remote signer", err)
+	}
+
+	if _, err := DialUnix(filepath.Join(os.TempDir(), "no-such-signer.sock")); err == nil {
+		t.Error("dialing a nonexistent signer succeeded")
+	}
+}
+
+// fakeToken implements pkcs11Ctx with a single key pair in memory, in the
+// way SoftHSM does.
+type fakeToken struct {
+	label    string
+	sk       crypto.Signer
+	signInit *pkcs11.Mechanism
+	findTemp []*pkcs11.Attribute
+}
+
+const (
+	fakePrivateKey pkcs11.ObjectHandle = iota + 1
+	fakePublicKey
+)
+
+func (ft *fakeToken) FindObjectsInit(sh pkcs11.SessionHandle, temp []*pkcs11.Attribute) error {
+	ft.findTemp = temp
+	return nil
+}
+
+func (ft *fakeToken) FindObjects(sh pkcs11.SessionHandle, max int) ([]pkcs11.ObjectHandle, bool, error) {
+	var class []byte
+	for _, a := range ft.findTemp {
+		if a.Type == pkcs11.CKA_LABEL && string(a.Value) != ft.label {
+			return nil, false, nil
+		}
+		if a.Type == pkcs11.CKA_CLASS {
+			class = a.Value
+		}
+	}
+	switch string(class) {
+	case string(pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_PRIVATE_KEY).Value):
+		return []pkcs11.ObjectHandle{fakePrivateKey}, false, nil
+	case string(pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_PUBLIC_KEY).Value):
+		return []pkcs11.ObjectHandle{fakePublicKey}, false, nil
+	}
+	return nil, false, nil
+}
+
+func (ft *fakeToken) FindObjectsFinal(sh pkcs11.SessionHandle) error {
+	ft.findTemp = nil
+	return nil
+}
+
+func (ft *fakeToken) GetAttributeValue(sh pkcs11.SessionHandle, o pkcs11.ObjectHandle, a []*pkcs11.Attribute) ([]*pkcs11.Attribute, error) {
+	if o != fakePublicKey {
+		return nil, pkcs11.Error(pkcs11.CKR_ATTRIBUTE_SENSITIVE)
+	}
+	var ret []*pkcs11.Attribute
+	for _, a := range a {
+		switch pk := ft.sk.Public().(type) {
+		case *ecdsa.PublicKey:
+			switch a.Type {
+			case pkcs11.CKA_KEY_TYPE:
+				ret = append(ret, pkcs11.NewAttribute(a.Type, pkcs11.CKK_EC))
+			case pkcs11.CKA_EC_PARAMS:
+				oid, err := asn1.Marshal(asn1.ObjectIdentifier{1, 2, 840, 10045, 3, 1, 7})
+				if err != nil {
+					return nil, err
+				}
+				ret = append(ret, pkcs11.NewAttribute(a.Type, oid))
+			case pkcs11.CKA_EC_POINT:
+				point, err := asn1.Marshal(elliptic.Marshal(pk.Curve, pk.X, pk.Y))
+				if err != nil {
+					return nil, err
+				}
+				ret = append(ret, pkcs11.NewAttribute(a.Type, point))
+			default:
+				return nil, pkcs11.Error(pkcs11.CKR_ATTRIBUTE_TYPE_INVALID)
+			}
+		case *rsa.PublicKey:
+			switch a.Type {
+			case pkcs11.CKA_KEY_TYPE:
+				ret = append(ret, pkcs11.NewAttribute(a.Type, pkcs11.CKK_RSA))
+			case pkcs11.CKA_MODULUS:
+				ret = append(ret, pkcs11.NewAttribute(a.Type, pk.N.Bytes()))
+			case pkcs11.CKA_PUBLIC_EXPONENT:
+				ret = append(ret, pkcs11.NewAttribute(a.Type, big.NewInt(int64(pk.E)).Bytes()))
+			default:
+				return nil, pkcs11.Error(pkcs11.CKR_ATTRIBUTE_TYPE_INVALID)
+			}
+		}
+	}
+	return ret, nil
+}
+
+func (ft *fakeToken) SignInit(sh pkcs11.SessionHandle, m []*pkcs11.Mechanism, o pkcs11.ObjectHandle) error {
+	if o != fakePrivateKey || len(m) != 1 {
+		return pkcs11.Error(pkcs11.CKR_ARGUMENTS_BAD)
+	}
+	ft.signInit = m[0]
+	return nil
+}
+
+func (ft *fakeToken) Sign(sh pkcs11.SessionHandle, message []byte) ([]byte, error) {
+	m := ft.signInit
+	ft.signInit = nil
+	if m == nil {
+		return nil, pkcs11.Error(pkcs11.CKR_OPERATION_NOT_INITIALIZED)
+	}
+	switch sk := ft.sk.(type) {
+	case *ecdsa.PrivateKey:
+		if m.Mechanism != pkcs11.CKM_ECDSA || len(message) != sha256.Size {
+			return nil, pkcs11.Error(pkcs11.CKR_MECHANISM_INVALID)
+		}
+		r, s, err := ecdsa.Sign(rand.Reader, sk, message)
+		if err != nil {
+			return nil, err
+		}
+		sig := make([]byte, 64)
+		r.FillBytes(sig[:32])
+		s.FillBytes(sig[32:])
+		return sig, nil
+	case *rsa.PrivateKey:
+		if m.Mechanism != pkcs11.CKM_SHA256_RSA_PKCS_PSS || string(m.Parameter) != string(pkcs11.NewPSSParams(pkcs11.CKM_SHA256, pkcs11.CKG_MGF1_SHA256, sha256.Size)) {
+			return nil, pkcs11.Error(pkcs11.CKR_MECHANISM_INVALID)
+		}
+		h := sha256.Sum256(message)
+		return rsa.SignPSS(rand.Reader, sk, crypto.SHA256, h[:], &rsa.PSSOptions{SaltLength: sha256.Size})
+	}
+	return nil, pkcs11.Error(pkcs11.CKR_KEY_TYPE_INCONSISTENT)
+}
+
+func TestPKCS11Signer(t *testing.T) {
+	ecsk, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rsask, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, sk := range []crypto.Signer{ecsk, rsask} {
+		s, err := newPKCS11Signer(&fakeToken{label: "replica", sk: sk}, 1, "replica")
+		if err != nil {
+			t.Fatalf("%T: %s", sk, err)
+		}
+		pk, err := coname.PublicKeyFor(sk)
+		if err != nil {
+			t.Fatal(err)
+		}
+		checkSigner(t, s, pk)
+	}
+
+	if _, err := newPKCS11Signer(&fakeToken{label: "replica", sk: ecsk}, 1, "verifier"); err == nil {
+		t.Error("found a key with the wrong label")
+	}
+	p384sk, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	shortsk, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, sk := range []crypto.Signer{p384sk, shortsk} {
+		if _, err := newPKCS11Signer(&fakeToken{label: "replica", sk: sk}, 1, "replica"); err == nil {
+			t.Errorf("accepted unsupported key %T", sk)
+		}
+	}
+}
+
+func TestOpenPKCS11BadAttributes(t *testing.T) {
+	for _, attrs := range []string{
+		"",
+		"token=coname;object=replica",
+		"module-path=/nonexistent.so;token=coname",
+		"module-path=/nonexistent.so;token",
+		"module-path=/nonexistent.so;token=coname;object=replica;pin-source=/nonexistent",
+		"module-path=/nonexistent.so;token=coname;object=replica",
+	} {
+		if _, err := Open("pkcs11:" + attrs); err == nil {
+			t.Errorf("OpenPKCS11(%q) succeeded", attrs)
+		}
+	}
+	if IsExternal("/etc/coname/replica.ed25519secret") {
+		t.Error("key file path considered external")
+	}
+}
@@ -0,0 +1,229 @@
+// Copyright 2014-2015 The Dename Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy of
+// the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations under
+// the License.
+
+package smtpfront
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/textproto"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultMaxSize is the largest email accepted if MaxSize is not set.
+const DefaultMaxSize = 1 << 20
+
+// maxLineLength bounds the length of a command line (RFC 5321 section 4.5.3.1
+// allows 512 octets, but some clients send longer ones).
+const maxLineLength = 1000
+
+// SMTPFront implements a minimal SMTP server that accepts email to a single
+// address and hands each message to Deliver. It does not relay mail, and it
+// does not authenticate senders: Deliver must verify the messages itself.
+type SMTPFront struct {
+	// ToAddr is the only recipient address accepted.
+	ToAddr string
+	// Deliver is called with the raw RFC 822 message. If it returns an error,
+	// the message is rejected and the error is returned to the sender.
+	Deliver func([]byte) error
+	// MaxSize is the largest message accepted, DefaultMaxSize if 0.
+	MaxSize int
+
+	ln net.Listener
+
+	connsMu sync.Mutex
+	conns   map[net.Conn]struct{}
+
+	stopOnce sync.Once
+	stop     chan struct{}
+	waitStop sync.WaitGroup // server + all open connections
+}
+
+func (s *SMTPFront) Start(ln net.Listener) {
+	s.stop = make(chan struct{})
+	s.conns = make(map[net.Conn]struct{})
+	s.ln = ln
+	s.waitStop.Add(1)
+	go s.run()
+}
+
+func (s *SMTPFront) run() {
+	defer s.waitStop.Done()
+	for {
+		conn, err := s.ln.Accept()
+		if err != nil {
+			select {
+			case <-s.stop:
+				return
+			default:
+			}
+			if ne, ok := err.(net.Error); ok && ne.Temporary() {
+				time.Sleep(10 * time.Millisecond)
+				continue
+			}
+			return
+		}
+		s.connsMu.Lock()
+		s.conns[conn] = struct{}{}
+		s.connsMu.Unlock()
+		s.waitStop.Add(1)
+		go s.serve(conn)
+	}
+}
+
+func (s *SMTPFront) Stop() {
+	s.stopOnce.Do(func() {
+		close(s.stop)
+		s.ln.Close()
+
+		s.connsMu.Lock()
+		for c := range s.conns {
+			c.Close()
+		}
+		s.connsMu.Unlock()
+
+		s.waitStop.Wait()
+	})
+}
+
+// serve handles one SMTP session.
+func (s *SMTPFront) serve(conn net.Conn) {
+	defer s.waitStop.Done()
+	defer func() {
+		s.connsMu.Lock()
+		delete(s.conns, conn)
+		s.connsMu.Unlock()
+		conn.Close()
+	}()
+	maxSize := s.MaxSize
+	if maxSize == 0 {
+		maxSize = DefaultMaxSize
+	}
+
+	// Command lines must fit in the buffer. Messages are read through it
+	// byte by byte and limited to maxSize below.
+	br := bufio.NewReaderSize(conn, maxLineLength)
+	tr := textproto.NewReader(br)
+	tw := textproto.NewWriter(bufio.NewWriter(conn))
+	reply := func(format string, args ...interface{}) bool {
+		conn.SetWriteDeadline(time.Now().Add(time.Minute))
+		return tw.PrintfLine(format, args...) == nil
+	}
+	if !reply("220 %s ESMTP ready", s.ToAddr[strings.LastIndex(s.ToAddr, "@")+1:]) {
+		return
+	}
+	haveFrom, haveTo := false, false
+	for {
+		conn.SetReadDeadline(time.Now().Add(5 * time.Minute))
+		lineBytes, err := br.ReadSlice('\n')
+		if err == bufio.ErrBufferFull {
+			reply("500 5.5.2 Line too long")
+			return
+		}
+		if err != nil {
+			return
+		}
+		line := strings.TrimRight(string(lineBytes), "\r\n")
+		verb, arg := line, ""
+		if i := strings.IndexByte(line, ' '); i != -1 {
+			verb, arg = line[:i], line[i+1:]
+		}
+		var ok bool
+		switch strings.ToUpper(verb) {
+		case "HELO", "EHLO":
+			haveFrom, haveTo = false, false
+			ok = reply("250 Hello %s", arg)
+		case "MAIL":
+			if !strings.HasPrefix(strings.ToUpper(arg), "FROM:") {
+				ok = reply("501 5.5.4 Syntax: MAIL FROM:<address>")
+				break
+			}
+			haveFrom, haveTo = true, false
+			ok = reply("250 2.1.0 OK")
+		case "RCPT":
+			switch {
+			case !haveFrom:
+				ok = reply("503 5.5.1 Need MAIL before RCPT")
+			case !strings.HasPrefix(strings.ToUpper(arg), "TO:"):
+				ok = reply("501 5.5.4 Syntax: RCPT TO:<address>")
+			case !strings.EqualFold(parseAddr(arg[len("TO:"):]), s.ToAddr):
+				ok = reply("550 5.1.1 No such user here")
+			default:
+				haveTo = true
+				ok = reply("250 2.1.5 OK")
+			}
+		case "DATA":
+			if !haveTo {
+				ok = reply("503 5.5.1 Need RCPT before DATA")
+				break
+			}
+			if !reply("354 End data with <CR><LF>.<CR><LF>") {
+				return
+			}
+			dr := tr.DotReader()
+			data, err := ioutil.ReadAll(io.LimitReader(dr, int64(maxSize)+1))
+			if err != nil {
+				return
+			}
+			if len(data) > maxSize {
+				// the rest of the message is not read, so the session ends
+				reply("552 5.3.4 Message too big")
+				return
+			}
+			haveFrom, haveTo = false, false
+			if err := s.Deliver(data); err != nil {
+				ok = reply("550 5.7.1 %s", oneLine(err))
+				break
+			}
+			ok = reply("250 2.0.0 OK")
+		case "RSET":
+			haveFrom, haveTo = false, false
+			ok = reply("250 2.0.0 OK")
+		case "NOOP":
+			ok = reply("250 2.0.0 OK")
+		case "QUIT":
+			reply("221 2.0.0 Bye")
+			return
+		default:
+			ok = reply("502 5.5.2 Command not implemented")
+		}
+		if !ok {
+			return
+		}
+	}
+}
+
+// parseAddr extracts the address from a MAIL or RCPT argument such as
+// "<alice@example.com> SIZE=123".
+func parseAddr(arg string) string {
+	arg = strings.TrimSpace(arg)
+	if strings.HasPrefix(arg, "<") {
+		if i := strings.IndexByte(arg, '>'); i != -1 {
+			return arg[1:i]
+		}
+	}
+	if i := strings.IndexByte(arg, ' '); i != -1 {
+		return arg[:i]
+	}
+	return arg
+}
+
+func oneLine(err error) string {
+	return strings.Replace(strings.Replace(fmt.Sprint(err), "\r", " ", -1), "\n", " ", -1)
+}
@@ -0,0 +1,111 @@
+// Copyright 2014-2015 The Dename Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy of
+// the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations under
+// the License.
+
+package smtpfront
+
+import (
+	"fmt"
+	"net"
+	"net/smtp"
+	"strings"
+	"testing"
+)
+
+const toAddr = "proofs@keyserver.example"
+
+func startFront(t *testing.T, deliver func([]byte) error) (*SMTPFront, string) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &SMTPFront{ToAddr: toAddr, Deliver: deliver, MaxSize: 1024}
+	s.Start(ln)
+	return s, ln.Addr().String()
+}
+
+func TestDeliver(t *testing.T) {
+	var got []byte
+	s, addr := startFront(t, func(data []byte) error {
+		got = data
+		return nil
+	})
+	defer s.Stop()
+
+	msg := "From: alice@example.com\r\nTo: " + toAddr + "\r\nSubject: hi\r\n\r\n.leading dot\r\n"
+	if err := smtp.SendMail(addr, nil, "alice@example.com", []string{toAddr}, []byte(msg)); err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != strings.Replace(msg, "\r\n", "\n", -1) {
+		t.Errorf("delivered %q, sent %q", got, msg)
+	}
+}
+
+func TestRejectUnknownRecipient(t *testing.T) {
+	delivered := false
+	s, addr := startFront(t, func([]byte) error {
+		delivered = true
+		return nil
+	})
+	defer s.Stop()
+
+	if err := smtp.SendMail(addr, nil, "alice@example.com", []string{"bob@keyserver.example"}, []byte("\r\n")); err == nil {
+		t.Errorf("mail to an unknown recipient was accepted")
+	}
+	if delivered {
+		t.Errorf("mail to an unknown recipient was delivered")
+	}
+}
+
+func TestRejectFailedDelivery(t *testing.T) {
+	s, addr := startFront(t, func([]byte) error {
+		return fmt.Errorf("bad proof\nsecond line")
+	})
+	defer s.Stop()
+
+	err := smtp.SendMail(addr, nil, "alice@example.com", []string{toAddr}, []byte("\r\n"))
+	if err == nil || !strings.Contains(err.Error(), "bad proof") {
+		t.Errorf("expected the delivery error to be returned, got %v", err)
+	}
+}
+
+func TestRejectTooBig(t *testing.T) {
+	delivered := false
+	s, addr := startFront(t, func([]byte) error {
+		delivered = true
+		return nil
+	})
+	defer s.Stop()
+
+	msg := strings.Repeat("x", 2000) + "\r\n"
+	if err := smtp.SendMail(addr, nil, "alice@example.com", []string{toAddr}, []byte(msg)); err == nil {
+		t.Errorf("oversized mail was accepted")
+	}
+	if delivered {
+		t.Errorf("oversized mail was delivered")
+	}
+}
+
+func TestRejectLongLine(t *testing.T) {
+	s, addr := startFront(t, func([]byte) error { return nil })
+	defer s.Stop()
+
+	c, err := smtp.Dial(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	if err := c.Mail(strings.Repeat("x", 2*maxLineLength) + "@example.com"); err == nil {
+		t.Errorf("overlong command line was accepted")
+	}
+}
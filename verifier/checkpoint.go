@@ -0,0 +1,214 @@
+// Copyright 2014-2015 The Dename Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy of
+// the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations under
+// the License.
+
+package verifier
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"log"
+
+	"golang.org/x/crypto/sha3"
+
+	"github.com/yahoo/coname"
+	"github.com/yahoo/coname/keyserver/kv"
+	"github.com/yahoo/coname/keyserver/merkletree"
+	"github.com/yahoo/coname/proto"
+	"github.com/yahoo/coname/vrf"
+)
+
+// bootstrap initializes the state of a verifier with an empty database from a
+// checkpoint of the directory as of the latest epoch ratified by
+// vr.checkpointRatifiers (see VerifierConfig.CheckpointRatifiers). The entries
+// are written chunk by chunk as the tree is rebuilt, but the verifier state is
+// only written after the whole checkpoint has been checked, so an interrupted
+// bootstrap is started over on the next run. The entries of a checkpoint that
+// fails the checks are wiped, and so are those of an interrupted bootstrap
+// when it is started over.
+func (vr *Verifier) bootstrap() (err error) {
+	if err := vr.wipeCheckpointTables(); err != nil {
+		return err
+	}
+	defer func() {
+		if err == nil {
+			return
+		}
+		if wipeErr := vr.wipeCheckpointTables(); wipeErr != nil {
+			log.Printf("ERROR: wiping the checkpoint that failed to verify: %s", wipeErr)
+		}
+	}()
+	stream, err := vr.keyserver.GetCheckpoint(vr.ctx, &proto.CheckpointRequest{
+		QuorumRequirement: vr.checkpointRatifiers.GetQuorum(),
+	})
+	if err != nil {
+		return err
+	}
+	first, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	head, err := vr.verifyCheckpointRatifications(first.Ratifications)
+	if err != nil {
+		return err
+	}
+	if first.NextIndex == 0 {
+		return fmt.Errorf("checkpoint of epoch %d starts at index 0", head.Epoch)
+	}
+	seh, err := vr.verifyCheckpointStep(first.NextIndex-1, head)
+	if err != nil {
+		return err
+	}
+
+	snapshotNr := vr.vs.LatestTreeSnapshot
+	wb := vr.db.NewBatch()
+	for chunk := first; ; {
+		newTree, err := vr.merkletree.GetSnapshot(snapshotNr).BeginModification()
+		if err != nil {
+			return err
+		}
+		for _, e := range chunk.Entries {
+			if len(e.Index) != vrf.Size {
+				return fmt.Errorf("checkpoint entry index %x has bad length", e.Index)
+			}
+			var entryHash [32]byte
+			sha3.ShakeSum256(entryHash[:], e.Entry.Encoding)
+			if err := newTree.Set(e.Index, entryHash[:]); err != nil {
+				return err
+			}
+			wb.Put(tableEntries(e.Index, head.Epoch), e.Entry.Encoding)
+		}
+		// Pending recoveries are not covered by the epoch head, so they are
+		// taken on the word of the keyserver like the steps that follow.
+		for _, r := range chunk.PendingRecoveries {
+			if r.Update == nil || len(r.Update.NewEntry.Index) != vrf.Size {
+				return fmt.Errorf("checkpoint pending recovery %v is malformed", r)
+			}
+			wb.Put(tablePendingRecoveries(r.Update.NewEntry.Index), proto.MustMarshal(r))
+		}
+		snapshotNr = newTree.Flush(wb).Nr
+		if err := vr.db.Write(wb); err != nil {
+			return err
+		}
+		wb.Reset()
+		if chunk, err = stream.Recv(); err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+	}
+	rootHash, err := vr.merkletree.GetSnapshot(snapshotNr).GetRootHash()
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(rootHash, head.RootHash) {
+		return fmt.Errorf("checkpoint of epoch %d has root hash %x, but the epoch head says %x", head.Epoch, rootHash, head.RootHash)
+	}
+
+	wb.Put(tableEpochHeads(head.Epoch), proto.MustMarshal(seh))
+	vr.vs.NextIndex = first.NextIndex
+	vr.vs.NextEpoch = head.Epoch + 1
+	vr.vs.LatestTreeSnapshot = snapshotNr
+	vr.vs.PreviousSummaryHash = make([]byte, 64)
+	sha3.ShakeSum256(vr.vs.PreviousSummaryHash, head.Encoding)
+	if len(head.VRFPublic) != 0 {
+		vr.vs.VRFPublic = head.VRFPublic
+	}
+	vr.vs.VRFRotationTime = head.VRFRotationTime
+	wb.Put(tableVerifierState, proto.MustMarshal(&vr.vs))
+	return vr.db.Write(wb)
+}
+
+// wipeCheckpointTables deletes the entries, pending recoveries and tree nodes
+// that bootstrap writes before it has checked the whole checkpoint.
+func (vr *Verifier) wipeCheckpointTables() error {
+	wb := vr.db.NewBatch()
+	for _, prefix := range [][]byte{
+		{tableEntriesPrefix},
+		{tablePendingRecoveriesPrefix},
+		{tableMerkleTreePrefix, merkletree.NodePrefix},
+	} {
+		iter := vr.db.NewIterator(kv.BytesPrefix(prefix))
+		for iter.Next() {
+			wb.Delete(append([]byte(nil), iter.Key()...))
+		}
+		iter.Release()
+		if err := iter.Error(); err != nil {
+			return err
+		}
+	}
+	return vr.db.Write(wb)
+}
+
+// verifyCheckpointRatifications checks that the ratifications of a checkpoint
+// are all of the same epoch head of our realm, and that they satisfy the
+// quorum of vr.checkpointRatifiers. As in coname.VerifyConsensus, each
+// signature is checked against the signed epoch head it came with because
+// every verifier signs its own timestamp (and the epoch may have been
+// refreshed).
+func (vr *Verifier) verifyCheckpointRatifications(ratifications []*proto.SignedEpochHead) (*proto.EncodedEpochHead, error) {
+	if len(ratifications) == 0 {
+		return nil, fmt.Errorf("checkpoint has no ratifications")
+	}
+	head := &ratifications[0].Head.Head
+	for _, seh := range ratifications[1:] {
+		if !bytes.Equal(seh.Head.Head.Encoding, head.Encoding) {
+			return nil, fmt.Errorf("checkpoint ratifications of different epoch heads: %x vs %x", head.Encoding, seh.Head.Head.Encoding)
+		}
+	}
+	if head.Realm != vr.realm {
+		return nil, fmt.Errorf("checkpoint for realm %q, expected %q", head.Realm, vr.realm)
+	}
+	have := make(map[uint64]struct{})
+	for _, seh := range ratifications {
+		for id, sig := range seh.Signatures {
+			if pk, ok := vr.checkpointRatifiers.PublicKeys[id]; ok && coname.VerifySignature(pk, seh.Head.Encoding, sig) {
+				have[id] = struct{}{}
+			}
+		}
+	}
+	if !coname.CheckQuorum(vr.checkpointRatifiers.GetQuorum(), have) {
+		return nil, fmt.Errorf("checkpoint of epoch %d has insufficient ratifications (have %v)", head.Epoch, have)
+	}
+	return head, nil
+}
+
+// verifyCheckpointStep checks that the verifier step at index is the one that
+// announced the epoch of head, signed by the keyserver, and returns the signed
+// epoch head in it. This ties the index the keyserver told us to continue from
+// to the checkpoint.
+func (vr *Verifier) verifyCheckpointStep(index uint64, head *proto.EncodedEpochHead) (*proto.SignedEpochHead, error) {
+	stream, err := vr.keyserver.VerifierStream(vr.ctx, &proto.VerifierStreamRequest{
+		Start:    index,
+		PageSize: 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	step, err := stream.Recv()
+	if err != nil {
+		return nil, err
+	}
+	seh := step.GetEpoch()
+	if seh == nil {
+		return nil, fmt.Errorf("%d: expected the step of epoch %d, got %#v", index, head.Epoch, *step)
+	}
+	if !bytes.Equal(seh.Head.Head.Encoding, head.Encoding) {
+		return nil, fmt.Errorf("%d: epoch head %x does not match checkpoint %x", index, seh.Head.Head.Encoding, head.Encoding)
+	}
+	if !coname.VerifyPolicy(vr.vs.KeyserverAuth, seh.Head.Encoding, seh.Signatures) {
+		return nil, fmt.Errorf("%d: keyserver signature verification failed", index)
+	}
+	return seh, nil
+}
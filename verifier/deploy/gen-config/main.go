@@ -21,6 +21,10 @@ func make_config(caCert *x509.Certificate, cert *x509.Certificate, id uint64, in
 		TLS:                  &proto.TLSConfig{RootCAs: [][]byte{caCert.Raw}, Certificates: []*proto.CertificateAndKeyID{{[][]byte{cert.Raw}, "tls", nil}}},
 		InitialKeyserverAuth: inputCfg.InitialKeyserverAuth,
 		KeyserverAddr:        inputCfg.KeyserverAddr,
+		DeletionPolicy:       inputCfg.DeletionPolicy,
+		DomainAdminPolicies:  inputCfg.DomainAdminPolicies,
+		VRFPublic:            inputCfg.VRFPublic,
+		VRFSuite:             inputCfg.VRFSuite,
 	}
 
 	configF, err := os.OpenFile("config.json", os.O_WRONLY|os.O_CREATE, 0600)
@@ -36,7 +40,9 @@ func make_config(caCert *x509.Certificate, cert *x509.Certificate, id uint64, in
 }
 
 func main() {
-	// input config file contains initial_keyserver_auth and keyserver_addr
+	// input config file contains initial_keyserver_auth and keyserver_addr,
+	// and the deletion_policy, domain_admin_policies, vrf_public and vrf_suite
+	// of the realm
 	// this file will be provided by keyserver admin
 	if len(os.Args) != 5 {
 		fmt.Printf("usage: %s cacert cert id inputcfg\n", os.Args[0])
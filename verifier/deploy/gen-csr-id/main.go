@@ -9,10 +9,10 @@ import (
 	"encoding/pem"
 	"fmt"
 	"io/ioutil"
-	"os"
 	"strconv"
 
 	"github.com/agl/ed25519"
+	"github.com/yahoo/coname/keyfile"
 	"github.com/yahoo/coname/proto"
 )
 
@@ -30,7 +30,7 @@ func main() {
 	sv := &proto.PublicKey{PubkeyType: &proto.PublicKey_Ed25519{Ed25519: pk[:]}}
 	hostname := fmt.Sprintf("verifier %x", proto.KeyID(sv))
 	fmt.Println("verifier ID: " + hostname + "\nID(uint): " + strconv.FormatUint(proto.KeyID(sv), 10))
-	err = ioutil.WriteFile("signing.ed25519secret", []byte(sk[:]), 0644)
+	err = keyfile.WriteFile("signing.ed25519secret", sk[:])
 	if err != nil {
 		panic(err)
 	}
@@ -48,20 +48,15 @@ func main() {
 	if err != nil {
 		panic(err)
 	}
-	keyF, err := os.OpenFile("key.pem", os.O_WRONLY|os.O_CREATE, 0644)
-	defer keyF.Close()
-	if err != nil {
-		panic(err)
-	}
 	skDer, err := x509.MarshalECPrivateKey(privKey)
 	if err != nil {
 		panic(err)
 	}
-	err = pem.Encode(keyF, &pem.Block{
+	err = keyfile.WriteFile("key.pem", pem.EncodeToMemory(&pem.Block{
 		Type:    "EC PRIVATE KEY",
 		Headers: make(map[string]string),
 		Bytes:   skDer,
-	})
+	}))
 	if err != nil {
 		panic(err)
 	}
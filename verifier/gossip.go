@@ -0,0 +1,257 @@
+// Copyright 2014-2015 The Dename Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy of
+// the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations under
+// the License.
+
+package verifier
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+
+	"github.com/yahoo/coname"
+	"github.com/yahoo/coname/keyserver/kv"
+	"github.com/yahoo/coname/proto"
+)
+
+const (
+	// defaultGossipInterval is used if VerifierConfig.GossipInterval is 0.
+	defaultGossipInterval = 1 * time.Minute
+	// maxGossipHeads bounds the number of epoch heads a peer may send at once.
+	maxGossipHeads = 16
+
+	verifierCommonNamePrefix = "verifier" + " "
+)
+
+// errInternal is returned to peers when the verifier fails to handle a
+// request by no fault of theirs. The cause is logged instead.
+var errInternal = grpc.Errorf(codes.Internal, "internal error")
+
+// withCode returns err with the gRPC status code c, unless it already has a
+// code other than codes.Unknown.
+func withCode(c codes.Code, err error) error {
+	if err == nil || grpc.Code(err) != codes.Unknown {
+		return err
+	}
+	return grpc.Errorf(c, "%s", err)
+}
+
+// gossip periodically sends the latest epoch head of this verifier to each of
+// vr.gossipPeers and compares the heads they reply with to our own, until the
+// verifier is stopped.
+func (vr *Verifier) gossip() {
+	clients := make([]proto.E2EKSVerifierGossipClient, len(vr.gossipPeers))
+	for i, p := range vr.gossipPeers {
+		conn, err := grpc.Dial(p.Addr, grpc.WithTransportCredentials(vr.auth))
+		if err != nil {
+			log.Printf("gossip: dial verifier %x at %s: %s", p.ID, p.Addr, err)
+			continue
+		}
+		defer conn.Close()
+		clients[i] = proto.NewE2EKSVerifierGossipClient(conn)
+	}
+	ticker := time.NewTicker(vr.gossipInterval)
+	defer ticker.Stop()
+	for {
+		for i, c := range clients {
+			if c == nil {
+				continue
+			}
+			if err := vr.gossipWith(vr.gossipPeers[i].ID, c); err != nil {
+				log.Printf("gossip with verifier %x: %s", vr.gossipPeers[i].ID, err)
+			}
+		}
+		select {
+		case <-vr.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (vr *Verifier) gossipWith(id uint64, c proto.E2EKSVerifierGossipClient) error {
+	latest, err := vr.latestEpochHead()
+	if err != nil || latest == nil {
+		return err
+	}
+	resp, err := c.Gossip(vr.ctx, &proto.EpochHeadGossip{Heads: []*proto.SignedEpochHead{latest}})
+	if err != nil {
+		return err
+	}
+	if len(resp.Heads) > maxGossipHeads {
+		return fmt.Errorf("too many epoch heads (%d)", len(resp.Heads))
+	}
+	return vr.compareEpochHeads(id, resp.Heads)
+}
+
+// Gossip implements proto.E2EKSVerifierGossipServer
+func (vr *Verifier) Gossip(ctx context.Context, rq *proto.EpochHeadGossip) (*proto.EpochHeadGossip, error) {
+	id, err := authenticatePeer(ctx)
+	if err != nil {
+		log.Printf("Gossip: %s", err)
+		return nil, withCode(codes.Unauthenticated, fmt.Errorf("Gossip: %s", err))
+	}
+	if _, ok := vr.gossipPeerIDs[id]; !ok {
+		return nil, grpc.Errorf(codes.PermissionDenied, "Gossip: not authorized: verifier %x is not a peer", id)
+	}
+	if len(rq.Heads) > maxGossipHeads {
+		return nil, grpc.Errorf(codes.InvalidArgument, "Gossip: too many epoch heads (%d > %d)", len(rq.Heads), maxGossipHeads)
+	}
+	if err := vr.compareEpochHeads(id, rq.Heads); err != nil {
+		log.Printf("ERROR: Gossip: %s", err)
+		return nil, errInternal
+	}
+	ret := new(proto.EpochHeadGossip)
+	have := make(map[uint64]struct{})
+	for _, theirs := range rq.Heads {
+		epoch := theirs.Head.Head.Epoch
+		if _, ok := have[epoch]; ok {
+			continue
+		}
+		ours, err := vr.getEpochHead(epoch)
+		if err != nil {
+			log.Printf("ERROR: Gossip: getEpochHead(%d): %s", epoch, err)
+			return nil, errInternal
+		}
+		if ours != nil {
+			ret.Heads = append(ret.Heads, ours)
+			have[epoch] = struct{}{}
+		}
+	}
+	latest, err := vr.latestEpochHead()
+	if err != nil {
+		log.Printf("ERROR: Gossip: latestEpochHead: %s", err)
+		return nil, errInternal
+	}
+	if latest != nil {
+		if _, ok := have[latest.Head.Head.Epoch]; !ok {
+			ret.Heads = append(ret.Heads, latest)
+		}
+	}
+	return ret, nil
+}
+
+// compareEpochHeads compares epoch heads received from a peer to the ones this
+// verifier has seen. Heads that are not signed by the keyserver of our realm
+// according to vr.vs.KeyserverAuth are ignored; heads that are, but differ
+// from ours for the same epoch, prove that the keyserver equivocated and are
+// recorded as such.
+func (vr *Verifier) compareEpochHeads(peer uint64, heads []*proto.SignedEpochHead) error {
+	for _, theirs := range heads {
+		epoch := theirs.Head.Head.Epoch
+		if theirs.Head.Head.Realm != vr.realm || !coname.VerifyPolicy(vr.vs.KeyserverAuth, theirs.Head.Encoding, theirs.Signatures) {
+			log.Printf("gossip: verifier %x sent an epoch head for epoch %d that is not signed by the keyserver of %q", peer, epoch, vr.realm)
+			continue
+		}
+		ours, err := vr.getEpochHead(epoch)
+		if err != nil {
+			return err
+		}
+		if ours == nil || bytes.Equal(ours.Head.Head.Encoding, theirs.Head.Head.Encoding) {
+			continue
+		}
+		log.Printf("ALARM: keyserver of %q equivocated in epoch %d: we saw %x, verifier %x saw %x", vr.realm, epoch, ours.Head.Head.Encoding, peer, theirs.Head.Head.Encoding)
+		if err := vr.db.Put(tableEquivocations(epoch, peer), proto.MustMarshal(&proto.EpochHeadEquivocation{
+			Ours:   ours,
+			Theirs: theirs,
+			Peer:   peer,
+		})); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Equivocations returns the evidence of keyserver equivocation that this
+// verifier has collected by gossiping with its peers, in epoch order.
+func (vr *Verifier) Equivocations() ([]*proto.EpochHeadEquivocation, error) {
+	var ret []*proto.EpochHeadEquivocation
+	iter := vr.db.NewIterator(kv.BytesPrefix([]byte{tableEquivocationsPrefix}))
+	defer iter.Release()
+	for iter.Next() {
+		e := new(proto.EpochHeadEquivocation)
+		if err := e.Unmarshal(iter.Value()); err != nil {
+			return nil, err
+		}
+		ret = append(ret, e)
+	}
+	return ret, iter.Error()
+}
+
+// getEpochHead returns the keyserver-signed head of epoch, or nil if this
+// verifier has not verified that epoch.
+func (vr *Verifier) getEpochHead(epoch uint64) (*proto.SignedEpochHead, error) {
+	sehBytes, err := vr.db.Get(tableEpochHeads(epoch))
+	switch err {
+	case nil:
+	case vr.db.ErrNotFound():
+		return nil, nil
+	default:
+		return nil, err
+	}
+	seh := new(proto.SignedEpochHead)
+	if err := seh.Unmarshal(sehBytes); err != nil {
+		return nil, err
+	}
+	return seh, nil
+}
+
+// latestEpochHead returns the keyserver-signed head of the latest epoch this
+// verifier has verified, or nil if there is none.
+func (vr *Verifier) latestEpochHead() (*proto.SignedEpochHead, error) {
+	iter := vr.db.NewIterator(&kv.Range{
+		Start: tableEpochHeads(0),
+		Limit: tableEpochHeads(math.MaxUint64),
+	})
+	defer iter.Release()
+	if !iter.Last() {
+		return nil, iter.Error()
+	}
+	seh := new(proto.SignedEpochHead)
+	if err := seh.Unmarshal(iter.Value()); err != nil {
+		return nil, err
+	}
+	return seh, nil
+}
+
+// authenticatePeer returns the id of the verifier whose client certificate
+// was used to make the call in ctx.
+func authenticatePeer(ctx context.Context) (uint64, error) {
+	pr, ok := peer.FromContext(ctx)
+	if !ok {
+		return 0, fmt.Errorf("failed to authenticate verifier: peer.FromContext returned false")
+	}
+	tlsInfo, ok := pr.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.VerifiedChains) != 1 {
+		return 0, fmt.Errorf("failed to authenticate verifier: expected exactly one valid certificate chain")
+	}
+	commonName := tlsInfo.State.VerifiedChains[0][0].Subject.CommonName
+	if !strings.HasPrefix(commonName, verifierCommonNamePrefix) {
+		return 0, fmt.Errorf("failed to authenticate verifier: invalid common name: missing prefix %q (got %q)", verifierCommonNamePrefix, commonName)
+	}
+	id, err := strconv.ParseUint(commonName[len(verifierCommonNamePrefix):], 16, 64)
+	if err != nil {
+		return 0, fmt.Errorf("failed to authenticate verifier: invalid common name: id not an integer: %s", err)
+	}
+	return id, nil
+}
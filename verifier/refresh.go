@@ -0,0 +1,79 @@
+// Copyright 2014-2015 The Dename Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy of
+// the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations under
+// the License.
+
+package verifier
+
+import (
+	"bytes"
+	"log"
+	"time"
+
+	"github.com/yahoo/coname"
+	"github.com/yahoo/coname/keyserver/kv"
+	"github.com/yahoo/coname/proto"
+)
+
+// maxRefreshClockSkew is how far in the future (according to our clock) the
+// timestamp of a refresh may be for us to sign it.
+const maxRefreshClockSkew = 5 * time.Minute
+
+// refreshEpoch is called by step for a keyserver-signed refresh of the last
+// epoch. We sign the timestamp chosen by the keyserver, not our own: by
+// refreshing an epoch, the keyserver claims that the directory has not changed
+// since the epoch, and we only sign if no other step has come after the epoch
+// in the verifier log (vs.ChangedSinceEpoch). No i/o allowed.
+func (vr *Verifier) refreshEpoch(refresh *proto.SignedEpochHead, vs *proto.VerifierState, wb kv.Batch) (deferredIO func()) {
+	// vr: &const
+	// refresh, vs, wb: &mut
+	epoch := refresh.Head.Head.Epoch
+	if !coname.VerifyPolicy(vs.KeyserverAuth, refresh.Head.Encoding, refresh.Signatures) {
+		log.Panicf("%d: keyserver signature verification failed for refresh: %#v", vs.NextIndex, *refresh)
+	}
+	if !refresh.Head.Refresh || refresh.Head.Head.Realm != vr.realm {
+		log.Panicf("%d: not a refresh in realm %q: %#v", vs.NextIndex, vr.realm, *refresh)
+	}
+	if vs.NextEpoch == 0 || epoch != vs.NextEpoch-1 {
+		log.Panicf("%d: refresh of epoch %d, but the next epoch is %d", vs.NextIndex, epoch, vs.NextEpoch)
+	}
+	sehBytes, err := vr.db.Get(tableEpochHeads(epoch))
+	if err != nil {
+		log.Panicf("get tableEpochHeads(%d): %s", epoch, err)
+	}
+	var last proto.SignedEpochHead
+	if err := last.Unmarshal(sehBytes); err != nil {
+		log.Panicf("invalid epoch head %d (%x): %s", epoch, sehBytes, err)
+	}
+	if !bytes.Equal(last.Head.Head.Encoding, refresh.Head.Head.Encoding) {
+		log.Panicf("%d: refresh of epoch %d with head %x, expected %x", vs.NextIndex, epoch, refresh.Head.Head.Encoding, last.Head.Head.Encoding)
+	}
+	if vs.ChangedSinceEpoch {
+		log.Printf("ERROR: %d: not signing refresh of epoch %d, which has been changed since", vs.NextIndex, epoch)
+		return nil
+	}
+	t := refresh.Head.Timestamp.Time()
+	if t.Before(last.Head.Head.IssueTime.Time()) {
+		log.Panicf("%d: refresh of epoch %d timestamped %v, before the epoch was issued", vs.NextIndex, epoch, t)
+	}
+	if t.After(time.Now().Add(maxRefreshClockSkew)) {
+		log.Printf("%d: not signing refresh of epoch %d timestamped in the future: %v", vs.NextIndex, epoch, t)
+		return nil
+	}
+	// signed by pushOutbox
+	seh := &proto.SignedEpochHead{
+		Head:       refresh.Head,
+		Signatures: make(map[uint64][]byte, 1),
+	}
+	wb.Put(tableOutbox(vs.NextIndex), proto.MustMarshal(seh))
+	return vr.notifyOutbox
+}
@@ -20,10 +20,14 @@ import (
 )
 
 var (
-	tableVerifierLogPrefix   byte = 'v' // index uint64 -> proto.VerifierStep
-	tableRatificationsPrefix byte = 'r' // epoch uint64, ratifier uint64 -> proto.SignedRatification
-	tableMerkleTreePrefix    byte = 't'
-	tableEntriesPrefix       byte = 'e' // vrfidx [vrf.Size]byte -> epoch uint64 -> proto.Entry
+	tableVerifierLogPrefix       byte = 'v' // index uint64 -> proto.VerifierStep
+	tableRatificationsPrefix     byte = 'r' // epoch uint64, ratifier uint64 -> proto.SignedRatification
+	tableMerkleTreePrefix        byte = 't'
+	tableEntriesPrefix           byte = 'e' // vrfidx [vrf.Size]byte -> epoch uint64 -> proto.Entry, empty if the entry has been moved by a VRF key rotation since
+	tableOutboxPrefix            byte = 'o' // index uint64 -> proto.SignedEpochHead, our ratification of the verifier step at index, not yet acknowledged by the keyserver
+	tableEpochHeadsPrefix        byte = 'h' // epoch uint64 -> proto.SignedEpochHead, as signed by the keyserver
+	tableEquivocationsPrefix     byte = 'x' // epoch uint64, peer uint64 -> proto.EpochHeadEquivocation
+	tablePendingRecoveriesPrefix byte = 'd' // vrfidx [vrf.Size]byte -> proto.PendingRecovery
 
 	tableVerifierState = []byte{'a'} // proto.VeriferState
 )
@@ -50,3 +54,32 @@ func tableEntries(vrfidx []byte, epoch uint64) []byte {
 	binary.BigEndian.PutUint64(ret[1+vrf.Size:1+vrf.Size+8], epoch)
 	return ret
 }
+
+func tableOutbox(index uint64) []byte {
+	ret := make([]byte, 1+8)
+	ret[0] = tableOutboxPrefix
+	binary.BigEndian.PutUint64(ret[1:1+8], index)
+	return ret
+}
+
+func tableEpochHeads(epoch uint64) []byte {
+	ret := make([]byte, 1+8)
+	ret[0] = tableEpochHeadsPrefix
+	binary.BigEndian.PutUint64(ret[1:1+8], epoch)
+	return ret
+}
+
+func tableEquivocations(epoch, peer uint64) []byte {
+	ret := make([]byte, 1+8+8)
+	ret[0] = tableEquivocationsPrefix
+	binary.BigEndian.PutUint64(ret[1:1+8], epoch)
+	binary.BigEndian.PutUint64(ret[1+8:1+8+8], peer)
+	return ret
+}
+
+func tablePendingRecoveries(vrfidx []byte) []byte {
+	ret := make([]byte, 1+vrf.Size)
+	ret[0] = tablePendingRecoveriesPrefix
+	copy(ret[1:1+vrf.Size], vrfidx)
+	return ret
+}
@@ -18,22 +18,25 @@ import (
 	"bytes"
 	"crypto"
 	"encoding/binary"
+	"fmt"
 	"log"
 	"math"
+	"net"
 	"sync"
 	"time"
 
 	"golang.org/x/crypto/sha3"
 	"golang.org/x/net/context"
 
-	"github.com/agl/ed25519"
 	"github.com/yahoo/coname"
 	"github.com/yahoo/coname/keyserver/kv"
 	"github.com/yahoo/coname/keyserver/merkletree"
 	"github.com/yahoo/coname/proto"
+	"github.com/yahoo/coname/signer"
 	"github.com/yahoo/coname/vrf"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
 )
 
@@ -44,8 +47,8 @@ type Verifier struct {
 	keyserverAddr string
 	auth          credentials.TransportCredentials
 
-	id         uint64
-	signingKey *[ed25519.PrivateKeySize]byte
+	id        uint64
+	sehSigner signer.Signer
 
 	db kv.DB
 	vs proto.VerifierState
@@ -58,8 +61,40 @@ type Verifier struct {
 
 	merkletree *merkletree.MerkleTree
 	latestTree *merkletree.Snapshot
+
+	// checkpointRatifiers is nil unless the verifier may bootstrap from a
+	// checkpoint, see VerifierConfig.CheckpointRatifiers.
+	checkpointRatifiers *proto.AuthorizationPolicy
+
+	// deletionPolicy is nil unless the realm allows deleting any entry, see
+	// VerifierConfig.DeletionPolicy.
+	deletionPolicy *proto.AuthorizationPolicy
+	// domainAdminPolicies is used to check AdminUpdate steps, see
+	// VerifierConfig.DomainAdminPolicies. Their index proofs are checked
+	// against vs.VRFPublic, which starts out as VerifierConfig.VRFPublic,
+	// using vrfSuite.
+	domainAdminPolicies []*proto.DomainAdminPolicy
+	vrfSuite            vrf.Suite
+
+	// outboxNotify wakes up pushRatifications when a new ratification has
+	// been added to the outbox.
+	outboxNotify chan struct{}
+
+	// gossip with other verifiers, see gossip.go
+	gossipPeers    []*proto.GossipPeer
+	gossipPeerIDs  map[uint64]struct{}
+	gossipInterval time.Duration
+	gossipServer   *grpc.Server
+	gossipListen   net.Listener
 }
 
+const (
+	// minPushBackoff and maxPushBackoff bound the delay between attempts to
+	// deliver ratifications the keyserver has not acknowledged yet.
+	minPushBackoff = 100 * time.Millisecond
+	maxPushBackoff = 1 * time.Minute
+)
+
 // Start initializes a new verifier based on config and db, or returns an error
 // if initialization fails. It then starts the worker goroutine(s).
 func Start(cfg *proto.VerifierConfig, db kv.DB, getKey func(string) (crypto.PrivateKey, error)) (*Verifier, error) {
@@ -71,16 +106,30 @@ func Start(cfg *proto.VerifierConfig, db kv.DB, getKey func(string) (crypto.Priv
 	if err != nil {
 		return nil, err
 	}
+	s, err := signer.New(sk)
+	if err != nil {
+		return nil, fmt.Errorf("signing key %s: %s", cfg.SigningKeyID, err)
+	}
+	if cfg.CheckpointRatifiers != nil && cfg.CheckpointRatifiers.GetQuorum() == nil {
+		return nil, fmt.Errorf("checkpoint_ratifiers does not specify a quorum")
+	}
 
 	vr := &Verifier{
 		id:    cfg.ID,
 		realm: cfg.Realm,
 
-		signingKey:    sk.(*[ed25519.PrivateKeySize]byte),
+		sehSigner:     s,
 		keyserverAddr: cfg.KeyserverAddr,
 		auth:          credentials.NewTLS(tls),
 
 		db: db,
+
+		checkpointRatifiers: cfg.CheckpointRatifiers,
+		deletionPolicy:      cfg.DeletionPolicy,
+		domainAdminPolicies: cfg.DomainAdminPolicies,
+		vrfSuite:            vrf.Suite(cfg.VRFSuite),
+
+		outboxNotify: make(chan struct{}, 1),
 	}
 	vr.ctx, vr.stop = context.WithCancel(context.Background())
 
@@ -95,18 +144,54 @@ func Start(cfg *proto.VerifierConfig, db kv.DB, getKey func(string) (crypto.Priv
 	default:
 		return nil, err
 	}
+	if len(vr.vs.VRFPublic) == 0 {
+		vr.vs.VRFPublic = cfg.VRFPublic
+	}
 	vr.merkletree, err = merkletree.AccessMerkleTree(vr.db, []byte{tableMerkleTreePrefix}, cfg.TreeNonce)
 	if err != nil {
 		return nil, err
 	}
 
+	vr.gossipPeers = cfg.GossipPeers
+	vr.gossipPeerIDs = make(map[uint64]struct{}, len(cfg.GossipPeers))
+	for _, p := range cfg.GossipPeers {
+		vr.gossipPeerIDs[p.ID] = struct{}{}
+	}
+	vr.gossipInterval = cfg.GossipInterval.Duration()
+	if vr.gossipInterval == 0 {
+		vr.gossipInterval = defaultGossipInterval
+	}
+	if cfg.GossipAddr != "" {
+		if cfg.GossipTLS == nil {
+			return nil, fmt.Errorf("gossip_addr is set but gossip_tls is not")
+		}
+		gossipTLS, err := cfg.GossipTLS.Config(getKey)
+		if err != nil {
+			return nil, err
+		}
+		vr.gossipServer = grpc.NewServer(grpc.Creds(credentials.NewTLS(gossipTLS)))
+		proto.RegisterE2EKSVerifierGossipServer(vr.gossipServer, vr)
+		vr.gossipListen, err = net.Listen("tcp", cfg.GossipAddr)
+		if err != nil {
+			return nil, err
+		}
+		go vr.gossipServer.Serve(vr.gossipListen)
+	}
+
 	vr.waitStop.Add(1)
 	go func() { vr.run(); vr.waitStop.Done() }()
+	if len(vr.gossipPeers) != 0 {
+		vr.waitStop.Add(1)
+		go func() { vr.gossip(); vr.waitStop.Done() }()
+	}
 	return vr, nil
 }
 
 // Stop cleanly shuts down the verifier and then returns.
 func (vr *Verifier) Stop() {
+	if vr.gossipServer != nil {
+		vr.gossipServer.Stop()
+	}
 	vr.stop()
 	vr.waitStop.Wait()
 }
@@ -120,6 +205,14 @@ func (vr *Verifier) shuttingDown() bool {
 	}
 }
 
+const (
+	// verifierBatchSize and verifierBatchWindow are requested from the
+	// keyserver when streaming the verifier log: up to verifierBatchWindow
+	// batches of verifierBatchSize steps each may be in flight at once.
+	verifierBatchSize   = 4096
+	verifierBatchWindow = 4
+)
+
 // run is the CSP-style main loop of the verifier. All code critical for safe
 // persistence should be directly in run. All functions called from run should
 // either interpret data and modify their mutable arguments OR interact with the
@@ -130,33 +223,69 @@ func (vr *Verifier) run() {
 		log.Panicf("dial %s: %s", vr.keyserverAddr, err)
 	}
 	vr.keyserver = proto.NewE2EKSVerificationClient(keyserverConnection)
-	stream, err := vr.keyserver.VerifierStream(vr.ctx, &proto.VerifierStreamRequest{
-		Start:    vr.vs.NextIndex,
-		PageSize: math.MaxUint64,
-	})
+	// ratifications left in the outbox by a previous run are picked up here
+	vr.waitStop.Add(1)
+	go func() { vr.pushRatifications(); vr.waitStop.Done() }()
+	if vr.vs.NextIndex == 0 && vr.checkpointRatifiers != nil {
+		if err := vr.bootstrap(); err != nil {
+			keyserverConnection.Close()
+			log.Panicf("bootstrap from checkpoint: %s", err)
+		}
+	}
+	stream, err := vr.keyserver.VerifierBatchStream(vr.ctx)
 	if err != nil {
 		keyserverConnection.Close()
-		log.Panicf("VerifierStream: %s", err)
+		log.Panicf("VerifierBatchStream: %s", err)
+	}
+	if err := stream.Send(&proto.VerifierBatchStreamRequest{Open: &proto.VerifierStreamRequest{
+		Start:       vr.vs.NextIndex,
+		PageSize:    math.MaxUint64,
+		BatchSize:   verifierBatchSize,
+		Window:      verifierBatchWindow,
+		Compression: proto.DEFLATE,
+	}}); err != nil {
+		keyserverConnection.Close()
+		log.Panicf("VerifierBatchStream.Send: %s", err)
 	}
 
 	wb := vr.db.NewBatch()
 	for !vr.shuttingDown() {
-		var step *proto.VerifierStep
-		step, err = stream.Recv()
+		batch, err := stream.Recv()
 		if err != nil {
-			log.Printf("VerifierStream.Recv: %s", err)
+			log.Printf("VerifierBatchStream.Recv: %s", err)
 			break
 		}
-		wb.Put(tableVerifierLog(vr.vs.NextIndex), proto.MustMarshal(step))
-		deferredIO := vr.step(step, &vr.vs, wb)
-		vr.vs.NextIndex++
-		wb.Put(tableVerifierState, proto.MustMarshal(&vr.vs))
-		if err := vr.db.Write(wb); err != nil {
-			log.Panicf("sync step to db: %s", err)
+		if batch.Start != vr.vs.NextIndex {
+			log.Panicf("VerifierBatchStream: batch starts at %d, expected %d", batch.Start, vr.vs.NextIndex)
+		}
+		steps, err := proto.DecodeVerifierSteps(batch.Steps, batch.Compression)
+		if err != nil {
+			log.Panicf("VerifierBatchStream: batch [%d, %d): %s", batch.Start, batch.NextIndex, err)
+		}
+		if batch.NextIndex < batch.Start || uint64(len(steps)) != batch.NextIndex-batch.Start {
+			log.Panicf("VerifierBatchStream: batch [%d, %d) contains %d steps", batch.Start, batch.NextIndex, len(steps))
 		}
-		wb.Reset()
-		if deferredIO != nil {
-			deferredIO()
+		// each step is persisted separately because vr.step reads the
+		// state written by the previous one from the db
+		for _, step := range steps {
+			if vr.shuttingDown() {
+				return
+			}
+			wb.Put(tableVerifierLog(vr.vs.NextIndex), proto.MustMarshal(step))
+			deferredIO := vr.step(step, &vr.vs, wb)
+			vr.vs.NextIndex++
+			wb.Put(tableVerifierState, proto.MustMarshal(&vr.vs))
+			if err := vr.db.Write(wb); err != nil {
+				log.Panicf("sync step to db: %s", err)
+			}
+			wb.Reset()
+			if deferredIO != nil {
+				deferredIO()
+			}
+		}
+		if err := stream.Send(&proto.VerifierBatchStreamRequest{Ack: batch.NextIndex}); err != nil {
+			log.Printf("VerifierBatchStream.Send: %s", err)
+			break
 		}
 	}
 }
@@ -165,26 +294,91 @@ func (vr *Verifier) run() {
 func (vr *Verifier) step(step *proto.VerifierStep, vs *proto.VerifierState, wb kv.Batch) (deferredIO func()) {
 	// vr: &const
 	// step, vs, wb: &mut
+	// the keyserver appends all steps of a VRF rotation at once (see
+	// keyserver.rotateVRF), so anything else in between is misbehavior
+	if _, ok := step.Type.(*proto.VerifierStep_VRFRotation); !ok && len(vs.VRFRotatingTo) != 0 {
+		log.Panicf("%d: step in the middle of the VRF rotation to %x: %#v", vs.NextIndex, vs.VRFRotatingTo, *step)
+	}
+	switch step.Type.(type) {
+	case *proto.VerifierStep_Epoch, *proto.VerifierStep_EpochRefresh:
+	default:
+		vs.ChangedSinceEpoch = true // see refreshEpoch
+	}
 	switch step.Type.(type) {
 	case *proto.VerifierStep_Update:
 		index := step.GetUpdate().NewEntry.Index
 		prevEntry, err := vr.getEntry(index, vs.NextEpoch)
-		if err := coname.VerifyUpdate(prevEntry, step.GetUpdate()); err != nil {
+		if err != nil {
+			log.Panicf("%d: getEntry(%x): %s", vs.NextIndex, index, err)
+		}
+		recovery, err := vr.getPendingRecovery(index)
+		if err != nil {
+			log.Panicf("%d: getPendingRecovery(%x): %s", vs.NextIndex, index, err)
+		}
+		if step.GetUpdate().NewEntry.Deleted {
+			err = coname.VerifyDeletion(prevEntry, step.GetUpdate(), vr.deletionPolicy)
+		} else {
+			err = coname.VerifyUpdateOrRecovery(prevEntry, recovery, step.GetUpdate(), vr.lastEpochIssueTime(vs))
+		}
+		if err != nil {
 			// the keyserver should filter all bad updates
 			log.Panicf("%d: bad update %v: %s", vs.NextIndex, *step, err)
 		}
-		var entryHash [32]byte
-		sha3.ShakeSum256(entryHash[:], step.GetUpdate().NewEntry.Encoding)
-		latestTree := vr.merkletree.GetSnapshot(vs.LatestTreeSnapshot)
-		newTree, err := latestTree.BeginModification()
+		vr.applyUpdate(step.GetUpdate(), vs, wb)
+
+	case *proto.VerifierStep_AdminUpdate:
+		adminUpdate := step.GetAdminUpdate()
+		if adminUpdate.Update == nil {
+			log.Panicf("%d: admin update without an update: %v", vs.NextIndex, *step)
+		}
+		index := adminUpdate.Update.NewEntry.Index
+		if !vr.vrfSuite.Verify(vs.VRFPublic, []byte(adminUpdate.UserId), index, adminUpdate.IndexProof) {
+			log.Panicf("%d: admin update with bad index proof for %q: %v", vs.NextIndex, adminUpdate.UserId, *step)
+		}
+		prevEntry, err := vr.getEntry(index, vs.NextEpoch)
+		if err != nil {
+			log.Panicf("%d: getEntry(%x): %s", vs.NextIndex, index, err)
+		}
+		adminPolicy := coname.GetDomainAdminPolicy(vr.domainAdminPolicies, adminUpdate.UserId)
+		if err := coname.VerifyAdminUpdate(prevEntry, adminUpdate.Update, adminPolicy); err != nil {
+			log.Panicf("%d: bad admin update %v: %s", vs.NextIndex, *step, err)
+		}
+		log.Printf("%d: entry of %q updated by its domain admin", vs.NextIndex, adminUpdate.UserId)
+		vr.applyUpdate(adminUpdate.Update, vs, wb)
+
+	case *proto.VerifierStep_RecoveryStart:
+		recovery := step.GetRecoveryStart()
+		if recovery.Update == nil {
+			log.Panicf("%d: recovery without an update: %v", vs.NextIndex, *step)
+		}
+		index := recovery.Update.NewEntry.Index
+		prevEntry, err := vr.getEntry(index, vs.NextEpoch)
+		if err != nil {
+			log.Panicf("%d: getEntry(%x): %s", vs.NextIndex, index, err)
+		}
+		if err := coname.VerifyRecoveryStart(movedEntry(prevEntry, index), recovery, vr.lastEpochIssueTime(vs)); err != nil {
+			log.Panicf("%d: bad recovery %v: %s", vs.NextIndex, *step, err)
+		}
+		// replaces any earlier recovery of the same entry
+		wb.Put(tablePendingRecoveries(index), proto.MustMarshal(recovery))
+
+	case *proto.VerifierStep_RecoveryVeto:
+		veto := step.GetRecoveryVeto()
+		prevEntry, err := vr.getEntry(veto.Index, vs.NextEpoch)
+		if err != nil {
+			log.Panicf("%d: getEntry(%x): %s", vs.NextIndex, veto.Index, err)
+		}
+		recovery, err := vr.getPendingRecovery(veto.Index)
 		if err != nil {
-			log.Panicf("%d: BeginModification(): %s", vs.NextIndex, err)
+			log.Panicf("%d: getPendingRecovery(%x): %s", vs.NextIndex, veto.Index, err)
 		}
-		if err := newTree.Set(index, entryHash[:]); err != nil {
-			log.Panicf("%d: Set(%x,%x): %s", vs.NextIndex, index, entryHash[:], err)
+		if err := coname.VerifyRecoveryVeto(movedEntry(prevEntry, veto.Index), recovery, veto); err != nil {
+			log.Panicf("%d: bad recovery veto %v: %s", vs.NextIndex, *step, err)
 		}
-		vs.LatestTreeSnapshot = newTree.Flush(wb).Nr
-		wb.Put(tableEntries(index, vs.NextEpoch), step.GetUpdate().NewEntry.Encoding)
+		wb.Delete(tablePendingRecoveries(veto.Index))
+
+	case *proto.VerifierStep_VRFRotation:
+		vr.rotateVRF(step.GetVRFRotation(), vs, wb)
 
 	case *proto.VerifierStep_Epoch:
 		ok := coname.VerifyPolicy(vr.vs.KeyserverAuth, step.GetEpoch().Head.Encoding, step.GetEpoch().Signatures)
@@ -211,6 +405,7 @@ func (vr *Verifier) step(step *proto.VerifierStep, vs *proto.VerifierState, wb k
 		if !bytes.Equal(s.RootHash, rootHash) {
 			log.Panicf("%d: seh with root hash %q, expected %q: %#v", vs.NextEpoch, s.RootHash, rootHash, *step)
 		}
+		vr.checkVRFAnnouncement(&s.EpochHead, vs)
 		seh := &proto.SignedEpochHead{
 			Head: proto.EncodedTimestampedEpochHead{TimestampedEpochHead: proto.TimestampedEpochHead{
 				Head:      s,
@@ -223,24 +418,150 @@ func (vr *Verifier) step(step *proto.VerifierStep, vs *proto.VerifierState, wb k
 		}
 		sha3.ShakeSum256(vs.PreviousSummaryHash[:], seh.Head.Head.Encoding)
 		seh.Head.UpdateEncoding()
-		seh.Signatures[vr.id] = ed25519.Sign(vr.signingKey, proto.MustMarshal(&seh.Head))[:]
-		wb.Put(tableRatifications(vs.NextEpoch, vr.id), proto.MustMarshal(seh))
+		// signed by pushOutbox
+		wb.Put(tableEpochHeads(vs.NextEpoch), proto.MustMarshal(step.GetEpoch()))
+		wb.Put(tableOutbox(vs.NextIndex), proto.MustMarshal(seh))
 		vs.NextEpoch++
-		return func() {
-			_, err := vr.keyserver.PushRatification(vr.ctx, seh)
-			if err != nil {
-				log.Printf("PushRatification: %s", err)
-			}
-		}
+		vs.ChangedSinceEpoch = false
+		return vr.notifyOutbox
+	case *proto.VerifierStep_EpochRefresh:
+		return vr.refreshEpoch(step.GetEpochRefresh(), vs, wb)
 	default:
 		log.Panicf("%d: unknown step: %#v", vs.NextIndex, *step)
 	}
 	return
 }
 
+// notifyOutbox wakes up pushRatifications, see outboxNotify.
+func (vr *Verifier) notifyOutbox() {
+	select {
+	case vr.outboxNotify <- struct{}{}:
+	default:
+	}
+}
+
+// pushRatifications delivers the ratifications in the outbox to the keyserver.
+// A ratification is removed from the outbox only after the keyserver has
+// acknowledged it; failed deliveries are retried with exponential backoff.
+func (vr *Verifier) pushRatifications() {
+	backoff := minPushBackoff
+	for {
+		var wait <-chan time.Time
+		if err := vr.pushOutbox(); err != nil {
+			log.Printf("PushRatification: %s (retrying in %s)", err, backoff)
+			wait = time.After(backoff)
+			if backoff *= 2; backoff > maxPushBackoff {
+				backoff = maxPushBackoff
+			}
+		} else {
+			backoff = minPushBackoff
+		}
+		select {
+		case <-vr.ctx.Done():
+			return
+		case <-vr.outboxNotify:
+		case <-wait:
+		}
+	}
+}
+
+// pushOutbox signs and sends all ratifications in the outbox to the keyserver
+// in the order of the verifier log, stopping at the first failure that may be
+// temporary. Once signed, a ratification is stored in tableRatifications and in
+// the outbox before it is sent, so that it is signed only once. A ratification
+// the keyserver rejects for good is dropped so that it does not hold up the
+// ones after it; we still have it in tableRatifications. A ratification that is
+// followed by one of the same epoch is of a refresh that has since been
+// superseded, so it is dropped without being sent.
+func (vr *Verifier) pushOutbox() error {
+	var keys, values [][]byte
+	iter := vr.db.NewIterator(&kv.Range{
+		Start: tableOutbox(0),
+		Limit: tableOutbox(math.MaxUint64),
+	})
+	for iter.Next() {
+		keys = append(keys, append([]byte(nil), iter.Key()...))
+		values = append(values, append([]byte(nil), iter.Value()...))
+	}
+	iter.Release()
+	if err := iter.Error(); err != nil {
+		return err
+	}
+	sehs := make([]*proto.SignedEpochHead, len(keys))
+	for i := range keys {
+		sehs[i] = new(proto.SignedEpochHead)
+		if err := sehs[i].Unmarshal(values[i]); err != nil {
+			log.Panicf("outbox: unmarshal %x: %s", keys[i], err)
+		}
+	}
+	for i, seh := range sehs {
+		superseded := i+1 < len(sehs) && sehs[i+1].Head.Head.Epoch == seh.Head.Head.Epoch
+		if !superseded {
+			if _, ok := seh.Signatures[vr.id]; !ok {
+				if err := vr.signRatification(keys[i], seh); err != nil {
+					return err
+				}
+			}
+			if _, err := vr.keyserver.PushRatification(vr.ctx, seh); err != nil {
+				if !isPermanentPushError(err) {
+					return err
+				}
+				log.Printf("ERROR: PushRatification: dropping ratification of epoch %d: %s", seh.Head.Head.Epoch, err)
+			}
+		}
+		if err := vr.db.Delete(keys[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// signRatification adds our signature to the ratification seh in the outbox
+// at dbkey.
+func (vr *Verifier) signRatification(dbkey []byte, seh *proto.SignedEpochHead) error {
+	sig, err := vr.sehSigner.Sign(seh.Head.Encoding)
+	if err != nil {
+		return fmt.Errorf("failed to sign epoch head %d: %s", seh.Head.Head.Epoch, err)
+	}
+	if seh.Signatures == nil {
+		seh.Signatures = make(map[uint64][]byte, 1)
+	}
+	seh.Signatures[vr.id] = sig
+	wb := vr.db.NewBatch()
+	wb.Put(tableRatifications(seh.Head.Head.Epoch, vr.id), proto.MustMarshal(seh))
+	wb.Put(dbkey, proto.MustMarshal(seh))
+	return vr.db.Write(wb)
+}
+
+// isPermanentPushError returns true if err means that the keyserver will
+// reject the same ratification again however often it is retried.
+func isPermanentPushError(err error) bool {
+	switch grpc.Code(err) {
+	case codes.InvalidArgument, codes.NotFound, codes.AlreadyExists, codes.PermissionDenied,
+		codes.FailedPrecondition, codes.OutOfRange, codes.Unimplemented, codes.Unauthenticated:
+		return true
+	}
+	return false
+}
+
 // getEntry returns the last version of the entry at idx during or before epoch.
-// If there is no such update, (nil, nil) is returned.
+// If there is no such update, or the entry has been moved to another index by
+// a VRF key rotation since, (nil, nil) is returned.
 func (vr *Verifier) getEntry(idx []byte, epoch uint64) (*proto.Entry, error) {
+	// idx: []&const
+	entryBytes, err := vr.getEntryEncoding(idx, epoch)
+	if err != nil || entryBytes == nil {
+		return nil, err
+	}
+	ret := new(proto.Entry)
+	if err := ret.Unmarshal(entryBytes); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// getEntryEncoding is like getEntry, but returns the encoding of the entry.
+func (vr *Verifier) getEntryEncoding(idx []byte, epoch uint64) ([]byte, error) {
 	// idx: []&const
 	prefixIdxEpoch := make([]byte, 1+vrf.Size+8)
 	prefixIdxEpoch[0] = tableEntriesPrefix
@@ -253,16 +574,69 @@ func (vr *Verifier) getEntry(idx []byte, epoch uint64) (*proto.Entry, error) {
 		Start: prefixIdxEpoch[:1+len(idx)],
 		Limit: prefixIdxEpoch,
 	})
+	defer iter.Release()
 	if !iter.Last() {
 		if iter.Error() != nil {
 			return nil, iter.Error()
 		}
 		return nil, nil
 	}
-	ret := new(proto.Entry)
-	if err := ret.Unmarshal(iter.Value()); err != nil {
-		return nil, iter.Error()
+	if len(iter.Value()) == 0 {
+		return nil, nil
+	}
+	return append([]byte(nil), iter.Value()...), nil
+}
+
+// applyUpdate stores the verified update and replaces its entry in the
+// merkle tree. Any pending recovery of the entry has been completed or
+// cancelled by the update.
+func (vr *Verifier) applyUpdate(update *proto.SignedEntryUpdate, vs *proto.VerifierState, wb kv.Batch) {
+	index := update.NewEntry.Index
+	wb.Delete(tablePendingRecoveries(index))
+	var entryHash [32]byte
+	sha3.ShakeSum256(entryHash[:], update.NewEntry.Encoding)
+	latestTree := vr.merkletree.GetSnapshot(vs.LatestTreeSnapshot)
+	newTree, err := latestTree.BeginModification()
+	if err != nil {
+		log.Panicf("%d: BeginModification(): %s", vs.NextIndex, err)
+	}
+	if err := newTree.Set(index, entryHash[:]); err != nil {
+		log.Panicf("%d: Set(%x,%x): %s", vs.NextIndex, index, entryHash[:], err)
+	}
+	vs.LatestTreeSnapshot = newTree.Flush(wb).Nr
+	wb.Put(tableEntries(index, vs.NextEpoch), update.NewEntry.Encoding)
+}
+
+// getPendingRecovery returns the pending recovery of the entry at idx. If
+// there is none, (nil, nil) is returned.
+func (vr *Verifier) getPendingRecovery(idx []byte) (*proto.PendingRecovery, error) {
+	recoveryBytes, err := vr.db.Get(tablePendingRecoveries(idx))
+	if err == vr.db.ErrNotFound() {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	ret := new(proto.PendingRecovery)
+	if err := ret.Unmarshal(recoveryBytes); err != nil {
+		return nil, err
 	}
-	iter.Release()
 	return ret, nil
 }
+
+// lastEpochIssueTime returns the issue time of the last epoch head that was
+// verified, or the zero time if there is none yet. The keyserver times
+// recoveries by the same clock.
+func (vr *Verifier) lastEpochIssueTime(vs *proto.VerifierState) time.Time {
+	if vs.NextEpoch <= 1 {
+		return time.Time{}
+	}
+	sehBytes, err := vr.db.Get(tableEpochHeads(vs.NextEpoch - 1))
+	if err != nil {
+		log.Panicf("get tableEpochHeads(%d): %s", vs.NextEpoch-1, err)
+	}
+	seh := new(proto.SignedEpochHead)
+	if err := seh.Unmarshal(sehBytes); err != nil {
+		log.Panicf("invalid epoch head %d (%x): %s", vs.NextEpoch-1, sehBytes, err)
+	}
+	return seh.Head.Head.IssueTime.Time()
+}
@@ -8,7 +8,6 @@ import (
 	"encoding/pem"
 	"flag"
 	"fmt"
-	"io/ioutil"
 	"log"
 	"os"
 	"os/signal"
@@ -19,8 +18,10 @@ import (
 	"github.com/maditya/protobuf/jsonpb"
 	"github.com/syndtr/goleveldb/leveldb"
 
+	"github.com/yahoo/coname/keyfile"
 	"github.com/yahoo/coname/keyserver/kv/leveldbkv"
 	"github.com/yahoo/coname/proto"
+	"github.com/yahoo/coname/signer"
 	"github.com/yahoo/coname/verifier"
 )
 
@@ -56,9 +57,13 @@ func main() {
 }
 
 // This getKey interprets key IDs as paths, and loads private keys from the
-// specified file
+// specified file, decrypting it if it is encrypted (see package keyfile). Key IDs that name an external signer (see package signer)
+// are connected to instead; only signing keys can be external.
 func getKey(keyid string) (crypto.PrivateKey, error) {
-	fileContents, err := ioutil.ReadFile(keyid)
+	if signer.IsExternal(keyid) {
+		return signer.Open(keyid)
+	}
+	fileContents, err := keyfile.ReadFile(keyid)
 	if err != nil {
 		return nil, err
 	}
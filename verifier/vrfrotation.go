@@ -0,0 +1,168 @@
+// Copyright 2014-2015 The Dename Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy of
+// the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations under
+// the License.
+
+package verifier
+
+import (
+	"bytes"
+	"log"
+
+	"github.com/yahoo/coname/keyserver/kv"
+	"github.com/yahoo/coname/proto"
+	"github.com/yahoo/coname/vrf"
+	"golang.org/x/crypto/sha3"
+)
+
+// rotateVRF checks one step of a VRF key rotation and moves the entries it
+// lists. Each entry must be moved to the index of its user ID under the new
+// key, as shown by the index proofs. The moved entries are also collected in a
+// tree at their old indices; when the last step arrives, that tree must equal
+// the latest tree, so that no entry has been added, dropped or changed.
+// Called from step.
+func (vr *Verifier) rotateVRF(rotation *proto.VRFRotation, vs *proto.VerifierState, wb kv.Batch) {
+	// vr: &const
+	// rotation: &const
+	// vs, wb: &mut
+	if len(rotation.VRFPublic) != vrf.PublicKeySize {
+		log.Panicf("%d: VRF rotation to a key of %d bytes", vs.NextIndex, len(rotation.VRFPublic))
+	}
+	if len(vs.VRFRotatingTo) == 0 {
+		if len(vs.VRFPublic) == 0 {
+			log.Panicf("%d: VRF rotation, but the current VRF key is not known", vs.NextIndex)
+		}
+		vs.VRFRotatingTo = rotation.VRFPublic
+		vs.VRFRotationCursor = nil
+		vs.VRFRotationNewSnapshot = 0
+		vs.VRFRotationCheckSnapshot = 0
+
+		// pending recoveries are cancelled, see proto.VRFRotation
+		recoveryIter := vr.db.NewIterator(kv.BytesPrefix([]byte{tablePendingRecoveriesPrefix}))
+		for recoveryIter.Next() {
+			wb.Delete(append([]byte(nil), recoveryIter.Key()...))
+		}
+		recoveryIter.Release()
+		if err := recoveryIter.Error(); err != nil {
+			log.Panicf("%d: scanning tablePendingRecoveries: %s", vs.NextIndex, err)
+		}
+	} else if !bytes.Equal(rotation.VRFPublic, vs.VRFRotatingTo) {
+		log.Panicf("%d: VRF rotation to %x in the middle of the rotation to %x", vs.NextIndex, rotation.VRFPublic, vs.VRFRotatingTo)
+	}
+
+	newTree, err := vr.merkletree.GetSnapshot(vs.VRFRotationNewSnapshot).BeginModification()
+	if err != nil {
+		log.Panicf("%d: BeginModification(): %s", vs.NextIndex, err)
+	}
+	checkTree, err := vr.merkletree.GetSnapshot(vs.VRFRotationCheckSnapshot).BeginModification()
+	if err != nil {
+		log.Panicf("%d: BeginModification(): %s", vs.NextIndex, err)
+	}
+	moved := make([][]byte, len(rotation.Moves))
+	for i, move := range rotation.Moves {
+		if bytes.Compare(move.OldIndex, vs.VRFRotationCursor) <= 0 {
+			log.Panicf("%d: VRF rotation moves %x after %x", vs.NextIndex, move.OldIndex, vs.VRFRotationCursor)
+		}
+		if !vr.vrfSuite.Verify(vs.VRFPublic, []byte(move.UserId), move.OldIndex, move.OldIndexProof) {
+			log.Panicf("%d: VRF rotation with bad old index proof for %q: %v", vs.NextIndex, move.UserId, *move)
+		}
+		if !vr.vrfSuite.Verify(rotation.VRFPublic, []byte(move.UserId), move.NewIndex, move.NewIndexProof) {
+			log.Panicf("%d: VRF rotation with bad new index proof for %q: %v", vs.NextIndex, move.UserId, *move)
+		}
+		entry, err := vr.getEntryEncoding(move.OldIndex, vs.NextEpoch)
+		if err != nil {
+			log.Panicf("%d: getEntryEncoding(%x): %s", vs.NextIndex, move.OldIndex, err)
+		}
+		if entry == nil {
+			log.Panicf("%d: VRF rotation moves %q from %x, but there is no entry", vs.NextIndex, move.UserId, move.OldIndex)
+		}
+		var entryHash [32]byte
+		sha3.ShakeSum256(entryHash[:], entry)
+		if err := checkTree.Set(move.OldIndex, entryHash[:]); err != nil {
+			log.Panicf("%d: Set(%x,%x): %s", vs.NextIndex, move.OldIndex, entryHash[:], err)
+		}
+		if previous, _, err := newTree.Lookup(move.NewIndex); err != nil {
+			log.Panicf("%d: Lookup(%x): %s", vs.NextIndex, move.NewIndex, err)
+		} else if previous != nil {
+			log.Panicf("%d: VRF rotation moves two entries to %x", vs.NextIndex, move.NewIndex)
+		}
+		if err := newTree.Set(move.NewIndex, entryHash[:]); err != nil {
+			log.Panicf("%d: Set(%x,%x): %s", vs.NextIndex, move.NewIndex, entryHash[:], err)
+		}
+		wb.Put(tableEntries(move.OldIndex, vs.NextEpoch), nil)
+		moved[i] = entry
+		vs.VRFRotationCursor = move.OldIndex
+	}
+	// the moved entries are written after all old indices have been cleared
+	for i, move := range rotation.Moves {
+		wb.Put(tableEntries(move.NewIndex, vs.NextEpoch), moved[i])
+	}
+	vs.VRFRotationNewSnapshot = newTree.Flush(wb).Nr
+	vs.VRFRotationCheckSnapshot = checkTree.Flush(wb).Nr
+	if rotation.More {
+		return
+	}
+
+	latestRootHash, err := vr.merkletree.GetSnapshot(vs.LatestTreeSnapshot).GetRootHash()
+	if err != nil {
+		log.Panicf("%d: GetRootHash(): %s", vs.NextIndex, err)
+	}
+	if checkRootHash := checkTree.FlushedRootHash(); !bytes.Equal(checkRootHash, latestRootHash) {
+		log.Panicf("%d: VRF rotation moves a directory with root hash %x, expected %x", vs.NextIndex, checkRootHash, latestRootHash)
+	}
+	vs.LatestTreeSnapshot = vs.VRFRotationNewSnapshot
+	vs.VRFPublic = rotation.VRFPublic
+	vs.VRFRotationPending = true
+	vs.VRFRotatingTo = nil
+	vs.VRFRotationCursor = nil
+	vs.VRFRotationNewSnapshot = 0
+	vs.VRFRotationCheckSnapshot = 0
+	log.Printf("%d: VRF key rotated to %x", vs.NextIndex, rotation.VRFPublic)
+}
+
+// movedEntry returns entry as it is found at idx, its index after the VRF key
+// rotations that moved it there. The entry keeps the index it was signed with,
+// so the index checks of an update or recovery of a moved entry need the copy.
+func movedEntry(entry *proto.Entry, idx []byte) *proto.Entry {
+	if entry == nil || bytes.Equal(entry.Index, idx) {
+		return entry
+	}
+	moved := *entry
+	moved.Index = idx
+	return &moved
+}
+
+// checkVRFAnnouncement checks the VRF key and rotation time in head against
+// those verified by rotateVRF. Called from step.
+func (vr *Verifier) checkVRFAnnouncement(head *proto.EpochHead, vs *proto.VerifierState) {
+	// head: &const
+	// vs: &mut
+	if vs.VRFRotationPending {
+		if head.VRFRotationTime == nil || !head.VRFRotationTime.Time().Equal(head.IssueTime.Time()) {
+			log.Panicf("%d: first epoch after a VRF rotation has rotation time %v, expected %v", vs.NextEpoch, head.VRFRotationTime, head.IssueTime)
+		}
+		rotationTime := head.IssueTime
+		vs.VRFRotationTime = &rotationTime
+		vs.VRFRotationPending = false
+	} else if !head.VRFRotationTime.Equal(vs.VRFRotationTime) {
+		log.Panicf("%d: epoch head with VRF rotation time %v, expected %v", vs.NextEpoch, head.VRFRotationTime, vs.VRFRotationTime)
+	}
+	switch {
+	case len(vs.VRFPublic) == 0:
+		// not configured, but it must not change without a rotation either
+		vs.VRFPublic = head.VRFPublic
+	case len(head.VRFPublic) == 0 && vs.VRFRotationTime == nil:
+		// a keyserver that does not announce its VRF key
+	case !bytes.Equal(head.VRFPublic, vs.VRFPublic):
+		log.Panicf("%d: epoch head announces VRF key %x, expected %x", vs.NextEpoch, head.VRFPublic, vs.VRFPublic)
+	}
+}
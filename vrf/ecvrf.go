@@ -0,0 +1,374 @@
+package vrf
+
+import (
+	"crypto/rand"
+	"crypto/sha512"
+	"io"
+
+	"github.com/yahoo/coname/ed25519/edwards25519"
+)
+
+// This file implements ECVRF-EDWARDS25519-SHA512-ELL2 as specified in RFC 9381,
+// so that index proofs can be checked with other implementations of the RFC.
+// Keys are ed25519 keys: the secret key is a 32-byte seed followed by the
+// public key, and the public key is that of ed25519 for the same seed.
+//
+//     Y = x*B is the public key, x is derived from the seed as in ed25519.
+//     H = encode_to_curve(Y, alpha), the ELL2 hash to curve of RFC 9380.
+//     Prove : pi = (Gamma = x*H, c = challenge(Y, H, Gamma, k*B, k*H), s = k+c*x)
+//         where k = SHA512(SHA512(seed)[32:64] || H) is the nonce
+//     Verify : c == challenge(Y, H, Gamma, s*B - c*Y, s*H - c*Gamma)
+//     beta = SHA512(0x04 || 0x03 || 8*Gamma || 0x00)
+
+const (
+	// ECVRFSize is the size of the output (beta) of ECVRFCompute.
+	ECVRFSize = 64
+	// ECVRFProofSize is the size of a proof (pi) of ECVRFProve.
+	ECVRFProofSize = 32 + ecvrfChallengeSize + 32
+
+	ecvrfChallengeSize = 16
+	ecvrfSuiteString   = 0x04
+)
+
+var ecvrfEncodeToCurveDST = []byte("ECVRF_edwards25519_XMD:SHA-512_ELL2_NU_\x04")
+
+// ECVRFGenerateKey creates a public/private key pair for ECVRFProve. rnd is
+// used for randomness. If it is nil, `crypto/rand` is used.
+func ECVRFGenerateKey(rnd io.Reader) (pk []byte, sk *[SecretKeySize]byte, err error) {
+	if rnd == nil {
+		rnd = rand.Reader
+	}
+	sk = new([SecretKeySize]byte)
+	_, err = io.ReadFull(rnd, sk[:32])
+	if err != nil {
+		return nil, nil, err
+	}
+	x, _ := ecvrfExpandSecret(sk)
+
+	var pkP edwards25519.ExtendedGroupElement
+	edwards25519.GeScalarMultBase(&pkP, x)
+	var pkBytes [PublicKeySize]byte
+	pkP.ToBytes(&pkBytes)
+
+	copy(sk[32:], pkBytes[:])
+	return pkBytes[:], sk, err
+}
+
+func ecvrfExpandSecret(sk *[SecretKeySize]byte) (x, nonceKey *[32]byte) {
+	x, nonceKey = new([32]byte), new([32]byte)
+	digest := sha512.Sum512(sk[:32])
+	copy(x[:], digest[:32])
+	copy(nonceKey[:], digest[32:])
+	x[0] &= 248
+	x[31] &= 127
+	x[31] |= 64
+	return
+}
+
+// ECVRFCompute returns the output (beta) of ECVRF-EDWARDS25519-SHA512-ELL2
+// for m.
+func ECVRFCompute(m []byte, sk *[SecretKeySize]byte) []byte {
+	x, _ := ecvrfExpandSecret(sk)
+	var h, gamma edwards25519.ExtendedGroupElement
+	ecvrfEncodeToCurve(&h, sk[32:], m)
+	edwards25519.GeScalarMult(&gamma, x, &h)
+	return ecvrfProofToHash(&gamma)
+}
+
+// ECVRFProve returns the output (beta) of ECVRF-EDWARDS25519-SHA512-ELL2 for
+// m and its proof (pi). The output is the same as returned by
+// ECVRFCompute(m, sk).
+func ECVRFProve(m []byte, sk *[SecretKeySize]byte) (vrf, proof []byte) {
+	x, nonceKey := ecvrfExpandSecret(sk)
+	var h, gamma, u, v edwards25519.ExtendedGroupElement
+	var hB, gammaB, uB, vB, k, s [32]byte
+
+	ecvrfEncodeToCurve(&h, sk[32:], m)
+	h.ToBytes(&hB)
+	edwards25519.GeScalarMult(&gamma, x, &h)
+	gamma.ToBytes(&gammaB)
+
+	hash := sha512.New()
+	hash.Write(nonceKey[:])
+	hash.Write(hB[:])
+	var kH [64]byte
+	hash.Sum(kH[:0])
+	edwards25519.ScReduce(&k, &kH)
+
+	edwards25519.GeScalarMultBase(&u, &k)
+	edwards25519.GeScalarMult(&v, &k, &h)
+	u.ToBytes(&uB)
+	v.ToBytes(&vB)
+	c := ecvrfChallenge(sk[32:], &hB, &gammaB, &uB, &vB)
+	edwards25519.ScMulAdd(&s, &c, x, &k)
+
+	proof = make([]byte, ECVRFProofSize)
+	copy(proof[:32], gammaB[:])
+	copy(proof[32:32+ecvrfChallengeSize], c[:ecvrfChallengeSize])
+	copy(proof[32+ecvrfChallengeSize:], s[:])
+	return ecvrfProofToHash(&gamma), proof
+}
+
+// ECVRFVerify returns the output (beta) of ECVRF-EDWARDS25519-SHA512-ELL2 for
+// m and true iff proof is valid for m under pkBytes.
+func ECVRFVerify(pkBytes, m, proof []byte) (vrf []byte, ok bool) {
+	if len(pkBytes) != PublicKeySize || len(proof) != ECVRFProofSize {
+		return nil, false
+	}
+	var pkB, gammaB, c, s, hB, uB, vB, zero [32]byte
+	copy(pkB[:], pkBytes)
+	copy(gammaB[:], proof[:32])
+	copy(c[:ecvrfChallengeSize], proof[32:32+ecvrfChallengeSize])
+	copy(s[:], proof[32+ecvrfChallengeSize:])
+
+	var y, gamma, h edwards25519.ExtendedGroupElement
+	if !ecvrfDecodePoint(&y, &pkB) || !ecvrfDecodePoint(&gamma, &gammaB) {
+		return nil, false
+	}
+	// the public key must not be of small order, see RFC 9381 section 5.4.5
+	var y8 edwards25519.ExtendedGroupElement
+	var y8B [32]byte
+	mulByCofactor(&y8, &y)
+	y8.ToBytes(&y8B)
+	if y8B == [32]byte{1} {
+		return nil, false
+	}
+	if !scIsReduced(&s) {
+		return nil, false
+	}
+	ecvrfEncodeToCurve(&h, pkB[:], m)
+	h.ToBytes(&hB)
+
+	// U = s*B - c*Y, V = s*H - c*Gamma
+	var minusY, minusGamma, sh, cGamma edwards25519.ExtendedGroupElement
+	var uP, shP, cGammaP edwards25519.ProjectiveGroupElement
+	negate(&minusY, &y)
+	negate(&minusGamma, &gamma)
+	edwards25519.GeDoubleScalarMultVartime(&uP, &c, &minusY, &s)
+	uP.ToBytes(&uB)
+	edwards25519.GeDoubleScalarMultVartime(&shP, &s, &h, &zero)
+	edwards25519.GeDoubleScalarMultVartime(&cGammaP, &c, &minusGamma, &zero)
+	shP.ToExtended(&sh)
+	cGammaP.ToExtended(&cGamma)
+	edwards25519.GeAdd(&sh, &sh, &cGamma)
+	sh.ToBytes(&vB)
+
+	if ecvrfChallenge(pkB[:], &hB, &gammaB, &uB, &vB) != c {
+		return nil, false
+	}
+	return ecvrfProofToHash(&gamma), true
+}
+
+// ecvrfChallenge returns the challenge c, which is 16 bytes long, as a scalar.
+func ecvrfChallenge(pk []byte, h, gamma, u, v *[32]byte) (c [32]byte) {
+	hash := sha512.New()
+	hash.Write([]byte{ecvrfSuiteString, 0x02})
+	hash.Write(pk) // const length: PublicKeySize
+	hash.Write(h[:])
+	hash.Write(gamma[:])
+	hash.Write(u[:])
+	hash.Write(v[:])
+	hash.Write([]byte{0x00})
+	copy(c[:ecvrfChallengeSize], hash.Sum(nil))
+	return
+}
+
+func ecvrfProofToHash(gamma *edwards25519.ExtendedGroupElement) []byte {
+	var gamma8 edwards25519.ExtendedGroupElement
+	var gamma8B [32]byte
+	mulByCofactor(&gamma8, gamma)
+	gamma8.ToBytes(&gamma8B)
+	hash := sha512.New()
+	hash.Write([]byte{ecvrfSuiteString, 0x03})
+	hash.Write(gamma8B[:])
+	hash.Write([]byte{0x00})
+	return hash.Sum(nil)
+}
+
+// ecvrfDecodePoint is string_to_point of RFC 9381: it accepts only canonical
+// encodings of points on the curve.
+func ecvrfDecodePoint(p *edwards25519.ExtendedGroupElement, s *[32]byte) bool {
+	if !p.FromBytes(s) {
+		return false
+	}
+	var sCheck [32]byte
+	p.ToBytes(&sCheck)
+	return sCheck == *s
+}
+
+// ecvrfEncodeToCurve is encode_to_curve of RFC 9381 with the ELL2 suite: the
+// hash to curve edwards25519_XMD:SHA-512_ELL2_NU_ of RFC 9380 of salt || m.
+func ecvrfEncodeToCurve(out *edwards25519.ExtendedGroupElement, salt, m []byte) {
+	msg := make([]byte, 0, len(salt)+len(m))
+	msg = append(append(msg, salt...), m...)
+	var u edwards25519.FieldElement
+	hashToField(&u, msg, ecvrfEncodeToCurveDST)
+	elligator2(out, &u)
+	mulByCofactor(out, out)
+}
+
+// hashToField sets u to an element of GF(2^255 - 19) derived from msg by
+// expand_message_xmd with SHA-512, as in hash_to_field of RFC 9380.
+func hashToField(u *edwards25519.FieldElement, msg, dst []byte) {
+	// L = ceil((ceil(log2(p)) + k) / 8) = 48 bytes for k = 128
+	uniform := expandMessageXMD(msg, dst, 48)
+	// u = uniform mod p, with uniform read as a big-endian integer. It is
+	// split into 248 low bits and 136 high bits, each of which fits in a
+	// field element: u = lo + hi * 2^248.
+	var loB, hiB, shiftB [32]byte
+	for i := 0; i < 31; i++ {
+		loB[i] = uniform[47-i]
+	}
+	for i := 0; i < 17; i++ {
+		hiB[i] = uniform[16-i]
+	}
+	shiftB[31] = 1
+	var lo, hi, shift edwards25519.FieldElement
+	edwards25519.FeFromBytes(&lo, &loB)
+	edwards25519.FeFromBytes(&hi, &hiB)
+	edwards25519.FeFromBytes(&shift, &shiftB)
+	edwards25519.FeMul(&hi, &hi, &shift)
+	edwards25519.FeAdd(u, &lo, &hi)
+}
+
+// expandMessageXMD is expand_message_xmd of RFC 9380 with SHA-512, for outputs
+// of at most one hash.
+func expandMessageXMD(msg, dst []byte, n int) []byte {
+	if n > sha512.Size || len(dst) > 255 {
+		panic("expandMessageXMD: output or domain separation tag too long")
+	}
+	dstPrime := append(append([]byte(nil), dst...), byte(len(dst)))
+	hash := sha512.New()
+	hash.Write(make([]byte, sha512.BlockSize)) // Z_pad
+	hash.Write(msg)
+	hash.Write([]byte{byte(n >> 8), byte(n), 0})
+	hash.Write(dstPrime)
+	b0 := hash.Sum(nil)
+	hash.Reset()
+	hash.Write(b0)
+	hash.Write([]byte{1})
+	hash.Write(dstPrime)
+	return hash.Sum(nil)[:n]
+}
+
+// sqrtMinusAPlus2 is sqrt(-486664) with sgn0 0, from the rational map between
+// curve25519 and edwards25519.
+var sqrtMinusAPlus2 edwards25519.FieldElement
+
+func init() {
+	var t, two edwards25519.FieldElement
+	edwards25519.FeOne(&two)
+	edwards25519.FeAdd(&two, &two, &two)
+	edwards25519.FeAdd(&t, &edwards25519.A, &two)
+	edwards25519.FeNeg(&t, &t)
+	feSqrt(&sqrtMinusAPlus2, &t)
+	if edwards25519.FeIsNegative(&sqrtMinusAPlus2) == 1 {
+		edwards25519.FeNeg(&sqrtMinusAPlus2, &sqrtMinusAPlus2)
+	}
+}
+
+// elligator2 is map_to_curve_elligator2_edwards25519 of RFC 9380: the
+// Elligator 2 map to curve25519 with Z = 2, followed by the rational map to
+// edwards25519.
+func elligator2(out *edwards25519.ExtendedGroupElement, u *edwards25519.FieldElement) {
+	var one, t, x1, x2, gx1, gx2, y1, y2, x, y edwards25519.FieldElement
+	edwards25519.FeOne(&one)
+
+	// x1 = -A / (1 + 2u^2), or -A if the denominator is zero
+	edwards25519.FeSquare2(&t, u)
+	edwards25519.FeAdd(&t, &t, &one)
+	edwards25519.FeCMove(&t, &one, 1-edwards25519.FeIsNonZero(&t))
+	edwards25519.FeInvert(&x1, &t)
+	edwards25519.FeMul(&x1, &x1, &edwards25519.A)
+	edwards25519.FeNeg(&x1, &x1)
+	// x2 = -x1 - A
+	edwards25519.FeAdd(&x2, &x1, &edwards25519.A)
+	edwards25519.FeNeg(&x2, &x2)
+	montgomeryRHS(&gx1, &x1)
+	montgomeryRHS(&gx2, &x2)
+
+	// x1 is taken with the root of sign 1 if g(x1) is a square, and x2 with
+	// the root of sign 0 otherwise
+	isSquare := feSqrt(&y1, &gx1)
+	feSqrt(&y2, &gx2)
+	feCondNeg(&y1, 1-int32(edwards25519.FeIsNegative(&y1)))
+	feCondNeg(&y2, int32(edwards25519.FeIsNegative(&y2)))
+	edwards25519.FeCopy(&x, &x2)
+	edwards25519.FeCMove(&x, &x1, isSquare)
+	edwards25519.FeCopy(&y, &y2)
+	edwards25519.FeCMove(&y, &y1, isSquare)
+
+	// (x, y) -> (sqrt(-486664) * x / y, (x - 1) / (x + 1)), in extended
+	// coordinates with Z = y * (x + 1). The points where that is zero map to
+	// the identity.
+	var xMinus1, xPlus1, c1x edwards25519.FieldElement
+	edwards25519.FeSub(&xMinus1, &x, &one)
+	edwards25519.FeAdd(&xPlus1, &x, &one)
+	edwards25519.FeMul(&c1x, &sqrtMinusAPlus2, &x)
+	edwards25519.FeMul(&out.X, &c1x, &xPlus1)
+	edwards25519.FeMul(&out.Y, &xMinus1, &y)
+	edwards25519.FeMul(&out.Z, &y, &xPlus1)
+	edwards25519.FeMul(&out.T, &c1x, &xMinus1)
+
+	var identity edwards25519.ExtendedGroupElement
+	identity.Zero()
+	edwards25519.ExtendedGroupElementCMove(out, &identity, 1-edwards25519.FeIsNonZero(&out.Z))
+}
+
+// montgomeryRHS sets out = x^3 + A x^2 + x, the right-hand side of the
+// equation of curve25519.
+func montgomeryRHS(out, x *edwards25519.FieldElement) {
+	var t, one edwards25519.FieldElement
+	edwards25519.FeOne(&one)
+	edwards25519.FeAdd(&t, x, &edwards25519.A)
+	edwards25519.FeMul(&t, &t, x)
+	edwards25519.FeAdd(&t, &t, &one)
+	edwards25519.FeMul(out, &t, x)
+}
+
+// feSqrt sets out to a square root of a and returns 1 if a is a square, and
+// returns 0 otherwise.
+func feSqrt(out, a *edwards25519.FieldElement) int32 {
+	// for p = 5 mod 8, a^((p+3)/8) is a square root of a or of -a
+	var r, rSqrtM1, check edwards25519.FieldElement
+	edwards25519.FePow22523(&r, a)
+	edwards25519.FeMul(&r, &r, a)
+	edwards25519.FeSquare(&check, &r)
+	edwards25519.FeSub(&check, &check, a)
+	edwards25519.FeMul(&rSqrtM1, &r, &edwards25519.SqrtM1)
+	edwards25519.FeCMove(&r, &rSqrtM1, edwards25519.FeIsNonZero(&check))
+	edwards25519.FeSquare(&check, &r)
+	edwards25519.FeSub(&check, &check, a)
+	edwards25519.FeCopy(out, &r)
+	return 1 - edwards25519.FeIsNonZero(&check)
+}
+
+// feCondNeg negates f iff b == 1.
+func feCondNeg(f *edwards25519.FieldElement, b int32) {
+	var neg edwards25519.FieldElement
+	edwards25519.FeNeg(&neg, f)
+	edwards25519.FeCMove(f, &neg, b)
+}
+
+func negate(out, p *edwards25519.ExtendedGroupElement) {
+	edwards25519.FeNeg(&out.X, &p.X)
+	edwards25519.FeCopy(&out.Y, &p.Y)
+	edwards25519.FeCopy(&out.Z, &p.Z)
+	edwards25519.FeNeg(&out.T, &p.T)
+}
+
+func mulByCofactor(out, p *edwards25519.ExtendedGroupElement) {
+	edwards25519.GeDouble(out, p)
+	edwards25519.GeDouble(out, out)
+	edwards25519.GeDouble(out, out)
+}
+
+// scIsReduced returns true iff s < l, the order of the base point.
+func scIsReduced(s *[32]byte) bool {
+	for i := 31; i >= 0; i-- {
+		if s[i] != edwards25519.BasePointOrder[i] {
+			return s[i] < edwards25519.BasePointOrder[i]
+		}
+	}
+	return false
+}
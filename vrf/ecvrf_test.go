@@ -0,0 +1,186 @@
+package vrf
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/yahoo/coname/ed25519/edwards25519"
+)
+
+func mustDecodeHex(t *testing.T, s string) []byte {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+// ECVRF-EDWARDS25519-SHA512-ELL2 test vectors from RFC 9381
+var ecvrfVectors = []struct {
+	sk, pk, alpha, h, pi, beta string
+}{
+	{
+		sk:    "9d61b19deffd5a60ba844af492ec2cc44449c5697b326919703bac031cae7f60",
+		pk:    "d75a980182b10ab7d54bfed3c964073a0ee172f3daa62325af021a68f707511a",
+		alpha: "",
+		h:     "b8066ebbb706c72b64390324e4a3276f129569eab100c26b9f05011200c1bad9",
+		pi:    "7d9c633ffeee27349264cf5c667579fc583b4bda63ab71d001f89c10003ab46f14adf9a3cd8b8412d9038531e865c341cafa73589b023d14311c331a9ad15ff2fb37831e00f0acaa6d73bc9997b06501",
+		beta:  "9d574bf9b8302ec0fc1e21c3ec5368269527b87b462ce36dab2d14ccf80c53cccf6758f058c5b1c856b116388152bbe509ee3b9ecfe63d93c3b4346c1fbc6c54",
+	},
+	{
+		sk:    "4ccd089b28ff96da9db6c346ec114e0f5b8a319f35aba624da8cf6ed4fb8a6fb",
+		pk:    "3d4017c3e843895a92b70aa74d1b7ebc9c982ccf2ec4968cc0cd55f12af4660c",
+		alpha: "72",
+		h:     "76ac3ccb86158a9104dff819b1ca293426d305fd76b39b13c9356d9b58c08e57",
+		pi:    "47b327393ff2dd81336f8a2ef10339112401253b3c714eeda879f12c509072ef055b48372bb82efbdce8e10c8cb9a2f9d60e93908f93df1623ad78a86a028d6bc064dbfc75a6a57379ef855dc6733801",
+		beta:  "38561d6b77b71d30eb97a062168ae12b667ce5c28caccdf76bc88e093e4635987cd96814ce55b4689b3dd2947f80e59aac7b7675f8083865b46c89b2ce9cc735",
+	},
+}
+
+func TestECVRFVectors(t *testing.T) {
+	for i, v := range ecvrfVectors {
+		sk := new([SecretKeySize]byte)
+		copy(sk[:32], mustDecodeHex(t, v.sk))
+		pk, wantPi, wantBeta := mustDecodeHex(t, v.pk), mustDecodeHex(t, v.pi), mustDecodeHex(t, v.beta)
+		alpha := mustDecodeHex(t, v.alpha)
+
+		gotPK, gotSK, err := ECVRFGenerateKey(bytes.NewReader(sk[:32]))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(gotPK, pk) || !bytes.Equal(gotSK[32:], pk) {
+			t.Errorf("%d: public key %x, want %x", i, gotPK, pk)
+		}
+		var h edwards25519.ExtendedGroupElement
+		var hB [32]byte
+		ecvrfEncodeToCurve(&h, pk, alpha)
+		h.ToBytes(&hB)
+		if got := hex.EncodeToString(hB[:]); got != v.h {
+			t.Errorf("%d: encode_to_curve %s, want %s", i, got, v.h)
+		}
+		beta, pi := ECVRFProve(alpha, gotSK)
+		if !bytes.Equal(pi, wantPi) {
+			t.Errorf("%d: proof %x, want %x", i, pi, wantPi)
+		}
+		if !bytes.Equal(beta, wantBeta) {
+			t.Errorf("%d: output %x, want %x", i, beta, wantBeta)
+		}
+		if got := ECVRFCompute(alpha, gotSK); !bytes.Equal(got, wantBeta) {
+			t.Errorf("%d: ECVRFCompute %x, want %x", i, got, wantBeta)
+		}
+		if got, ok := ECVRFVerify(pk, alpha, wantPi); !ok || !bytes.Equal(got, wantBeta) {
+			t.Errorf("%d: ECVRFVerify = %x, %v", i, got, ok)
+		}
+	}
+}
+
+func TestHashToCurveVector(t *testing.T) {
+	// expand_message_xmd and edwards25519_XMD:SHA-512_ELL2_NU_ test vectors
+	// from RFC 9380 for the empty message
+	if got, want := hex.EncodeToString(expandMessageXMD(nil, []byte("QUUX-V01-CS02-with-expander-SHA512-256"), 0x20)),
+		"6b9a7312411d92f921c6f68ca0b6380730a1a4d982c507211a90964c394179ba"; got != want {
+		t.Errorf("expand_message_xmd: %s, want %s", got, want)
+	}
+	var u edwards25519.FieldElement
+	var p edwards25519.ExtendedGroupElement
+	hashToField(&u, nil, []byte("QUUX-V01-CS02-with-edwards25519_XMD:SHA-512_ELL2_NU_"))
+	elligator2(&p, &u)
+	mulByCofactor(&p, &p)
+	var x, y, recip edwards25519.FieldElement
+	edwards25519.FeInvert(&recip, &p.Z)
+	edwards25519.FeMul(&x, &p.X, &recip)
+	edwards25519.FeMul(&y, &p.Y, &recip)
+	for _, c := range []struct {
+		name string
+		fe   *edwards25519.FieldElement
+		want string
+	}{
+		{"u", &u, "7f3e7fb9428103ad7f52db32f9df32505d7b427d894c5093f7a0f0374a30641d"},
+		{"P.x", &x, "1ff2b70ecf862799e11b7ae744e3489aa058ce805dd323a936375a84695e76da"},
+		{"P.y", &y, "222e314d04a4d5725e9f2aff9fb2a6b69ef375a1214eb19021ceab2d687f0f9b"},
+	} {
+		var le, be [32]byte
+		edwards25519.FeToBytes(&le, c.fe)
+		for i := range le {
+			be[31-i] = le[i]
+		}
+		if got := hex.EncodeToString(be[:]); got != c.want {
+			t.Errorf("%s: %s, want %s", c.name, got, c.want)
+		}
+	}
+}
+
+func TestECVRFForgery(t *testing.T) {
+	pk, sk, err := ECVRFGenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	alice := []byte("alice")
+	_, aliceProof := ECVRFProve(alice, sk)
+	if _, ok := ECVRFVerify(pk, []byte("bob"), aliceProof); ok {
+		t.Error("proof for alice verified for bob")
+	}
+	for i := range aliceProof {
+		for j := uint(0); j < 8; j++ {
+			aliceProof[i] ^= 1 << j
+			if _, ok := ECVRFVerify(pk, alice, aliceProof); ok {
+				t.Fatalf("forged by using aliceProof[%d]^=%d:\n (sk=%x)", i, 1<<j, sk)
+			}
+			aliceProof[i] ^= 1 << j
+		}
+	}
+	// a public key of small order
+	if _, ok := ECVRFVerify(make([]byte, PublicKeySize), alice, aliceProof); ok {
+		t.Error("verified under the identity as public key")
+	}
+}
+
+func TestSuites(t *testing.T) {
+	for _, s := range []Suite{Coname, ECVRFEdwards25519SHA512ELL2} {
+		pk, sk, err := s.GenerateKey(nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		alice := []byte("alice")
+		aliceVRF := s.Compute(alice, sk)
+		aliceVRFFromProof, aliceProof := s.Prove(alice, sk)
+		if len(aliceVRF) != Size || !bytes.Equal(aliceVRF, aliceVRFFromProof) {
+			t.Errorf("%d: Compute != Prove", s)
+		}
+		if !s.Verify(pk, alice, aliceVRF, aliceProof) {
+			t.Errorf("%d: Gen -> Compute -> Prove -> Verify -> FALSE", s)
+		}
+		if s.Verify(pk, []byte("bob"), aliceVRF, aliceProof) {
+			t.Errorf("%d: proof for alice verified for bob", s)
+		}
+		if other := Suite(1 - s); other.Verify(pk, alice, aliceVRF, aliceProof) {
+			t.Errorf("%d: proof verified as suite %d", s, other)
+		}
+	}
+}
+
+func BenchmarkECVRFProve(b *testing.B) {
+	_, sk, err := ECVRFGenerateKey(nil)
+	if err != nil {
+		b.Fatal(err)
+	}
+	alice := []byte("alice")
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		ECVRFProve(alice, sk)
+	}
+}
+
+func BenchmarkECVRFVerify(b *testing.B) {
+	pk, sk, err := ECVRFGenerateKey(nil)
+	if err != nil {
+		b.Fatal(err)
+	}
+	alice := []byte("alice")
+	_, aliceProof := ECVRFProve(alice, sk)
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		ECVRFVerify(pk, alice, aliceProof)
+	}
+}
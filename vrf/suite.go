@@ -0,0 +1,73 @@
+package vrf
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+)
+
+// A Suite is a VRF construction. Whatever the construction, the VRF value of
+// a Suite is Size bytes long: longer outputs are truncated. The values are
+// those of proto.VRFSuite.
+type Suite int32
+
+const (
+	// Coname is the construction of GenerateKey, Compute, Prove and Verify.
+	Coname Suite = 0
+	// ECVRFEdwards25519SHA512ELL2 is ECVRF-EDWARDS25519-SHA512-ELL2 of RFC
+	// 9381, see ECVRFProve. The VRF value is the first Size bytes of beta.
+	ECVRFEdwards25519SHA512ELL2 Suite = 1
+)
+
+// GenerateKey creates a public/private key pair for s. rnd is used for
+// randomness. If it is nil, `crypto/rand` is used.
+func (s Suite) GenerateKey(rnd io.Reader) (pk []byte, sk *[SecretKeySize]byte, err error) {
+	switch s {
+	case Coname:
+		return GenerateKey(rnd)
+	case ECVRFEdwards25519SHA512ELL2:
+		return ECVRFGenerateKey(rnd)
+	default:
+		return nil, nil, fmt.Errorf("unknown VRF suite %d", s)
+	}
+}
+
+// Compute returns the VRF value of m under sk.
+func (s Suite) Compute(m []byte, sk *[SecretKeySize]byte) []byte {
+	switch s {
+	case Coname:
+		return Compute(m, sk)
+	case ECVRFEdwards25519SHA512ELL2:
+		return ECVRFCompute(m, sk)[:Size]
+	default:
+		panic(fmt.Sprintf("unknown VRF suite %d", s))
+	}
+}
+
+// Prove returns the VRF value of m under sk and a proof such that
+// s.Verify(pk, m, vrf, proof) == true.
+func (s Suite) Prove(m []byte, sk *[SecretKeySize]byte) (vrf, proof []byte) {
+	switch s {
+	case Coname:
+		return Prove(m, sk)
+	case ECVRFEdwards25519SHA512ELL2:
+		vrf, proof = ECVRFProve(m, sk)
+		return vrf[:Size], proof
+	default:
+		panic(fmt.Sprintf("unknown VRF suite %d", s))
+	}
+}
+
+// Verify returns true iff vrf=s.Compute(m, sk) for the sk that corresponds to
+// pk.
+func (s Suite) Verify(pk, m, vrf, proof []byte) bool {
+	switch s {
+	case Coname:
+		return Verify(pk, m, vrf, proof)
+	case ECVRFEdwards25519SHA512ELL2:
+		beta, ok := ECVRFVerify(pk, m, proof)
+		return ok && len(vrf) == Size && bytes.Equal(beta[:Size], vrf)
+	default:
+		return false
+	}
+}
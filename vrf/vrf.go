@@ -15,6 +15,9 @@
 //     Check : E -> names -> vrfs -> proofs -> bool
 //         Check(P, n, vrf, (c,t,ii)) = vrf == h(n, ii)
 //                                     && c == h(n, g^t*P^c, H(n)^t*ii^c)
+//
+// The package also implements ECVRF-EDWARDS25519-SHA512-ELL2 of RFC 9381 (see
+// ECVRFProve), and Suite selects between the two.
 package vrf
 
 import (